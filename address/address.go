@@ -50,6 +50,12 @@ var (
 		"address: normal asset amount of zero",
 	)
 
+	// ErrAmountMismatch is returned when we attempt to set an amount on an
+	// address that already specifies a different amount.
+	ErrAmountMismatch = errors.New(
+		"address: amount doesn't match address amount",
+	)
+
 	// ErrUnsupportedAssetType is an error returned when we attempt to
 	// create a Taproot Asset address for a non-standard asset type.
 	ErrUnsupportedAssetType = errors.New("address: unsupported asset type")
@@ -83,10 +89,21 @@ const (
 	// V1 addresses use V2 Taproot Asset commitments.
 	V1 Version = 1
 
+	// V2 addresses are reusable. They use V2 Taproot Asset commitments,
+	// can be paid more than once and don't need to specify an amount, in
+	// which case the sender decides how many units to send.
+	V2 Version = 2
+
 	// LatestVersion is the latest supported Taproot Asset address version.
-	latestVersion = V1
+	latestVersion = V2
 )
 
+// IsReusable returns true if addresses of this version can be paid more than
+// once and don't need to specify an amount.
+func (v Version) IsReusable() bool {
+	return v == V2
+}
+
 // Tap represents a Taproot Asset address. Taproot Asset addresses specify an
 // asset, pubkey, and amount.
 type Tap struct {
@@ -121,6 +138,8 @@ type Tap struct {
 	TapscriptSibling *commitment.TapscriptPreimage
 
 	// Amount is the number of asset units being requested by the receiver.
+	// This is zero for reusable addresses that don't request a specific
+	// amount.
 	Amount uint64
 
 	// assetGen is the receiving asset's genesis metadata which directly
@@ -174,7 +193,8 @@ func New(version Version, genesis asset.Genesis, groupKey *btcec.PublicKey,
 
 	// Check for invalid combinations of asset type and amount.
 	// Collectible assets must have an amount of 1, and Normal assets must
-	// have a non-zero amount. We also reject invalid asset types.
+	// have a non-zero amount, unless the address is reusable. We also
+	// reject invalid asset types.
 	switch genesis.Type {
 	case asset.Collectible:
 		if amt != 1 {
@@ -182,7 +202,7 @@ func New(version Version, genesis asset.Genesis, groupKey *btcec.PublicKey,
 		}
 
 	case asset.Normal:
-		if amt == 0 {
+		if amt == 0 && !version.IsReusable() {
 			return nil, ErrInvalidAmountNormal
 		}
 
@@ -240,6 +260,32 @@ func (a *Tap) Copy() *Tap {
 	return &addressCopy
 }
 
+// HasAmount returns true if the address requests a specific amount. Only
+// reusable addresses can omit the amount.
+func (a *Tap) HasAmount() bool {
+	return a.Amount != 0
+}
+
+// WithAmount returns a copy of the address with the amount set to the given
+// value. This is used by the sender of a payment to an address that doesn't
+// specify an amount. If the address does specify an amount, the given amount
+// must match it.
+func (a *Tap) WithAmount(amt uint64) (*Tap, error) {
+	switch {
+	case amt == 0:
+		return nil, ErrInvalidAmountNormal
+
+	case a.HasAmount() && a.Amount != amt:
+		return nil, fmt.Errorf("%w: address amount %d, requested "+
+			"amount %d", ErrAmountMismatch, a.Amount, amt)
+	}
+
+	addrCopy := a.Copy()
+	addrCopy.Amount = amt
+
+	return addrCopy, nil
+}
+
 // CommitmentVersion returns the Taproot Asset commitment version that matches
 // the address version.
 func CommitmentVersion(vers Version) (*commitment.TapCommitmentVersion,
@@ -250,7 +296,7 @@ func CommitmentVersion(vers Version) (*commitment.TapCommitmentVersion,
 	// can't know without accessing all leaves of the commitment itself.
 	case V0:
 		return nil, nil
-	case V1:
+	case V1, V2:
 		return fn.Ptr(commitment.TapCommitmentV2), nil
 	default:
 		return nil, ErrUnknownVersion
@@ -449,7 +495,7 @@ func (a *Tap) String() string {
 // this implementation of tap.
 func IsUnknownVersion(v Version) bool {
 	switch v {
-	case V0, V1:
+	case V0, V1, V2:
 		return false
 	default:
 		return true
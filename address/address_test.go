@@ -198,6 +198,28 @@ func TestNewAddress(t *testing.T) {
 			},
 			err: ErrInvalidAmountNormal,
 		},
+		{
+			name: "reusable address without amount",
+			f: func() (*Tap, error) {
+				zeroAmt := uint64(0)
+				return randAddress(
+					t, &TestNet3Tap, fn.Ptr(V2), false,
+					false, &zeroAmt, asset.Normal,
+				)
+			},
+			err: nil,
+		},
+		{
+			name: "reusable collectible address without amount",
+			f: func() (*Tap, error) {
+				zeroAmt := uint64(0)
+				return randAddress(
+					t, &TestNet3Tap, fn.Ptr(V2), true,
+					false, &zeroAmt, asset.Collectible,
+				)
+			},
+			err: ErrInvalidAmountCollectible,
+		},
 		{
 			name: "invalid collectible asset value",
 			f: func() (*Tap, error) {
@@ -250,6 +272,44 @@ func TestNewAddress(t *testing.T) {
 	}
 }
 
+// TestAddressWithAmount tests that an amount can be set on an address that
+// doesn't specify one, but that an existing amount can't be changed.
+func TestAddressWithAmount(t *testing.T) {
+	t.Parallel()
+
+	zeroAmt := uint64(0)
+	reusable, err := randAddress(
+		t, &TestNet3Tap, fn.Ptr(V2), false, false, &zeroAmt,
+		asset.Normal,
+	)
+	require.NoError(t, err)
+	require.False(t, reusable.HasAmount())
+
+	// The amount-less address can be encoded and decoded.
+	encoded, err := reusable.EncodeAddress()
+	require.NoError(t, err)
+	decoded, err := DecodeAddress(encoded, &TestNet3Tap)
+	require.NoError(t, err)
+	assertAddressEqual(t, reusable, decoded)
+
+	// A zero amount is never valid for a payment.
+	_, err = reusable.WithAmount(0)
+	require.ErrorIs(t, err, ErrInvalidAmountNormal)
+
+	withAmt, err := reusable.WithAmount(1234)
+	require.NoError(t, err)
+	require.EqualValues(t, 1234, withAmt.Amount)
+	require.False(t, reusable.HasAmount())
+
+	// Once an amount is set, only the same amount is accepted.
+	_, err = withAmt.WithAmount(1235)
+	require.ErrorIs(t, err, ErrAmountMismatch)
+
+	sameAmt, err := withAmt.WithAmount(1234)
+	require.NoError(t, err)
+	require.Equal(t, withAmt, sameAmt)
+}
+
 func TestAddressEncoding(t *testing.T) {
 	t.Parallel()
 
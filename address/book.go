@@ -15,6 +15,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapscript"
 	"github.com/lightningnetwork/lnd/keychain"
 )
 
@@ -419,6 +420,126 @@ func (b *Book) NextScriptKey(ctx context.Context,
 	return scriptKey, nil
 }
 
+// NewMuSig2ScriptKey aggregates the given local key and remote keys into a
+// MuSig2 script key and inserts it, together with its key aggregation context,
+// into the database. This makes sure assets sent to the script key are
+// recognized as local assets and can later be signed for collaboratively with
+// the other signers.
+func (b *Book) NewMuSig2ScriptKey(ctx context.Context,
+	localKey keychain.KeyDescriptor,
+	remoteKeys []*btcec.PublicKey) (asset.ScriptKey, error) {
+
+	scriptKey, err := asset.NewMuSig2ScriptKey(localKey, remoteKeys)
+	if err != nil {
+		return asset.ScriptKey{}, fmt.Errorf("error creating MuSig2 "+
+			"script key: %w", err)
+	}
+
+	// We'll only be able to create partial signatures if the local key
+	// can actually be derived by our wallet.
+	if !b.cfg.KeyRing.IsLocalKey(ctx, localKey) {
+		return asset.ScriptKey{}, fmt.Errorf("local key %x is not "+
+			"known to the wallet",
+			localKey.PubKey.SerializeCompressed())
+	}
+
+	err = b.cfg.Store.InsertScriptKey(ctx, scriptKey, true)
+	if err != nil {
+		return asset.ScriptKey{}, err
+	}
+
+	return scriptKey, nil
+}
+
+// NewDescriptorScriptKey derives the script key described by the given tr()
+// output descriptor template at the given index and inserts it, together with
+// the derived descriptor, into the database. The template must contain the
+// local key placeholder, which is replaced with a newly derived key of the
+// wallet. That key is used to sign for any of the descriptor's spend paths it
+// is part of. The index is used to derive the extended keys of the template
+// that end in a wildcard step and is ignored if there are none.
+func (b *Book) NewDescriptorScriptKey(ctx context.Context, template string,
+	index uint32) (asset.ScriptKey, error) {
+
+	desc, err := tapscript.ParseDescriptor(template)
+	if err != nil {
+		return asset.ScriptKey{}, err
+	}
+
+	// We only ever create script keys we're able to sign for, otherwise
+	// the assets sent to them would be shown as ours without us being able
+	// to spend them.
+	if !desc.HasLocalKey() {
+		return asset.ScriptKey{}, fmt.Errorf("descriptor template "+
+			"must contain the %s local key placeholder",
+			tapscript.DescriptorLocalKey)
+	}
+
+	localKey, err := b.cfg.KeyRing.DeriveNextTaprootAssetKey(ctx)
+	if err != nil {
+		return asset.ScriptKey{}, fmt.Errorf("unable to gen key: %w",
+			err)
+	}
+
+	derivedDesc, err := desc.Derive(index, localKey.PubKey)
+	if err != nil {
+		return asset.ScriptKey{}, fmt.Errorf("unable to derive "+
+			"descriptor: %w", err)
+	}
+
+	scriptTree, err := derivedDesc.ScriptTree()
+	if err != nil {
+		return asset.ScriptKey{}, fmt.Errorf("unable to compute "+
+			"descriptor script tree: %w", err)
+	}
+
+	scriptKey := scriptTree.ScriptKey()
+	scriptKey.Descriptor = &asset.DescriptorInfo{
+		Descriptor: derivedDesc.String(),
+		LocalKey:   localKey,
+	}
+
+	err = b.cfg.Store.InsertScriptKey(ctx, scriptKey, true)
+	if err != nil {
+		return asset.ScriptKey{}, err
+	}
+
+	return scriptKey, nil
+}
+
+// NewAddressFromDescriptor creates a new Taproot Asset address with a script
+// key that is derived from the given tr() output descriptor template at the
+// given index. See NewDescriptorScriptKey for the requirements of the
+// template.
+func (b *Book) NewAddressFromDescriptor(ctx context.Context,
+	addrVersion Version, assetID asset.ID, amount uint64, template string,
+	index uint32, tapscriptSibling *commitment.TapscriptPreimage,
+	proofCourierAddr url.URL, addrOpts ...NewAddrOpt) (*AddrWithKeyInfo,
+	error) {
+
+	// Before we proceed and make new keys, make sure that we actually know
+	// of this asset ID, or can import it.
+	if _, err := b.QueryAssetInfo(ctx, assetID); err != nil {
+		return nil, fmt.Errorf("unable to make address for unknown "+
+			"asset %x: %w", assetID[:], err)
+	}
+
+	scriptKey, err := b.NewDescriptorScriptKey(ctx, template, index)
+	if err != nil {
+		return nil, err
+	}
+
+	internalKeyDesc, err := b.cfg.KeyRing.DeriveNextTaprootAssetKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to gen key: %w", err)
+	}
+
+	return b.NewAddressWithKeys(
+		ctx, addrVersion, assetID, amount, scriptKey, internalKeyDesc,
+		tapscriptSibling, proofCourierAddr, addrOpts...,
+	)
+}
+
 // ListAddrs lists a set of addresses based on the expressed query params.
 func (b *Book) ListAddrs(ctx context.Context,
 	params QueryParams) ([]AddrWithKeyInfo, error) {
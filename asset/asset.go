@@ -18,6 +18,7 @@ import (
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
@@ -479,6 +480,12 @@ const (
 	// output key, allowing the ability for an asset to indirectly commit to
 	// multiple spending conditions.
 	ScriptV0 ScriptVersion = 0
+
+	// ScriptV1 represents the asset script version that extends ScriptV0
+	// with introspection opcodes. In this version, the tapscript leaves of
+	// the script key can inspect the amounts, script keys and asset IDs of
+	// the asset outputs of the virtual transaction that spends the asset.
+	ScriptV1 ScriptVersion = 1
 )
 
 // TapscriptTreeNodes represents the two supported ways to define a tapscript
@@ -992,6 +999,19 @@ type TweakedScriptKey struct {
 	// flag has is that assets with a declared key are shown in the asset
 	// list/balance.
 	DeclaredKnown bool
+
+	// MuSig2 holds the key aggregation context if this script key is a
+	// MuSig2 aggregate of a local and one or more remote keys. In that
+	// case the RawKey is the aggregated key before the BIP-0086 tweak is
+	// applied and doesn't have a key locator, since it cannot be signed
+	// for by the local wallet alone.
+	MuSig2 *MuSig2KeyInfo
+
+	// Descriptor holds the output descriptor the script key was derived
+	// from, if it was imported from one. In that case the RawKey is the
+	// internal key of the descriptor, which isn't necessarily a key of the
+	// local wallet.
+	Descriptor *DescriptorInfo
 }
 
 // IsEqual returns true is this tweaked script key is exactly equivalent to the
@@ -1009,9 +1029,121 @@ func (ts *TweakedScriptKey) IsEqual(other *TweakedScriptKey) bool {
 		return false
 	}
 
+	if !ts.MuSig2.IsEqual(other.MuSig2) {
+		return false
+	}
+
+	if !ts.Descriptor.IsEqual(other.Descriptor) {
+		return false
+	}
+
 	return EqualKeyDescriptors(ts.RawKey, other.RawKey)
 }
 
+// MuSig2KeyInfo is the key aggregation context of a MuSig2 script key. It
+// contains everything that is needed to create a MuSig2 signing session for
+// the aggregated key with the local wallet's signer.
+type MuSig2KeyInfo struct {
+	// LocalKey is the key descriptor of the local signer's key that is
+	// part of the aggregated key.
+	LocalKey keychain.KeyDescriptor
+
+	// SignerKeys is the full list of all signer keys that were aggregated,
+	// including the local key. The keys are sorted lexicographically by
+	// their compressed serialization, as described in BIP-0327.
+	SignerKeys []*btcec.PublicKey
+}
+
+// IsEqual returns true if this key aggregation context is exactly equivalent
+// to the passed other context.
+func (m *MuSig2KeyInfo) IsEqual(other *MuSig2KeyInfo) bool {
+	if m == nil {
+		return other == nil
+	}
+
+	if other == nil {
+		return false
+	}
+
+	if !EqualKeyDescriptors(m.LocalKey, other.LocalKey) {
+		return false
+	}
+
+	if len(m.SignerKeys) != len(other.SignerKeys) {
+		return false
+	}
+
+	for idx := range m.SignerKeys {
+		if !m.SignerKeys[idx].IsEqual(other.SignerKeys[idx]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the key aggregation context.
+func (m *MuSig2KeyInfo) Copy() *MuSig2KeyInfo {
+	return &MuSig2KeyInfo{
+		LocalKey:   m.LocalKey,
+		SignerKeys: fn.CopySlice(m.SignerKeys),
+	}
+}
+
+// AggregateKey returns the MuSig2 aggregate of all signer keys with the
+// BIP-0086 tweak applied.
+func (m *MuSig2KeyInfo) AggregateKey() (*musig2.AggregateKey, error) {
+	return input.MuSig2CombineKeys(
+		input.MuSig2Version100RC2, m.SignerKeys, true,
+		&input.MuSig2Tweaks{
+			TaprootBIP0086Tweak: true,
+		},
+	)
+}
+
+// RemoteKeys returns all signer keys except for the local key.
+func (m *MuSig2KeyInfo) RemoteKeys() []*btcec.PublicKey {
+	return fn.Filter(m.SignerKeys, func(key *btcec.PublicKey) bool {
+		return !key.IsEqual(m.LocalKey.PubKey)
+	})
+}
+
+// DescriptorInfo is the output descriptor information of a script key that
+// was imported from a descriptor.
+type DescriptorInfo struct {
+	// Descriptor is the fully derived tr() output descriptor of the script
+	// key. All key expressions of the descriptor are concrete public keys.
+	Descriptor string
+
+	// LocalKey is the key descriptor of the local wallet's key that is
+	// used in the descriptor. This is the key we sign with when spending
+	// through one of the descriptor's spend paths.
+	LocalKey keychain.KeyDescriptor
+}
+
+// IsEqual returns true if this descriptor information is exactly equivalent
+// to the passed other descriptor information.
+func (d *DescriptorInfo) IsEqual(other *DescriptorInfo) bool {
+	if d == nil {
+		return other == nil
+	}
+
+	if other == nil {
+		return false
+	}
+
+	return d.Descriptor == other.Descriptor &&
+		EqualKeyDescriptors(d.LocalKey, other.LocalKey)
+}
+
+// Copy returns a copy of the descriptor information.
+func (d *DescriptorInfo) Copy() *DescriptorInfo {
+	return &DescriptorInfo{
+		Descriptor: d.Descriptor,
+		LocalKey:   d.LocalKey,
+	}
+}
+
 // ScriptKey represents a tweaked Taproot output key encumbering the different
 // ways an asset can be spent.
 type ScriptKey struct {
@@ -1116,6 +1248,71 @@ func NewScriptKeyBip86(rawKey keychain.KeyDescriptor) ScriptKey {
 	}
 }
 
+// NewMuSig2ScriptKey constructs a ScriptKey from the MuSig2 aggregate of the
+// given local key and remote keys, tweaked BIP-0086 style. The keys are sorted
+// before being aggregated, so all signers arrive at the same script key
+// regardless of the order they specify the keys in.
+func NewMuSig2ScriptKey(localKey keychain.KeyDescriptor,
+	remoteKeys []*btcec.PublicKey) (ScriptKey, error) {
+
+	if localKey.PubKey == nil {
+		return ScriptKey{}, fmt.Errorf("local key must be set")
+	}
+	if len(remoteKeys) == 0 {
+		return ScriptKey{}, fmt.Errorf("at least one remote key is " +
+			"required")
+	}
+
+	signerKeys := make([]*btcec.PublicKey, 0, len(remoteKeys)+1)
+	signerKeys = append(signerKeys, localKey.PubKey)
+	for _, remoteKey := range remoteKeys {
+		for _, signerKey := range signerKeys {
+			if remoteKey.IsEqual(signerKey) {
+				return ScriptKey{}, fmt.Errorf("duplicate "+
+					"signer key %x",
+					remoteKey.SerializeCompressed())
+			}
+		}
+
+		signerKeys = append(signerKeys, remoteKey)
+	}
+
+	slices.SortFunc(signerKeys, func(a, b *btcec.PublicKey) int {
+		return bytes.Compare(
+			a.SerializeCompressed(), b.SerializeCompressed(),
+		)
+	})
+
+	muSig2Info := &MuSig2KeyInfo{
+		LocalKey:   localKey,
+		SignerKeys: signerKeys,
+	}
+	aggKey, err := muSig2Info.AggregateKey()
+	if err != nil {
+		return ScriptKey{}, fmt.Errorf("error aggregating keys: %w",
+			err)
+	}
+
+	// Just like for BIP-0086 script keys, we only ever use the x-only
+	// representation of the tweaked key.
+	tweakedPubKey, err := schnorr.ParsePubKey(
+		schnorr.SerializePubKey(aggKey.FinalKey),
+	)
+	if err != nil {
+		return ScriptKey{}, err
+	}
+
+	return ScriptKey{
+		PubKey: tweakedPubKey,
+		TweakedScriptKey: &TweakedScriptKey{
+			RawKey: keychain.KeyDescriptor{
+				PubKey: aggKey.PreTweakedKey,
+			},
+			MuSig2: muSig2Info,
+		},
+	}, nil
+}
+
 // NewGroupKeyRequest constructs and validates a group key request.
 func NewGroupKeyRequest(internalKey keychain.KeyDescriptor, anchorGen Genesis,
 	newAsset *Asset, scriptRoot []byte) (*GroupKeyRequest, error) {
@@ -1674,6 +1871,14 @@ func (a *Asset) Copy() *Asset {
 			)
 			copy(assetCopy.ScriptKey.Tweak, a.ScriptKey.Tweak)
 		}
+
+		if a.ScriptKey.MuSig2 != nil {
+			assetCopy.ScriptKey.MuSig2 = a.ScriptKey.MuSig2.Copy()
+		}
+
+		if desc := a.ScriptKey.Descriptor; desc != nil {
+			assetCopy.ScriptKey.Descriptor = desc.Copy()
+		}
 	}
 
 	if a.GroupKey != nil {
@@ -1163,3 +1163,53 @@ func TestNewAssetWithCustomVersion(t *testing.T) {
 
 	require.Equal(t, int(assetCustomVersion.Version), newVersion)
 }
+
+// TestNewMuSig2ScriptKey tests that all signers arrive at the same MuSig2
+// script key, independent of which key is their local key and the order of
+// the remote keys.
+func TestNewMuSig2ScriptKey(t *testing.T) {
+	t.Parallel()
+
+	keys := make([]keychain.KeyDescriptor, 3)
+	for idx := range keys {
+		keys[idx] = keychain.KeyDescriptor{
+			PubKey: test.RandPubKey(t),
+		}
+	}
+
+	aliceKey, err := NewMuSig2ScriptKey(
+		keys[0], []*btcec.PublicKey{keys[1].PubKey, keys[2].PubKey},
+	)
+	require.NoError(t, err)
+
+	bobKey, err := NewMuSig2ScriptKey(
+		keys[1], []*btcec.PublicKey{keys[2].PubKey, keys[0].PubKey},
+	)
+	require.NoError(t, err)
+
+	require.True(t, aliceKey.PubKey.IsEqual(bobKey.PubKey))
+	require.True(t, aliceKey.RawKey.PubKey.IsEqual(bobKey.RawKey.PubKey))
+	require.Equal(t, aliceKey.MuSig2.SignerKeys, bobKey.MuSig2.SignerKeys)
+	require.Len(t, aliceKey.MuSig2.RemoteKeys(), 2)
+
+	// The script key must be the BIP-0086 tweaked aggregate key, so it can
+	// be spent through the key path with a single MuSig2 signature.
+	bip86Key := NewScriptKeyBip86(keychain.KeyDescriptor{
+		PubKey: aliceKey.RawKey.PubKey,
+	})
+	require.True(t, bip86Key.PubKey.IsEqual(aliceKey.PubKey))
+
+	// The key aggregation context must survive a copy of the asset.
+	newAsset := RandAsset(t, Normal)
+	newAsset.ScriptKey = aliceKey
+	require.True(t, newAsset.Copy().ScriptKey.IsEqual(&aliceKey))
+
+	// Specifying the local key as a remote key isn't allowed.
+	_, err = NewMuSig2ScriptKey(
+		keys[0], []*btcec.PublicKey{keys[1].PubKey, keys[0].PubKey},
+	)
+	require.ErrorContains(t, err, "duplicate signer key")
+
+	_, err = NewMuSig2ScriptKey(keys[0], nil)
+	require.ErrorContains(t, err, "at least one remote key")
+}
@@ -124,7 +124,7 @@ func GenesisPrevOutFetcher(prevAsset Asset) (*txscript.CannedPrevOutputFetcher,
 // enable group witness validation.
 func InputGenesisAssetPrevOut(prevAsset Asset) (*wire.TxOut, error) {
 	switch prevAsset.ScriptVersion {
-	case ScriptV0:
+	case ScriptV0, ScriptV1:
 		// If the input asset is a genesis asset that is part of an
 		// asset group, we need to validate the group witness against
 		// the tweaked group key and not the genesis asset script key.
@@ -3,7 +3,10 @@ package taprootassets
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"fmt"
+	"os"
+	"sync"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -18,10 +21,13 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/funding"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/verrpc"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
@@ -91,6 +97,23 @@ func (l *LndRpcChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	}, errChan, nil
 }
 
+// RegisterSpendNtfn registers an intent to be notified once the given outpoint
+// is spent by a transaction that confirmed on chain.
+func (l *LndRpcChainBridge) RegisterSpendNtfn(ctx context.Context,
+	outpoint *wire.OutPoint, pkScript []byte,
+	heightHint uint32) (chan *chainntnfs.SpendDetail, chan error, error) {
+
+	spendChan, errChan, err := l.lnd.ChainNotifier.RegisterSpendNtfn(
+		ctx, outpoint, pkScript, int32(heightHint),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to register for spend: %w",
+			err)
+	}
+
+	return spendChan, errChan, nil
+}
+
 // RegisterBlockEpochNtfn registers an intent to be notified of each new block
 // connected to the main chain.
 func (l *LndRpcChainBridge) RegisterBlockEpochNtfn(
@@ -438,3 +461,96 @@ func (l *LndInvoicesClient) HtlcModifier(ctx context.Context,
 // Ensure LndInvoicesClient implements the tapchannel.InvoiceHtlcModifier
 // interface.
 var _ tapchannel.InvoiceHtlcModifier = (*LndInvoicesClient)(nil)
+
+// LndPaymentClient is an LND client that exposes lnd's raw payment and invoice
+// RPCs. We need direct access to them, as lndclient doesn't allow us to set
+// all the fields of the requests, for example the first hop custom records.
+// The raw clients share the gRPC connection of lndclient, but need to add the
+// macaroon to each request themselves.
+type LndPaymentClient struct {
+	router    routerrpc.RouterClient
+	lightning lnrpc.LightningClient
+
+	// macaroonPath is the path of the macaroon the requests are
+	// authenticated with. If it is empty, the requests are sent without a
+	// macaroon.
+	macaroonPath string
+
+	// macaroonMtx guards macaroon.
+	macaroonMtx sync.Mutex
+
+	// macaroon is the hex encoded macaroon, once it was read from disk.
+	macaroon string
+}
+
+// NewLndPaymentClient creates a new LND payment client that uses the gRPC
+// connection of the given LND services and authenticates with the macaroon at
+// the given path. The macaroon is only read once the first request is made,
+// so setups that don't use the payment RPCs don't need the macaroon on disk.
+func NewLndPaymentClient(lnd *lndclient.LndServices,
+	macaroonPath string) (*LndPaymentClient, error) {
+
+	if lnd.ClientConn == nil {
+		return nil, fmt.Errorf("lnd services have no client connection")
+	}
+
+	return &LndPaymentClient{
+		router:       routerrpc.NewRouterClient(lnd.ClientConn),
+		lightning:    lnrpc.NewLightningClient(lnd.ClientConn),
+		macaroonPath: macaroonPath,
+	}, nil
+}
+
+// withMacaroonAuth adds the macaroon to the outgoing context of an RPC call,
+// reading it from disk if that didn't happen yet. A failed read is retried on
+// the next call.
+func (l *LndPaymentClient) withMacaroonAuth(
+	ctx context.Context) (context.Context, error) {
+
+	if l.macaroonPath == "" {
+		return ctx, nil
+	}
+
+	l.macaroonMtx.Lock()
+	defer l.macaroonMtx.Unlock()
+
+	if l.macaroon == "" {
+		macBytes, err := os.ReadFile(l.macaroonPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read lnd macaroon "+
+				"%s: %w", l.macaroonPath, err)
+		}
+
+		l.macaroon = hex.EncodeToString(macBytes)
+	}
+
+	return metadata.AppendToOutgoingContext(
+		ctx, "macaroon", l.macaroon,
+	), nil
+}
+
+// SendPayment attempts to route a payment with the given request and returns
+// a stream of payment updates.
+func (l *LndPaymentClient) SendPayment(ctx context.Context,
+	req *routerrpc.SendPaymentRequest) (
+	routerrpc.Router_SendPaymentV2Client, error) {
+
+	ctx, err := l.withMacaroonAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.router.SendPaymentV2(ctx, req)
+}
+
+// AddInvoice creates a new invoice from the given invoice request.
+func (l *LndPaymentClient) AddInvoice(ctx context.Context,
+	req *lnrpc.Invoice) (*lnrpc.AddInvoiceResponse, error) {
+
+	ctx, err := l.withMacaroonAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.lightning.AddInvoice(ctx, req)
+}
@@ -0,0 +1,153 @@
+package taprootassets
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// mockRouterClient is a mock router client that records the macaroons of the
+// payments it was asked to send.
+type mockRouterClient struct {
+	routerrpc.RouterClient
+
+	macaroons [][]string
+}
+
+// SendPaymentV2 records the macaroon of the outgoing context.
+func (m *mockRouterClient) SendPaymentV2(ctx context.Context,
+	_ *routerrpc.SendPaymentRequest,
+	_ ...grpc.CallOption) (routerrpc.Router_SendPaymentV2Client, error) {
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	m.macaroons = append(m.macaroons, md.Get("macaroon"))
+
+	return nil, nil
+}
+
+// mockLightningClient is a mock lightning client that records the macaroons
+// of the invoices it was asked to add.
+type mockLightningClient struct {
+	lnrpc.LightningClient
+
+	macaroons [][]string
+}
+
+// AddInvoice records the macaroon of the outgoing context.
+func (m *mockLightningClient) AddInvoice(ctx context.Context, _ *lnrpc.Invoice,
+	_ ...grpc.CallOption) (*lnrpc.AddInvoiceResponse, error) {
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	m.macaroons = append(m.macaroons, md.Get("macaroon"))
+
+	return &lnrpc.AddInvoiceResponse{}, nil
+}
+
+// TestLndPaymentClient tests that the LND payment client authenticates its
+// requests with the macaroon at the configured path, and that a missing
+// macaroon only fails the requests that need it.
+func TestLndPaymentClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	macBytes := []byte("test macaroon")
+	macHex := hex.EncodeToString(macBytes)
+
+	tempDir := t.TempDir()
+	macPath := filepath.Join(tempDir, "admin.macaroon")
+	require.NoError(t, os.WriteFile(macPath, macBytes, 0600))
+
+	testCases := []struct {
+		name          string
+		macaroonPath  string
+		expectedMacs  []string
+		expectedError string
+	}{{
+		name:         "macaroon on disk",
+		macaroonPath: macPath,
+		expectedMacs: []string{macHex},
+	}, {
+		name:          "missing macaroon",
+		macaroonPath:  filepath.Join(tempDir, "missing.macaroon"),
+		expectedError: "unable to read lnd macaroon",
+	}, {
+		name:         "no macaroon path",
+		macaroonPath: "",
+		expectedMacs: nil,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router := &mockRouterClient{}
+			lightning := &mockLightningClient{}
+			client := &LndPaymentClient{
+				router:       router,
+				lightning:    lightning,
+				macaroonPath: tc.macaroonPath,
+			}
+
+			_, err := client.SendPayment(
+				ctx, &routerrpc.SendPaymentRequest{},
+			)
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			_, err = client.AddInvoice(ctx, &lnrpc.Invoice{})
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				require.Empty(t, router.macaroons)
+				require.Empty(t, lightning.macaroons)
+
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(
+				t, [][]string{tc.expectedMacs},
+				router.macaroons,
+			)
+			require.Equal(
+				t, [][]string{tc.expectedMacs},
+				lightning.macaroons,
+			)
+		})
+	}
+}
+
+// TestNewLndPaymentClient tests that the LND payment client can be created
+// without a macaroon on disk, as long as there is an lnd connection.
+func TestNewLndPaymentClient(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewLndPaymentClient(&lndclient.LndServices{}, "")
+	require.ErrorContains(t, err, "no client connection")
+
+	conn, err := grpc.Dial(
+		"localhost:10009",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, conn.Close())
+	})
+
+	missingPath := filepath.Join(t.TempDir(), "missing.macaroon")
+	client, err := NewLndPaymentClient(
+		&lndclient.LndServices{ClientConn: conn}, missingPath,
+	)
+	require.NoError(t, err)
+	require.Equal(t, missingPath, client.macaroonPath)
+}
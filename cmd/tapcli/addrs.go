@@ -46,16 +46,18 @@ var newAddrCommand = cli.Command{
 			Usage: "the asset genesis ID of the asset to receive",
 		},
 		cli.Uint64Flag{
-			Name:  amtName,
-			Usage: "the amt of the asset to receive",
+			Name: amtName,
+			Usage: "the amt of the asset to receive; can be " +
+				"omitted for reusable (v2) addresses",
 		},
 		cli.Uint64Flag{
 			Name:  assetVersionName,
 			Usage: "the asset version of the asset to receive",
 		},
 		cli.StringFlag{
-			Name:  addressVersionName,
-			Usage: "the version of address to generate",
+			Name: addressVersionName,
+			Usage: "the version of address to generate; v2 " +
+				"creates a reusable address",
 		},
 		cli.StringFlag{
 			Name: proofCourierAddrName,
@@ -96,6 +98,8 @@ func newAddr(ctx *cli.Context) error {
 		addrVersion = taprpc.AddrVersion_ADDR_VERSION_V0
 	case "v1", "V1":
 		addrVersion = taprpc.AddrVersion_ADDR_VERSION_V1
+	case "v2", "V2":
+		addrVersion = taprpc.AddrVersion_ADDR_VERSION_V2
 	default:
 		return fmt.Errorf("unknown address version: %s",
 			ctx.String(addressVersionName))
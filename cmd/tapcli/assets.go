@@ -6,10 +6,13 @@ import (
 	"math"
 	"os"
 	"strconv"
+	"strings"
 
+	"github.com/btcsuite/btcd/wire"
 	taprootassets "github.com/lightninglabs/taproot-assets"
 	"github.com/lightninglabs/taproot-assets/tapcfg"
 	"github.com/lightninglabs/taproot-assets/taprpc"
+	wrpc "github.com/lightninglabs/taproot-assets/taprpc/assetwalletrpc"
 	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/urfave/cli"
@@ -29,6 +32,8 @@ var assetsCommands = []cli.Command{
 			listAssetBalancesCommand,
 			sendAssetsCommand,
 			burnAssetsCommand,
+			bumpTransferFeeCommand,
+			consolidateAssetsCommand,
 			listTransfersCommand,
 			fetchMetaCommand,
 		},
@@ -51,12 +56,18 @@ var (
 	assetGroupKeyName            = "group_key"
 	assetGroupAnchorName         = "group_anchor"
 	batchKeyName                 = "batch_key"
+	newBatchName                 = "new_batch"
 	groupByGroupName             = "by_group"
 	assetIDName                  = "asset_id"
 	shortResponseName            = "short"
 	feeRateName                  = "sat_per_vbyte"
 	assetAmountName              = "amount"
 	burnOverrideConfirmationName = "override_confirmation_destroy_assets"
+	anchorTxidName               = "anchor_txid"
+	coinSelectStrategyName       = "coin_select_strategy"
+	pinnedInputName              = "pinned_input"
+	addrWithAmtName              = "addr_with_amt"
+	dryRunName                   = "dry_run"
 )
 
 var mintAssetCommand = cli.Command{
@@ -127,6 +138,17 @@ var mintAssetCommand = cli.Command{
 			Usage: "the other asset in this batch that the new " +
 				"asset be grouped with",
 		},
+		cli.StringFlag{
+			Name: batchKeyName,
+			Usage: "if set, the batch key of the pending batch " +
+				"to add the asset to; must be set if " +
+				"multiple batches are pending",
+		},
+		cli.BoolFlag{
+			Name: newBatchName,
+			Usage: "if true, then the asset is added to a new " +
+				"batch, even if other batches are pending",
+		},
 		cli.BoolFlag{
 			Name: shortResponseName,
 			Usage: "if true, then the current assets within the " +
@@ -142,6 +164,7 @@ var mintAssetCommand = cli.Command{
 		sealBatchCommand,
 		finalizeBatchCommand,
 		cancelBatchCommand,
+		bumpBatchFeeCommand,
 	},
 }
 
@@ -181,6 +204,24 @@ func parseMetaType(metaType string) (taprpc.AssetMetaType, error) {
 	}
 }
 
+// targetBatchKeyFlag is the flag used to specify the pending batch a minting
+// batch command targets.
+var targetBatchKeyFlag = cli.StringFlag{
+	Name: batchKeyName,
+	Usage: "if set, the batch key of the target batch; must be set if " +
+		"multiple batches are pending",
+}
+
+// parseBatchKey parses the optional batch key flag.
+func parseBatchKey(ctx *cli.Context) ([]byte, error) {
+	batchKey, err := hex.DecodeString(ctx.String(batchKeyName))
+	if err != nil {
+		return nil, fmt.Errorf("invalid batch key: %w", err)
+	}
+
+	return batchKey, nil
+}
+
 func parseFeeRate(ctx *cli.Context) (uint32, error) {
 	if ctx.IsSet(feeRateName) {
 		userFeeRate := ctx.Uint64(feeRateName)
@@ -318,6 +359,11 @@ func mintAsset(ctx *cli.Context) error {
 		return fmt.Errorf("supply must be set for normal assets")
 	}
 
+	batchKey, err := parseBatchKey(ctx)
+	if err != nil {
+		return err
+	}
+
 	ctxc := getContext()
 	client, cleanUp := getMintClient(ctx)
 	defer cleanUp()
@@ -338,6 +384,8 @@ func mintAsset(ctx *cli.Context) error {
 			),
 		},
 		ShortResponse: ctx.Bool(shortResponseName),
+		BatchKey:      batchKey,
+		NewBatch:      ctx.Bool(newBatchName),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to mint asset: %w", err)
@@ -352,8 +400,9 @@ var fundBatchCommand = cli.Command{
 	Usage: "fund a batch",
 	Description: `
 	Attempt to fund a pending batch, or create a new funded batch if no
-	batch exists yet. This is only needed if batch funding should happen
-	separately from batch finalization. Otherwise, finalize can be used.
+	batch exists yet or --new_batch is set. This is only needed if batch
+	funding should happen separately from batch finalization. Otherwise,
+	finalize can be used.
 	`,
 	Flags: []cli.Flag{
 		cli.BoolFlag{
@@ -368,6 +417,12 @@ var fundBatchCommand = cli.Command{
 			Usage: "if set, the fee rate in sat/vB to use for " +
 				"the minting transaction",
 		},
+		targetBatchKeyFlag,
+		cli.BoolFlag{
+			Name: newBatchName,
+			Usage: "if true, then a new batch is created and " +
+				"funded, even if other batches are pending",
+		},
 	},
 	Action: fundBatch,
 }
@@ -382,9 +437,16 @@ func fundBatch(ctx *cli.Context) error {
 		return err
 	}
 
+	batchKey, err := parseBatchKey(ctx)
+	if err != nil {
+		return err
+	}
+
 	resp, err := client.FundBatch(ctxc, &mintrpc.FundBatchRequest{
 		ShortResponse: ctx.Bool(shortResponseName),
 		FeeRate:       feeRate,
+		BatchKey:      batchKey,
+		NewBatch:      ctx.Bool(newBatchName),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to fund batch: %w", err)
@@ -411,6 +473,7 @@ var sealBatchCommand = cli.Command{
 				"in order to avoid printing a large amount " +
 				"of data in case of large batches",
 		},
+		targetBatchKeyFlag,
 	},
 	Hidden: true,
 	Action: sealBatch,
@@ -421,8 +484,14 @@ func sealBatch(ctx *cli.Context) error {
 	client, cleanUp := getMintClient(ctx)
 	defer cleanUp()
 
+	batchKey, err := parseBatchKey(ctx)
+	if err != nil {
+		return err
+	}
+
 	resp, err := client.SealBatch(ctxc, &mintrpc.SealBatchRequest{
 		ShortResponse: ctx.Bool(shortResponseName),
+		BatchKey:      batchKey,
 	})
 	if err != nil {
 		return fmt.Errorf("unable to seal batch: %w", err)
@@ -449,6 +518,7 @@ var finalizeBatchCommand = cli.Command{
 			Usage: "if set, the fee rate in sat/vB to use for " +
 				"the minting transaction",
 		},
+		targetBatchKeyFlag,
 	},
 	Action: finalizeBatch,
 }
@@ -463,9 +533,15 @@ func finalizeBatch(ctx *cli.Context) error {
 		return err
 	}
 
+	batchKey, err := parseBatchKey(ctx)
+	if err != nil {
+		return err
+	}
+
 	resp, err := client.FinalizeBatch(ctxc, &mintrpc.FinalizeBatchRequest{
 		ShortResponse: ctx.Bool(shortResponseName),
 		FeeRate:       feeRate,
+		BatchKey:      batchKey,
 	})
 	if err != nil {
 		return fmt.Errorf("unable to finalize batch: %w", err)
@@ -480,7 +556,10 @@ var cancelBatchCommand = cli.Command{
 	ShortName:   "c",
 	Usage:       "cancel a batch",
 	Description: "Attempt to cancel a pending batch.",
-	Action:      cancelBatch,
+	Flags: []cli.Flag{
+		targetBatchKeyFlag,
+	},
+	Action: cancelBatch,
 }
 
 func cancelBatch(ctx *cli.Context) error {
@@ -488,7 +567,14 @@ func cancelBatch(ctx *cli.Context) error {
 	client, cleanUp := getMintClient(ctx)
 	defer cleanUp()
 
-	resp, err := client.CancelBatch(ctxc, &mintrpc.CancelBatchRequest{})
+	batchKey, err := parseBatchKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.CancelBatch(ctxc, &mintrpc.CancelBatchRequest{
+		BatchKey: batchKey,
+	})
 	if err != nil {
 		return fmt.Errorf("unable to cancel batch: %w", err)
 	}
@@ -497,6 +583,56 @@ func cancelBatch(ctx *cli.Context) error {
 	return nil
 }
 
+var bumpBatchFeeCommand = cli.Command{
+	Name:      "bumpfee",
+	ShortName: "bf",
+	Usage:     "bump the fee of a broadcast batch",
+	Description: `
+	Replace the anchor transaction of a minting batch that was broadcast but
+	is not yet confirmed with one paying the given, higher fee rate.
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "the new fee rate in sat/vB to use for the " +
+				"minting transaction",
+		},
+		targetBatchKeyFlag,
+	},
+	Action: bumpBatchFee,
+}
+
+func bumpBatchFee(ctx *cli.Context) error {
+	if !ctx.IsSet(feeRateName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	batchKey, err := parseBatchKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.BumpBatchFee(ctxc, &mintrpc.BumpBatchFeeRequest{
+		BatchKey: batchKey,
+		FeeRate:  feeRate,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to bump batch fee: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var listBatchesCommand = cli.Command{
 	Name:        "batches",
 	ShortName:   "b",
@@ -712,15 +848,108 @@ var sendAssetsCommand = cli.Command{
 			Usage: "if set, the fee rate in sat/vB to use for " +
 				"the anchor transaction",
 		},
+		cli.StringFlag{
+			Name: coinSelectStrategyName,
+			Usage: "the strategy used to select the asset " +
+				"inputs; one of 'max-amount', 'min-amount', " +
+				"'exact-match' or 'short-proof-chain'",
+			Value: "max-amount",
+		},
+		cli.StringSliceFlag{
+			Name: pinnedInputName,
+			Usage: "an anchor outpoint (txid:vout) to spend " +
+				"instead of selecting inputs with the " +
+				"strategy; can be specified multiple times",
+		},
+		cli.StringSliceFlag{
+			Name: addrWithAmtName,
+			Usage: "an addr to send to together with the amount " +
+				"to send (addr:amt); must be used for " +
+				"reusable addresses without an amount; can " +
+				"be specified multiple times",
+		},
 		// TODO(roasbeef): add arg for file name to write sender proof
 		// blob
 	},
 	Action: sendAssets,
 }
 
+// parseCoinSelectStrategy parses the coin selection strategy flag, which is
+// the suffix of the RPC enum value in lower case and with dashes, for example
+// "max-amount" for COIN_SELECT_STRATEGY_MAX_AMOUNT.
+func parseCoinSelectStrategy(
+	ctx *cli.Context) (taprpc.CoinSelectStrategy, error) {
+
+	flagValue := ctx.String(coinSelectStrategyName)
+	enumName := "COIN_SELECT_STRATEGY_" + strings.ToUpper(
+		strings.ReplaceAll(flagValue, "-", "_"),
+	)
+
+	strategy, ok := taprpc.CoinSelectStrategy_value[enumName]
+	if !ok {
+		return 0, fmt.Errorf("unknown coin select strategy '%v'",
+			flagValue)
+	}
+
+	return taprpc.CoinSelectStrategy(strategy), nil
+}
+
+// parsePinnedInputs parses the pinned input flags.
+func parsePinnedInputs(ctx *cli.Context) ([]*taprpc.OutPoint, error) {
+	var pinnedInputs []*taprpc.OutPoint
+	for _, opStr := range ctx.StringSlice(pinnedInputName) {
+		op, err := wire.NewOutPointFromString(opStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pinned input '%v': %w",
+				opStr, err)
+		}
+
+		pinnedInputs = append(pinnedInputs, &taprpc.OutPoint{
+			Txid:        op.Hash[:],
+			OutputIndex: op.Index,
+		})
+	}
+
+	return pinnedInputs, nil
+}
+
+// parseAddrsWithAmounts parses the address with amount flags.
+func parseAddrsWithAmounts(
+	ctx *cli.Context) ([]*taprpc.AddressWithAmount, error) {
+
+	var addrsWithAmts []*taprpc.AddressWithAmount
+	for _, flagValue := range ctx.StringSlice(addrWithAmtName) {
+		sep := strings.LastIndex(flagValue, ":")
+		if sep < 0 {
+			return nil, fmt.Errorf("invalid address with amount "+
+				"'%v', expected format addr:amt", flagValue)
+		}
+
+		amt, err := strconv.ParseUint(flagValue[sep+1:], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount in '%v': %w",
+				flagValue, err)
+		}
+
+		addrsWithAmts = append(addrsWithAmts, &taprpc.AddressWithAmount{
+			TapAddr: flagValue[:sep],
+			Amount:  amt,
+		})
+	}
+
+	return addrsWithAmts, nil
+}
+
 func sendAssets(ctx *cli.Context) error {
 	addrs := ctx.StringSlice(addrName)
-	if ctx.NArg() != 0 || ctx.NumFlags() == 0 || len(addrs) == 0 {
+	addrsWithAmts, err := parseAddrsWithAmounts(ctx)
+	if err != nil {
+		return err
+	}
+
+	if ctx.NArg() != 0 || ctx.NumFlags() == 0 ||
+		len(addrs)+len(addrsWithAmts) == 0 {
+
 		return cli.ShowSubcommandHelp(ctx)
 	}
 
@@ -733,9 +962,22 @@ func sendAssets(ctx *cli.Context) error {
 		return err
 	}
 
+	strategy, err := parseCoinSelectStrategy(ctx)
+	if err != nil {
+		return err
+	}
+
+	pinnedInputs, err := parsePinnedInputs(ctx)
+	if err != nil {
+		return err
+	}
+
 	resp, err := client.SendAsset(ctxc, &taprpc.SendAssetRequest{
-		TapAddrs: addrs,
-		FeeRate:  feeRate,
+		TapAddrs:             addrs,
+		FeeRate:              feeRate,
+		CoinSelectStrategy:   strategy,
+		PinnedInputs:         pinnedInputs,
+		AddressesWithAmounts: addrsWithAmts,
 	})
 	if err != nil {
 		return fmt.Errorf("unable to send assets: %w", err)
@@ -845,6 +1087,132 @@ func burnAssets(ctx *cli.Context) error {
 	return nil
 }
 
+var bumpTransferFeeCommand = cli.Command{
+	Name:      "bumpfee",
+	ShortName: "bf",
+	Usage:     "bump the fee of a broadcast transfer",
+	Description: `
+	Replace the anchor transaction of an asset transfer that was broadcast
+	but is not yet confirmed with one paying the given, higher fee rate. The
+	fee increase is deducted from the BTC change output of the transfer.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  anchorTxidName,
+			Usage: "the anchor transaction ID of the transfer",
+		},
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "the new fee rate in sat/vB to use for the " +
+				"anchor transaction",
+		},
+	},
+	Action: bumpTransferFee,
+}
+
+func bumpTransferFee(ctx *cli.Context) error {
+	if !ctx.IsSet(anchorTxidName) || !ctx.IsSet(feeRateName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &taprpc.BumpTransferFeeRequest{
+		AnchorTxid: ctx.String(anchorTxidName),
+		FeeRate:    feeRate,
+	}
+	resp, err := client.BumpTransferFee(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to bump transfer fee: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var consolidateAssetsCommand = cli.Command{
+	Name:      "consolidate",
+	ShortName: "c",
+	Usage:     "merge all coins of an asset into a single output",
+	Description: `
+	Spend all available coins of the given asset ID or asset group back to
+	the wallet in a single transfer. All coins are merged into a single new
+	anchor output, with one fresh script key per asset ID.
+
+	With --dry_run, no transfer is created and only the expected chain fee
+	and proof size of the consolidation are shown.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the coins to consolidate",
+		},
+		cli.StringFlag{
+			Name: assetGroupKeyName,
+			Usage: "the group key of the coins to consolidate, " +
+				"instead of a specific asset ID",
+		},
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "if set, the fee rate in sat/vB to use for " +
+				"the anchor transaction",
+		},
+		cli.BoolFlag{
+			Name: dryRunName,
+			Usage: "if set, only the expected fee and proof size " +
+				"are shown",
+		},
+	},
+	Action: consolidateAssets,
+}
+
+func consolidateAssets(ctx *cli.Context) error {
+	if !ctx.IsSet(assetIDName) && !ctx.IsSet(assetGroupKeyName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	assetID, err := hex.DecodeString(ctx.String(assetIDName))
+	if err != nil {
+		return fmt.Errorf("invalid asset ID: %w", err)
+	}
+
+	groupKey, err := hex.DecodeString(ctx.String(assetGroupKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid group key: %w", err)
+	}
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ConsolidateAssets(
+		ctxc, &wrpc.ConsolidateAssetsRequest{
+			AssetId:  assetID,
+			GroupKey: groupKey,
+			FeeRate:  feeRate,
+			DryRun:   ctx.Bool(dryRunName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to consolidate assets: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var listTransfersCommand = cli.Command{
 	Name:      "transfers",
 	ShortName: "t",
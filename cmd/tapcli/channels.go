@@ -0,0 +1,275 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/lightninglabs/taproot-assets/taprpc/tapchannelrpc"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/urfave/cli"
+)
+
+const (
+	peerPubKeyName = "peer_pubkey"
+
+	payReqName = "pay_req"
+
+	destName = "dest"
+
+	keysendName = "keysend"
+
+	feeLimitSatName = "fee_limit_sat"
+
+	paymentTimeoutName = "timeout"
+
+	memoName = "memo"
+
+	invoiceExpiryName = "expiry"
+
+	// defaultPaymentTimeout is the default number of seconds a payment is
+	// attempted before it is given up.
+	defaultPaymentTimeout = 60
+)
+
+var channelsCommands = []cli.Command{
+	{
+		Name:      "channels",
+		ShortName: "ch",
+		Usage:     "Interact with Taproot Asset channels.",
+		Category:  "Channels",
+		Subcommands: []cli.Command{
+			sendPaymentCommand,
+			addInvoiceCommand,
+		},
+	},
+}
+
+// assetSpecifierFlags are the flags used to specify the asset of a channel
+// payment or invoice.
+var assetSpecifierFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  assetIDName,
+		Usage: "the asset ID of the asset to use",
+	},
+	cli.StringFlag{
+		Name: groupKeyName,
+		Usage: "the group key of the asset group to use, instead of " +
+			"a specific asset ID",
+	},
+}
+
+// parseAssetSpecifier parses the asset ID or group key flags.
+func parseAssetSpecifier(ctx *cli.Context) ([]byte, []byte, error) {
+	assetID, err := hex.DecodeString(ctx.String(assetIDName))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid asset ID: %w", err)
+	}
+
+	groupKey, err := hex.DecodeString(ctx.String(groupKeyName))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid group key: %w", err)
+	}
+
+	return assetID, groupKey, nil
+}
+
+var sendPaymentCommand = cli.Command{
+	Name:      "sendpayment",
+	ShortName: "s",
+	Usage:     "pay an invoice or send a keysend payment with assets",
+	Description: `
+	Pays an invoice with assets, by first negotiating a quote with the given
+	peer for converting the assets to the satoshi amount of the invoice. If
+	no invoice is given, a keysend payment of the given asset amount is sent
+	to the destination node instead.
+
+	The payment updates are printed until the payment reaches a final state.
+`,
+	Flags: append([]cli.Flag{
+		cli.Uint64Flag{
+			Name: assetAmountName,
+			Usage: "the amount of asset units to send in a " +
+				"keysend payment, or the maximum amount to " +
+				"spend when paying an invoice",
+		},
+		cli.StringFlag{
+			Name: peerPubKeyName,
+			Usage: "the public key of the peer to ask for a " +
+				"quote when paying an invoice",
+		},
+		cli.StringFlag{
+			Name:  payReqName,
+			Usage: "the invoice to pay",
+		},
+		cli.StringFlag{
+			Name:  destName,
+			Usage: "the destination node of a keysend payment",
+		},
+		cli.BoolFlag{
+			Name: keysendName,
+			Usage: "if set, a keysend payment is sent to the " +
+				"destination node instead of paying an invoice",
+		},
+		cli.Int64Flag{
+			Name:  feeLimitSatName,
+			Usage: "the maximum routing fee in satoshis to pay",
+		},
+		cli.Int64Flag{
+			Name: paymentTimeoutName,
+			Usage: "the number of seconds the payment is " +
+				"attempted before it is given up",
+			Value: defaultPaymentTimeout,
+		},
+	}, assetSpecifierFlags...),
+	Action: sendPayment,
+}
+
+func sendPayment(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getTapChannelsClient(ctx)
+	defer cleanUp()
+
+	assetID, groupKey, err := parseAssetSpecifier(ctx)
+	if err != nil {
+		return err
+	}
+
+	peerPubKey, err := hex.DecodeString(ctx.String(peerPubKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid peer pubkey: %w", err)
+	}
+
+	pReq := &routerrpc.SendPaymentRequest{
+		FeeLimitSat:    ctx.Int64(feeLimitSatName),
+		TimeoutSeconds: int32(ctx.Int64(paymentTimeoutName)),
+	}
+
+	switch {
+	case ctx.Bool(keysendName) && ctx.IsSet(payReqName):
+		return fmt.Errorf("cannot use --%s together with --%s",
+			keysendName, payReqName)
+
+	case ctx.Bool(keysendName):
+		dest, err := hex.DecodeString(ctx.String(destName))
+		if err != nil {
+			return fmt.Errorf("invalid destination: %w", err)
+		}
+
+		// For a keysend payment we create the preimage ourselves and
+		// send it to the destination in a custom record.
+		var preimage [32]byte
+		if _, err := rand.Read(preimage[:]); err != nil {
+			return fmt.Errorf("unable to create preimage: %w", err)
+		}
+		paymentHash := sha256.Sum256(preimage[:])
+
+		pReq.Dest = dest
+		pReq.PaymentHash = paymentHash[:]
+		pReq.DestCustomRecords = map[uint64][]byte{
+			record.KeySendType: preimage[:],
+		}
+
+	case ctx.IsSet(payReqName):
+		pReq.PaymentRequest = ctx.String(payReqName)
+
+	default:
+		return fmt.Errorf("either --%s or --%s must be set", payReqName,
+			keysendName)
+	}
+
+	req := &tapchannelrpc.SendPaymentRequest{
+		AssetId:        assetID,
+		GroupKey:       groupKey,
+		AssetAmount:    ctx.Uint64(assetAmountName),
+		PeerPubkey:     peerPubKey,
+		PaymentRequest: pReq,
+	}
+	stream, err := client.SendPayment(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to send payment: %w", err)
+	}
+
+	for {
+		resp, err := stream.Recv()
+		switch {
+		case err == io.EOF:
+			return nil
+
+		case err != nil:
+			return fmt.Errorf("unable to receive payment "+
+				"update: %w", err)
+		}
+
+		printRespJSON(resp)
+	}
+}
+
+var addInvoiceCommand = cli.Command{
+	Name:      "addinvoice",
+	ShortName: "i",
+	Usage:     "create an invoice to receive assets",
+	Description: `
+	Creates an invoice for receiving the given amount of asset units, by
+	first negotiating a quote with the given peer for converting the
+	satoshis of the payment into assets.
+`,
+	Flags: append([]cli.Flag{
+		cli.Uint64Flag{
+			Name:  assetAmountName,
+			Usage: "the amount of asset units to receive",
+		},
+		cli.StringFlag{
+			Name: peerPubKeyName,
+			Usage: "the public key of the peer to ask for a " +
+				"quote and to receive the payment through",
+		},
+		cli.StringFlag{
+			Name:  memoName,
+			Usage: "a description of the payment to attach",
+		},
+		cli.Int64Flag{
+			Name: invoiceExpiryName,
+			Usage: "the invoice's expiry time in seconds, uses " +
+				"lnd's default if not set",
+		},
+	}, assetSpecifierFlags...),
+	Action: addInvoice,
+}
+
+func addInvoice(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getTapChannelsClient(ctx)
+	defer cleanUp()
+
+	assetID, groupKey, err := parseAssetSpecifier(ctx)
+	if err != nil {
+		return err
+	}
+
+	peerPubKey, err := hex.DecodeString(ctx.String(peerPubKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid peer pubkey: %w", err)
+	}
+
+	resp, err := client.AddInvoice(ctxc, &tapchannelrpc.AddInvoiceRequest{
+		AssetId:     assetID,
+		GroupKey:    groupKey,
+		AssetAmount: ctx.Uint64(assetAmountName),
+		PeerPubkey:  peerPubKey,
+		InvoiceRequest: &lnrpc.Invoice{
+			Memo:   ctx.String(memoName),
+			Expiry: ctx.Int64(invoiceExpiryName),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to add invoice: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	wrpc "github.com/lightninglabs/taproot-assets/taprpc/assetwalletrpc"
 	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
 	"github.com/lightninglabs/taproot-assets/taprpc/rfqrpc"
+	"github.com/lightninglabs/taproot-assets/taprpc/tapchannelrpc"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/lightningnetwork/lnd/tor"
@@ -96,6 +97,18 @@ func getRfqClient(ctx *cli.Context) (rfqrpc.RfqClient, func()) {
 	return rfqrpc.NewRfqClient(conn), cleanUp
 }
 
+func getTapChannelsClient(
+	ctx *cli.Context) (tapchannelrpc.TaprootAssetChannelsClient, func()) {
+
+	conn := getClientConn(ctx, false)
+
+	cleanUp := func() {
+		conn.Close()
+	}
+
+	return tapchannelrpc.NewTaprootAssetChannelsClient(conn), cleanUp
+}
+
 func getClientConn(ctx *cli.Context, skipMacaroons bool) *grpc.ClientConn {
 	// First, we'll get the selected stored profile or an ephemeral one
 	// created from the global options in the CLI context.
@@ -346,6 +359,7 @@ func main() {
 	app.Commands = append(app.Commands, eventCommands...)
 	app.Commands = append(app.Commands, proofCommands...)
 	app.Commands = append(app.Commands, rfqCommands...)
+	app.Commands = append(app.Commands, channelsCommands...)
 	app.Commands = append(app.Commands, universeCommands...)
 	app.Commands = append(app.Commands, devCommands...)
 
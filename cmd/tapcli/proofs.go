@@ -23,8 +23,11 @@ var proofCommands = []cli.Command{
 			verifyProofCommand,
 			decodeProofCommand,
 			exportProofCommand,
+			pruneProofCommand,
 			proveOwnershipCommand,
 			verifyOwnershipCommand,
+			exportWalletCommand,
+			importWalletCommand,
 		},
 	},
 }
@@ -277,6 +280,67 @@ func exportProof(ctx *cli.Context) error {
 	return nil
 }
 
+var pruneProofCommand = cli.Command{
+	Name:      "prune",
+	ShortName: "p",
+	Usage:     "prune the history of a taproot asset proof",
+	Description: `
+	Prune the history of a local taproot asset proof file, so the file
+	starts at the latest proof that is a leaf of the local universe or a
+	trusted checkpoint server instead of the asset's genesis. The pruned
+	proofs are removed from the local proof archive, but only if they can
+	be restored from those universes, which happens whenever the asset is
+	sent. This requires the universe.proof-checkpoints option to be
+	enabled.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: assetIDName,
+			Usage: "the asset ID of the asset to prune the proof " +
+				"of",
+		},
+		cli.StringFlag{
+			Name: scriptKeyName,
+			Usage: "the script key of the asset to prune the " +
+				"proof of",
+		},
+	},
+	Action: pruneProof,
+}
+
+func pruneProof(ctx *cli.Context) error {
+	switch {
+	case ctx.String(scriptKeyName) == "",
+		ctx.String(assetIDName) == "":
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	scriptKeyBytes, err := hex.DecodeString(ctx.String(scriptKeyName))
+	if err != nil {
+		return fmt.Errorf("unable to decode script key: %w", err)
+	}
+
+	assetID, err := hex.DecodeString(ctx.String(assetIDName))
+	if err != nil {
+		return fmt.Errorf("unable to decode asset ID: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.PruneProof(ctxc, &taprpc.PruneProofRequest{
+		AssetId:   assetID,
+		ScriptKey: scriptKeyBytes,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to prune proof file: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var proveOwnershipCommand = cli.Command{
 	Name:      "proveownership",
 	ShortName: "po",
@@ -352,6 +416,108 @@ func proveOwnership(ctx *cli.Context) error {
 	return nil
 }
 
+const (
+	bundlePathName = "bundle_file"
+)
+
+var exportWalletCommand = cli.Command{
+	Name:      "exportwallet",
+	ShortName: "ew",
+	Usage:     "export the complete asset wallet into a bundle",
+	Description: `
+	Export all proof files, the derivation information of all internal and
+	script keys and the history of all confirmed transfers into a single,
+	versioned wallet bundle. The bundle can be imported into another node
+	with the "importwallet" command. The export fails if there are any
+	unconfirmed transfers.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: bundlePathName,
+			Usage: "the file to write the wallet bundle to; use " +
+				"the dash character (-) to write the bundle " +
+				"to stdout",
+		},
+	},
+	Action: exportWallet,
+}
+
+func exportWallet(ctx *cli.Context) error {
+	if ctx.String(bundlePathName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ExportWallet(ctxc, &wrpc.ExportWalletRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to export wallet: %w", err)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(bundlePathName))
+	if err := writeToFile(filePath, resp.Bundle); err != nil {
+		return err
+	}
+
+	// The bundle itself is only written to the file, we don't want to
+	// also print it to stdout.
+	if filePath != "-" {
+		resp.Bundle = nil
+		printRespJSON(resp)
+	}
+
+	return nil
+}
+
+var importWalletCommand = cli.Command{
+	Name:      "importwallet",
+	ShortName: "iw",
+	Usage:     "import an asset wallet from a bundle",
+	Description: `
+	Import a wallet bundle created with the "exportwallet" command. All
+	proofs in the bundle are verified before anything is written to the
+	database. A wallet bundle can only be imported into a node that doesn't
+	own any assets or transfers yet.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: bundlePathName,
+			Usage: "the file to read the wallet bundle from; use " +
+				"the dash character (-) to read the bundle " +
+				"from stdin",
+		},
+	},
+	Action: importWallet,
+}
+
+func importWallet(ctx *cli.Context) error {
+	if ctx.String(bundlePathName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(bundlePathName))
+	bundle, err := readFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read wallet bundle: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ImportWallet(ctxc, &wrpc.ImportWalletRequest{
+		Bundle: bundle,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to import wallet: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 // readFile attempts to read a file from disk. If the passed fileName is equal
 // to the dash character, then this function reads from stdin instead.
 func readFile(fileName string) ([]byte, error) {
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	"github.com/lightninglabs/taproot-assets/taprpc/rfqrpc"
@@ -15,6 +16,8 @@ var rfqCommands = []cli.Command{
 		Category:  "Channels",
 		Subcommands: []cli.Command{
 			acceptedQuotesCommand,
+			rateTicksCommand,
+			quoteHistoryCommand,
 		},
 	},
 }
@@ -45,3 +48,218 @@ func acceptedQuotes(ctx *cli.Context) error {
 
 	return nil
 }
+
+const (
+	tickTypeName = "tick_type"
+
+	startTimestampName = "start_timestamp"
+
+	endTimestampName = "end_timestamp"
+
+	validAtName = "valid_at"
+
+	tickAssetAmountName = "asset_amount"
+)
+
+var rateTicksCommand = cli.Command{
+	Name:      "rateticks",
+	ShortName: "t",
+	Usage:     "show the recorded rate ticks of the price oracle",
+	Description: `
+	Lists the rate ticks that were received from the price oracle, ordered
+	from the most recent to the oldest. This requires tapd to be configured
+	to record rate ticks (experimental.rfq.recordrateticks).
+
+	If --valid_at is set, only the rate tick of the given asset and type
+	that was valid at that unix timestamp is shown. As the price oracle can
+	quote different rates for different amounts, --asset_amount should be
+	set to the amount of the quote that is audited.
+`,
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name: tickTypeName,
+			Usage: "only show rate ticks of this type, either " +
+				"'ask' or 'bid'",
+		},
+		cli.Uint64Flag{
+			Name: startTimestampName,
+			Usage: "only show rate ticks received at or after " +
+				"this unix timestamp",
+		},
+		cli.Uint64Flag{
+			Name: endTimestampName,
+			Usage: "only show rate ticks received at or before " +
+				"this unix timestamp",
+		},
+		cli.Uint64Flag{
+			Name: validAtName,
+			Usage: "show the rate tick that was valid at this " +
+				"unix timestamp",
+		},
+		cli.Uint64Flag{
+			Name: tickAssetAmountName,
+			Usage: "only show rate ticks the price oracle " +
+				"returned for this asset amount",
+		},
+		cli.Int64Flag{
+			Name:  limitName,
+			Usage: "the max number of rate ticks to return",
+		},
+	}, assetSpecifierFlags...),
+	Action: rateTicks,
+}
+
+func rateTicks(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getRfqClient(ctx)
+	defer cleanUp()
+
+	req := &rfqrpc.QueryRateTicksRequest{
+		StartTimestamp:   ctx.Uint64(startTimestampName),
+		EndTimestamp:     ctx.Uint64(endTimestampName),
+		ValidAtTimestamp: ctx.Uint64(validAtName),
+		AssetAmount:      ctx.Uint64(tickAssetAmountName),
+		Limit:            int32(ctx.Int64(limitName)),
+	}
+
+	switch ctx.String(tickTypeName) {
+	case "":
+		req.TickType = rfqrpc.RateTickType_RATE_TICK_TYPE_UNSPECIFIED
+
+	case "ask":
+		req.TickType = rfqrpc.RateTickType_RATE_TICK_TYPE_ASK
+
+	case "bid":
+		req.TickType = rfqrpc.RateTickType_RATE_TICK_TYPE_BID
+
+	default:
+		return fmt.Errorf("unknown tick type: %v",
+			ctx.String(tickTypeName))
+	}
+
+	assetSpecifier, err := parseRfqAssetSpecifier(ctx)
+	if err != nil {
+		return err
+	}
+	req.AssetSpecifier = assetSpecifier
+
+	resp, err := client.QueryRateTicks(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to query rate ticks: %w", err)
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+// parseRfqAssetSpecifier parses the optional asset ID or group key flags into
+// an RFQ asset specifier. Nil is returned if neither flag is set.
+func parseRfqAssetSpecifier(ctx *cli.Context) (*rfqrpc.AssetSpecifier,
+	error) {
+
+	switch {
+	case ctx.IsSet(assetIDName) && ctx.IsSet(groupKeyName):
+		return nil, fmt.Errorf("only one of --%s or --%s can be set",
+			assetIDName, groupKeyName)
+
+	case ctx.IsSet(assetIDName):
+		assetID, err := hex.DecodeString(ctx.String(assetIDName))
+		if err != nil {
+			return nil, fmt.Errorf("invalid asset ID: %w", err)
+		}
+
+		return &rfqrpc.AssetSpecifier{
+			Id: &rfqrpc.AssetSpecifier_AssetId{
+				AssetId: assetID,
+			},
+		}, nil
+
+	case ctx.IsSet(groupKeyName):
+		groupKey, err := hex.DecodeString(ctx.String(groupKeyName))
+		if err != nil {
+			return nil, fmt.Errorf("invalid group key: %w", err)
+		}
+
+		return &rfqrpc.AssetSpecifier{
+			Id: &rfqrpc.AssetSpecifier_GroupKey{
+				GroupKey: groupKey,
+			},
+		}, nil
+
+	default:
+		return nil, nil
+	}
+}
+
+var quoteHistoryCommand = cli.Command{
+	Name:      "quotehistory",
+	ShortName: "h",
+	Usage:     "show the history of accepted quotes",
+	Description: `
+	Lists the quotes that were accepted by our node or by our peers,
+	ordered from the most recent to the oldest, together with the HTLCs
+	that were accepted against each quote.
+`,
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name:  peerPubKeyName,
+			Usage: "only show quotes negotiated with this peer",
+		},
+		cli.Uint64Flag{
+			Name: startTimestampName,
+			Usage: "only show quotes accepted at or after this " +
+				"unix timestamp",
+		},
+		cli.Uint64Flag{
+			Name: endTimestampName,
+			Usage: "only show quotes accepted at or before this " +
+				"unix timestamp",
+		},
+		cli.Int64Flag{
+			Name:  limitName,
+			Usage: "the max number of quotes to return",
+		},
+		cli.Int64Flag{
+			Name:  offsetName,
+			Usage: "the number of quotes to skip",
+		},
+	}, assetSpecifierFlags...),
+	Action: quoteHistory,
+}
+
+func quoteHistory(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getRfqClient(ctx)
+	defer cleanUp()
+
+	req := &rfqrpc.QueryQuoteHistoryRequest{
+		StartTimestamp: ctx.Uint64(startTimestampName),
+		EndTimestamp:   ctx.Uint64(endTimestampName),
+		Limit:          int32(ctx.Int64(limitName)),
+		Offset:         int32(ctx.Int64(offsetName)),
+	}
+
+	if ctx.IsSet(peerPubKeyName) {
+		peer, err := hex.DecodeString(ctx.String(peerPubKeyName))
+		if err != nil {
+			return fmt.Errorf("invalid peer pubkey: %w", err)
+		}
+		req.Peer = peer
+	}
+
+	assetSpecifier, err := parseRfqAssetSpecifier(ctx)
+	if err != nil {
+		return err
+	}
+	req.AssetSpecifier = assetSpecifier
+
+	resp, err := client.QueryQuoteHistory(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to query quote history: %w", err)
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"math"
 
 	"github.com/btcsuite/btcd/wire"
 	tap "github.com/lightninglabs/taproot-assets"
@@ -431,11 +432,12 @@ var universeProofCommand = cli.Command{
 	on chain. Proofs are namespaced based on a top level assetID/groupKey,
 	so that must be specified for each command.
 
-	Two sub-commands are available: proof querying (query) and proof
-	insertion (insert).
+	The available sub-commands are: proof querying (query), batched proof
+	querying (multi), proof insertion (insert) and proof pushing (push).
 	`,
 	Subcommands: []cli.Command{
 		universeProofQueryCommand,
+		universeProofMultiCommand,
 		universeProofInsertCommand,
 		universeProofPushCommand,
 	},
@@ -511,6 +513,96 @@ func universeProofQuery(ctx *cli.Context) error {
 	return nil
 }
 
+var universeProofMultiCommand = cli.Command{
+	Name:  "multi",
+	Usage: "query for a single multi-proof covering a set of leaves",
+	Description: `
+	Attempt to query the target universe for a single batched inclusion
+	proof that covers all the given leaves of a top level asset id or group
+	key. Each leaf is identified by an outpoint and a script key, the
+	outpoint and script_key flags are paired up in the order they're given.
+	If no leaves are given, the multi-proof covers all leaves of the
+	universe.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the universe to query for",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the universe to query for",
+		},
+		cli.StringSliceFlag{
+			Name: outpointName,
+			Usage: "the outpoint of a leaf to include in the " +
+				"proof, can be specified multiple times",
+		},
+		cli.StringSliceFlag{
+			Name: scriptKeyName,
+			Usage: "the script key of a leaf to include in the " +
+				"proof, can be specified multiple times",
+		},
+		cli.StringFlag{
+			Name: proofTypeName,
+			Usage: "the type of proof to query for, either " +
+				"'issuance' or 'transfer'",
+			Value: universe.ProofTypeIssuance.String(),
+		},
+	},
+	Action: universeProofMulti,
+}
+
+func universeProofMulti(ctx *cli.Context) error {
+	universeID, err := parseUniverseID(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	outpoints := ctx.StringSlice(outpointName)
+	scriptKeys := ctx.StringSlice(scriptKeyName)
+	if len(outpoints) != len(scriptKeys) {
+		return fmt.Errorf("the number of outpoints (%d) must match "+
+			"the number of script keys (%d)", len(outpoints),
+			len(scriptKeys))
+	}
+
+	leafKeys := make([]*unirpc.AssetKey, 0, len(outpoints))
+	for idx := range outpoints {
+		outpoint, err := wire.NewOutPointFromString(outpoints[idx])
+		if err != nil {
+			return err
+		}
+
+		leafKeys = append(leafKeys, &unirpc.AssetKey{
+			Outpoint: &unirpc.AssetKey_Op{
+				Op: &unirpc.Outpoint{
+					HashStr: outpoint.Hash.String(),
+					Index:   int32(outpoint.Index),
+				},
+			},
+			ScriptKey: &unirpc.AssetKey_ScriptKeyStr{
+				ScriptKeyStr: scriptKeys[idx],
+			},
+		})
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.QueryMultiProof(ctxc, &unirpc.MultiProofRequest{
+		Id:       universeID,
+		LeafKeys: leafKeys,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var universeProofInsertCommand = cli.Command{
 	Name:  "insert",
 	Usage: "insert a new universe proof",
@@ -862,11 +954,12 @@ var universeFederationConfigCommand = cli.Command{
 	Manage the sync behavior of the local Universe. These settings are
 	defined by the proof type (issuance or transfer), the sync behavior
 	(insert from remote Universe or export to remote Universe), and the
-	scope (all assets or specific assets).
+	scope (all assets, specific assets or specific servers).
         `,
 	Subcommands: []cli.Command{
 		universeFederationGlobalConfig,
 		universeFederationLocalConfig,
+		universeFederationServerConfig,
 		universeFederationConfigInfo,
 	},
 }
@@ -875,6 +968,8 @@ var (
 	universeConfigScope    = "config_scope"
 	proofInsertName        = "allow_insert"
 	proofExportName        = "allow_export"
+	syncQuorumName         = "sync_quorum"
+	trustWeightName        = "trust_weight"
 	universeSyncConfigArgs = []cli.Flag{
 		cli.StringFlag{
 			Name:  proofTypeName,
@@ -956,9 +1051,18 @@ var universeFederationGlobalConfig = cli.Command{
 	assets by default. Per-asset sync behavior will override global
 	settings. These settings are defined by the proof type (issuance or
 	transfer) and the sync behavior (insert from remote Universe or export
-	to remote Universe).
+	to remote Universe). A sync quorum can be set to require multiple
+	federation servers to agree on the root of a universe before it is
+	synced.
         `,
-	Flags:  universeSyncConfigArgs,
+	Flags: append(universeSyncConfigArgs,
+		cli.Uint64Flag{
+			Name: syncQuorumName,
+			Usage: "the combined trust weight of the " +
+				"federation servers that need to report the " +
+				"same universe root before it is synced; 0 " +
+				"syncs the root of any single server",
+		}),
 	Action: universeFederationUpdateGlobalConfig,
 }
 
@@ -978,9 +1082,22 @@ func universeFederationUpdateGlobalConfig(ctx *cli.Context) error {
 		return err
 	}
 
-	insertOpt, exportOpt, err := parseConfigArgs(ctx)
-	if err != nil {
-		return err
+	// The sync quorum can be updated on its own, otherwise either the
+	// insert or export flag must be set.
+	var insertOpt, exportOpt *bool
+	if !ctx.IsSet(syncQuorumName) || ctx.IsSet(proofInsertName) ||
+		ctx.IsSet(proofExportName) {
+
+		insertOpt, exportOpt, err = parseConfigArgs(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	syncQuorum := ctx.Uint64(syncQuorumName)
+	if syncQuorum > math.MaxInt32 {
+		return fmt.Errorf("sync quorum must not exceed %d",
+			math.MaxInt32)
 	}
 
 	// Read the current global config for the matching proof type.
@@ -1014,6 +1131,9 @@ func universeFederationUpdateGlobalConfig(ctx *cli.Context) error {
 	if exportOpt != nil {
 		currentConfig.AllowSyncExport = *exportOpt
 	}
+	if ctx.IsSet(syncQuorumName) {
+		currentConfig.SyncQuorum = fn.Ptr(uint32(syncQuorum))
+	}
 
 	configReq := &unirpc.SetFederationSyncConfigRequest{
 		GlobalSyncConfigs: []*unirpc.GlobalFederationSyncConfig{
@@ -1128,6 +1248,119 @@ func universeFederationUpdateLocalConfig(ctx *cli.Context) error {
 	return nil
 }
 
+var universeFederationServerConfig = cli.Command{
+	Name:      "server",
+	ShortName: "s",
+	Usage: "Change the sync behavior of the local Universe for a " +
+		"specific federation server",
+	Description: `
+	Manage the sync behavior of the local Universe for a specific
+	federation server. A server can be made read-only (proofs are only
+	synced from it) or write-only (proofs are only pushed to it), and can
+	be given a trust weight that counts towards the sync quorum. Servers
+	without a specific config are synced with in both directions and have
+	a trust weight of 1.
+        `,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  universeHostName,
+			Usage: "the host of the federation server to configure",
+		},
+		cli.StringFlag{
+			Name: proofInsertName,
+			Usage: "if true, proofs are synced from the server " +
+				"into the local Universe",
+		},
+		cli.StringFlag{
+			Name: proofExportName,
+			Usage: "if true, proofs of the local Universe are " +
+				"pushed to the server",
+		},
+		cli.Uint64Flag{
+			Name: trustWeightName,
+			Usage: "the weight the universe roots reported by " +
+				"the server carry towards the sync quorum",
+		},
+	},
+	Action: universeFederationUpdateServerConfig,
+}
+
+func universeFederationUpdateServerConfig(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	host := ctx.String(universeHostName)
+	if host == "" {
+		return fmt.Errorf("must specify the server host")
+	}
+
+	var insertOpt, exportOpt *bool
+	if !ctx.IsSet(trustWeightName) || ctx.IsSet(proofInsertName) ||
+		ctx.IsSet(proofExportName) {
+
+		var err error
+		insertOpt, exportOpt, err = parseConfigArgs(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	trustWeight := ctx.Uint64(trustWeightName)
+	if trustWeight > math.MaxInt32 {
+		return fmt.Errorf("trust weight must not exceed %d",
+			math.MaxInt32)
+	}
+
+	// Read the current config for the server if it exists, otherwise we
+	// start from the default config.
+	syncConfigs, err := client.QueryFederationSyncConfig(
+		ctxc, &unirpc.QueryFederationSyncConfigRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	serverConfig, err := fn.First(
+		syncConfigs.ServerSyncConfigs,
+		func(cfg *unirpc.ServerFederationSyncConfig) bool {
+			return cfg.Host == host
+		},
+	)
+	if err != nil {
+		serverConfig = &unirpc.ServerFederationSyncConfig{
+			Host:            host,
+			AllowSyncInsert: true,
+			AllowSyncExport: true,
+			TrustWeight:     1,
+		}
+	}
+
+	if insertOpt != nil {
+		serverConfig.AllowSyncInsert = *insertOpt
+	}
+	if exportOpt != nil {
+		serverConfig.AllowSyncExport = *exportOpt
+	}
+	if ctx.IsSet(trustWeightName) {
+		serverConfig.TrustWeight = uint32(trustWeight)
+	}
+
+	configReq := &unirpc.SetFederationSyncConfigRequest{
+		ServerSyncConfigs: []*unirpc.ServerFederationSyncConfig{
+			serverConfig,
+		},
+	}
+
+	resp, err := client.SetFederationSyncConfig(ctxc, configReq)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var universeFederationConfigInfo = cli.Command{
 	Name:      "info",
 	ShortName: "i",
@@ -1140,8 +1373,9 @@ var universeFederationConfigInfo = cli.Command{
         `,
 	Flags: []cli.Flag{
 		cli.StringFlag{
-			Name:  universeConfigScope,
-			Usage: "the scope (global or local) of the config",
+			Name: universeConfigScope,
+			Usage: "the scope (global, local or server) of the " +
+				"config",
 		},
 		cli.StringFlag{
 			Name:  assetIDName,
@@ -1160,7 +1394,7 @@ func universeFederationGetConfigInfo(ctx *cli.Context) error {
 	client, cleanUp := getUniverseClient(ctx)
 	defer cleanUp()
 
-	validScopes := []string{"global", "local"}
+	validScopes := []string{"global", "local", "server"}
 	parseConfigScope := func(ctx *cli.Context) (string, error) {
 		if !ctx.IsSet(universeConfigScope) {
 			return "", fmt.Errorf("config scope not specified")
@@ -1210,6 +1444,14 @@ func universeFederationGetConfigInfo(ctx *cli.Context) error {
 		case "local":
 			return fmt.Errorf("local scope requires " +
 				"universe ID fields")
+
+		case "server":
+			for _, config := range syncConfigs.ServerSyncConfigs {
+				config := config
+				printRespJSON(config)
+			}
+
+			return nil
 		}
 	}
 
@@ -1264,9 +1506,9 @@ func universeFederationGetConfigInfo(ctx *cli.Context) error {
 		return err
 	}
 
-	if scope == "global" {
-		return fmt.Errorf("cannot specify global scope and a " +
-			"specific asset")
+	if scope != "local" {
+		return fmt.Errorf("cannot specify %s scope and a "+
+			"specific asset", scope)
 	}
 
 	return printLocalConfig()
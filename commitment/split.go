@@ -73,6 +73,9 @@ type SplitLocator struct {
 
 	// AssetVersion is the version that the asset split should use.
 	AssetVersion asset.Version
+
+	// ScriptVersion is the script version that the asset split should use.
+	ScriptVersion asset.ScriptVersion
 }
 
 // Hash computes the hash of a SplitLocator, encumbering its `OutputIndex`,
@@ -197,6 +200,7 @@ func NewSplitCommitment(ctx context.Context, inputs []SplitCommitmentInput,
 		assetSplit := inputs[0].Asset.Copy()
 		assetSplit.Amount = locator.Amount
 		assetSplit.Version = locator.AssetVersion
+		assetSplit.ScriptVersion = locator.ScriptVersion
 
 		scriptKey, err := btcec.ParsePubKey(locator.ScriptKey[:])
 		if err != nil {
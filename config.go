@@ -19,6 +19,7 @@ import (
 	"github.com/lightningnetwork/lnd"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/signal"
+	"github.com/lightningnetwork/lnd/tor"
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
 )
@@ -156,6 +157,11 @@ type Config struct {
 
 	Lnd *lndclient.LndServices
 
+	// LndPayments is used to send payments and create invoices through
+	// lnd with Taproot Asset specific custom records. It is only set if
+	// the channel features are enabled.
+	LndPayments *LndPaymentClient
+
 	SignalInterceptor signal.Interceptor
 
 	ReOrgWatcher *tapgarden.ReOrgWatcher
@@ -166,6 +172,10 @@ type Config struct {
 
 	ChainBridge tapgarden.ChainBridge
 
+	// WalletAnchor is the on-chain wallet that backs the anchor outputs of
+	// our assets.
+	WalletAnchor tapgarden.WalletAnchor
+
 	AddrBook *address.Book
 
 	// AddrBookDisableSyncer is a flag which, if true, will prevent the
@@ -177,6 +187,15 @@ type Config struct {
 
 	ProofArchive proof.Archiver
 
+	// ProofCheckpointVerifier is used to verify the first proof of proof
+	// files that don't start at the genesis of the asset. If this is nil,
+	// such proof files are rejected.
+	ProofCheckpointVerifier proof.CheckpointVerifier
+
+	// ProofHistoryFetcher is used to restore the history of pruned proof
+	// files. If this is nil, proof files can't be pruned.
+	ProofHistoryFetcher proof.HistoryFetcher
+
 	AssetWallet tapfreighter.Wallet
 
 	CoinSelect *tapfreighter.CoinSelect
@@ -189,6 +208,14 @@ type Config struct {
 
 	UniverseFederation *universe.FederationEnvoy
 
+	// UniverseCommitments is used to commit the multiverse roots on chain,
+	// and to couple universe proofs with those commitments.
+	UniverseCommitments *universe.ChainStamper
+
+	// WalletRecoverer is used to rediscover the assets owned by the keys
+	// derived from the backing wallet's seed after the database was lost.
+	WalletRecoverer *tapgarden.Recoverer
+
 	// UniFedSyncAllAssets is a flag that indicates whether the
 	// universe federation syncer should default to syncing all assets.
 	UniFedSyncAllAssets bool
@@ -225,6 +252,11 @@ type Config struct {
 	// limiting.
 	UniverseQueriesBurst int
 
+	// Proxy is an optional SOCKS5 proxy that all outgoing connections to
+	// remote universe servers are made through. If nil, direct
+	// connections are made.
+	Proxy *tor.ProxyNet
+
 	Prometheus monitoring.PrometheusConfig
 
 	// LogWriter is the root logger that all of the daemon's subloggers are
@@ -276,6 +276,37 @@ func testMultiAddress(t *harnessTest) {
 		ctxt, t, alice, bob, groupGenInfo, mintedGroupAsset, 1, 2,
 	)
 
+	// Bob now owns units of both assets, so he can send both of them back
+	// to Alice in a single transfer, anchored in a single transaction.
+	const multiAssetAmt = 50
+	aliceAssetAddr, err := alice.NewAddr(ctxt, &taprpc.NewAddrRequest{
+		AssetId: genInfo.AssetId,
+		Amt:     multiAssetAmt,
+	})
+	require.NoError(t.t, err)
+	aliceGroupAssetAddr, err := alice.NewAddr(ctxt, &taprpc.NewAddrRequest{
+		AssetId: groupGenInfo.AssetId,
+		Amt:     multiAssetAmt,
+	})
+	require.NoError(t.t, err)
+
+	multiAssetResp, err := bob.SendAsset(ctxt, &taprpc.SendAssetRequest{
+		TapAddrs: []string{
+			aliceAssetAddr.Encoded, aliceGroupAssetAddr.Encoded,
+		},
+	})
+	require.NoError(t.t, err)
+
+	// We expect one input for each asset, and a change and a recipient
+	// output for each of them.
+	multiAssetTransfer := multiAssetResp.Transfer
+	require.Len(t.t, multiAssetTransfer.Inputs, 2)
+	require.Len(t.t, multiAssetTransfer.Outputs, 4)
+
+	MineBlocks(t.t, t.lndHarness.Miner.Client, 1, 1)
+	AssertAddrEvent(t.t, alice, aliceAssetAddr, 1, statusCompleted)
+	AssertAddrEvent(t.t, alice, aliceGroupAssetAddr, 1, statusCompleted)
+
 	// If the second node tries to send assets to a set of addresses with
 	// mixed versions, that should fail early.
 	const sendAmt = 25
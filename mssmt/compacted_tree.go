@@ -392,3 +392,37 @@ func (t *CompactedTree) MerkleProof(ctx context.Context, key [hashSize]byte) (
 
 	return NewProof(proof), nil
 }
+
+// MerkleMultiProof generates a single merkle proof for the leaf nodes found at
+// the given keys within the MS-SMT. Like with MerkleProof, keys without a leaf
+// node are proven to be empty.
+func (t *CompactedTree) MerkleMultiProof(ctx context.Context,
+	keys [][hashSize]byte) (*MultiProof, error) {
+
+	proofs := make([]*Proof, len(keys))
+	err := t.store.View(ctx, func(tx TreeStoreViewTx) error {
+		for idx := range keys {
+			proof := make([]Node, MaxTreeLevels)
+			_, err := t.walkDown(
+				tx, &keys[idx],
+				func(i int, _, sibling, _ Node) error {
+					sibling.NodeHash()
+					proof[MaxTreeLevels-1-i] = sibling
+					return nil
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			proofs[idx] = NewProof(proof)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMultiProof(keys, proofs)
+}
@@ -0,0 +1,256 @@
+package mssmt
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	// MaxDiffNodes is the maximum number of nodes the children of which
+	// are fetched at once while computing the diff of two trees.
+	MaxDiffNodes = 1024
+)
+
+// DiffNode is a node of a tree that is compared against the node at the same
+// position of another tree. Besides the hash and sum of the node, it carries
+// the key of the leaf the subtree below the node consists of, if the node is a
+// compacted leaf.
+type DiffNode struct {
+	Node
+
+	// LeafKey is the key of the only leaf within the subtree rooted at
+	// this node. This is only set if the node is a compacted leaf.
+	LeafKey *[hashSize]byte
+}
+
+// NewDiffNode creates a new diff node from the given tree node.
+func NewDiffNode(node Node) DiffNode {
+	diffNode := DiffNode{
+		Node: NewComputedNode(node.NodeHash(), node.NodeSum()),
+	}
+
+	if leaf, ok := node.(*CompactedLeafNode); ok {
+		leafKey := leaf.Key()
+		diffNode.LeafKey = &leafKey
+	}
+
+	return diffNode
+}
+
+// isEmpty returns true if the node is the root of an empty subtree at the
+// given height.
+func (d DiffNode) isEmpty(height int) bool {
+	return d.NodeHash() == EmptyTree[height].NodeHash()
+}
+
+// DiffChildrenFetcher is a function that returns the left and right children
+// of the branch nodes with the given hashes, which are all located at the
+// given height of a tree.
+type DiffChildrenFetcher func(ctx context.Context, height int,
+	nodes []NodeHash) ([][2]DiffNode, error)
+
+// DiffChildren returns the left and right children of the branch nodes with
+// the given hashes, which are all located at the given height of the compacted
+// tree viewed by the passed transaction.
+func DiffChildren(tx TreeStoreViewTx, height int,
+	nodes []NodeHash) ([][2]DiffNode, error) {
+
+	if height < 0 || height > lastBitIndex {
+		return nil, fmt.Errorf("invalid branch height %d", height)
+	}
+	if len(nodes) > MaxDiffNodes {
+		return nil, fmt.Errorf("too many nodes requested: %d, max %d",
+			len(nodes), MaxDiffNodes)
+	}
+
+	children := make([][2]DiffNode, len(nodes))
+	for idx, nodeHash := range nodes {
+		left, right, err := tx.GetChildren(height, nodeHash)
+		if err != nil {
+			return nil, err
+		}
+
+		children[idx] = [2]DiffNode{
+			NewDiffNode(left), NewDiffNode(right),
+		}
+	}
+
+	return children, nil
+}
+
+// diffPair is a pair of nodes at the same position of the local and the remote
+// tree that differ.
+type diffPair struct {
+	// local is the local node. This is nil if the local subtree at this
+	// position isn't known, which is the case if there's no local tree or
+	// the local subtree is a compacted leaf.
+	local *DiffNode
+
+	// remote is the remote node, which is always a branch.
+	remote DiffNode
+}
+
+// DiffLeafKeys walks a local and a remote compacted tree top-down, level by
+// level, and returns the keys of the leaves of all remote subtrees that differ
+// from the local tree. Subtrees that are equal in both trees are skipped, so
+// only the differing parts of the trees need to be fetched. The returned keys
+// include all leaves that are missing in the local tree or have a different
+// value there, but might also include some leaves the local tree already has.
+//
+// The local root may be nil if there is no local tree yet. The children of
+// the nodes of both trees are fetched level by level, in batches of at most
+// MaxDiffNodes nodes.
+func DiffLeafKeys(ctx context.Context, localRoot, remoteRoot Node,
+	fetchLocal, fetchRemote DiffChildrenFetcher) ([][hashSize]byte, error) {
+
+	if remoteRoot == nil || NewDiffNode(remoteRoot).isEmpty(0) ||
+		IsEqualNode(localRoot, remoteRoot) {
+
+		return nil, nil
+	}
+
+	level := []diffPair{{
+		remote: NewDiffNode(remoteRoot),
+	}}
+	if localRoot != nil {
+		localNode := NewDiffNode(localRoot)
+		level[0].local = &localNode
+	}
+
+	var leafKeys [][hashSize]byte
+	for height := 0; len(level) > 0; height++ {
+		if height > lastBitIndex {
+			return nil, fmt.Errorf("remote tree contains a " +
+				"branch at leaf level")
+		}
+
+		var nextLevel []diffPair
+		for start := 0; start < len(level); start += MaxDiffNodes {
+			end := min(start+MaxDiffNodes, len(level))
+
+			nextPairs, keys, err := diffLevel(
+				ctx, height, level[start:end], fetchLocal,
+				fetchRemote,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			nextLevel = append(nextLevel, nextPairs...)
+			leafKeys = append(leafKeys, keys...)
+		}
+
+		level = nextLevel
+	}
+
+	return leafKeys, nil
+}
+
+// diffLevel fetches the children of the given pairs of differing nodes at the
+// given height and compares them. The pairs of differing branch children and
+// the keys of differing remote compacted leaves are returned.
+func diffLevel(ctx context.Context, height int, pairs []diffPair,
+	fetchLocal, fetchRemote DiffChildrenFetcher) ([]diffPair,
+	[][hashSize]byte, error) {
+
+	remoteHashes := make([]NodeHash, len(pairs))
+	for idx, pair := range pairs {
+		remoteHashes[idx] = pair.remote.NodeHash()
+	}
+
+	remoteChildren, err := fetchRemote(ctx, height, remoteHashes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to fetch remote nodes: %w",
+			err)
+	}
+	if len(remoteChildren) != len(pairs) {
+		return nil, nil, fmt.Errorf("expected children of %d remote "+
+			"nodes, got %d", len(pairs), len(remoteChildren))
+	}
+
+	// We only need to fetch the children of local nodes that are
+	// branches. The children of empty subtrees are empty, and the
+	// children of a compacted leaf can't be compared without extracting
+	// the leaf, so we treat them as unknown.
+	var (
+		localHashes  []NodeHash
+		localIndexes = make(map[int]int)
+	)
+	for idx, pair := range pairs {
+		if pair.local == nil || pair.local.LeafKey != nil ||
+			pair.local.isEmpty(height) {
+
+			continue
+		}
+
+		localIndexes[idx] = len(localHashes)
+		localHashes = append(localHashes, pair.local.NodeHash())
+	}
+
+	var localChildren [][2]DiffNode
+	if len(localHashes) > 0 {
+		localChildren, err = fetchLocal(ctx, height, localHashes)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to fetch local "+
+				"nodes: %w", err)
+		}
+		if len(localChildren) != len(localHashes) {
+			return nil, nil, fmt.Errorf("expected children of %d "+
+				"local nodes, got %d", len(localHashes),
+				len(localChildren))
+		}
+	}
+
+	var (
+		nextPairs []diffPair
+		leafKeys  [][hashSize]byte
+	)
+	for idx, pair := range pairs {
+		children := remoteChildren[idx]
+
+		// Make sure the remote children actually belong to the node
+		// we asked for, so the remote can't make us walk a different
+		// tree.
+		parent := NewBranch(children[0].Node, children[1].Node)
+		if !IsEqualNode(parent, pair.remote.Node) {
+			return nil, nil, fmt.Errorf("remote children don't "+
+				"match node %v at height %d",
+				pair.remote.NodeHash(), height)
+		}
+
+		for side, remoteChild := range children {
+			if remoteChild.isEmpty(height + 1) {
+				continue
+			}
+
+			var localChild *DiffNode
+			switch localIdx, ok := localIndexes[idx]; {
+			case ok:
+				localChild = &localChildren[localIdx][side]
+
+			case pair.local != nil && pair.local.isEmpty(height):
+				emptyChild := NewDiffNode(EmptyTree[height+1])
+				localChild = &emptyChild
+			}
+
+			if localChild != nil &&
+				IsEqualNode(localChild.Node, remoteChild.Node) {
+
+				continue
+			}
+
+			if remoteChild.LeafKey != nil {
+				leafKey := *remoteChild.LeafKey
+				leafKeys = append(leafKeys, leafKey)
+				continue
+			}
+
+			nextPairs = append(nextPairs, diffPair{
+				local:  localChild,
+				remote: remoteChild,
+			})
+		}
+	}
+
+	return nextPairs, leafKeys, nil
+}
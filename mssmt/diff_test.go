@@ -0,0 +1,147 @@
+package mssmt_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDiffLeafKeys asserts that walking a local and a remote tree returns the
+// keys of all remote leaves the local tree is missing, without walking the
+// subtrees that are equal in both trees.
+func TestDiffLeafKeys(t *testing.T) {
+	t.Parallel()
+
+	for storeName, makeStore := range genTestStores(t) {
+		t.Run(storeName, func(t *testing.T) {
+			testDiffLeafKeys(t, makeStore)
+		})
+	}
+}
+
+func testDiffLeafKeys(t *testing.T,
+	makeStore func() (mssmt.TreeStore, error)) {
+
+	ctx := context.Background()
+
+	localStore, err := makeStore()
+	require.NoError(t, err)
+	localTree := mssmt.NewCompactedTree(localStore)
+
+	remoteStore, err := makeStore()
+	require.NoError(t, err)
+	remoteTree := mssmt.NewCompactedTree(remoteStore)
+
+	// Both trees share most of their leaves. The remote tree has some
+	// leaves the local tree doesn't have, and one leaf with a different
+	// value. The local tree also has a leaf the remote doesn't know.
+	var (
+		shared    = randTree(500)
+		missing   = randTree(20)
+		changed   = shared[0]
+		localOnly = randTree(1)
+	)
+	for _, item := range shared {
+		_, err := remoteTree.Insert(ctx, item.key, item.leaf)
+		require.NoError(t, err)
+
+		leaf := item.leaf
+		if item.key == changed.key {
+			leaf = randLeaf()
+		}
+		_, err = localTree.Insert(ctx, item.key, leaf)
+		require.NoError(t, err)
+	}
+	for _, item := range missing {
+		_, err := remoteTree.Insert(ctx, item.key, item.leaf)
+		require.NoError(t, err)
+	}
+	_, err = localTree.Insert(ctx, localOnly[0].key, localOnly[0].leaf)
+	require.NoError(t, err)
+
+	localRoot, err := localTree.Root(ctx)
+	require.NoError(t, err)
+	remoteRoot, err := remoteTree.Root(ctx)
+	require.NoError(t, err)
+
+	// We count the number of nodes the children are fetched for, to make
+	// sure equal subtrees aren't walked.
+	var numFetched int
+	fetcher := func(store mssmt.TreeStore) mssmt.DiffChildrenFetcher {
+		return func(ctx context.Context, height int,
+			nodes []mssmt.NodeHash) ([][2]mssmt.DiffNode, error) {
+
+			numFetched += len(nodes)
+
+			var children [][2]mssmt.DiffNode
+			view := func(tx mssmt.TreeStoreViewTx) error {
+				var err error
+				children, err = mssmt.DiffChildren(
+					tx, height, nodes,
+				)
+				return err
+			}
+			err := store.View(ctx, view)
+
+			return children, err
+		}
+	}
+	fetchLocal := fetcher(localStore)
+	fetchRemote := fetcher(remoteStore)
+
+	leafKeys, err := mssmt.DiffLeafKeys(
+		ctx, localRoot, remoteRoot, fetchLocal, fetchRemote,
+	)
+	require.NoError(t, err)
+
+	// All missing and changed leaves must be found. Some shared leaves
+	// might be returned too, but never the one only the local tree has.
+	foundKeys := fn.NewSet(leafKeys...)
+	for _, item := range append(missing, changed) {
+		require.True(t, foundKeys.Contains(item.key))
+	}
+	require.False(t, foundKeys.Contains(localOnly[0].key))
+	require.Less(t, len(leafKeys), len(shared))
+	require.Less(t, numFetched, 2*len(shared))
+
+	// Without a local tree, all remote leaves are returned.
+	leafKeys, err = mssmt.DiffLeafKeys(
+		ctx, nil, remoteRoot, fetchLocal, fetchRemote,
+	)
+	require.NoError(t, err)
+	require.Len(t, leafKeys, len(shared)+len(missing))
+
+	// Equal trees don't need to be walked at all.
+	numFetched = 0
+	leafKeys, err = mssmt.DiffLeafKeys(
+		ctx, remoteRoot, remoteRoot, fetchLocal, fetchRemote,
+	)
+	require.NoError(t, err)
+	require.Empty(t, leafKeys)
+	require.Zero(t, numFetched)
+
+	// A remote that returns children that don't belong to the requested
+	// node is rejected.
+	badRemote := func(ctx context.Context, height int,
+		nodes []mssmt.NodeHash) ([][2]mssmt.DiffNode, error) {
+
+		children, err := fetchRemote(ctx, height, nodes)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range children {
+			children[i][0], children[i][1] = children[i][1],
+				children[i][0]
+		}
+
+		return children, nil
+	}
+	_, err = mssmt.DiffLeafKeys(
+		ctx, localRoot, remoteRoot, fetchLocal, badRemote,
+	)
+	require.ErrorContains(t, err, "remote children don't match")
+}
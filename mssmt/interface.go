@@ -30,4 +30,10 @@ type Tree interface {
 	// proof. This is noted by the returned `Proof` containing an empty
 	// leaf.
 	MerkleProof(ctx context.Context, key [hashSize]byte) (*Proof, error)
+
+	// MerkleMultiProof generates a single merkle proof for the leaf nodes
+	// found at the given keys within the MS-SMT. Like with MerkleProof,
+	// keys without a leaf node are proven to be empty.
+	MerkleMultiProof(ctx context.Context,
+		keys [][hashSize]byte) (*MultiProof, error)
 }
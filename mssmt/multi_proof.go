@@ -0,0 +1,418 @@
+package mssmt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+const (
+	// maxMultiProofKeys is the maximum number of keys a multi-proof can be
+	// decoded for. This prevents large allocations when decoding a
+	// multi-proof from an untrusted source.
+	maxMultiProofKeys = 1 << 16
+
+	// maxMultiProofSiblings is the maximum number of siblings a decoded
+	// multi-proof can contain, which is bounded by the number of keys
+	// times the number of levels in the tree.
+	maxMultiProofSiblings = maxMultiProofKeys * MaxTreeLevels
+)
+
+var (
+	// ErrInvalidMultiProof is returned when a multi-proof doesn't contain
+	// the expected number of sibling nodes for the given set of keys.
+	ErrInvalidMultiProof = errors.New("mssmt: invalid multi-proof")
+
+	// ErrDuplicateProofKey is returned when the same key is given more
+	// than once when creating or verifying a multi-proof.
+	ErrDuplicateProofKey = errors.New("mssmt: duplicate multi-proof key")
+)
+
+// MultiProof represents a batched merkle proof for a set of leaves of a
+// MS-SMT. Instead of including all 256 siblings for each of the leaves like
+// individual proofs would, a multi-proof only includes the siblings that can't
+// be computed from the proven leaves themselves. Like in a CompressedProof,
+// siblings that are part of the empty tree are replaced by a bit vector.
+//
+// The siblings are ordered by walking up the tree level by level, starting at
+// the leaves. Within a level, the siblings are ordered by the byte order of the
+// smallest key below them.
+type MultiProof struct {
+	// Bits determines, for each sibling that needs to be provided, whether
+	// it is part of the empty tree.
+	Bits []bool
+
+	// Nodes represents the non-empty siblings that should be hashed with
+	// the leaves and their parents to arrive at the root of the MS-SMT.
+	Nodes []Node
+}
+
+// proofPath is the path from the root down to a node of the tree, which is the
+// node's key with all bits below its height cleared.
+type proofPath = [hashSize]byte
+
+// multiProofEntry is a node on the path of at least one of the proven keys.
+type multiProofEntry struct {
+	// path is the path of the node.
+	path proofPath
+
+	// keyIdx is the index of the smallest key below the node.
+	keyIdx int
+
+	// node is the node itself. This is only known when verifying a proof.
+	node Node
+}
+
+// siblingPath returns the path of the sibling of the node at the given path,
+// which is found at the given height.
+func siblingPath(path proofPath, height int) proofPath {
+	path[height/8] ^= 1 << (height % 8)
+	return path
+}
+
+// parentPath returns the path of the parent of the node at the given path,
+// which is found at the given height.
+func parentPath(path proofPath, height int) proofPath {
+	path[height/8] &^= 1 << (height % 8)
+	return path
+}
+
+// sortedKeyIndexes returns the indexes of the given keys in the byte order of
+// the keys. An error is returned if a key is given more than once.
+func sortedKeyIndexes(keys [][hashSize]byte) ([]int, error) {
+	indexes := make([]int, len(keys))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.Slice(indexes, func(i, j int) bool {
+		return bytes.Compare(
+			keys[indexes[i]][:], keys[indexes[j]][:],
+		) < 0
+	})
+
+	for i := 1; i < len(indexes); i++ {
+		if keys[indexes[i-1]] == keys[indexes[i]] {
+			return nil, fmt.Errorf("%w: %x", ErrDuplicateProofKey,
+				keys[indexes[i]])
+		}
+	}
+
+	return indexes, nil
+}
+
+// walkMultiProof walks up the tree from the nodes at the given paths up to the
+// root, one level at a time. For each node whose sibling isn't also on the
+// path of one of the keys, the sibling callback is invoked to obtain the
+// sibling. If the nodes of the given entries are set, then the parent nodes are
+// computed along the way and the root is returned.
+func walkMultiProof(entries []*multiProofEntry,
+	sibling func(height int, entry *multiProofEntry) (Node, error)) (Node,
+	error) {
+
+	for height := lastBitIndex; height >= 0; height-- {
+		levelNodes := make(map[proofPath]*multiProofEntry, len(entries))
+		for _, entry := range entries {
+			levelNodes[entry.path] = entry
+		}
+
+		var (
+			parents     = make([]*multiProofEntry, 0, len(entries))
+			parentNodes = make(map[proofPath]struct{}, len(entries))
+		)
+		for _, entry := range entries {
+			parent := parentPath(entry.path, height)
+
+			// If the sibling is on the path of another key, we'll
+			// only create the parent once, when visiting the first
+			// of the two nodes.
+			sibPath := siblingPath(entry.path, height)
+			siblingEntry, siblingKnown := levelNodes[sibPath]
+			if _, ok := parentNodes[parent]; ok {
+				continue
+			}
+
+			var siblingNode Node
+			if siblingKnown {
+				siblingNode = siblingEntry.node
+			} else {
+				var err error
+				siblingNode, err = sibling(height, entry)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			var parentNode Node
+			if entry.node != nil {
+				left, right := stepOrder(
+					height, &entry.path, entry.node,
+					siblingNode,
+				)
+				parentNode = NewBranch(left, right)
+			}
+
+			parentNodes[parent] = struct{}{}
+			parents = append(parents, &multiProofEntry{
+				path:   parent,
+				keyIdx: entry.keyIdx,
+				node:   parentNode,
+			})
+		}
+
+		entries = parents
+	}
+
+	if len(entries) != 1 {
+		return nil, fmt.Errorf("%w: expected single root, got %d",
+			ErrInvalidMultiProof, len(entries))
+	}
+
+	return entries[0].node, nil
+}
+
+// NewMultiProof creates a multi-proof for the given keys from the individual
+// merkle proofs of each key. The proofs must all be obtained from the same
+// tree, and are expected in the same order as the keys.
+func NewMultiProof(keys [][hashSize]byte, proofs []*Proof) (*MultiProof,
+	error) {
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: no keys given",
+			ErrInvalidMultiProof)
+	}
+	if len(keys) > maxMultiProofKeys {
+		return nil, fmt.Errorf("%w: too many keys (%d)",
+			ErrInvalidMultiProof, len(keys))
+	}
+	if len(keys) != len(proofs) {
+		return nil, fmt.Errorf("%w: got %d keys but %d proofs",
+			ErrInvalidMultiProof, len(keys), len(proofs))
+	}
+
+	indexes, err := sortedKeyIndexes(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*multiProofEntry, len(indexes))
+	for i, keyIdx := range indexes {
+		if len(proofs[keyIdx].Nodes) != MaxTreeLevels {
+			return nil, fmt.Errorf("%w: proof for key %x has %d "+
+				"nodes", ErrInvalidMultiProof, keys[keyIdx],
+				len(proofs[keyIdx].Nodes))
+		}
+
+		entries[i] = &multiProofEntry{
+			path:   keys[keyIdx],
+			keyIdx: keyIdx,
+		}
+	}
+
+	var multiProof MultiProof
+	_, err = walkMultiProof(
+		entries, func(height int, entry *multiProofEntry) (Node,
+			error) {
+
+			// The proof nodes start at the leaf, while the
+			// EmptyTree starts at the root.
+			node := proofs[entry.keyIdx].Nodes[lastBitIndex-height]
+			isEmpty := node.NodeHash() ==
+				EmptyTree[height+1].NodeHash()
+
+			multiProof.Bits = append(multiProof.Bits, isEmpty)
+			if !isEmpty {
+				multiProof.Nodes = append(
+					multiProof.Nodes, node.Copy(),
+				)
+			}
+
+			return node, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &multiProof, nil
+}
+
+// Root returns the root node obtained by walking up the tree from the given
+// leaves at the given keys. The leaves are expected in the same order as the
+// keys, but the order of the keys doesn't need to match the order used when
+// creating the proof.
+func (p *MultiProof) Root(keys [][hashSize]byte,
+	leaves []*LeafNode) (*BranchNode, error) {
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: no keys given",
+			ErrInvalidMultiProof)
+	}
+	if len(keys) != len(leaves) {
+		return nil, fmt.Errorf("%w: got %d keys but %d leaves",
+			ErrInvalidMultiProof, len(keys), len(leaves))
+	}
+
+	indexes, err := sortedKeyIndexes(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*multiProofEntry, len(indexes))
+	for i, keyIdx := range indexes {
+		entries[i] = &multiProofEntry{
+			path:   keys[keyIdx],
+			keyIdx: keyIdx,
+			node:   leaves[keyIdx],
+		}
+	}
+
+	var nextBitIdx, nextNodeIdx int
+	root, err := walkMultiProof(
+		entries, func(height int, _ *multiProofEntry) (Node, error) {
+			if nextBitIdx >= len(p.Bits) {
+				return nil, fmt.Errorf("%w: missing siblings",
+					ErrInvalidMultiProof)
+			}
+
+			isEmpty := p.Bits[nextBitIdx]
+			nextBitIdx++
+
+			if isEmpty {
+				return EmptyTree[height+1], nil
+			}
+
+			if nextNodeIdx >= len(p.Nodes) {
+				return nil, fmt.Errorf("%w: missing nodes",
+					ErrInvalidMultiProof)
+			}
+
+			node := p.Nodes[nextNodeIdx]
+			nextNodeIdx++
+
+			return node, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// A valid proof contains exactly the siblings we need, so any
+	// left-over bits or nodes render the proof invalid.
+	if nextBitIdx != len(p.Bits) || nextNodeIdx != len(p.Nodes) {
+		return nil, fmt.Errorf("%w: num_bits=%d, num_nodes=%d, "+
+			"used_bits=%d, used_nodes=%d", ErrInvalidMultiProof,
+			len(p.Bits), len(p.Nodes), nextBitIdx, nextNodeIdx)
+	}
+
+	return root.(*BranchNode), nil
+}
+
+// Copy returns a deep copy of the multi-proof.
+func (p *MultiProof) Copy() *MultiProof {
+	bitsCopy := make([]bool, len(p.Bits))
+	copy(bitsCopy, p.Bits)
+
+	nodesCopy := make([]Node, len(p.Nodes))
+	for idx := range p.Nodes {
+		nodesCopy[idx] = p.Nodes[idx].Copy()
+	}
+
+	return &MultiProof{
+		Bits:  bitsCopy,
+		Nodes: nodesCopy,
+	}
+}
+
+// Encode encodes the multi-proof into the provided Writer.
+func (p *MultiProof) Encode(w io.Writer) error {
+	err := binary.Write(w, byteOrder, uint32(len(p.Bits)))
+	if err != nil {
+		return err
+	}
+
+	bitsBytes := PackBits(p.Bits)
+	if _, err := w.Write(bitsBytes); err != nil {
+		return err
+	}
+
+	err = binary.Write(w, byteOrder, uint32(len(p.Nodes)))
+	if err != nil {
+		return err
+	}
+	for _, node := range p.Nodes {
+		key := node.NodeHash()
+		if _, err := w.Write(key[:]); err != nil {
+			return err
+		}
+		err := binary.Write(w, byteOrder, node.NodeSum())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Decode decodes the multi-proof encoded within Reader.
+func (p *MultiProof) Decode(r io.Reader) error {
+	var numBits uint32
+	if err := binary.Read(r, byteOrder, &numBits); err != nil {
+		return err
+	}
+	if numBits > maxMultiProofSiblings {
+		return fmt.Errorf("%w: too many siblings (%d)",
+			ErrInvalidMultiProof, numBits)
+	}
+
+	bitsBytes := make([]byte, (numBits+8-1)/8)
+	if _, err := io.ReadFull(r, bitsBytes); err != nil {
+		return err
+	}
+	bits := UnpackBits(bitsBytes)[:numBits]
+
+	var numNodes uint32
+	if err := binary.Read(r, byteOrder, &numNodes); err != nil {
+		return err
+	}
+	if numNodes > numBits {
+		return fmt.Errorf("%w: more nodes (%d) than siblings (%d)",
+			ErrInvalidMultiProof, numNodes, numBits)
+	}
+
+	nodes := make([]Node, 0, numNodes)
+	for i := uint32(0); i < numNodes; i++ {
+		var keyBytes [sha256.Size]byte
+		if _, err := io.ReadFull(r, keyBytes[:]); err != nil {
+			return err
+		}
+		var sum uint64
+		if err := binary.Read(r, byteOrder, &sum); err != nil {
+			return err
+		}
+		nodes = append(nodes, NewComputedNode(NodeHash(keyBytes), sum))
+	}
+
+	*p = MultiProof{
+		Bits:  bits,
+		Nodes: nodes,
+	}
+	return nil
+}
+
+// VerifyMultiProof determines whether a multi-proof for the given leaves found
+// at the given keys is valid.
+func VerifyMultiProof(keys [][hashSize]byte, leaves []*LeafNode,
+	proof *MultiProof, root Node) bool {
+
+	proofRoot, err := proof.Root(keys, leaves)
+	if err != nil {
+		return false
+	}
+
+	return IsEqualNode(proofRoot, root)
+}
@@ -10,6 +10,7 @@ import (
 	"math"
 	"math/rand"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"testing"
 
@@ -804,6 +805,108 @@ func TestMerkleProof(t *testing.T) {
 	}
 }
 
+// TestMerkleMultiProof asserts that multi-proofs for a set of leaf nodes are
+// identical for both tree types, survive an encoding round trip, and verify
+// properly independent of the order of the keys.
+func TestMerkleMultiProof(t *testing.T) {
+	t.Parallel()
+
+	for storeName, makeStore := range genTestStores(t) {
+		t.Run(storeName, func(t *testing.T) {
+			store1, err := makeStore()
+			require.NoError(t, err)
+			tree := mssmt.NewFullTree(store1)
+
+			store2, err := makeStore()
+			require.NoError(t, err)
+			smolTree := mssmt.NewCompactedTree(store2)
+
+			leaves := randTree(100)
+			ctx := context.TODO()
+			for _, item := range leaves {
+				_, err := tree.Insert(ctx, item.key, item.leaf)
+				require.NoError(t, err)
+				_, err = smolTree.Insert(
+					ctx, item.key, item.leaf,
+				)
+				require.NoError(t, err)
+			}
+
+			root, err := tree.Root(ctx)
+			require.NoError(t, err)
+
+			// We'll prove a subset of the leaves, plus a key that
+			// isn't in the tree.
+			var (
+				keys       [][hashSize]byte
+				leafNodes  []*mssmt.LeafNode
+				emptyKey   = test.RandHash()
+				numToProve = 20
+			)
+			for _, item := range leaves[:numToProve] {
+				keys = append(keys, item.key)
+				leafNodes = append(leafNodes, item.leaf)
+			}
+			keys = append(keys, emptyKey)
+			leafNodes = append(leafNodes, mssmt.EmptyLeafNode)
+
+			proof, err := tree.MerkleMultiProof(ctx, keys)
+			require.NoError(t, err)
+			smolProof, err := smolTree.MerkleMultiProof(ctx, keys)
+			require.NoError(t, err)
+			require.Equal(t, proof.Bits, smolProof.Bits)
+			require.Len(t, smolProof.Nodes, len(proof.Nodes))
+			for i := range proof.Nodes {
+				require.True(t, mssmt.IsEqualNode(
+					proof.Nodes[i], smolProof.Nodes[i],
+				))
+			}
+
+			require.True(t, mssmt.VerifyMultiProof(
+				keys, leafNodes, proof, root,
+			))
+
+			// The proof should also be valid when given the keys
+			// in a different order.
+			reversedKeys := slices.Clone(keys)
+			slices.Reverse(reversedKeys)
+			reversedLeaves := slices.Clone(leafNodes)
+			slices.Reverse(reversedLeaves)
+			require.True(t, mssmt.VerifyMultiProof(
+				reversedKeys, reversedLeaves, proof, root,
+			))
+
+			// Encoding and decoding the proof should result in an
+			// identical proof.
+			var buf bytes.Buffer
+			require.NoError(t, proof.Encode(&buf))
+
+			decodedProof := &mssmt.MultiProof{}
+			require.NoError(t, decodedProof.Decode(&buf))
+			require.True(t, mssmt.VerifyMultiProof(
+				keys, leafNodes, decodedProof, root,
+			))
+
+			// Leaving out a key or modifying a leaf should result
+			// in an invalid proof.
+			require.False(t, mssmt.VerifyMultiProof(
+				keys[1:], leafNodes[1:], proof, root,
+			))
+
+			leafNodes[0] = randLeaf()
+			require.False(t, mssmt.VerifyMultiProof(
+				keys, leafNodes, proof, root,
+			))
+
+			// Duplicate keys aren't allowed.
+			_, err = tree.MerkleMultiProof(
+				ctx, append(keys, keys[0]),
+			)
+			require.ErrorIs(t, err, mssmt.ErrDuplicateProofKey)
+		})
+	}
+}
+
 // TestBIPTestVectors tests that the BIP test vectors are passing.
 func TestBIPTestVectors(t *testing.T) {
 	t.Parallel()
@@ -68,6 +68,10 @@ var (
 			Entity: "proofs",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/PruneProof": {{
+			Entity: "proofs",
+			Action: "write",
+		}},
 		"/taprpc.TaprootAssets/SendAsset": {{
 			Entity: "assets",
 			Action: "write",
@@ -76,6 +80,10 @@ var (
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/taprpc.TaprootAssets/BumpTransferFee": {{
+			Entity: "assets",
+			Action: "write",
+		}},
 		"/taprpc.TaprootAssets/FetchAssetMeta": {{
 			Entity: "assets",
 			Action: "read",
@@ -140,6 +148,58 @@ var (
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/assetwalletrpc.AssetWallet/ConsolidateAssets": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/ExportWallet": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/assetwalletrpc.AssetWallet/ImportWallet": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/ProposeSwap": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/AcceptSwap": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/SignSwap": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/PublishSwap": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/CancelSwap": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/RefundHtlc": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/CombineVirtualPsbts": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/assetwalletrpc.AssetWallet/VirtualPsbtStatus": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/assetwalletrpc.AssetWallet/NewMuSig2ScriptKey": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/ImportScriptKeyDescriptor": {{
+			Entity: "assets",
+			Action: "write",
+		}},
 		"/mintrpc.Mint/MintAsset": {{
 			Entity: "mint",
 			Action: "write",
@@ -160,6 +220,10 @@ var (
 			Entity: "mint",
 			Action: "write",
 		}},
+		"/mintrpc.Mint/BumpBatchFee": {{
+			Entity: "mint",
+			Action: "write",
+		}},
 		"/mintrpc.Mint/ListBatches": {{
 			Entity: "mint",
 			Action: "read",
@@ -196,6 +260,10 @@ var (
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/QueryMultiProof": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/InsertProof": {{
 			Entity: "universe",
 			Action: "write",
@@ -208,6 +276,10 @@ var (
 			Entity: "universe",
 			Action: "write",
 		}},
+		"/universerpc.Universe/SyncDiff": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/ListFederationServers": {{
 			Entity: "universe",
 			Action: "read",
@@ -260,6 +332,14 @@ var (
 			Entity: "rfq",
 			Action: "read",
 		}},
+		"/rfqrpc.Rfq/QueryRateTicks": {{
+			Entity: "rfq",
+			Action: "read",
+		}},
+		"/rfqrpc.Rfq/QueryQuoteHistory": {{
+			Entity: "rfq",
+			Action: "read",
+		}},
 		"/rfqrpc.Rfq/SubscribeRfqEventNtfns": {{
 			Entity: "rfq",
 			Action: "write",
@@ -272,6 +352,14 @@ var (
 			// This RPC is completely stateless and doesn't require
 			// any permissions to use.
 		},
+		"/tapchannelrpc.TaprootAssetChannels/SendPayment": {{
+			Entity: "channels",
+			Action: "write",
+		}},
+		"/tapchannelrpc.TaprootAssetChannels/AddInvoice": {{
+			Entity: "channels",
+			Action: "write",
+		}},
 		"/tapdevrpc.TapDev/ImportProof": {{
 			Entity: "proofs",
 			Action: "write",
@@ -318,6 +406,13 @@ func MacaroonWhitelist(allowUniPublicAccessRead bool,
 		whitelist["/universerpc.Universe/QueryProof"] = struct{}{}
 	}
 
+	// The batched proof query and the tree diff are only public if
+	// universe reads are.
+	if allowUniPublicAccessRead {
+		whitelist["/universerpc.Universe/QueryMultiProof"] = struct{}{}
+		whitelist["/universerpc.Universe/SyncDiff"] = struct{}{}
+	}
+
 	// Conditionally whitelist universe server write methods.
 	if allowUniPublicAccessWrite || allowPublicUniProofCourier {
 		whitelist["/universerpc.Universe/InsertProof"] = struct{}{}
@@ -1117,3 +1117,194 @@ func ReplaceProofInBlob(ctx context.Context, p *Proof, archive Archiver,
 
 	return nil
 }
+
+// HistoryFetcher is a function that fetches a single proof identified by the
+// given locator from a trusted source, such as a universe. It is used to
+// restore the history of a pruned proof file.
+type HistoryFetcher func(ctx context.Context, loc Locator) (Blob, error)
+
+// RestorePrunedFile restores the full history of the given proof file if it
+// was pruned, by fetching the proofs before its first proof with the given
+// history fetcher. If the file wasn't pruned, it is returned as is.
+func RestorePrunedFile(ctx context.Context, f *File,
+	historyFetcher HistoryFetcher) (*File, error) {
+
+	pruned, err := f.IsPruned()
+	if err != nil {
+		return nil, err
+	}
+
+	if !pruned {
+		return f, nil
+	}
+
+	firstProof, err := f.ProofAt(0)
+	if err != nil {
+		return nil, err
+	}
+
+	// The history of the file ends at the principal input of its first
+	// proof, so that's where we start fetching backwards from.
+	prevID, err := firstProof.Asset.PrimaryPrevID()
+	if err != nil {
+		return nil, err
+	}
+
+	scriptKey, err := btcec.ParsePubKey(prevID.ScriptKey[:])
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse script key: %w", err)
+	}
+
+	prevLocator := Locator{
+		AssetID:   &prevID.ID,
+		ScriptKey: *scriptKey,
+		OutPoint:  &prevID.OutPoint,
+	}
+	if firstProof.Asset.GroupKey != nil {
+		prevLocator.GroupKey = &firstProof.Asset.GroupKey.GroupPubKey
+	}
+
+	fullFile, err := FetchProofProvenance(
+		ctx, nil, prevLocator, historyFetcher,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch pruned proof "+
+			"history: %w", err)
+	}
+
+	for idx := 0; idx < f.NumProofs(); idx++ {
+		rawProof, err := f.RawProofAt(uint32(idx))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := fullFile.AppendProofRaw(rawProof); err != nil {
+			return nil, fmt.Errorf("unable to append proof: %w",
+				err)
+		}
+	}
+
+	return fullFile, nil
+}
+
+// PruneProofFile prunes the history of the proof file identified by the given
+// locator, so the file starts at the latest proof that is accepted as a
+// checkpoint by the given checkpoint verifier. The pruned file replaces the
+// full file in the archive, which must therefore be able to verify proof files
+// from a checkpoint. Before the full file is replaced, the pruned history must
+// be restorable with the given history fetcher, so the full file can be
+// re-created whenever it is needed, for example to transfer the asset. The
+// number of pruned proofs is returned, which is zero if none of the proofs of
+// the file is accepted as a checkpoint.
+func PruneProofFile(ctx context.Context, loc Locator, archive Archiver,
+	checkpointVerifier CheckpointVerifier, historyFetcher HistoryFetcher,
+	headerVerifier HeaderVerifier, merkleVerifier MerkleVerifier,
+	groupVerifier GroupVerifier,
+	chainLookupGen ChainLookupGenerator) (uint32, error) {
+
+	blob, err := archive.FetchProof(ctx, loc)
+	if err != nil {
+		return 0, fmt.Errorf("unable to fetch proof: %w", err)
+	}
+
+	f, err := blob.AsFile()
+	if err != nil {
+		return 0, fmt.Errorf("unable to decode proof file: %w", err)
+	}
+
+	// We encode the full file again, so we can compare it against the
+	// restored file below, independent of how the blob was encoded.
+	var fullBuf bytes.Buffer
+	if err := f.Encode(&fullBuf); err != nil {
+		return 0, fmt.Errorf("unable to encode proof file: %w", err)
+	}
+
+	lastProof, err := f.LastProof()
+	if err != nil {
+		return 0, fmt.Errorf("unable to fetch last proof: %w", err)
+	}
+
+	// We walk the file backwards, so we prune as much of the history as
+	// possible. The first proof of the file doesn't need to be checked, as
+	// there is nothing before it to prune.
+	var checkpointIdx uint32
+	for idx := f.NumProofs() - 1; idx > 0; idx-- {
+		p, err := f.ProofAt(uint32(idx))
+		if err != nil {
+			return 0, err
+		}
+
+		err = checkpointVerifier(ctx, p)
+		if err != nil {
+			log.Tracef("Proof at index %d isn't a checkpoint: %v",
+				idx, err)
+			continue
+		}
+
+		checkpointIdx = uint32(idx)
+		break
+	}
+
+	if checkpointIdx == 0 {
+		log.Debugf("No checkpoint found in proof file with %d proofs",
+			f.NumProofs())
+
+		return 0, nil
+	}
+
+	if err := f.PruneBefore(checkpointIdx); err != nil {
+		return 0, fmt.Errorf("unable to prune proof file: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := f.Encode(&buf); err != nil {
+		return 0, fmt.Errorf("unable to encode pruned proof: %w", err)
+	}
+
+	// Once the full file is replaced, the pruned history can only be
+	// restored from the history fetcher. So we make sure it can be before
+	// we throw anything away.
+	restoredFile, err := RestorePrunedFile(ctx, f, historyFetcher)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrPrunedHistoryUnavailable, err)
+	}
+
+	var restoredBuf bytes.Buffer
+	if err := restoredFile.Encode(&restoredBuf); err != nil {
+		return 0, fmt.Errorf("unable to encode restored proof: %w", err)
+	}
+
+	if !bytes.Equal(restoredBuf.Bytes(), fullBuf.Bytes()) {
+		return 0, fmt.Errorf("%w: restored proof file doesn't match "+
+			"full proof file", ErrPrunedHistoryUnavailable)
+	}
+
+	// We replace the full file with the pruned one, using the full locator
+	// of the last proof, so the file is stored in the same place.
+	lastAsset := lastProof.Asset
+	prunedProof := &AnnotatedProof{
+		Locator: Locator{
+			AssetID:   fn.Ptr(lastAsset.ID()),
+			ScriptKey: *lastAsset.ScriptKey.PubKey,
+			OutPoint:  fn.Ptr(lastProof.OutPoint()),
+		},
+		Blob: buf.Bytes(),
+	}
+	if lastAsset.GroupKey != nil {
+		prunedProof.GroupKey = &lastAsset.GroupKey.GroupPubKey
+	}
+
+	err = archive.ImportProofs(
+		ctx, headerVerifier, merkleVerifier, groupVerifier,
+		chainLookupGen, true, prunedProof,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("unable to import pruned proof: %w", err)
+	}
+
+	log.Infof("Pruned %d proofs from proof file of asset %v "+
+		"(script_key=%x)", checkpointIdx, lastAsset.ID(),
+		lastAsset.ScriptKey.PubKey.SerializeCompressed())
+
+	return checkpointIdx, nil
+}
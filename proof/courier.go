@@ -5,18 +5,28 @@ import (
 	"context"
 	"crypto/sha512"
 	"crypto/tls"
+	"encoding/hex"
 	"fmt"
+	"math"
+	"net"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/lightning-node-connect/hashmailrpc"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/taprpc"
 	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"github.com/lightningnetwork/lnd/tor"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
@@ -39,6 +49,20 @@ const (
 	// UniverseRpcCourierType is a courier that uses the daemon universe RPC
 	// endpoints to deliver proofs.
 	UniverseRpcCourierType = "universerpc"
+
+	// FileCourierType is a courier that writes proofs to and reads them
+	// from a local directory, which can be carried across an air gap.
+	FileCourierType = "file"
+)
+
+const (
+	// listProofsPageSize is the number of universe leaf keys that are
+	// requested at once when listing the proofs for a script key.
+	listProofsPageSize = 512
+
+	// defaultProxyDialTimeout is the timeout for establishing a connection
+	// through a proxy if the dial context doesn't specify a deadline.
+	defaultProxyDialTimeout = 30 * time.Second
 )
 
 // CourierHarness interface is an integration testing harness for a proof
@@ -72,6 +96,35 @@ type Courier interface {
 	Close() error
 }
 
+// ProofLister is an optional interface a Courier can implement if it is able
+// to list the proofs it holds for a given asset and script key. This is used
+// to discover payments to reusable addresses, which can't be detected on-chain
+// if the address doesn't specify an amount.
+type ProofLister interface {
+	// ListProofs returns the locators of the proofs the courier holds for
+	// the asset and script key of the given locator. The outpoint of the
+	// given locator is ignored, each returned locator has its outpoint
+	// set. Only proofs the courier received after the given cursor was
+	// returned are listed, a zero cursor lists all proofs. The returned
+	// cursor can be passed to the next call to only list new proofs.
+	// Couriers that can't list proofs incrementally always list all
+	// proofs and return a zero cursor.
+	ListProofs(context.Context, Locator, uint64) ([]Locator, uint64,
+		error)
+}
+
+// CanListProofs returns true if the courier with the given address implements
+// the ProofLister interface.
+func CanListProofs(addr *url.URL) bool {
+	switch addr.Scheme {
+	case UniverseRpcCourierType, FileCourierType:
+		return true
+
+	default:
+		return false
+	}
+}
+
 // CourierCfg contains general config parameters applicable to all proof
 // couriers.
 type CourierCfg struct {
@@ -82,6 +135,9 @@ type CourierCfg struct {
 	// parameters.
 	UniverseRpcCfg *UniverseRpcCourierCfg
 
+	// FileCfg contains file courier specific config parameters.
+	FileCfg *FileCourierCfg
+
 	// TransferLog is a log for recording proof delivery and retrieval
 	// attempts.
 	TransferLog TransferLog
@@ -89,6 +145,11 @@ type CourierCfg struct {
 	// LocalArchive is an archive that can be used to fetch proofs from the
 	// local archive.
 	LocalArchive Archiver
+
+	// Proxy is an optional SOCKS5 proxy that all connections to proof
+	// courier services are made through. If nil, direct connections are
+	// made.
+	Proxy *tor.ProxyNet
 }
 
 // CourierDispatch is an interface that abstracts away the different proof
@@ -127,7 +188,7 @@ func (u *URLDispatch) NewCourier(addr *url.URL,
 			cfg.BackoffCfg, u.cfg.TransferLog,
 		)
 
-		hashMailBox, err := NewHashMailBox(addr)
+		hashMailBox, err := NewHashMailBox(addr, u.cfg.Proxy)
 		if err != nil {
 			return nil, fmt.Errorf("unable to make mailbox: %w",
 				err)
@@ -148,12 +209,12 @@ func (u *URLDispatch) NewCourier(addr *url.URL,
 		)
 
 		// Connect to the universe RPC server.
-		dialOpts, err := serverDialOpts()
+		dialOpts, err := serverDialOpts(u.cfg.Proxy)
 		if err != nil {
 			return nil, err
 		}
 
-		serverAddr := fmt.Sprintf("%s:%s", addr.Hostname(), addr.Port())
+		serverAddr := net.JoinHostPort(addr.Hostname(), addr.Port())
 		conn, err := grpc.Dial(serverAddr, dialOpts...)
 		if err != nil {
 			return nil, err
@@ -170,6 +231,25 @@ func (u *URLDispatch) NewCourier(addr *url.URL,
 			rawConn:       conn,
 		}, nil
 
+	case FileCourierType:
+		cfg := u.cfg.FileCfg
+		dir, err := fileCourierDir(cfg.BaseDir, addr.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		backoffHandler := NewBackoffHandler(
+			cfg.BackoffCfg, u.cfg.TransferLog,
+		)
+
+		return &FileCourier{
+			recipient:     recipient,
+			dir:           dir,
+			cfg:           u.cfg,
+			backoffHandle: backoffHandler,
+			subscribers:   subscribers,
+		}, nil
+
 	default:
 		return nil, fmt.Errorf("unknown courier address protocol "+
 			"(consider updating tapd): %v", addr.Scheme)
@@ -200,6 +280,18 @@ func ParseCourierAddress(addr string) (*url.URL, error) {
 // ValidateCourierAddress validates that all required fields are present and
 // ensures the protocol is one of the supported protocols.
 func ValidateCourierAddress(addr *url.URL) error {
+	// The file courier doesn't connect to a service, but it needs to know
+	// which directory to use.
+	if addr.Scheme == FileCourierType {
+		if addr.Host != "" || !filepath.IsAbs(addr.Path) {
+			return fmt.Errorf("file proof courier URI address "+
+				"must be of the form %v:///<absolute path>",
+				FileCourierType)
+		}
+
+		return nil
+	}
+
 	// We expect the port number to be specified.
 	if addr.Port() == "" {
 		return fmt.Errorf("proof courier URI address port unspecified")
@@ -251,9 +343,25 @@ type HashMailBox struct {
 	client hashmailrpc.HashMailClient
 }
 
+// ProxyDialOption returns a gRPC dial option that makes all connections
+// through the given SOCKS5 proxy. The target host name is resolved by the
+// proxy, which means .onion addresses can be dialed as well.
+func ProxyDialOption(proxy *tor.ProxyNet) grpc.DialOption {
+	return grpc.WithContextDialer(
+		func(ctx context.Context, addr string) (net.Conn, error) {
+			timeout := defaultProxyDialTimeout
+			if deadline, ok := ctx.Deadline(); ok {
+				timeout = time.Until(deadline)
+			}
+
+			return proxy.Dial("tcp", addr, timeout)
+		},
+	)
+}
+
 // serverDialOpts returns the set of server options needed to connect to the
-// server using a TLS connection.
-func serverDialOpts() ([]grpc.DialOption, error) {
+// server using a TLS connection, optionally through the given proxy.
+func serverDialOpts(proxy *tor.ProxyNet) ([]grpc.DialOption, error) {
 	var opts []grpc.DialOption
 
 	// Skip TLS certificate verification.
@@ -261,15 +369,16 @@ func serverDialOpts() ([]grpc.DialOption, error) {
 	transportCredentials := credentials.NewTLS(&tlsConfig)
 	opts = append(opts, grpc.WithTransportCredentials(transportCredentials))
 
+	if proxy != nil {
+		opts = append(opts, ProxyDialOption(proxy))
+	}
+
 	return opts, nil
 }
 
 // NewHashMailBox makes a new mailbox by dialing to the server specified by the
-// address above.
-//
-// NOTE: The TLS certificate path argument (tlsCertPath) is optional. If unset,
-// then the system's TLS trust store is used.
-func NewHashMailBox(courierAddr *url.URL) (*HashMailBox,
+// address above. If a proxy is given, the connection is made through it.
+func NewHashMailBox(courierAddr *url.URL, proxy *tor.ProxyNet) (*HashMailBox,
 	error) {
 
 	if courierAddr.Scheme != HashmailCourierType {
@@ -277,13 +386,13 @@ func NewHashMailBox(courierAddr *url.URL) (*HashMailBox,
 			courierAddr.Scheme)
 	}
 
-	dialOpts, err := serverDialOpts()
+	dialOpts, err := serverDialOpts(proxy)
 	if err != nil {
 		return nil, err
 	}
 
-	serverAddr := fmt.Sprintf(
-		"%s:%s", courierAddr.Hostname(), courierAddr.Port(),
+	serverAddr := net.JoinHostPort(
+		courierAddr.Hostname(), courierAddr.Port(),
 	)
 	conn, err := grpc.Dial(serverAddr, dialOpts...)
 	if err != nil {
@@ -1207,6 +1316,108 @@ func (c *UniverseRpcCourier) ReceiveProof(ctx context.Context,
 	}, nil
 }
 
+// ListProofs returns the locators of the transfer proofs the universe holds
+// for the asset and script key of the given locator. The universe returns its
+// leaf keys in the order they were inserted, so the cursor is the number of
+// leaf keys of the universe that were already looked at. This way a poll only
+// needs to fetch the leaf keys that were added since the previous one.
+func (c *UniverseRpcCourier) ListProofs(ctx context.Context, loc Locator,
+	cursor uint64) ([]Locator, uint64, error) {
+
+	if loc.AssetID == nil {
+		return nil, 0, fmt.Errorf("proof locator is missing asset ID")
+	}
+	if cursor > math.MaxInt32 {
+		return nil, 0, fmt.Errorf("invalid cursor %d", cursor)
+	}
+
+	var groupKeyBytes []byte
+	if loc.GroupKey != nil {
+		groupKeyBytes = loc.GroupKey.SerializeCompressed()
+	}
+	universeID := unirpc.MarshalUniverseID(loc.AssetID[:], groupKeyBytes)
+	universeID.ProofType = unirpc.ProofType_PROOF_TYPE_TRANSFER
+
+	// The universe might return the script key in its x-only form, so we
+	// compare the keys in that form.
+	targetScriptKey := schnorr.SerializePubKey(&loc.ScriptKey)
+
+	var (
+		locators []Locator
+		offset   = int32(cursor)
+	)
+	for {
+		resp, err := c.client.AssetLeafKeys(
+			ctx, &unirpc.AssetLeafKeysRequest{
+				Id:     universeID,
+				Offset: offset,
+				Limit:  listProofsPageSize,
+			},
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error listing universe "+
+				"leaf keys: %w", err)
+		}
+
+		for _, key := range resp.AssetKeys {
+			scriptKey := key.GetScriptKeyBytes()
+			if len(scriptKey) == btcec.PubKeyBytesLenCompressed {
+				scriptKey = scriptKey[1:]
+			}
+			if !bytes.Equal(scriptKey, targetScriptKey) {
+				continue
+			}
+
+			outPoint, err := unmarshalLeafKeyOutPoint(key)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			locators = append(locators, Locator{
+				AssetID:   loc.AssetID,
+				GroupKey:  loc.GroupKey,
+				ScriptKey: loc.ScriptKey,
+				OutPoint:  &outPoint,
+			})
+		}
+
+		offset += int32(len(resp.AssetKeys))
+		if len(resp.AssetKeys) < listProofsPageSize {
+			return locators, uint64(offset), nil
+		}
+	}
+}
+
+// unmarshalLeafKeyOutPoint parses the outpoint of a universe leaf key.
+func unmarshalLeafKeyOutPoint(key *unirpc.AssetKey) (wire.OutPoint, error) {
+	switch {
+	case key.GetOpStr() != "":
+		outPoint, err := wire.NewOutPointFromString(key.GetOpStr())
+		if err != nil {
+			return wire.OutPoint{}, fmt.Errorf("invalid leaf key "+
+				"outpoint: %w", err)
+		}
+
+		return *outPoint, nil
+
+	case key.GetOp() != nil:
+		hash, err := chainhash.NewHashFromStr(key.GetOp().HashStr)
+		if err != nil {
+			return wire.OutPoint{}, fmt.Errorf("invalid leaf key "+
+				"outpoint hash: %w", err)
+		}
+
+		return wire.OutPoint{
+			Hash:  *hash,
+			Index: uint32(key.GetOp().Index),
+		}, nil
+
+	default:
+		return wire.OutPoint{}, fmt.Errorf("leaf key is missing " +
+			"outpoint")
+	}
+}
+
 // SetSubscribers sets the subscribers for the courier. This method is
 // thread-safe.
 func (c *UniverseRpcCourier) SetSubscribers(
@@ -1236,8 +1447,275 @@ func (c *UniverseRpcCourier) Close() error {
 }
 
 // A compile-time assertion to ensure the UniverseRpcCourier meets the
-// proof.Courier interface.
+// proof.Courier and proof.ProofLister interfaces.
 var _ Courier = (*UniverseRpcCourier)(nil)
+var _ ProofLister = (*UniverseRpcCourier)(nil)
+
+// FileCourierCfg is the config for the file proof courier.
+type FileCourierCfg struct {
+	// BaseDir is the directory the file courier is restricted to. The
+	// directory of a file courier address must be BaseDir or one of its
+	// subdirectories. If this is empty, the file courier is disabled.
+	BaseDir string `long:"basedir" description:"The directory the file proof courier is restricted to. Only file courier addresses that point to this directory or one of its subdirectories can be used. The file courier is disabled if this is not set."`
+
+	// BackoffCfg configures the behaviour of the proof delivery
+	// functionality. When receiving a proof, the backoff procedure
+	// determines how long we wait for the proof file to appear in the
+	// courier directory.
+	BackoffCfg *BackoffCfg
+}
+
+// fileCourierDir returns the cleaned directory of a file courier address,
+// making sure it is within the given base directory. The courier address is
+// usually chosen by the counterparty, so without this check a proof could be
+// written to or read from any directory tapd has access to.
+func fileCourierDir(baseDir, addrPath string) (string, error) {
+	if baseDir == "" {
+		return "", fmt.Errorf("file proof courier is disabled, set " +
+			"filecourier.basedir to enable it")
+	}
+
+	baseDir, err := filepath.Abs(baseDir)
+	if err != nil {
+		return "", fmt.Errorf("invalid file courier base directory: "+
+			"%w", err)
+	}
+
+	dir := filepath.Clean(addrPath)
+	relPath, err := filepath.Rel(baseDir, dir)
+	if err != nil || relPath == ".." ||
+		strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+
+		return "", fmt.Errorf("file proof courier directory %v is "+
+			"not within the base directory %v", dir, baseDir)
+	}
+
+	return dir, nil
+}
+
+// FileCourier is a proof courier service handle that writes proof files to and
+// reads them from a local directory. This allows proofs to be carried to or
+// from a node that never connects to a courier service, for example across an
+// air gap. It implements the Courier and ProofLister interfaces.
+//
+// The courier doesn't get notified about new files in the directory. Instead,
+// ReceiveProof polls the directory according to the backoff configuration, and
+// transfers to reusable addresses are found by the custodian polling
+// ListProofs.
+type FileCourier struct {
+	// recipient describes the recipient of the proof.
+	recipient Recipient
+
+	// dir is the directory proof files are written to and read from.
+	dir string
+
+	// cfg is the general courier configuration.
+	cfg *CourierCfg
+
+	// backoffHandle is a handle to the backoff procedure used in proof
+	// delivery.
+	backoffHandle *BackoffHandler
+
+	// subscribers is a map of components that want to be notified on new
+	// events, keyed by their subscription ID.
+	subscribers map[uint64]*fn.EventReceiver[fn.Event]
+
+	// subscriberMtx guards the subscribers map and access to the
+	// subscriptionID.
+	subscriberMtx sync.Mutex
+}
+
+// DeliverProof writes the proof file to the courier directory. The file is
+// stored under the same path a FileArchiver would use for the last proof in
+// the file, so the directory can be read by a receiving courier.
+func (c *FileCourier) DeliverProof(ctx context.Context,
+	annotatedProof *AnnotatedProof) error {
+
+	lastProof, err := extractLastProof(annotatedProof.Blob)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("File proof courier attempting to deliver proof file for "+
+		"send event (asset_id=%v, amt=%v) to %v", c.recipient.AssetID,
+		c.recipient.Amount, c.dir)
+
+	loc := proofLocator(lastProof)
+	proofPath, err := genProofFileStoragePath(c.dir, loc)
+	if err != nil {
+		return err
+	}
+
+	deliverFunc := func() error {
+		err := os.MkdirAll(filepath.Dir(proofPath), 0750)
+		if err != nil {
+			return fmt.Errorf("error creating courier directory: "+
+				"%w", err)
+		}
+
+		// We write to a temporary file first and then rename it, so a
+		// receiver watching the directory never reads a partially
+		// written proof file.
+		tmpPath := proofPath + ".tmp"
+		err = os.WriteFile(tmpPath, annotatedProof.Blob, 0640)
+		if err != nil {
+			return fmt.Errorf("error writing proof file: %w", err)
+		}
+
+		return os.Rename(tmpPath, proofPath)
+	}
+	err = c.backoffHandle.Exec(
+		ctx, loc, SendTransferType, deliverFunc,
+		c.publishSubscriberEvent,
+	)
+	if err != nil {
+		return fmt.Errorf("proof backoff delivery attempt has failed: "+
+			"%w", err)
+	}
+
+	return nil
+}
+
+// ReceiveProof reads the proof file identified by the given locator from the
+// courier directory. If the file isn't there yet, we keep looking for it
+// according to the backoff configuration.
+func (c *FileCourier) ReceiveProof(ctx context.Context,
+	loc Locator) (*AnnotatedProof, error) {
+
+	var proofBlob Blob
+	receiveFunc := func() error {
+		proofPath, err := lookupProofFilePath(c.dir, loc)
+		if err != nil {
+			return err
+		}
+
+		proofBlob, err = os.ReadFile(proofPath)
+		if err != nil {
+			return fmt.Errorf("error reading proof file: %w", err)
+		}
+
+		return nil
+	}
+	err := c.backoffHandle.Exec(
+		ctx, loc, ReceiveTransferType, receiveFunc,
+		c.publishSubscriberEvent,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("proof backoff receive attempt has "+
+			"failed: %w", err)
+	}
+
+	return &AnnotatedProof{
+		Locator: loc,
+		Blob:    proofBlob,
+	}, nil
+}
+
+// ListProofs returns the locators of all proof files in the courier directory
+// for the asset and script key of the given locator. If the locator has a
+// group key, the proof files of all asset IDs of the group are returned. The
+// files in the directory have no order, so the cursor is ignored and all
+// files are always listed.
+func (c *FileCourier) ListProofs(_ context.Context, loc Locator,
+	_ uint64) ([]Locator, uint64, error) {
+
+	assetDir := "*"
+	switch {
+	case loc.GroupKey != nil:
+
+	case loc.AssetID != nil:
+		assetDir = hex.EncodeToString(loc.AssetID[:])
+
+	default:
+		return nil, 0, ErrInvalidLocatorID
+	}
+
+	searchPattern := filepath.Join(
+		c.dir, assetDir, fmt.Sprintf("%x-*%s",
+			loc.ScriptKey.SerializeCompressed(),
+			TaprootAssetsFileSuffix),
+	)
+	matches, err := filepath.Glob(searchPattern)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error listing proof files: %w", err)
+	}
+
+	// The file names only contain a truncated outpoint, so we need to
+	// read the last proof of each file to find out where the asset is.
+	locators := make([]Locator, 0, len(matches))
+	for _, proofPath := range matches {
+		proofBlob, err := os.ReadFile(proofPath)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error reading proof "+
+				"file: %w", err)
+		}
+
+		lastProof, err := extractLastProof(proofBlob)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error reading proof "+
+				"file %v: %w", proofPath, err)
+		}
+
+		proofLoc := proofLocator(lastProof)
+		if loc.GroupKey != nil && (proofLoc.GroupKey == nil ||
+			!proofLoc.GroupKey.IsEqual(loc.GroupKey)) {
+
+			continue
+		}
+
+		locators = append(locators, proofLoc)
+	}
+
+	return locators, 0, nil
+}
+
+// SetSubscribers sets the subscribers for the courier. This method is
+// thread-safe.
+func (c *FileCourier) SetSubscribers(
+	subscribers map[uint64]*fn.EventReceiver[fn.Event]) {
+
+	c.subscriberMtx.Lock()
+	defer c.subscriberMtx.Unlock()
+
+	c.subscribers = subscribers
+}
+
+// publishSubscriberEvent publishes an event to all subscribers.
+func (c *FileCourier) publishSubscriberEvent(event fn.Event) {
+	// Lock the subscriber mutex to ensure that we don't modify the
+	// subscriber map while we're iterating over it.
+	c.subscriberMtx.Lock()
+	defer c.subscriberMtx.Unlock()
+
+	for _, sub := range c.subscribers {
+		sub.NewItemCreated.ChanIn() <- event
+	}
+}
+
+// Close stops the courier instance. The file courier doesn't hold any
+// resources, so this is a no-op.
+func (c *FileCourier) Close() error {
+	return nil
+}
+
+// proofLocator returns the locator that identifies the given proof.
+func proofLocator(p *Proof) Locator {
+	loc := Locator{
+		AssetID:   fn.Ptr(p.Asset.ID()),
+		ScriptKey: *p.Asset.ScriptKey.PubKey,
+		OutPoint:  fn.Ptr(p.OutPoint()),
+	}
+	if p.Asset.GroupKey != nil {
+		loc.GroupKey = &p.Asset.GroupKey.GroupPubKey
+	}
+
+	return loc
+}
+
+// A compile-time assertion to ensure the FileCourier meets the proof.Courier
+// and proof.ProofLister interfaces.
+var _ Courier = (*FileCourier)(nil)
+var _ ProofLister = (*FileCourier)(nil)
 
 // TransferType is the type of proof transfer attempt. The transfer is
 // either a proof delivery to the transfer counterparty or receiving a proof
@@ -3,12 +3,17 @@ package proof
 import (
 	"bytes"
 	"context"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/internal/test"
+	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 )
 
 // TestUniverseRpcCourierLocalArchiveShortCut tests that the local archive is
@@ -73,3 +78,277 @@ func TestUniverseRpcCourierLocalArchiveShortCut(t *testing.T) {
 	})
 	require.ErrorContains(t, err, "is missing outpoint")
 }
+
+// mockTransferLog is a transfer log that doesn't record anything.
+type mockTransferLog struct{}
+
+// LogProofTransferAttempt logs a new proof transfer attempt.
+func (m *mockTransferLog) LogProofTransferAttempt(context.Context, Locator,
+	TransferType) error {
+
+	return nil
+}
+
+// QueryProofTransferLog returns timestamps which correspond to logged proof
+// delivery attempts.
+func (m *mockTransferLog) QueryProofTransferLog(context.Context, Locator,
+	TransferType) ([]time.Time, error) {
+
+	return nil, nil
+}
+
+// TestFileCourier tests that a proof delivered by the file courier can be
+// listed and received from the courier directory.
+func TestFileCourier(t *testing.T) {
+	t.Parallel()
+
+	baseDir := t.TempDir()
+	courierAddr, err := ParseCourierAddress(
+		FileCourierType + "://" + filepath.Join(baseDir, "courier"),
+	)
+	require.NoError(t, err)
+
+	dispatch := NewCourierDispatch(&CourierCfg{
+		FileCfg: &FileCourierCfg{
+			BaseDir: baseDir,
+			BackoffCfg: &BackoffCfg{
+				SkipInitDelay: true,
+				NumTries:      1,
+			},
+		},
+		TransferLog: &mockTransferLog{},
+	})
+	courier, err := dispatch.NewCourier(courierAddr, Recipient{})
+	require.NoError(t, err)
+
+	testBlocks := readTestData(t)
+	genesis := asset.RandGenesis(t, asset.Collectible)
+	scriptKey := test.RandPubKey(t)
+	proof := RandProof(t, genesis, scriptKey, testBlocks[0], 0, 1)
+
+	file, err := NewFile(V0, proof)
+	require.NoError(t, err)
+
+	var fileBuf bytes.Buffer
+	require.NoError(t, file.Encode(&fileBuf))
+	proofBlob := Blob(fileBuf.Bytes())
+
+	locator := Locator{
+		AssetID:   fn.Ptr(genesis.ID()),
+		GroupKey:  &proof.Asset.GroupKey.GroupPubKey,
+		ScriptKey: *proof.Asset.ScriptKey.PubKey,
+		OutPoint:  fn.Ptr(proof.OutPoint()),
+	}
+
+	ctx := context.Background()
+	ctxt, cancel := context.WithTimeout(ctx, testTimeout)
+	defer cancel()
+
+	// Before the proof is delivered, the courier can't find it.
+	_, err = courier.ReceiveProof(ctxt, locator)
+	require.ErrorContains(t, err, ErrProofNotFound.Error())
+
+	err = courier.DeliverProof(ctxt, &AnnotatedProof{
+		Locator: locator,
+		Blob:    proofBlob,
+	})
+	require.NoError(t, err)
+
+	// The proof can now be listed by asset ID and by group key. Keys might
+	// not have the same internal representation after parsing, so we
+	// compare the locators by their hash.
+	lister, ok := courier.(ProofLister)
+	require.True(t, ok)
+
+	locHash, err := locator.Hash()
+	require.NoError(t, err)
+	assertLocators := func(locators []Locator) {
+		require.Len(t, locators, 1)
+
+		hash, err := locators[0].Hash()
+		require.NoError(t, err)
+		require.Equal(t, locHash, hash)
+	}
+
+	byID := locator
+	byID.GroupKey = nil
+	byID.OutPoint = nil
+	locators, _, err := lister.ListProofs(ctxt, byID, 0)
+	require.NoError(t, err)
+	assertLocators(locators)
+
+	byGroup := locator
+	byGroup.OutPoint = nil
+	locators, _, err = lister.ListProofs(ctxt, byGroup, 0)
+	require.NoError(t, err)
+	assertLocators(locators)
+
+	byGroup.GroupKey = test.RandPubKey(t)
+	locators, _, err = lister.ListProofs(ctxt, byGroup, 0)
+	require.NoError(t, err)
+	require.Empty(t, locators)
+
+	// And finally, we can receive it.
+	received, err := courier.ReceiveProof(ctxt, locator)
+	require.NoError(t, err)
+	require.Equal(t, proofBlob, received.Blob)
+}
+
+// TestFileCourierDir tests that the file courier can only be used with
+// directories within its base directory.
+func TestFileCourierDir(t *testing.T) {
+	t.Parallel()
+
+	baseDir := filepath.Join(t.TempDir(), "courier")
+
+	testCases := []struct {
+		name        string
+		baseDir     string
+		addrPath    string
+		expectedDir string
+		expectedErr string
+	}{{
+		name:        "base directory",
+		baseDir:     baseDir,
+		addrPath:    baseDir,
+		expectedDir: baseDir,
+	}, {
+		name:        "subdirectory",
+		baseDir:     baseDir,
+		addrPath:    baseDir + "/alice/",
+		expectedDir: filepath.Join(baseDir, "alice"),
+	}, {
+		name:        "disabled",
+		baseDir:     "",
+		addrPath:    baseDir,
+		expectedErr: "file proof courier is disabled",
+	}, {
+		name:        "outside",
+		baseDir:     baseDir,
+		addrPath:    "/etc",
+		expectedErr: "not within the base directory",
+	}, {
+		name:        "sibling with same prefix",
+		baseDir:     baseDir,
+		addrPath:    baseDir + "-other",
+		expectedErr: "not within the base directory",
+	}, {
+		name:        "path traversal",
+		baseDir:     baseDir,
+		addrPath:    baseDir + "/../other",
+		expectedErr: "not within the base directory",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir, err := fileCourierDir(tc.baseDir, tc.addrPath)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedDir, dir)
+		})
+	}
+}
+
+// mockUniverseClient is a mock universe client that pages through a fixed
+// list of leaf keys.
+type mockUniverseClient struct {
+	unirpc.UniverseClient
+
+	keys    []*unirpc.AssetKey
+	offsets []int32
+}
+
+// AssetLeafKeys returns the page of leaf keys at the requested offset.
+func (m *mockUniverseClient) AssetLeafKeys(_ context.Context,
+	req *unirpc.AssetLeafKeysRequest,
+	_ ...grpc.CallOption) (*unirpc.AssetLeafKeyResponse, error) {
+
+	m.offsets = append(m.offsets, req.Offset)
+
+	start := min(int(req.Offset), len(m.keys))
+	end := min(start+int(req.Limit), len(m.keys))
+
+	return &unirpc.AssetLeafKeyResponse{
+		AssetKeys: m.keys[start:end],
+	}, nil
+}
+
+// TestUniverseRpcCourierListProofs tests that the universe courier only lists
+// the proofs of the requested script key, and that the returned cursor can be
+// used to only fetch the leaf keys that were added since the last call.
+func TestUniverseRpcCourierListProofs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	scriptKey := test.RandPubKey(t)
+
+	client := &mockUniverseClient{}
+	addKey := func(matching bool) wire.OutPoint {
+		keyBytes := test.RandPubKey(t).SerializeCompressed()
+		if matching {
+			keyBytes = scriptKey.SerializeCompressed()
+		}
+
+		outPoint := test.RandOp(t)
+		client.keys = append(client.keys, &unirpc.AssetKey{
+			Outpoint: &unirpc.AssetKey_OpStr{
+				OpStr: outPoint.String(),
+			},
+			ScriptKey: &unirpc.AssetKey_ScriptKeyBytes{
+				ScriptKeyBytes: keyBytes,
+			},
+		})
+
+		return outPoint
+	}
+
+	// We add more keys than fit into a single page, with some of them
+	// belonging to our script key.
+	var expected []wire.OutPoint
+	for i := 0; i < listProofsPageSize+100; i++ {
+		outPoint := addKey(i%100 == 0)
+		if i%100 == 0 {
+			expected = append(expected, outPoint)
+		}
+	}
+
+	courier := &UniverseRpcCourier{client: client}
+	loc := Locator{
+		AssetID:   fn.Ptr(asset.RandID(t)),
+		ScriptKey: *scriptKey,
+	}
+	outPoints := func(locators []Locator) []wire.OutPoint {
+		return fn.Map(locators, func(l Locator) wire.OutPoint {
+			return *l.OutPoint
+		})
+	}
+
+	locators, cursor, err := courier.ListProofs(ctx, loc, 0)
+	require.NoError(t, err)
+	require.Equal(t, expected, outPoints(locators))
+	require.EqualValues(t, len(client.keys), cursor)
+	require.Equal(t, []int32{0, listProofsPageSize}, client.offsets)
+
+	// Without any new keys, there is nothing new to list.
+	client.offsets = nil
+	locators, nextCursor, err := courier.ListProofs(ctx, loc, cursor)
+	require.NoError(t, err)
+	require.Empty(t, locators)
+	require.Equal(t, cursor, nextCursor)
+	require.Equal(t, []int32{int32(cursor)}, client.offsets)
+
+	// A new transfer is found without fetching the old keys again.
+	addKey(false)
+	newOutPoint := addKey(true)
+
+	client.offsets = nil
+	locators, nextCursor, err = courier.ListProofs(ctx, loc, cursor)
+	require.NoError(t, err)
+	require.Equal(t, []wire.OutPoint{newOutPoint}, outPoints(locators))
+	require.Equal(t, cursor+2, nextCursor)
+	require.Equal(t, []int32{int32(cursor)}, client.offsets)
+}
@@ -61,6 +61,11 @@ const (
 	// much smaller, assuming they don't all have additional inputs. But we
 	// must cap this value somewhere to avoid OOM attacks.
 	FileMaxSizeBytes = 500 * 1024 * 1024
+
+	// FileMaxHeaderSizeBytes is the maximum size of the header of a proof
+	// file, which consists of the prefix magic bytes, the file version and
+	// the number of proofs encoded as a variable length integer.
+	FileMaxHeaderSizeBytes = PrefixMagicBytesLength + 4 + 9
 )
 
 // hashedProof is a struct that contains an encoded proof and its chained
@@ -172,44 +177,66 @@ func (f *File) Encode(w io.Writer) error {
 	return nil
 }
 
-// Decode decodes a proof file from `r`.
-func (f *File) Decode(r io.Reader) error {
+// decodeFileHeader decodes the header of a proof file from `r`, returning the
+// file version and the number of proofs in the file.
+func decodeFileHeader(r io.Reader) (Version, uint64, error) {
 	var prefixMagicBytes [PrefixMagicBytesLength]byte
 	num, err := r.Read(prefixMagicBytes[:])
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 	if num != PrefixMagicBytesLength {
-		return errors.New("failed to read prefix magic bytes")
+		return 0, 0, errors.New("failed to read prefix magic bytes")
 	}
 
 	if prefixMagicBytes != FilePrefixMagicBytes {
-		return fmt.Errorf("invalid prefix magic bytes, expected %s, "+
-			"got %s", string(FilePrefixMagicBytes[:]),
+		return 0, 0, fmt.Errorf("invalid prefix magic bytes, expected "+
+			"%s, got %s", string(FilePrefixMagicBytes[:]),
 			string(prefixMagicBytes[:]))
 	}
 
 	var version uint32
 	if err := binary.Read(r, binary.BigEndian, &version); err != nil {
-		return err
+		return 0, 0, err
 	}
-	f.Version = Version(version)
 
 	var tlvBuf [8]byte
 	numProofs, err := tlv.ReadVarInt(r, &tlvBuf)
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 
 	// Cap the number of proofs there can be within a single file to avoid
 	// OOM attacks. See the comment for FileMaxNumProofs for the reasoning
 	// behind the value chosen.
 	if numProofs > FileMaxNumProofs {
-		return fmt.Errorf("%w: too many proofs in file",
+		return 0, 0, fmt.Errorf("%w: too many proofs in file",
 			ErrProofFileInvalid)
 	}
 
-	var prevHash, currentHash, proofHash [sha256.Size]byte
+	return Version(version), numProofs, nil
+}
+
+// FileNumProofs returns the number of proofs in the given encoded proof file,
+// without reading the proofs themselves. It is enough to pass the first
+// FileMaxHeaderSizeBytes bytes of the file.
+func FileNumProofs(blob Blob) (uint64, error) {
+	_, numProofs, err := decodeFileHeader(bytes.NewReader(blob))
+	return numProofs, err
+}
+
+// Decode decodes a proof file from `r`.
+func (f *File) Decode(r io.Reader) error {
+	version, numProofs, err := decodeFileHeader(r)
+	if err != nil {
+		return err
+	}
+	f.Version = version
+
+	var (
+		tlvBuf                           [8]byte
+		prevHash, currentHash, proofHash [sha256.Size]byte
+	)
 	f.proofs = make([]*hashedProof, numProofs)
 	for i := uint64(0); i < numProofs; i++ {
 		// We need to find out how many bytes we expect for the proof,
@@ -457,6 +484,51 @@ func (f *File) ReplaceProofAt(index uint32, proof Proof) error {
 	return nil
 }
 
+// IsPruned returns true if the first proof of the file isn't the genesis proof
+// of the asset, which means the history before the first proof was pruned.
+// Such a file can only be verified from a trusted checkpoint.
+func (f *File) IsPruned() (bool, error) {
+	if f.IsEmpty() {
+		return false, nil
+	}
+
+	firstProof, err := f.ProofAt(0)
+	if err != nil {
+		return false, err
+	}
+
+	return !firstProof.Asset.IsGenesisAsset(), nil
+}
+
+// PruneBefore removes all proofs before the proof at the given index from the
+// file, so the file starts at that proof. The chained hashes of the remaining
+// proofs are updated in the process.
+func (f *File) PruneBefore(index uint32) error {
+	if err := f.IsValid(); err != nil {
+		return err
+	}
+
+	if index >= uint32(len(f.proofs)) {
+		return fmt.Errorf("invalid index %d", index)
+	}
+
+	// We copy the remaining proofs, so the pruned ones can be garbage
+	// collected.
+	remainingProofs := make([]*hashedProof, len(f.proofs)-int(index))
+	copy(remainingProofs, f.proofs[index:])
+
+	// The first remaining proof is now chained to the zero hash, so all
+	// hashes need to be re-computed.
+	var prevHash [sha256.Size]byte
+	for _, p := range remainingProofs {
+		p.hash = hashProof(p.proofBytes, prevHash)
+		prevHash = p.hash
+	}
+	f.proofs = remainingProofs
+
+	return nil
+}
+
 // encodeProof encodes the given proof and returns its raw bytes.
 func encodeProof(proof *Proof) ([]byte, error) {
 	var buf bytes.Buffer
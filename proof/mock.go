@@ -527,6 +527,22 @@ func (m *MockProofCourier) ReceiveProof(_ context.Context,
 	}, nil
 }
 
+// ListProofs returns the locator of the proof the courier holds for the
+// script key of the given locator, if any. The cursor is ignored.
+func (m *MockProofCourier) ListProofs(_ context.Context, loc Locator,
+	_ uint64) ([]Locator, uint64, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	proof, ok := m.currentProofs[asset.ToSerialized(&loc.ScriptKey)]
+	if !ok {
+		return nil, 0, nil
+	}
+
+	return []Locator{proof.Locator}, 0, nil
+}
+
 // SetSubscribers sets the set of subscribers that will be notified
 // of proof courier related events.
 func (m *MockProofCourier) SetSubscribers(
@@ -544,6 +560,7 @@ func (m *MockProofCourier) Close() error {
 }
 
 var _ Courier = (*MockProofCourier)(nil)
+var _ ProofLister = (*MockProofCourier)(nil)
 
 type ValidTestCase struct {
 	Proof    *TestProof `json:"proof"`
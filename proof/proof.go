@@ -99,6 +99,22 @@ var (
 	// invalid.
 	ErrProofInvalid = errors.New("proof is invalid")
 
+	// ErrInvalidCheckpoint is an error returned if the first proof of a
+	// pruned proof file isn't accepted as a checkpoint.
+	ErrInvalidCheckpoint = errors.New("proof is not a valid checkpoint")
+
+	// ErrCheckpointRequired is an error returned if a pruned proof file
+	// is verified without a way to verify its first proof as a
+	// checkpoint.
+	ErrCheckpointRequired = errors.New("proof file doesn't start at " +
+		"genesis, checkpoint verifier required")
+
+	// ErrPrunedHistoryUnavailable is an error returned if the history of
+	// a proof file can't be pruned, because it couldn't be restored from
+	// a trusted source afterward.
+	ErrPrunedHistoryUnavailable = errors.New("pruned proof history " +
+		"can't be restored")
+
 	// RegtestTestVectorName is the name of the test vector file that is
 	// generated/updated by an actual integration test run on regtest. It is
 	// exported here, so we can use it in the integration tests.
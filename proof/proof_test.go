@@ -23,6 +23,7 @@ import (
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/stretchr/testify/require"
@@ -693,6 +694,206 @@ func TestProofFileVerification(t *testing.T) {
 	require.ErrorIs(t, err, ErrUnknownVersion)
 }
 
+// TestProofFileCheckpointVerification ensures that a pruned proof file can
+// only be verified if its first proof is accepted as a checkpoint.
+func TestProofFileCheckpointVerification(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	proofHex, err := os.ReadFile(proofFileHexFileName)
+	require.NoError(t, err)
+
+	proofBytes, err := hex.DecodeString(
+		strings.Trim(string(proofHex), "\n"),
+	)
+	require.NoError(t, err)
+
+	f := &File{}
+	err = f.Decode(bytes.NewReader(proofBytes))
+	require.NoError(t, err)
+
+	numProofs := f.NumProofs()
+	require.Greater(t, numProofs, 1)
+
+	fullSnapshot, err := f.Verify(
+		ctx, MockHeaderVerifier, MockMerkleVerifier, MockGroupVerifier,
+		MockChainLookup,
+	)
+	require.NoError(t, err)
+
+	pruned, err := f.IsPruned()
+	require.NoError(t, err)
+	require.False(t, pruned)
+
+	// We prune the genesis proof, so the file starts at the first
+	// transfer.
+	checkpoint, err := f.ProofAt(1)
+	require.NoError(t, err)
+
+	require.NoError(t, f.PruneBefore(1))
+	require.Equal(t, numProofs-1, f.NumProofs())
+
+	pruned, err = f.IsPruned()
+	require.NoError(t, err)
+	require.True(t, pruned)
+
+	// The chained hashes were updated, so the pruned file can be encoded
+	// and decoded again.
+	var buf bytes.Buffer
+	require.NoError(t, f.Encode(&buf))
+	prunedBlob := buf.Bytes()
+
+	verify := func(verifier *BaseVerifier) (*AssetSnapshot, error) {
+		return verifier.Verify(
+			ctx, bytes.NewReader(prunedBlob), MockHeaderVerifier,
+			MockMerkleVerifier, MockGroupVerifier, MockChainLookup,
+		)
+	}
+
+	// Without a checkpoint verifier, the pruned file can't be verified.
+	_, err = verify(&BaseVerifier{})
+	require.ErrorIs(t, err, ErrCheckpointRequired)
+
+	// The first proof must be accepted as a checkpoint.
+	_, err = verify(&BaseVerifier{
+		CheckpointVerifier: func(context.Context, *Proof) error {
+			return fmt.Errorf("unknown proof")
+		},
+	})
+	require.ErrorIs(t, err, ErrInvalidCheckpoint)
+
+	snapshot, err := verify(&BaseVerifier{
+		CheckpointVerifier: func(_ context.Context, p *Proof) error {
+			if p.OutPoint() != checkpoint.OutPoint() {
+				return fmt.Errorf("unexpected checkpoint")
+			}
+
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, fullSnapshot.OutPoint, snapshot.OutPoint)
+	require.Equal(t, fullSnapshot.Asset, snapshot.Asset)
+}
+
+// TestPruneProofFile tests that a proof file is only pruned in the archive if
+// its history can be restored afterward, and that the restored file matches the
+// original one.
+func TestPruneProofFile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	proofHex, err := os.ReadFile(proofFileHexFileName)
+	require.NoError(t, err)
+
+	proofBytes, err := hex.DecodeString(
+		strings.Trim(string(proofHex), "\n"),
+	)
+	require.NoError(t, err)
+
+	f := &File{}
+	require.NoError(t, f.Decode(bytes.NewReader(proofBytes)))
+	require.Greater(t, f.NumProofs(), 1)
+
+	// We re-encode the file, so we can compare it against the encoding of
+	// the restored file.
+	var fullBuf bytes.Buffer
+	require.NoError(t, f.Encode(&fullBuf))
+	fullBlob := fullBuf.Bytes()
+
+	// The history fetcher serves all the proofs of the original file by
+	// their outpoint, like a universe would.
+	rawProofs := make(map[wire.OutPoint]Blob)
+	for idx := 0; idx < f.NumProofs(); idx++ {
+		p, err := f.ProofAt(uint32(idx))
+		require.NoError(t, err)
+		rawProof, err := f.RawProofAt(uint32(idx))
+		require.NoError(t, err)
+
+		rawProofs[p.OutPoint()] = rawProof
+	}
+	historyFetcher := func(_ context.Context, loc Locator) (Blob, error) {
+		rawProof, ok := rawProofs[*loc.OutPoint]
+		if !ok {
+			return nil, ErrProofNotFound
+		}
+
+		return rawProof, nil
+	}
+	noHistoryFetcher := func(context.Context, Locator) (Blob, error) {
+		return nil, ErrProofNotFound
+	}
+
+	checkpoint, err := f.ProofAt(1)
+	require.NoError(t, err)
+	checkpointVerifier := func(_ context.Context, p *Proof) error {
+		if p.OutPoint() != checkpoint.OutPoint() {
+			return fmt.Errorf("unexpected checkpoint")
+		}
+
+		return nil
+	}
+
+	lastProof, err := f.LastProof()
+	require.NoError(t, err)
+	lastAsset := lastProof.Asset
+	loc := Locator{
+		AssetID:   fn.Ptr(lastAsset.ID()),
+		ScriptKey: *lastAsset.ScriptKey.PubKey,
+		OutPoint:  fn.Ptr(lastProof.OutPoint()),
+	}
+	if lastAsset.GroupKey != nil {
+		loc.GroupKey = &lastAsset.GroupKey.GroupPubKey
+	}
+
+	archive := NewMockProofArchive()
+	err = archive.ImportProofs(
+		ctx, MockHeaderVerifier, MockMerkleVerifier, MockGroupVerifier,
+		MockChainLookup, false, &AnnotatedProof{
+			Locator: loc,
+			Blob:    fullBlob,
+		},
+	)
+	require.NoError(t, err)
+
+	prune := func(fetcher HistoryFetcher) (uint32, error) {
+		return PruneProofFile(
+			ctx, loc, archive, checkpointVerifier, fetcher,
+			MockHeaderVerifier, MockMerkleVerifier,
+			MockGroupVerifier, MockChainLookup,
+		)
+	}
+
+	// If the pruned history can't be restored, the full file is kept.
+	_, err = prune(noHistoryFetcher)
+	require.ErrorIs(t, err, ErrPrunedHistoryUnavailable)
+
+	storedBlob, err := archive.FetchProof(ctx, loc)
+	require.NoError(t, err)
+	require.Equal(t, fullBlob, []byte(storedBlob))
+
+	// Otherwise, the file is pruned up to the checkpoint.
+	numPruned, err := prune(historyFetcher)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, numPruned)
+
+	storedBlob, err = archive.FetchProof(ctx, loc)
+	require.NoError(t, err)
+	prunedFile, err := storedBlob.AsFile()
+	require.NoError(t, err)
+	require.Equal(t, f.NumProofs()-1, prunedFile.NumProofs())
+
+	// The full file can be restored from the pruned one.
+	restoredFile, err := RestorePrunedFile(ctx, prunedFile, historyFetcher)
+	require.NoError(t, err)
+
+	var restoredBuf bytes.Buffer
+	require.NoError(t, restoredFile.Encode(&restoredBuf))
+	require.Equal(t, fullBlob, restoredBuf.Bytes())
+}
+
 // TestProofVerification ensures that the proof encoding and decoding works as
 // expected.
 func TestProofVerification(t *testing.T) {
@@ -855,6 +1056,11 @@ func TestProofReplacement(t *testing.T) {
 	err = f.Encode(&buf)
 	require.NoError(t, err)
 
+	// The number of proofs can be read from the encoded file directly.
+	fileNumProofs, err := FileNumProofs(buf.Bytes())
+	require.NoError(t, err)
+	require.EqualValues(t, numProofs, fileNumProofs)
+
 	f2, err := NewFile(V0)
 	require.NoError(t, err)
 
@@ -43,9 +43,19 @@ type Verifier interface {
 		chainLookupGen ChainLookupGenerator) (*AssetSnapshot, error)
 }
 
+// CheckpointVerifier is a function that returns a nil error if the given proof
+// is committed to a trusted source, such as a universe root, and can therefore
+// be used as the starting point of a proof file without verifying the history
+// of the asset before it.
+type CheckpointVerifier func(ctx context.Context, p *Proof) error
+
 // BaseVerifier implements a simple verifier that loads the entire proof file
 // into memory and then verifies it all at once.
 type BaseVerifier struct {
+	// CheckpointVerifier is used to verify the first proof of a pruned
+	// proof file as a trusted checkpoint. If this is nil, only proof files
+	// that start at the genesis of the asset can be verified.
+	CheckpointVerifier CheckpointVerifier
 }
 
 // Verify takes the passed serialized proof file, and returns a nil
@@ -62,9 +72,29 @@ func (b *BaseVerifier) Verify(ctx context.Context, blobReader io.Reader,
 		return nil, fmt.Errorf("unable to parse proof: %w", err)
 	}
 
+	pruned, err := proofFile.IsPruned()
+	if err != nil {
+		return nil, err
+	}
+
+	chainLookup := chainLookupGen.GenFileChainLookup(&proofFile)
+
+	// A proof file that doesn't start at the genesis of the asset can
+	// only be verified from a trusted checkpoint.
+	if pruned {
+		if b.CheckpointVerifier == nil {
+			return nil, ErrCheckpointRequired
+		}
+
+		return proofFile.VerifyFromCheckpoint(
+			ctx, b.CheckpointVerifier, headerVerifier,
+			merkleVerifier, groupVerifier, chainLookup,
+		)
+	}
+
 	return proofFile.Verify(
 		ctx, headerVerifier, merkleVerifier, groupVerifier,
-		chainLookupGen.GenFileChainLookup(&proofFile),
+		chainLookup,
 	)
 }
 
@@ -634,8 +664,16 @@ func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
 		return nil, err
 	}
 
-	// 8. At this point we know there is an inclusion proof, which must be
-	// a commitment proof. So we can extract the tapscript preimage directly
+	return p.assetSnapshot(tapCommitment, splitAsset), nil
+}
+
+// assetSnapshot returns the snapshot of the asset resulting from the state
+// transition of the verified proof.
+func (p *Proof) assetSnapshot(tapCommitment *commitment.TapCommitment,
+	splitAsset bool) *AssetSnapshot {
+
+	// At this point we know there is an inclusion proof, which must be a
+	// commitment proof. So we can extract the tapscript preimage directly
 	// from there.
 	tapscriptPreimage := p.InclusionProof.CommitmentProof.TapSiblingPreimage
 
@@ -656,7 +694,71 @@ func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
 		TapscriptSibling:  tapscriptPreimage,
 		SplitAsset:        splitAsset,
 		MetaReveal:        p.MetaReveal,
-	}, nil
+	}
+}
+
+// VerifyCheckpoint verifies a proof that is used as the starting point of a
+// pruned proof file. The state transition of a checkpoint can't be verified
+// as the previous asset isn't known, so the checkpoint verifier must accept
+// the proof instead. Everything else that can be verified without the
+// previous asset is verified as well:
+//  1. The anchor transaction has a valid merkle proof within a block in the
+//     chain.
+//  2. The inclusion, split root and exclusion proofs are valid.
+//  3. The group key of the asset, if any, is known.
+//  4. The proof is accepted by the checkpoint verifier.
+func (p *Proof) VerifyCheckpoint(ctx context.Context,
+	checkpointVerifier CheckpointVerifier, headerVerifier HeaderVerifier,
+	merkleVerifier MerkleVerifier,
+	groupVerifier GroupVerifier) (*AssetSnapshot, error) {
+
+	if p.IsUnknownVersion() {
+		return nil, ErrUnknownVersion
+	}
+
+	if err := p.Asset.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate proof asset: "+
+			"%w", err)
+	}
+
+	// 1. The anchor transaction has a valid merkle proof within a block in
+	// the chain.
+	err := headerVerifier(p.BlockHeader, p.BlockHeight)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate proof block "+
+			"header: %w", err)
+	}
+
+	err = merkleVerifier(
+		&p.AnchorTx, &p.TxMerkleProof, p.BlockHeader.MerkleRoot,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to validate merkle proof: "+
+			"%w", err, ErrInvalidTxMerkleProof)
+	}
+
+	// 2. The inclusion, split root and exclusion proofs are valid.
+	tapCommitment, err := p.VerifyProofs()
+	if err != nil {
+		return nil, fmt.Errorf("error verifying proofs: %w", err)
+	}
+
+	// 3. Any asset with a group key must carry a group key that has
+	// already been imported and verified.
+	if p.Asset.GroupKey != nil {
+		if err := p.verifyGenesisGroupKey(groupVerifier); err != nil {
+			return nil, err
+		}
+	}
+
+	// 4. The proof is accepted by the checkpoint verifier.
+	if err := checkpointVerifier(ctx, p); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCheckpoint, err)
+	}
+
+	return p.assetSnapshot(
+		tapCommitment, p.Asset.HasSplitCommitmentWitness(),
+	), nil
 }
 
 // VerifyProofs verifies the inclusion and exclusion proofs as well as the split
@@ -729,8 +831,64 @@ func (f *File) Verify(ctx context.Context, headerVerifier HeaderVerifier,
 		return nil, ErrUnknownVersion
 	}
 
-	var prev *AssetSnapshot
-	for idx := range f.proofs {
+	return f.verifyFrom(
+		ctx, 0, nil, headerVerifier, merkleVerifier, groupVerifier,
+		chainLookup,
+	)
+}
+
+// VerifyFromCheckpoint attempts to verify a proof file that starts at a
+// checkpoint instead of the asset's genesis. The first proof of the file must
+// be accepted by the given checkpoint verifier, all following proofs are fully
+// verified.
+func (f *File) VerifyFromCheckpoint(ctx context.Context,
+	checkpointVerifier CheckpointVerifier, headerVerifier HeaderVerifier,
+	merkleVerifier MerkleVerifier, groupVerifier GroupVerifier,
+	chainLookup asset.ChainLookup) (*AssetSnapshot, error) {
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	if f.IsUnknownVersion() {
+		return nil, ErrUnknownVersion
+	}
+
+	// An empty proof file should return a nil error, just like a full
+	// verification would.
+	if f.IsEmpty() {
+		return nil, nil
+	}
+
+	checkpoint, err := f.ProofAt(0)
+	if err != nil {
+		return nil, err
+	}
+
+	prev, err := checkpoint.VerifyCheckpoint(
+		ctx, checkpointVerifier, headerVerifier, merkleVerifier,
+		groupVerifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.verifyFrom(
+		ctx, 1, prev, headerVerifier, merkleVerifier, groupVerifier,
+		chainLookup,
+	)
+}
+
+// verifyFrom verifies the proofs of the file starting at the given index, with
+// the given snapshot as the result of the previous state transition.
+func (f *File) verifyFrom(ctx context.Context, startIdx int,
+	prev *AssetSnapshot, headerVerifier HeaderVerifier,
+	merkleVerifier MerkleVerifier, groupVerifier GroupVerifier,
+	chainLookup asset.ChainLookup) (*AssetSnapshot, error) {
+
+	for idx := startIdx; idx < len(f.proofs); idx++ {
 		select {
 		case <-ctx.Done():
 			return nil, ctx.Err()
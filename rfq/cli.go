@@ -24,6 +24,10 @@ type CliConfig struct {
 
 	SkipAcceptQuotePriceCheck bool `long:"skipacceptquotepricecheck" description:"Accept any price quote returned by RFQ peer, skipping price validation"`
 
+	RecordRateTicks bool `long:"recordrateticks" description:"Store every rate tick received from the price oracle in the local database, so accepted quotes can be audited later"`
+
+	DisableOracleCache bool `long:"disableoraclecache" description:"Query the price oracle for every quote instead of reusing its rate ticks until they are about to expire"`
+
 	MockOracleAssetsPerBTC uint64 `long:"mockoracleassetsperbtc" description:"Mock price oracle static asset units per BTC rate (for example number of USD cents per BTC if one asset unit represents a USD cent); whole numbers only, use either this or mockoraclesatsperasset depending on required precision"`
 
 	MockOracleSatsPerAsset uint64 `long:"mockoraclesatsperasset" description:"Mock price oracle static satoshis per asset unit rate (for example number of satoshis to pay for one USD cent if one asset unit represents a USD cent); whole numbers only, use either this or mockoracleassetsperbtc depending on required precision"`
@@ -12,6 +12,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnutils"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
@@ -48,6 +49,23 @@ type ScidAliasManager interface {
 		baseScid lnwire.ShortChannelID) error
 }
 
+// MsgSigner is an interface that can sign messages with a key of the lnd
+// wallet. It is used to sign the quote accept messages we send with our node
+// identity key.
+type MsgSigner interface {
+	// SignMessage signs a message with the key specified in the key
+	// locator.
+	SignMessage(ctx context.Context, msg []byte,
+		locator keychain.KeyLocator,
+		opts ...lndclient.SignMessageOption) ([]byte, error)
+}
+
+// nodeKeyLocator is the key locator of the lnd node identity key.
+var nodeKeyLocator = keychain.KeyLocator{
+	Family: keychain.KeyFamilyNodeKey,
+	Index:  0,
+}
+
 // ManagerCfg is a struct that holds the configuration parameters for the RFQ
 // manager.
 type ManagerCfg struct {
@@ -63,6 +81,19 @@ type ManagerCfg struct {
 	// determine whether a quote is accepted or rejected.
 	PriceOracle PriceOracle
 
+	// RateTickStore is an optional store that keeps a history of the rate
+	// ticks received from the price oracle.
+	RateTickStore RateTickStore
+
+	// QuoteStore is an optional store that persists accepted quotes and
+	// the HTLCs accepted against them. If set, active quotes are restored
+	// on startup.
+	QuoteStore QuoteStore
+
+	// Signer is used to sign the quote accept messages we send with our
+	// node identity key.
+	Signer MsgSigner
+
 	// ChannelLister is the channel lister that the RFQ manager will use to
 	// determine the available channels for routing.
 	ChannelLister ChannelLister
@@ -199,6 +230,19 @@ func (m *Manager) startSubsystems(ctx context.Context) error {
 		return fmt.Errorf("unable to start RFQ order handler: %w", err)
 	}
 
+	// Remove the SCID aliases of quotes that expired while we were
+	// offline and restore the quotes that are still active, so HTLCs
+	// against them are accepted after a restart. Stale aliases don't
+	// prevent us from starting, their removal is retried periodically.
+	if err := m.removeExpiredAliases(ctx); err != nil {
+		log.Warnf("Unable to remove expired RFQ SCID aliases, will "+
+			"retry: %v", err)
+	}
+
+	if err := m.restoreQuotes(ctx); err != nil {
+		return fmt.Errorf("unable to restore RFQ quotes: %w", err)
+	}
+
 	// Initialise and start the peer message stream handler.
 	m.streamHandler, err = NewStreamHandler(
 		ctx, StreamHandlerCfg{
@@ -216,11 +260,22 @@ func (m *Manager) startSubsystems(ctx context.Context) error {
 			"peer message stream handler: %w", err)
 	}
 
+	// If the price oracle caches its rate ticks, we start removing the
+	// expired ones from its cache periodically.
+	cachingOracle, ok := m.cfg.PriceOracle.(*CachingPriceOracle)
+	if ok {
+		if err := cachingOracle.Start(); err != nil {
+			return fmt.Errorf("unable to start caching price "+
+				"oracle: %w", err)
+		}
+	}
+
 	// Initialise and start the quote negotiator.
 	m.negotiator, err = NewNegotiator(
 		// nolint: lll
 		NegotiatorCfg{
 			PriceOracle:               m.cfg.PriceOracle,
+			Signer:                    m.cfg.Signer,
 			OutgoingMessages:          m.outgoingMessages,
 			AcceptPriceDeviationPpm:   DefaultAcceptPriceDeviationPpm,
 			SkipAcceptQuotePriceCheck: m.cfg.SkipAcceptQuotePriceCheck,
@@ -326,6 +381,16 @@ func (m *Manager) stopSubsystems() error {
 			err)
 	}
 
+	// Stop the cache sweeper of the price oracle, if it caches its rate
+	// ticks.
+	cachingOracle, ok := m.cfg.PriceOracle.(*CachingPriceOracle)
+	if ok {
+		if err := cachingOracle.Stop(); err != nil {
+			return fmt.Errorf("error stopping caching price "+
+				"oracle: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -370,7 +435,7 @@ func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 			// need to make sure we can identify the incoming asset
 			// payment by the SCID alias through which it comes in
 			// and compare it to the one in the invoice.
-			err := m.addScidAlias(
+			baseSCID, err := m.addScidAlias(
 				uint64(msg.ShortChannelId()),
 				*msg.Request.AssetID, msg.Peer,
 			)
@@ -382,6 +447,13 @@ func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 				return
 			}
 
+			err = m.storeQuote(NewStoredQuoteFromBuyAccept(
+				msg, false, fn.Some(baseSCID),
+			))
+			if err != nil {
+				m.handleError(err)
+			}
+
 			// Notify subscribers of the incoming peer accepted
 			// asset buy quote.
 			event := NewPeerAcceptedBuyQuoteEvent(&msg)
@@ -422,6 +494,13 @@ func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 			scid := msg.ShortChannelId()
 			m.peerAcceptedSellQuotes.Store(scid, msg)
 
+			err := m.storeQuote(NewStoredQuoteFromSellAccept(
+				msg, false, fn.None[uint64](),
+			))
+			if err != nil {
+				m.handleError(err)
+			}
+
 			// Notify subscribers of the incoming peer accepted
 			// asset sell quote.
 			event := NewPeerAcceptedSellQuoteEvent(&msg)
@@ -463,7 +542,7 @@ func (m *Manager) handleOutgoingMessage(outgoingMsg rfqmsg.OutgoingMsg) error {
 		// Since our peer is going to buy assets from us, we need to
 		// make sure we can identify the forwarded asset payment by the
 		// outgoing SCID alias within the onion packet.
-		err := m.addScidAlias(
+		baseSCID, err := m.addScidAlias(
 			uint64(msg.ShortChannelId()), *msg.Request.AssetID,
 			msg.Peer,
 		)
@@ -471,6 +550,13 @@ func (m *Manager) handleOutgoingMessage(outgoingMsg rfqmsg.OutgoingMsg) error {
 			return fmt.Errorf("error adding local alias: %w", err)
 		}
 
+		err = m.storeQuote(NewStoredQuoteFromBuyAccept(
+			*msg, true, fn.Some(baseSCID),
+		))
+		if err != nil {
+			return err
+		}
+
 	case *rfqmsg.SellAccept:
 		// A peer sent us an asset sell quote request in an attempt to
 		// sell an asset to us. Having accepted the request, but before
@@ -482,6 +568,13 @@ func (m *Manager) handleOutgoingMessage(outgoingMsg rfqmsg.OutgoingMsg) error {
 		// We want to store that we accepted the sell quote, in case we
 		// need to look it up for a direct peer payment.
 		m.localAcceptedSellQuotes.Store(msg.ShortChannelId(), *msg)
+
+		err := m.storeQuote(NewStoredQuoteFromSellAccept(
+			*msg, true, fn.None[uint64](),
+		))
+		if err != nil {
+			return err
+		}
 	}
 
 	// Send the outgoing message to the peer.
@@ -494,9 +587,10 @@ func (m *Manager) handleOutgoingMessage(outgoingMsg rfqmsg.OutgoingMsg) error {
 	return nil
 }
 
-// addScidAlias adds a SCID alias to the alias manager.
+// addScidAlias adds a SCID alias to the alias manager and returns the base
+// SCID the alias was mapped to.
 func (m *Manager) addScidAlias(scidAlias uint64, assetID asset.ID,
-	peer route.Vertex) error {
+	peer route.Vertex) (uint64, error) {
 
 	// Retrieve all local channels.
 	ctxb := context.Background()
@@ -504,7 +598,7 @@ func (m *Manager) addScidAlias(scidAlias uint64, assetID asset.ID,
 	if err != nil {
 		// Not being able to call lnd to add the alias is a critical
 		// error, which warrants shutting down, as something is wrong.
-		return fn.NewCriticalError(
+		return 0, fn.NewCriticalError(
 			fmt.Errorf("add alias: error listing local channels: "+
 				"%w", err),
 		)
@@ -553,8 +647,8 @@ func (m *Manager) addScidAlias(scidAlias uint64, assetID asset.ID,
 	// At this point, if the base SCID is still not found, we return an
 	// error. We can't map the SCID alias to a base SCID.
 	if baseSCID == 0 {
-		return fmt.Errorf("add alias: base SCID not found for asset: "+
-			"%v", assetID)
+		return 0, fmt.Errorf("add alias: base SCID not found for "+
+			"asset: %v", assetID)
 	}
 
 	log.Debugf("Adding SCID alias %d for base SCID %d", scidAlias, baseSCID)
@@ -566,17 +660,189 @@ func (m *Manager) addScidAlias(scidAlias uint64, assetID asset.ID,
 	if err != nil {
 		// Not being able to call lnd to add the alias is a critical
 		// error, which warrants shutting down, as something is wrong.
-		return fn.NewCriticalError(
+		return 0, fn.NewCriticalError(
 			fmt.Errorf("add alias: error adding SCID alias to "+
 				"lnd alias manager: %w", err),
 		)
 	}
 
+	return baseSCID, nil
+}
+
+// storeQuote persists an accepted quote, if a quote store is configured.
+func (m *Manager) storeQuote(quote *StoredQuote) error {
+	if m.cfg.QuoteStore == nil {
+		return nil
+	}
+
+	ctx, cancel := m.WithCtxQuit()
+	defer cancel()
+
+	err := m.cfg.QuoteStore.InsertQuote(ctx, *quote)
+	if err != nil {
+		return fmt.Errorf("unable to store %v quote %x: %w",
+			quote.Type, quote.ID[:], err)
+	}
+
+	return nil
+}
+
+// removeExpiredAliases removes the SCID aliases of all stored quotes that have
+// expired from the alias manager. An alias that can't be removed is logged and
+// left in place, so its removal is retried on the next call.
+func (m *Manager) removeExpiredAliases(ctx context.Context) error {
+	if m.cfg.QuoteStore == nil {
+		return nil
+	}
+
+	quotes, err := m.cfg.QuoteStore.ExpiredAliasQuotes(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("unable to fetch expired quotes: %w", err)
+	}
+
+	for idx := range quotes {
+		quote := quotes[idx]
+		baseSCID := quote.BaseScid.UnwrapOr(0)
+		if baseSCID == 0 {
+			continue
+		}
+
+		log.Debugf("Removing SCID alias %d of expired quote %x",
+			quote.Scid(), quote.ID[:])
+
+		err := m.cfg.AliasManager.DeleteLocalAlias(
+			ctx, lnwire.NewShortChanIDFromInt(uint64(quote.Scid())),
+			lnwire.NewShortChanIDFromInt(baseSCID),
+		)
+		if err != nil {
+			log.Warnf("Unable to delete SCID alias %d of expired "+
+				"quote %x, will retry: %v", quote.Scid(),
+				quote.ID[:], err)
+			continue
+		}
+
+		err = m.cfg.QuoteStore.MarkAliasDeleted(ctx, quote.ID)
+		if err != nil {
+			log.Warnf("Unable to mark SCID alias %d of expired "+
+				"quote %x as deleted, will retry: %v",
+				quote.Scid(), quote.ID[:], err)
+		}
+	}
+
+	return nil
+}
+
+// restoreQuotes loads all active quotes from the quote store and registers
+// them with the manager and the order handler.
+func (m *Manager) restoreQuotes(ctx context.Context) error {
+	if m.cfg.QuoteStore == nil {
+		return nil
+	}
+
+	quotes, err := m.cfg.QuoteStore.ActiveQuotes(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("unable to fetch active quotes: %w", err)
+	}
+
+	log.Infof("Restoring %d active RFQ quotes", len(quotes))
+
+	for idx := range quotes {
+		quote := quotes[idx]
+		scid := quote.Scid()
+
+		switch quote.Type {
+		case QuoteTypeBuy:
+			accept, err := quote.BuyAccept()
+			if err != nil {
+				return err
+			}
+
+			if quote.LocallyAccepted {
+				m.orderHandler.RegisterAssetSalePolicy(*accept)
+				m.localAcceptedBuyQuotes.Store(scid, *accept)
+			} else {
+				m.peerAcceptedBuyQuotes.Store(scid, *accept)
+			}
+
+		case QuoteTypeSell:
+			accept, err := quote.SellAccept()
+			if err != nil {
+				return err
+			}
+
+			if quote.LocallyAccepted {
+				m.orderHandler.RegisterAssetPurchasePolicy(
+					*accept,
+				)
+				m.localAcceptedSellQuotes.Store(scid, *accept)
+			} else {
+				m.peerAcceptedSellQuotes.Store(scid, *accept)
+			}
+
+		default:
+			return fmt.Errorf("unknown quote type %v of quote %x",
+				quote.Type, quote.ID[:])
+		}
+	}
+
+	return nil
+}
+
+// policyQuoteIDs returns the IDs of the quotes the given policy was created
+// from.
+func policyQuoteIDs(policy Policy) []rfqmsg.ID {
+	switch p := policy.(type) {
+	case *AssetSalePolicy:
+		return []rfqmsg.ID{p.ID}
+
+	case *AssetPurchasePolicy:
+		return []rfqmsg.ID{p.AcceptedQuoteId}
+
+	case *AssetForwardPolicy:
+		return []rfqmsg.ID{
+			p.incomingPolicy.AcceptedQuoteId, p.outgoingPolicy.ID,
+		}
+
+	default:
+		return nil
+	}
+}
+
+// storeHtlcFill records an accepted HTLC against all quotes of the policy it
+// was accepted with, if a quote store is configured.
+func (m *Manager) storeHtlcFill(event *AcceptHtlcEvent) error {
+	if m.cfg.QuoteStore == nil {
+		return nil
+	}
+
+	ctx, cancel := m.WithCtxQuit()
+	defer cancel()
+
+	htlc := event.Htlc
+	for _, quoteID := range policyQuoteIDs(event.Policy) {
+		err := m.cfg.QuoteStore.InsertHtlcFill(ctx, HtlcFill{
+			QuoteID:        quoteID,
+			IncomingChanID: htlc.IncomingCircuitKey.ChanID,
+			IncomingHtlcID: htlc.IncomingCircuitKey.HtlcID,
+			OutgoingChanID: htlc.OutgoingChannelID,
+			AmountIn:       htlc.AmountInMsat,
+			AmountOut:      htlc.AmountOutMsat,
+			Timestamp:      event.Timestamp(),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to store HTLC fill for "+
+				"quote %x: %w", quoteID[:], err)
+		}
+	}
+
 	return nil
 }
 
 // mainEventLoop is the main event loop of the RFQ manager.
 func (m *Manager) mainEventLoop() {
+	cleanupTicker := time.NewTicker(CacheCleanupInterval)
+	defer cleanupTicker.Stop()
+
 	for {
 		select {
 		// Handle incoming message.
@@ -606,9 +872,25 @@ func (m *Manager) mainEventLoop() {
 			}
 
 		case acceptHtlcEvent := <-m.acceptHtlcEvents:
-			// Handle a HTLC accept event. Notify any subscribers.
+			// Handle a HTLC accept event. Record the fill against
+			// the quote and notify any subscribers.
+			err := m.storeHtlcFill(acceptHtlcEvent)
+			if err != nil {
+				m.handleError(err)
+			}
+
 			m.publishSubscriberEvent(acceptHtlcEvent)
 
+		// Remove the SCID aliases of quotes that have expired.
+		case <-cleanupTicker.C:
+			ctx, cancel := m.WithCtxQuit()
+			err := m.removeExpiredAliases(ctx)
+			cancel()
+			if err != nil {
+				m.handleError(fmt.Errorf("unable to remove "+
+					"expired SCID aliases: %w", err))
+			}
+
 		// Handle subsystem errors.
 		case err := <-m.subsystemErrChan:
 			// Report the subsystem error to the main server, in
@@ -746,6 +1028,56 @@ func (m *Manager) UpsertAssetSellOrder(order SellOrder) error {
 	return nil
 }
 
+// QueryRateTicks returns the past rate ticks received from the price oracle
+// that match the given query. An error is returned if no rate tick store is
+// configured.
+func (m *Manager) QueryRateTicks(ctx context.Context,
+	query RateTickQuery) ([]RateTick, error) {
+
+	if m.cfg.RateTickStore == nil {
+		return nil, ErrNoRateTickStore
+	}
+
+	return m.cfg.RateTickStore.QueryRateTicks(ctx, query)
+}
+
+// RateTickAt returns the most recent rate tick of the given type for an asset
+// that was valid at the given time, optionally only for the given asset amount.
+// An error is returned if no rate tick store is configured.
+func (m *Manager) RateTickAt(ctx context.Context, assetID *asset.ID,
+	groupKey *btcec.PublicKey, tickType RateTickType,
+	assetAmount fn.Option[uint64], at time.Time) (*RateTick, error) {
+
+	return RateTickAt(
+		ctx, m.cfg.RateTickStore, assetID, groupKey, tickType,
+		assetAmount, at,
+	)
+}
+
+// QueryQuoteHistory returns the persisted quotes that match the given query.
+// An error is returned if no quote store is configured.
+func (m *Manager) QueryQuoteHistory(ctx context.Context,
+	query QuoteQuery) ([]StoredQuote, error) {
+
+	if m.cfg.QuoteStore == nil {
+		return nil, ErrNoQuoteStore
+	}
+
+	return m.cfg.QuoteStore.QueryQuotes(ctx, query)
+}
+
+// QueryHtlcFills returns the HTLCs that were accepted against the given quote.
+// An error is returned if no quote store is configured.
+func (m *Manager) QueryHtlcFills(ctx context.Context,
+	id rfqmsg.ID) ([]HtlcFill, error) {
+
+	if m.cfg.QuoteStore == nil {
+		return nil, ErrNoQuoteStore
+	}
+
+	return m.cfg.QuoteStore.QueryHtlcFills(ctx, id)
+}
+
 // PeerAcceptedBuyQuotes returns buy quotes that were requested by our node and
 // have been accepted by our peers. These quotes are exclusively available to
 // our node for the acquisition of assets.
@@ -923,6 +1255,10 @@ const (
 	// PriceOracleQueryErrQuoteRespStatus indicates that an error occurred
 	// when querying the price oracle whilst evaluating the quote response.
 	PriceOracleQueryErrQuoteRespStatus QuoteRespStatus = 2
+
+	// InvalidSigQuoteRespStatus indicates that the quote response isn't
+	// signed by the peer that accepted the quote.
+	InvalidSigQuoteRespStatus QuoteRespStatus = 3
 )
 
 // InvalidQuoteRespEvent is an event that is broadcast when the RFQ manager
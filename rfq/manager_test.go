@@ -0,0 +1,270 @@
+package rfq
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// mockHtlcInterceptor is a mock HTLC interceptor that never intercepts any
+// HTLCs.
+type mockHtlcInterceptor struct{}
+
+// InterceptHtlcs blocks until the given context is canceled.
+func (m *mockHtlcInterceptor) InterceptHtlcs(ctx context.Context,
+	_ lndclient.HtlcInterceptHandler) error {
+
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// mockPeerMessenger is a mock peer messenger that never receives any messages.
+type mockPeerMessenger struct{}
+
+// SubscribeCustomMessages returns channels that never deliver any messages.
+func (m *mockPeerMessenger) SubscribeCustomMessages(
+	_ context.Context) (<-chan lndclient.CustomMessage, <-chan error,
+	error) {
+
+	return make(chan lndclient.CustomMessage), make(chan error), nil
+}
+
+// SendCustomMessage drops the given message.
+func (m *mockPeerMessenger) SendCustomMessage(context.Context,
+	lndclient.CustomMessage) error {
+
+	return nil
+}
+
+// mockAliasManager is a mock SCID alias manager that records the aliases it
+// deleted.
+type mockAliasManager struct {
+	sync.Mutex
+
+	deleteErr error
+	deleted   []lnwire.ShortChannelID
+}
+
+// AddLocalAlias is a no-op.
+func (m *mockAliasManager) AddLocalAlias(context.Context, lnwire.ShortChannelID,
+	lnwire.ShortChannelID) error {
+
+	return nil
+}
+
+// DeleteLocalAlias records the deleted alias, unless the mock is set to fail.
+func (m *mockAliasManager) DeleteLocalAlias(_ context.Context, alias,
+	_ lnwire.ShortChannelID) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	if m.deleteErr != nil {
+		return m.deleteErr
+	}
+
+	m.deleted = append(m.deleted, alias)
+
+	return nil
+}
+
+// mockQuoteStore is an in-memory quote store.
+type mockQuoteStore struct {
+	sync.Mutex
+
+	quotes       []StoredQuote
+	aliasDeleted map[rfqmsg.ID]bool
+}
+
+// InsertQuote stores a new accepted quote.
+func (m *mockQuoteStore) InsertQuote(_ context.Context,
+	quote StoredQuote) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.quotes = append(m.quotes, quote)
+
+	return nil
+}
+
+// ActiveQuotes returns all quotes that haven't expired at the given time.
+func (m *mockQuoteStore) ActiveQuotes(_ context.Context,
+	now time.Time) ([]StoredQuote, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	return fn.Filter(m.quotes, func(q StoredQuote) bool {
+		return q.Expiry > uint64(now.Unix())
+	}), nil
+}
+
+// ExpiredAliasQuotes returns all expired quotes with an alias that wasn't yet
+// removed.
+func (m *mockQuoteStore) ExpiredAliasQuotes(_ context.Context,
+	now time.Time) ([]StoredQuote, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	return fn.Filter(m.quotes, func(q StoredQuote) bool {
+		return q.Expiry <= uint64(now.Unix()) &&
+			q.BaseScid.IsSome() && !m.aliasDeleted[q.ID]
+	}), nil
+}
+
+// MarkAliasDeleted marks the SCID alias of the given quote as removed.
+func (m *mockQuoteStore) MarkAliasDeleted(_ context.Context,
+	id rfqmsg.ID) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.aliasDeleted[id] = true
+
+	return nil
+}
+
+// InsertHtlcFill is a no-op.
+func (m *mockQuoteStore) InsertHtlcFill(context.Context, HtlcFill) error {
+	return nil
+}
+
+// QueryQuotes isn't used by the tests.
+func (m *mockQuoteStore) QueryQuotes(context.Context,
+	QuoteQuery) ([]StoredQuote, error) {
+
+	return nil, nil
+}
+
+// QueryHtlcFills isn't used by the tests.
+func (m *mockQuoteStore) QueryHtlcFills(context.Context,
+	rfqmsg.ID) ([]HtlcFill, error) {
+
+	return nil, nil
+}
+
+// isAliasDeleted returns true if the alias of the given quote was marked as
+// removed.
+func (m *mockQuoteStore) isAliasDeleted(id rfqmsg.ID) bool {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.aliasDeleted[id]
+}
+
+// randStoredQuote creates a random stored quote of the given type that
+// expires at the given time.
+func randStoredQuote(t *testing.T, quoteType QuoteType, locallyAccepted bool,
+	expiry time.Time) StoredQuote {
+
+	var id rfqmsg.ID
+	copy(id[:], test.RandBytes(32))
+	assetID := asset.RandID(t)
+
+	return StoredQuote{
+		ID:              id,
+		Peer:            route.NewVertex(test.RandPubKey(t)),
+		Type:            quoteType,
+		LocallyAccepted: locallyAccepted,
+		AssetID:         &assetID,
+		AssetAmount:     1000,
+		RequestPrice:    100,
+		AcceptedPrice:   100,
+		Expiry:          uint64(expiry.Unix()),
+		BaseScid:        fn.Some[uint64](1234),
+		CreatedAt:       time.Now(),
+	}
+}
+
+// newTestManager creates and starts a new RFQ manager with the given quote
+// store and alias manager.
+func newTestManager(t *testing.T, quoteStore QuoteStore,
+	aliasManager ScidAliasManager) *Manager {
+
+	manager, err := NewManager(ManagerCfg{
+		PeerMessenger:   &mockPeerMessenger{},
+		HtlcInterceptor: &mockHtlcInterceptor{},
+		QuoteStore:      quoteStore,
+		AliasManager:    aliasManager,
+		ErrChan:         make(chan error, 1),
+	})
+	require.NoError(t, err)
+	require.NoError(t, manager.Start())
+
+	t.Cleanup(func() {
+		require.NoError(t, manager.Stop())
+	})
+
+	return manager
+}
+
+// TestManagerRestoreQuotes tests that the RFQ manager restores the active
+// quotes on startup and removes the SCID aliases of expired quotes, retrying
+// the removal if it fails.
+func TestManagerRestoreQuotes(t *testing.T) {
+	t.Parallel()
+
+	var (
+		future = time.Now().Add(time.Hour)
+		past   = time.Now().Add(-time.Hour)
+
+		localBuy  = randStoredQuote(t, QuoteTypeBuy, true, future)
+		peerSell  = randStoredQuote(t, QuoteTypeSell, false, future)
+		expired   = randStoredQuote(t, QuoteTypeBuy, true, past)
+		quoteRows = []StoredQuote{localBuy, peerSell, expired}
+	)
+
+	quoteStore := &mockQuoteStore{
+		quotes:       quoteRows,
+		aliasDeleted: make(map[rfqmsg.ID]bool),
+	}
+
+	// If the expired alias can't be removed, the manager still starts and
+	// restores the active quotes.
+	aliasManager := &mockAliasManager{
+		deleteErr: errors.New("alias not removed"),
+	}
+	manager := newTestManager(t, quoteStore, aliasManager)
+
+	localBuyQuotes := manager.LocalAcceptedBuyQuotes()
+	require.Len(t, localBuyQuotes, 1)
+	require.Contains(t, localBuyQuotes, localBuy.Scid())
+
+	peerSellQuotes := manager.PeerAcceptedSellQuotes()
+	require.Len(t, peerSellQuotes, 1)
+	require.Contains(t, peerSellQuotes, peerSell.Scid())
+
+	require.Empty(t, manager.PeerAcceptedBuyQuotes())
+	require.Empty(t, manager.LocalAcceptedSellQuotes())
+
+	// HTLCs against the locally accepted quote are handled by the order
+	// handler again.
+	_, ok := manager.orderHandler.policies.Load(localBuy.Scid())
+	require.True(t, ok)
+
+	require.False(t, quoteStore.isAliasDeleted(expired.ID))
+
+	// After a restart with a working alias manager, the alias of the
+	// expired quote is removed.
+	aliasManager = &mockAliasManager{}
+	manager = newTestManager(t, quoteStore, aliasManager)
+
+	require.Len(t, manager.LocalAcceptedBuyQuotes(), 1)
+	require.True(t, quoteStore.isAliasDeleted(expired.ID))
+	require.Equal(t, []lnwire.ShortChannelID{
+		lnwire.NewShortChanIDFromInt(uint64(expired.Scid())),
+	}, aliasManager.deleted)
+}
@@ -1,12 +1,14 @@
 package rfq
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"sync"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/rfqmsg"
@@ -33,6 +35,10 @@ type NegotiatorCfg struct {
 	// determine whether a quote is accepted or rejected.
 	PriceOracle PriceOracle
 
+	// Signer is used to sign the quote accept messages we send with our
+	// node identity key.
+	Signer MsgSigner
+
 	// OutgoingMessages is a channel which is populated with outgoing peer
 	// messages. These are messages which are destined to be sent to peers.
 	OutgoingMessages chan<- rfqmsg.OutgoingMsg
@@ -78,6 +84,11 @@ type Negotiator struct {
 	// asset buy offers.
 	assetGroupBuyOffers lnutils.SyncMap[asset.SerializedKey, BuyOffer]
 
+	// signingPeers is the set of peers that have shown that they support
+	// signed quotes, either by requesting a signed accept message from us
+	// or by sending us one.
+	signingPeers lnutils.SyncMap[route.Vertex, struct{}]
+
 	// ContextGuard provides a wait group and main quit channel that can be
 	// used to create guarded contexts.
 	*fn.ContextGuard
@@ -96,6 +107,8 @@ func NewNegotiator(cfg NegotiatorCfg) (*Negotiator, error) {
 		assetGroupBuyOffers: lnutils.SyncMap[
 			asset.SerializedKey, BuyOffer]{},
 
+		signingPeers: lnutils.SyncMap[route.Vertex, struct{}]{},
+
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -267,6 +280,8 @@ func (n *Negotiator) queryAskFromPriceOracle(peer *route.Vertex,
 func (n *Negotiator) HandleIncomingBuyRequest(
 	request rfqmsg.BuyRequest) error {
 
+	n.rememberSigningPeer(request.Peer, request.MaxAcceptVersion)
+
 	// Define a thread safe helper function for adding outgoing message to
 	// the outgoing messages channel.
 	sendOutgoingMsg := func(msg rfqmsg.OutgoingMsg) {
@@ -342,10 +357,18 @@ func (n *Negotiator) HandleIncomingBuyRequest(
 			return
 		}
 
-		// Construct and send a buy accept message.
+		// Construct, sign and send a buy accept message.
 		msg := rfqmsg.NewBuyAcceptFromRequest(
 			request, askPrice, askExpiry,
 		)
+		if err := n.signAccept(msg); err != nil {
+			sendOutgoingMsg(rfqmsg.NewReject(
+				request.Peer, request.ID,
+				rfqmsg.ErrUnknownReject,
+			))
+			n.cfg.ErrChan <- err
+			return
+		}
 		sendOutgoingMsg(msg)
 	}()
 
@@ -357,6 +380,8 @@ func (n *Negotiator) HandleIncomingBuyRequest(
 func (n *Negotiator) HandleIncomingSellRequest(
 	request rfqmsg.SellRequest) error {
 
+	n.rememberSigningPeer(request.Peer, request.MaxAcceptVersion)
+
 	// Define a thread safe helper function for adding outgoing message to
 	// the outgoing messages channel.
 	sendOutgoingMsg := func(msg rfqmsg.OutgoingMsg) {
@@ -437,10 +462,18 @@ func (n *Negotiator) HandleIncomingSellRequest(
 			return
 		}
 
-		// Construct and send a sell accept message.
+		// Construct, sign and send a sell accept message.
 		msg := rfqmsg.NewSellAcceptFromRequest(
 			request, bidPrice, bidExpiry,
 		)
+		if err := n.signAccept(msg); err != nil {
+			sendOutgoingMsg(rfqmsg.NewReject(
+				request.Peer, request.ID,
+				rfqmsg.ErrUnknownReject,
+			))
+			n.cfg.ErrChan <- err
+			return
+		}
 		sendOutgoingMsg(msg)
 	}()
 
@@ -547,13 +580,112 @@ func pricesWithinBounds(firstPrice lnwire.MilliSatoshi,
 	return deltaPpm <= float64(tolerancePpm)
 }
 
+// signableAccept is a quote accept message that is signed by the accepting
+// node.
+type signableAccept interface {
+	// SigMsg returns the message that is signed.
+	SigMsg() []byte
+
+	// SetSig sets the signature over the message.
+	SetSig(sig [64]byte)
+}
+
+// signAccept signs the given quote accept message with our node identity key.
+func (n *Negotiator) signAccept(accept signableAccept) error {
+	if n.cfg.Signer == nil {
+		return fmt.Errorf("unable to sign quote accept: no signer")
+	}
+
+	ctx, cancel := n.WithCtxQuit()
+	defer cancel()
+
+	sig, err := n.cfg.Signer.SignMessage(
+		ctx, accept.SigMsg(), nodeKeyLocator,
+		lndclient.SignSchnorr(nil),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to sign quote accept: %w", err)
+	}
+
+	if len(sig) != 64 {
+		return fmt.Errorf("unexpected quote accept signature length "+
+			"%d", len(sig))
+	}
+
+	var schnorrSig [64]byte
+	copy(schnorrSig[:], sig)
+	accept.SetSig(schnorrSig)
+
+	return nil
+}
+
+// rememberSigningPeer records that the given peer supports signed quotes if
+// the given accept message version, which the peer either requested from us or
+// sent us, must be signed.
+func (n *Negotiator) rememberSigningPeer(peer route.Vertex,
+	version rfqmsg.WireMsgDataVersion) {
+
+	if rfqmsg.AcceptSigRequired(version) {
+		n.signingPeers.Store(peer, struct{}{})
+	}
+}
+
+// acceptDowngraded returns true if an unsigned V0 accept message from the given
+// peer in response to a quote request that allowed the given accept message
+// version must be rejected. That's the case if we asked for a signed accept
+// message and the peer is known to support signed quotes, as the unsigned
+// accept message is then an attempt to avoid committing to the quote.
+func (n *Negotiator) acceptDowngraded(peer route.Vertex,
+	requestedVersion rfqmsg.WireMsgDataVersion) bool {
+
+	if !rfqmsg.AcceptSigRequired(requestedVersion) {
+		return false
+	}
+
+	_, ok := n.signingPeers.Load(peer)
+	return ok
+}
+
 // HandleIncomingBuyAccept handles an incoming buy accept message. This method
 // is called when a peer accepts a quote request from this node. The method
-// checks the price and expiry time of the quote accept message. Once validation
-// is complete, the finalise callback function is called.
+// checks the signature, price and expiry time of the quote accept message. Once
+// validation is complete, the finalise callback function is called.
 func (n *Negotiator) HandleIncomingBuyAccept(msg rfqmsg.BuyAccept,
 	finalise func(rfqmsg.BuyAccept, fn.Option[InvalidQuoteRespEvent])) {
 
+	// Ensure that the quote was signed by the peer that accepted it, so
+	// we can prove the agreed upon terms later on. Peers that don't support
+	// signed quotes yet respond with an unsigned V0 accept message, which
+	// we still accept, unless the peer is known to support signed quotes.
+	err := msg.VerifySig(msg.Peer)
+	switch {
+	case errors.Is(err, rfqmsg.ErrAcceptSigMissing) &&
+		!rfqmsg.AcceptSigRequired(msg.Version) &&
+		!n.acceptDowngraded(msg.Peer, msg.Request.MaxAcceptVersion):
+
+		log.Warnf("Accepting unsigned buy accept quote from peer %v "+
+			"that doesn't support signed quotes (id=%x)", msg.Peer,
+			msg.ID[:])
+
+	case err != nil:
+		log.Debugf("Buy accept quote signature is invalid: %v", err)
+
+		invalidQuoteRespEvent := NewInvalidQuoteRespEvent(
+			&msg, InvalidSigQuoteRespStatus,
+		)
+		finalise(
+			msg, fn.Some[InvalidQuoteRespEvent](
+				*invalidQuoteRespEvent,
+			),
+		)
+
+		return
+
+	// A valid signature shows that the peer supports signed quotes.
+	default:
+		n.rememberSigningPeer(msg.Peer, msg.Version)
+	}
+
 	// Ensure that the quote expiry time is within acceptable bounds.
 	//
 	// TODO(ffranr): Sanity check the buy accept quote expiry
@@ -671,11 +803,44 @@ func (n *Negotiator) HandleIncomingBuyAccept(msg rfqmsg.BuyAccept,
 
 // HandleIncomingSellAccept handles an incoming sell accept message. This method
 // is called when a peer accepts a quote request from this node. The method
-// checks the price and expiry time of the quote accept message. Once validation
-// is complete, the finalise callback function is called.
+// checks the signature, price and expiry time of the quote accept message. Once
+// validation is complete, the finalise callback function is called.
 func (n *Negotiator) HandleIncomingSellAccept(msg rfqmsg.SellAccept,
 	finalise func(rfqmsg.SellAccept, fn.Option[InvalidQuoteRespEvent])) {
 
+	// Ensure that the quote was signed by the peer that accepted it, so
+	// we can prove the agreed upon terms later on. Peers that don't support
+	// signed quotes yet respond with an unsigned V0 accept message, which
+	// we still accept, unless the peer is known to support signed quotes.
+	err := msg.VerifySig(msg.Peer)
+	switch {
+	case errors.Is(err, rfqmsg.ErrAcceptSigMissing) &&
+		!rfqmsg.AcceptSigRequired(msg.Version) &&
+		!n.acceptDowngraded(msg.Peer, msg.Request.MaxAcceptVersion):
+
+		log.Warnf("Accepting unsigned sell accept quote from peer %v "+
+			"that doesn't support signed quotes (id=%x)", msg.Peer,
+			msg.ID[:])
+
+	case err != nil:
+		log.Debugf("Sell accept quote signature is invalid: %v", err)
+
+		invalidQuoteRespEvent := NewInvalidQuoteRespEvent(
+			&msg, InvalidSigQuoteRespStatus,
+		)
+		finalise(
+			msg, fn.Some[InvalidQuoteRespEvent](
+				*invalidQuoteRespEvent,
+			),
+		)
+
+		return
+
+	// A valid signature shows that the peer supports signed quotes.
+	default:
+		n.rememberSigningPeer(msg.Peer, msg.Version)
+	}
+
 	// Ensure that the quote expiry time is within acceptable bounds.
 	//
 	// TODO(ffranr): Sanity check the quote expiry timestamp given
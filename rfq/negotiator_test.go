@@ -2,8 +2,14 @@ package rfq
 
 import (
 	"testing"
+	"time"
 
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/stretchr/testify/require"
 )
 
@@ -134,3 +140,168 @@ func TestPricesWithinBounds(t *testing.T) {
 		)
 	}
 }
+
+// TestHandleIncomingAcceptSig tests that the negotiator only requires a quote
+// accept signature from peers that support signed quotes.
+func TestHandleIncomingAcceptSig(t *testing.T) {
+	t.Parallel()
+
+	negotiator, err := NewNegotiator(NegotiatorCfg{
+		SkipAcceptQuotePriceCheck: true,
+	})
+	require.NoError(t, err)
+
+	peer := route.NewVertex(test.RandPubKey(t))
+	assetID := asset.RandID(t)
+	expiry := uint64(time.Now().Add(10 * time.Minute).Unix())
+
+	// handleAccept passes the given accept message to the negotiator and
+	// returns the resulting invalid quote event, if any.
+	handleAccept := func(
+		accept *rfqmsg.BuyAccept) fn.Option[InvalidQuoteRespEvent] {
+
+		var result fn.Option[InvalidQuoteRespEvent]
+		negotiator.HandleIncomingBuyAccept(
+			*accept, func(_ rfqmsg.BuyAccept,
+				event fn.Option[InvalidQuoteRespEvent]) {
+
+				result = event
+			},
+		)
+
+		return result
+	}
+
+	request, err := rfqmsg.NewBuyRequest(peer, &assetID, nil, 100, 0)
+	require.NoError(t, err)
+
+	// A peer that supports signed quotes must sign its accept message.
+	accept := rfqmsg.NewBuyAcceptFromRequest(*request, 1000, expiry)
+	require.True(t, rfqmsg.AcceptSigRequired(accept.Version))
+
+	event := handleAccept(accept)
+	require.True(t, event.IsSome())
+	event.WhenSome(func(e InvalidQuoteRespEvent) {
+		require.Equal(t, InvalidSigQuoteRespStatus, e.Status)
+	})
+
+	// A peer that doesn't support signed quotes responds with an unsigned
+	// V0 accept message, which is accepted.
+	request.MaxAcceptVersion = rfqmsg.V0
+	accept = rfqmsg.NewBuyAcceptFromRequest(*request, 1000, expiry)
+	require.Equal(t, rfqmsg.V0, accept.Version)
+	require.True(t, handleAccept(accept).IsNone())
+
+	// An invalid signature is rejected, even from a peer that doesn't
+	// support signed quotes.
+	var invalidSig [64]byte
+	invalidSig[0] = 1
+	accept.SetSig(invalidSig)
+	require.True(t, handleAccept(accept).IsSome())
+}
+
+// TestHandleIncomingAcceptDowngrade tests that the negotiator rejects an
+// unsigned V0 accept message from a peer that is known to support signed
+// quotes, if our quote request asked for a signed accept message.
+func TestHandleIncomingAcceptDowngrade(t *testing.T) {
+	t.Parallel()
+
+	outgoingMsgs := make(chan rfqmsg.OutgoingMsg, 1)
+	negotiator, err := NewNegotiator(NegotiatorCfg{
+		OutgoingMessages:          outgoingMsgs,
+		SkipAcceptQuotePriceCheck: true,
+	})
+	require.NoError(t, err)
+
+	peer := route.NewVertex(test.RandPubKey(t))
+	assetID := asset.RandID(t)
+	expiry := uint64(time.Now().Add(10 * time.Minute).Unix())
+
+	// handleBuyAccept and handleSellAccept pass the given accept message
+	// to the negotiator and return the resulting invalid quote event, if
+	// any.
+	handleBuyAccept := func(
+		accept *rfqmsg.BuyAccept) fn.Option[InvalidQuoteRespEvent] {
+
+		var result fn.Option[InvalidQuoteRespEvent]
+		negotiator.HandleIncomingBuyAccept(
+			*accept, func(_ rfqmsg.BuyAccept,
+				event fn.Option[InvalidQuoteRespEvent]) {
+
+				result = event
+			},
+		)
+
+		return result
+	}
+	handleSellAccept := func(
+		accept *rfqmsg.SellAccept) fn.Option[InvalidQuoteRespEvent] {
+
+		var result fn.Option[InvalidQuoteRespEvent]
+		negotiator.HandleIncomingSellAccept(
+			*accept, func(_ rfqmsg.SellAccept,
+				event fn.Option[InvalidQuoteRespEvent]) {
+
+				result = event
+			},
+		)
+
+		return result
+	}
+
+	buyRequest, err := rfqmsg.NewBuyRequest(peer, &assetID, nil, 100, 0)
+	require.NoError(t, err)
+	sellRequest, err := rfqmsg.NewSellRequest(
+		peer, &assetID, nil, 100, 0,
+	)
+	require.NoError(t, err)
+
+	// Our requests ask for a signed accept message, but the peer responds
+	// with an unsigned V0 accept message instead.
+	buyAccept := rfqmsg.NewBuyAcceptFromRequest(*buyRequest, 1000, expiry)
+	buyAccept.Version = rfqmsg.V0
+	sellAccept := rfqmsg.NewSellAcceptFromRequest(
+		*sellRequest, 1000, expiry,
+	)
+	sellAccept.Version = rfqmsg.V0
+
+	// As long as we don't know whether the peer supports signed quotes,
+	// the unsigned accept messages are accepted.
+	require.True(t, handleBuyAccept(buyAccept).IsNone())
+	require.True(t, handleSellAccept(sellAccept).IsNone())
+
+	// The peer then sends us a quote request that asks for a signed accept
+	// message, which shows that it supports signed quotes. Without a price
+	// oracle, the request itself is rejected.
+	err = negotiator.HandleIncomingBuyRequest(rfqmsg.BuyRequest{
+		Peer:             peer,
+		ID:               buyRequest.ID,
+		AssetID:          &assetID,
+		AssetAmount:      100,
+		MaxAcceptVersion: rfqmsg.V1,
+	})
+	require.NoError(t, err)
+
+	select {
+	case <-outgoingMsgs:
+	case <-time.After(time.Second):
+		t.Fatalf("expected quote request to be rejected")
+	}
+
+	// From now on, the unsigned accept messages are rejected as a
+	// downgrade.
+	requireInvalidSig := func(event fn.Option[InvalidQuoteRespEvent]) {
+		require.True(t, event.IsSome())
+		event.WhenSome(func(e InvalidQuoteRespEvent) {
+			require.Equal(t, InvalidSigQuoteRespStatus, e.Status)
+		})
+	}
+	requireInvalidSig(handleBuyAccept(buyAccept))
+	requireInvalidSig(handleSellAccept(sellAccept))
+
+	// If our request didn't ask for a signed accept message, an unsigned
+	// one is still fine.
+	buyRequest.MaxAcceptVersion = rfqmsg.V0
+	buyAccept = rfqmsg.NewBuyAcceptFromRequest(*buyRequest, 1000, expiry)
+	require.True(t, handleBuyAccept(buyAccept).IsNone())
+}
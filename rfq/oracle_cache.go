@@ -0,0 +1,324 @@
+package rfq
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// DefaultMinRateTickLifetime is the default minimum remaining lifetime
+	// of a cached rate tick for it to still be served from the cache. This
+	// makes sure a quote built from a cached rate tick doesn't expire right
+	// after it was sent to the peer.
+	DefaultMinRateTickLifetime = time.Minute
+
+	// DefaultRateTickSweepInterval is the default interval at which
+	// expired rate ticks are removed from the cache.
+	DefaultRateTickSweepInterval = 5 * time.Minute
+)
+
+// rateTickCacheKey is the key of a cached price oracle response.
+type rateTickCacheKey struct {
+	// tickType is the type of the cached rate tick.
+	tickType RateTickType
+
+	// assetID is the asset ID the oracle was queried for.
+	assetID asset.ID
+
+	// groupKey is the serialized group key the oracle was queried for.
+	groupKey [btcec.PubKeyBytesLenCompressed]byte
+
+	// assetAmount is the asset amount the oracle was queried for.
+	assetAmount uint64
+
+	// hint is the suggested price that was passed to the oracle, if any.
+	hint lnwire.MilliSatoshi
+}
+
+// newRateTickCacheKey creates a new cache key for the given query arguments.
+func newRateTickCacheKey(tickType RateTickType, assetID *asset.ID,
+	groupKey *btcec.PublicKey, assetAmount uint64,
+	hint *lnwire.MilliSatoshi) rateTickCacheKey {
+
+	key := rateTickCacheKey{
+		tickType:    tickType,
+		assetAmount: assetAmount,
+	}
+	if assetID != nil {
+		key.assetID = *assetID
+	}
+	if groupKey != nil {
+		copy(key.groupKey[:], groupKey.SerializeCompressed())
+	}
+	if hint != nil {
+		key.hint = *hint
+	}
+
+	return key
+}
+
+// CachingPriceOracleCfg is the configuration of the caching price oracle.
+type CachingPriceOracleCfg struct {
+	// Oracle is the price oracle that is queried on a cache miss.
+	Oracle PriceOracle
+
+	// TickStore is an optional store that every rate tick received from
+	// the price oracle is persisted to.
+	TickStore RateTickStore
+
+	// Clock is the clock used to determine whether a cached rate tick is
+	// still valid.
+	Clock clock.Clock
+
+	// MinTickLifetime is the minimum remaining lifetime a cached rate tick
+	// must have to still be served from the cache.
+	MinTickLifetime time.Duration
+
+	// SweepInterval is the interval at which expired rate ticks are
+	// removed from the cache. If zero, DefaultRateTickSweepInterval is
+	// used.
+	SweepInterval time.Duration
+
+	// DisableCache, if set, causes every query to be sent to the price
+	// oracle. The rate ticks are still persisted to the tick store.
+	DisableCache bool
+}
+
+// CachingPriceOracle is a price oracle that wraps another price oracle and
+// caches its responses until the returned rate ticks expire. Optionally all
+// rate ticks received from the wrapped oracle are stored in a rate tick
+// store.
+type CachingPriceOracle struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg CachingPriceOracleCfg
+
+	// cache holds the latest rate tick for each distinct query.
+	cache map[rateTickCacheKey]RateTick
+
+	mu sync.Mutex
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+}
+
+// NewCachingPriceOracle creates a new caching price oracle.
+func NewCachingPriceOracle(cfg CachingPriceOracleCfg) *CachingPriceOracle {
+	if cfg.Clock == nil {
+		cfg.Clock = clock.NewDefaultClock()
+	}
+	if cfg.SweepInterval == 0 {
+		cfg.SweepInterval = DefaultRateTickSweepInterval
+	}
+
+	return &CachingPriceOracle{
+		cfg:   cfg,
+		cache: make(map[rateTickCacheKey]RateTick),
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts the periodic removal of expired rate ticks from the cache.
+func (c *CachingPriceOracle) Start() error {
+	c.startOnce.Do(func() {
+		log.Info("Starting subsystem: caching price oracle")
+
+		c.Wg.Add(1)
+		go c.sweepLoop()
+	})
+
+	return nil
+}
+
+// Stop stops the periodic removal of expired rate ticks from the cache.
+func (c *CachingPriceOracle) Stop() error {
+	c.stopOnce.Do(func() {
+		log.Info("Stopping subsystem: caching price oracle")
+
+		close(c.Quit)
+		c.Wg.Wait()
+	})
+
+	return nil
+}
+
+// sweepLoop periodically removes expired rate ticks from the cache, so rate
+// ticks of queries that are never repeated don't pile up.
+func (c *CachingPriceOracle) sweepLoop() {
+	defer c.Wg.Done()
+
+	sweepTicker := c.cfg.Clock.TickAfter(c.cfg.SweepInterval)
+	for {
+		select {
+		case <-sweepTicker:
+			c.mu.Lock()
+			c.sweepExpired()
+			c.mu.Unlock()
+
+			sweepTicker = c.cfg.Clock.TickAfter(
+				c.cfg.SweepInterval,
+			)
+
+		case <-c.Quit:
+			return
+		}
+	}
+}
+
+// sweepExpired removes all expired rate ticks from the cache.
+//
+// NOTE: The cache mutex must be held when calling this method.
+func (c *CachingPriceOracle) sweepExpired() {
+	now := c.cfg.Clock.Now()
+	for key, tick := range c.cache {
+		if !tick.Expiry.After(now) {
+			delete(c.cache, key)
+		}
+	}
+}
+
+// lookup returns the cached rate tick for the given key, if it is still valid
+// for at least the minimum tick lifetime.
+func (c *CachingPriceOracle) lookup(key rateTickCacheKey) (RateTick, bool) {
+	if c.cfg.DisableCache {
+		return RateTick{}, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	tick, ok := c.cache[key]
+	if !ok {
+		return RateTick{}, false
+	}
+
+	minExpiry := c.cfg.Clock.Now().Add(c.cfg.MinTickLifetime)
+	if tick.Expiry.Before(minExpiry) {
+		delete(c.cache, key)
+		return RateTick{}, false
+	}
+
+	return tick, true
+}
+
+// add caches the given rate tick, unless caching is disabled, prunes all
+// expired entries and persists the rate tick to the rate tick store, if one is
+// configured.
+func (c *CachingPriceOracle) add(ctx context.Context, key rateTickCacheKey,
+	tick RateTick) {
+
+	if !c.cfg.DisableCache {
+		c.mu.Lock()
+		c.sweepExpired()
+		c.cache[key] = tick
+		c.mu.Unlock()
+	}
+
+	if c.cfg.TickStore == nil {
+		return
+	}
+
+	// Failing to record the rate tick shouldn't cause the quote to fail,
+	// so we only log the error.
+	if err := c.cfg.TickStore.InsertRateTick(ctx, tick); err != nil {
+		log.Warnf("Unable to store %v rate tick: %v", tick.Type, err)
+	}
+}
+
+// QueryAskPrice returns the ask price for the given asset amount, either from
+// the cache or from the wrapped price oracle.
+func (c *CachingPriceOracle) QueryAskPrice(ctx context.Context,
+	assetId *asset.ID, assetGroupKey *btcec.PublicKey, assetAmount uint64,
+	suggestedBidPrice *lnwire.MilliSatoshi) (*OracleAskResponse, error) {
+
+	key := newRateTickCacheKey(
+		RateTickTypeAsk, assetId, assetGroupKey, assetAmount,
+		suggestedBidPrice,
+	)
+	if tick, ok := c.lookup(key); ok {
+		return &OracleAskResponse{
+			AskPrice: &tick.Rate,
+			Expiry:   uint64(tick.Expiry.Unix()),
+		}, nil
+	}
+
+	resp, err := c.cfg.Oracle.QueryAskPrice(
+		ctx, assetId, assetGroupKey, assetAmount, suggestedBidPrice,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Errors returned by the oracle service and responses without a price
+	// are never cached.
+	if resp.Err != nil || resp.AskPrice == nil {
+		return resp, nil
+	}
+
+	c.add(ctx, key, RateTick{
+		Type:          RateTickTypeAsk,
+		AssetID:       assetId,
+		AssetGroupKey: assetGroupKey,
+		AssetAmount:   assetAmount,
+		Rate:          *resp.AskPrice,
+		Expiry:        time.Unix(int64(resp.Expiry), 0),
+		Timestamp:     c.cfg.Clock.Now(),
+	})
+
+	return resp, nil
+}
+
+// QueryBidPrice returns the bid price for the given asset amount, either from
+// the cache or from the wrapped price oracle.
+func (c *CachingPriceOracle) QueryBidPrice(ctx context.Context,
+	assetId *asset.ID, assetGroupKey *btcec.PublicKey,
+	assetAmount uint64) (*OracleBidResponse, error) {
+
+	key := newRateTickCacheKey(
+		RateTickTypeBid, assetId, assetGroupKey, assetAmount, nil,
+	)
+	if tick, ok := c.lookup(key); ok {
+		return &OracleBidResponse{
+			BidPrice: &tick.Rate,
+			Expiry:   uint64(tick.Expiry.Unix()),
+		}, nil
+	}
+
+	resp, err := c.cfg.Oracle.QueryBidPrice(
+		ctx, assetId, assetGroupKey, assetAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Err != nil || resp.BidPrice == nil {
+		return resp, nil
+	}
+
+	c.add(ctx, key, RateTick{
+		Type:          RateTickTypeBid,
+		AssetID:       assetId,
+		AssetGroupKey: assetGroupKey,
+		AssetAmount:   assetAmount,
+		Rate:          *resp.BidPrice,
+		Expiry:        time.Unix(int64(resp.Expiry), 0),
+		Timestamp:     c.cfg.Clock.Now(),
+	})
+
+	return resp, nil
+}
+
+// Ensure that CachingPriceOracle implements the PriceOracle interface.
+var _ PriceOracle = (*CachingPriceOracle)(nil)
@@ -0,0 +1,226 @@
+package rfq
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// countingPriceOracle is a price oracle that returns a new rate for every
+// query and counts the number of queries.
+type countingPriceOracle struct {
+	clock  clock.Clock
+	expiry time.Duration
+
+	numQueries int
+}
+
+// nextRate returns the next rate and its expiry.
+func (c *countingPriceOracle) nextRate() (lnwire.MilliSatoshi, uint64) {
+	c.numQueries++
+	rate := lnwire.MilliSatoshi(1000 * c.numQueries)
+	expiry := c.clock.Now().Add(c.expiry).Unix()
+
+	return rate, uint64(expiry)
+}
+
+// QueryAskPrice returns a new ask price for every query.
+func (c *countingPriceOracle) QueryAskPrice(_ context.Context, _ *asset.ID,
+	_ *btcec.PublicKey, _ uint64,
+	_ *lnwire.MilliSatoshi) (*OracleAskResponse, error) {
+
+	rate, expiry := c.nextRate()
+	return &OracleAskResponse{
+		AskPrice: &rate,
+		Expiry:   expiry,
+	}, nil
+}
+
+// QueryBidPrice returns a new bid price for every query.
+func (c *countingPriceOracle) QueryBidPrice(_ context.Context, _ *asset.ID,
+	_ *btcec.PublicKey, _ uint64) (*OracleBidResponse, error) {
+
+	rate, expiry := c.nextRate()
+	return &OracleBidResponse{
+		BidPrice: &rate,
+		Expiry:   expiry,
+	}, nil
+}
+
+// mockRateTickStore is an in-memory rate tick store.
+type mockRateTickStore struct {
+	ticks []RateTick
+}
+
+// InsertRateTick stores a new rate tick.
+func (m *mockRateTickStore) InsertRateTick(_ context.Context,
+	tick RateTick) error {
+
+	m.ticks = append(m.ticks, tick)
+	return nil
+}
+
+// QueryRateTicks returns all stored rate ticks, most recent first.
+func (m *mockRateTickStore) QueryRateTicks(_ context.Context,
+	_ RateTickQuery) ([]RateTick, error) {
+
+	ticks := make([]RateTick, 0, len(m.ticks))
+	for i := len(m.ticks) - 1; i >= 0; i-- {
+		ticks = append(ticks, m.ticks[i])
+	}
+
+	return ticks, nil
+}
+
+// TestCachingPriceOracle tests that the caching price oracle serves rate
+// ticks from its cache until they're about to expire and records all rate
+// ticks received from the wrapped oracle.
+func TestCachingPriceOracle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testClock := clock.NewTestClock(time.Unix(1_700_000_000, 0))
+	oracle := &countingPriceOracle{
+		clock:  testClock,
+		expiry: 5 * time.Minute,
+	}
+	store := &mockRateTickStore{}
+	cachingOracle := NewCachingPriceOracle(CachingPriceOracleCfg{
+		Oracle:          oracle,
+		TickStore:       store,
+		Clock:           testClock,
+		MinTickLifetime: time.Minute,
+	})
+
+	assetID := asset.RandID(t)
+	groupKey := test.RandPubKey(t)
+
+	// The first query goes to the oracle, the second one is served from
+	// the cache.
+	resp, err := cachingOracle.QueryBidPrice(ctx, &assetID, nil, 100)
+	require.NoError(t, err)
+	require.EqualValues(t, 1000, *resp.BidPrice)
+
+	resp, err = cachingOracle.QueryBidPrice(ctx, &assetID, nil, 100)
+	require.NoError(t, err)
+	require.EqualValues(t, 1000, *resp.BidPrice)
+	require.Equal(t, 1, oracle.numQueries)
+
+	// A query for a different amount, asset or type is a cache miss.
+	_, err = cachingOracle.QueryBidPrice(ctx, &assetID, nil, 200)
+	require.NoError(t, err)
+	_, err = cachingOracle.QueryBidPrice(ctx, nil, groupKey, 100)
+	require.NoError(t, err)
+	askResp, err := cachingOracle.QueryAskPrice(
+		ctx, &assetID, nil, 100, nil,
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 4000, *askResp.AskPrice)
+	require.Equal(t, 4, oracle.numQueries)
+
+	// Once the cached tick is about to expire, the oracle is queried
+	// again.
+	testClock.SetTime(testClock.Now().Add(4*time.Minute + time.Second))
+	resp, err = cachingOracle.QueryBidPrice(ctx, &assetID, nil, 100)
+	require.NoError(t, err)
+	require.EqualValues(t, 5000, *resp.BidPrice)
+	require.Equal(t, 5, oracle.numQueries)
+
+	// Every rate tick received from the oracle should have been recorded.
+	require.Len(t, store.ticks, 5)
+	require.Equal(t, RateTickTypeAsk, store.ticks[3].Type)
+	require.Equal(t, groupKey, store.ticks[2].AssetGroupKey)
+
+	tick, err := RateTickAt(
+		ctx, store, &assetID, nil, RateTickTypeBid,
+		fn.Some[uint64](100), testClock.Now(),
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 5000, tick.Rate)
+}
+
+// TestCachingPriceOracleSweep tests that expired rate ticks are periodically
+// removed from the cache, even if their query is never repeated.
+func TestCachingPriceOracleSweep(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testClock := clock.NewTestClock(time.Unix(1_700_000_000, 0))
+	oracle := &countingPriceOracle{
+		clock:  testClock,
+		expiry: 5 * time.Minute,
+	}
+	cachingOracle := NewCachingPriceOracle(CachingPriceOracleCfg{
+		Oracle:          oracle,
+		Clock:           testClock,
+		MinTickLifetime: time.Minute,
+		SweepInterval:   time.Minute,
+	})
+	require.NoError(t, cachingOracle.Start())
+	t.Cleanup(func() {
+		require.NoError(t, cachingOracle.Stop())
+	})
+
+	numCached := func() int {
+		cachingOracle.mu.Lock()
+		defer cachingOracle.mu.Unlock()
+
+		return len(cachingOracle.cache)
+	}
+
+	assetID := asset.RandID(t)
+	_, err := cachingOracle.QueryBidPrice(ctx, &assetID, nil, 100)
+	require.NoError(t, err)
+	require.Equal(t, 1, numCached())
+
+	// Once the rate tick expired, the next sweep removes it. We keep
+	// advancing the clock, as the sweeper might not have waited for its
+	// next tick yet.
+	testClock.SetTime(testClock.Now().Add(5 * time.Minute))
+	require.Eventually(t, func() bool {
+		testClock.SetTime(testClock.Now().Add(time.Minute))
+		return numCached() == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+// TestCachingPriceOracleDisabled tests that every query is sent to the price
+// oracle if caching is disabled, while the rate ticks are still recorded.
+func TestCachingPriceOracleDisabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testClock := clock.NewTestClock(time.Unix(1_700_000_000, 0))
+	oracle := &countingPriceOracle{
+		clock:  testClock,
+		expiry: 5 * time.Minute,
+	}
+	store := &mockRateTickStore{}
+	cachingOracle := NewCachingPriceOracle(CachingPriceOracleCfg{
+		Oracle:          oracle,
+		TickStore:       store,
+		Clock:           testClock,
+		MinTickLifetime: time.Minute,
+		DisableCache:    true,
+	})
+
+	assetID := asset.RandID(t)
+	for i := 1; i <= 2; i++ {
+		resp, err := cachingOracle.QueryBidPrice(
+			ctx, &assetID, nil, 100,
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, 1000*i, *resp.BidPrice)
+	}
+
+	require.Equal(t, 2, oracle.numQueries)
+	require.Len(t, store.ticks, 2)
+	require.Empty(t, cachingOracle.cache)
+}
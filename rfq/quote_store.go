@@ -0,0 +1,320 @@
+package rfq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// ErrNoQuoteStore is returned when the quote history is queried but no
+	// quote store was configured.
+	ErrNoQuoteStore = errors.New("quote store not configured")
+)
+
+// QuoteType is the type of an accepted quote, seen from the perspective of
+// the node that requested the quote.
+type QuoteType uint8
+
+const (
+	// QuoteTypeBuy is the type of quote that was accepted in response to
+	// an asset buy request.
+	QuoteTypeBuy QuoteType = 0
+
+	// QuoteTypeSell is the type of quote that was accepted in response to
+	// an asset sell request.
+	QuoteTypeSell QuoteType = 1
+)
+
+// String returns a human-readable string representation of the quote type.
+func (t QuoteType) String() string {
+	switch t {
+	case QuoteTypeBuy:
+		return "buy"
+
+	case QuoteTypeSell:
+		return "sell"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(t))
+	}
+}
+
+// StoredQuote is an accepted quote as it is persisted in the quote store.
+type StoredQuote struct {
+	// ID is the unique identifier of the quote request.
+	ID rfqmsg.ID
+
+	// Peer is the counterparty of the quote.
+	Peer route.Vertex
+
+	// Type is the type of the quote.
+	Type QuoteType
+
+	// LocallyAccepted is true if the quote was requested by the peer and
+	// accepted by our node. If false, the quote was requested by our node
+	// and accepted by the peer.
+	LocallyAccepted bool
+
+	// AssetID is the ID of the subject asset of the quote.
+	AssetID *asset.ID
+
+	// AssetGroupKey is the group key of the subject asset of the quote.
+	AssetGroupKey *btcec.PublicKey
+
+	// AssetAmount is the asset amount of the quote request.
+	AssetAmount uint64
+
+	// RequestPrice is the price that was suggested in the quote request.
+	RequestPrice lnwire.MilliSatoshi
+
+	// AcceptedPrice is the price that was accepted.
+	AcceptedPrice lnwire.MilliSatoshi
+
+	// RequestVersion is the wire message data version of the request.
+	RequestVersion rfqmsg.WireMsgDataVersion
+
+	// AcceptVersion is the wire message data version of the accept.
+	AcceptVersion rfqmsg.WireMsgDataVersion
+
+	// Expiry is the unix timestamp after which the quote is no longer
+	// valid.
+	Expiry uint64
+
+	// BaseScid is the SCID of the channel the SCID alias of the quote was
+	// mapped to, if an alias was added for the quote.
+	BaseScid fn.Option[uint64]
+
+	// CreatedAt is the time the quote was accepted.
+	CreatedAt time.Time
+
+	// Signature is the signature of the accepting node over the quote. It
+	// is all zeros for quotes that were accepted without a signature.
+	Signature [64]byte
+}
+
+// Scid returns the SCID alias of the quote.
+func (q *StoredQuote) Scid() SerialisedScid {
+	return q.ID.Scid()
+}
+
+// NewStoredQuoteFromBuyAccept creates a stored quote from a buy accept
+// message.
+func NewStoredQuoteFromBuyAccept(accept rfqmsg.BuyAccept,
+	locallyAccepted bool, baseScid fn.Option[uint64]) *StoredQuote {
+
+	return &StoredQuote{
+		ID:              accept.ID,
+		Peer:            accept.Peer,
+		Type:            QuoteTypeBuy,
+		LocallyAccepted: locallyAccepted,
+		AssetID:         accept.Request.AssetID,
+		AssetGroupKey:   accept.Request.AssetGroupKey,
+		AssetAmount:     accept.Request.AssetAmount,
+		RequestPrice:    accept.Request.BidPrice,
+		AcceptedPrice:   accept.AskPrice,
+		RequestVersion:  accept.Request.Version,
+		AcceptVersion:   accept.Version,
+		Expiry:          accept.Expiry,
+		BaseScid:        baseScid,
+		CreatedAt:       time.Now().UTC(),
+		Signature:       accept.Sig(),
+	}
+}
+
+// NewStoredQuoteFromSellAccept creates a stored quote from a sell accept
+// message.
+func NewStoredQuoteFromSellAccept(accept rfqmsg.SellAccept,
+	locallyAccepted bool, baseScid fn.Option[uint64]) *StoredQuote {
+
+	return &StoredQuote{
+		ID:              accept.ID,
+		Peer:            accept.Peer,
+		Type:            QuoteTypeSell,
+		LocallyAccepted: locallyAccepted,
+		AssetID:         accept.Request.AssetID,
+		AssetGroupKey:   accept.Request.AssetGroupKey,
+		AssetAmount:     accept.Request.AssetAmount,
+		RequestPrice:    accept.Request.AskPrice,
+		AcceptedPrice:   accept.BidPrice,
+		RequestVersion:  accept.Request.Version,
+		AcceptVersion:   accept.Version,
+		Expiry:          accept.Expiry,
+		BaseScid:        baseScid,
+		CreatedAt:       time.Now().UTC(),
+		Signature:       accept.Sig(),
+	}
+}
+
+// BuyAccept converts a stored buy quote back into a buy accept message.
+func (q *StoredQuote) BuyAccept() (*rfqmsg.BuyAccept, error) {
+	if q.Type != QuoteTypeBuy {
+		return nil, fmt.Errorf("quote %x is a %v quote, not a buy "+
+			"quote", q.ID[:], q.Type)
+	}
+
+	accept := &rfqmsg.BuyAccept{
+		Peer: q.Peer,
+		Request: rfqmsg.BuyRequest{
+			Peer:          q.Peer,
+			Version:       q.RequestVersion,
+			ID:            q.ID,
+			AssetID:       q.AssetID,
+			AssetGroupKey: q.AssetGroupKey,
+			AssetAmount:   q.AssetAmount,
+			BidPrice:      q.RequestPrice,
+		},
+		Version:  q.AcceptVersion,
+		ID:       q.ID,
+		AskPrice: q.AcceptedPrice,
+		Expiry:   q.Expiry,
+	}
+	accept.SetSig(q.Signature)
+
+	return accept, nil
+}
+
+// SellAccept converts a stored sell quote back into a sell accept message.
+func (q *StoredQuote) SellAccept() (*rfqmsg.SellAccept, error) {
+	if q.Type != QuoteTypeSell {
+		return nil, fmt.Errorf("quote %x is a %v quote, not a sell "+
+			"quote", q.ID[:], q.Type)
+	}
+
+	accept := &rfqmsg.SellAccept{
+		Peer: q.Peer,
+		Request: rfqmsg.SellRequest{
+			Peer:          q.Peer,
+			Version:       q.RequestVersion,
+			ID:            q.ID,
+			AssetID:       q.AssetID,
+			AssetGroupKey: q.AssetGroupKey,
+			AssetAmount:   q.AssetAmount,
+			AskPrice:      q.RequestPrice,
+		},
+		Version:  q.AcceptVersion,
+		ID:       q.ID,
+		BidPrice: q.AcceptedPrice,
+		Expiry:   q.Expiry,
+	}
+	accept.SetSig(q.Signature)
+
+	return accept, nil
+}
+
+// SigMsg returns the message the accepting node signed for the quote.
+func (q *StoredQuote) SigMsg() ([]byte, error) {
+	switch q.Type {
+	case QuoteTypeBuy:
+		accept, err := q.BuyAccept()
+		if err != nil {
+			return nil, err
+		}
+
+		return accept.SigMsg(), nil
+
+	case QuoteTypeSell:
+		accept, err := q.SellAccept()
+		if err != nil {
+			return nil, err
+		}
+
+		return accept.SigMsg(), nil
+
+	default:
+		return nil, fmt.Errorf("unknown quote type %v", q.Type)
+	}
+}
+
+// HtlcFill is an HTLC that was accepted by the order handler against a quote.
+type HtlcFill struct {
+	// QuoteID is the ID of the quote the HTLC was accepted against.
+	QuoteID rfqmsg.ID
+
+	// IncomingChanID is the SCID of the channel the HTLC came in through.
+	IncomingChanID lnwire.ShortChannelID
+
+	// IncomingHtlcID is the ID of the HTLC on the incoming channel.
+	IncomingHtlcID uint64
+
+	// OutgoingChanID is the SCID of the channel the HTLC is forwarded to.
+	OutgoingChanID lnwire.ShortChannelID
+
+	// AmountIn is the incoming amount of the HTLC.
+	AmountIn lnwire.MilliSatoshi
+
+	// AmountOut is the outgoing amount of the HTLC.
+	AmountOut lnwire.MilliSatoshi
+
+	// Timestamp is the time the HTLC was accepted.
+	Timestamp time.Time
+}
+
+// QuoteQuery is a query for the history of accepted quotes.
+type QuoteQuery struct {
+	// Peer is an optional peer to filter the quotes by.
+	Peer *route.Vertex
+
+	// AssetID is an optional asset ID to filter the quotes by.
+	AssetID *asset.ID
+
+	// AssetGroupKey is an optional group key to filter the quotes by.
+	AssetGroupKey *btcec.PublicKey
+
+	// StartTime is the earliest time (inclusive) a returned quote was
+	// accepted. If zero, no lower bound is applied.
+	StartTime time.Time
+
+	// EndTime is the latest time (inclusive) a returned quote was
+	// accepted. If zero, no upper bound is applied.
+	EndTime time.Time
+
+	// Limit is the maximum number of quotes to return. If zero, a default
+	// limit is applied.
+	Limit int32
+
+	// Offset is the number of quotes to skip.
+	Offset int32
+}
+
+// QuoteStore is an interface for a store that persists accepted quotes and
+// the HTLCs that were accepted against them, so they survive a restart.
+type QuoteStore interface {
+	// InsertQuote stores a new accepted quote. Inserting a quote that
+	// already exists is a no-op.
+	InsertQuote(ctx context.Context, quote StoredQuote) error
+
+	// ActiveQuotes returns all quotes that haven't expired at the given
+	// time, ordered by the time they were accepted.
+	ActiveQuotes(ctx context.Context, now time.Time) ([]StoredQuote,
+		error)
+
+	// ExpiredAliasQuotes returns all quotes that have expired at the given
+	// time and for which an SCID alias was added that wasn't yet removed.
+	ExpiredAliasQuotes(ctx context.Context,
+		now time.Time) ([]StoredQuote, error)
+
+	// MarkAliasDeleted marks the SCID alias of the given quote as removed.
+	MarkAliasDeleted(ctx context.Context, id rfqmsg.ID) error
+
+	// InsertHtlcFill stores an HTLC that was accepted against a quote.
+	// Inserting the same HTLC twice is a no-op.
+	InsertHtlcFill(ctx context.Context, fill HtlcFill) error
+
+	// QueryQuotes returns the quotes that match the given query, ordered
+	// from the most recent to the oldest.
+	QueryQuotes(ctx context.Context, query QuoteQuery) ([]StoredQuote,
+		error)
+
+	// QueryHtlcFills returns the HTLCs that were accepted against the
+	// given quote, ordered by the time they were accepted.
+	QueryHtlcFills(ctx context.Context, id rfqmsg.ID) ([]HtlcFill, error)
+}
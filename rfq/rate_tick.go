@@ -0,0 +1,154 @@
+package rfq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrNoRateTickStore is returned when rate ticks are queried but no
+	// rate tick store was configured.
+	ErrNoRateTickStore = errors.New("rate tick store not configured")
+)
+
+// RateTickType is the type of rate tick, which is either an ask or a bid
+// price returned by the price oracle.
+type RateTickType uint8
+
+const (
+	// RateTickTypeAsk is the type of rate tick that represents an ask
+	// price.
+	RateTickTypeAsk RateTickType = 0
+
+	// RateTickTypeBid is the type of rate tick that represents a bid
+	// price.
+	RateTickTypeBid RateTickType = 1
+)
+
+// String returns a human-readable string representation of the rate tick
+// type.
+func (t RateTickType) String() string {
+	switch t {
+	case RateTickTypeAsk:
+		return "ask"
+
+	case RateTickTypeBid:
+		return "bid"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(t))
+	}
+}
+
+// RateTick is a single price returned by the price oracle for an asset.
+type RateTick struct {
+	// Type is the type of the rate tick.
+	Type RateTickType
+
+	// AssetID is the ID of the asset the rate tick is for. Either the
+	// asset ID or the group key (or both) must be set.
+	AssetID *asset.ID
+
+	// AssetGroupKey is the group key of the asset the rate tick is for.
+	AssetGroupKey *btcec.PublicKey
+
+	// AssetAmount is the asset amount the price oracle was queried for.
+	AssetAmount uint64
+
+	// Rate is the price in milli-satoshi per asset unit.
+	Rate lnwire.MilliSatoshi
+
+	// Expiry is the time after which the rate tick is no longer valid.
+	Expiry time.Time
+
+	// Timestamp is the time the rate tick was received from the price
+	// oracle.
+	Timestamp time.Time
+}
+
+// IsValidAt returns true if the rate tick was already received and not yet
+// expired at the given time.
+func (r *RateTick) IsValidAt(t time.Time) bool {
+	return !t.Before(r.Timestamp) && t.Before(r.Expiry)
+}
+
+// RateTickQuery is a query for past rate ticks.
+type RateTickQuery struct {
+	// AssetID is an optional asset ID to filter the rate ticks by.
+	AssetID *asset.ID
+
+	// AssetGroupKey is an optional group key to filter the rate ticks by.
+	AssetGroupKey *btcec.PublicKey
+
+	// Type is an optional rate tick type to filter the rate ticks by.
+	Type fn.Option[RateTickType]
+
+	// AssetAmount is an optional asset amount the price oracle was queried
+	// for to filter the rate ticks by.
+	AssetAmount fn.Option[uint64]
+
+	// StartTime is the earliest time (inclusive) a returned rate tick was
+	// received. If zero, no lower bound is applied.
+	StartTime time.Time
+
+	// EndTime is the latest time (inclusive) a returned rate tick was
+	// received. If zero, no upper bound is applied.
+	EndTime time.Time
+
+	// Limit is the maximum number of rate ticks to return. If zero, a
+	// default limit is applied.
+	Limit int32
+}
+
+// RateTickStore is an interface for a store that keeps a history of the rate
+// ticks received from the price oracle.
+type RateTickStore interface {
+	// InsertRateTick stores a new rate tick.
+	InsertRateTick(ctx context.Context, tick RateTick) error
+
+	// QueryRateTicks returns the rate ticks that match the given query,
+	// ordered from the most recent to the oldest.
+	QueryRateTicks(ctx context.Context,
+		query RateTickQuery) ([]RateTick, error)
+}
+
+// RateTickAt returns the most recent rate tick of the given type for an asset
+// that was valid at the given time. This can be used to audit an accepted
+// quote or to recompute a fill at the rate that was quoted at the time. The
+// price oracle can return different rates for different asset amounts, so if
+// an asset amount is given, only the rate ticks of queries for that amount are
+// considered.
+func RateTickAt(ctx context.Context, store RateTickStore, assetID *asset.ID,
+	groupKey *btcec.PublicKey, tickType RateTickType,
+	assetAmount fn.Option[uint64], at time.Time) (*RateTick, error) {
+
+	if store == nil {
+		return nil, ErrNoRateTickStore
+	}
+
+	ticks, err := store.QueryRateTicks(ctx, RateTickQuery{
+		AssetID:       assetID,
+		AssetGroupKey: groupKey,
+		Type:          fn.Some(tickType),
+		AssetAmount:   assetAmount,
+		EndTime:       at,
+		Limit:         1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ticks) == 0 || !ticks[0].IsValidAt(at) {
+		return nil, fmt.Errorf("no valid %v rate tick found at %v",
+			tickType, at)
+	}
+
+	return &ticks[0], nil
+}
@@ -12,7 +12,7 @@ import (
 const (
 	// latestAcceptWireMsgDataVersion is the latest supported quote accept
 	// wire message data field version.
-	latestAcceptWireMsgDataVersion = V0
+	latestAcceptWireMsgDataVersion = V1
 )
 
 type (
@@ -11,7 +11,7 @@ import (
 const (
 	// latestBuyAcceptVersion is the latest supported buy accept wire
 	// message data field version.
-	latestBuyAcceptVersion = V0
+	latestBuyAcceptVersion = V1
 )
 
 // BuyAccept is a struct that represents a buy quote request accept message.
@@ -46,10 +46,14 @@ type BuyAccept struct {
 func NewBuyAcceptFromRequest(request BuyRequest, askPrice lnwire.MilliSatoshi,
 	expiry uint64) *BuyAccept {
 
+	// Respond with the latest accept message version that is supported by
+	// both us and the requesting peer.
+	version := min(request.MaxAcceptVersion, latestBuyAcceptVersion)
+
 	return &BuyAccept{
 		Peer:     request.Peer,
 		Request:  request,
-		Version:  latestBuyAcceptVersion,
+		Version:  version,
 		ID:       request.ID,
 		AskPrice: askPrice,
 		Expiry:   expiry,
@@ -92,10 +96,34 @@ func (q *BuyAccept) ShortChannelId() SerialisedScid {
 	return q.ID.Scid()
 }
 
-// ToWire returns a wire message with a serialized data field.
-//
-// TODO(ffranr): This method should accept a signer so that we can generate a
-// signature over the message data.
+// SigMsg returns the message that is signed by the node that accepts the
+// quote. It commits to the accepted price and expiry as well as to the terms of
+// the quote request.
+func (q *BuyAccept) SigMsg() []byte {
+	return acceptSigMsg(
+		acceptSigQuoteTypeBuy, q.ID, q.Expiry, q.AskPrice,
+		q.Request.AssetID, q.Request.AssetGroupKey,
+		q.Request.AssetAmount, q.Request.BidPrice,
+	)
+}
+
+// Sig returns the signature of the accepting node over the quote.
+func (q *BuyAccept) Sig() [64]byte {
+	return q.sig
+}
+
+// SetSig sets the signature of the accepting node over the quote.
+func (q *BuyAccept) SetSig(sig [64]byte) {
+	q.sig = sig
+}
+
+// VerifySig verifies that the quote was signed by the given node.
+func (q *BuyAccept) VerifySig(signer route.Vertex) error {
+	return VerifyAcceptSig(q.SigMsg(), q.sig, signer)
+}
+
+// ToWire returns a wire message with a serialized data field. The signature
+// must be set with SetSig before the message is sent.
 func (q *BuyAccept) ToWire() (WireMessage, error) {
 	if q == nil {
 		return WireMessage{}, fmt.Errorf("cannot serialize nil buy " +
@@ -42,6 +42,11 @@ type BuyRequest struct {
 
 	// BidPrice is the peer's proposed bid price for the asset amount.
 	BidPrice lnwire.MilliSatoshi
+
+	// MaxAcceptVersion is the latest quote accept message version that the
+	// requesting peer supports. The accepting peer responds with the
+	// latest version supported by both peers.
+	MaxAcceptVersion WireMsgDataVersion
 }
 
 // NewBuyRequest creates a new asset buy quote request.
@@ -64,6 +69,8 @@ func NewBuyRequest(peer route.Vertex, assetID *asset.ID,
 		AssetGroupKey: assetGroupKey,
 		AssetAmount:   assetAmount,
 		BidPrice:      bidPrice,
+
+		MaxAcceptVersion: latestAcceptWireMsgDataVersion,
 	}, nil
 }
 
@@ -115,6 +122,8 @@ func NewBuyRequestMsgFromWire(wireMsg WireMessage,
 		AssetGroupKey: assetGroupKey,
 		AssetAmount:   msgData.AssetMaxAmount.Val,
 		BidPrice:      bidPrice,
+
+		MaxAcceptVersion: msgData.maxAcceptVersion(),
 	}
 
 	// Perform basic sanity checks on the quote request.
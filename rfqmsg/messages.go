@@ -111,6 +111,9 @@ type WireMsgDataVersion uint8
 const (
 	// V0 represents version 0 of the contents in a wire message data field.
 	V0 WireMsgDataVersion = 0
+
+	// V1 represents version 1 of the contents in a wire message data field.
+	V1 WireMsgDataVersion = 1
 )
 
 // Record returns a TLV record that can be used to encode/decode a
@@ -45,6 +45,12 @@ type (
 	requestOutAssetGroupKey = tlv.OptionalRecordT[
 		tlv.TlvType8, *btcec.PublicKey,
 	]
+
+	// requestMaxAcceptVersion is a type alias for a record that represents
+	// the latest quote accept message version the requesting peer supports.
+	requestMaxAcceptVersion = tlv.OptionalRecordT[
+		tlv.TlvType9, WireMsgDataVersion,
+	]
 )
 
 // requestWireMsgData is a struct that represents the message data field for
@@ -95,6 +101,34 @@ type requestWireMsgData struct {
 	// outbound to the requesting peer (therefore inbound to the
 	// counterparty peer).
 	OutAssetGroupKey requestOutAssetGroupKey
+
+	// MaxAcceptVersion is the latest quote accept message version that the
+	// requesting peer supports. Peers that don't set this field only
+	// support V0 accept messages.
+	//
+	// NOTE: This field is optional.
+	MaxAcceptVersion requestMaxAcceptVersion
+}
+
+// newRequestMaxAcceptVersion creates the optional max accept version record
+// for the given version. The record is omitted for V0, which is the version
+// peers support that don't set the record at all.
+func newRequestMaxAcceptVersion(
+	version WireMsgDataVersion) requestMaxAcceptVersion {
+
+	if version == V0 {
+		return requestMaxAcceptVersion{}
+	}
+
+	return tlv.SomeRecordT[tlv.TlvType9](
+		tlv.NewRecordT[tlv.TlvType9](version),
+	)
+}
+
+// maxAcceptVersion returns the latest quote accept message version that the
+// requesting peer supports.
+func (m *requestWireMsgData) maxAcceptVersion() WireMsgDataVersion {
+	return m.MaxAcceptVersion.ValOpt().UnwrapOr(V0)
 }
 
 // newRequestWireMsgDataFromBuy creates a new requestWireMsgData from a buy
@@ -137,6 +171,8 @@ func newRequestWireMsgDataFromBuy(q BuyRequest) requestWireMsgData {
 		)
 	}
 
+	maxAcceptVersion := newRequestMaxAcceptVersion(q.MaxAcceptVersion)
+
 	// Use a zero asset ID for the outbound asset ID. This indicates that
 	// the outbound asset is BTC.
 	var zeroOutAssetID asset.ID
@@ -157,6 +193,7 @@ func newRequestWireMsgDataFromBuy(q BuyRequest) requestWireMsgData {
 		InAssetGroupKey:   inAssetGroupKey,
 		OutAssetID:        outAssetID,
 		OutAssetGroupKey:  outAssetGroupKey,
+		MaxAcceptVersion:  maxAcceptVersion,
 	}
 }
 
@@ -199,6 +236,8 @@ func newRequestWireMsgDataFromSell(q SellRequest) requestWireMsgData {
 		)
 	}
 
+	maxAcceptVersion := newRequestMaxAcceptVersion(q.MaxAcceptVersion)
+
 	outAssetGroupKey := requestOutAssetGroupKey{}
 	if q.AssetGroupKey != nil {
 		outAssetGroupKey = tlv.SomeRecordT[tlv.TlvType8](
@@ -218,6 +257,7 @@ func newRequestWireMsgDataFromSell(q SellRequest) requestWireMsgData {
 		InAssetID:         inAssetID,
 		OutAssetID:        outAssetID,
 		OutAssetGroupKey:  outAssetGroupKey,
+		MaxAcceptVersion:  maxAcceptVersion,
 	}
 }
 
@@ -327,6 +367,12 @@ func (m *requestWireMsgData) Encode(w io.Writer) error {
 		},
 	)
 
+	m.MaxAcceptVersion.WhenSome(
+		func(r tlv.RecordT[tlv.TlvType9, WireMsgDataVersion]) {
+			records = append(records, r.Record())
+		},
+	)
+
 	tlv.SortRecords(records)
 
 	// Create the tlv stream.
@@ -349,6 +395,8 @@ func (m *requestWireMsgData) Decode(r io.Reader) error {
 	outAssetID := m.OutAssetID.Zero()
 	outAssetGroupKey := m.OutAssetGroupKey.Zero()
 
+	maxAcceptVersion := m.MaxAcceptVersion.Zero()
+
 	// Create a tlv stream with all the fields.
 	tlvStream, err := tlv.NewStream(
 		m.Version.Record(),
@@ -363,6 +411,8 @@ func (m *requestWireMsgData) Decode(r io.Reader) error {
 
 		outAssetID.Record(),
 		outAssetGroupKey.Record(),
+
+		maxAcceptVersion.Record(),
 	)
 	if err != nil {
 		return err
@@ -393,6 +443,10 @@ func (m *requestWireMsgData) Decode(r io.Reader) error {
 		m.OutAssetGroupKey = tlv.SomeRecordT(outAssetGroupKey)
 	}
 
+	if _, ok := tlvMap[maxAcceptVersion.TlvType()]; ok {
+		m.MaxAcceptVersion = tlv.SomeRecordT(maxAcceptVersion)
+	}
+
 	return nil
 }
 
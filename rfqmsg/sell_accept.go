@@ -12,7 +12,7 @@ import (
 const (
 	// latestSellAcceptVersion is the latest supported sell accept wire
 	// message data field version.
-	latestSellAcceptVersion = V0
+	latestSellAcceptVersion = V1
 )
 
 // SellAccept is a struct that represents a sell quote request accept message.
@@ -47,10 +47,14 @@ type SellAccept struct {
 func NewSellAcceptFromRequest(request SellRequest, bidPrice lnwire.MilliSatoshi,
 	expiry uint64) *SellAccept {
 
+	// Respond with the latest accept message version that is supported by
+	// both us and the requesting peer.
+	version := min(request.MaxAcceptVersion, latestSellAcceptVersion)
+
 	return &SellAccept{
 		Peer:     request.Peer,
 		Request:  request,
-		Version:  latestSellAcceptVersion,
+		Version:  version,
 		ID:       request.ID,
 		BidPrice: bidPrice,
 		Expiry:   expiry,
@@ -103,10 +107,34 @@ func (q *SellAccept) ShortChannelId() SerialisedScid {
 	return SerialisedScid(scidInteger)
 }
 
-// ToWire returns a wire message with a serialized data field.
-//
-// TODO(ffranr): This method should accept a signer so that we can generate a
-// signature over the message data.
+// SigMsg returns the message that is signed by the node that accepts the
+// quote. It commits to the accepted price and expiry as well as to the terms of
+// the quote request.
+func (q *SellAccept) SigMsg() []byte {
+	return acceptSigMsg(
+		acceptSigQuoteTypeSell, q.ID, q.Expiry, q.BidPrice,
+		q.Request.AssetID, q.Request.AssetGroupKey,
+		q.Request.AssetAmount, q.Request.AskPrice,
+	)
+}
+
+// Sig returns the signature of the accepting node over the quote.
+func (q *SellAccept) Sig() [64]byte {
+	return q.sig
+}
+
+// SetSig sets the signature of the accepting node over the quote.
+func (q *SellAccept) SetSig(sig [64]byte) {
+	q.sig = sig
+}
+
+// VerifySig verifies that the quote was signed by the given node.
+func (q *SellAccept) VerifySig(signer route.Vertex) error {
+	return VerifyAcceptSig(q.SigMsg(), q.sig, signer)
+}
+
+// ToWire returns a wire message with a serialized data field. The signature
+// must be set with SetSig before the message is sent.
 func (q *SellAccept) ToWire() (WireMessage, error) {
 	if q == nil {
 		return WireMessage{}, fmt.Errorf("cannot serialize nil sell " +
@@ -46,6 +46,11 @@ type SellRequest struct {
 	AskPrice lnwire.MilliSatoshi
 
 	// TODO(ffranr): Add expiry time for suggested ask price.
+
+	// MaxAcceptVersion is the latest quote accept message version that the
+	// requesting peer supports. The accepting peer responds with the
+	// latest version supported by both peers.
+	MaxAcceptVersion WireMsgDataVersion
 }
 
 // NewSellRequest creates a new asset sell quote request.
@@ -67,6 +72,8 @@ func NewSellRequest(peer route.Vertex, assetID *asset.ID,
 		AssetGroupKey: assetGroupKey,
 		AssetAmount:   assetAmount,
 		AskPrice:      askPrice,
+
+		MaxAcceptVersion: latestAcceptWireMsgDataVersion,
 	}, nil
 }
 
@@ -121,6 +128,8 @@ func NewSellRequestMsgFromWire(wireMsg WireMessage,
 		AssetGroupKey: assetGroupKey,
 		AssetAmount:   msgData.AssetMaxAmount.Val,
 		AskPrice:      askPrice,
+
+		MaxAcceptVersion: msgData.maxAcceptVersion(),
 	}
 
 	// Perform basic sanity checks on the quote request.
@@ -0,0 +1,121 @@
+package rfqmsg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// ErrAcceptSigMissing is returned when a quote accept message that
+	// should be signed doesn't carry a signature.
+	ErrAcceptSigMissing = errors.New("quote accept signature missing")
+
+	// ErrAcceptSigInvalid is returned when the signature of a quote accept
+	// message doesn't verify against the accepting node's identity key.
+	ErrAcceptSigInvalid = errors.New("quote accept signature invalid")
+
+	// acceptSigMsgTag is prepended to the message a quote accept signature
+	// commits to. This makes sure a signature over a quote can't be
+	// mistaken for a signature over any other message signed by the node
+	// key.
+	acceptSigMsgTag = []byte("taproot-assets/rfq/accept")
+)
+
+// AcceptSigRequired returns true if a quote accept message of the given
+// version must be signed by the accepting node. Peers that don't support
+// signed quotes respond with a V0 accept message, which we can't require a
+// signature for.
+func AcceptSigRequired(version WireMsgDataVersion) bool {
+	return version >= V1
+}
+
+// acceptSigQuoteType identifies the type of quote a signature commits to.
+type acceptSigQuoteType uint8
+
+const (
+	// acceptSigQuoteTypeBuy is the quote type of a buy accept message.
+	acceptSigQuoteTypeBuy acceptSigQuoteType = 0
+
+	// acceptSigQuoteTypeSell is the quote type of a sell accept message.
+	acceptSigQuoteTypeSell acceptSigQuoteType = 1
+)
+
+// acceptSigMsg serializes the terms of an accepted quote into the message that
+// is signed by the accepting node. Besides the accepted price and expiry, the
+// message commits to the terms of the quote request, so a signed quote can be
+// verified by a third party without access to the request.
+func acceptSigMsg(quoteType acceptSigQuoteType, id ID, expiry uint64,
+	acceptedPrice lnwire.MilliSatoshi, assetID *asset.ID,
+	groupKey *btcec.PublicKey, assetAmount uint64,
+	requestPrice lnwire.MilliSatoshi) []byte {
+
+	var (
+		b   bytes.Buffer
+		num [8]byte
+	)
+	writeUint64 := func(v uint64) {
+		binary.BigEndian.PutUint64(num[:], v)
+		b.Write(num[:])
+	}
+
+	b.Write(acceptSigMsgTag)
+	b.WriteByte(byte(quoteType))
+	b.Write(id[:])
+	writeUint64(expiry)
+	writeUint64(uint64(acceptedPrice))
+
+	// Absent asset specifier fields are committed to as all zeros, so the
+	// message always has the same length.
+	var (
+		assetIDBytes  asset.ID
+		groupKeyBytes [btcec.PubKeyBytesLenCompressed]byte
+	)
+	if assetID != nil {
+		assetIDBytes = *assetID
+	}
+	if groupKey != nil {
+		copy(groupKeyBytes[:], groupKey.SerializeCompressed())
+	}
+	b.Write(assetIDBytes[:])
+	b.Write(groupKeyBytes[:])
+
+	writeUint64(assetAmount)
+	writeUint64(uint64(requestPrice))
+
+	return b.Bytes()
+}
+
+// VerifyAcceptSig verifies that the given signature is a valid BIP-340
+// Schnorr signature by the given node over the SHA256 hash of the given quote
+// accept signature message.
+func VerifyAcceptSig(msg []byte, sig [64]byte, signer route.Vertex) error {
+	if sig == [64]byte{} {
+		return ErrAcceptSigMissing
+	}
+
+	pubKey, err := btcec.ParsePubKey(signer[:])
+	if err != nil {
+		return fmt.Errorf("unable to parse signer public key: %w", err)
+	}
+
+	schnorrSig, err := schnorr.ParseSignature(sig[:])
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrAcceptSigInvalid, err)
+	}
+
+	digest := chainhash.HashB(msg)
+	if !schnorrSig.Verify(digest, pubKey) {
+		return ErrAcceptSigInvalid
+	}
+
+	return nil
+}
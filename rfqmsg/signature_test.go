@@ -0,0 +1,115 @@
+package rfqmsg
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAcceptSig tests that quote accept messages can be signed and that the
+// signature commits to the terms of the quote.
+func TestAcceptSig(t *testing.T) {
+	t.Parallel()
+
+	signerKey := test.RandPrivKey(t)
+	var signer, otherPeer route.Vertex
+	copy(signer[:], signerKey.PubKey().SerializeCompressed())
+	copy(otherPeer[:], test.RandPubKey(t).SerializeCompressed())
+
+	sign := func(msg []byte) [64]byte {
+		sig, err := schnorr.Sign(signerKey, chainhash.HashB(msg))
+		require.NoError(t, err)
+
+		var sigBytes [64]byte
+		copy(sigBytes[:], sig.Serialize())
+
+		return sigBytes
+	}
+
+	assetID := asset.RandID(t)
+	buyReq, err := NewBuyRequest(signer, &assetID, nil, 100, 2000)
+	require.NoError(t, err)
+	sellReq, err := NewSellRequest(signer, &assetID, nil, 100, 2000)
+	require.NoError(t, err)
+
+	buyAccept := NewBuyAcceptFromRequest(*buyReq, 1000, 1_700_000_000)
+	sellAccept := NewSellAcceptFromRequest(*sellReq, 1000, 1_700_000_000)
+
+	// Unsigned messages are rejected.
+	require.ErrorIs(t, buyAccept.VerifySig(signer), ErrAcceptSigMissing)
+	require.ErrorIs(t, sellAccept.VerifySig(signer), ErrAcceptSigMissing)
+
+	// A buy and a sell quote with the same terms must not have the same
+	// signature message.
+	require.NotEqual(t, buyAccept.SigMsg(), sellAccept.SigMsg())
+
+	buyAccept.SetSig(sign(buyAccept.SigMsg()))
+	sellAccept.SetSig(sign(sellAccept.SigMsg()))
+
+	require.NoError(t, buyAccept.VerifySig(signer))
+	require.NoError(t, sellAccept.VerifySig(signer))
+
+	// The signature of another node doesn't verify.
+	require.ErrorIs(t, buyAccept.VerifySig(otherPeer), ErrAcceptSigInvalid)
+	require.ErrorIs(
+		t, sellAccept.VerifySig(otherPeer), ErrAcceptSigInvalid,
+	)
+
+	// Changing the terms of the quote invalidates the signature.
+	buyAccept.AskPrice++
+	require.ErrorIs(t, buyAccept.VerifySig(signer), ErrAcceptSigInvalid)
+
+	sellAccept.Request.AssetAmount++
+	require.ErrorIs(t, sellAccept.VerifySig(signer), ErrAcceptSigInvalid)
+}
+
+// TestAcceptVersionNegotiation tests that the accepting peer responds with the
+// latest quote accept message version supported by the requesting peer.
+func TestAcceptVersionNegotiation(t *testing.T) {
+	t.Parallel()
+
+	peer := route.NewVertex(test.RandPubKey(t))
+	assetID := asset.RandID(t)
+
+	// decodeRequest encodes the given request to a wire message and
+	// decodes it again, just like the accepting peer would.
+	decodeRequest := func(req *BuyRequest) *BuyRequest {
+		wireMsg, err := req.ToWire()
+		require.NoError(t, err)
+
+		incoming, err := NewIncomingRequestFromWire(wireMsg)
+		require.NoError(t, err)
+		require.IsType(t, &BuyRequest{}, incoming)
+
+		return incoming.(*BuyRequest)
+	}
+
+	// A request of a peer that supports signed quotes results in a signed
+	// accept message version.
+	req, err := NewBuyRequest(peer, &assetID, nil, 100, 0)
+	require.NoError(t, err)
+
+	decoded := decodeRequest(req)
+	require.Equal(t, latestAcceptWireMsgDataVersion,
+		decoded.MaxAcceptVersion)
+
+	accept := NewBuyAcceptFromRequest(*decoded, 1000, 1_700_000_000)
+	require.Equal(t, V1, accept.Version)
+	require.True(t, AcceptSigRequired(accept.Version))
+
+	// Requests of older peers don't carry the max accept version, so they
+	// get an accept message they can decode, which isn't required to be
+	// signed.
+	req.MaxAcceptVersion = V0
+	decoded = decodeRequest(req)
+	require.Equal(t, V0, decoded.MaxAcceptVersion)
+
+	accept = NewBuyAcceptFromRequest(*decoded, 1000, 1_700_000_000)
+	require.Equal(t, V0, accept.Version)
+	require.False(t, AcceptSigRequired(accept.Version))
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"strings"
 	"sync"
@@ -19,6 +20,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcwallet/wtxmgr"
 	"github.com/davecgh/go-spew/spew"
@@ -34,6 +36,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/rfqmsg"
 	"github.com/lightninglabs/taproot-assets/rpcperms"
 	"github.com/lightninglabs/taproot-assets/tapchannel"
+	"github.com/lightninglabs/taproot-assets/tapdb"
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/tappsbt"
@@ -52,6 +55,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/verrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
@@ -85,6 +89,13 @@ var (
 			"signrpc", "walletrpc", "chainrpc", "invoicesrpc",
 		},
 	}
+
+	// errChannelFeaturesDisabled is returned by the Taproot Asset channel
+	// RPCs if tapd isn't running inside litd.
+	errChannelFeaturesDisabled = errors.New("the Taproot Asset channel " +
+		"functionality is only available when running inside " +
+		"Lightning Terminal daemon (litd), with lnd and tapd both " +
+		"running in 'integrated' mode")
 )
 
 const (
@@ -595,6 +606,18 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 		groupTapscriptRoot = bytes.Clone(req.Asset.GroupTapscriptRoot)
 	}
 
+	// The target batch is either given by its batch key, or a new batch is
+	// requested, but not both.
+	if len(req.BatchKey) != 0 && req.NewBatch {
+		return nil, fmt.Errorf("cannot specify both batch_key and " +
+			"new_batch")
+	}
+
+	batchKey, err := parseBatchKey(req.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
 	seedling := &tapgarden.Seedling{
 		AssetVersion:   assetVersion,
 		AssetType:      asset.Type(req.Asset.AssetType),
@@ -602,6 +625,8 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 		Amount:         req.Asset.Amount,
 		EnableEmission: req.Asset.NewGroupedAsset,
 		Meta:           seedlingMeta,
+		BatchKey:       batchKey,
+		NewBatch:       req.NewBatch,
 	}
 
 	rpcsLog.Infof("[MintAsset]: version=%v, type=%v, name=%v, amt=%v, "+
@@ -698,10 +723,93 @@ func checkFeeRateSanity(rpcFeeRate uint32) (*chainfee.SatPerKWeight, error) {
 	}
 }
 
-// FundBatch attempts to fund the current pending batch.
+// unmarshalCoinSelectStrategy parses a coin selection strategy from the RPC
+// variant.
+func unmarshalCoinSelectStrategy(strategy taprpc.CoinSelectStrategy) (
+	tapfreighter.MultiCommitmentSelectStrategy, error) {
+
+	switch strategy {
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_MAX_AMOUNT:
+		return tapfreighter.PreferMaxAmount, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_MIN_AMOUNT:
+		return tapfreighter.PreferMinAmount, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_EXACT_MATCH:
+		return tapfreighter.PreferExactMatch, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_SHORT_PROOF_CHAIN:
+		return tapfreighter.PreferShortProofChain, nil
+
+	default:
+		return 0, fmt.Errorf("unknown coin select strategy: %v",
+			strategy)
+	}
+}
+
+// unmarshalFundOptions parses the coin selection strategy and the pinned
+// inputs of a funding request into the options for the asset wallet.
+func unmarshalFundOptions(rpcStrategy taprpc.CoinSelectStrategy,
+	rpcPinnedInputs []*taprpc.OutPoint) ([]tapfreighter.FundOption, error) {
+
+	strategy, err := unmarshalCoinSelectStrategy(rpcStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	fundOpts := []tapfreighter.FundOption{
+		tapfreighter.WithCoinSelectStrategy(strategy),
+	}
+	if len(rpcPinnedInputs) == 0 {
+		return fundOpts, nil
+	}
+
+	pinnedInputs := make([]wire.OutPoint, len(rpcPinnedInputs))
+	for idx := range rpcPinnedInputs {
+		pinnedInputs[idx], err = taprpc.UnmarshalOutPoint(
+			rpcPinnedInputs[idx],
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pinned input: %w", err)
+		}
+	}
+
+	return append(
+		fundOpts, tapfreighter.WithPinnedInputs(pinnedInputs...),
+	), nil
+}
+
+// parseBatchKey parses the optional batch key of a minting batch. If no batch
+// key is given, nil is returned.
+func parseBatchKey(batchKeyBytes []byte) (*btcec.PublicKey, error) {
+	if len(batchKeyBytes) == 0 {
+		return nil, nil
+	}
+
+	batchKey, err := btcec.ParsePubKey(batchKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid batch key: %w", err)
+	}
+
+	return batchKey, nil
+}
+
+// FundBatch attempts to fund the target pending batch.
 func (r *rpcServer) FundBatch(_ context.Context,
 	req *mintrpc.FundBatchRequest) (*mintrpc.FundBatchResponse, error) {
 
+	// The target batch is either given by its batch key, or a new batch is
+	// requested, but not both.
+	if len(req.BatchKey) != 0 && req.NewBatch {
+		return nil, fmt.Errorf("cannot specify both batch_key and " +
+			"new_batch")
+	}
+
+	batchKey, err := parseBatchKey(req.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
 	feeRate, err := checkFeeRateSanity(req.FeeRate)
 	if err != nil {
 		return nil, err
@@ -717,6 +825,8 @@ func (r *rpcServer) FundBatch(_ context.Context,
 
 	batch, err := r.cfg.AssetMinter.FundBatch(
 		tapgarden.FundParams{
+			BatchKey:       batchKey,
+			NewBatch:       req.NewBatch,
 			FeeRate:        feeRateOpt,
 			SiblingTapTree: tapTreeOpt,
 		},
@@ -740,11 +850,16 @@ func (r *rpcServer) FundBatch(_ context.Context,
 	}, nil
 }
 
-// SealBatch attempts to seal the current pending batch, validating provided
+// SealBatch attempts to seal the target pending batch, validating provided
 // asset group witnesses and generating asset group witnesses as needed.
 func (r *rpcServer) SealBatch(ctx context.Context,
 	req *mintrpc.SealBatchRequest) (*mintrpc.SealBatchResponse, error) {
 
+	batchKey, err := parseBatchKey(req.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
 	var groupWitnesses []asset.PendingGroupWitness
 	for i := range req.GroupWitnesses {
 		wit, err := taprpc.UnmarshalGroupWitness(req.GroupWitnesses[i])
@@ -757,6 +872,7 @@ func (r *rpcServer) SealBatch(ctx context.Context,
 
 	batch, err := r.cfg.AssetMinter.SealBatch(
 		tapgarden.SealParams{
+			BatchKey:       batchKey,
 			GroupWitnesses: groupWitnesses,
 		},
 	)
@@ -774,11 +890,16 @@ func (r *rpcServer) SealBatch(ctx context.Context,
 	}, nil
 }
 
-// FinalizeBatch attempts to finalize the current pending batch.
+// FinalizeBatch attempts to finalize the target pending batch.
 func (r *rpcServer) FinalizeBatch(_ context.Context,
 	req *mintrpc.FinalizeBatchRequest) (*mintrpc.FinalizeBatchResponse,
 	error) {
 
+	batchKey, err := parseBatchKey(req.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
 	feeRate, err := checkFeeRateSanity(req.FeeRate)
 	if err != nil {
 		return nil, err
@@ -794,6 +915,7 @@ func (r *rpcServer) FinalizeBatch(_ context.Context,
 
 	batch, err := r.cfg.AssetMinter.FinalizeBatch(
 		tapgarden.FinalizeParams{
+			BatchKey:       batchKey,
 			FeeRate:        feeRateOpt,
 			SiblingTapTree: tapTreeOpt,
 		},
@@ -817,12 +939,21 @@ func (r *rpcServer) FinalizeBatch(_ context.Context,
 	}, nil
 }
 
-// CancelBatch attempts to cancel the current pending batch.
+// CancelBatch attempts to cancel the target pending batch.
 func (r *rpcServer) CancelBatch(_ context.Context,
-	_ *mintrpc.CancelBatchRequest) (*mintrpc.CancelBatchResponse,
+	req *mintrpc.CancelBatchRequest) (*mintrpc.CancelBatchResponse,
 	error) {
 
-	batchKey, err := r.cfg.AssetMinter.CancelBatch()
+	targetBatchKey, err := parseBatchKey(req.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	batchKey, err := r.cfg.AssetMinter.CancelBatch(
+		tapgarden.CancelParams{
+			BatchKey: targetBatchKey,
+		},
+	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to cancel batch: %w", err)
 	}
@@ -837,6 +968,38 @@ func (r *rpcServer) CancelBatch(_ context.Context,
 	}, nil
 }
 
+// BumpBatchFee attempts to bump the fee of the anchor transaction of a
+// broadcast but not yet confirmed minting batch.
+func (r *rpcServer) BumpBatchFee(_ context.Context,
+	req *mintrpc.BumpBatchFeeRequest) (*mintrpc.BumpBatchFeeResponse,
+	error) {
+
+	batchKey, err := parseBatchKey(req.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+	if feeRate == nil {
+		return nil, fmt.Errorf("fee rate must be set")
+	}
+
+	txid, err := r.cfg.AssetMinter.BumpBatchFee(tapgarden.BumpFeeParams{
+		BatchKey: batchKey,
+		FeeRate:  *feeRate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to bump batch fee: %w", err)
+	}
+
+	return &mintrpc.BumpBatchFeeResponse{
+		BatchTxid: txid.String(),
+	}, nil
+}
+
 // ListBatches lists the set of batches submitted for minting, including pending
 // and cancelled batches.
 func (r *rpcServer) ListBatches(_ context.Context,
@@ -1445,6 +1608,18 @@ func (r *rpcServer) NewAddr(ctx context.Context,
 		return nil, err
 	}
 
+	// Payments to reusable addresses without an amount can't be detected
+	// on-chain, as the output key depends on the amount. We instead look
+	// them up through the proof courier, so it needs to be able to list
+	// the proofs it holds.
+	if addrVersion.IsReusable() && req.Amt == 0 &&
+		!proof.CanListProofs(courierAddr) {
+
+		return nil, fmt.Errorf("reusable addresses without an amount "+
+			"require a %v or %v proof courier",
+			proof.UniverseRpcCourierType, proof.FileCourierType)
+	}
+
 	var addr *address.AddrWithKeyInfo
 	switch {
 	// No key was specified, we'll let the address book derive them.
@@ -1564,9 +1739,12 @@ func (r *rpcServer) VerifyProof(ctx context.Context,
 
 	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
 	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
-	_, err = proofFile.Verify(
-		ctx, headerVerifier, proof.DefaultMerkleVerifier, groupVerifier,
-		r.cfg.ChainBridge.GenFileChainLookup(proofFile),
+	verifier := &proof.BaseVerifier{
+		CheckpointVerifier: r.cfg.ProofCheckpointVerifier,
+	}
+	_, err = verifier.Verify(
+		ctx, bytes.NewReader(req.RawProofFile), headerVerifier,
+		proof.DefaultMerkleVerifier, groupVerifier, r.cfg.ChainBridge,
 	)
 	if err != nil {
 		// We don't want to fail the RPC request because of a proof
@@ -1823,16 +2001,88 @@ func (r *rpcServer) marshalProof(ctx context.Context, p *proof.Proof,
 func (r *rpcServer) ExportProof(ctx context.Context,
 	req *taprpc.ExportProofRequest) (*taprpc.ProofFile, error) {
 
-	if len(req.ScriptKey) == 0 {
+	loc, err := unmarshalProofLocator(
+		req.AssetId, req.ScriptKey, req.Outpoint,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	proofBlob, err := r.cfg.ProofArchive.FetchProof(ctx, *loc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taprpc.ProofFile{
+		RawProofFile: proofBlob,
+	}, nil
+}
+
+// PruneProof prunes the history of the local proof file anchored at the
+// specified script key, so the file starts at the latest proof that is a leaf
+// of the local universe or a trusted universe server.
+func (r *rpcServer) PruneProof(ctx context.Context,
+	req *taprpc.PruneProofRequest) (*taprpc.PruneProofResponse, error) {
+
+	// Without a checkpoint verifier, we wouldn't be able to verify the
+	// pruned proof file anymore. And without a history fetcher, we
+	// couldn't restore the full file to transfer the asset.
+	if r.cfg.ProofCheckpointVerifier == nil ||
+		r.cfg.ProofHistoryFetcher == nil {
+
+		return nil, fmt.Errorf("proof checkpoints must be enabled " +
+			"(universe.proof-checkpoints) to prune proofs")
+	}
+
+	loc, err := unmarshalProofLocator(
+		req.AssetId, req.ScriptKey, req.Outpoint,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+	numPruned, err := proof.PruneProofFile(
+		ctx, *loc, r.cfg.ProofArchive, r.cfg.ProofCheckpointVerifier,
+		r.cfg.ProofHistoryFetcher, headerVerifier,
+		proof.DefaultMerkleVerifier, groupVerifier, r.cfg.ChainBridge,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to prune proof: %w", err)
+	}
+
+	proofBlob, err := r.cfg.ProofArchive.FetchProof(ctx, *loc)
+	if err != nil {
+		return nil, err
+	}
+
+	numProofs, err := proof.FileNumProofs(proofBlob)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode proof file: %w", err)
+	}
+
+	return &taprpc.PruneProofResponse{
+		NumPrunedProofs:    numPruned,
+		NumRemainingProofs: uint32(numProofs),
+	}, nil
+}
+
+// unmarshalProofLocator parses the RPC asset ID, script key and optional
+// outpoint of a proof file into a proof locator.
+func unmarshalProofLocator(assetIDBytes, scriptKeyBytes []byte,
+	rpcOutPoint *taprpc.OutPoint) (*proof.Locator, error) {
+
+	if len(scriptKeyBytes) == 0 {
 		return nil, fmt.Errorf("a valid script key must be specified")
 	}
 
-	scriptKey, err := parseUserKey(req.ScriptKey)
+	scriptKey, err := parseUserKey(scriptKeyBytes)
 	if err != nil {
 		return nil, fmt.Errorf("invalid script key: %w", err)
 	}
 
-	if len(req.AssetId) != 32 {
+	if len(assetIDBytes) != 32 {
 		return nil, fmt.Errorf("asset ID must be 32 bytes")
 	}
 
@@ -1840,35 +2090,28 @@ func (r *rpcServer) ExportProof(ctx context.Context,
 		assetID  asset.ID
 		outPoint *wire.OutPoint
 	)
-	copy(assetID[:], req.AssetId)
+	copy(assetID[:], assetIDBytes)
 
 	// The outpoint is optional when querying for a proof file. But if
 	// multiple proofs exist for the same assetID and script key, then an
 	// error will be returned and the outpoint needs to be specified to
 	// disambiguate.
-	if req.Outpoint != nil {
-		txid, err := chainhash.NewHash(req.Outpoint.Txid)
+	if rpcOutPoint != nil {
+		txid, err := chainhash.NewHash(rpcOutPoint.Txid)
 		if err != nil {
 			return nil, fmt.Errorf("error parsing outpoint: %w",
 				err)
 		}
 		outPoint = &wire.OutPoint{
 			Hash:  *txid,
-			Index: req.Outpoint.OutputIndex,
+			Index: rpcOutPoint.OutputIndex,
 		}
 	}
 
-	proofBlob, err := r.cfg.ProofArchive.FetchProof(ctx, proof.Locator{
+	return &proof.Locator{
 		AssetID:   &assetID,
 		ScriptKey: *scriptKey,
 		OutPoint:  outPoint,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	return &taprpc.ProofFile{
-		RawProofFile: proofBlob,
 	}, nil
 }
 
@@ -2002,6 +2245,21 @@ func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
 	req *wrpc.FundVirtualPsbtRequest) (*wrpc.FundVirtualPsbtResponse,
 	error) {
 
+	// If a raw template specifies inputs, we pin their anchor outpoints
+	// instead of running coin selection.
+	templateInputs := fn.Map(
+		req.GetRaw().GetInputs(),
+		func(i *wrpc.PrevId) *taprpc.OutPoint {
+			return i.Outpoint
+		},
+	)
+	fundOpts, err := unmarshalFundOptions(
+		req.CoinSelectStrategy, templateInputs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	var fundedVPkt *tapfreighter.FundedVPacket
 	switch {
 	case req.GetPsbt() != nil:
@@ -2024,7 +2282,7 @@ func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
 		}
 
 		fundedVPkt, err = r.cfg.AssetWallet.FundPacket(
-			ctx, desc, vPkt,
+			ctx, desc, vPkt, fundOpts...,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error funding packet: %w", err)
@@ -2032,10 +2290,6 @@ func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
 
 	case req.GetRaw() != nil:
 		raw := req.GetRaw()
-		if len(raw.Inputs) > 0 {
-			return nil, fmt.Errorf("template inputs not yet " +
-				"supported")
-		}
 		if len(raw.Recipients) > 1 {
 			return nil, fmt.Errorf("only one recipient supported")
 		}
@@ -2056,7 +2310,9 @@ func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
 			return nil, fmt.Errorf("no recipients specified")
 		}
 
-		fundedVPkt, err = r.cfg.AssetWallet.FundAddressSend(ctx, addr)
+		fundedVPkt, err = r.cfg.AssetWallet.FundAddressSend(
+			ctx, []*address.Tap{addr}, fundOpts...,
+		)
 		if err != nil {
 			return nil, fmt.Errorf("error funding address send: "+
 				"%w", err)
@@ -2117,6 +2373,21 @@ func (r *rpcServer) SignVirtualPsbt(ctx context.Context,
 
 	// Make sure the input keys are known.
 	for _, input := range vPkt.Inputs {
+		// The key aggregation context of a MuSig2 script key and the
+		// descriptor of an imported script key are only stored in our
+		// local database and never part of the packet. So we need to
+		// look them up to be able to take part in the MuSig2 signing
+		// rounds of the input or to select its spend path.
+		err := r.cfg.AssetWallet.AddScriptKeySpendInfo(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if tapsend.IsMuSig2Input(input) ||
+			tapsend.IsDescriptorInput(input) {
+
+			continue
+		}
+
 		// If we have all the derivation information, we don't need to
 		// do anything.
 		if len(input.Bip32Derivation) > 0 &&
@@ -2152,7 +2423,9 @@ func (r *rpcServer) SignVirtualPsbt(ctx context.Context,
 		}
 	}
 
-	signedInputs, err := r.cfg.AssetWallet.SignVirtualPacket(vPkt)
+	signedInputs, err := r.cfg.AssetWallet.SignVirtualPacket(
+		vPkt, tapfreighter.WithSignContext(ctx),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("error signing packet: %w", err)
 	}
@@ -2168,6 +2441,206 @@ func (r *rpcServer) SignVirtualPsbt(ctx context.Context,
 	}, nil
 }
 
+// CombineVirtualPsbts combines several copies of the same virtual transaction
+// that were each signed by a different party into a single virtual PSBT.
+func (r *rpcServer) CombineVirtualPsbts(_ context.Context,
+	req *wrpc.CombineVirtualPsbtsRequest) (
+	*wrpc.CombineVirtualPsbtsResponse, error) {
+
+	if len(req.VirtualPsbts) == 0 {
+		return nil, fmt.Errorf("no virtual PSBTs to combine")
+	}
+
+	vPackets, err := decodeVirtualPackets(req.VirtualPsbts)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := tapsend.NewVPacketSession(vPackets[0])
+	if err != nil {
+		return nil, fmt.Errorf("error creating combiner session: %w",
+			err)
+	}
+	for idx, vPkt := range vPackets[1:] {
+		if err := session.Combine(vPkt); err != nil {
+			return nil, fmt.Errorf("error combining virtual PSBT "+
+				"%d: %w", idx+1, err)
+		}
+	}
+
+	status, err := session.Status()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching signing status: %w", err)
+	}
+	complete, err := session.IsComplete()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching signing status: %w", err)
+	}
+
+	// Once all inputs are signed, we make sure the combined witnesses are
+	// actually valid before handing out the packet.
+	combinedPkt := session.Packet()
+	if complete {
+		combinedPkt, err = session.Finalize(&WitnessValidatorV0{})
+		if err != nil {
+			return nil, fmt.Errorf("error finalizing combined "+
+				"virtual PSBT: %w", err)
+		}
+	}
+
+	combinedPsbt, err := serialize(combinedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing packet: %w", err)
+	}
+
+	return &wrpc.CombineVirtualPsbtsResponse{
+		CombinedPsbt: combinedPsbt,
+		Complete:     complete,
+		Inputs:       marshalVInputStatus(status),
+	}, nil
+}
+
+// VirtualPsbtStatus returns the signing status of each input of a virtual
+// PSBT.
+func (r *rpcServer) VirtualPsbtStatus(_ context.Context,
+	req *wrpc.VirtualPsbtStatusRequest) (*wrpc.VirtualPsbtStatusResponse,
+	error) {
+
+	vPkt, err := tappsbt.Decode(req.VirtualPsbt)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding packet: %w", err)
+	}
+
+	status, err := tapsend.VPacketStatus(vPkt)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching signing status: %w", err)
+	}
+
+	complete := fn.All(status, func(s tapsend.VInputStatus) bool {
+		return s.State == tapsend.VInputSigned
+	})
+
+	return &wrpc.VirtualPsbtStatusResponse{
+		Complete: complete,
+		Inputs:   marshalVInputStatus(status),
+	}, nil
+}
+
+// NewMuSig2ScriptKey aggregates a local key and the keys of one or more remote
+// signers into a MuSig2 script key and declares it to the wallet.
+func (r *rpcServer) NewMuSig2ScriptKey(ctx context.Context,
+	req *wrpc.NewMuSig2ScriptKeyRequest) (*wrpc.NewMuSig2ScriptKeyResponse,
+	error) {
+
+	if req.LocalKey == nil {
+		return nil, fmt.Errorf("local key must be set")
+	}
+	if len(req.RemoteKeys) == 0 {
+		return nil, fmt.Errorf("at least one remote key must be set")
+	}
+
+	localKey, err := taprpc.UnmarshalKeyDescriptor(req.LocalKey)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling local key: %w", err)
+	}
+
+	remoteKeys := make([]*btcec.PublicKey, len(req.RemoteKeys))
+	for idx, keyBytes := range req.RemoteKeys {
+		remoteKeys[idx], err = btcec.ParsePubKey(keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing remote key "+
+				"%d: %w", idx, err)
+		}
+	}
+
+	scriptKey, err := r.cfg.AddrBook.NewMuSig2ScriptKey(
+		ctx, localKey, remoteKeys,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating MuSig2 script key: %w",
+			err)
+	}
+
+	signerKeys := fn.Map(
+		scriptKey.MuSig2.SignerKeys, func(k *btcec.PublicKey) []byte {
+			return k.SerializeCompressed()
+		},
+	)
+
+	return &wrpc.NewMuSig2ScriptKeyResponse{
+		ScriptKey:  taprpc.MarshalScriptKey(scriptKey),
+		SignerKeys: signerKeys,
+	}, nil
+}
+
+// ImportScriptKeyDescriptor derives a script key from a tr() output descriptor
+// template and declares it to the wallet.
+func (r *rpcServer) ImportScriptKeyDescriptor(ctx context.Context,
+	req *wrpc.ImportScriptKeyDescriptorRequest) (
+	*wrpc.ImportScriptKeyDescriptorResponse, error) {
+
+	if req.DescriptorTemplate == "" {
+		return nil, fmt.Errorf("descriptor template must be set")
+	}
+
+	scriptKey, err := r.cfg.AddrBook.NewDescriptorScriptKey(
+		ctx, req.DescriptorTemplate, req.Index,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error importing descriptor: %w", err)
+	}
+
+	descInfo := scriptKey.Descriptor
+	localKey := taprpc.MarshalKeyDescriptor(descInfo.LocalKey)
+
+	return &wrpc.ImportScriptKeyDescriptorResponse{
+		ScriptKey:         taprpc.MarshalScriptKey(scriptKey),
+		DerivedDescriptor: descInfo.Descriptor,
+		LocalKey:          localKey,
+	}, nil
+}
+
+// marshalVInputStatus converts the signing status of virtual inputs into
+// their RPC representation.
+func marshalVInputStatus(
+	status []tapsend.VInputStatus) []*wrpc.VirtualInputStatus {
+
+	rpcStatus := make([]*wrpc.VirtualInputStatus, len(status))
+	for idx, s := range status {
+		prevID := s.PrevID
+		rpcStatus[idx] = &wrpc.VirtualInputStatus{
+			PrevId: &wrpc.PrevId{
+				Outpoint: &taprpc.OutPoint{
+					Txid:        prevID.OutPoint.Hash[:],
+					OutputIndex: prevID.OutPoint.Index,
+				},
+				Id:        prevID.ID[:],
+				ScriptKey: prevID.ScriptKey[:],
+			},
+			State:         marshalVInputState(s.State),
+			NumSignatures: s.NumSignatures,
+			Threshold:     s.Threshold,
+		}
+	}
+
+	return rpcStatus
+}
+
+// marshalVInputState converts the signing state of a virtual input into its
+// RPC representation.
+func marshalVInputState(state tapsend.VInputState) wrpc.VirtualInputState {
+	switch state {
+	case tapsend.VInputPartiallySigned:
+		return wrpc.VirtualInputState_VIRTUAL_INPUT_PARTIALLY_SIGNED
+
+	case tapsend.VInputSigned:
+		return wrpc.VirtualInputState_VIRTUAL_INPUT_SIGNED
+
+	default:
+		return wrpc.VirtualInputState_VIRTUAL_INPUT_UNSIGNED
+	}
+}
+
 // AnchorVirtualPsbts merges and then commits multiple virtual transactions in
 // a single BTC level anchor transaction.
 func (r *rpcServer) AnchorVirtualPsbts(ctx context.Context,
@@ -2228,7 +2701,9 @@ func (r *rpcServer) AnchorVirtualPsbts(ctx context.Context,
 	}
 
 	resp, err := r.cfg.ChainPorter.RequestShipment(
-		tapfreighter.NewPreSignedParcel(vPackets, inputCommitments),
+		tapfreighter.NewPreSignedParcel(
+			vPackets, inputCommitments, nil,
+		),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error requesting delivery: %w", err)
@@ -2253,20 +2728,8 @@ func (r *rpcServer) CommitVirtualPsbts(ctx context.Context,
 	req *wrpc.CommitVirtualPsbtsRequest) (*wrpc.CommitVirtualPsbtsResponse,
 	error) {
 
-	// For this call we require `lnd` to be at least v0.17.99-beta (which
-	// will become v0.18.0-beta eventually) as we need the new coin
-	// selection mode in the FundPsbt call.
-	fundPsbtCoinSelectNotSupportedErr := fmt.Errorf("connected lnd "+
-		"version %v does not support advanced coin selection in the "+
-		"FundPsbt RPC, need at least v%d.%d.%d for this call",
-		r.cfg.Lnd.Version.Version, fundPsbtCoinSelectVersion.AppMajor,
-		fundPsbtCoinSelectVersion.AppMinor,
-		fundPsbtCoinSelectVersion.AppPatch)
-	verErr := lndclient.AssertVersionCompatible(
-		r.cfg.Lnd.Version, fundPsbtCoinSelectVersion,
-	)
-	if verErr != nil {
-		return nil, fundPsbtCoinSelectNotSupportedErr
+	if err := r.assertFundPsbtCoinSelect(); err != nil {
+		return nil, err
 	}
 
 	if len(req.VirtualPsbts) == 0 {
@@ -2360,42 +2823,92 @@ func (r *rpcServer) CommitVirtualPsbts(ctx context.Context,
 		return nil, fmt.Errorf("error funding packet: %w", err)
 	}
 
-	lockedOutpoints := fn.Map(
-		lockedUTXO, func(utxo *walletrpc.UtxoLease) wire.OutPoint {
-			var hash chainhash.Hash
-			copy(hash[:], utxo.Outpoint.TxidBytes)
-			return wire.OutPoint{
-				Hash:  hash,
-				Index: utxo.Outpoint.OutputIndex,
-			}
-		},
-	)
+	lockedOutpoints := lndLeaseOutpoints(lockedUTXO)
 
 	// From now on, if we error out, we need to make sure we unlock the
 	// UTXOs that lnd just locked for us.
 	success := false
 	defer func() {
 		if !success {
-			for idx, utxo := range lockedUTXO {
-				var lockID wtxmgr.LockID
-				copy(lockID[:], utxo.Id)
-
-				op := lockedOutpoints[idx]
-				err := lndWallet.ReleaseOutput(ctx, lockID, op)
-				if err != nil {
-					rpcsLog.Errorf("Error unlocking lnd "+
-						"UTXO %v: %v", op, err)
-				}
-			}
+			r.releaseLndLeases(ctx, lockedUTXO)
 		}
 	}()
 
+	// We can now update the anchor outputs as we have the final
+	// commitments and create the transition proof suffixes.
+	err = commitAnchorOutputs(fundedPacket, allPackets)
+	if err != nil {
+		return nil, err
+	}
+
+	// We can now prepare the full answer, beginning with the serialized
+	// final packet.
+	response := &wrpc.CommitVirtualPsbtsResponse{
+		ChangeOutputIndex: changeIndex,
+	}
+
+	response.AnchorPsbt, err = serialize(fundedPacket)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing packet: %w", err)
+	}
+
+	// Serialize the final active and passive virtual packets.
+	response.VirtualPsbts, err = encodeVirtualPackets(activePackets)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding active packets: %w", err)
+	}
+	response.PassiveAssetPsbts, err = encodeVirtualPackets(passivePackets)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding passive packets: %w",
+			err)
+	}
+
+	// And finally, we need to also return the locked UTXOs. We just return
+	// the outpoint, as any additional information can be fetched from the
+	// lnd wallet directly (we don't want to create pass-through RPCs for
+	// all those methods).
+	response.LndLockedUtxos = marshalOutPoints(lockedOutpoints)
+
+	// We were successful, let's cancel the UTXO release in the defer.
+	success = true
+
+	return response, nil
+}
+
+// assertFundPsbtCoinSelect makes sure the connected lnd node supports the
+// advanced coin selection mode of the FundPsbt RPC.
+func (r *rpcServer) assertFundPsbtCoinSelect() error {
+	// We require `lnd` to be at least v0.17.99-beta (which will become
+	// v0.18.0-beta eventually) as we need the new coin selection mode in
+	// the FundPsbt call.
+	verErr := lndclient.AssertVersionCompatible(
+		r.cfg.Lnd.Version, fundPsbtCoinSelectVersion,
+	)
+	if verErr != nil {
+		return fmt.Errorf("connected lnd version %v does not support "+
+			"advanced coin selection in the FundPsbt RPC, need at "+
+			"least v%d.%d.%d for this call",
+			r.cfg.Lnd.Version.Version,
+			fundPsbtCoinSelectVersion.AppMajor,
+			fundPsbtCoinSelectVersion.AppMinor,
+			fundPsbtCoinSelectVersion.AppPatch)
+	}
+
+	return nil
+}
+
+// commitAnchorOutputs updates the Taproot output keys of the funded BTC level
+// anchor transaction to commit to the given virtual packets and then adds the
+// transition proof suffixes to all virtual outputs.
+func commitAnchorOutputs(fundedPacket *psbt.Packet,
+	allPackets []*tappsbt.VPacket) error {
+
 	// We can now update the anchor outputs as we have the final
 	// commitments.
 	outputCommitments, err := tapsend.CreateOutputCommitments(allPackets)
 	if err != nil {
-		return nil, fmt.Errorf("unable to create new output "+
-			"commitments: %w", err)
+		return fmt.Errorf("unable to create new output commitments: "+
+			"%w", err)
 	}
 
 	for _, vPkt := range allPackets {
@@ -2403,7 +2916,7 @@ func (r *rpcServer) CommitVirtualPsbts(ctx context.Context,
 			fundedPacket, vPkt, outputCommitments,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("error updating taproot output "+
+			return fmt.Errorf("error updating taproot output "+
 				"keys: %w", err)
 		}
 	}
@@ -2419,53 +2932,57 @@ func (r *rpcServer) CommitVirtualPsbts(ctx context.Context,
 				vPkt, outputCommitments, vOutIdx, allPackets,
 			)
 			if err != nil {
-				return nil, fmt.Errorf("unable to create "+
-					"proof suffix for output %d of vPSBT "+
-					"%d: %w", vOutIdx, idx, err)
+				return fmt.Errorf("unable to create proof "+
+					"suffix for output %d of vPSBT %d: %w",
+					vOutIdx, idx, err)
 			}
 
 			vPkt.Outputs[vOutIdx].ProofSuffix = proofSuffix
 		}
 	}
 
-	// We can now prepare the full answer, beginning with the serialized
-	// final packet.
-	response := &wrpc.CommitVirtualPsbtsResponse{
-		ChangeOutputIndex: changeIndex,
-	}
+	return nil
+}
 
-	response.AnchorPsbt, err = serialize(fundedPacket)
-	if err != nil {
-		return nil, fmt.Errorf("error serializing packet: %w", err)
-	}
+// lndLeaseOutpoints returns the outpoints of the given lnd UTXO leases.
+func lndLeaseOutpoints(leases []*walletrpc.UtxoLease) []wire.OutPoint {
+	return fn.Map(leases, func(utxo *walletrpc.UtxoLease) wire.OutPoint {
+		var hash chainhash.Hash
+		copy(hash[:], utxo.Outpoint.TxidBytes)
+		return wire.OutPoint{
+			Hash:  hash,
+			Index: utxo.Outpoint.OutputIndex,
+		}
+	})
+}
 
-	// Serialize the final active and passive virtual packets.
-	response.VirtualPsbts, err = encodeVirtualPackets(activePackets)
-	if err != nil {
-		return nil, fmt.Errorf("error encoding active packets: %w", err)
-	}
-	response.PassiveAssetPsbts, err = encodeVirtualPackets(passivePackets)
-	if err != nil {
-		return nil, fmt.Errorf("error encoding passive packets: %w",
-			err)
-	}
+// releaseLndLeases releases the given lnd UTXO leases. Any errors are only
+// logged, as this is used to clean up after a failed funding attempt.
+func (r *rpcServer) releaseLndLeases(ctx context.Context,
+	leases []*walletrpc.UtxoLease) {
 
-	// And finally, we need to also return the locked UTXOs. We just return
-	// the outpoint, as any additional information can be fetched from the
-	// lnd wallet directly (we don't want to create pass-through RPCs for
-	// all those methods).
-	response.LndLockedUtxos = make([]*taprpc.OutPoint, len(lockedOutpoints))
-	for idx := range lockedOutpoints {
-		response.LndLockedUtxos[idx] = &taprpc.OutPoint{
-			Txid:        lockedOutpoints[idx].Hash[:],
-			OutputIndex: lockedOutpoints[idx].Index,
+	lockedOutpoints := lndLeaseOutpoints(leases)
+	for idx, utxo := range leases {
+		var lockID wtxmgr.LockID
+		copy(lockID[:], utxo.Id)
+
+		op := lockedOutpoints[idx]
+		err := r.cfg.Lnd.WalletKit.ReleaseOutput(ctx, lockID, op)
+		if err != nil {
+			rpcsLog.Errorf("Error unlocking lnd UTXO %v: %v", op,
+				err)
 		}
 	}
+}
 
-	// We were successful, let's cancel the UTXO release in the defer.
-	success = true
-
-	return response, nil
+// marshalOutPoints converts the given outpoints to their RPC representation.
+func marshalOutPoints(outpoints []wire.OutPoint) []*taprpc.OutPoint {
+	return fn.Map(outpoints, func(op wire.OutPoint) *taprpc.OutPoint {
+		return &taprpc.OutPoint{
+			Txid:        op.Hash[:],
+			OutputIndex: op.Index,
+		}
+	})
 }
 
 // validateInputAssets makes sure that the input assets are correct and their
@@ -2659,11 +3176,42 @@ func (r *rpcServer) PublishAndLogTransfer(ctx context.Context,
 			err)
 	}
 
+	lockedUTXOs := make([]wire.OutPoint, len(req.LndLockedUtxos))
+	for idx, lndOutpoint := range req.LndLockedUtxos {
+		lockedUTXOs[idx], err = taprpc.UnmarshalOutPoint(lndOutpoint)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing outpoint: %w",
+				err)
+		}
+	}
+
+	parcel, err := r.publishAndLogPackets(
+		ctx, pkt, activePackets, passivePackets, req.ChangeOutputIndex,
+		lockedUTXOs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taprpc.SendAssetResponse{
+		Transfer: parcel,
+	}, nil
+}
+
+// publishAndLogPackets validates the given fully signed anchor transaction
+// against the given active and passive virtual packets and then hands them to
+// the chain porter. The porter publishes the transaction, logs the transfer of
+// the packets in the database and ships any outgoing proofs.
+func (r *rpcServer) publishAndLogPackets(ctx context.Context,
+	pkt *psbt.Packet, activePackets, passivePackets []*tappsbt.VPacket,
+	changeOutputIndex int32,
+	lockedUTXOs []wire.OutPoint) (*taprpc.AssetTransfer, error) {
+
 	// Before we commit the transaction to the database, we want to make
 	// sure everything is in order. We start by validating the inputs.
 	allPackets := append([]*tappsbt.VPacket{}, activePackets...)
 	allPackets = append(allPackets, passivePackets...)
-	err = r.validateInputAssets(ctx, pkt, allPackets)
+	err := r.validateInputAssets(ctx, pkt, allPackets)
 	if err != nil {
 		return nil, fmt.Errorf("error validating input assets: %w", err)
 	}
@@ -2692,26 +3240,13 @@ func (r *rpcServer) PublishAndLogTransfer(ctx context.Context,
 	anchorTx := &tapsend.AnchorTransaction{
 		FundedPsbt: &tapsend.FundedPsbt{
 			Pkt:               pkt,
-			ChangeOutputIndex: req.ChangeOutputIndex,
+			ChangeOutputIndex: changeOutputIndex,
 			ChainFees:         int64(chainFees),
-			LockedUTXOs: make(
-				[]wire.OutPoint, len(req.LndLockedUtxos),
-			),
+			LockedUTXOs:       lockedUTXOs,
 		},
 		ChainFees: int64(chainFees),
 		FinalTx:   finalTx,
 	}
-	for idx, lndOutpoint := range req.LndLockedUtxos {
-		hash, err := chainhash.NewHash(lndOutpoint.Txid)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing txid: %w", err)
-		}
-
-		anchorTx.FundedPsbt.LockedUTXOs[idx] = wire.OutPoint{
-			Hash:  *hash,
-			Index: lndOutpoint.OutputIndex,
-		}
-	}
 
 	// We now have everything to ship the pre-anchored parcel using the
 	// freighter. This will publish the TX, create the transfer database
@@ -2733,9 +3268,7 @@ func (r *rpcServer) PublishAndLogTransfer(ctx context.Context,
 			err)
 	}
 
-	return &taprpc.SendAssetResponse{
-		Transfer: parcel,
-	}, nil
+	return parcel, nil
 }
 
 // NextInternalKey derives the next internal key for the given key family and
@@ -3105,47 +3638,52 @@ func marshalAddrEventStatus(status address.Status) (taprpc.AddrEventStatus,
 func (r *rpcServer) SendAsset(_ context.Context,
 	req *taprpc.SendAssetRequest) (*taprpc.SendAssetResponse, error) {
 
-	if len(req.TapAddrs) == 0 {
+	numAddrs := len(req.TapAddrs) + len(req.AddressesWithAmounts)
+	if numAddrs == 0 {
 		return nil, fmt.Errorf("at least one addr is required")
 	}
 
+	// The addresses with an explicit amount are appended to the plain
+	// addresses, so we can treat them the same way below.
+	addrStrs := make([]string, 0, numAddrs)
+	addrStrs = append(addrStrs, req.TapAddrs...)
+	for _, addrWithAmt := range req.AddressesWithAmounts {
+		addrStrs = append(addrStrs, addrWithAmt.TapAddr)
+	}
+
 	var (
-		tapAddrs = make([]*address.Tap, len(req.TapAddrs))
+		tapAddrs = make([]*address.Tap, numAddrs)
 		err      error
 	)
-	for idx := range req.TapAddrs {
-		if req.TapAddrs[idx] == "" {
+	for idx := range addrStrs {
+		if addrStrs[idx] == "" {
 			return nil, fmt.Errorf("addr %d must be specified", idx)
 		}
 
 		tapAddrs[idx], err = address.DecodeAddress(
-			req.TapAddrs[idx], &r.cfg.ChainParams,
+			addrStrs[idx], &r.cfg.ChainParams,
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		// Ensure all addrs are of the same asset ID. Within a single
-		// transfer (=a single virtual packet), we expect only to have
-		// inputs and outputs of the same asset ID. Multiple assets can
-		// be moved in a single BTC level anchor output, but the
-		// expectation is that they would be in separate virtual
-		// packets, one for each asset ID. They would then be merged
-		// into the same anchor output in the wallet's
-		// AnchorVirtualTransactions call.
-		//
-		// TODO(guggero): Support creating multiple virtual packets, one
-		// for each asset ID when the user wants to send multiple asset
-		// IDs at the same time without going through the PSBT flow.
-		//
-		// TODO(guggero): Revisit after we have a way to send fungible
-		// assets with different IDs to an address (non-interactive).
-		if idx > 0 {
-			if tapAddrs[idx].AssetID != tapAddrs[0].AssetID {
-				return nil, fmt.Errorf("all addrs must be of "+
-					"the same asset ID %v",
-					tapAddrs[0].AssetID)
+		// Reusable addresses might not specify an amount, in which
+		// case the sender needs to set it.
+		switch {
+		case idx >= len(req.TapAddrs):
+			amt := req.AddressesWithAmounts[idx-len(req.TapAddrs)]
+			tapAddrs[idx], err = tapAddrs[idx].WithAmount(
+				amt.Amount,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("invalid amount for "+
+					"addr %d: %w", idx, err)
 			}
+
+		case !tapAddrs[idx].HasAmount():
+			return nil, fmt.Errorf("addr %d doesn't specify an "+
+				"amount, use addresses_with_amounts to send "+
+				"to it", idx)
 		}
 	}
 
@@ -3154,8 +3692,18 @@ func (r *rpcServer) SendAsset(_ context.Context,
 		return nil, err
 	}
 
+	fundOpts, err := unmarshalFundOptions(
+		req.CoinSelectStrategy, req.PinnedInputs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The addresses may request different assets. The wallet funds one
+	// virtual packet for each asset ID, and all of them are anchored in
+	// the same BTC level anchor transaction.
 	resp, err := r.cfg.ChainPorter.RequestShipment(
-		tapfreighter.NewAddressParcel(feeRate, tapAddrs...),
+		tapfreighter.NewAddressParcel(feeRate, tapAddrs, fundOpts...),
 	)
 	if err != nil {
 		return nil, err
@@ -3254,7 +3802,7 @@ func (r *rpcServer) BurnAsset(ctx context.Context,
 	resp, err := r.cfg.ChainPorter.RequestShipment(
 		tapfreighter.NewPreSignedParcel(
 			[]*tappsbt.VPacket{fundResp.VPacket},
-			fundResp.InputCommitments,
+			fundResp.InputCommitments, nil,
 		),
 	)
 	if err != nil {
@@ -3292,6 +3840,42 @@ func (r *rpcServer) BurnAsset(ctx context.Context,
 	}, nil
 }
 
+// BumpTransferFee attempts to bump the fee of the anchor transaction of a
+// broadcast but not yet confirmed asset transfer.
+func (r *rpcServer) BumpTransferFee(ctx context.Context,
+	req *taprpc.BumpTransferFeeRequest) (*taprpc.BumpTransferFeeResponse,
+	error) {
+
+	anchorTxHash, err := chainhash.NewHashFromStr(req.AnchorTxid)
+	if err != nil {
+		return nil, fmt.Errorf("invalid anchor tx hash: %w", err)
+	}
+
+	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+	if feeRate == nil {
+		return nil, fmt.Errorf("fee rate must be set")
+	}
+
+	parcel, err := r.cfg.ChainPorter.BumpTransferFee(
+		ctx, *anchorTxHash, *feeRate,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to bump transfer fee: %w", err)
+	}
+
+	transfer, err := marshalOutboundParcel(parcel)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal transfer: %w", err)
+	}
+
+	return &taprpc.BumpTransferFeeResponse{
+		Transfer: transfer,
+	}, nil
+}
+
 // marshalOutboundParcel turns a pending parcel into its RPC counterpart.
 func marshalOutboundParcel(
 	parcel *tapfreighter.OutboundParcel) (*taprpc.AssetTransfer,
@@ -4427,6 +5011,39 @@ func marshalMssmtNode(node mssmt.Node) *unirpc.MerkleSumNode {
 	}
 }
 
+// marshalUniverseCommitment marshals an on chain universe commitment into the
+// RPC counterpart.
+func marshalUniverseCommitment(
+	c *universe.Commitment) (*unirpc.UniverseCommitment, error) {
+
+	var headerBuf bytes.Buffer
+	if err := c.BlockHeader.Serialize(&headerBuf); err != nil {
+		return nil, err
+	}
+
+	var proofBuf bytes.Buffer
+	if err := c.MerkleProof.Encode(&proofBuf); err != nil {
+		return nil, err
+	}
+
+	var txBuf bytes.Buffer
+	if err := c.AnchorTx.Serialize(&txBuf); err != nil {
+		return nil, err
+	}
+
+	return &unirpc.UniverseCommitment{
+		BlockHeight:  c.BlockHeight,
+		BlockHeader:  headerBuf.Bytes(),
+		MerkleProof:  proofBuf.Bytes(),
+		AnchorTx:     txBuf.Bytes(),
+		OutputIndex:  c.OutputIndex,
+		InternalKey:  c.InternalKey.PubKey.SerializeCompressed(),
+		IssuanceRoot: marshalMssmtNode(c.IssuanceRoot),
+		TransferRoot: marshalMssmtNode(c.TransferRoot),
+		UniverseRoot: marshalMssmtNode(c.UniverseRoot),
+	}, nil
+}
+
 // marshallUniverseRoot marshals the universe root into the RPC counterpart.
 func marshalUniverseRoot(node universe.Root) (*unirpc.UniverseRoot, error) {
 	// There was no old base root, so we'll just return a blank root.
@@ -4604,6 +5221,41 @@ func unmarshalAssetSyncConfig(
 	}, nil
 }
 
+// unmarshalServerSyncConfig parses the RPC server sync config into the native
+// counterpart.
+func unmarshalServerSyncConfig(
+	config *unirpc.ServerFederationSyncConfig) (
+	*universe.FedServerSyncConfig, error) {
+
+	if config == nil {
+		return nil, fmt.Errorf("empty server sync config")
+	}
+
+	if config.Host == "" {
+		return nil, fmt.Errorf("server host must be set")
+	}
+
+	if config.TrustWeight > math.MaxInt32 {
+		return nil, fmt.Errorf("trust weight must not exceed %d",
+			math.MaxInt32)
+	}
+
+	// We always store the host with its port, so the same server isn't
+	// configured twice with and without the default port.
+	serverAddr := universe.NewServerAddrFromStr(config.Host)
+	hostPort, err := serverAddr.HostPort()
+	if err != nil {
+		return nil, fmt.Errorf("invalid server host: %w", err)
+	}
+
+	return &universe.FedServerSyncConfig{
+		ServerAddr:      universe.NewServerAddrFromStr(hostPort),
+		AllowSyncInsert: config.AllowSyncInsert,
+		AllowSyncExport: config.AllowSyncExport,
+		TrustWeight:     config.TrustWeight,
+	}, nil
+}
+
 // UnmarshalUniID parses the RPC universe ID into the native counterpart.
 func UnmarshalUniID(rpcID *unirpc.ID) (universe.Identifier, error) {
 	if rpcID == nil {
@@ -5152,54 +5804,61 @@ func (r *rpcServer) QueryProof(ctx context.Context,
 		return nil, err
 	}
 
-	return r.marshalUniverseProofLeaf(ctx, req, firstProof)
-}
-
-// queryProof attempts to query for an issuance or transfer proof for a given
-// asset based on its UniverseKey. A UniverseKey is composed of the Universe ID
-// (asset_id/group_key) and also a leaf key (outpoint || script_key).
-func (r *rpcServer) queryProof(ctx context.Context, uniID universe.Identifier,
-	leafKey universe.LeafKey) (*universe.Proof, error) {
-
-	rpcsLog.Tracef("[QueryProof]: fetching proof at (uniID=%v, "+
-		"leafKey=%x)", uniID.StringForLog(), leafKey.UniverseKey())
-
-	// Retrieve proof export config for the given universe.
-	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	resp, err := r.marshalUniverseProofLeaf(ctx, req, firstProof)
 	if err != nil {
 		return nil, err
 	}
 
-	var candidateIDs []universe.Identifier
+	// If the multiverse root the proof was generated against has been
+	// committed to on chain, we'll include the earliest such commitment.
+	committedProof, err := r.cfg.UniverseCommitments.CommitProof(
+		ctx, firstProof,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch universe chain "+
+			"commitment: %w", err)
+	}
 
-	if uniID.ProofType == universe.ProofTypeUnspecified {
-		// If the proof type is unspecified, then we'll attempt to
-		// retrieve both the issuance and transfer proofs. We gather the
-		// corresponding universe IDs into a candidate set.
-		uniID.ProofType = universe.ProofTypeIssuance
-		if syncConfigs.IsSyncExportEnabled(uniID) {
-			candidateIDs = append(candidateIDs, uniID)
+	if committedProof.ChainProof != nil {
+		resp.ChainCommitment, err = marshalUniverseCommitment(
+			committedProof.ChainProof,
+		)
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		uniID.ProofType = universe.ProofTypeTransfer
-		if syncConfigs.IsSyncExportEnabled(uniID) {
-			candidateIDs = append(candidateIDs, uniID)
-		}
-	} else {
-		// Otherwise, we'll only attempt to retrieve the proof for the
-		// specified proof type. But first we'll check that proof export
-		// is enabled for the given universe.
-		if !syncConfigs.IsSyncExportEnabled(uniID) {
-			return nil, fmt.Errorf("proof export is disabled for " +
-				"the given universe")
-		}
+	return resp, nil
+}
 
-		candidateIDs = append(candidateIDs, uniID)
+// QueryMultiProof is the batched version of QueryProof. It returns the asset
+// leaves at the given leaf keys of a universe, together with a single inclusion
+// proof for all of them. If no leaf keys are given, then all the leaves of the
+// universe are returned, like with AssetLeaves.
+func (r *rpcServer) QueryMultiProof(ctx context.Context,
+	req *unirpc.MultiProofRequest) (*unirpc.MultiProofResponse, error) {
+
+	universeID, err := UnmarshalUniID(req.Id)
+	if err != nil {
+		return nil, err
 	}
 
-	// If no candidate IDs were applicable then our config must have
-	// disabled proof export for the given universe.
-	if len(candidateIDs) == 0 {
+	// A multi-proof is always against a single universe tree, so we need
+	// to know which one to use.
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		return nil, fmt.Errorf("proof type must be specified")
+	}
+
+	leafKeys, err := fn.MapErr(req.LeafKeys, unmarshalLeafKey)
+	if err != nil {
+		return nil, err
+	}
+
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !syncConfigs.IsSyncExportEnabled(universeID) {
 		return nil, fmt.Errorf("proof export is disabled for the " +
 			"given universe")
 	}
@@ -5210,13 +5869,309 @@ func (r *rpcServer) queryProof(ctx context.Context, uniID universe.Identifier,
 		return nil, err
 	}
 
-	// Attempt to retrieve the proof given the candidate set of universe
-	// IDs.
-	var proofs []*universe.Proof
-	for i := range candidateIDs {
-		candidateID := candidateIDs[i]
-
-		proofs, err = r.cfg.UniverseArchive.FetchProofLeaf(
+	multiProof, err := r.cfg.UniverseArchive.FetchMultiProof(
+		ctx, universeID, leafKeys,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.marshalMultiProof(ctx, req.Id, multiProof)
+}
+
+// marshalMultiProof marshals a universe multi-proof of the universe with the
+// given ID into its RPC counterpart.
+func (r *rpcServer) marshalMultiProof(ctx context.Context, rpcID *unirpc.ID,
+	multiProof *universe.MultiProof) (*unirpc.MultiProofResponse, error) {
+
+	var proofBuf bytes.Buffer
+	err := multiProof.UniverseInclusionProof.Encode(&proofBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	uniRoot, err := marshalUniverseRoot(universe.Root{
+		Node: multiProof.UniverseRoot,
+	})
+	if err != nil {
+		return nil, err
+	}
+	uniRoot.Id = rpcID
+
+	numLeaves := len(multiProof.Leaves)
+	resp := &unirpc.MultiProofResponse{
+		UniverseRoot:       uniRoot,
+		LeafKeys:           fn.Map(multiProof.LeafKeys, marshalLeafKey),
+		Leaves:             make([]*unirpc.AssetLeaf, numLeaves),
+		UniverseMultiProof: proofBuf.Bytes(),
+	}
+	for i, leaf := range multiProof.Leaves {
+		decDisplay, err := r.DecDisplayForAssetID(ctx, leaf.ID())
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Leaves[i], err = r.marshalAssetLeaf(
+			ctx, leaf, fn.Some(decDisplay),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	uniRoot.AssetName = resp.Leaves[0].Asset.AssetGenesis.Name
+
+	return resp, nil
+}
+
+// SyncDiff is used to compare a remote universe tree against a local tree
+// top-down, exchanging only the subtrees that differ instead of the full set
+// of leaf keys. The first request opens the session for a universe, all
+// following requests are answered against that session.
+func (r *rpcServer) SyncDiff(stream unirpc.Universe_SyncDiffServer) error {
+	ctx := stream.Context()
+
+	// The first request must tell us which universe tree to walk.
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	start := req.GetStart()
+	if start == nil {
+		return fmt.Errorf("first request must start the diff session")
+	}
+
+	universeID, err := UnmarshalUniID(start.Id)
+	if err != nil {
+		return err
+	}
+
+	// A tree diff is always against a single universe tree, so we need to
+	// know which one to use.
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		return fmt.Errorf("proof type must be specified")
+	}
+
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return err
+	}
+	if !syncConfigs.IsSyncExportEnabled(universeID) {
+		return fmt.Errorf("proof export is disabled for the " +
+			"given universe")
+	}
+
+	session, err := r.cfg.UniverseArchive.NewTreeDiffSession(
+		ctx, universeID,
+	)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	uniRoot, err := marshalUniverseRoot(session.Root())
+	if err != nil {
+		return err
+	}
+	err = stream.Send(&unirpc.SyncDiffResponse{
+		Response: &unirpc.SyncDiffResponse_Root{
+			Root: uniRoot,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Now we answer the requests of the remote party one by one, until
+	// it closes the stream.
+	for {
+		req, err := stream.Recv()
+		switch {
+		case err == io.EOF:
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		var resp *unirpc.SyncDiffResponse
+		switch diffReq := req.Request.(type) {
+		case *unirpc.SyncDiffRequest_Nodes:
+			resp, err = syncDiffNodes(ctx, session, diffReq.Nodes)
+
+		case *unirpc.SyncDiffRequest_Leaves:
+			resp, err = r.syncDiffLeaves(
+				ctx, session, start.Id, diffReq.Leaves,
+			)
+
+		default:
+			err = fmt.Errorf("unexpected diff request: %T",
+				req.Request)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// syncDiffNodes returns the children of the branch nodes requested within a
+// tree diff session.
+func syncDiffNodes(ctx context.Context, session universe.TreeDiffSession,
+	req *unirpc.SyncDiffNodesRequest) (*unirpc.SyncDiffResponse, error) {
+
+	nodeHashes := make([]mssmt.NodeHash, len(req.NodeHashes))
+	for idx, nodeHash := range req.NodeHashes {
+		if len(nodeHash) != sha256.Size {
+			return nil, fmt.Errorf("invalid node hash length: %d",
+				len(nodeHash))
+		}
+
+		nodeHashes[idx] = fn.ToArray[mssmt.NodeHash](nodeHash)
+	}
+
+	children, err := session.DiffChildren(
+		ctx, int(req.Height), nodeHashes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcChildren := make([]*unirpc.DiffNodeChildren, len(children))
+	for idx, pair := range children {
+		rpcChildren[idx] = &unirpc.DiffNodeChildren{
+			Left:  marshalDiffNode(pair[0]),
+			Right: marshalDiffNode(pair[1]),
+		}
+	}
+
+	return &unirpc.SyncDiffResponse{
+		Response: &unirpc.SyncDiffResponse_Nodes{
+			Nodes: &unirpc.SyncDiffNodesResponse{
+				Children: rpcChildren,
+			},
+		},
+	}, nil
+}
+
+// syncDiffLeaves returns the leaves requested within a tree diff session,
+// along with a single inclusion proof for all of them.
+func (r *rpcServer) syncDiffLeaves(ctx context.Context,
+	session universe.TreeDiffSession, rpcID *unirpc.ID,
+	req *unirpc.SyncDiffLeavesRequest) (*unirpc.SyncDiffResponse, error) {
+
+	nodeKeys := make([][32]byte, len(req.LeafNodeKeys))
+	for idx, nodeKey := range req.LeafNodeKeys {
+		if len(nodeKey) != sha256.Size {
+			return nil, fmt.Errorf("invalid leaf node key "+
+				"length: %d", len(nodeKey))
+		}
+
+		nodeKeys[idx] = fn.ToArray[[32]byte](nodeKey)
+	}
+
+	// Check the rate limiter to see if we need to wait at all. If not then
+	// this'll be a noop.
+	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	multiProof, err := session.FetchMultiProof(ctx, nodeKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcMultiProof, err := r.marshalMultiProof(ctx, rpcID, multiProof)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.SyncDiffResponse{
+		Response: &unirpc.SyncDiffResponse_Leaves{
+			Leaves: rpcMultiProof,
+		},
+	}, nil
+}
+
+// marshalDiffNode marshals a node of a tree diff into its RPC counterpart.
+func marshalDiffNode(node mssmt.DiffNode) *unirpc.DiffNode {
+	nodeHash := node.NodeHash()
+	rpcNode := &unirpc.DiffNode{
+		NodeHash: nodeHash[:],
+		NodeSum:  node.NodeSum(),
+	}
+	if node.LeafKey != nil {
+		rpcNode.LeafNodeKey = node.LeafKey[:]
+	}
+
+	return rpcNode
+}
+
+// queryProof attempts to query for an issuance or transfer proof for a given
+// asset based on its UniverseKey. A UniverseKey is composed of the Universe ID
+// (asset_id/group_key) and also a leaf key (outpoint || script_key).
+func (r *rpcServer) queryProof(ctx context.Context, uniID universe.Identifier,
+	leafKey universe.LeafKey) (*universe.Proof, error) {
+
+	rpcsLog.Tracef("[QueryProof]: fetching proof at (uniID=%v, "+
+		"leafKey=%x)", uniID.StringForLog(), leafKey.UniverseKey())
+
+	// Retrieve proof export config for the given universe.
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidateIDs []universe.Identifier
+
+	if uniID.ProofType == universe.ProofTypeUnspecified {
+		// If the proof type is unspecified, then we'll attempt to
+		// retrieve both the issuance and transfer proofs. We gather the
+		// corresponding universe IDs into a candidate set.
+		uniID.ProofType = universe.ProofTypeIssuance
+		if syncConfigs.IsSyncExportEnabled(uniID) {
+			candidateIDs = append(candidateIDs, uniID)
+		}
+
+		uniID.ProofType = universe.ProofTypeTransfer
+		if syncConfigs.IsSyncExportEnabled(uniID) {
+			candidateIDs = append(candidateIDs, uniID)
+		}
+	} else {
+		// Otherwise, we'll only attempt to retrieve the proof for the
+		// specified proof type. But first we'll check that proof export
+		// is enabled for the given universe.
+		if !syncConfigs.IsSyncExportEnabled(uniID) {
+			return nil, fmt.Errorf("proof export is disabled for " +
+				"the given universe")
+		}
+
+		candidateIDs = append(candidateIDs, uniID)
+	}
+
+	// If no candidate IDs were applicable then our config must have
+	// disabled proof export for the given universe.
+	if len(candidateIDs) == 0 {
+		return nil, fmt.Errorf("proof export is disabled for the " +
+			"given universe")
+	}
+
+	// Check the rate limiter to see if we need to wait at all. If not then
+	// this'll be a noop.
+	if err = r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	// Attempt to retrieve the proof given the candidate set of universe
+	// IDs.
+	var proofs []*universe.Proof
+	for i := range candidateIDs {
+		candidateID := candidateIDs[i]
+
+		proofs, err = r.cfg.UniverseArchive.FetchProofLeaf(
 			ctx, candidateID, leafKey,
 		)
 		if err != nil {
@@ -5412,12 +6367,13 @@ func (r *rpcServer) PushProof(ctx context.Context,
 	// then attempt to push the proof.
 	err = CheckFederationServer(
 		r.cfg.RuntimeID, universe.DefaultTimeout, remoteUniAddr,
+		r.cfg.Proxy,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	remoteUni, err := NewRpcUniverseRegistrar(remoteUniAddr)
+	remoteUni, err := NewRpcUniverseRegistrar(remoteUniAddr, r.cfg.Proxy)
 	if err != nil {
 		return nil, err
 	}
@@ -5634,6 +6590,7 @@ func (r *rpcServer) AddFederationServer(ctx context.Context,
 		// ourselves.
 		err := CheckFederationServer(
 			r.cfg.RuntimeID, universe.DefaultTimeout, server,
+			r.cfg.Proxy,
 		)
 		if err != nil {
 			return nil, err
@@ -5680,6 +6637,18 @@ func (r *rpcServer) SetFederationSyncConfig(ctx context.Context,
 	req *unirpc.SetFederationSyncConfigRequest) (
 	*unirpc.SetFederationSyncConfigResponse, error) {
 
+	// A global config that doesn't specify a sync quorum keeps the current
+	// one, so we need to know the current configs.
+	currentGlobalConfigs, _, err := r.cfg.FederationDB.
+		QueryFederationSyncConfigs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query federation sync "+
+			"config(s): %w", err)
+	}
+	currentConfigs := universe.SyncConfigs{
+		GlobalSyncConfigs: currentGlobalConfigs,
+	}
+
 	// Unmarshal global sync configs.
 	globalSyncConfig := make(
 		[]*universe.FedGlobalSyncConfig, len(req.GlobalSyncConfigs),
@@ -5693,10 +6662,20 @@ func (r *rpcServer) SetFederationSyncConfig(ctx context.Context,
 				"proof type: %w", err)
 		}
 
+		syncQuorum := currentConfigs.SyncQuorum(proofType)
+		if config.SyncQuorum != nil {
+			syncQuorum = *config.SyncQuorum
+		}
+		if syncQuorum > math.MaxInt32 {
+			return nil, fmt.Errorf("sync quorum must not exceed %d",
+				math.MaxInt32)
+		}
+
 		globalSyncConfig[i] = &universe.FedGlobalSyncConfig{
 			ProofType:       proofType,
 			AllowSyncInsert: config.AllowSyncInsert,
 			AllowSyncExport: config.AllowSyncExport,
+			SyncQuorum:      syncQuorum,
 		}
 	}
 
@@ -5715,8 +6694,24 @@ func (r *rpcServer) SetFederationSyncConfig(ctx context.Context,
 		assetSyncConfigs[i] = config
 	}
 
+	// Unmarshal server specific sync configs.
+	serverSyncConfigs := make(
+		[]*universe.FedServerSyncConfig, len(req.ServerSyncConfigs),
+	)
+	for i := range req.ServerSyncConfigs {
+		config, err := unmarshalServerSyncConfig(
+			req.ServerSyncConfigs[i],
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse server sync "+
+				"config: %w", err)
+		}
+
+		serverSyncConfigs[i] = config
+	}
+
 	// Update asset (asset/asset group) specific sync configs.
-	err := r.cfg.FederationDB.UpsertFederationSyncConfig(
+	err = r.cfg.FederationDB.UpsertFederationSyncConfig(
 		ctx, globalSyncConfig, assetSyncConfigs,
 	)
 	if err != nil {
@@ -5724,6 +6719,15 @@ func (r *rpcServer) SetFederationSyncConfig(ctx context.Context,
 			"config: %w", err)
 	}
 
+	// Update server specific sync configs.
+	err = r.cfg.FederationDB.UpsertFederationServerSyncConfigs(
+		ctx, serverSyncConfigs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to set federation server "+
+			"sync config: %w", err)
+	}
+
 	return &unirpc.SetFederationSyncConfigResponse{}, nil
 }
 
@@ -5758,6 +6762,7 @@ func (r *rpcServer) QueryFederationSyncConfig(ctx context.Context,
 			ProofType:       proofTypeRpc,
 			AllowSyncInsert: globalConfig.AllowSyncInsert,
 			AllowSyncExport: globalConfig.AllowSyncExport,
+			SyncQuorum:      fn.Ptr(globalConfig.SyncQuorum),
 		}
 	}
 
@@ -5775,9 +6780,21 @@ func (r *rpcServer) QueryFederationSyncConfig(ctx context.Context,
 		uniConfigRPCs[i] = uniConfigRPC
 	}
 
+	// Marshal server specific sync configs into the RPC form.
+	serverSyncConfigs, err := r.cfg.FederationDB.
+		QueryFederationServerSyncConfigs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query federation server "+
+			"sync config(s): %w", err)
+	}
+	serverConfigRPCs := fn.Map(
+		serverSyncConfigs, marshalServerFedSyncCfg,
+	)
+
 	return &unirpc.QueryFederationSyncConfigResponse{
 		GlobalSyncConfigs: globalConfigRPC,
 		AssetSyncConfigs:  uniConfigRPCs,
+		ServerSyncConfigs: serverConfigRPCs,
 	}, nil
 }
 
@@ -5839,9 +6856,12 @@ func (r *rpcServer) ProveAssetOwnership(ctx context.Context,
 
 	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
 	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
-	lastSnapshot, err := proofFile.Verify(
-		ctx, headerVerifier, proof.DefaultMerkleVerifier, groupVerifier,
-		r.cfg.ChainBridge.GenFileChainLookup(proofFile),
+	verifier := &proof.BaseVerifier{
+		CheckpointVerifier: r.cfg.ProofCheckpointVerifier,
+	}
+	lastSnapshot, err := verifier.Verify(
+		ctx, bytes.NewReader(proofBlob), headerVerifier,
+		proof.DefaultMerkleVerifier, groupVerifier, r.cfg.ChainBridge,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("cannot verify proof: %w", err)
@@ -6117,6 +7137,19 @@ func MarshalAssetFedSyncCfg(
 	}, nil
 }
 
+// marshalServerFedSyncCfg returns an RPC ready server specific federation sync
+// config.
+func marshalServerFedSyncCfg(
+	cfg *universe.FedServerSyncConfig) *unirpc.ServerFederationSyncConfig {
+
+	return &unirpc.ServerFederationSyncConfig{
+		Host:            cfg.ServerAddr.HostStr(),
+		AllowSyncInsert: cfg.AllowSyncInsert,
+		AllowSyncExport: cfg.AllowSyncExport,
+		TrustWeight:     cfg.TrustWeight,
+	}
+}
+
 // unmarshalAssetSpecifier unmarshals an asset specifier from the RPC form.
 func unmarshalAssetSpecifier(req *rfqrpc.AssetSpecifier) (*asset.ID,
 	*btcec.PublicKey, error) {
@@ -6473,6 +7506,7 @@ func marshalPeerAcceptedBuyQuotes(
 		[]*rfqrpc.PeerAcceptedBuyQuote, 0, len(quotes),
 	)
 	for scid, quote := range quotes {
+		sig := quote.Sig()
 		rpcQuote := &rfqrpc.PeerAcceptedBuyQuote{
 			Peer:        quote.Peer.String(),
 			Id:          quote.ID[:],
@@ -6480,6 +7514,8 @@ func marshalPeerAcceptedBuyQuotes(
 			AssetAmount: quote.Request.AssetAmount,
 			AskPrice:    uint64(quote.AskPrice),
 			Expiry:      quote.Expiry,
+			Signature:   sig[:],
+			SignedMsg:   quote.SigMsg(),
 		}
 		rpcQuotes = append(rpcQuotes, rpcQuote)
 	}
@@ -6496,6 +7532,7 @@ func marshalPeerAcceptedSellQuotes(
 	// Marshal the accepted quotes into the RPC form.
 	rpcQuotes := make([]*rfqrpc.PeerAcceptedSellQuote, 0, len(quotes))
 	for scid, quote := range quotes {
+		sig := quote.Sig()
 		rpcQuote := &rfqrpc.PeerAcceptedSellQuote{
 			Peer:        quote.Peer.String(),
 			Id:          quote.ID[:],
@@ -6503,6 +7540,8 @@ func marshalPeerAcceptedSellQuotes(
 			AssetAmount: quote.Request.AssetAmount,
 			BidPrice:    uint64(quote.BidPrice),
 			Expiry:      quote.Expiry,
+			Signature:   sig[:],
+			SignedMsg:   quote.SigMsg(),
 		}
 		rpcQuotes = append(rpcQuotes, rpcQuote)
 	}
@@ -6530,36 +7569,290 @@ func (r *rpcServer) QueryPeerAcceptedQuotes(_ context.Context,
 	}, nil
 }
 
-// marshallRfqEvent marshals an RFQ event into the RPC form.
-func marshallRfqEvent(eventInterface fn.Event) (*rfqrpc.RfqEvent, error) {
-	timestamp := eventInterface.Timestamp().UTC().UnixMicro()
+// unmarshalRateTickType converts an RPC rate tick type into its native
+// counterpart. An unspecified type results in an empty option.
+func unmarshalRateTickType(
+	t rfqrpc.RateTickType) (fn.Option[rfq.RateTickType], error) {
 
-	switch event := eventInterface.(type) {
-	case *rfq.PeerAcceptedBuyQuoteEvent:
-		acceptedQuote := taprpc.MarshalAcceptedBuyQuoteEvent(event)
-		eventRpc := &rfqrpc.RfqEvent_PeerAcceptedBuyQuote{
-			PeerAcceptedBuyQuote: &rfqrpc.PeerAcceptedBuyQuoteEvent{
-				Timestamp:            uint64(timestamp),
-				PeerAcceptedBuyQuote: acceptedQuote,
-			},
-		}
-		return &rfqrpc.RfqEvent{
-			Event: eventRpc,
-		}, nil
+	switch t {
+	case rfqrpc.RateTickType_RATE_TICK_TYPE_UNSPECIFIED:
+		return fn.None[rfq.RateTickType](), nil
 
-	case *rfq.PeerAcceptedSellQuoteEvent:
-		acceptedQuote := taprpc.MarshalAcceptedSellQuoteEvent(event)
-		eventRpc := &rfqrpc.RfqEvent_PeerAcceptedSellQuote{
-			PeerAcceptedSellQuote: &rfqrpc.PeerAcceptedSellQuoteEvent{
-				Timestamp:             uint64(timestamp),
-				PeerAcceptedSellQuote: acceptedQuote,
-			},
-		}
-		return &rfqrpc.RfqEvent{
-			Event: eventRpc,
-		}, nil
+	case rfqrpc.RateTickType_RATE_TICK_TYPE_ASK:
+		return fn.Some(rfq.RateTickTypeAsk), nil
 
-	case *rfq.AcceptHtlcEvent:
+	case rfqrpc.RateTickType_RATE_TICK_TYPE_BID:
+		return fn.Some(rfq.RateTickTypeBid), nil
+
+	default:
+		return fn.None[rfq.RateTickType](), fmt.Errorf("unknown rate "+
+			"tick type: %v", t)
+	}
+}
+
+// marshalRateTick marshals a rate tick into the RPC form.
+func marshalRateTick(tick rfq.RateTick) *rfqrpc.RateTick {
+	rpcTick := &rfqrpc.RateTick{
+		TickType:    rfqrpc.RateTickType_RATE_TICK_TYPE_ASK,
+		AssetAmount: tick.AssetAmount,
+		Rate:        uint64(tick.Rate),
+		Expiry:      uint64(tick.Expiry.Unix()),
+		Timestamp:   uint64(tick.Timestamp.Unix()),
+	}
+	if tick.Type == rfq.RateTickTypeBid {
+		rpcTick.TickType = rfqrpc.RateTickType_RATE_TICK_TYPE_BID
+	}
+	if tick.AssetID != nil {
+		rpcTick.AssetId = fn.CopySlice(tick.AssetID[:])
+	}
+	if tick.AssetGroupKey != nil {
+		rpcTick.GroupKey = tick.AssetGroupKey.SerializeCompressed()
+	}
+
+	return rpcTick
+}
+
+// QueryRateTicks is used to query the history of rate ticks that were received
+// from the price oracle.
+func (r *rpcServer) QueryRateTicks(ctx context.Context,
+	req *rfqrpc.QueryRateTicksRequest) (*rfqrpc.QueryRateTicksResponse,
+	error) {
+
+	var (
+		assetID  *asset.ID
+		groupKey *btcec.PublicKey
+		err      error
+	)
+	if req.AssetSpecifier != nil {
+		assetID, groupKey, err = unmarshalAssetSpecifier(
+			req.AssetSpecifier,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshalling asset "+
+				"specifier: %w", err)
+		}
+	}
+
+	tickType, err := unmarshalRateTickType(req.TickType)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Limit < 0 {
+		return nil, fmt.Errorf("limit must not be negative")
+	}
+
+	assetAmount := fn.None[uint64]()
+	if req.AssetAmount != 0 {
+		assetAmount = fn.Some(req.AssetAmount)
+	}
+
+	// If a point in time is given, we return the single rate tick that
+	// was valid at that time.
+	if req.ValidAtTimestamp != 0 {
+		if req.AssetSpecifier == nil || tickType.IsNone() {
+			return nil, fmt.Errorf("asset specifier and tick " +
+				"type must be set when querying by valid at " +
+				"time")
+		}
+
+		validAt := time.Unix(int64(req.ValidAtTimestamp), 0)
+		tick, err := r.cfg.RfqManager.RateTickAt(
+			ctx, assetID, groupKey,
+			tickType.UnwrapOr(rfq.RateTickTypeAsk), assetAmount,
+			validAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to query rate tick: %w",
+				err)
+		}
+
+		return &rfqrpc.QueryRateTicksResponse{
+			RateTicks: []*rfqrpc.RateTick{marshalRateTick(*tick)},
+		}, nil
+	}
+
+	query := rfq.RateTickQuery{
+		AssetID:       assetID,
+		AssetGroupKey: groupKey,
+		Type:          tickType,
+		AssetAmount:   assetAmount,
+		Limit:         req.Limit,
+	}
+	if req.StartTimestamp != 0 {
+		query.StartTime = time.Unix(int64(req.StartTimestamp), 0)
+	}
+	if req.EndTimestamp != 0 {
+		query.EndTime = time.Unix(int64(req.EndTimestamp), 0)
+	}
+
+	ticks, err := r.cfg.RfqManager.QueryRateTicks(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query rate ticks: %w", err)
+	}
+
+	return &rfqrpc.QueryRateTicksResponse{
+		RateTicks: fn.Map(ticks, marshalRateTick),
+	}, nil
+}
+
+// marshalHtlcFill marshals an HTLC fill into the RPC form.
+func marshalHtlcFill(fill rfq.HtlcFill) *rfqrpc.HtlcFill {
+	return &rfqrpc.HtlcFill{
+		IncomingChanId: fill.IncomingChanID.ToUint64(),
+		IncomingHtlcId: fill.IncomingHtlcID,
+		OutgoingChanId: fill.OutgoingChanID.ToUint64(),
+		AmountInMsat:   uint64(fill.AmountIn),
+		AmountOutMsat:  uint64(fill.AmountOut),
+		Timestamp:      uint64(fill.Timestamp.Unix()),
+	}
+}
+
+// marshalHistoricQuote marshals a stored quote and its HTLC fills into the
+// RPC form.
+func marshalHistoricQuote(quote rfq.StoredQuote,
+	fills []rfq.HtlcFill) (*rfqrpc.HistoricQuote, error) {
+
+	rpcQuote := &rfqrpc.HistoricQuote{
+		Id:              fn.CopySlice(quote.ID[:]),
+		Scid:            uint64(quote.Scid()),
+		Peer:            quote.Peer.String(),
+		QuoteType:       rfqrpc.QuoteType_QUOTE_TYPE_BUY,
+		LocallyAccepted: quote.LocallyAccepted,
+		AssetAmount:     quote.AssetAmount,
+		RequestPrice:    uint64(quote.RequestPrice),
+		AcceptedPrice:   uint64(quote.AcceptedPrice),
+		Expiry:          quote.Expiry,
+		Timestamp:       uint64(quote.CreatedAt.Unix()),
+		HtlcFills:       fn.Map(fills, marshalHtlcFill),
+	}
+	if quote.Type == rfq.QuoteTypeSell {
+		rpcQuote.QuoteType = rfqrpc.QuoteType_QUOTE_TYPE_SELL
+	}
+	if quote.AssetID != nil {
+		rpcQuote.AssetId = fn.CopySlice(quote.AssetID[:])
+	}
+	if quote.AssetGroupKey != nil {
+		rpcQuote.GroupKey = quote.AssetGroupKey.SerializeCompressed()
+	}
+
+	// Quotes accepted without a signature don't have a signed message
+	// either.
+	if quote.Signature != [64]byte{} {
+		sigMsg, err := quote.SigMsg()
+		if err != nil {
+			return nil, err
+		}
+
+		rpcQuote.Signature = fn.CopySlice(quote.Signature[:])
+		rpcQuote.SignedMsg = sigMsg
+	}
+
+	return rpcQuote, nil
+}
+
+// QueryQuoteHistory is used to query the history of quotes that were accepted
+// by our node or by our peers, including the HTLCs accepted against them.
+func (r *rpcServer) QueryQuoteHistory(ctx context.Context,
+	req *rfqrpc.QueryQuoteHistoryRequest) (
+	*rfqrpc.QueryQuoteHistoryResponse, error) {
+
+	var (
+		assetID  *asset.ID
+		groupKey *btcec.PublicKey
+		err      error
+	)
+	if req.AssetSpecifier != nil {
+		assetID, groupKey, err = unmarshalAssetSpecifier(
+			req.AssetSpecifier,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshalling asset "+
+				"specifier: %w", err)
+		}
+	}
+
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, fmt.Errorf("limit and offset must not be negative")
+	}
+
+	query := rfq.QuoteQuery{
+		AssetID:       assetID,
+		AssetGroupKey: groupKey,
+		Limit:         req.Limit,
+		Offset:        req.Offset,
+	}
+	if len(req.Peer) > 0 {
+		peer, err := route.NewVertexFromBytes(req.Peer)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing peer: %w", err)
+		}
+		query.Peer = &peer
+	}
+	if req.StartTimestamp != 0 {
+		query.StartTime = time.Unix(int64(req.StartTimestamp), 0)
+	}
+	if req.EndTimestamp != 0 {
+		query.EndTime = time.Unix(int64(req.EndTimestamp), 0)
+	}
+
+	quotes, err := r.cfg.RfqManager.QueryQuoteHistory(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query quote history: %w", err)
+	}
+
+	rpcQuotes := make([]*rfqrpc.HistoricQuote, 0, len(quotes))
+	for _, quote := range quotes {
+		fills, err := r.cfg.RfqManager.QueryHtlcFills(ctx, quote.ID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to query HTLC fills of "+
+				"quote %x: %w", quote.ID[:], err)
+		}
+
+		rpcQuote, err := marshalHistoricQuote(quote, fills)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal quote %x: %w",
+				quote.ID[:], err)
+		}
+
+		rpcQuotes = append(rpcQuotes, rpcQuote)
+	}
+
+	return &rfqrpc.QueryQuoteHistoryResponse{
+		Quotes: rpcQuotes,
+	}, nil
+}
+
+// marshallRfqEvent marshals an RFQ event into the RPC form.
+func marshallRfqEvent(eventInterface fn.Event) (*rfqrpc.RfqEvent, error) {
+	timestamp := eventInterface.Timestamp().UTC().UnixMicro()
+
+	switch event := eventInterface.(type) {
+	case *rfq.PeerAcceptedBuyQuoteEvent:
+		acceptedQuote := taprpc.MarshalAcceptedBuyQuoteEvent(event)
+		eventRpc := &rfqrpc.RfqEvent_PeerAcceptedBuyQuote{
+			PeerAcceptedBuyQuote: &rfqrpc.PeerAcceptedBuyQuoteEvent{
+				Timestamp:            uint64(timestamp),
+				PeerAcceptedBuyQuote: acceptedQuote,
+			},
+		}
+		return &rfqrpc.RfqEvent{
+			Event: eventRpc,
+		}, nil
+
+	case *rfq.PeerAcceptedSellQuoteEvent:
+		acceptedQuote := taprpc.MarshalAcceptedSellQuoteEvent(event)
+		eventRpc := &rfqrpc.RfqEvent_PeerAcceptedSellQuote{
+			PeerAcceptedSellQuote: &rfqrpc.PeerAcceptedSellQuoteEvent{
+				Timestamp:             uint64(timestamp),
+				PeerAcceptedSellQuote: acceptedQuote,
+			},
+		}
+		return &rfqrpc.RfqEvent{
+			Event: eventRpc,
+		}, nil
+
+	case *rfq.AcceptHtlcEvent:
 		eventRpc := &rfqrpc.RfqEvent_AcceptHtlc{
 			AcceptHtlc: &rfqrpc.AcceptHtlcEvent{
 				Timestamp: uint64(timestamp),
@@ -6599,10 +7892,7 @@ func (r *rpcServer) FundChannel(ctx context.Context,
 
 	// If we're not running inside litd, we cannot offer this functionality.
 	if !r.cfg.EnableChannelFeatures {
-		return nil, fmt.Errorf("the Taproot Asset channel " +
-			"functionality is only available when running inside " +
-			"Lightning Terminal daemon (litd), with lnd and tapd " +
-			"both running in 'integrated' mode")
+		return nil, errChannelFeaturesDisabled
 	}
 
 	peerPub, err := btcec.ParsePubKey(req.PeerPubkey)
@@ -6715,27 +8005,2041 @@ func (r *rpcServer) EncodeCustomRecords(_ context.Context,
 	}
 }
 
-// DeclareScriptKey declares a new script key to the wallet. This is useful
-// when the script key contains scripts, which would mean it wouldn't be
-// recognized by the wallet automatically. Declaring a script key will make any
-// assets sent to the script key be recognized as being local assets.
-func (r *rpcServer) DeclareScriptKey(ctx context.Context,
-	in *wrpc.DeclareScriptKeyRequest) (*wrpc.DeclareScriptKeyResponse,
-	error) {
+// channelAssetSpecifier creates an RFQ asset specifier from the asset ID or
+// group key given in one of the channel payment RPCs.
+func channelAssetSpecifier(assetID,
+	groupKey []byte) (*rfqrpc.AssetSpecifier, error) {
 
-	scriptKey, err := taprpc.UnmarshalScriptKey(in.ScriptKey)
+	switch {
+	case len(assetID) > 0 && len(groupKey) > 0:
+		return nil, fmt.Errorf("only one of asset ID or group key " +
+			"can be specified")
+
+	case len(assetID) > 0:
+		if len(assetID) != sha256.Size {
+			return nil, fmt.Errorf("asset ID must be 32 bytes")
+		}
+
+		return &rfqrpc.AssetSpecifier{
+			Id: &rfqrpc.AssetSpecifier_AssetId{
+				AssetId: assetID,
+			},
+		}, nil
+
+	case len(groupKey) > 0:
+		return &rfqrpc.AssetSpecifier{
+			Id: &rfqrpc.AssetSpecifier_GroupKey{
+				GroupKey: groupKey,
+			},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("either asset ID or group key must be " +
+			"specified")
+	}
+}
+
+// SendPayment is a wrapper around lnd's routerrpc.SendPaymentV2 RPC method
+// with asset specific parameters. It allows RPC users to send asset keysend
+// payments (direct payments) or payments to an invoice with a specified asset
+// amount.
+func (r *rpcServer) SendPayment(req *tchrpc.SendPaymentRequest,
+	stream tchrpc.TaprootAssetChannels_SendPaymentServer) error {
+
+	// If we're not running inside litd, we cannot offer this functionality.
+	if !r.cfg.EnableChannelFeatures {
+		return errChannelFeaturesDisabled
+	}
+
+	if req.AssetAmount == 0 {
+		return fmt.Errorf("asset amount must be specified")
+	}
+	if req.PaymentRequest == nil {
+		return fmt.Errorf("payment request must be specified")
+	}
+
+	// We'll set the first hop custom records ourselves, so we don't allow
+	// the user to specify them.
+	pReq := req.PaymentRequest
+	if len(pReq.FirstHopCustomRecords) > 0 {
+		return fmt.Errorf("first hop custom records cannot be set " +
+			"manually")
+	}
+
+	specifier, err := channelAssetSpecifier(req.AssetId, req.GroupKey)
 	if err != nil {
-		return nil, fmt.Errorf("error unmarshalling script key: %w",
-			err)
+		return err
 	}
 
-	err = r.cfg.TapAddrBook.InsertScriptKey(ctx, *scriptKey, true)
+	ctx := stream.Context()
+
+	var htlc *rfqmsg.Htlc
+	switch {
+	// If a payment request (invoice) is given, we need to convert the
+	// invoice amount from satoshis to asset units. We'll ask our peer for
+	// a quote first, and then reference that quote in the HTLC.
+	case pReq.PaymentRequest != "":
+		if len(req.PeerPubkey) == 0 {
+			return fmt.Errorf("peer pubkey must be specified " +
+				"when paying an invoice")
+		}
+
+		invoice, err := r.cfg.Lnd.Client.DecodePaymentRequest(
+			ctx, pReq.PaymentRequest,
+		)
+		if err != nil {
+			return fmt.Errorf("error decoding payment request: %w",
+				err)
+		}
+
+		if invoice.Value == 0 {
+			return fmt.Errorf("cannot pay invoices without an " +
+				"amount")
+		}
+
+		expiry := time.Now().Add(rfq.DefaultTimeout).Unix()
+		timeoutSeconds := uint32(rfq.DefaultTimeout.Seconds())
+		resp, err := r.AddAssetSellOrder(
+			ctx, &rfqrpc.AddAssetSellOrderRequest{
+				AssetSpecifier: specifier,
+				MaxAssetAmount: req.AssetAmount,
+				MinAsk:         uint64(invoice.Value),
+				Expiry:         uint64(expiry),
+				PeerPubKey:     req.PeerPubkey,
+				TimeoutSeconds: timeoutSeconds,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("error adding sell order: %w", err)
+		}
+
+		quote := resp.GetAcceptedQuote()
+		if quote == nil {
+			return fmt.Errorf("peer did not accept sell order: %v",
+				resp.GetResponse())
+		}
+
+		// Make sure we don't spend more assets than the user is
+		// willing to.
+		if quote.BidPrice == 0 {
+			return fmt.Errorf("peer accepted quote with zero bid " +
+				"price")
+		}
+		bidPrice := lnwire.MilliSatoshi(quote.BidPrice)
+		numUnits := uint64((invoice.Value + bidPrice - 1) / bidPrice)
+		if numUnits > req.AssetAmount {
+			return fmt.Errorf("paying the invoice requires %d "+
+				"asset units, which is more than the maximum "+
+				"of %d", numUnits, req.AssetAmount)
+		}
+
+		rpcsLog.Debugf("[SendPayment]: paying invoice of %v with "+
+			"%d asset units (rfq_id=%x)", invoice.Value, numUnits,
+			quote.Id)
+
+		err = stream.Send(&tchrpc.SendPaymentResponse{
+			Result: &tchrpc.SendPaymentResponse_AcceptedSellOrder{
+				AcceptedSellOrder: quote,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error sending accepted quote: %w",
+				err)
+		}
+
+		var rfqID rfqmsg.ID
+		copy(rfqID[:], quote.Id)
+		htlc = rfqmsg.NewHtlc(nil, fn.Some(rfqID))
+
+	// Without a payment request, this is a keysend payment where we
+	// directly send the specified amount of asset units. This requires a
+	// specific asset ID.
+	default:
+		if len(req.AssetId) == 0 {
+			return fmt.Errorf("asset ID must be specified for " +
+				"keysend payments")
+		}
+
+		var assetID asset.ID
+		copy(assetID[:], req.AssetId)
+
+		htlc = rfqmsg.NewHtlc([]*rfqmsg.AssetBalance{
+			rfqmsg.NewAssetBalance(assetID, req.AssetAmount),
+		}, fn.None[rfqmsg.ID]())
+
+		// The satoshi amount of the HTLC is only used to carry the
+		// assets, so we use the minimal on-chain amount if the user
+		// didn't specify one.
+		if pReq.Amt == 0 && pReq.AmtMsat == 0 {
+			pReq.Amt = int64(tapchannel.DefaultOnChainHtlcAmount)
+		}
+	}
+
+	pReq.FirstHopCustomRecords, err = tlv.RecordsToMap(htlc.Records())
 	if err != nil {
-		return nil, fmt.Errorf("error inserting script key: %w", err)
+		return fmt.Errorf("unable to encode records as map: %w", err)
 	}
 
-	return &wrpc.DeclareScriptKeyResponse{
-		ScriptKey: taprpc.MarshalScriptKey(*scriptKey),
+	payStream, err := r.cfg.LndPayments.SendPayment(ctx, pReq)
+	if err != nil {
+		return fmt.Errorf("error sending payment: %w", err)
+	}
+
+	for {
+		payment, err := payStream.Recv()
+		switch {
+		// The stream is closed by lnd once the payment reached a final
+		// state.
+		case err == io.EOF:
+			return nil
+
+		case err != nil:
+			return fmt.Errorf("error receiving payment update: %w",
+				err)
+		}
+
+		err = stream.Send(&tchrpc.SendPaymentResponse{
+			Result: &tchrpc.SendPaymentResponse_PaymentResult{
+				PaymentResult: payment,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error sending payment update: %w",
+				err)
+		}
+	}
+}
+
+// AddInvoice is a wrapper around lnd's lnrpc.AddInvoice method with asset
+// specific parameters. It allows RPC users to create invoices that correspond
+// to the specified asset amount.
+func (r *rpcServer) AddInvoice(ctx context.Context,
+	req *tchrpc.AddInvoiceRequest) (*tchrpc.AddInvoiceResponse, error) {
+
+	// If we're not running inside litd, we cannot offer this functionality.
+	if !r.cfg.EnableChannelFeatures {
+		return nil, errChannelFeaturesDisabled
+	}
+
+	if req.AssetAmount == 0 {
+		return nil, fmt.Errorf("asset amount must be specified")
+	}
+
+	peer, err := route.NewVertexFromBytes(req.PeerPubkey)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing peer pubkey: %w", err)
+	}
+
+	// The amount and route hints are derived from the quote, so they
+	// cannot be specified by the user.
+	iReq := req.InvoiceRequest
+	if iReq == nil {
+		iReq = &lnrpc.Invoice{}
+	}
+	if iReq.Value != 0 || iReq.ValueMsat != 0 {
+		return nil, fmt.Errorf("invoice amount is derived from the " +
+			"asset amount and cannot be set")
+	}
+	if len(iReq.RouteHints) > 0 {
+		return nil, fmt.Errorf("invoice route hints are derived from " +
+			"the quote and cannot be set")
+	}
+
+	specifier, err := channelAssetSpecifier(req.AssetId, req.GroupKey)
+	if err != nil {
+		return nil, err
+	}
+
+	expiry := time.Now().Add(rfq.DefaultTimeout).Unix()
+	resp, err := r.AddAssetBuyOrder(ctx, &rfqrpc.AddAssetBuyOrderRequest{
+		AssetSpecifier: specifier,
+		MinAssetAmount: req.AssetAmount,
+		Expiry:         uint64(expiry),
+		PeerPubKey:     peer[:],
+		TimeoutSeconds: uint32(rfq.DefaultTimeout.Seconds()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error adding buy order: %w", err)
+	}
+
+	quote := resp.GetAcceptedQuote()
+	if quote == nil {
+		return nil, fmt.Errorf("peer did not accept buy order: %v",
+			resp.GetResponse())
+	}
+
+	// The peer will convert the incoming satoshis into asset units at the
+	// accepted ask price, so that's what the invoice amount is based on.
+	amtMsat := lnwire.MilliSatoshi(quote.AskPrice) *
+		lnwire.MilliSatoshi(req.AssetAmount)
+
+	hopHint, err := r.rfqHopHint(ctx, peer, quote.Scid)
+	if err != nil {
+		return nil, fmt.Errorf("error creating hop hint: %w", err)
+	}
+
+	rpcsLog.Debugf("[AddInvoice]: creating invoice of %v for %d asset "+
+		"units (rfq_id=%x)", amtMsat, req.AssetAmount, quote.Id)
+
+	iReq.ValueMsat = int64(amtMsat)
+	iReq.RouteHints = []*lnrpc.RouteHint{{
+		HopHints: []*lnrpc.HopHint{hopHint},
+	}}
+
+	invoiceResp, err := r.cfg.LndPayments.AddInvoice(ctx, iReq)
+	if err != nil {
+		return nil, fmt.Errorf("error creating invoice: %w", err)
+	}
+
+	return &tchrpc.AddInvoiceResponse{
+		AcceptedBuyQuote: quote,
+		InvoiceResult:    invoiceResp,
+	}, nil
+}
+
+// rfqHopHint creates a hop hint that instructs the payer to route the payment
+// through the given peer, using the SCID of an accepted RFQ quote. The routing
+// policy of the hint is taken from one of our active channels with the peer.
+func (r *rpcServer) rfqHopHint(ctx context.Context, peer route.Vertex,
+	scid uint64) (*lnrpc.HopHint, error) {
+
+	channels, err := r.cfg.Lnd.Client.ListChannels(ctx, true, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list channels: %w", err)
+	}
+
+	for _, channel := range channels {
+		if channel.PubKeyBytes != peer {
+			continue
+		}
+
+		edge, err := r.cfg.Lnd.Client.GetChanInfo(
+			ctx, channel.ChannelID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch channel "+
+				"info: %w", err)
+		}
+
+		// We need the policy of the peer, as the hop hint describes
+		// the last hop from the peer to us.
+		policy := edge.Node2Policy
+		if edge.Node1 == peer {
+			policy = edge.Node1Policy
+		}
+		if policy == nil {
+			continue
+		}
+
+		feeRate := uint32(policy.FeeRateMilliMsat)
+		return &lnrpc.HopHint{
+			NodeId:                    peer.String(),
+			ChanId:                    scid,
+			FeeBaseMsat:               uint32(policy.FeeBaseMsat),
+			FeeProportionalMillionths: feeRate,
+			CltvExpiryDelta:           policy.TimeLockDelta,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no active channel with peer %v found", peer)
+}
+
+// DeclareScriptKey declares a new script key to the wallet. This is useful
+// when the script key contains scripts, which would mean it wouldn't be
+// recognized by the wallet automatically. Declaring a script key will make any
+// assets sent to the script key be recognized as being local assets.
+func (r *rpcServer) DeclareScriptKey(ctx context.Context,
+	in *wrpc.DeclareScriptKeyRequest) (*wrpc.DeclareScriptKeyResponse,
+	error) {
+
+	scriptKey, err := taprpc.UnmarshalScriptKey(in.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling script key: %w",
+			err)
+	}
+
+	err = r.cfg.TapAddrBook.InsertScriptKey(ctx, *scriptKey, true)
+	if err != nil {
+		return nil, fmt.Errorf("error inserting script key: %w", err)
+	}
+
+	return &wrpc.DeclareScriptKeyResponse{
+		ScriptKey: taprpc.MarshalScriptKey(*scriptKey),
+	}, nil
+}
+
+// ConsolidateAssets spends all available coins of the given asset ID or asset
+// group back to the wallet in a single transfer. All coins are merged into a
+// single new anchor output, with one fresh script key per asset ID. If dry_run
+// is set, no transfer is created and only the expected chain fee and proof
+// size are returned.
+func (r *rpcServer) ConsolidateAssets(ctx context.Context,
+	req *wrpc.ConsolidateAssetsRequest) (*wrpc.ConsolidateAssetsResponse,
+	error) {
+
+	var constraints tapfreighter.CommitmentConstraints
+	switch {
+	case len(req.AssetId) > 0 && len(req.GroupKey) > 0:
+		return nil, fmt.Errorf("cannot set both asset ID and group key")
+
+	case len(req.AssetId) > 0:
+		if len(req.AssetId) != sha256.Size {
+			return nil, fmt.Errorf("asset ID must be 32 bytes")
+		}
+
+		var assetID asset.ID
+		copy(assetID[:], req.AssetId)
+		constraints.AssetID = &assetID
+
+	case len(req.GroupKey) > 0:
+		groupKey, err := btcec.ParsePubKey(req.GroupKey)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing group key: %w",
+				err)
+		}
+		constraints.GroupKey = groupKey
+
+	default:
+		return nil, fmt.Errorf("either asset ID or group key must be " +
+			"set")
+	}
+
+	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	// For the estimate we need a concrete fee rate, so we'll ask the
+	// backend if none was specified. The same fee rate is then used for
+	// the actual transfer, so the estimate matches what is paid.
+	if feeRate == nil {
+		estimatedRate, err := r.cfg.ChainBridge.EstimateFee(
+			ctx, tapsend.SendConfTarget,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to estimate fee: %w",
+				err)
+		}
+		feeRate = &estimatedRate
+	}
+
+	if req.DryRun {
+		constraints.Bip86ScriptKeysOnly = true
+		coins, err := r.cfg.CoinSelect.ListAllCoins(
+			ctx, constraints, commitment.TapCommitmentV2,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error listing coins: %w", err)
+		}
+
+		return r.consolidationEstimate(ctx, coins, *feeRate)
+	}
+
+	fundedPackets, err := r.cfg.AssetWallet.FundConsolidation(
+		ctx, constraints,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error funding consolidation: %w", err)
+	}
+
+	// If we can't hand the transfer over to the porter, we release the
+	// coins the wallet leased for it.
+	success := false
+	defer func() {
+		if success {
+			return
+		}
+
+		anchors := vPacketAnchors(fn.Map(
+			fundedPackets,
+			func(p *tapfreighter.FundedVPacket) *tappsbt.VPacket {
+				return p.VPacket
+			},
+		))
+		err := r.cfg.CoinSelect.ReleaseCoins(ctx, anchors...)
+		if err != nil {
+			rpcsLog.Errorf("Error releasing consolidation "+
+				"coins: %v", err)
+		}
+	}()
+
+	var (
+		vPackets         []*tappsbt.VPacket
+		inputCommitments = make(tappsbt.InputCommitments)
+		coins            []*tapfreighter.AnchoredCommitment
+	)
+	for _, fundedPkt := range fundedPackets {
+		_, err := r.cfg.AssetWallet.SignVirtualPacket(fundedPkt.VPacket)
+		if err != nil {
+			return nil, fmt.Errorf("error signing packet: %w", err)
+		}
+
+		vPackets = append(vPackets, fundedPkt.VPacket)
+		for prevID, c := range fundedPkt.InputCommitments {
+			inputCommitments[prevID] = c
+		}
+
+		for _, vIn := range fundedPkt.VPacket.Inputs {
+			coins = append(coins, &tapfreighter.AnchoredCommitment{
+				AnchorPoint: vIn.PrevID.OutPoint,
+				Asset:       vIn.Asset(),
+			})
+		}
+	}
+
+	resp, err := r.consolidationEstimate(ctx, coins, *feeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	outboundParcel, err := r.cfg.ChainPorter.RequestShipment(
+		tapfreighter.NewPreSignedParcel(
+			vPackets, inputCommitments, feeRate,
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting delivery: %w", err)
+	}
+
+	// The porter now owns the leases of the coins.
+	success = true
+
+	resp.Transfer, err = marshalOutboundParcel(outboundParcel)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	return resp, nil
+}
+
+// ExportWallet exports the complete asset wallet into a single, versioned
+// bundle. The bundle contains all proof files, the derivation information of
+// all internal and script keys and the history of all confirmed transfers.
+func (r *rpcServer) ExportWallet(ctx context.Context,
+	_ *wrpc.ExportWalletRequest) (*wrpc.ExportWalletResponse, error) {
+
+	bundle, err := r.cfg.AssetStore.ExportWallet(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to export wallet: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := bundle.Encode(&buf); err != nil {
+		return nil, fmt.Errorf("unable to encode wallet bundle: %w",
+			err)
+	}
+
+	return &wrpc.ExportWalletResponse{
+		Bundle:          buf.Bytes(),
+		NumProofs:       uint32(len(bundle.Proofs)),
+		NumInternalKeys: uint32(len(bundle.InternalKeys)),
+		NumScriptKeys:   uint32(len(bundle.ScriptKeys)),
+		NumTransfers:    uint32(len(bundle.Transfers)),
+	}, nil
+}
+
+// ImportWallet restores an asset wallet from a bundle created by the
+// ExportWallet RPC. Every proof in the bundle is verified before anything is
+// written to the database, which is then restored in a single transaction. A
+// failed import can be retried with the same bundle.
+func (r *rpcServer) ImportWallet(ctx context.Context,
+	req *wrpc.ImportWalletRequest) (*wrpc.ImportWalletResponse, error) {
+
+	if len(req.Bundle) == 0 {
+		return nil, fmt.Errorf("wallet bundle must be specified")
+	}
+
+	bundle, err := tapdb.DecodeWalletBundle(req.Bundle)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode wallet bundle: %w",
+			err)
+	}
+
+	// We need the locator of each proof file to store it, which we
+	// extract from the last proof in the file.
+	var (
+		proofFiles      = make([]*proof.File, 0, len(bundle.Proofs))
+		annotatedProofs = make(
+			[]*proof.AnnotatedProof, 0, len(bundle.Proofs),
+		)
+	)
+	for _, walletProof := range bundle.Proofs {
+		proofFile, err := proof.DecodeFile(walletProof.Blob)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode proof file: "+
+				"%w", err)
+		}
+		proofFiles = append(proofFiles, proofFile)
+
+		lastProof, err := proofFile.LastProof()
+		if err != nil {
+			return nil, fmt.Errorf("error extracting last proof: "+
+				"%w", err)
+		}
+
+		locator := proof.Locator{
+			AssetID:   fn.Ptr(lastProof.Asset.ID()),
+			ScriptKey: *lastProof.Asset.ScriptKey.PubKey,
+			OutPoint:  fn.Ptr(lastProof.OutPoint()),
+		}
+		annotatedProofs = append(
+			annotatedProofs, &proof.AnnotatedProof{
+				Locator: locator,
+				Blob:    walletProof.Blob,
+			},
+		)
+	}
+
+	// Asset groups are only known once the proofs are imported, so we
+	// also accept the group keys revealed by the genesis proofs in the
+	// bundle.
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	groupVerifier, err := tapgarden.GenProofFileGroupVerifier(
+		proofFiles, tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// We need to make sure all proofs are valid before we write anything
+	// to the database. The verified asset snapshots are needed to import
+	// the assets.
+	verifier := proof.BaseVerifier{
+		CheckpointVerifier: r.cfg.ProofCheckpointVerifier,
+	}
+	err = fn.ParSlice(
+		ctx, annotatedProofs, func(ctx context.Context,
+			p *proof.AnnotatedProof) error {
+
+			snapshot, err := verifier.Verify(
+				ctx, bytes.NewReader(p.Blob), headerVerifier,
+				proof.DefaultMerkleVerifier, groupVerifier,
+				r.cfg.ChainBridge,
+			)
+			if err != nil {
+				return fmt.Errorf("invalid proof for asset "+
+					"%v: %w", p.Locator.AssetID, err)
+			}
+
+			p.AssetSnapshot = snapshot
+			if snapshot.Asset.GroupKey != nil {
+				p.Locator.GroupKey = fn.Ptr(
+					snapshot.Asset.GroupKey.GroupPubKey,
+				)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The backing lnd wallet needs to know about the anchor outputs of
+	// the unspent assets to be able to sign for them. Importing an output
+	// twice is harmless, so we do this before writing to the database.
+	for idx, p := range annotatedProofs {
+		if bundle.Proofs[idx].Spent {
+			continue
+		}
+
+		err := r.importAnchorOutput(ctx, p.AssetSnapshot)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The keys, transfers and assets are written in a single database
+	// transaction, so a failed import doesn't leave a partially imported
+	// wallet behind.
+	err = r.cfg.AssetStore.ImportWallet(ctx, bundle, annotatedProofs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import wallet: %w", err)
+	}
+
+	// Finally, we also write the proof files to the remaining proof
+	// archives. Replacing the proofs is idempotent, so this step is
+	// repeated if the import is retried.
+	err = r.cfg.ProofArchive.ImportProofs(
+		ctx, headerVerifier, proof.DefaultMerkleVerifier, groupVerifier,
+		r.cfg.ChainBridge, true, annotatedProofs...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import proofs: %w", err)
+	}
+
+	rpcsLog.Infof("Imported wallet bundle with %d proofs, %d internal "+
+		"keys, %d script keys and %d transfers", len(bundle.Proofs),
+		len(bundle.InternalKeys), len(bundle.ScriptKeys),
+		len(bundle.Transfers))
+
+	return &wrpc.ImportWalletResponse{
+		NumProofs:       uint32(len(bundle.Proofs)),
+		NumInternalKeys: uint32(len(bundle.InternalKeys)),
+		NumScriptKeys:   uint32(len(bundle.ScriptKeys)),
+		NumTransfers:    uint32(len(bundle.Transfers)),
+	}, nil
+}
+
+// importAnchorOutput imports the Taproot output key of the anchor output of
+// the given asset into the backing lnd wallet.
+func (r *rpcServer) importAnchorOutput(ctx context.Context,
+	snapshot *proof.AssetSnapshot) error {
+
+	outputKey, err := proof.ExtractTaprootKey(
+		snapshot.AnchorTx, snapshot.OutputIndex,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to extract anchor output key: %w",
+			err)
+	}
+
+	_, err = r.cfg.WalletAnchor.ImportTaprootOutput(ctx, outputKey)
+	switch {
+	case err == nil:
+
+	// The output is already known to the wallet if the import is retried.
+	case errors.Is(err, tapsend.ErrOutputAlreadyImported):
+
+	default:
+		return fmt.Errorf("unable to import anchor output: %w", err)
+	}
+
+	return nil
+}
+
+// consolidationEstimate returns the expected chain fee and proof size of a
+// consolidation of the given coins. The fee assumes that all distinct anchor
+// outputs as well as one additional wallet input are spent into a single
+// anchor output and a change output. Because the proofs of all but one input
+// are embedded into the proof of the merged asset, the proof size is estimated
+// as the sum of the proof files of all inputs.
+func (r *rpcServer) consolidationEstimate(ctx context.Context,
+	coins []*tapfreighter.AnchoredCommitment,
+	feeRate chainfee.SatPerKWeight) (*wrpc.ConsolidateAssetsResponse,
+	error) {
+
+	var (
+		totalAmount uint64
+		proofSize   uint64
+		anchors     = make(map[wire.OutPoint]struct{})
+	)
+	for _, coin := range coins {
+		totalAmount += coin.Asset.Amount
+		anchors[coin.AnchorPoint] = struct{}{}
+
+		assetID := coin.Asset.ID()
+		proofBlob, err := r.cfg.ProofArchive.FetchProof(
+			ctx, proof.Locator{
+				AssetID:   &assetID,
+				ScriptKey: *coin.Asset.ScriptKey.PubKey,
+				OutPoint:  fn.Ptr(coin.AnchorPoint),
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("cannot fetch proof: %w", err)
+		}
+		proofSize += uint64(len(proofBlob))
+	}
+
+	// All anchor outputs are P2TR, and we assume lnd adds a single P2TR
+	// input for the fees.
+	inputScripts := make([][]byte, 0, len(anchors)+1)
+	for i := 0; i < len(anchors)+1; i++ {
+		inputScripts = append(inputScripts, tapsend.GenesisDummyScript)
+	}
+	outputs := []*wire.TxOut{{
+		Value:    int64(tapsend.DummyAmtSats),
+		PkScript: tapsend.GenesisDummyScript,
+	}, {
+		Value:    int64(tapsend.DummyAmtSats),
+		PkScript: tapsend.GenesisDummyScript,
+	}}
+	_, fee := tapscript.EstimateFee(inputScripts, outputs, feeRate)
+
+	return &wrpc.ConsolidateAssetsResponse{
+		NumInputs:               uint32(len(coins)),
+		TotalAmount:             totalAmount,
+		EstimatedFeeSats:        int64(fee),
+		EstimatedProofSizeBytes: proofSize,
+	}, nil
+}
+
+// swapTerms is the decoded form of the terms of an atomic swap.
+type swapTerms struct {
+	// offerID is the ID of the asset the maker offers.
+	offerID asset.ID
+
+	// offerAmount is the number of units of the offered asset.
+	offerAmount uint64
+
+	// requestAmount is the number of units of the requested asset or the
+	// amount of satoshis of the requested BTC payment.
+	requestAmount uint64
+
+	// makerTapAddr is the address the requested asset is paid to. This is
+	// nil if the maker requests BTC.
+	makerTapAddr *address.Tap
+
+	// makerBtcAddr is the address the requested BTC is paid to. This is
+	// nil if the maker requests an asset.
+	makerBtcAddr btcutil.Address
+}
+
+// requestsBtc returns true if the maker requests BTC instead of an asset.
+func (t *swapTerms) requestsBtc() bool {
+	return t.makerTapAddr == nil
+}
+
+// swapLeg is the decoded form of one leg of an atomic swap.
+type swapLeg struct {
+	// activePackets are the virtual packets that pay the counterparty.
+	activePackets []*tappsbt.VPacket
+
+	// passivePackets are the virtual packets of the passive assets that
+	// are re-anchored by the leg.
+	passivePackets []*tappsbt.VPacket
+
+	// inputProofs are the full proof files of all assets spent by the
+	// virtual packets of the leg.
+	inputProofs [][]byte
+}
+
+// allPackets returns both the active and passive virtual packets of the leg.
+func (l *swapLeg) allPackets() []*tappsbt.VPacket {
+	allPackets := append([]*tappsbt.VPacket{}, l.activePackets...)
+	return append(allPackets, l.passivePackets...)
+}
+
+// ProposeSwap creates the terms of an atomic swap of assets for other assets
+// or for BTC, with this node acting as the maker of the swap.
+func (r *rpcServer) ProposeSwap(ctx context.Context,
+	req *wrpc.ProposeSwapRequest) (*wrpc.ProposeSwapResponse, error) {
+
+	if len(req.OfferAssetId) != 32 {
+		return nil, fmt.Errorf("invalid offer asset id length")
+	}
+	if req.OfferAmount == 0 || req.RequestAmount == 0 {
+		return nil, fmt.Errorf("offer and request amount must be set")
+	}
+
+	var offerID asset.ID
+	copy(offerID[:], req.OfferAssetId)
+
+	terms := &wrpc.SwapTerms{
+		OfferAssetId:   req.OfferAssetId,
+		OfferAmount:    req.OfferAmount,
+		RequestAssetId: req.RequestAssetId,
+		RequestAmount:  req.RequestAmount,
+	}
+
+	switch len(req.RequestAssetId) {
+	// No asset is requested, so we want to receive BTC on a new address of
+	// our lnd wallet.
+	case 0:
+		addr, err := r.cfg.Lnd.WalletKit.NextAddr(
+			ctx, "", walletrpc.AddressType_TAPROOT_PUBKEY, false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to derive BTC address: "+
+				"%w", err)
+		}
+
+		terms.MakerAddr = addr.String()
+
+		rpcsLog.Infof("[ProposeSwap]: proposing swap of %d units of "+
+			"asset_id=%v for %d sats", req.OfferAmount, offerID,
+			req.RequestAmount)
+
+	case 32:
+		var requestID asset.ID
+		copy(requestID[:], req.RequestAssetId)
+
+		if requestID == offerID {
+			return nil, fmt.Errorf("offered and requested asset " +
+				"must differ")
+		}
+
+		addr, err := r.newSwapAddr(ctx, requestID, req.RequestAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		terms.MakerAddr, err = addr.EncodeAddress()
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode address: %w",
+				err)
+		}
+
+		rpcsLog.Infof("[ProposeSwap]: proposing swap of %d units of "+
+			"asset_id=%v for %d units of asset_id=%v",
+			req.OfferAmount, offerID, req.RequestAmount, requestID)
+
+	default:
+		return nil, fmt.Errorf("invalid request asset id length")
+	}
+
+	return &wrpc.ProposeSwapResponse{
+		Terms: terms,
+	}, nil
+}
+
+// AcceptSwap accepts the terms of an atomic swap with this node acting as the
+// taker of the swap.
+func (r *rpcServer) AcceptSwap(ctx context.Context,
+	req *wrpc.AcceptSwapRequest) (*wrpc.AcceptSwapResponse, error) {
+
+	terms, err := r.unmarshalSwapTerms(req.Terms)
+	if err != nil {
+		return nil, err
+	}
+
+	// We receive the offered asset on a new address of our own.
+	takerAddr, err := r.newSwapAddr(ctx, terms.offerID, terms.offerAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &wrpc.AcceptSwapResponse{}
+	resp.TakerAddr, err = takerAddr.EncodeAddress()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode address: %w", err)
+	}
+
+	// If we pay an asset, the change of our leg is anchored in the first
+	// output of the anchor transaction and the payment in the second one.
+	if !terms.requestsBtc() {
+		leg, err := r.fundSwapLeg(ctx, terms.makerTapAddr, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.TakerLeg, err = marshalSwapLeg(leg)
+		if err != nil {
+			r.releaseSwapLeg(ctx, leg)
+			return nil, err
+		}
+
+		return resp, nil
+	}
+
+	// Otherwise we fund the requested BTC payment from our lnd wallet. The
+	// maker then adds its inputs and outputs to the anchor transaction.
+	makerAddr := terms.makerBtcAddr.String()
+	fundRequest := &walletrpc.FundPsbtRequest{
+		Template: &walletrpc.FundPsbtRequest_Raw{
+			Raw: &walletrpc.TxTemplate{
+				Outputs: map[string]uint64{
+					makerAddr: terms.requestAmount,
+				},
+			},
+		},
+		MinConfs:   1,
+		ChangeType: P2TRChangeType,
+	}
+	switch fee := req.Fees.(type) {
+	case *wrpc.AcceptSwapRequest_TargetConf:
+		fundRequest.Fees = &walletrpc.FundPsbtRequest_TargetConf{
+			TargetConf: fee.TargetConf,
+		}
+
+	case *wrpc.AcceptSwapRequest_SatPerVbyte:
+		fundRequest.Fees = &walletrpc.FundPsbtRequest_SatPerVbyte{
+			SatPerVbyte: fee.SatPerVbyte,
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown fee type")
+	}
+
+	btcPayment, _, lockedUTXO, err := r.cfg.Lnd.WalletKit.FundPsbt(
+		ctx, fundRequest,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error funding BTC payment: %w", err)
+	}
+
+	resp.BtcPaymentPsbt, err = serialize(btcPayment)
+	if err != nil {
+		r.releaseLndLeases(ctx, lockedUTXO)
+		return nil, fmt.Errorf("error serializing packet: %w", err)
+	}
+	resp.LndLockedUtxos = marshalOutPoints(lndLeaseOutpoints(lockedUTXO))
+
+	return resp, nil
+}
+
+// SignSwap verifies the leg of the taker of an atomic swap, funds and signs
+// the leg of the maker and anchors both legs in a single BTC level anchor
+// transaction.
+func (r *rpcServer) SignSwap(ctx context.Context,
+	req *wrpc.SignSwapRequest) (*wrpc.SignSwapResponse, error) {
+
+	if err := r.assertFundPsbtCoinSelect(); err != nil {
+		return nil, err
+	}
+
+	terms, err := r.unmarshalSwapTerms(req.Terms)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Acceptance == nil {
+		return nil, fmt.Errorf("swap acceptance must be set")
+	}
+
+	takerAddr, err := r.unmarshalTakerAddr(terms, req.Acceptance.TakerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Before we spend anything, we make sure the taker actually pays what
+	// we requested. Our own leg is anchored in the outputs after the ones
+	// used by the leg of the taker.
+	var (
+		takerLeg          *swapLeg
+		btcPayment        *psbt.Packet
+		changeOutputIndex uint32
+	)
+	if terms.requestsBtc() {
+		btcPayment, err = psbt.NewFromRawBytes(
+			bytes.NewReader(req.Acceptance.BtcPaymentPsbt), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding BTC payment: %w",
+				err)
+		}
+
+		err = assertBtcPayment(btcPayment.UnsignedTx, terms)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		takerLeg, err = unmarshalSwapLeg(req.Acceptance.TakerLeg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid taker leg: %w", err)
+		}
+
+		err = r.verifySwapLeg(ctx, takerLeg, terms.makerTapAddr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid taker leg: %w", err)
+		}
+
+		for _, vPkt := range takerLeg.allPackets() {
+			for _, vOut := range vPkt.Outputs {
+				changeOutputIndex = max(
+					changeOutputIndex,
+					vOut.AnchorOutputIndex+1,
+				)
+			}
+		}
+	}
+
+	makerLeg, err := r.fundSwapLeg(ctx, takerAddr, changeOutputIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	// From now on, if we error out, we need to make sure we release the
+	// asset coins of our leg and the UTXOs lnd locks for us below.
+	var (
+		lockedUTXO []*walletrpc.UtxoLease
+		success    = false
+	)
+	defer func() {
+		if !success {
+			r.releaseSwapLeg(ctx, makerLeg)
+			r.releaseLndLeases(ctx, lockedUTXO)
+		}
+	}()
+
+	// Both legs are anchored in a single BTC level anchor transaction. The
+	// inputs and outputs of a BTC payment are simply added to it.
+	var allPackets []*tappsbt.VPacket
+	if takerLeg != nil {
+		allPackets = append(allPackets, takerLeg.allPackets()...)
+	}
+	allPackets = append(allPackets, makerLeg.allPackets()...)
+
+	anchorPkt, err := tapsend.PrepareAnchoringTemplate(allPackets)
+	if err != nil {
+		return nil, fmt.Errorf("error creating anchor template: %w",
+			err)
+	}
+	if btcPayment != nil {
+		anchorTx := anchorPkt.UnsignedTx
+		anchorTx.TxIn = append(
+			anchorTx.TxIn, btcPayment.UnsignedTx.TxIn...,
+		)
+		anchorTx.TxOut = append(
+			anchorTx.TxOut, btcPayment.UnsignedTx.TxOut...,
+		)
+		anchorPkt.Inputs = append(
+			anchorPkt.Inputs, btcPayment.Inputs...,
+		)
+		anchorPkt.Outputs = append(
+			anchorPkt.Outputs, btcPayment.Outputs...,
+		)
+	}
+
+	// We can only validate the inputs of our own leg against our database.
+	// The inputs of the taker were verified against their proofs above.
+	err = r.validateInputAssets(ctx, anchorPkt, makerLeg.allPackets())
+	if err != nil {
+		return nil, fmt.Errorf("error validating input assets: %w", err)
+	}
+
+	packetBytes, err := serialize(anchorPkt)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing packet: %w", err)
+	}
+
+	// As the maker, we pay the chain fees of the anchor transaction and
+	// get any BTC change in a new output.
+	fundRequest := &walletrpc.FundPsbtRequest{
+		Template: &walletrpc.FundPsbtRequest_CoinSelect{
+			CoinSelect: &walletrpc.PsbtCoinSelect{
+				Psbt: packetBytes,
+				ChangeOutput: &walletrpc.PsbtCoinSelect_Add{
+					Add: true,
+				},
+			},
+		},
+		MinConfs:   1,
+		ChangeType: P2TRChangeType,
+	}
+	switch fee := req.Fees.(type) {
+	case *wrpc.SignSwapRequest_TargetConf:
+		fundRequest.Fees = &walletrpc.FundPsbtRequest_TargetConf{
+			TargetConf: fee.TargetConf,
+		}
+
+	case *wrpc.SignSwapRequest_SatPerVbyte:
+		fundRequest.Fees = &walletrpc.FundPsbtRequest_SatPerVbyte{
+			SatPerVbyte: fee.SatPerVbyte,
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown fee type")
+	}
+
+	fundedPacket, changeIndex, lockedUTXO, err :=
+		r.cfg.Lnd.WalletKit.FundPsbt(ctx, fundRequest)
+	if err != nil {
+		return nil, fmt.Errorf("error funding packet: %w", err)
+	}
+
+	lockedOutpoints := lndLeaseOutpoints(lockedUTXO)
+
+	err = commitAnchorOutputs(fundedPacket, allPackets)
+	if err != nil {
+		return nil, err
+	}
+
+	// We sign all inputs that belong to us, which are the anchor inputs of
+	// our leg and the inputs lnd just added to pay for the fees.
+	localInputs := fn.NewSet(lockedOutpoints...)
+	for _, vPkt := range makerLeg.allPackets() {
+		for _, vIn := range vPkt.Inputs {
+			localInputs.Add(vIn.PrevID.OutPoint)
+		}
+	}
+	err = r.signLocalAnchorInputs(ctx, fundedPacket, localInputs)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &wrpc.SignSwapResponse{
+		ChangeOutputIndex: changeIndex,
+		LndLockedUtxos:    marshalOutPoints(lockedOutpoints),
+	}
+	resp.AnchorPsbt, err = serialize(fundedPacket)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing packet: %w", err)
+	}
+
+	resp.MakerLeg, err = marshalSwapLeg(makerLeg)
+	if err != nil {
+		return nil, err
+	}
+	if takerLeg != nil {
+		resp.TakerLeg, err = marshalSwapLeg(takerLeg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// We were successful, let's cancel the lease release in the defer.
+	success = true
+
+	return resp, nil
+}
+
+// PublishSwap verifies the leg of the counterparty of an atomic swap, signs
+// the remaining local inputs of the anchor transaction and then publishes it
+// and logs the transfer of the local leg.
+func (r *rpcServer) PublishSwap(ctx context.Context,
+	req *wrpc.PublishSwapRequest) (*wrpc.PublishSwapResponse, error) {
+
+	terms, err := r.unmarshalSwapTerms(req.Terms)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Acceptance == nil || req.SignedSwap == nil {
+		return nil, fmt.Errorf("swap acceptance and signed swap must " +
+			"be set")
+	}
+
+	takerAddr, err := r.unmarshalTakerAddr(terms, req.Acceptance.TakerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	makerLeg, err := unmarshalSwapLeg(req.SignedSwap.MakerLeg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maker leg: %w", err)
+	}
+
+	signedPkt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.SignedSwap.AnchorPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding packet: %w", err)
+	}
+
+	switch req.Role {
+	case wrpc.SwapRole_SWAP_ROLE_TAKER:
+		return r.publishTakerSwap(
+			ctx, terms, takerAddr, req.Acceptance, signedPkt,
+			makerLeg,
+		)
+
+	case wrpc.SwapRole_SWAP_ROLE_MAKER:
+		finalPkt, err := psbt.NewFromRawBytes(
+			bytes.NewReader(req.FinalAnchorPsbt), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding final packet: "+
+				"%w", err)
+		}
+
+		// The taker must not have changed anything in the transaction
+		// we signed, otherwise our signatures wouldn't be valid.
+		if finalPkt.UnsignedTx.TxHash() !=
+			signedPkt.UnsignedTx.TxHash() {
+
+			return nil, fmt.Errorf("final anchor transaction " +
+				"doesn't match signed swap")
+		}
+
+		lockedUTXOs := make(
+			[]wire.OutPoint, len(req.SignedSwap.LndLockedUtxos),
+		)
+		for idx, lndOutpoint := range req.SignedSwap.LndLockedUtxos {
+			lockedUTXOs[idx], err = taprpc.UnmarshalOutPoint(
+				lndOutpoint,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing "+
+					"outpoint: %w", err)
+			}
+		}
+
+		transfer, err := r.publishAndLogPackets(
+			ctx, finalPkt, makerLeg.activePackets,
+			makerLeg.passivePackets,
+			req.SignedSwap.ChangeOutputIndex, lockedUTXOs,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &wrpc.PublishSwapResponse{
+			FinalAnchorPsbt: req.FinalAnchorPsbt,
+			Transfer:        transfer,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown swap role: %v", req.Role)
+	}
+}
+
+// publishTakerSwap verifies the leg of the maker of an atomic swap, signs the
+// inputs of the taker and publishes the anchor transaction. If the taker paid
+// an asset, the transfer of its leg is logged as well.
+func (r *rpcServer) publishTakerSwap(ctx context.Context, terms *swapTerms,
+	takerAddr *address.Tap, acceptance *wrpc.AcceptSwapResponse,
+	pkt *psbt.Packet, makerLeg *swapLeg) (*wrpc.PublishSwapResponse,
+	error) {
+
+	err := r.verifySwapLeg(ctx, makerLeg, takerAddr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maker leg: %w", err)
+	}
+
+	// We only rely on the leg we created ourselves, not on the copy that
+	// was returned by the maker. By committing both legs to a copy of the
+	// anchor transaction ourselves, we make sure the maker didn't change
+	// any of our outputs. This also creates the proofs of our own leg.
+	var (
+		takerLeg    *swapLeg
+		allPackets  = makerLeg.allPackets()
+		localInputs = fn.NewSet[wire.OutPoint]()
+	)
+	if terms.requestsBtc() {
+		btcPayment, err := psbt.NewFromRawBytes(
+			bytes.NewReader(acceptance.BtcPaymentPsbt), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding BTC payment: %w",
+				err)
+		}
+
+		for _, txOut := range btcPayment.UnsignedTx.TxOut {
+			if !hasOutput(pkt.UnsignedTx, txOut) {
+				return nil, fmt.Errorf("anchor transaction " +
+					"is missing BTC payment output")
+			}
+		}
+		for _, txIn := range btcPayment.UnsignedTx.TxIn {
+			localInputs.Add(txIn.PreviousOutPoint)
+		}
+	} else {
+		takerLeg, err = unmarshalSwapLeg(acceptance.TakerLeg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid taker leg: %w", err)
+		}
+
+		allPackets = append(allPackets, takerLeg.allPackets()...)
+		for _, vPkt := range takerLeg.allPackets() {
+			for _, vIn := range vPkt.Inputs {
+				localInputs.Add(vIn.PrevID.OutPoint)
+			}
+		}
+	}
+
+	packetBytes, err := serialize(pkt)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing packet: %w", err)
+	}
+	committedPkt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(packetBytes), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding packet: %w", err)
+	}
+
+	err = commitAnchorOutputs(committedPkt, allPackets)
+	if err != nil {
+		return nil, err
+	}
+	if committedPkt.UnsignedTx.TxHash() != pkt.UnsignedTx.TxHash() {
+		return nil, fmt.Errorf("anchor transaction doesn't commit to " +
+			"swap legs")
+	}
+
+	err = r.signLocalAnchorInputs(ctx, pkt, localInputs)
+	if err != nil {
+		return nil, err
+	}
+	err = psbt.MaybeFinalizeAll(pkt)
+	if err != nil {
+		return nil, fmt.Errorf("error finalizing anchor transaction: "+
+			"%w", err)
+	}
+
+	resp := &wrpc.PublishSwapResponse{}
+	resp.FinalAnchorPsbt, err = serialize(pkt)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing packet: %w", err)
+	}
+
+	// If we only paid BTC, there is no transfer to log and we can publish
+	// the transaction directly.
+	if takerLeg == nil {
+		finalTx, err := psbt.Extract(pkt)
+		if err != nil {
+			return nil, fmt.Errorf("error extracting final anchor "+
+				"transaction: %w", err)
+		}
+
+		err = r.cfg.ChainBridge.PublishTransaction(ctx, finalTx)
+		if err != nil {
+			return nil, fmt.Errorf("error publishing anchor "+
+				"transaction: %w", err)
+		}
+
+		return resp, nil
+	}
+
+	resp.Transfer, err = r.publishAndLogPackets(
+		ctx, pkt, takerLeg.activePackets, takerLeg.passivePackets, -1,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// CancelSwap cancels an atomic swap that wasn't published yet by releasing the
+// leases of the asset and BTC inputs this node acquired for its side of the
+// swap.
+func (r *rpcServer) CancelSwap(ctx context.Context,
+	req *wrpc.CancelSwapRequest) (*wrpc.CancelSwapResponse, error) {
+
+	var (
+		rpcLeg       *wrpc.SwapLeg
+		rpcLndLeases []*taprpc.OutPoint
+	)
+	switch req.Role {
+	case wrpc.SwapRole_SWAP_ROLE_TAKER:
+		if req.Acceptance == nil {
+			return nil, fmt.Errorf("swap acceptance must be set")
+		}
+
+		rpcLeg = req.Acceptance.TakerLeg
+		rpcLndLeases = req.Acceptance.LndLockedUtxos
+
+	case wrpc.SwapRole_SWAP_ROLE_MAKER:
+		if req.SignedSwap == nil {
+			return nil, fmt.Errorf("signed swap must be set")
+		}
+
+		rpcLeg = req.SignedSwap.MakerLeg
+		rpcLndLeases = req.SignedSwap.LndLockedUtxos
+
+	default:
+		return nil, fmt.Errorf("unknown swap role: %v", req.Role)
+	}
+
+	resp := &wrpc.CancelSwapResponse{}
+
+	// A taker that pays BTC doesn't have an asset leg.
+	if rpcLeg != nil {
+		leg, err := unmarshalSwapLeg(rpcLeg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid swap leg: %w", err)
+		}
+
+		anchors := vPacketAnchors(leg.allPackets())
+		err = r.cfg.CoinSelect.ReleaseCoins(ctx, anchors...)
+		if err != nil {
+			return nil, fmt.Errorf("error releasing asset coins: "+
+				"%w", err)
+		}
+
+		resp.ReleasedAssetUtxos = marshalOutPoints(anchors)
+	}
+
+	for _, rpcOutpoint := range rpcLndLeases {
+		op, err := taprpc.UnmarshalOutPoint(rpcOutpoint)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing outpoint: %w",
+				err)
+		}
+
+		if err := r.cfg.WalletAnchor.UnlockInput(ctx, op); err != nil {
+			return nil, fmt.Errorf("error unlocking lnd UTXO %v: "+
+				"%w", op, err)
+		}
+
+		resp.ReleasedLndUtxos = append(
+			resp.ReleasedLndUtxos, rpcOutpoint,
+		)
+	}
+
+	rpcsLog.Infof("[CancelSwap]: released %d asset and %d BTC inputs of "+
+		"swap as %v", len(resp.ReleasedAssetUtxos),
+		len(resp.ReleasedLndUtxos), req.Role)
+
+	return resp, nil
+}
+
+// RefundHtlc reclaims an asset locked to the script key of an asset level HTLC
+// through its timeout path, once the relative time lock of the HTLC expired.
+func (r *rpcServer) RefundHtlc(ctx context.Context,
+	req *wrpc.RefundHtlcRequest) (*taprpc.SendAssetResponse, error) {
+
+	if len(req.AssetId) != sha256.Size {
+		return nil, fmt.Errorf("asset ID must be 32 bytes")
+	}
+	if req.RefundKey == nil {
+		return nil, fmt.Errorf("refund key must be set")
+	}
+
+	var assetID asset.ID
+	copy(assetID[:], req.AssetId)
+
+	anchorPoint, err := taprpc.UnmarshalOutPoint(req.AnchorOutpoint)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing anchor outpoint: %w", err)
+	}
+
+	claimKey, err := btcec.ParsePubKey(req.ClaimKey)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing claim key: %w", err)
+	}
+
+	refundKey, err := taprpc.UnmarshalKeyDescriptor(req.RefundKey)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling refund key: %w",
+			err)
+	}
+
+	paymentHash, err := lntypes.MakeHash(req.PaymentHash)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing payment hash: %w", err)
+	}
+
+	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	htlcTree, err := tapscript.NewHtlcScriptTree(
+		claimKey, refundKey.PubKey, paymentHash, req.CsvDelay,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// We always refund the full amount locked to the HTLC, so we need to
+	// look up the asset first.
+	htlcScriptKey := htlcTree.ScriptKey()
+	htlcCoin, err := r.cfg.AssetStore.FetchCommitment(
+		ctx, assetID, anchorPoint, nil, &htlcScriptKey, false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find asset locked to HTLC: "+
+			"%w", err)
+	}
+
+	scriptKey, err := r.cfg.AddrBook.NextScriptKey(
+		ctx, asset.TaprootAssetsKeyFamily,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive script key: %w", err)
+	}
+	internalKey, err := r.cfg.AddrBook.NextInternalKey(
+		ctx, asset.TaprootAssetsKeyFamily,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive internal key: %w",
+			err)
+	}
+
+	// The refund output must carry the relative lock time of the timeout
+	// path, which is also set as the sequence of the BTC level input.
+	htlcAsset := htlcCoin.Asset
+	vPkt := tappsbt.ForInteractiveSend(
+		assetID, htlcAsset.Amount, scriptKey, 0,
+		uint64(htlcTree.CsvDelay), 0, internalKey, htlcAsset.Version,
+		&r.cfg.ChainParams,
+	)
+
+	fundDesc := &tapsend.FundingDescriptor{
+		ID:     assetID,
+		Amount: htlcAsset.Amount,
+	}
+	if htlcAsset.GroupKey != nil {
+		fundDesc.GroupKey = &htlcAsset.GroupKey.GroupPubKey
+	}
+
+	fundedVPkt, err := r.cfg.AssetWallet.FundPacket(
+		ctx, fundDesc, vPkt, tapfreighter.WithPinnedInputs(anchorPoint),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error funding refund: %w", err)
+	}
+
+	// From now on, if we error out, we need to make sure we release the
+	// asset coins the wallet just leased for us.
+	success := false
+	defer func() {
+		if !success {
+			err := r.cfg.CoinSelect.ReleaseCoins(ctx, anchorPoint)
+			if err != nil {
+				rpcsLog.Errorf("Error releasing HTLC coin: %v",
+					err)
+			}
+		}
+	}()
+
+	err = tapsend.PrepareHtlcRefund(fundedVPkt.VPacket, htlcTree, refundKey)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.cfg.AssetWallet.SignVirtualPacket(fundedVPkt.VPacket)
+	if err != nil {
+		return nil, fmt.Errorf("error signing refund: %w", err)
+	}
+
+	// The chain porter releases the coins itself if the shipment fails.
+	success = true
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(
+		tapfreighter.NewPreSignedParcel(
+			[]*tappsbt.VPacket{fundedVPkt.VPacket},
+			fundedVPkt.InputCommitments, feeRate,
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting delivery: %w", err)
+	}
+
+	parcel, err := marshalOutboundParcel(resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	rpcsLog.Infof("[RefundHtlc]: refunded %d units of asset_id=%v "+
+		"locked to HTLC at %v", htlcAsset.Amount, assetID, anchorPoint)
+
+	return &taprpc.SendAssetResponse{
+		Transfer: parcel,
+	}, nil
+}
+
+// unmarshalSwapTerms decodes the given RPC swap terms and makes sure the maker
+// address requests exactly what the terms say.
+func (r *rpcServer) unmarshalSwapTerms(
+	rpcTerms *wrpc.SwapTerms) (*swapTerms, error) {
+
+	if rpcTerms == nil {
+		return nil, fmt.Errorf("swap terms must be set")
+	}
+
+	if len(rpcTerms.OfferAssetId) != 32 {
+		return nil, fmt.Errorf("invalid offer asset id length")
+	}
+	if rpcTerms.OfferAmount == 0 || rpcTerms.RequestAmount == 0 {
+		return nil, fmt.Errorf("offer and request amount must be set")
+	}
+
+	terms := &swapTerms{
+		offerAmount:   rpcTerms.OfferAmount,
+		requestAmount: rpcTerms.RequestAmount,
+	}
+	copy(terms.offerID[:], rpcTerms.OfferAssetId)
+
+	// If no asset is requested, the maker address is a BTC address of the
+	// maker's lnd wallet.
+	if len(rpcTerms.RequestAssetId) == 0 {
+		addr, err := btcutil.DecodeAddress(
+			rpcTerms.MakerAddr, r.cfg.ChainParams.Params,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode maker BTC "+
+				"address: %w", err)
+		}
+		if !addr.IsForNet(r.cfg.ChainParams.Params) {
+			return nil, fmt.Errorf("maker BTC address is for " +
+				"wrong network")
+		}
+
+		terms.makerBtcAddr = addr
+
+		return terms, nil
+	}
+
+	if len(rpcTerms.RequestAssetId) != 32 {
+		return nil, fmt.Errorf("invalid request asset id length")
+	}
+
+	var requestID asset.ID
+	copy(requestID[:], rpcTerms.RequestAssetId)
+
+	addr, err := address.DecodeAddress(
+		rpcTerms.MakerAddr, &r.cfg.ChainParams,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode maker address: %w",
+			err)
+	}
+
+	if addr.AssetID != requestID || addr.Amount != terms.requestAmount {
+		return nil, fmt.Errorf("maker address doesn't match " +
+			"requested asset and amount")
+	}
+
+	terms.makerTapAddr = addr
+
+	return terms, nil
+}
+
+// unmarshalTakerAddr decodes the address of the taker of an atomic swap and
+// makes sure it requests exactly the offered asset and amount.
+func (r *rpcServer) unmarshalTakerAddr(terms *swapTerms,
+	rpcAddr string) (*address.Tap, error) {
+
+	addr, err := address.DecodeAddress(rpcAddr, &r.cfg.ChainParams)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode taker address: %w",
+			err)
+	}
+
+	if addr.AssetID != terms.offerID || addr.Amount != terms.offerAmount {
+		return nil, fmt.Errorf("taker address doesn't match offered " +
+			"asset and amount")
+	}
+
+	return addr, nil
+}
+
+// newSwapAddr derives a new Taproot Asset address that receives the given
+// amount of an asset in an atomic swap. The proof of the payment is delivered
+// to the default proof courier of this node.
+func (r *rpcServer) newSwapAddr(ctx context.Context, assetID asset.ID,
+	amount uint64) (*address.Tap, error) {
+
+	courierAddr := r.cfg.DefaultProofCourierAddr
+	if courierAddr == nil {
+		return nil, fmt.Errorf("no proof courier address configured")
+	}
+
+	err := r.checkBalanceOverflow(ctx, &assetID, nil, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := r.cfg.AddrBook.NewAddress(
+		ctx, address.V1, assetID, amount, nil, *courierAddr,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to make new addr: %w", err)
+	}
+
+	return addr.Tap, nil
+}
+
+// fundSwapLeg funds and signs the virtual packets of a swap leg that pays the
+// given address of the counterparty. The change of the leg is anchored in the
+// output with the given index and the payment in the output right after it.
+func (r *rpcServer) fundSwapLeg(ctx context.Context, addr *address.Tap,
+	changeOutputIndex uint32) (*swapLeg, error) {
+
+	receiverAddrs := []*address.Tap{addr}
+	vPkt, err := tappsbt.FromAddresses(receiverAddrs, changeOutputIndex+1)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create virtual transaction "+
+			"from address: %w", err)
+	}
+	vPkt.Outputs[0].AnchorOutputIndex = changeOutputIndex
+
+	fundDesc, err := tapsend.DescribeAddrs(receiverAddrs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to describe recipients: %w", err)
+	}
+
+	fundedVPkt, err := r.cfg.AssetWallet.FundPacket(ctx, fundDesc, vPkt)
+	if err != nil {
+		return nil, fmt.Errorf("error funding swap leg: %w", err)
+	}
+
+	leg := &swapLeg{
+		activePackets: []*tappsbt.VPacket{fundedVPkt.VPacket},
+	}
+
+	// From now on, if we error out, we need to make sure we release the
+	// asset coins the wallet just leased for us.
+	success := false
+	defer func() {
+		if !success {
+			r.releaseSwapLeg(ctx, leg)
+		}
+	}()
+	leg.passivePackets, err = r.cfg.AssetWallet.CreatePassiveAssets(
+		ctx, leg.activePackets, fundedVPkt.InputCommitments,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating passive assets: %w", err)
+	}
+
+	_, err = r.cfg.AssetWallet.SignVirtualPacket(fundedVPkt.VPacket)
+	if err != nil {
+		return nil, fmt.Errorf("error signing swap leg: %w", err)
+	}
+	err = r.cfg.AssetWallet.SignPassiveAssets(leg.passivePackets)
+	if err != nil {
+		return nil, fmt.Errorf("error signing passive assets: %w", err)
+	}
+
+	leg.inputProofs, err = r.fetchInputProofs(ctx, leg.allPackets())
+	if err != nil {
+		return nil, err
+	}
+
+	success = true
+
+	return leg, nil
+}
+
+// vPacketAnchors returns the distinct anchor outpoints of all assets spent by
+// the given virtual packets.
+func vPacketAnchors(vPackets []*tappsbt.VPacket) []wire.OutPoint {
+	var (
+		anchors []wire.OutPoint
+		seen    = fn.NewSet[wire.OutPoint]()
+	)
+	for _, vPkt := range vPackets {
+		for _, vIn := range vPkt.Inputs {
+			if seen.Contains(vIn.PrevID.OutPoint) {
+				continue
+			}
+			seen.Add(vIn.PrevID.OutPoint)
+
+			anchors = append(anchors, vIn.PrevID.OutPoint)
+		}
+	}
+
+	return anchors
+}
+
+// releaseSwapLeg releases the leases of the asset coins spent by the given
+// swap leg. Any errors are only logged, as this is used to clean up after a
+// failed funding attempt.
+func (r *rpcServer) releaseSwapLeg(ctx context.Context, leg *swapLeg) {
+	anchors := vPacketAnchors(leg.allPackets())
+	if len(anchors) == 0 {
+		return
+	}
+
+	err := r.cfg.CoinSelect.ReleaseCoins(ctx, anchors...)
+	if err != nil {
+		rpcsLog.Errorf("Error releasing swap leg coins: %v", err)
+	}
+}
+
+// fetchInputProofs fetches the full proof files of all inputs of the given
+// virtual packets from the local proof archive.
+func (r *rpcServer) fetchInputProofs(ctx context.Context,
+	vPackets []*tappsbt.VPacket) ([][]byte, error) {
+
+	var (
+		inputProofs [][]byte
+		seen        = fn.NewSet[asset.PrevID]()
+	)
+	for _, vPkt := range vPackets {
+		for _, vIn := range vPkt.Inputs {
+			if seen.Contains(vIn.PrevID) {
+				continue
+			}
+			seen.Add(vIn.PrevID)
+
+			inputAsset := vIn.Asset()
+			assetID := inputAsset.ID()
+			proofBlob, err := r.cfg.ProofArchive.FetchProof(
+				ctx, proof.Locator{
+					AssetID:   &assetID,
+					ScriptKey: *inputAsset.ScriptKey.PubKey,
+					OutPoint:  &vIn.PrevID.OutPoint,
+				},
+			)
+			if err != nil {
+				return nil, fmt.Errorf("cannot fetch proof of "+
+					"input %v: %w", vIn.PrevID.OutPoint,
+					err)
+			}
+
+			inputProofs = append(inputProofs, proofBlob)
+		}
+	}
+
+	return inputProofs, nil
+}
+
+// verifySwapLeg verifies the input proofs and the signed virtual packets of a
+// swap leg created by the counterparty and makes sure the leg pays exactly
+// what the given address requests.
+func (r *rpcServer) verifySwapLeg(ctx context.Context, leg *swapLeg,
+	addr *address.Tap) error {
+
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+	verifier := &proof.BaseVerifier{
+		CheckpointVerifier: r.cfg.ProofCheckpointVerifier,
+	}
+
+	// We first verify all input proofs and index the proven assets by the
+	// ID they are spent with.
+	snapshots := make(map[asset.PrevID]*proof.AssetSnapshot)
+	for idx, rawProof := range leg.inputProofs {
+		if err := proof.CheckMaxFileSize(rawProof); err != nil {
+			return fmt.Errorf("invalid input proof %d: %w", idx,
+				err)
+		}
+
+		snapshot, err := verifier.Verify(
+			ctx, bytes.NewReader(rawProof), headerVerifier,
+			proof.DefaultMerkleVerifier, groupVerifier,
+			r.cfg.ChainBridge,
+		)
+		if err != nil {
+			return fmt.Errorf("invalid input proof %d: %w", idx,
+				err)
+		}
+
+		prevID := asset.PrevID{
+			OutPoint: snapshot.OutPoint,
+			ID:       snapshot.Asset.ID(),
+			ScriptKey: asset.ToSerialized(
+				snapshot.Asset.ScriptKey.PubKey,
+			),
+		}
+		snapshots[prevID] = snapshot
+	}
+
+	// Every input of the leg must spend an asset that was proven to exist
+	// on-chain.
+	for _, vPkt := range leg.allPackets() {
+		for _, vIn := range vPkt.Inputs {
+			snapshot, ok := snapshots[vIn.PrevID]
+			if !ok {
+				return fmt.Errorf("no input proof for input %v",
+					vIn.PrevID.OutPoint)
+			}
+
+			if !snapshot.Asset.DeepEqual(vIn.Asset()) {
+				return fmt.Errorf("input %v doesn't match its "+
+					"proof", vIn.PrevID.OutPoint)
+			}
+		}
+	}
+
+	return tapsend.ValidateSwapLeg(
+		leg.allPackets(), addr, &WitnessValidatorV0{},
+	)
+}
+
+// signLocalAnchorInputs signs the inputs of the given BTC level anchor
+// transaction that spend any of the given local outpoints with the lnd
+// wallet. The derivation information of all other inputs is removed from the
+// copy of the packet that is handed to lnd, so the wallet can't be tricked
+// into signing inputs a counterparty added to the transaction.
+func (r *rpcServer) signLocalAnchorInputs(ctx context.Context,
+	pkt *psbt.Packet, localInputs fn.Set[wire.OutPoint]) error {
+
+	packetBytes, err := serialize(pkt)
+	if err != nil {
+		return fmt.Errorf("error serializing packet: %w", err)
+	}
+	signPkt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(packetBytes), false,
+	)
+	if err != nil {
+		return fmt.Errorf("error decoding packet: %w", err)
+	}
+
+	for idx, txIn := range signPkt.UnsignedTx.TxIn {
+		if localInputs.Contains(txIn.PreviousOutPoint) {
+			continue
+		}
+
+		signPkt.Inputs[idx].Bip32Derivation = nil
+		signPkt.Inputs[idx].TaprootBip32Derivation = nil
+	}
+
+	signedPkt, err := r.cfg.Lnd.WalletKit.SignPsbt(ctx, signPkt)
+	if err != nil {
+		return fmt.Errorf("error signing anchor transaction: %w", err)
+	}
+	if len(signedPkt.Inputs) != len(pkt.Inputs) {
+		return fmt.Errorf("signed anchor transaction has %d inputs, "+
+			"expected %d", len(signedPkt.Inputs), len(pkt.Inputs))
+	}
+
+	// We only take over the signed local inputs, everything else stays
+	// exactly as it was.
+	for idx, txIn := range pkt.UnsignedTx.TxIn {
+		if localInputs.Contains(txIn.PreviousOutPoint) {
+			pkt.Inputs[idx] = signedPkt.Inputs[idx]
+		}
+	}
+
+	return nil
+}
+
+// assertBtcPayment makes sure the given transaction pays the requested amount
+// to the BTC address of the maker of a swap.
+func assertBtcPayment(tx *wire.MsgTx, terms *swapTerms) error {
+	pkScript, err := txscript.PayToAddrScript(terms.makerBtcAddr)
+	if err != nil {
+		return fmt.Errorf("error creating maker script: %w", err)
+	}
+
+	payment := &wire.TxOut{
+		Value:    int64(terms.requestAmount),
+		PkScript: pkScript,
+	}
+	if !hasOutput(tx, payment) {
+		return fmt.Errorf("transaction doesn't pay %d sats to maker "+
+			"address %v", terms.requestAmount, terms.makerBtcAddr)
+	}
+
+	return nil
+}
+
+// hasOutput returns true if the given transaction has an output with the same
+// value and script as the given one.
+func hasOutput(tx *wire.MsgTx, txOut *wire.TxOut) bool {
+	for _, out := range tx.TxOut {
+		if out.Value == txOut.Value &&
+			bytes.Equal(out.PkScript, txOut.PkScript) {
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// unmarshalSwapLeg decodes the given RPC swap leg.
+func unmarshalSwapLeg(rpcLeg *wrpc.SwapLeg) (*swapLeg, error) {
+	if rpcLeg == nil || len(rpcLeg.VirtualPsbts) == 0 {
+		return nil, fmt.Errorf("swap leg has no virtual PSBTs")
+	}
+
+	activePackets, err := decodeVirtualPackets(rpcLeg.VirtualPsbts)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding active packets: %w", err)
+	}
+
+	passivePackets, err := decodeVirtualPackets(rpcLeg.PassiveAssetPsbts)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding passive packets: %w",
+			err)
+	}
+
+	return &swapLeg{
+		activePackets:  activePackets,
+		passivePackets: passivePackets,
+		inputProofs:    rpcLeg.InputProofs,
+	}, nil
+}
+
+// marshalSwapLeg encodes the given swap leg into its RPC representation.
+func marshalSwapLeg(leg *swapLeg) (*wrpc.SwapLeg, error) {
+	virtualPsbts, err := encodeVirtualPackets(leg.activePackets)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding active packets: %w", err)
+	}
+
+	passivePsbts, err := encodeVirtualPackets(leg.passivePackets)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding passive packets: %w",
+			err)
+	}
+
+	return &wrpc.SwapLeg{
+		VirtualPsbts:      virtualPsbts,
+		PassiveAssetPsbts: passivePsbts,
+		InputProofs:       leg.inputProofs,
 	}, nil
 }
 
@@ -191,6 +191,17 @@ func (s *Server) initialize(interceptorChain *rpcperms.InterceptorChain) error {
 			"federation: %w", err)
 	}
 
+	if err := s.cfg.UniverseCommitments.Start(); err != nil {
+		return fmt.Errorf("unable to start universe chain "+
+			"commitments: %w", err)
+	}
+
+	// The wallet recoverer needs the universe federation to be started,
+	// as it scans the federation's servers.
+	if err := s.cfg.WalletRecoverer.Start(); err != nil {
+		return fmt.Errorf("unable to start wallet recoverer: %w", err)
+	}
+
 	// Start the request for quote (RFQ) manager.
 	if err := s.cfg.RfqManager.Start(); err != nil {
 		return fmt.Errorf("unable to start RFQ manager: %w", err)
@@ -650,6 +661,14 @@ func (s *Server) Stop() error {
 		return err
 	}
 
+	if err := s.cfg.UniverseCommitments.Stop(); err != nil {
+		return err
+	}
+
+	if err := s.cfg.WalletRecoverer.Stop(); err != nil {
+		return err
+	}
+
 	if err := s.cfg.RfqManager.Stop(); err != nil {
 		return err
 	}
@@ -24,6 +24,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/rfq"
 	"github.com/lightninglabs/taproot-assets/tapdb"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/cert"
 	"github.com/lightningnetwork/lnd/lncfg"
@@ -130,6 +131,15 @@ const (
 	// waits having identified an asset transfer on-chain and before
 	// retrieving the corresponding proof via the proof courier service.
 	defaultProofRetrievalDelay = 5 * time.Second
+
+	// defaultReusablePollInterval is the default interval at which the
+	// custodian polls the proof courier for transfers to reusable
+	// addresses that don't specify an amount.
+	defaultReusablePollInterval = time.Minute
+
+	// defaultTorSOCKSPort is the default port of the Tor SOCKS5 proxy that
+	// is used if the configured proxy address doesn't specify a port.
+	defaultTorSOCKSPort = 9050
 )
 
 var (
@@ -278,6 +288,12 @@ type UniverseConfig struct {
 	UniverseQueriesPerSecond rate.Limit `long:"max-qps" description:"The maximum number of queries per second across the set of active universe queries that is permitted. Anything above this starts to get rate limited."`
 
 	UniverseQueriesBurst int `long:"req-burst-budget" description:"The burst budget for the universe query rate limiting."`
+
+	ChainCommitInterval time.Duration `long:"chain-commit-interval" description:"The interval at which the issuance and transfer multiverse roots are committed on chain. A commitment is only made if the roots changed since the last one. If set to 0, on chain universe commitments are disabled."`
+
+	ProofCheckpoints bool `long:"proof-checkpoints" description:"If set, proof files that don't start at the genesis of the asset are accepted if their first proof is a leaf of the local universe or of a trusted checkpoint server. Such pruned proof files are verified from that checkpoint instead of the asset's genesis, and the history of local proof files can be pruned. The pruned history is restored from the same universes whenever an asset is sent, so receivers always get the full proof file."`
+
+	ProofCheckpointServers []string `long:"proof-checkpoint-server" description:"The host:port of a trusted Universe server whose roots are accepted for proof checkpoints, in addition to the local universe. The server is also used to restore the history of pruned proof files. Can be specified multiple times."`
 }
 
 // AddrBookConfig is the config that houses any address Book related config
@@ -286,6 +302,25 @@ type AddrBookConfig struct {
 	DisableSyncer bool `long:"disable-syncer" description:"If true, tapd will not try to sync issuance proofs for unknown assets when creating an address."`
 }
 
+// RecoveryConfig is the config that houses the wallet recovery options.
+//
+// nolint: lll
+type RecoveryConfig struct {
+	Enable bool `long:"enable" description:"If true, tapd scans the universe federation servers and the default proof courier on startup for assets owned by keys derived from the lnd seed, and imports the proofs of all unspent assets it finds. This can be used to recover assets after the tapd database was lost. Only assets locked to BIP-86 script keys are found, and an asset is only considered spent if the scanned universes know about a transfer spending it."`
+
+	KeyWindow uint32 `long:"key-window" description:"The number of consecutive unused keys of the Taproot Assets key family that are derived after the last used key before the recovery scan stops."`
+}
+
+// TorConfig is the config that houses the options for routing outgoing
+// connections through Tor or any other SOCKS5 proxy.
+//
+// nolint: lll
+type TorConfig struct {
+	SOCKS string `long:"socks" description:"The host:port of a SOCKS5 proxy (e.g. Tor) that all connections to universe federation servers and proof couriers are made through. Host names, including .onion addresses, are resolved by the proxy. If no port is specified, the default Tor SOCKS port 9050 is used. If unset, direct connections are made."`
+
+	StreamIsolation bool `long:"streamisolation" description:"If true, a new Tor circuit is used for each connection made through the proxy, which makes it harder to correlate the connections."`
+}
+
 // ExperimentalConfig houses experimental tapd cli configuration options.
 type ExperimentalConfig struct {
 	Rfq rfq.CliConfig `group:"rfq" namespace:"rfq"`
@@ -321,8 +356,10 @@ type Config struct {
 	DefaultProofCourierAddr string                       `long:"proofcourieraddr" description:"Default proof courier service address."`
 	HashMailCourier         *proof.HashMailCourierCfg    `group:"hashmailcourier" namespace:"hashmailcourier"`
 	UniverseRpcCourier      *proof.UniverseRpcCourierCfg `group:"universerpccourier" namespace:"universerpccourier"`
+	FileCourier             *proof.FileCourierCfg        `group:"filecourier" namespace:"filecourier"`
 
-	CustodianProofRetrievalDelay time.Duration `long:"custodianproofretrievaldelay" description:"The number of seconds the custodian waits after identifying an asset transfer on-chain and before retrieving the corresponding proof."`
+	CustodianProofRetrievalDelay      time.Duration `long:"custodianproofretrievaldelay" description:"The number of seconds the custodian waits after identifying an asset transfer on-chain and before retrieving the corresponding proof."`
+	CustodianReusableAddrPollInterval time.Duration `long:"custodianreusableaddrpollinterval" description:"The interval at which the custodian polls the proof courier for transfers to reusable addresses that don't specify an amount. Set to 0 to disable polling."`
 
 	ChainConf *ChainConfig
 	RpcConf   *RpcConfig
@@ -337,6 +374,10 @@ type Config struct {
 
 	AddrBook *AddrBookConfig `group:"address" namespace:"address"`
 
+	Recovery *RecoveryConfig `group:"recovery" namespace:"recovery"`
+
+	Tor *TorConfig `group:"tor" namespace:"tor"`
+
 	Prometheus monitoring.PrometheusConfig `group:"prometheus" namespace:"prometheus"`
 
 	Experimental *ExperimentalConfig `group:"experimental" namespace:"experimental"`
@@ -417,7 +458,17 @@ func DefaultConfig() Config {
 				MaxBackoff:       defaultProofTransferMaxBackoff,
 			},
 		},
-		CustodianProofRetrievalDelay: defaultProofRetrievalDelay,
+		FileCourier: &proof.FileCourierCfg{
+			BackoffCfg: &proof.BackoffCfg{
+				SkipInitDelay:    true,
+				BackoffResetWait: defaultProofTransferBackoffResetWait,
+				NumTries:         defaultProofTransferNumTries,
+				InitialBackoff:   defaultProofTransferInitialBackoff,
+				MaxBackoff:       defaultProofTransferMaxBackoff,
+			},
+		},
+		CustodianProofRetrievalDelay:      defaultProofRetrievalDelay,
+		CustodianReusableAddrPollInterval: defaultReusablePollInterval,
 		Universe: &UniverseConfig{
 			SyncInterval: defaultUniverseSyncInterval,
 			UniverseQueriesPerSecond: rate.Limit(
@@ -428,6 +479,10 @@ func DefaultConfig() Config {
 		AddrBook: &AddrBookConfig{
 			DisableSyncer: false,
 		},
+		Recovery: &RecoveryConfig{
+			KeyWindow: tapgarden.DefaultRecoveryKeyWindow,
+		},
+		Tor:          &TorConfig{},
 		Experimental: &ExperimentalConfig{},
 	}
 }
@@ -612,6 +667,7 @@ func ValidateConfig(cfg Config, cfgLogger btclog.Logger) (*Config, error) {
 	cfg.RpcConf.TLSKeyPath = CleanAndExpandPath(cfg.RpcConf.TLSKeyPath)
 	cfg.LogDir = CleanAndExpandPath(cfg.LogDir)
 	cfg.RpcConf.MacaroonPath = CleanAndExpandPath(cfg.RpcConf.MacaroonPath)
+	cfg.FileCourier.BaseDir = CleanAndExpandPath(cfg.FileCourier.BaseDir)
 
 	// Multiple networks can't be selected simultaneously.  Count number of
 	// network flags passed; assign active network params
@@ -845,6 +901,31 @@ func ValidateConfig(cfg Config, cfgLogger btclog.Logger) (*Config, error) {
 			"config: %w", err)
 	}
 
+	// A recovery scan without a key window would never derive any keys.
+	if cfg.Recovery.Enable && cfg.Recovery.KeyWindow == 0 {
+		return nil, mkErr("recovery.key-window must be greater than 0")
+	}
+
+	// If a SOCKS5 proxy is configured, all outgoing connections to
+	// universe servers and proof couriers are made through it. The local
+	// listeners above were already resolved directly.
+	if cfg.Tor.SOCKS != "" {
+		socksAddr, err := lncfg.ParseAddressString(
+			cfg.Tor.SOCKS, strconv.Itoa(defaultTorSOCKSPort),
+			net.ResolveTCPAddr,
+		)
+		if err != nil {
+			return nil, mkErr("error parsing tor.socks address: %v",
+				err)
+		}
+
+		cfg.Tor.SOCKS = socksAddr.String()
+		cfg.net = &tor.ProxyNet{
+			SOCKS:           cfg.Tor.SOCKS,
+			StreamIsolation: cfg.Tor.StreamIsolation,
+		}
+	}
+
 	// Use a way higher re-org safe depth value for testnet (if the user
 	// didn't specify a custom value).
 	if cfg.ActiveNetParams.Net == chaincfg.TestNet3Params.Net &&
@@ -25,6 +25,7 @@ import (
 	"github.com/lightningnetwork/lnd"
 	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/signal"
+	"github.com/lightningnetwork/lnd/tor"
 )
 
 // databaseBackend is an interface that contains all methods our different
@@ -166,9 +167,10 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	if err != nil {
 		return nil, fmt.Errorf("unable to open disk archive: %w", err)
 	}
+	proofVerifier := &proof.BaseVerifier{}
 	proofArchive := proof.NewMultiArchiver(
-		&proof.BaseVerifier{}, tapdb.DefaultStoreTimeout,
-		assetStore, proofFileStore,
+		proofVerifier, tapdb.DefaultStoreTimeout, assetStore,
+		proofFileStore,
 	)
 
 	federationMembers := cfg.Universe.FederationServers
@@ -257,9 +259,54 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 
 	baseUni := universe.NewArchive(uniCfg)
 
+	// If a SOCKS5 proxy is configured, all connections to remote universe
+	// servers and proof couriers are made through it.
+	proxy, _ := cfg.net.(*tor.ProxyNet)
+	newRemoteDiffEngine := func(
+		addr universe.ServerAddr) (universe.DiffEngine, error) {
+
+		return tap.NewRpcUniverseDiff(addr, proxy)
+	}
+
+	// If proof checkpoints are enabled, proof files that were pruned are
+	// verified starting at a proof that is a leaf of our local universe or
+	// one of the trusted checkpoint servers. The pruned history is restored
+	// from the same universes.
+	var (
+		checkpointVerifier proof.CheckpointVerifier
+		historyFetcher     proof.HistoryFetcher
+	)
+	if cfg.Universe.ProofCheckpoints {
+		var trustedRemotes []universe.DiffEngine
+		for _, server := range cfg.Universe.ProofCheckpointServers {
+			remote, err := newRemoteDiffEngine(
+				universe.NewServerAddrFromStr(server),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to connect to "+
+					"checkpoint server %v: %w", server, err)
+			}
+
+			trustedRemotes = append(trustedRemotes, remote)
+		}
+
+		checkpointVerifier = universe.NewCheckpointVerifier(
+			baseUni, trustedRemotes...,
+		)
+		historyFetcher = universe.NewHistoryFetcher(
+			baseUni, trustedRemotes...,
+		)
+		proofVerifier.CheckpointVerifier = checkpointVerifier
+	}
+	newRemoteRegistrar := func(
+		addr universe.ServerAddr) (universe.Registrar, error) {
+
+		return tap.NewRpcUniverseRegistrar(addr, proxy)
+	}
+
 	universeSyncer := universe.NewSimpleSyncer(universe.SimpleSyncCfg{
 		LocalDiffEngine:     baseUni,
-		NewRemoteDiffEngine: tap.NewRpcUniverseDiff,
+		NewRemoteDiffEngine: newRemoteDiffEngine,
 		LocalRegistrar:      baseUni,
 		SyncBatchSize:       defaultUniverseSyncBatchSize,
 	})
@@ -277,18 +324,75 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			UniverseSyncer:          universeSyncer,
 			LocalRegistrar:          baseUni,
 			SyncInterval:            cfg.Universe.SyncInterval,
-			NewRemoteRegistrar:      tap.NewRpcUniverseRegistrar,
+			NewRemoteRegistrar:      newRemoteRegistrar,
 			StaticFederationMembers: federationMembers,
 			ServerChecker: func(addr universe.ServerAddr) error {
 				return tap.CheckFederationServer(
 					runtimeID, universe.DefaultTimeout,
-					addr,
+					addr, proxy,
 				)
 			},
 			ErrChan: mainErrChan,
 		},
 	)
 
+	universeCommitmentStore := tapdb.NewTransactionExecutor(db,
+		func(tx *sql.Tx) tapdb.UniverseCommitmentStore {
+			return db.WithTx(tx)
+		},
+	)
+	universeCommitments := universe.NewChainStamper(
+		universe.ChainStamperConfig{
+			Multiverse: multiverse,
+			Commitments: tapdb.NewUniverseCommitmentDB(
+				universeCommitmentStore,
+			),
+			Committer: universe.NewOnChainCommitter(
+				universe.OnChainCommitterConfig{
+					KeyRing:     keyRing,
+					Wallet:      walletAnchor,
+					ChainBridge: chainBridge,
+				},
+			),
+			CommitInterval: cfg.Universe.ChainCommitInterval,
+		},
+	)
+
+	// Besides the federation servers, we also scan the default proof
+	// courier for proofs to recover if it is a universe server.
+	walletRecoverer := tapgarden.NewRecoverer(&tapgarden.RecovererConfig{
+		Enabled:   cfg.Recovery.Enable,
+		KeyWindow: cfg.Recovery.KeyWindow,
+		KeyRing:   keyRing,
+		UniverseServers: func(
+			ctx context.Context) ([]universe.ServerAddr, error) {
+
+			servers, err := federationDB.UniverseServers(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			if proofCourierAddr.Scheme ==
+				proof.UniverseRpcCourierType {
+
+				servers = append(
+					servers, universe.NewServerAddrFromStr(
+						proofCourierAddr.Host,
+					),
+				)
+			}
+
+			return servers, nil
+		},
+		NewDiffEngine:  newRemoteDiffEngine,
+		KeyStore:       tapdbAddrBook,
+		Wallet:         walletAnchor,
+		ProofArchive:   proofArchive,
+		HeaderVerifier: headerVerifier,
+		GroupVerifier:  groupVerifier,
+		ChainLookupGen: chainBridge,
+	})
+
 	addrBookConfig := address.BookConfig{
 		Store:        tapdbAddrBook,
 		Syncer:       universeFederation,
@@ -309,20 +413,23 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		AddrBook:         tapdbAddrBook,
 		KeyRing:          keyRing,
 		Signer:           virtualTxSigner,
+		MuSig2Signer:     virtualTxSigner,
 		TxValidator:      &tap.ValidatorV0{},
 		WitnessValidator: &tap.WitnessValidatorV0{},
 		Wallet:           walletAnchor,
 		ChainParams:      &tapChainParams,
 	})
 
-	// Addresses can have different proof couriers configured, but both
+	// Addresses can have different proof couriers configured, but all
 	// types of couriers that currently exist will receive this config upon
 	// initialization.
 	proofCourierDispatcher := proof.NewCourierDispatch(&proof.CourierCfg{
 		HashMailCfg:    cfg.HashMailCourier,
 		UniverseRpcCfg: cfg.UniverseRpcCourier,
+		FileCfg:        cfg.FileCourier,
 		TransferLog:    assetStore,
 		LocalArchive:   proofArchive,
+		Proxy:          proxy,
 	})
 
 	multiNotifier := proof.NewMultiArchiveNotifier(assetStore, multiverse)
@@ -363,12 +470,51 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		}
 	}
 
+	// If requested, we'll keep a local history of all the rate ticks that
+	// we receive from the price oracle.
+	var rateTickStore rfq.RateTickStore
+	if rfqCfg.RecordRateTicks {
+		rateTickDB := tapdb.NewTransactionExecutor(db,
+			func(tx *sql.Tx) tapdb.RfqRateTickStore {
+				return db.WithTx(tx)
+			},
+		)
+		rateTickStore = tapdb.NewRfqRateTickDB(rateTickDB)
+	}
+
+	// To avoid hitting the price oracle for every single quote, we'll
+	// cache its rate ticks until they expire, unless disabled. The rate
+	// ticks are recorded either way.
+	if priceOracle != nil {
+		priceOracle = rfq.NewCachingPriceOracle(
+			rfq.CachingPriceOracleCfg{
+				Oracle:          priceOracle,
+				TickStore:       rateTickStore,
+				Clock:           defaultClock,
+				MinTickLifetime: rfq.DefaultMinRateTickLifetime,
+				DisableCache:    rfqCfg.DisableOracleCache,
+			},
+		)
+	}
+
+	// Accepted quotes and the HTLCs paid against them are persisted, so
+	// quotes survive a restart and their history can be queried.
+	quoteDB := tapdb.NewTransactionExecutor(db,
+		func(tx *sql.Tx) tapdb.RfqQuoteStore {
+			return db.WithTx(tx)
+		},
+	)
+	quoteStore := tapdb.NewRfqQuoteDB(quoteDB)
+
 	// Construct the RFQ manager.
 	rfqManager, err := rfq.NewManager(
 		rfq.ManagerCfg{
 			PeerMessenger:   msgTransportClient,
 			HtlcInterceptor: lndRouterClient,
 			PriceOracle:     priceOracle,
+			RateTickStore:   rateTickStore,
+			QuoteStore:      quoteStore,
+			Signer:          lndServices.Signer,
 			ChannelLister:   walletAnchor,
 			AliasManager:    lndRouterClient,
 			// nolint: lll
@@ -395,6 +541,8 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			GroupVerifier: tapgarden.GenGroupVerifier(
 				context.Background(), assetMintingStore,
 			),
+			CheckpointVerifier:     checkpointVerifier,
+			HistoryFetcher:         historyFetcher,
 			Wallet:                 walletAnchor,
 			KeyRing:                keyRing,
 			AssetWallet:            assetWallet,
@@ -413,6 +561,21 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		},
 	)
 	channelFunder := tap.NewLndPbstChannelFunder(lndServices)
+
+	// The asset payment RPCs need to set fields of lnd's payment and
+	// invoice requests that lndclient doesn't support, so we create a raw
+	// client for them if we are running with channel features enabled.
+	var lndPaymentClient *tap.LndPaymentClient
+	if enableChannelFeatures {
+		lndPaymentClient, err = tap.NewLndPaymentClient(
+			lndServices, cfg.Lnd.MacaroonPath,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create lnd payment "+
+				"client: %w", err)
+		}
+	}
+
 	auxFundingController := tapchannel.NewFundingController(
 		tapchannel.FundingControllerCfg{
 			HeaderVerifier: headerVerifier,
@@ -495,10 +658,12 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		RuntimeID:             runtimeID,
 		EnableChannelFeatures: enableChannelFeatures,
 		Lnd:                   lndServices,
+		LndPayments:           lndPaymentClient,
 		ChainParams: address.ParamsForChain(
 			cfg.ActiveNetParams.Name,
 		),
 		ReOrgWatcher: reOrgWatcher,
+		WalletAnchor: walletAnchor,
 		AssetMinter: tapgarden.NewChainPlanter(tapgarden.PlanterConfig{
 			GardenKit: tapgarden.GardenKit{
 				Wallet:                walletAnchor,
@@ -531,6 +696,8 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 				ErrChan:                mainErrChan,
 				ProofCourierDispatcher: proofCourierDispatcher,
 				ProofRetrievalDelay:    cfg.CustodianProofRetrievalDelay, ProofWatcher: reOrgWatcher,
+				ReusableAddrPollInterval: cfg.
+					CustodianReusableAddrPollInterval,
 			},
 		),
 		ChainBridge:              chainBridge,
@@ -538,17 +705,22 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		AddrBookDisableSyncer:    cfg.AddrBook.DisableSyncer,
 		DefaultProofCourierAddr:  proofCourierAddr,
 		ProofArchive:             proofArchive,
+		ProofCheckpointVerifier:  checkpointVerifier,
+		ProofHistoryFetcher:      historyFetcher,
 		AssetWallet:              assetWallet,
 		CoinSelect:               coinSelect,
 		ChainPorter:              chainPorter,
 		UniverseArchive:          baseUni,
 		UniverseSyncer:           universeSyncer,
 		UniverseFederation:       universeFederation,
+		UniverseCommitments:      universeCommitments,
+		WalletRecoverer:          walletRecoverer,
 		UniFedSyncAllAssets:      cfg.Universe.SyncAllAssets,
 		UniverseStats:            universeStats,
 		UniversePublicAccess:     universePublicAccess,
 		UniverseQueriesPerSecond: cfg.Universe.UniverseQueriesPerSecond,
 		UniverseQueriesBurst:     cfg.Universe.UniverseQueriesBurst,
+		Proxy:                    proxy,
 		RfqManager:               rfqManager,
 		AuxLeafSigner:            auxLeafSigner,
 		AuxFundingController:     auxFundingController,
@@ -74,6 +74,22 @@ type (
 	// ScriptKey is a type alias for fetching the script key information.
 	ScriptKey = sqlc.FetchScriptKeyByTweakedKeyRow
 
+	// MuSig2ScriptKey is a type alias for fetching the key aggregation
+	// context of a MuSig2 script key.
+	MuSig2ScriptKey = sqlc.FetchMuSig2ScriptKeyRow
+
+	// NewMuSig2ScriptKey is a type alias for inserting the key aggregation
+	// context of a MuSig2 script key.
+	NewMuSig2ScriptKey = sqlc.UpsertMuSig2ScriptKeyParams
+
+	// ScriptKeyDescriptor is a type alias for fetching the output
+	// descriptor of a script key.
+	ScriptKeyDescriptor = sqlc.FetchScriptKeyDescriptorRow
+
+	// NewScriptKeyDescriptor is a type alias for inserting the output
+	// descriptor of a script key.
+	NewScriptKeyDescriptor = sqlc.UpsertScriptKeyDescriptorParams
+
 	// KeyLocator is a type alias for fetching the key locator information
 	// for an internal key.
 	KeyLocator = sqlc.FetchInternalKeyLocatorRow
@@ -685,13 +701,26 @@ func (t *TapAddressBook) InsertScriptKey(ctx context.Context,
 			return fmt.Errorf("error inserting internal key: %w",
 				err)
 		}
-		_, err = q.UpsertScriptKey(ctx, NewScriptKey{
+		scriptKeyID, err := q.UpsertScriptKey(ctx, NewScriptKey{
 			InternalKeyID:    internalKeyID,
 			TweakedScriptKey: scriptKey.PubKey.SerializeCompressed(),
 			Tweak:            scriptKey.Tweak,
 			DeclaredKnown:    sqlBool(declaredKnown),
 		})
-		return err
+		if err != nil {
+			return err
+		}
+
+		err = upsertMuSig2ScriptKey(
+			ctx, q, scriptKeyID, scriptKey.MuSig2,
+		)
+		if err != nil {
+			return err
+		}
+
+		return upsertScriptKeyDescriptor(
+			ctx, q, scriptKeyID, scriptKey.Descriptor,
+		)
 	})
 }
 
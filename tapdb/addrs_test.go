@@ -7,13 +7,16 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/stretchr/testify/require"
 )
@@ -644,3 +647,101 @@ func TestAddressEventQuery(t *testing.T) {
 		})
 	}
 }
+
+// TestMuSig2ScriptKey tests that the key aggregation context of a MuSig2 script
+// key is stored alongside the script key and returned when fetching it.
+func TestMuSig2ScriptKey(t *testing.T) {
+	t.Parallel()
+
+	addrBook, _ := newAddrBook(t, clock.NewTestClock(time.Now()))
+	ctx := context.Background()
+
+	localKey := keychain.KeyDescriptor{
+		PubKey: test.RandPubKey(t),
+		KeyLocator: keychain.KeyLocator{
+			Family: asset.TaprootAssetsKeyFamily,
+			Index:  test.RandInt[uint32](),
+		},
+	}
+	remoteKeys := []*btcec.PublicKey{
+		test.RandPubKey(t), test.RandPubKey(t),
+	}
+
+	scriptKey, err := asset.NewMuSig2ScriptKey(localKey, remoteKeys)
+	require.NoError(t, err)
+	require.NoError(t, addrBook.InsertScriptKey(ctx, scriptKey, true))
+
+	// Inserting the same key again must be a no-op.
+	require.NoError(t, addrBook.InsertScriptKey(ctx, scriptKey, true))
+
+	dbScriptKey, err := addrBook.FetchScriptKey(ctx, scriptKey.PubKey)
+	require.NoError(t, err)
+	require.NotNil(t, dbScriptKey.MuSig2)
+	require.True(t, scriptKey.MuSig2.IsEqual(dbScriptKey.MuSig2))
+	require.Len(t, dbScriptKey.MuSig2.RemoteKeys(), len(remoteKeys))
+	require.True(
+		t, scriptKey.RawKey.PubKey.IsEqual(dbScriptKey.RawKey.PubKey),
+	)
+
+	// The local key must also be known as an internal key, so we're able
+	// to derive it when signing.
+	keyLoc, err := addrBook.FetchInternalKeyLocator(ctx, localKey.PubKey)
+	require.NoError(t, err)
+	require.Equal(t, localKey.KeyLocator, keyLoc)
+
+	// A normal script key doesn't carry any key aggregation context.
+	bip86Key := asset.NewScriptKeyBip86(localKey)
+	require.NoError(t, addrBook.InsertScriptKey(ctx, bip86Key, true))
+
+	dbScriptKey, err = addrBook.FetchScriptKey(ctx, bip86Key.PubKey)
+	require.NoError(t, err)
+	require.Nil(t, dbScriptKey.MuSig2)
+}
+
+// TestScriptKeyDescriptor tests that the output descriptor of a script key is
+// stored alongside the script key and returned when fetching it.
+func TestScriptKeyDescriptor(t *testing.T) {
+	t.Parallel()
+
+	addrBook, _ := newAddrBook(t, clock.NewTestClock(time.Now()))
+	ctx := context.Background()
+
+	localKey := keychain.KeyDescriptor{
+		PubKey: test.RandPubKey(t),
+		KeyLocator: keychain.KeyLocator{
+			Family: asset.TaprootAssetsKeyFamily,
+			Index:  test.RandInt[uint32](),
+		},
+	}
+
+	// The internal key of a descriptor isn't necessarily a local key, so
+	// it doesn't have a key locator.
+	scriptKey := asset.RandScriptKey(t)
+	scriptKey.TweakedScriptKey = &asset.TweakedScriptKey{
+		RawKey: keychain.KeyDescriptor{
+			PubKey: test.RandPubKey(t),
+		},
+		Tweak: test.RandBytes(32),
+		Descriptor: &asset.DescriptorInfo{
+			Descriptor: "tr(internal,pk(local))#checksum",
+			LocalKey:   localKey,
+		},
+	}
+	require.NoError(t, addrBook.InsertScriptKey(ctx, scriptKey, true))
+
+	// Inserting the same key again must be a no-op.
+	require.NoError(t, addrBook.InsertScriptKey(ctx, scriptKey, true))
+
+	dbScriptKey, err := addrBook.FetchScriptKey(ctx, scriptKey.PubKey)
+	require.NoError(t, err)
+	require.Nil(t, dbScriptKey.MuSig2)
+	require.NotNil(t, dbScriptKey.Descriptor)
+	require.True(t, scriptKey.Descriptor.IsEqual(dbScriptKey.Descriptor))
+	require.Equal(t, scriptKey.Tweak, dbScriptKey.Tweak)
+
+	// The local key must also be known as an internal key, so we're able
+	// to derive it when signing.
+	keyLoc, err := addrBook.FetchInternalKeyLocator(ctx, localKey.PubKey)
+	require.NoError(t, err)
+	require.Equal(t, localKey.KeyLocator, keyLoc)
+}
@@ -214,6 +214,10 @@ type PendingAssetStore interface {
 	// that once confirmed will mint the asset.
 	AnchorPendingAssets(ctx context.Context, arg AssetAnchor) error
 
+	// DeleteManagedUTXO deletes the managed utxo identified by the passed
+	// serialized outpoint.
+	DeleteManagedUTXO(ctx context.Context, outpoint []byte) error
+
 	// UpsertChainTx inserts a new or updates an existing chain tx into the
 	// DB.
 	UpsertChainTx(ctx context.Context, arg ChainTxParams) (int64, error)
@@ -1510,13 +1514,34 @@ func (a *AssetMintingStore) CommitSignedGenesisTx(ctx context.Context,
 
 	var writeTxOpts AssetStoreTxOptions
 	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		// The batch might already have been committed to a different
+		// genesis transaction that is now being replaced, for example
+		// to bump its fee. We'll need the anchor point of that
+		// transaction to clean up after re-anchoring the assets.
+		dbBatch, err := q.FetchMintingBatch(ctx, rawBatchKey)
+		if err != nil {
+			return fmt.Errorf("unable to fetch batch: %w", err)
+		}
+		prevAnchorPoint := anchorPoint
+		if len(dbBatch.MintingTxPsbt) > 0 {
+			prevPkt, err := psbt.NewFromRawBytes(
+				bytes.NewReader(dbBatch.MintingTxPsbt), false,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to decode genesis "+
+					"psbt: %w", err)
+			}
+
+			prevAnchorPoint.Hash = prevPkt.UnsignedTx.TxHash()
+		}
+
 		// First, we'll update the genesis packet stored as part of the
 		// batch, as this packet is now fully signed.
 		var psbtBuf bytes.Buffer
 		if err := genesisPkt.Pkt.Serialize(&psbtBuf); err != nil {
 			return err
 		}
-		err := q.UpdateBatchGenesisTx(ctx, GenesisTxUpdate{
+		err = q.UpdateBatchGenesisTx(ctx, GenesisTxUpdate{
 			RawKey:        rawBatchKey,
 			MintingTxPsbt: psbtBuf.Bytes(),
 		})
@@ -1568,6 +1593,21 @@ func (a *AssetMintingStore) CommitSignedGenesisTx(ctx context.Context,
 				err)
 		}
 
+		// If we replaced a previous genesis transaction, none of the
+		// assets reference its anchor UTXO anymore, so we remove it.
+		if prevAnchorPoint != anchorPoint {
+			prevOutpoint, err := encodeOutpoint(prevAnchorPoint)
+			if err != nil {
+				return err
+			}
+
+			err = q.DeleteManagedUTXO(ctx, prevOutpoint)
+			if err != nil {
+				return fmt.Errorf("unable to delete replaced "+
+					"managed utxo: %w", err)
+			}
+		}
+
 		// Next, we'll anchor the genesis point-to-point to the chain
 		// transaction we inserted above.
 		if err := q.AnchorGenesisPoint(ctx, GenesisPointAnchor{
@@ -58,6 +58,14 @@ type UpsertAssetStore interface {
 	// UpsertScriptKey inserts a new script key on disk into the DB.
 	UpsertScriptKey(context.Context, NewScriptKey) (int64, error)
 
+	// UpsertMuSig2ScriptKey inserts the key aggregation context of a
+	// MuSig2 script key into the DB.
+	UpsertMuSig2ScriptKey(context.Context, NewMuSig2ScriptKey) error
+
+	// UpsertScriptKeyDescriptor inserts the output descriptor of a script
+	// key into the DB.
+	UpsertScriptKeyDescriptor(context.Context, NewScriptKeyDescriptor) error
+
 	// UpsertAssetGroupWitness inserts a new asset group witness into the DB.
 	UpsertAssetGroupWitness(ctx context.Context,
 		arg AssetGroupWitness) (int64, error)
@@ -387,6 +395,20 @@ func upsertScriptKey(ctx context.Context, scriptKey asset.ScriptKey,
 			return 0, fmt.Errorf("%w: %w", ErrUpsertScriptKey, err)
 		}
 
+		err = upsertMuSig2ScriptKey(
+			ctx, q, scriptKeyID, scriptKey.MuSig2,
+		)
+		if err != nil {
+			return 0, err
+		}
+
+		err = upsertScriptKeyDescriptor(
+			ctx, q, scriptKeyID, scriptKey.Descriptor,
+		)
+		if err != nil {
+			return 0, err
+		}
+
 		return scriptKeyID, nil
 	}
 
@@ -422,6 +444,74 @@ func upsertScriptKey(ctx context.Context, scriptKey asset.ScriptKey,
 	return scriptKeyID, nil
 }
 
+// upsertMuSig2ScriptKey inserts the key aggregation context of a MuSig2 script
+// key with the given database ID, including the local signer key. Nothing is
+// inserted if the script key isn't a MuSig2 script key.
+func upsertMuSig2ScriptKey(ctx context.Context, q UpsertAssetStore,
+	scriptKeyID int64, muSig2Info *asset.MuSig2KeyInfo) error {
+
+	if muSig2Info == nil {
+		return nil
+	}
+
+	localKeyID, err := q.UpsertInternalKey(ctx, InternalKey{
+		RawKey:    muSig2Info.LocalKey.PubKey.SerializeCompressed(),
+		KeyFamily: int32(muSig2Info.LocalKey.Family),
+		KeyIndex:  int32(muSig2Info.LocalKey.Index),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUpsertInternalKey, err)
+	}
+
+	var signerKeys bytes.Buffer
+	for _, signerKey := range muSig2Info.SignerKeys {
+		signerKeys.Write(signerKey.SerializeCompressed())
+	}
+
+	err = q.UpsertMuSig2ScriptKey(ctx, NewMuSig2ScriptKey{
+		ScriptKeyID: scriptKeyID,
+		LocalKeyID:  localKeyID,
+		SignerKeys:  signerKeys.Bytes(),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to upsert MuSig2 script key: %w", err)
+	}
+
+	return nil
+}
+
+// upsertScriptKeyDescriptor inserts the output descriptor of the script key
+// with the given database ID, including the local key used in the descriptor.
+// Nothing is inserted if the script key wasn't imported from a descriptor.
+func upsertScriptKeyDescriptor(ctx context.Context, q UpsertAssetStore,
+	scriptKeyID int64, descInfo *asset.DescriptorInfo) error {
+
+	if descInfo == nil {
+		return nil
+	}
+
+	localKeyID, err := q.UpsertInternalKey(ctx, InternalKey{
+		RawKey:    descInfo.LocalKey.PubKey.SerializeCompressed(),
+		KeyFamily: int32(descInfo.LocalKey.Family),
+		KeyIndex:  int32(descInfo.LocalKey.Index),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUpsertInternalKey, err)
+	}
+
+	err = q.UpsertScriptKeyDescriptor(ctx, NewScriptKeyDescriptor{
+		ScriptKeyID: scriptKeyID,
+		LocalKeyID:  localKeyID,
+		Descriptor:  descInfo.Descriptor,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to upsert script key descriptor: %w",
+			err)
+	}
+
+	return nil
+}
+
 // FetchScriptKeyStore houses the methods related to fetching all information
 // about a script key.
 type FetchScriptKeyStore interface {
@@ -429,6 +519,16 @@ type FetchScriptKeyStore interface {
 	// corresponding internal key from the database.
 	FetchScriptKeyByTweakedKey(ctx context.Context,
 		tweakedScriptKey []byte) (ScriptKey, error)
+
+	// FetchMuSig2ScriptKey attempts to fetch the key aggregation context
+	// of a MuSig2 script key from the database.
+	FetchMuSig2ScriptKey(ctx context.Context,
+		tweakedScriptKey []byte) (MuSig2ScriptKey, error)
+
+	// FetchScriptKeyDescriptor attempts to fetch the output descriptor of
+	// a script key from the database.
+	FetchScriptKeyDescriptor(ctx context.Context,
+		tweakedScriptKey []byte) (ScriptKeyDescriptor, error)
 }
 
 // fetchScriptKey attempts to fetch the full tweaked script key struct
@@ -462,9 +562,100 @@ func fetchScriptKey(ctx context.Context, q FetchScriptKeyStore,
 		DeclaredKnown: dbKey.DeclaredKnown.Valid,
 	}
 
+	// If this is a MuSig2 script key, we also need the key aggregation
+	// context to be able to sign for it.
+	dbMuSig2Key, err := q.FetchMuSig2ScriptKey(
+		ctx, tweakedScriptKey.SerializeCompressed(),
+	)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		// Not a MuSig2 script key, nothing to add.
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch MuSig2 script key: %w",
+			err)
+
+	default:
+		scriptKey.MuSig2, err = parseMuSig2KeyInfo(dbMuSig2Key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// If the script key was imported from a descriptor, we also need the
+	// descriptor to be able to satisfy its spend paths.
+	dbDescriptor, err := q.FetchScriptKeyDescriptor(
+		ctx, tweakedScriptKey.SerializeCompressed(),
+	)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return scriptKey, nil
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch script key "+
+			"descriptor: %w", err)
+	}
+
+	localKey, err := btcec.ParsePubKey(dbDescriptor.RawKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse local key: %w", err)
+	}
+
+	scriptKey.Descriptor = &asset.DescriptorInfo{
+		Descriptor: dbDescriptor.Descriptor,
+		LocalKey: keychain.KeyDescriptor{
+			PubKey: localKey,
+			KeyLocator: keychain.KeyLocator{
+				Family: keychain.KeyFamily(
+					dbDescriptor.KeyFamily,
+				),
+				Index: uint32(dbDescriptor.KeyIndex),
+			},
+		},
+	}
+
 	return scriptKey, nil
 }
 
+// parseMuSig2KeyInfo parses the key aggregation context of a MuSig2 script key
+// from its database representation.
+func parseMuSig2KeyInfo(dbKey MuSig2ScriptKey) (*asset.MuSig2KeyInfo, error) {
+	localKey, err := btcec.ParsePubKey(dbKey.RawKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse local key: %w", err)
+	}
+
+	if len(dbKey.SignerKeys)%btcec.PubKeyBytesLenCompressed != 0 {
+		return nil, fmt.Errorf("invalid signer keys length %d",
+			len(dbKey.SignerKeys))
+	}
+
+	var signerKeys []*btcec.PublicKey
+	for rawKeys := dbKey.SignerKeys; len(rawKeys) > 0; {
+		signerKey, err := btcec.ParsePubKey(
+			rawKeys[:btcec.PubKeyBytesLenCompressed],
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse signer key: "+
+				"%w", err)
+		}
+
+		signerKeys = append(signerKeys, signerKey)
+		rawKeys = rawKeys[btcec.PubKeyBytesLenCompressed:]
+	}
+
+	return &asset.MuSig2KeyInfo{
+		LocalKey: keychain.KeyDescriptor{
+			PubKey: localKey,
+			KeyLocator: keychain.KeyLocator{
+				Family: keychain.KeyFamily(dbKey.KeyFamily),
+				Index:  uint32(dbKey.KeyIndex),
+			},
+		},
+		SignerKeys: signerKeys,
+	}, nil
+}
+
 // FetchGenesisStore houses the methods related to fetching genesis assets.
 type FetchGenesisStore interface {
 	// FetchGenesisByID returns a single genesis asset by its primary key
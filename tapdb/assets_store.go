@@ -11,6 +11,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
@@ -23,6 +24,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapsend"
 	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/keychain"
 )
@@ -50,10 +52,22 @@ type (
 	// FetchAssetProof are the query parameters for fetching an asset proof.
 	FetchAssetProof = sqlc.FetchAssetProofParams
 
+	// FetchAssetProofHeader are the query parameters for fetching the
+	// header of an asset proof file.
+	FetchAssetProofHeader = sqlc.FetchAssetProofHeaderParams
+
 	// AssetProofByIDRow is the asset proof for a given asset, identified by
 	// its asset ID.
 	AssetProofByIDRow = sqlc.FetchAssetProofsByAssetIDRow
 
+	// WalletAssetProof is the proof of an asset along with the information
+	// whether the asset was already spent.
+	WalletAssetProof = sqlc.FetchAllAssetProofsRow
+
+	// WalletScriptKey is a script key along with the derivation
+	// information of its internal key.
+	WalletScriptKey = sqlc.FetchAllScriptKeysRow
+
 	// PrevInput stores the full input information including the prev out,
 	// and also the witness information itself.
 	PrevInput = sqlc.UpsertAssetWitnessParams
@@ -85,6 +99,10 @@ type (
 	// UpdateUTXOLease wraps the params needed to lease a managed UTXO.
 	UpdateUTXOLease = sqlc.UpdateUTXOLeaseParams
 
+	// UpdateUTXOOutpoint wraps the params needed to move a managed UTXO to
+	// a new outpoint.
+	UpdateUTXOOutpoint = sqlc.UpdateManagedUTXOOutpointParams
+
 	// ApplyPendingOutput is used to update the script key and amount of an
 	// existing asset.
 	ApplyPendingOutput = sqlc.ApplyPendingOutputParams
@@ -96,6 +114,10 @@ type (
 	// transfer.
 	NewAssetTransfer = sqlc.InsertAssetTransferParams
 
+	// TransferAnchorTxUpdate wraps the params needed to replace the anchor
+	// transaction of an asset transfer.
+	TransferAnchorTxUpdate = sqlc.UpdateAssetTransferAnchorTxParams
+
 	// AssetTransfer tracks an asset transfer.
 	AssetTransfer = sqlc.AssetTransfer
 
@@ -126,6 +148,10 @@ type (
 	// output.
 	NewTransferOutput = sqlc.InsertAssetTransferOutputParams
 
+	// TransferOutputProofUpdate wraps the params needed to update the proof
+	// suffix of a transfer output.
+	TransferOutputProofUpdate = sqlc.UpdateTransferOutputProofSuffixParams
+
 	// NewPassiveAsset wraps the params needed to insert a new passive
 	// asset.
 	NewPassiveAsset = sqlc.InsertPassiveAssetParams
@@ -136,6 +162,10 @@ type (
 	// ReAnchorParams wraps the params needed to re-anchor a passive asset.
 	ReAnchorParams = sqlc.ReAnchorPassiveAssetsParams
 
+	// PassiveAssetProofUpdate wraps the params needed to update the new
+	// proof of a passive asset.
+	PassiveAssetProofUpdate = sqlc.UpdatePassiveAssetProofParams
+
 	// LogProofTransAttemptParams is a type alias for the params needed to
 	// log a proof transfer attempt.
 	LogProofTransAttemptParams = sqlc.LogProofTransferAttemptParams
@@ -194,6 +224,12 @@ type ActiveAssetsStore interface {
 	FetchAssetProof(ctx context.Context,
 		arg FetchAssetProof) ([]AssetProofI, error)
 
+	// FetchAssetProofHeader fetches the header of the proof file of a
+	// given asset identified by its script key, without loading the
+	// proofs themselves.
+	FetchAssetProofHeader(ctx context.Context,
+		arg FetchAssetProofHeader) ([][]byte, error)
+
 	// HasAssetProof returns true if we have proof for a given asset
 	// identified by its script key.
 	HasAssetProof(ctx context.Context, scriptKey []byte) (bool, error)
@@ -203,6 +239,17 @@ type ActiveAssetsStore interface {
 	FetchAssetProofsByAssetID(ctx context.Context,
 		assetID []byte) ([]AssetProofByIDRow, error)
 
+	// FetchAllAssetProofs fetches the proofs of all assets, including the
+	// spent ones.
+	FetchAllAssetProofs(ctx context.Context) ([]WalletAssetProof, error)
+
+	// FetchAllScriptKeys fetches all script keys, along with their
+	// internal key derivation information.
+	FetchAllScriptKeys(ctx context.Context) ([]WalletScriptKey, error)
+
+	// AllInternalKeys fetches all internal keys.
+	AllInternalKeys(ctx context.Context) ([]sqlc.InternalKey, error)
+
 	// UpsertChainTx inserts a new or updates an existing chain tx into the
 	// DB.
 	UpsertChainTx(ctx context.Context, arg ChainTxParams) (int64, error)
@@ -253,6 +300,11 @@ type ActiveAssetsStore interface {
 	// serialized outpoint.
 	UpdateUTXOLease(ctx context.Context, arg UpdateUTXOLease) error
 
+	// UpdateManagedUTXOOutpoint moves a managed UTXO identified by the
+	// passed serialized outpoint to a new outpoint.
+	UpdateManagedUTXOOutpoint(ctx context.Context,
+		arg UpdateUTXOOutpoint) error
+
 	// DeleteUTXOLease deletes the lease on a managed UTXO identified by
 	// the passed serialized outpoint.
 	DeleteUTXOLease(ctx context.Context, outpoint []byte) error
@@ -268,6 +320,11 @@ type ActiveAssetsStore interface {
 	InsertAssetTransfer(ctx context.Context,
 		arg NewAssetTransfer) (int64, error)
 
+	// UpdateAssetTransferAnchorTx replaces the anchor transaction of an
+	// asset transfer.
+	UpdateAssetTransferAnchorTx(ctx context.Context,
+		arg TransferAnchorTxUpdate) error
+
 	// InsertAssetTransferInput inserts a new asset transfer input into the
 	// DB.
 	InsertAssetTransferInput(ctx context.Context,
@@ -278,6 +335,11 @@ type ActiveAssetsStore interface {
 	InsertAssetTransferOutput(ctx context.Context,
 		arg NewTransferOutput) error
 
+	// UpdateTransferOutputProofSuffix updates the proof suffix of an asset
+	// transfer output.
+	UpdateTransferOutputProofSuffix(ctx context.Context,
+		arg TransferOutputProofUpdate) error
+
 	// FetchTransferInputs fetches the inputs to a given asset transfer.
 	FetchTransferInputs(ctx context.Context,
 		transferID int64) ([]TransferInputRow, error)
@@ -317,6 +379,11 @@ type ActiveAssetsStore interface {
 	// the passed params.
 	ReAnchorPassiveAssets(ctx context.Context, arg ReAnchorParams) error
 
+	// UpdatePassiveAssetProof updates the new proof of a passive asset
+	// that is re-anchored by the given transfer.
+	UpdatePassiveAssetProof(ctx context.Context,
+		arg PassiveAssetProofUpdate) error
+
 	// FetchAssetMetaByHash fetches the asset meta for a given meta hash.
 	//
 	// TODO(roasbeef): split into MetaStore?
@@ -685,6 +752,12 @@ func (a *AssetStore) dbAssetsToChainAssets(dbAssets []ConfirmedAsset,
 			assetSprout.Amount = 0
 		}
 
+		// The script version isn't an option of the New function, as
+		// only transfers can create assets with other script versions.
+		assetSprout.ScriptVersion = asset.ScriptVersion(
+			sprout.ScriptVersion,
+		)
+
 		if len(sprout.SplitCommitmentRootHash) != 0 {
 			var nodeHash mssmt.NodeHash
 			copy(nodeHash[:], sprout.SplitCommitmentRootHash)
@@ -1907,6 +1980,59 @@ func (a *AssetStore) DeleteExpiredLeases(ctx context.Context) error {
 	})
 }
 
+// ProofChainLength returns the number of proofs in the proof file of the
+// asset identified by the given previous ID.
+func (a *AssetStore) ProofChainLength(ctx context.Context,
+	prevID asset.PrevID) (uint32, error) {
+
+	outpoint, err := encodeOutpoint(prevID.OutPoint)
+	if err != nil {
+		return 0, fmt.Errorf("unable to encode outpoint: %w", err)
+	}
+
+	// The number of proofs is part of the file header, so we only load the
+	// header instead of the whole proof file, which can be large.
+	args := FetchAssetProofHeader{
+		TweakedScriptKey: fn.CopySlice(prevID.ScriptKey[:]),
+		Outpoint:         outpoint,
+		GenAssetID:       fn.CopySlice(prevID.ID[:]),
+		HeaderSize:       proof.FileMaxHeaderSizeBytes,
+	}
+
+	var header []byte
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		headers, err := q.FetchAssetProofHeader(ctx, args)
+		if err != nil {
+			return fmt.Errorf("unable to fetch asset proof "+
+				"header: %w", err)
+		}
+
+		switch len(headers) {
+		case 0:
+			return proof.ErrProofNotFound
+
+		case 1:
+			header = headers[0]
+			return nil
+
+		default:
+			return proof.ErrMultipleProofs
+		}
+	})
+	if dbErr != nil {
+		return 0, dbErr
+	}
+
+	numProofs, err := proof.FileNumProofs(header)
+	if err != nil {
+		return 0, fmt.Errorf("unable to decode proof file header: %w",
+			err)
+	}
+
+	return uint32(numProofs), nil
+}
+
 // queryCommitments queries the database for commitments matching the passed
 // filter.
 func (a *AssetStore) queryCommitments(ctx context.Context,
@@ -2120,6 +2246,11 @@ func (a *AssetStore) LogPendingParcel(ctx context.Context,
 	}
 	anchorTxBytes := txBuf.Bytes()
 
+	anchorPsbtBytes, changeOutputIndex, err := encodeAnchorPsbt(spend)
+	if err != nil {
+		return err
+	}
+
 	var writeTxOpts AssetStoreTxOptions
 	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
 		// First, we'll insert the new transaction that anchors the new
@@ -2138,9 +2269,11 @@ func (a *AssetStore) LogPendingParcel(ctx context.Context,
 		// outputs will reference. We'll insert this next, so we can
 		// use its ID.
 		transferID, err := q.InsertAssetTransfer(ctx, NewAssetTransfer{
-			HeightHint:       int32(spend.AnchorTxHeightHint),
-			AnchorTxid:       newAnchorTXID[:],
-			TransferTimeUnix: spend.TransferTime,
+			HeightHint:        int32(spend.AnchorTxHeightHint),
+			AnchorTxid:        newAnchorTXID[:],
+			TransferTimeUnix:  spend.TransferTime,
+			AnchorPsbt:        anchorPsbtBytes,
+			ChangeOutputIndex: changeOutputIndex,
 		})
 		if err != nil {
 			return fmt.Errorf("unable to insert asset transfer: "+
@@ -2191,6 +2324,25 @@ func (a *AssetStore) LogPendingParcel(ctx context.Context,
 	})
 }
 
+// encodeAnchorPsbt serializes the funded anchor PSBT of the given parcel, if
+// it has one.
+func encodeAnchorPsbt(parcel *tapfreighter.OutboundParcel) ([]byte,
+	sql.NullInt32, error) {
+
+	if parcel.AnchorPsbt == nil || parcel.AnchorPsbt.Pkt == nil {
+		return nil, sql.NullInt32{}, nil
+	}
+
+	var psbtBuf bytes.Buffer
+	if err := parcel.AnchorPsbt.Pkt.Serialize(&psbtBuf); err != nil {
+		return nil, sql.NullInt32{}, fmt.Errorf("unable to encode "+
+			"anchor psbt: %w", err)
+	}
+
+	return psbtBuf.Bytes(), sqlInt32(parcel.AnchorPsbt.ChangeOutputIndex),
+		nil
+}
+
 // insertAssetTransferInput inserts a new asset transfer input into the DB.
 func insertAssetTransferInput(ctx context.Context, q ActiveAssetsStore,
 	transferID int64, input tapfreighter.TransferInput,
@@ -3111,6 +3263,26 @@ func (a *AssetStore) QueryParcels(ctx context.Context,
 				Inputs:             inputs,
 				Outputs:            outputs,
 			}
+
+			if dbT.AnchorPsbt != nil {
+				anchorPkt, err := psbt.NewFromRawBytes(
+					bytes.NewReader(dbT.AnchorPsbt), false,
+				)
+				if err != nil {
+					return fmt.Errorf("unable to decode "+
+						"anchor psbt: %w", err)
+				}
+
+				changeIdx := extractSqlInt32[int32](
+					dbT.ChangeOutputIndex,
+				)
+				transfer.AnchorPsbt = &tapsend.FundedPsbt{
+					Pkt:               anchorPkt,
+					ChangeOutputIndex: changeIdx,
+					ChainFees:         dbAnchorTx.ChainFees,
+				}
+			}
+
 			transfers = append(transfers, transfer)
 		}
 
@@ -3123,6 +3295,181 @@ func (a *AssetStore) QueryParcels(ctx context.Context,
 	return transfers, nil
 }
 
+// ReplaceParcelAnchorTx replaces the anchor transaction of the pending parcel
+// that is anchored in the transaction with the given ID with the anchor
+// transaction of the given parcel. The anchor outputs and proofs of the parcel
+// are updated to reference the new transaction.
+func (a *AssetStore) ReplaceParcelAnchorTx(ctx context.Context,
+	oldAnchorTxHash chainhash.Hash,
+	parcel *tapfreighter.OutboundParcel) error {
+
+	newAnchorTx := parcel.AnchorTx
+	newAnchorTXID := newAnchorTx.TxHash()
+
+	var txBuf bytes.Buffer
+	if err := newAnchorTx.Serialize(&txBuf); err != nil {
+		return err
+	}
+
+	anchorPsbtBytes, changeOutputIndex, err := encodeAnchorPsbt(parcel)
+	if err != nil {
+		return err
+	}
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		dbTransfers, err := q.QueryAssetTransfers(ctx, TransferQuery{
+			UnconfOnly:   true,
+			AnchorTxHash: oldAnchorTxHash[:],
+		})
+		if err != nil {
+			return fmt.Errorf("unable to query asset transfers: %w",
+				err)
+		}
+		if len(dbTransfers) != 1 {
+			return fmt.Errorf("expected one pending transfer with "+
+				"anchor_txid=%v, got %d", oldAnchorTxHash,
+				len(dbTransfers))
+		}
+		transferID := dbTransfers[0].ID
+
+		txnID, err := q.UpsertChainTx(ctx, ChainTxParams{
+			Txid:      newAnchorTXID[:],
+			RawTx:     txBuf.Bytes(),
+			ChainFees: parcel.ChainFees,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert new chain tx: %w",
+				err)
+		}
+
+		err = q.UpdateAssetTransferAnchorTx(ctx, TransferAnchorTxUpdate{
+			AnchorTxnID:       txnID,
+			AnchorPsbt:        anchorPsbtBytes,
+			ChangeOutputIndex: changeOutputIndex,
+			TransferID:        transferID,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to update asset transfer: %w",
+				err)
+		}
+
+		// The replacement transaction has the same outputs as the
+		// original one, so we can move each managed UTXO that was
+		// created by the original transaction to the same output index
+		// of the replacement. This covers the anchor outputs of both
+		// the active and passive assets.
+		for idx := range newAnchorTx.TxOut {
+			oldOutpoint, err := encodeOutpoint(wire.OutPoint{
+				Hash:  oldAnchorTxHash,
+				Index: uint32(idx),
+			})
+			if err != nil {
+				return err
+			}
+			newOutpoint, err := encodeOutpoint(wire.OutPoint{
+				Hash:  newAnchorTXID,
+				Index: uint32(idx),
+			})
+			if err != nil {
+				return err
+			}
+
+			utxoUpdate := UpdateUTXOOutpoint{
+				NewOutpoint: newOutpoint,
+				TxnID:       txnID,
+				OldOutpoint: oldOutpoint,
+			}
+			err = q.UpdateManagedUTXOOutpoint(ctx, utxoUpdate)
+			if err != nil {
+				return fmt.Errorf("unable to update managed "+
+					"utxo: %w", err)
+			}
+		}
+
+		// Finally, all proofs created by the transfer need to commit
+		// to the replacement transaction.
+		outputs, err := q.FetchTransferOutputs(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to fetch transfer outputs: "+
+				"%w", err)
+		}
+		for _, out := range outputs {
+			proofSuffix, err := replaceProofAnchorTx(
+				out.ProofSuffix, newAnchorTx,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to update proof "+
+					"suffix: %w", err)
+			}
+
+			err = q.UpdateTransferOutputProofSuffix(
+				ctx, TransferOutputProofUpdate{
+					ProofSuffix: proofSuffix,
+					OutputID:    out.OutputID,
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to update transfer "+
+					"output: %w", err)
+			}
+		}
+
+		passiveAssets, err := q.QueryPassiveAssets(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to query passive assets: %w",
+				err)
+		}
+		for _, passiveAsset := range passiveAssets {
+			newProof, err := replaceProofAnchorTx(
+				passiveAsset.NewProof, newAnchorTx,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to update passive "+
+					"asset proof: %w", err)
+			}
+
+			err = q.UpdatePassiveAssetProof(
+				ctx, PassiveAssetProofUpdate{
+					NewProof:   newProof,
+					TransferID: transferID,
+					AssetID:    passiveAsset.AssetID,
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to update passive "+
+					"asset: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// replaceProofAnchorTx replaces the anchor transaction of the given encoded
+// proof and returns the re-encoded proof. Empty proofs are returned as is.
+func replaceProofAnchorTx(proofBytes []byte,
+	anchorTx *wire.MsgTx) ([]byte, error) {
+
+	if len(proofBytes) == 0 {
+		return proofBytes, nil
+	}
+
+	var p proof.Proof
+	if err := p.Decode(bytes.NewReader(proofBytes)); err != nil {
+		return nil, err
+	}
+
+	p.AnchorTx = *anchorTx
+
+	var buf bytes.Buffer
+	if err := p.Encode(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
 // ErrAssetMetaNotFound is returned when an asset meta is not found in the
 // database.
 var ErrAssetMetaNotFound = fmt.Errorf("asset meta not found")
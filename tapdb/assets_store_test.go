@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"encoding/binary"
 	"math/rand"
 	"sort"
 	"testing"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
@@ -22,6 +24,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightninglabs/taproot-assets/tapsend"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/stretchr/testify/require"
@@ -413,6 +416,58 @@ func TestImportAssetProof(t *testing.T) {
 	require.EqualValues(t, testProof.Blob, []byte(dbBlob))
 }
 
+// TestProofChainLength tests that the number of proofs in a proof file can be
+// read from its header, without loading the whole file.
+func TestProofChainLength(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxb = context.Background()
+
+		dbHandle   = NewDbHandle(t)
+		assetStore = dbHandle.AssetStore
+	)
+
+	testAsset, testProof := dbHandle.AddRandomAssetProof(t)
+
+	// We replace the proof with a file that has a valid header announcing
+	// more proofs than the file actually contains. Only the header should
+	// be read, so the truncated proofs shouldn't matter.
+	var fileBlob bytes.Buffer
+	fileBlob.Write(proof.FilePrefixMagicBytes[:])
+	require.NoError(t, binary.Write(
+		&fileBlob, binary.BigEndian, uint32(proof.V0),
+	))
+	fileBlob.Write([]byte{0xfd, 0x01, 0x2c})
+	fileBlob.Write(bytes.Repeat([]byte{0x77}, 100))
+
+	testProof.Blob = fileBlob.Bytes()
+	require.NoError(t, assetStore.ImportProofs(
+		ctxb, proof.MockHeaderVerifier, proof.MockMerkleVerifier,
+		proof.MockGroupVerifier, proof.MockChainLookup, true, testProof,
+	))
+
+	prevID := asset.PrevID{
+		OutPoint:  testProof.AssetSnapshot.OutPoint,
+		ID:        testAsset.ID(),
+		ScriptKey: asset.ToSerialized(testAsset.ScriptKey.PubKey),
+	}
+	chainLength, err := assetStore.ProofChainLength(ctxb, prevID)
+	require.NoError(t, err)
+	require.EqualValues(t, 300, chainLength)
+
+	// There's no proof for a different asset ID.
+	otherAssetID := prevID
+	otherAssetID.ID = asset.RandID(t)
+	_, err = assetStore.ProofChainLength(ctxb, otherAssetID)
+	require.ErrorIs(t, err, proof.ErrProofNotFound)
+
+	// There's no proof for a different outpoint either.
+	prevID.OutPoint = test.RandOp(t)
+	_, err = assetStore.ProofChainLength(ctxb, prevID)
+	require.ErrorIs(t, err, proof.ErrProofNotFound)
+}
+
 // TestInternalKeyUpsert tests that if we insert an internal key that's a
 // duplicate, it works and we get the primary key of the key that was already
 // inserted.
@@ -1619,6 +1674,178 @@ func TestAssetExportLog(t *testing.T) {
 	require.Len(t, parcels, 0)
 }
 
+// TestReplaceParcelAnchorTx tests that the anchor transaction of a pending
+// parcel can be replaced, and that all anchor outputs and proofs of the parcel
+// reference the replacement afterward.
+func TestReplaceParcelAnchorTx(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	// We'll start with a single asset that we'll spend in the transfer.
+	assetGen := newAssetGenerator(t, 1, 1)
+	assetGen.genAssets(t, assetsStore, []assetDesc{{
+		assetGen:    assetGen.assetGens[0],
+		anchorPoint: assetGen.anchorPoints[0],
+		amt:         16,
+	}})
+
+	allAssets, err := assetsStore.FetchAllAssets(ctx, true, false, nil)
+	require.NoError(t, err)
+	require.Len(t, allAssets, 1)
+	inputAsset := allAssets[0]
+
+	// The anchor transaction has an asset output and a change output.
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t),
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		PkScript: bytes.Repeat([]byte{0x01}, 34),
+		Value:    1000,
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		PkScript: bytes.Repeat([]byte{0x02}, 34),
+		Value:    50_000,
+	})
+	anchorTxHash := anchorTx.TxHash()
+
+	newPsbt := func(tx *wire.MsgTx) *tapsend.FundedPsbt {
+		pkt, err := psbt.NewFromUnsignedTx(tx.Copy())
+		require.NoError(t, err)
+
+		return &tapsend.FundedPsbt{
+			Pkt:               pkt,
+			ChangeOutputIndex: 1,
+		}
+	}
+
+	scriptKey := asset.NewScriptKeyBip86(keychain.KeyDescriptor{
+		PubKey: test.RandPubKey(t),
+	})
+	proofSuffix := proof.RandProof(
+		t, inputAsset.Genesis, scriptKey.PubKey, wire.MsgBlock{
+			Transactions: []*wire.MsgTx{anchorTx},
+		}, 0, 0,
+	)
+	var proofBuf bytes.Buffer
+	require.NoError(t, proofSuffix.Encode(&proofBuf))
+
+	parcel := &tapfreighter.OutboundParcel{
+		AnchorTx:           anchorTx,
+		AnchorTxHeightHint: 1450,
+		ChainFees:          100,
+		AnchorPsbt:         newPsbt(anchorTx),
+		Inputs: []tapfreighter.TransferInput{{
+			PrevID: asset.PrevID{
+				OutPoint: assetGen.anchorPoints[0],
+				ID:       inputAsset.ID(),
+				ScriptKey: asset.ToSerialized(
+					inputAsset.ScriptKey.PubKey,
+				),
+			},
+			Amount: inputAsset.Amount,
+		}},
+		Outputs: []tapfreighter.TransferOutput{{
+			Anchor: tapfreighter.Anchor{
+				Value: 1000,
+				OutPoint: wire.OutPoint{
+					Hash:  anchorTxHash,
+					Index: 0,
+				},
+				InternalKey: keychain.KeyDescriptor{
+					PubKey: test.RandPubKey(t),
+				},
+				TaprootAssetRoot: bytes.Repeat([]byte{0x1}, 32),
+				MerkleRoot:       bytes.Repeat([]byte{0x1}, 32),
+			},
+			ScriptKey:      scriptKey,
+			ScriptKeyLocal: true,
+			Amount:         inputAsset.Amount,
+			AssetVersion:   asset.V0,
+			ProofSuffix:    proofBuf.Bytes(),
+		}},
+	}
+	require.NoError(t, assetsStore.LogPendingParcel(
+		ctx, parcel, fn.ToArray[[32]byte](test.RandBytes(32)),
+		time.Now().Add(time.Hour),
+	))
+
+	// The anchor PSBT should be stored with the parcel.
+	parcels, err := assetsStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Len(t, parcels, 1)
+	require.NotNil(t, parcels[0].AnchorPsbt)
+	require.Equal(
+		t, anchorTxHash, parcels[0].AnchorPsbt.Pkt.UnsignedTx.TxHash(),
+	)
+	require.EqualValues(t, 1, parcels[0].AnchorPsbt.ChangeOutputIndex)
+
+	// We'll now replace the anchor transaction with one that pays a higher
+	// fee by reducing the change output.
+	replacementTx := anchorTx.Copy()
+	replacementTx.TxOut[1].Value -= 500
+	replacementTxHash := replacementTx.TxHash()
+
+	replacement := parcel.Copy()
+	replacement.AnchorTx = replacementTx
+	replacement.ChainFees += 500
+	replacement.AnchorPsbt = newPsbt(replacementTx)
+	require.NoError(t, assetsStore.ReplaceParcelAnchorTx(
+		ctx, anchorTxHash, replacement,
+	))
+
+	// Replacing the original transaction again should fail, as there's no
+	// longer a transfer anchored in it.
+	require.Error(t, assetsStore.ReplaceParcelAnchorTx(
+		ctx, anchorTxHash, replacement,
+	))
+
+	parcels, err = assetsStore.QueryParcels(ctx, &anchorTxHash, true)
+	require.NoError(t, err)
+	require.Empty(t, parcels)
+
+	parcels, err = assetsStore.QueryParcels(ctx, &replacementTxHash, true)
+	require.NoError(t, err)
+	require.Len(t, parcels, 1)
+
+	dbParcel := parcels[0]
+	require.Equal(t, replacementTxHash, dbParcel.AnchorTx.TxHash())
+	require.Equal(t, replacement.ChainFees, dbParcel.ChainFees)
+	require.Equal(
+		t, replacementTxHash,
+		dbParcel.AnchorPsbt.Pkt.UnsignedTx.TxHash(),
+	)
+	require.Equal(t, parcel.Inputs, dbParcel.Inputs)
+
+	// The anchor output and the proof suffix should both reference the
+	// replacement transaction.
+	require.Len(t, dbParcel.Outputs, 1)
+	dbOutput := dbParcel.Outputs[0]
+	require.Equal(t, wire.OutPoint{
+		Hash:  replacementTxHash,
+		Index: 0,
+	}, dbOutput.Anchor.OutPoint)
+
+	var dbProofSuffix proof.Proof
+	require.NoError(t, dbProofSuffix.Decode(
+		bytes.NewReader(dbOutput.ProofSuffix),
+	))
+	require.Equal(t, replacementTxHash, dbProofSuffix.AnchorTx.TxHash())
+	require.Equal(t, proofSuffix.Asset, dbProofSuffix.Asset)
+
+	// The managed UTXO of the original anchor output should have been
+	// moved to the replacement.
+	utxos, err := assetsStore.FetchManagedUTXOs(ctx)
+	require.NoError(t, err)
+	utxoPoints := fn.Map(utxos, func(u *ManagedUTXO) wire.OutPoint {
+		return u.OutPoint
+	})
+	require.Contains(t, utxoPoints, dbOutput.Anchor.OutPoint)
+	require.NotContains(t, utxoPoints, parcel.Outputs[0].Anchor.OutPoint)
+}
+
 // TestAssetGroupWitnessUpsert tests that if you try to insert another asset
 // group witness with the same asset_gen_id, then only one is actually created.
 func TestAssetGroupWitnessUpsert(t *testing.T) {
@@ -705,6 +705,78 @@ func (b *MultiverseStore) UniverseLeafKeys(ctx context.Context,
 	return leafKeys, nil
 }
 
+// UniverseDiffChildren returns the left and right children of the branch nodes
+// with the given hashes, which are all located at the given height of the
+// universe tree with the given ID.
+func (b *MultiverseStore) UniverseDiffChildren(ctx context.Context,
+	id universe.Identifier, height int,
+	nodes []mssmt.NodeHash) ([][2]mssmt.DiffNode, error) {
+
+	var children [][2]mssmt.DiffNode
+
+	readTx := NewBaseUniverseReadTx()
+	dbErr := b.db.ExecTx(ctx, &readTx, func(db BaseMultiverseStore) error {
+		treeStore := newTreeStoreWrapperTx(db, id.String())
+		view := func(tx mssmt.TreeStoreViewTx) error {
+			var err error
+			children, err = mssmt.DiffChildren(tx, height, nodes)
+			return err
+		}
+
+		return treeStore.View(ctx, view)
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return children, nil
+}
+
+// UniverseLeafKeysByNodeKey returns the leaf keys of the leaves stored at the
+// given MS-SMT leaf node keys of the universe tree with the given ID. If any of
+// the leaves isn't found, then universe.ErrNoUniverseProofFound is returned.
+func (b *MultiverseStore) UniverseLeafKeysByNodeKey(ctx context.Context,
+	id universe.Identifier,
+	nodeKeys [][32]byte) ([]universe.LeafKey, error) {
+
+	leafKeys := make([]universe.LeafKey, len(nodeKeys))
+
+	readTx := NewBaseUniverseReadTx()
+	dbErr := b.db.ExecTx(ctx, &readTx, func(db BaseMultiverseStore) error {
+		for idx, nodeKey := range nodeKeys {
+			dbKey, err := db.FetchUniverseLeafKey(
+				ctx, UniverseLeafKeyQuery{
+					Namespace:   id.String(),
+					LeafNodeKey: fn.ByteSlice(nodeKey),
+				},
+			)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				return fmt.Errorf("%w: node key %x",
+					universe.ErrNoUniverseProofFound,
+					nodeKey[:])
+
+			case err != nil:
+				return err
+			}
+
+			leafKeys[idx], err = parseUniverseLeafKey(
+				dbKey.MintingPoint, dbKey.ScriptKeyBytes,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return leafKeys, nil
+}
+
 // RootNodes returns the complete set of known base universe root nodes for the
 // set of base universes tracked in the multiverse.
 func (b *MultiverseStore) RootNodes(ctx context.Context,
@@ -0,0 +1,399 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfq"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// defaultQuoteQueryLimit is the maximum number of quotes that are
+	// returned by a query if no explicit limit is given.
+	defaultQuoteQueryLimit = 1000
+)
+
+type (
+	// NewRfqQuote is used to insert a new accepted quote.
+	NewRfqQuote = sqlc.InsertRfqQuoteParams
+
+	// NewRfqHtlcFill is used to insert an HTLC accepted against a quote.
+	NewRfqHtlcFill = sqlc.InsertRfqHtlcFillParams
+
+	// RfqQuoteQuery is used to query for past quotes.
+	RfqQuoteQuery = sqlc.QueryRfqQuotesParams
+
+	// RfqHtlcFill is an HTLC accepted against a quote, joined with the ID
+	// of the quote.
+	RfqHtlcFill = sqlc.FetchRfqHtlcFillsRow
+)
+
+// RfqQuoteStore is the main storage interface for accepted RFQ quotes and the
+// HTLCs accepted against them.
+type RfqQuoteStore interface {
+	// InsertRfqQuote inserts a new accepted quote.
+	InsertRfqQuote(ctx context.Context, arg NewRfqQuote) error
+
+	// FetchActiveRfqQuotes fetches all quotes that expire after the given
+	// time.
+	FetchActiveRfqQuotes(ctx context.Context,
+		now time.Time) ([]sqlc.RfqQuote, error)
+
+	// FetchExpiredRfqAliases fetches all expired quotes with an SCID alias
+	// that wasn't removed yet.
+	FetchExpiredRfqAliases(ctx context.Context,
+		now time.Time) ([]sqlc.RfqQuote, error)
+
+	// MarkRfqAliasDeleted marks the SCID alias of a quote as removed.
+	MarkRfqAliasDeleted(ctx context.Context, quoteID []byte) error
+
+	// QueryRfqQuotes queries for quotes matching the given filters.
+	QueryRfqQuotes(ctx context.Context,
+		arg RfqQuoteQuery) ([]sqlc.RfqQuote, error)
+
+	// InsertRfqHtlcFill inserts an HTLC accepted against a quote.
+	InsertRfqHtlcFill(ctx context.Context, arg NewRfqHtlcFill) error
+
+	// FetchRfqHtlcFills fetches all HTLCs accepted against a quote.
+	FetchRfqHtlcFills(ctx context.Context,
+		quoteID []byte) ([]RfqHtlcFill, error)
+}
+
+// RfqQuoteOptions is the database tx object for the quote store.
+type RfqQuoteOptions struct {
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+func (r *RfqQuoteOptions) ReadOnly() bool {
+	return r.readOnly
+}
+
+// NewRfqQuoteReadTx returns a new read tx for the quote store.
+func NewRfqQuoteReadTx() RfqQuoteOptions {
+	return RfqQuoteOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedRfqQuoteStore allows for batched DB transactions for the quote store.
+type BatchedRfqQuoteStore interface {
+	RfqQuoteStore
+
+	BatchedTx[RfqQuoteStore]
+}
+
+// RfqQuoteDB is used to persist accepted RFQ quotes and the HTLCs accepted
+// against them, so they survive a restart.
+type RfqQuoteDB struct {
+	db BatchedRfqQuoteStore
+}
+
+// A compile-time check to ensure that RfqQuoteDB meets the rfq.QuoteStore
+// interface.
+var _ rfq.QuoteStore = (*RfqQuoteDB)(nil)
+
+// NewRfqQuoteDB creates a new quote DB.
+func NewRfqQuoteDB(db BatchedRfqQuoteStore) *RfqQuoteDB {
+	return &RfqQuoteDB{
+		db: db,
+	}
+}
+
+// InsertQuote stores a new accepted quote. Inserting a quote that already
+// exists is a no-op.
+func (r *RfqQuoteDB) InsertQuote(ctx context.Context,
+	quote rfq.StoredQuote) error {
+
+	if quote.AssetID == nil && quote.AssetGroupKey == nil {
+		return fmt.Errorf("quote must have an asset ID or group key")
+	}
+
+	var assetID, groupKey []byte
+	if quote.AssetID != nil {
+		assetID = fn.CopySlice(quote.AssetID[:])
+	}
+	if quote.AssetGroupKey != nil {
+		groupKey = quote.AssetGroupKey.SerializeCompressed()
+	}
+
+	var baseScid sql.NullInt64
+	quote.BaseScid.WhenSome(func(scid uint64) {
+		baseScid = sqlInt64(scid)
+	})
+
+	var sig []byte
+	if quote.Signature != [64]byte{} {
+		sig = fn.CopySlice(quote.Signature[:])
+	}
+
+	var writeTx RfqQuoteOptions
+	return r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		return db.InsertRfqQuote(ctx, NewRfqQuote{
+			QuoteID:         fn.CopySlice(quote.ID[:]),
+			Scid:            int64(quote.Scid()),
+			Peer:            fn.CopySlice(quote.Peer[:]),
+			QuoteType:       int16(quote.Type),
+			LocallyAccepted: quote.LocallyAccepted,
+			AssetID:         assetID,
+			GroupKey:        groupKey,
+			AssetAmount:     int64(quote.AssetAmount),
+			RequestPrice:    int64(quote.RequestPrice),
+			AcceptedPrice:   int64(quote.AcceptedPrice),
+			RequestVersion:  int16(quote.RequestVersion),
+			AcceptVersion:   int16(quote.AcceptVersion),
+			Expiry: time.Unix(
+				int64(quote.Expiry), 0,
+			).UTC(),
+			BaseScid:  baseScid,
+			CreatedAt: quote.CreatedAt.UTC(),
+			Signature: sig,
+		})
+	})
+}
+
+// fetchQuotes runs the given quote query in a read transaction and parses the
+// returned rows.
+func (r *RfqQuoteDB) fetchQuotes(ctx context.Context,
+	query func(RfqQuoteStore) ([]sqlc.RfqQuote, error)) ([]rfq.StoredQuote,
+	error) {
+
+	var quotes []rfq.StoredQuote
+	readTx := NewRfqQuoteReadTx()
+	dbErr := r.db.ExecTx(ctx, &readTx, func(db RfqQuoteStore) error {
+		dbQuotes, err := query(db)
+		if err != nil {
+			return err
+		}
+
+		quotes = make([]rfq.StoredQuote, 0, len(dbQuotes))
+		for _, dbQuote := range dbQuotes {
+			quote, err := parseRfqQuote(dbQuote)
+			if err != nil {
+				return err
+			}
+
+			quotes = append(quotes, *quote)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return quotes, nil
+}
+
+// ActiveQuotes returns all quotes that haven't expired at the given time,
+// ordered by the time they were accepted.
+func (r *RfqQuoteDB) ActiveQuotes(ctx context.Context,
+	now time.Time) ([]rfq.StoredQuote, error) {
+
+	quotes, err := r.fetchQuotes(
+		ctx, func(db RfqQuoteStore) ([]sqlc.RfqQuote, error) {
+			return db.FetchActiveRfqQuotes(ctx, now.UTC())
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch active quotes: %w", err)
+	}
+
+	return quotes, nil
+}
+
+// ExpiredAliasQuotes returns all quotes that have expired at the given time
+// and for which an SCID alias was added that wasn't yet removed.
+func (r *RfqQuoteDB) ExpiredAliasQuotes(ctx context.Context,
+	now time.Time) ([]rfq.StoredQuote, error) {
+
+	quotes, err := r.fetchQuotes(
+		ctx, func(db RfqQuoteStore) ([]sqlc.RfqQuote, error) {
+			return db.FetchExpiredRfqAliases(ctx, now.UTC())
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch expired quotes: %w",
+			err)
+	}
+
+	return quotes, nil
+}
+
+// MarkAliasDeleted marks the SCID alias of the given quote as removed.
+func (r *RfqQuoteDB) MarkAliasDeleted(ctx context.Context,
+	id rfqmsg.ID) error {
+
+	var writeTx RfqQuoteOptions
+	return r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		return db.MarkRfqAliasDeleted(ctx, fn.CopySlice(id[:]))
+	})
+}
+
+// QueryQuotes returns the quotes that match the given query, ordered from the
+// most recent to the oldest.
+func (r *RfqQuoteDB) QueryQuotes(ctx context.Context,
+	query rfq.QuoteQuery) ([]rfq.StoredQuote, error) {
+
+	sqlQuery := RfqQuoteQuery{
+		CreatedAfter:  time.Unix(0, 0).UTC(),
+		CreatedBefore: MaxValidSQLTime,
+		NumLimit:      defaultQuoteQueryLimit,
+		NumOffset:     query.Offset,
+	}
+	if !query.StartTime.IsZero() {
+		sqlQuery.CreatedAfter = query.StartTime.UTC()
+	}
+	if !query.EndTime.IsZero() {
+		sqlQuery.CreatedBefore = query.EndTime.UTC()
+	}
+	if query.Limit > 0 {
+		sqlQuery.NumLimit = query.Limit
+	}
+	if query.Peer != nil {
+		sqlQuery.Peer = fn.CopySlice(query.Peer[:])
+	}
+	if query.AssetID != nil {
+		sqlQuery.AssetID = fn.CopySlice(query.AssetID[:])
+	}
+	if query.AssetGroupKey != nil {
+		sqlQuery.GroupKey = query.AssetGroupKey.SerializeCompressed()
+	}
+
+	quotes, err := r.fetchQuotes(
+		ctx, func(db RfqQuoteStore) ([]sqlc.RfqQuote, error) {
+			return db.QueryRfqQuotes(ctx, sqlQuery)
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query quotes: %w", err)
+	}
+
+	return quotes, nil
+}
+
+// InsertHtlcFill stores an HTLC that was accepted against a quote. Inserting
+// the same HTLC twice is a no-op.
+func (r *RfqQuoteDB) InsertHtlcFill(ctx context.Context,
+	fill rfq.HtlcFill) error {
+
+	var writeTx RfqQuoteOptions
+	return r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		return db.InsertRfqHtlcFill(ctx, NewRfqHtlcFill{
+			QuoteID: fn.CopySlice(fill.QuoteID[:]),
+			IncomingChanID: int64(
+				fill.IncomingChanID.ToUint64(),
+			),
+			IncomingHtlcID: int64(fill.IncomingHtlcID),
+			OutgoingChanID: int64(
+				fill.OutgoingChanID.ToUint64(),
+			),
+			AmountInMsat:  int64(fill.AmountIn),
+			AmountOutMsat: int64(fill.AmountOut),
+			AcceptedAt:    fill.Timestamp.UTC(),
+		})
+	})
+}
+
+// QueryHtlcFills returns the HTLCs that were accepted against the given quote,
+// ordered by the time they were accepted.
+func (r *RfqQuoteDB) QueryHtlcFills(ctx context.Context,
+	id rfqmsg.ID) ([]rfq.HtlcFill, error) {
+
+	var fills []rfq.HtlcFill
+	readTx := NewRfqQuoteReadTx()
+	dbErr := r.db.ExecTx(ctx, &readTx, func(db RfqQuoteStore) error {
+		dbFills, err := db.FetchRfqHtlcFills(ctx, fn.CopySlice(id[:]))
+		if err != nil {
+			return err
+		}
+
+		fills = make([]rfq.HtlcFill, 0, len(dbFills))
+		for _, dbFill := range dbFills {
+			var quoteID rfqmsg.ID
+			copy(quoteID[:], dbFill.QuoteID)
+
+			fills = append(fills, rfq.HtlcFill{
+				QuoteID: quoteID,
+				IncomingChanID: lnwire.NewShortChanIDFromInt(
+					uint64(dbFill.IncomingChanID),
+				),
+				IncomingHtlcID: uint64(dbFill.IncomingHtlcID),
+				OutgoingChanID: lnwire.NewShortChanIDFromInt(
+					uint64(dbFill.OutgoingChanID),
+				),
+				AmountIn: lnwire.MilliSatoshi(
+					dbFill.AmountInMsat,
+				),
+				AmountOut: lnwire.MilliSatoshi(
+					dbFill.AmountOutMsat,
+				),
+				Timestamp: dbFill.AcceptedAt.UTC(),
+			})
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query HTLC fills: %w", dbErr)
+	}
+
+	return fills, nil
+}
+
+// parseRfqQuote converts a quote database row into a stored quote.
+func parseRfqQuote(dbQuote sqlc.RfqQuote) (*rfq.StoredQuote, error) {
+	quote := &rfq.StoredQuote{
+		Type:            rfq.QuoteType(dbQuote.QuoteType),
+		LocallyAccepted: dbQuote.LocallyAccepted,
+		AssetAmount:     uint64(dbQuote.AssetAmount),
+		RequestPrice:    lnwire.MilliSatoshi(dbQuote.RequestPrice),
+		AcceptedPrice:   lnwire.MilliSatoshi(dbQuote.AcceptedPrice),
+		RequestVersion: rfqmsg.WireMsgDataVersion(
+			dbQuote.RequestVersion,
+		),
+		AcceptVersion: rfqmsg.WireMsgDataVersion(
+			dbQuote.AcceptVersion,
+		),
+		Expiry:    uint64(dbQuote.Expiry.Unix()),
+		CreatedAt: dbQuote.CreatedAt.UTC(),
+	}
+	copy(quote.ID[:], dbQuote.QuoteID)
+	copy(quote.Signature[:], dbQuote.Signature)
+
+	peer, err := route.NewVertexFromBytes(dbQuote.Peer)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse peer: %w", err)
+	}
+	quote.Peer = peer
+
+	if len(dbQuote.AssetID) > 0 {
+		var assetID asset.ID
+		copy(assetID[:], dbQuote.AssetID)
+		quote.AssetID = &assetID
+	}
+
+	if len(dbQuote.GroupKey) > 0 {
+		groupKey, err := btcec.ParsePubKey(dbQuote.GroupKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse group key: %w",
+				err)
+		}
+		quote.AssetGroupKey = groupKey
+	}
+
+	if dbQuote.BaseScid.Valid {
+		quote.BaseScid = fn.Some(uint64(dbQuote.BaseScid.Int64))
+	}
+
+	return quote, nil
+}
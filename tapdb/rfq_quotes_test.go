@@ -0,0 +1,212 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/rfq"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRfqQuoteDB(t *testing.T) *RfqQuoteDB {
+	db := NewTestDB(t)
+
+	dbTxer := NewTransactionExecutor(db,
+		func(tx *sql.Tx) RfqQuoteStore {
+			return db.WithTx(tx)
+		},
+	)
+
+	return NewRfqQuoteDB(dbTxer)
+}
+
+// assertQuotesEqual asserts that the given quotes are equal. Group keys are
+// compared by their serialization, as a parsed key doesn't necessarily have
+// the same internal representation.
+func assertQuotesEqual(t *testing.T, expected, actual []rfq.StoredQuote) {
+	t.Helper()
+
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		e, a := expected[i], actual[i]
+		if e.AssetGroupKey != nil {
+			require.NotNil(t, a.AssetGroupKey)
+			require.Equal(
+				t, e.AssetGroupKey.SerializeCompressed(),
+				a.AssetGroupKey.SerializeCompressed(),
+			)
+		}
+		e.AssetGroupKey, a.AssetGroupKey = nil, nil
+
+		require.Equal(t, e, a)
+	}
+}
+
+// TestRfqQuotes tests that accepted quotes and their HTLC fills can be stored,
+// restored and queried.
+func TestRfqQuotes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	quoteDB := newTestRfqQuoteDB(t)
+
+	var peer route.Vertex
+	copy(peer[:], test.RandPubKey(t).SerializeCompressed())
+
+	assetID := asset.RandID(t)
+	groupKey := test.RandPubKey(t)
+	now := time.Unix(1_700_000_000, 0).UTC()
+
+	newQuote := func(quoteType rfq.QuoteType, local bool,
+		expiry time.Time, baseScid fn.Option[uint64],
+		createdAt time.Time) rfq.StoredQuote {
+
+		var (
+			id  rfqmsg.ID
+			sig [64]byte
+		)
+		copy(id[:], test.RandBytes(32))
+		copy(sig[:], test.RandBytes(64))
+
+		return rfq.StoredQuote{
+			ID:              id,
+			Peer:            peer,
+			Type:            quoteType,
+			LocallyAccepted: local,
+			AssetID:         &assetID,
+			AssetGroupKey:   groupKey,
+			AssetAmount:     uint64(test.RandInt[uint32]()),
+			RequestPrice: lnwire.MilliSatoshi(
+				test.RandInt[uint32](),
+			),
+			AcceptedPrice: lnwire.MilliSatoshi(
+				test.RandInt[uint32](),
+			),
+			Expiry:    uint64(expiry.Unix()),
+			BaseScid:  baseScid,
+			CreatedAt: createdAt,
+			Signature: sig,
+		}
+	}
+
+	// An expired buy quote with an alias, an active buy quote with an
+	// alias and an active sell quote without an alias.
+	expired := newQuote(
+		rfq.QuoteTypeBuy, true, now.Add(-time.Minute),
+		fn.Some[uint64](5), now.Add(-time.Hour),
+	)
+	activeBuy := newQuote(
+		rfq.QuoteTypeBuy, false, now.Add(time.Hour),
+		fn.Some[uint64](7), now.Add(-time.Minute),
+	)
+	activeSell := newQuote(
+		rfq.QuoteTypeSell, true, now.Add(time.Hour), fn.None[uint64](),
+		now,
+	)
+	allQuotes := []rfq.StoredQuote{expired, activeBuy, activeSell}
+	for _, quote := range allQuotes {
+		require.NoError(t, quoteDB.InsertQuote(ctx, quote))
+	}
+
+	// Inserting the same quote twice is a no-op.
+	require.NoError(t, quoteDB.InsertQuote(ctx, activeSell))
+
+	// Quotes accepted before quotes were signed don't have a signature.
+	unsigned := newQuote(
+		rfq.QuoteTypeSell, false, now.Add(-2*time.Hour),
+		fn.None[uint64](), now.Add(-2*time.Hour),
+	)
+	unsigned.Signature = [64]byte{}
+	require.NoError(t, quoteDB.InsertQuote(ctx, unsigned))
+
+	// A quote needs either an asset ID or a group key.
+	invalid := newQuote(
+		rfq.QuoteTypeBuy, true, now, fn.None[uint64](), now,
+	)
+	invalid.AssetID, invalid.AssetGroupKey = nil, nil
+	require.Error(t, quoteDB.InsertQuote(ctx, invalid))
+
+	// Only the quotes that haven't expired yet are restored.
+	active, err := quoteDB.ActiveQuotes(ctx, now)
+	require.NoError(t, err)
+	assertQuotesEqual(t, []rfq.StoredQuote{activeBuy, activeSell}, active)
+
+	// The expired quote's alias still needs to be removed. Once it's
+	// marked as deleted, it's no longer returned.
+	expiredAliases, err := quoteDB.ExpiredAliasQuotes(ctx, now)
+	require.NoError(t, err)
+	assertQuotesEqual(t, []rfq.StoredQuote{expired}, expiredAliases)
+
+	require.NoError(t, quoteDB.MarkAliasDeleted(ctx, expired.ID))
+	expiredAliases, err = quoteDB.ExpiredAliasQuotes(ctx, now)
+	require.NoError(t, err)
+	require.Empty(t, expiredAliases)
+
+	// The history contains all quotes, most recent first.
+	history, err := quoteDB.QueryQuotes(ctx, rfq.QuoteQuery{})
+	require.NoError(t, err)
+	assertQuotesEqual(
+		t, []rfq.StoredQuote{activeSell, activeBuy, expired, unsigned},
+		history,
+	)
+
+	// Limit and offset are applied.
+	history, err = quoteDB.QueryQuotes(ctx, rfq.QuoteQuery{
+		Limit:  1,
+		Offset: 1,
+	})
+	require.NoError(t, err)
+	assertQuotesEqual(t, []rfq.StoredQuote{activeBuy}, history)
+
+	// Filtering by time and by an unknown peer works as well.
+	history, err = quoteDB.QueryQuotes(ctx, rfq.QuoteQuery{
+		StartTime: now.Add(-30 * time.Minute),
+	})
+	require.NoError(t, err)
+	assertQuotesEqual(
+		t, []rfq.StoredQuote{activeSell, activeBuy}, history,
+	)
+
+	var otherPeer route.Vertex
+	copy(otherPeer[:], test.RandPubKey(t).SerializeCompressed())
+	history, err = quoteDB.QueryQuotes(ctx, rfq.QuoteQuery{
+		Peer: &otherPeer,
+	})
+	require.NoError(t, err)
+	require.Empty(t, history)
+
+	// Finally, we record HTLC fills against a quote. Recording the same
+	// HTLC twice only stores it once.
+	fill := rfq.HtlcFill{
+		QuoteID:        activeSell.ID,
+		IncomingChanID: lnwire.NewShortChanIDFromInt(123),
+		IncomingHtlcID: 1,
+		OutgoingChanID: lnwire.NewShortChanIDFromInt(456),
+		AmountIn:       1000,
+		AmountOut:      900,
+		Timestamp:      now,
+	}
+	fill2 := fill
+	fill2.IncomingHtlcID = 2
+	fill2.Timestamp = now.Add(time.Second)
+
+	require.NoError(t, quoteDB.InsertHtlcFill(ctx, fill))
+	require.NoError(t, quoteDB.InsertHtlcFill(ctx, fill))
+	require.NoError(t, quoteDB.InsertHtlcFill(ctx, fill2))
+
+	fills, err := quoteDB.QueryHtlcFills(ctx, activeSell.ID)
+	require.NoError(t, err)
+	require.Equal(t, []rfq.HtlcFill{fill, fill2}, fills)
+
+	fills, err = quoteDB.QueryHtlcFills(ctx, activeBuy.ID)
+	require.NoError(t, err)
+	require.Empty(t, fills)
+}
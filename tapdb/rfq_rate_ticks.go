@@ -0,0 +1,201 @@
+package tapdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfq"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// defaultRateTickQueryLimit is the maximum number of rate ticks that
+	// are returned by a query if no explicit limit is given.
+	defaultRateTickQueryLimit = 1000
+)
+
+type (
+	// NewRfqRateTick is used to insert a new rate tick.
+	NewRfqRateTick = sqlc.InsertRfqRateTickParams
+
+	// RfqRateTickQuery is used to query for past rate ticks.
+	RfqRateTickQuery = sqlc.QueryRfqRateTicksParams
+)
+
+// RfqRateTickStore is the main storage interface for the rate ticks received
+// from the price oracle.
+type RfqRateTickStore interface {
+	// InsertRfqRateTick inserts a new rate tick.
+	InsertRfqRateTick(ctx context.Context, arg NewRfqRateTick) (int64,
+		error)
+
+	// QueryRfqRateTicks queries for rate ticks matching the given filters.
+	QueryRfqRateTicks(ctx context.Context,
+		arg RfqRateTickQuery) ([]sqlc.RfqRateTick, error)
+}
+
+// RfqRateTickOptions is the database tx object for the rate tick store.
+type RfqRateTickOptions struct {
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+func (r *RfqRateTickOptions) ReadOnly() bool {
+	return r.readOnly
+}
+
+// NewRfqRateTickReadTx returns a new read tx for the rate tick store.
+func NewRfqRateTickReadTx() RfqRateTickOptions {
+	return RfqRateTickOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedRfqRateTickStore allows for batched DB transactions for the rate tick
+// store.
+type BatchedRfqRateTickStore interface {
+	RfqRateTickStore
+
+	BatchedTx[RfqRateTickStore]
+}
+
+// RfqRateTickDB is used to persist and query the history of rate ticks
+// received from the price oracle.
+type RfqRateTickDB struct {
+	db BatchedRfqRateTickStore
+}
+
+// A compile-time check to ensure that RfqRateTickDB meets the
+// rfq.RateTickStore interface.
+var _ rfq.RateTickStore = (*RfqRateTickDB)(nil)
+
+// NewRfqRateTickDB creates a new rate tick DB.
+func NewRfqRateTickDB(db BatchedRfqRateTickStore) *RfqRateTickDB {
+	return &RfqRateTickDB{
+		db: db,
+	}
+}
+
+// InsertRateTick stores a new rate tick.
+func (r *RfqRateTickDB) InsertRateTick(ctx context.Context,
+	tick rfq.RateTick) error {
+
+	if tick.AssetID == nil && tick.AssetGroupKey == nil {
+		return fmt.Errorf("rate tick must have an asset ID or group " +
+			"key")
+	}
+
+	var assetID, groupKey []byte
+	if tick.AssetID != nil {
+		assetID = fn.CopySlice(tick.AssetID[:])
+	}
+	if tick.AssetGroupKey != nil {
+		groupKey = tick.AssetGroupKey.SerializeCompressed()
+	}
+
+	var writeTx RfqRateTickOptions
+	return r.db.ExecTx(ctx, &writeTx, func(db RfqRateTickStore) error {
+		_, err := db.InsertRfqRateTick(ctx, NewRfqRateTick{
+			TickType:    int16(tick.Type),
+			AssetID:     assetID,
+			GroupKey:    groupKey,
+			AssetAmount: int64(tick.AssetAmount),
+			Rate:        int64(tick.Rate),
+			Expiry:      tick.Expiry.UTC(),
+			CreatedAt:   tick.Timestamp.UTC(),
+		})
+		return err
+	})
+}
+
+// QueryRateTicks returns the rate ticks that match the given query, ordered
+// from the most recent to the oldest.
+func (r *RfqRateTickDB) QueryRateTicks(ctx context.Context,
+	query rfq.RateTickQuery) ([]rfq.RateTick, error) {
+
+	sqlQuery := RfqRateTickQuery{
+		CreatedAfter:  time.Unix(0, 0).UTC(),
+		CreatedBefore: MaxValidSQLTime,
+		NumLimit:      defaultRateTickQueryLimit,
+	}
+	if !query.StartTime.IsZero() {
+		sqlQuery.CreatedAfter = query.StartTime.UTC()
+	}
+	if !query.EndTime.IsZero() {
+		sqlQuery.CreatedBefore = query.EndTime.UTC()
+	}
+	if query.Limit > 0 {
+		sqlQuery.NumLimit = query.Limit
+	}
+	if query.AssetID != nil {
+		sqlQuery.AssetID = fn.CopySlice(query.AssetID[:])
+	}
+	if query.AssetGroupKey != nil {
+		sqlQuery.GroupKey = query.AssetGroupKey.SerializeCompressed()
+	}
+	query.Type.WhenSome(func(t rfq.RateTickType) {
+		sqlQuery.TickType = sqlInt16(t)
+	})
+	query.AssetAmount.WhenSome(func(amt uint64) {
+		sqlQuery.AssetAmount = sqlInt64(amt)
+	})
+
+	var ticks []rfq.RateTick
+	readTx := NewRfqRateTickReadTx()
+	dbErr := r.db.ExecTx(ctx, &readTx, func(db RfqRateTickStore) error {
+		dbTicks, err := db.QueryRfqRateTicks(ctx, sqlQuery)
+		if err != nil {
+			return err
+		}
+
+		ticks = make([]rfq.RateTick, 0, len(dbTicks))
+		for _, dbTick := range dbTicks {
+			tick, err := parseRateTick(dbTick)
+			if err != nil {
+				return err
+			}
+
+			ticks = append(ticks, *tick)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query rate ticks: %w", dbErr)
+	}
+
+	return ticks, nil
+}
+
+// parseRateTick converts a rate tick database row into a rate tick.
+func parseRateTick(dbTick sqlc.RfqRateTick) (*rfq.RateTick, error) {
+	tick := &rfq.RateTick{
+		Type:        rfq.RateTickType(dbTick.TickType),
+		AssetAmount: uint64(dbTick.AssetAmount),
+		Rate:        lnwire.MilliSatoshi(dbTick.Rate),
+		Expiry:      dbTick.Expiry.UTC(),
+		Timestamp:   dbTick.CreatedAt.UTC(),
+	}
+
+	if len(dbTick.AssetID) > 0 {
+		var assetID asset.ID
+		copy(assetID[:], dbTick.AssetID)
+		tick.AssetID = &assetID
+	}
+
+	if len(dbTick.GroupKey) > 0 {
+		groupKey, err := btcec.ParsePubKey(dbTick.GroupKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse group key: %w",
+				err)
+		}
+		tick.AssetGroupKey = groupKey
+	}
+
+	return tick, nil
+}
@@ -0,0 +1,159 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/rfq"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRfqRateTickDB(t *testing.T) *RfqRateTickDB {
+	db := NewTestDB(t)
+
+	dbTxer := NewTransactionExecutor(db,
+		func(tx *sql.Tx) RfqRateTickStore {
+			return db.WithTx(tx)
+		},
+	)
+
+	return NewRfqRateTickDB(dbTxer)
+}
+
+// assertRateTicksEqual asserts that the given rate ticks are equal. Group keys
+// are compared by their serialization, as a parsed key doesn't necessarily
+// have the same internal representation.
+func assertRateTicksEqual(t *testing.T, expected, actual []rfq.RateTick) {
+	t.Helper()
+
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		e, a := expected[i], actual[i]
+		if e.AssetGroupKey != nil {
+			require.NotNil(t, a.AssetGroupKey)
+			require.Equal(
+				t, e.AssetGroupKey.SerializeCompressed(),
+				a.AssetGroupKey.SerializeCompressed(),
+			)
+		}
+		e.AssetGroupKey, a.AssetGroupKey = nil, nil
+
+		require.Equal(t, e, a)
+	}
+}
+
+// TestRfqRateTicks tests that rate ticks can be stored and queried by asset,
+// type and time.
+func TestRfqRateTicks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tickDB := newTestRfqRateTickDB(t)
+
+	assetID := asset.RandID(t)
+	groupKey := test.RandPubKey(t)
+	baseTime := time.Unix(1_700_000_000, 0).UTC()
+
+	newTick := func(tickType rfq.RateTickType, id *asset.ID,
+		key *btcec.PublicKey, offset time.Duration) rfq.RateTick {
+
+		rate := lnwire.MilliSatoshi(test.RandInt[uint32]())
+		return rfq.RateTick{
+			Type:          tickType,
+			AssetID:       id,
+			AssetGroupKey: key,
+			AssetAmount:   uint64(test.RandInt[uint32]()),
+			Rate:          rate,
+			Expiry:        baseTime.Add(offset + time.Minute),
+			Timestamp:     baseTime.Add(offset),
+		}
+	}
+
+	// A rate tick needs either an asset ID or a group key.
+	err := tickDB.InsertRateTick(
+		ctx, newTick(rfq.RateTickTypeAsk, nil, nil, 0),
+	)
+	require.Error(t, err)
+
+	ticks := []rfq.RateTick{
+		newTick(rfq.RateTickTypeAsk, &assetID, nil, 0),
+		newTick(rfq.RateTickTypeBid, &assetID, nil, time.Second),
+		newTick(rfq.RateTickTypeAsk, &assetID, nil, 2*time.Minute),
+		newTick(rfq.RateTickTypeBid, nil, groupKey, 3*time.Minute),
+	}
+	for _, tick := range ticks {
+		require.NoError(t, tickDB.InsertRateTick(ctx, tick))
+	}
+
+	// Without any filters, all ticks are returned, most recent first.
+	allTicks, err := tickDB.QueryRateTicks(ctx, rfq.RateTickQuery{})
+	require.NoError(t, err)
+	assertRateTicksEqual(t, []rfq.RateTick{
+		ticks[3], ticks[2], ticks[1], ticks[0],
+	}, allTicks)
+
+	// Filter by asset ID and type.
+	askTicks, err := tickDB.QueryRateTicks(ctx, rfq.RateTickQuery{
+		AssetID: &assetID,
+		Type:    fn.Some(rfq.RateTickTypeAsk),
+	})
+	require.NoError(t, err)
+	assertRateTicksEqual(t, []rfq.RateTick{ticks[2], ticks[0]}, askTicks)
+
+	// Filter by group key.
+	groupTicks, err := tickDB.QueryRateTicks(ctx, rfq.RateTickQuery{
+		AssetGroupKey: groupKey,
+	})
+	require.NoError(t, err)
+	assertRateTicksEqual(t, []rfq.RateTick{ticks[3]}, groupTicks)
+
+	// Filter by time range and limit.
+	rangeTicks, err := tickDB.QueryRateTicks(ctx, rfq.RateTickQuery{
+		StartTime: baseTime.Add(time.Second),
+		EndTime:   baseTime.Add(3 * time.Minute),
+		Limit:     2,
+	})
+	require.NoError(t, err)
+	assertRateTicksEqual(
+		t, []rfq.RateTick{ticks[3], ticks[2]}, rangeTicks,
+	)
+
+	// Filter by the asset amount the oracle was queried for.
+	amountTicks, err := tickDB.QueryRateTicks(ctx, rfq.RateTickQuery{
+		AssetAmount: fn.Some(ticks[1].AssetAmount),
+	})
+	require.NoError(t, err)
+	assertRateTicksEqual(t, []rfq.RateTick{ticks[1]}, amountTicks)
+
+	// Finally, we should be able to find the ask tick that was valid at a
+	// given point in time, but not once it expired.
+	tick, err := rfq.RateTickAt(
+		ctx, tickDB, &assetID, nil, rfq.RateTickTypeAsk,
+		fn.None[uint64](), baseTime.Add(30*time.Second),
+	)
+	require.NoError(t, err)
+	assertRateTicksEqual(
+		t, []rfq.RateTick{ticks[0]}, []rfq.RateTick{*tick},
+	)
+
+	_, err = rfq.RateTickAt(
+		ctx, tickDB, &assetID, nil, rfq.RateTickTypeAsk,
+		fn.None[uint64](), baseTime.Add(90*time.Second),
+	)
+	require.Error(t, err)
+
+	// A rate tick the oracle returned for a different amount isn't valid
+	// for a quote of another amount.
+	_, err = rfq.RateTickAt(
+		ctx, tickDB, &assetID, nil, rfq.RateTickTypeAsk,
+		fn.Some(ticks[0].AssetAmount+1), baseTime.Add(30*time.Second),
+	)
+	require.Error(t, err)
+}
@@ -516,6 +516,90 @@ func (q *Queries) DeleteUTXOLease(ctx context.Context, outpoint []byte) error {
 	return err
 }
 
+const fetchAllAssetProofs = `-- name: FetchAllAssetProofs :many
+SELECT assets.spent, asset_proofs.proof_file
+FROM asset_proofs
+JOIN assets
+    ON asset_proofs.asset_id = assets.asset_id
+ORDER BY asset_proofs.proof_id
+`
+
+type FetchAllAssetProofsRow struct {
+	Spent     bool
+	ProofFile []byte
+}
+
+func (q *Queries) FetchAllAssetProofs(ctx context.Context) ([]FetchAllAssetProofsRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchAllAssetProofs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchAllAssetProofsRow
+	for rows.Next() {
+		var i FetchAllAssetProofsRow
+		if err := rows.Scan(&i.Spent, &i.ProofFile); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const fetchAllScriptKeys = `-- name: FetchAllScriptKeys :many
+SELECT tweaked_script_key, tweak, declared_known, raw_key, key_family,
+       key_index
+FROM script_keys
+JOIN internal_keys
+  ON script_keys.internal_key_id = internal_keys.key_id
+ORDER BY script_keys.script_key_id
+`
+
+type FetchAllScriptKeysRow struct {
+	TweakedScriptKey []byte
+	Tweak            []byte
+	DeclaredKnown    sql.NullBool
+	RawKey           []byte
+	KeyFamily        int32
+	KeyIndex         int32
+}
+
+func (q *Queries) FetchAllScriptKeys(ctx context.Context) ([]FetchAllScriptKeysRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchAllScriptKeys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchAllScriptKeysRow
+	for rows.Next() {
+		var i FetchAllScriptKeysRow
+		if err := rows.Scan(
+			&i.TweakedScriptKey,
+			&i.Tweak,
+			&i.DeclaredKnown,
+			&i.RawKey,
+			&i.KeyFamily,
+			&i.KeyIndex,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchAssetID = `-- name: FetchAssetID :many
 SELECT asset_id
     FROM assets
@@ -678,6 +762,64 @@ func (q *Queries) FetchAssetProof(ctx context.Context, arg FetchAssetProofParams
 	return items, nil
 }
 
+const fetchAssetProofHeader = `-- name: FetchAssetProofHeader :many
+WITH asset_info AS (
+    SELECT assets.asset_id
+    FROM assets
+    JOIN script_keys
+        ON assets.script_key_id = script_keys.script_key_id
+    JOIN managed_utxos utxos
+        ON assets.anchor_utxo_id = utxos.utxo_id
+    JOIN genesis_assets
+        ON assets.genesis_id = genesis_assets.gen_asset_id
+   WHERE script_keys.tweaked_script_key = $1
+     AND (utxos.outpoint = $2 OR $2 IS NULL)
+     AND genesis_assets.asset_id = $3
+)
+SELECT substr(asset_proofs.proof_file, 1, $4) AS proof_header
+FROM asset_proofs
+JOIN asset_info
+  ON asset_info.asset_id = asset_proofs.asset_id
+`
+
+type FetchAssetProofHeaderParams struct {
+	TweakedScriptKey []byte
+	Outpoint         []byte
+	GenAssetID       []byte
+	HeaderSize       int32
+}
+
+// Only the first header_size bytes of the proof file are returned, which is
+// enough to find out how many proofs the file contains without loading the
+// whole file.
+func (q *Queries) FetchAssetProofHeader(ctx context.Context, arg FetchAssetProofHeaderParams) ([][]byte, error) {
+	rows, err := q.db.QueryContext(ctx, fetchAssetProofHeader,
+		arg.TweakedScriptKey,
+		arg.Outpoint,
+		arg.GenAssetID,
+		arg.HeaderSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items [][]byte
+	for rows.Next() {
+		var proof_header []byte
+		if err := rows.Scan(&proof_header); err != nil {
+			return nil, err
+		}
+		items = append(items, proof_header)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchAssetProofs = `-- name: FetchAssetProofs :many
 WITH asset_info AS (
     SELECT assets.asset_id, script_keys.tweaked_script_key
@@ -1539,6 +1681,35 @@ func (q *Queries) FetchMintingBatchesByInverseState(ctx context.Context, batchSt
 	return items, nil
 }
 
+const fetchMuSig2ScriptKey = `-- name: FetchMuSig2ScriptKey :one
+SELECT signer_keys, raw_key, key_family, key_index
+FROM musig2_script_keys
+JOIN script_keys
+  ON musig2_script_keys.script_key_id = script_keys.script_key_id
+JOIN internal_keys
+  ON musig2_script_keys.local_key_id = internal_keys.key_id
+WHERE script_keys.tweaked_script_key = $1
+`
+
+type FetchMuSig2ScriptKeyRow struct {
+	SignerKeys []byte
+	RawKey     []byte
+	KeyFamily  int32
+	KeyIndex   int32
+}
+
+func (q *Queries) FetchMuSig2ScriptKey(ctx context.Context, tweakedScriptKey []byte) (FetchMuSig2ScriptKeyRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchMuSig2ScriptKey, tweakedScriptKey)
+	var i FetchMuSig2ScriptKeyRow
+	err := row.Scan(
+		&i.SignerKeys,
+		&i.RawKey,
+		&i.KeyFamily,
+		&i.KeyIndex,
+	)
+	return i, err
+}
+
 const fetchScriptKeyByTweakedKey = `-- name: FetchScriptKeyByTweakedKey :one
 SELECT tweak, raw_key, key_family, key_index, declared_known
 FROM script_keys
@@ -1568,6 +1739,35 @@ func (q *Queries) FetchScriptKeyByTweakedKey(ctx context.Context, tweakedScriptK
 	return i, err
 }
 
+const fetchScriptKeyDescriptor = `-- name: FetchScriptKeyDescriptor :one
+SELECT descriptor, raw_key, key_family, key_index
+FROM script_key_descriptors
+JOIN script_keys
+  ON script_key_descriptors.script_key_id = script_keys.script_key_id
+JOIN internal_keys
+  ON script_key_descriptors.local_key_id = internal_keys.key_id
+WHERE script_keys.tweaked_script_key = $1
+`
+
+type FetchScriptKeyDescriptorRow struct {
+	Descriptor string
+	RawKey     []byte
+	KeyFamily  int32
+	KeyIndex   int32
+}
+
+func (q *Queries) FetchScriptKeyDescriptor(ctx context.Context, tweakedScriptKey []byte) (FetchScriptKeyDescriptorRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchScriptKeyDescriptor, tweakedScriptKey)
+	var i FetchScriptKeyDescriptorRow
+	err := row.Scan(
+		&i.Descriptor,
+		&i.RawKey,
+		&i.KeyFamily,
+		&i.KeyIndex,
+	)
+	return i, err
+}
+
 const fetchScriptKeyIDByTweakedKey = `-- name: FetchScriptKeyIDByTweakedKey :one
 SELECT script_key_id
 FROM script_keys
@@ -2386,6 +2586,23 @@ func (q *Queries) UpdateBatchGenesisTx(ctx context.Context, arg UpdateBatchGenes
 	return err
 }
 
+const updateManagedUTXOOutpoint = `-- name: UpdateManagedUTXOOutpoint :exec
+UPDATE managed_utxos
+SET outpoint = $1, txn_id = $2
+WHERE outpoint = $3
+`
+
+type UpdateManagedUTXOOutpointParams struct {
+	NewOutpoint []byte
+	TxnID       int64
+	OldOutpoint []byte
+}
+
+func (q *Queries) UpdateManagedUTXOOutpoint(ctx context.Context, arg UpdateManagedUTXOOutpointParams) error {
+	_, err := q.db.ExecContext(ctx, updateManagedUTXOOutpoint, arg.NewOutpoint, arg.TxnID, arg.OldOutpoint)
+	return err
+}
+
 const updateMintingBatchState = `-- name: UpdateMintingBatchState :exec
 WITH target_batch AS (
     -- This CTE is used to fetch the ID of a batch, based on the serialized
@@ -2780,6 +2997,28 @@ func (q *Queries) UpsertManagedUTXO(ctx context.Context, arg UpsertManagedUTXOPa
 	return utxo_id, err
 }
 
+const upsertMuSig2ScriptKey = `-- name: UpsertMuSig2ScriptKey :exec
+INSERT INTO musig2_script_keys (
+    script_key_id, local_key_id, signer_keys
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (script_key_id)
+    -- The key aggregation context can't change for the same script key, so
+    -- there's nothing to update.
+    DO NOTHING
+`
+
+type UpsertMuSig2ScriptKeyParams struct {
+	ScriptKeyID int64
+	LocalKeyID  int64
+	SignerKeys  []byte
+}
+
+func (q *Queries) UpsertMuSig2ScriptKey(ctx context.Context, arg UpsertMuSig2ScriptKeyParams) error {
+	_, err := q.db.ExecContext(ctx, upsertMuSig2ScriptKey, arg.ScriptKeyID, arg.LocalKeyID, arg.SignerKeys)
+	return err
+}
+
 const upsertScriptKey = `-- name: UpsertScriptKey :one
 INSERT INTO script_keys (
     internal_key_id, tweaked_script_key, tweak, declared_known
@@ -2811,6 +3050,28 @@ func (q *Queries) UpsertScriptKey(ctx context.Context, arg UpsertScriptKeyParams
 	return script_key_id, err
 }
 
+const upsertScriptKeyDescriptor = `-- name: UpsertScriptKeyDescriptor :exec
+INSERT INTO script_key_descriptors (
+    script_key_id, local_key_id, descriptor
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (script_key_id)
+    -- The descriptor can't change for the same script key, so there's
+    -- nothing to update.
+    DO NOTHING
+`
+
+type UpsertScriptKeyDescriptorParams struct {
+	ScriptKeyID int64
+	LocalKeyID  int64
+	Descriptor  string
+}
+
+func (q *Queries) UpsertScriptKeyDescriptor(ctx context.Context, arg UpsertScriptKeyDescriptorParams) error {
+	_, err := q.db.ExecContext(ctx, upsertScriptKeyDescriptor, arg.ScriptKeyID, arg.LocalKeyID, arg.Descriptor)
+	return err
+}
+
 const upsertTapscriptTreeEdge = `-- name: UpsertTapscriptTreeEdge :one
 INSERT INTO tapscript_edges (
     root_hash_id, node_index, raw_node_id
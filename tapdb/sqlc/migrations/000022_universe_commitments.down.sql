@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS universe_commitments_transfer_root_idx;
+DROP INDEX IF EXISTS universe_commitments_issuance_root_idx;
+DROP TABLE IF EXISTS universe_commitments;
@@ -0,0 +1,47 @@
+-- universe_commitments stores the on chain commitments to the multiverse
+-- roots. Each commitment anchors a branch over the issuance and transfer
+-- multiverse roots in the tapscript tree of a P2TR output.
+CREATE TABLE IF NOT EXISTS universe_commitments (
+    id BIGINT PRIMARY KEY,
+
+    -- chain_txn_id references the confirmed transaction that carries the
+    -- commitment output.
+    chain_txn_id BIGINT NOT NULL UNIQUE REFERENCES chain_txns(txn_id),
+
+    -- output_index is the index of the commitment output within the anchor
+    -- transaction.
+    output_index INTEGER NOT NULL,
+
+    -- internal_key_id references the internal key of the commitment output.
+    internal_key_id BIGINT NOT NULL REFERENCES internal_keys(key_id),
+
+    -- issuance_root_hash is the root hash of the issuance multiverse at the
+    -- time of the commitment.
+    issuance_root_hash BLOB NOT NULL CHECK(length(issuance_root_hash) = 32),
+
+    -- issuance_root_sum is the root sum of the issuance multiverse at the time
+    -- of the commitment.
+    issuance_root_sum BIGINT NOT NULL,
+
+    -- transfer_root_hash is the root hash of the transfer multiverse at the
+    -- time of the commitment.
+    transfer_root_hash BLOB NOT NULL CHECK(length(transfer_root_hash) = 32),
+
+    -- transfer_root_sum is the root sum of the transfer multiverse at the time
+    -- of the commitment.
+    transfer_root_sum BIGINT NOT NULL,
+
+    -- block_header is the serialized header of the block that includes the
+    -- anchor transaction.
+    block_header BLOB NOT NULL,
+
+    -- merkle_proof is the serialized merkle proof of the anchor transaction's
+    -- inclusion in the block.
+    merkle_proof BLOB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS universe_commitments_issuance_root_idx
+    ON universe_commitments(issuance_root_hash);
+
+CREATE INDEX IF NOT EXISTS universe_commitments_transfer_root_idx
+    ON universe_commitments(transfer_root_hash);
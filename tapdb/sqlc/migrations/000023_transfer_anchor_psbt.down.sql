@@ -0,0 +1,2 @@
+ALTER TABLE asset_transfers DROP COLUMN anchor_psbt;
+ALTER TABLE asset_transfers DROP COLUMN change_output_index;
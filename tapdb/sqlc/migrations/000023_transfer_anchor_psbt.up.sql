@@ -0,0 +1,5 @@
+-- The anchor_psbt is the funded, but unsigned, PSBT of the anchor transaction
+-- of a transfer. Together with the index of the BTC level change output, it
+-- allows us to create a replacement transaction that pays a higher fee.
+ALTER TABLE asset_transfers ADD COLUMN anchor_psbt BLOB;
+ALTER TABLE asset_transfers ADD COLUMN change_output_index INTEGER;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS rfq_rate_ticks_group_key_idx;
+DROP INDEX IF EXISTS rfq_rate_ticks_asset_id_idx;
+DROP TABLE IF EXISTS rfq_rate_ticks;
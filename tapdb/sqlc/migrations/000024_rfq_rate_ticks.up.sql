@@ -0,0 +1,38 @@
+-- rfq_rate_ticks stores the rate ticks that were received from the price
+-- oracle. Keeping a local history of rate ticks allows accepted quotes to be
+-- audited after the fact and fills to be recomputed at the quoted rate.
+CREATE TABLE IF NOT EXISTS rfq_rate_ticks (
+    id BIGINT PRIMARY KEY,
+
+    -- tick_type is the type of the rate tick, either an ask (0) or a bid (1)
+    -- price.
+    tick_type SMALLINT NOT NULL CHECK(tick_type IN (0, 1)),
+
+    -- asset_id is the ID of the asset the rate tick is for.
+    asset_id BLOB CHECK(length(asset_id) = 32) NULL,
+
+    -- group_key is the compressed group key of the asset group the rate tick
+    -- is for.
+    group_key BLOB CHECK(LENGTH(group_key) = 33) NULL,
+
+    -- asset_amount is the asset amount the price oracle was queried for.
+    asset_amount BIGINT NOT NULL,
+
+    -- rate is the price in milli-satoshi per asset unit.
+    rate BIGINT NOT NULL,
+
+    -- expiry is the time after which the rate tick is no longer valid.
+    expiry TIMESTAMP NOT NULL,
+
+    -- created_at is the time the rate tick was received from the price oracle.
+    created_at TIMESTAMP NOT NULL,
+
+    -- At least one of the asset ID or group key must be set.
+    CHECK (asset_id IS NOT NULL OR group_key IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS rfq_rate_ticks_asset_id_idx
+    ON rfq_rate_ticks(asset_id, created_at);
+
+CREATE INDEX IF NOT EXISTS rfq_rate_ticks_group_key_idx
+    ON rfq_rate_ticks(group_key, created_at);
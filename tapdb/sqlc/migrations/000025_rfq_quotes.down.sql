@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS rfq_htlc_fills_quote_id_idx;
+DROP TABLE IF EXISTS rfq_htlc_fills;
+DROP INDEX IF EXISTS rfq_quotes_expiry_idx;
+DROP INDEX IF EXISTS rfq_quotes_scid_idx;
+DROP TABLE IF EXISTS rfq_quotes;
@@ -0,0 +1,101 @@
+-- rfq_quotes stores the RFQ quotes that were accepted by our node or by our
+-- peers. Active quotes are reloaded on startup, so HTLCs that are in flight
+-- against them are still accepted after a restart.
+CREATE TABLE IF NOT EXISTS rfq_quotes (
+    id BIGINT PRIMARY KEY,
+
+    -- quote_id is the unique identifier of the quote request.
+    quote_id BLOB NOT NULL UNIQUE CHECK(length(quote_id) = 32),
+
+    -- scid is the SCID alias derived from the quote ID, which is used to
+    -- identify the HTLCs that are paid against the quote.
+    scid BIGINT NOT NULL,
+
+    -- peer is the public key of the quote counterparty.
+    peer BLOB NOT NULL CHECK(length(peer) = 33),
+
+    -- quote_type is the type of the quote, either a buy (0) or a sell (1)
+    -- quote.
+    quote_type SMALLINT NOT NULL CHECK(quote_type IN (0, 1)),
+
+    -- locally_accepted is true if the quote was requested by the peer and
+    -- accepted by our node, and false if it was requested by our node and
+    -- accepted by the peer.
+    locally_accepted BOOLEAN NOT NULL,
+
+    -- asset_id is the ID of the subject asset of the quote.
+    asset_id BLOB CHECK(length(asset_id) = 32) NULL,
+
+    -- group_key is the compressed group key of the subject asset of the
+    -- quote.
+    group_key BLOB CHECK(LENGTH(group_key) = 33) NULL,
+
+    -- asset_amount is the asset amount of the quote request.
+    asset_amount BIGINT NOT NULL,
+
+    -- request_price is the price in milli-satoshi per asset unit that was
+    -- suggested in the quote request.
+    request_price BIGINT NOT NULL,
+
+    -- accepted_price is the price in milli-satoshi per asset unit that was
+    -- accepted.
+    accepted_price BIGINT NOT NULL,
+
+    -- request_version is the wire message data version of the quote request.
+    request_version SMALLINT NOT NULL,
+
+    -- accept_version is the wire message data version of the quote accept.
+    accept_version SMALLINT NOT NULL,
+
+    -- expiry is the time after which the quote is no longer valid.
+    expiry TIMESTAMP NOT NULL,
+
+    -- base_scid is the SCID of the real channel the SCID alias of the quote
+    -- was mapped to, if an alias was added.
+    base_scid BIGINT,
+
+    -- alias_deleted is true once the SCID alias of the expired quote was
+    -- removed again.
+    alias_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+
+    -- created_at is the time the quote was accepted.
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS rfq_quotes_scid_idx ON rfq_quotes(scid);
+
+CREATE INDEX IF NOT EXISTS rfq_quotes_expiry_idx ON rfq_quotes(expiry);
+
+-- rfq_htlc_fills stores the HTLCs that were accepted by the RFQ order handler
+-- against a quote.
+CREATE TABLE IF NOT EXISTS rfq_htlc_fills (
+    id BIGINT PRIMARY KEY,
+
+    -- quote_id references the quote the HTLC was accepted against.
+    quote_id BIGINT NOT NULL REFERENCES rfq_quotes(id),
+
+    -- incoming_chan_id is the SCID of the incoming channel of the HTLC.
+    incoming_chan_id BIGINT NOT NULL,
+
+    -- incoming_htlc_id is the ID of the HTLC on the incoming channel.
+    incoming_htlc_id BIGINT NOT NULL,
+
+    -- outgoing_chan_id is the SCID of the outgoing channel of the HTLC.
+    outgoing_chan_id BIGINT NOT NULL,
+
+    -- amount_in_msat is the incoming amount of the HTLC.
+    amount_in_msat BIGINT NOT NULL,
+
+    -- amount_out_msat is the outgoing amount of the HTLC.
+    amount_out_msat BIGINT NOT NULL,
+
+    -- accepted_at is the time the HTLC was accepted.
+    accepted_at TIMESTAMP NOT NULL,
+
+    -- An HTLC is intercepted again after a restart, so we make sure to only
+    -- record it once.
+    UNIQUE(quote_id, incoming_chan_id, incoming_htlc_id)
+);
+
+CREATE INDEX IF NOT EXISTS rfq_htlc_fills_quote_id_idx
+    ON rfq_htlc_fills(quote_id);
@@ -0,0 +1 @@
+ALTER TABLE rfq_quotes DROP COLUMN signature;
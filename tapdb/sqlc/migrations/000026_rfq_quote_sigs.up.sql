@@ -0,0 +1,3 @@
+-- Add a field to store the signature of the accepting node over the quote.
+-- Quotes that were accepted before quotes were signed have this set to NULL.
+ALTER TABLE rfq_quotes ADD COLUMN signature BLOB CHECK(length(signature) = 64);
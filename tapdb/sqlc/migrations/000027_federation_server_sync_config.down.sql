@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS federation_server_sync_config;
+ALTER TABLE federation_global_sync_config DROP COLUMN sync_quorum;
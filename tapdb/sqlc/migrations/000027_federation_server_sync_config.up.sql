@@ -0,0 +1,27 @@
+-- This field is the combined trust weight of the federation servers that need
+-- to report the same root for a universe of the given proof type before that
+-- root is synced. A quorum of zero means that the root of any single server is
+-- synced.
+ALTER TABLE federation_global_sync_config
+    ADD COLUMN sync_quorum INTEGER NOT NULL DEFAULT 0 CHECK(sync_quorum >= 0);
+
+-- This table contains universe server specific federation sync configuration.
+CREATE TABLE IF NOT EXISTS federation_server_sync_config (
+    -- The host of the universe server to which this configuration is
+    -- applicable. The server doesn't need to be part of the federation yet.
+    server_host TEXT NOT NULL PRIMARY KEY,
+
+    -- This field is a boolean that indicates whether or not proofs should be
+    -- inserted from the given server via federation sync. If false, the server
+    -- is write-only.
+    allow_sync_insert BOOLEAN NOT NULL,
+
+    -- This field is a boolean that indicates whether or not proofs should be
+    -- exported to the given server via federation sync. If false, the server
+    -- is read-only.
+    allow_sync_export BOOLEAN NOT NULL,
+
+    -- The weight the roots reported by the given server carry when
+    -- determining whether the sync quorum for a universe root is reached.
+    trust_weight INTEGER NOT NULL CHECK(trust_weight >= 0)
+);
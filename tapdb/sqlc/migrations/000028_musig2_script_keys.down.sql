@@ -0,0 +1 @@
+DROP TABLE IF EXISTS musig2_script_keys;
@@ -0,0 +1,15 @@
+-- musig2_script_keys stores the key aggregation context of script keys that
+-- are a MuSig2 aggregate of a local key and one or more remote keys. The
+-- internal key of the referenced script key is the aggregated key before the
+-- BIP-0086 tweak is applied.
+CREATE TABLE IF NOT EXISTS musig2_script_keys (
+    script_key_id BIGINT PRIMARY KEY REFERENCES script_keys(script_key_id),
+
+    -- The local key that is part of the aggregated key. This is the key we
+    -- create our partial signatures with.
+    local_key_id BIGINT NOT NULL REFERENCES internal_keys(key_id),
+
+    -- The 33-byte compressed keys of all signers, including the local key,
+    -- concatenated in the order they were aggregated in.
+    signer_keys BLOB NOT NULL CHECK(length(signer_keys) % 33 = 0)
+);
@@ -0,0 +1,14 @@
+-- script_key_descriptors stores the output descriptor of script keys that were
+-- imported from a tr() descriptor. The internal key of the referenced script
+-- key is the internal key of the descriptor, the tweak is the root hash of the
+-- descriptor's tapscript tree.
+CREATE TABLE IF NOT EXISTS script_key_descriptors (
+    script_key_id BIGINT PRIMARY KEY REFERENCES script_keys(script_key_id),
+
+    -- The local key that is used in the descriptor. This is the key we
+    -- create our signatures with when spending through the descriptor.
+    local_key_id BIGINT NOT NULL REFERENCES internal_keys(key_id),
+
+    -- The fully derived descriptor, including its checksum.
+    descriptor TEXT NOT NULL
+);
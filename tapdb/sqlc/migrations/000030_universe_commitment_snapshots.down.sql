@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS universe_commitment_leaves_root_idx;
+DROP INDEX IF EXISTS universe_commitment_leaves_commitment_idx;
+DROP INDEX IF EXISTS universe_commitment_leaves_pending_idx;
+DROP TABLE IF EXISTS universe_commitment_leaves;
+DROP TABLE IF EXISTS universe_pending_commitments;
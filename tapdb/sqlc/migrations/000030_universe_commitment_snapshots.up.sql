@@ -0,0 +1,88 @@
+-- universe_pending_commitments stores universe commitments that were created
+-- and published, but haven't confirmed yet. This allows the confirmation of a
+-- commitment to be picked up again after a restart.
+CREATE TABLE IF NOT EXISTS universe_pending_commitments (
+    id BIGINT PRIMARY KEY,
+
+    -- raw_tx is the serialized and fully signed anchor transaction.
+    raw_tx BLOB NOT NULL,
+
+    -- output_index is the index of the commitment output within the anchor
+    -- transaction.
+    output_index INTEGER NOT NULL,
+
+    -- chain_fees is the amount in sats paid in on-chain fees for the anchor
+    -- transaction.
+    chain_fees BIGINT NOT NULL,
+
+    -- internal_key_id references the internal key of the commitment output.
+    internal_key_id BIGINT NOT NULL REFERENCES internal_keys(key_id),
+
+    -- issuance_root_hash is the root hash of the issuance multiverse that is
+    -- committed to.
+    issuance_root_hash BLOB NOT NULL CHECK(length(issuance_root_hash) = 32),
+
+    -- issuance_root_sum is the root sum of the issuance multiverse that is
+    -- committed to.
+    issuance_root_sum BIGINT NOT NULL,
+
+    -- transfer_root_hash is the root hash of the transfer multiverse that is
+    -- committed to.
+    transfer_root_hash BLOB NOT NULL CHECK(length(transfer_root_hash) = 32),
+
+    -- transfer_root_sum is the root sum of the transfer multiverse that is
+    -- committed to.
+    transfer_root_sum BIGINT NOT NULL,
+
+    -- height_hint is the block height at which the anchor transaction was
+    -- created. The transaction can't confirm before this height.
+    height_hint INTEGER NOT NULL
+);
+
+-- universe_commitment_leaves stores the multiverse leaves that make up the
+-- committed multiverse trees. This allows us to create multiverse inclusion
+-- proofs against a committed root after the multiverse has moved on. A leaf
+-- belongs to either a pending or a confirmed commitment.
+CREATE TABLE IF NOT EXISTS universe_commitment_leaves (
+    id BIGINT PRIMARY KEY,
+
+    pending_commitment_id BIGINT REFERENCES universe_pending_commitments(id),
+
+    commitment_id BIGINT REFERENCES universe_commitments(id),
+
+    proof_type TEXT NOT NULL CHECK(proof_type IN ('issuance', 'transfer')),
+
+    asset_id BLOB CHECK(length(asset_id) = 32),
+
+    -- We use the 32 byte schnorr key here, the same as in the
+    -- multiverse_leaves table.
+    group_key BLOB CHECK(length(group_key) = 32),
+
+    -- universe_root_hash is the value of the multiverse leaf, which is the
+    -- root hash of the universe tree at the time of the commitment.
+    universe_root_hash BLOB NOT NULL CHECK(length(universe_root_hash) = 32),
+
+    -- universe_root_sum is the sum of the multiverse leaf.
+    universe_root_sum BIGINT NOT NULL,
+
+    -- Both the asset ID and group key cannot be null at the same time.
+    CHECK (
+        (asset_id IS NOT NULL AND group_key IS NULL) OR
+        (asset_id IS NULL AND group_key IS NOT NULL)
+    ),
+
+    -- A leaf belongs to exactly one commitment.
+    CHECK (
+        (pending_commitment_id IS NOT NULL AND commitment_id IS NULL) OR
+        (pending_commitment_id IS NULL AND commitment_id IS NOT NULL)
+    )
+);
+
+CREATE INDEX IF NOT EXISTS universe_commitment_leaves_pending_idx
+    ON universe_commitment_leaves(pending_commitment_id);
+
+CREATE INDEX IF NOT EXISTS universe_commitment_leaves_commitment_idx
+    ON universe_commitment_leaves(commitment_id, proof_type);
+
+CREATE INDEX IF NOT EXISTS universe_commitment_leaves_root_idx
+    ON universe_commitment_leaves(universe_root_hash);
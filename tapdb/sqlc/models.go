@@ -103,10 +103,12 @@ type AssetSeedling struct {
 }
 
 type AssetTransfer struct {
-	ID               int64
-	HeightHint       int32
-	AnchorTxnID      int64
-	TransferTimeUnix time.Time
+	ID                int64
+	HeightHint        int32
+	AnchorTxnID       int64
+	TransferTimeUnix  time.Time
+	AnchorPsbt        []byte
+	ChangeOutputIndex sql.NullInt32
 }
 
 type AssetTransferInput struct {
@@ -169,6 +171,7 @@ type FederationGlobalSyncConfig struct {
 	ProofType       string
 	AllowSyncInsert bool
 	AllowSyncExport bool
+	SyncQuorum      int32
 }
 
 type FederationProofSyncLog struct {
@@ -182,6 +185,13 @@ type FederationProofSyncLog struct {
 	ServersID      int64
 }
 
+type FederationServerSyncConfig struct {
+	ServerHost      string
+	AllowSyncInsert bool
+	AllowSyncExport bool
+	TrustWeight     int32
+}
+
 type FederationUniSyncConfig struct {
 	Namespace       string
 	AssetID         []byte
@@ -287,6 +297,12 @@ type MultiverseRoot struct {
 	ProofType     string
 }
 
+type Musig2ScriptKey struct {
+	ScriptKeyID int64
+	LocalKeyID  int64
+	SignerKeys  []byte
+}
+
 type PassiveAsset struct {
 	PassiveID       int64
 	TransferID      int64
@@ -304,6 +320,49 @@ type ProofTransferLog struct {
 	TimeUnix         time.Time
 }
 
+type RfqHtlcFill struct {
+	ID             int64
+	QuoteID        int64
+	IncomingChanID int64
+	IncomingHtlcID int64
+	OutgoingChanID int64
+	AmountInMsat   int64
+	AmountOutMsat  int64
+	AcceptedAt     time.Time
+}
+
+type RfqQuote struct {
+	ID              int64
+	QuoteID         []byte
+	Scid            int64
+	Peer            []byte
+	QuoteType       int16
+	LocallyAccepted bool
+	AssetID         []byte
+	GroupKey        []byte
+	AssetAmount     int64
+	RequestPrice    int64
+	AcceptedPrice   int64
+	RequestVersion  int16
+	AcceptVersion   int16
+	Expiry          time.Time
+	BaseScid        sql.NullInt64
+	AliasDeleted    bool
+	CreatedAt       time.Time
+	Signature       []byte
+}
+
+type RfqRateTick struct {
+	ID          int64
+	TickType    int16
+	AssetID     []byte
+	GroupKey    []byte
+	AssetAmount int64
+	Rate        int64
+	Expiry      time.Time
+	CreatedAt   time.Time
+}
+
 type ScriptKey struct {
 	ScriptKeyID      int64
 	InternalKeyID    int64
@@ -312,6 +371,12 @@ type ScriptKey struct {
 	DeclaredKnown    sql.NullBool
 }
 
+type ScriptKeyDescriptor struct {
+	ScriptKeyID int64
+	LocalKeyID  int64
+	Descriptor  string
+}
+
 type TapscriptEdge struct {
 	EdgeID     int64
 	RootHashID int64
@@ -330,6 +395,30 @@ type TapscriptRoot struct {
 	BranchOnly bool
 }
 
+type UniverseCommitment struct {
+	ID               int64
+	ChainTxnID       int64
+	OutputIndex      int32
+	InternalKeyID    int64
+	IssuanceRootHash []byte
+	IssuanceRootSum  int64
+	TransferRootHash []byte
+	TransferRootSum  int64
+	BlockHeader      []byte
+	MerkleProof      []byte
+}
+
+type UniverseCommitmentLeafe struct {
+	ID                  int64
+	PendingCommitmentID sql.NullInt64
+	CommitmentID        sql.NullInt64
+	ProofType           string
+	AssetID             []byte
+	GroupKey            []byte
+	UniverseRootHash    []byte
+	UniverseRootSum     int64
+}
+
 type UniverseEvent struct {
 	EventID        int64
 	EventType      string
@@ -348,6 +437,19 @@ type UniverseLeafe struct {
 	LeafNodeNamespace string
 }
 
+type UniversePendingCommitment struct {
+	ID               int64
+	RawTx            []byte
+	OutputIndex      int32
+	ChainFees        int64
+	InternalKeyID    int64
+	IssuanceRootHash []byte
+	IssuanceRootSum  int64
+	TransferRootHash []byte
+	TransferRootSum  int64
+	HeightHint       int32
+}
+
 type UniverseRoot struct {
 	ID            int64
 	NamespaceRoot string
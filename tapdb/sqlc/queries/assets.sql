@@ -724,6 +724,13 @@ FROM asset_proofs
 JOIN asset_info
     ON asset_info.asset_id = asset_proofs.asset_id;
 
+-- name: FetchAllAssetProofs :many
+SELECT assets.spent, asset_proofs.proof_file
+FROM asset_proofs
+JOIN assets
+    ON asset_proofs.asset_id = assets.asset_id
+ORDER BY asset_proofs.proof_id;
+
 -- name: FetchAssetProof :many
 WITH asset_info AS (
     SELECT assets.asset_id, script_keys.tweaked_script_key, utxos.outpoint
@@ -742,6 +749,28 @@ FROM asset_proofs
 JOIN asset_info
   ON asset_info.asset_id = asset_proofs.asset_id;
 
+-- name: FetchAssetProofHeader :many
+-- Only the first header_size bytes of the proof file are returned, which is
+-- enough to find out how many proofs the file contains without loading the
+-- whole file.
+WITH asset_info AS (
+    SELECT assets.asset_id
+    FROM assets
+    JOIN script_keys
+        ON assets.script_key_id = script_keys.script_key_id
+    JOIN managed_utxos utxos
+        ON assets.anchor_utxo_id = utxos.utxo_id
+    JOIN genesis_assets
+        ON assets.genesis_id = genesis_assets.gen_asset_id
+   WHERE script_keys.tweaked_script_key = $1
+     AND (utxos.outpoint = sqlc.narg('outpoint') OR sqlc.narg('outpoint') IS NULL)
+     AND genesis_assets.asset_id = @gen_asset_id
+)
+SELECT substr(asset_proofs.proof_file, 1, @header_size) AS proof_header
+FROM asset_proofs
+JOIN asset_info
+  ON asset_info.asset_id = asset_proofs.asset_id;
+
 -- name: HasAssetProof :one
 WITH asset_info AS (
     SELECT assets.asset_id
@@ -790,6 +819,11 @@ UPDATE managed_utxos
 SET lease_owner = @lease_owner, lease_expiry = @lease_expiry
 WHERE outpoint = @outpoint;
 
+-- name: UpdateManagedUTXOOutpoint :exec
+UPDATE managed_utxos
+SET outpoint = @new_outpoint, txn_id = @txn_id
+WHERE outpoint = @old_outpoint;
+
 -- name: DeleteUTXOLease :exec
 UPDATE managed_utxos
 SET lease_owner = NULL, lease_expiry = NULL
@@ -830,6 +864,52 @@ JOIN internal_keys
   ON script_keys.internal_key_id = internal_keys.key_id
 WHERE script_keys.tweaked_script_key = $1;
 
+-- name: UpsertMuSig2ScriptKey :exec
+INSERT INTO musig2_script_keys (
+    script_key_id, local_key_id, signer_keys
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (script_key_id)
+    -- The key aggregation context can't change for the same script key, so
+    -- there's nothing to update.
+    DO NOTHING;
+
+-- name: FetchMuSig2ScriptKey :one
+SELECT signer_keys, raw_key, key_family, key_index
+FROM musig2_script_keys
+JOIN script_keys
+  ON musig2_script_keys.script_key_id = script_keys.script_key_id
+JOIN internal_keys
+  ON musig2_script_keys.local_key_id = internal_keys.key_id
+WHERE script_keys.tweaked_script_key = $1;
+
+-- name: UpsertScriptKeyDescriptor :exec
+INSERT INTO script_key_descriptors (
+    script_key_id, local_key_id, descriptor
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (script_key_id)
+    -- The descriptor can't change for the same script key, so there's
+    -- nothing to update.
+    DO NOTHING;
+
+-- name: FetchScriptKeyDescriptor :one
+SELECT descriptor, raw_key, key_family, key_index
+FROM script_key_descriptors
+JOIN script_keys
+  ON script_key_descriptors.script_key_id = script_keys.script_key_id
+JOIN internal_keys
+  ON script_key_descriptors.local_key_id = internal_keys.key_id
+WHERE script_keys.tweaked_script_key = $1;
+
+-- name: FetchAllScriptKeys :many
+SELECT tweaked_script_key, tweak, declared_known, raw_key, key_family,
+       key_index
+FROM script_keys
+JOIN internal_keys
+  ON script_keys.internal_key_id = internal_keys.key_id
+ORDER BY script_keys.script_key_id;
+
 -- name: FetchInternalKeyLocator :one
 SELECT key_family, key_index
 FROM internal_keys
@@ -0,0 +1,88 @@
+-- name: InsertRfqRateTick :one
+INSERT INTO rfq_rate_ticks (
+    tick_type, asset_id, group_key, asset_amount, rate, expiry, created_at
+) VALUES (
+    @tick_type, @asset_id, @group_key, @asset_amount, @rate, @expiry,
+    @created_at
+)
+RETURNING id;
+
+-- name: QueryRfqRateTicks :many
+SELECT id, tick_type, asset_id, group_key, asset_amount, rate, expiry,
+       created_at
+FROM rfq_rate_ticks
+WHERE created_at >= @created_after
+    AND created_at <= @created_before
+    AND (asset_id = sqlc.narg('asset_id') OR sqlc.narg('asset_id') IS NULL)
+    AND (group_key = sqlc.narg('group_key') OR
+         sqlc.narg('group_key') IS NULL)
+    AND (tick_type = sqlc.narg('tick_type') OR
+         sqlc.narg('tick_type') IS NULL)
+    AND (asset_amount = sqlc.narg('asset_amount') OR
+         sqlc.narg('asset_amount') IS NULL)
+ORDER BY created_at DESC, id DESC
+LIMIT @num_limit;
+
+-- name: InsertRfqQuote :exec
+INSERT INTO rfq_quotes (
+    quote_id, scid, peer, quote_type, locally_accepted, asset_id, group_key,
+    asset_amount, request_price, accepted_price, request_version,
+    accept_version, expiry, base_scid, created_at, signature
+) VALUES (
+    @quote_id, @scid, @peer, @quote_type, @locally_accepted, @asset_id,
+    @group_key, @asset_amount, @request_price, @accepted_price,
+    @request_version, @accept_version, @expiry, @base_scid, @created_at,
+    @signature
+)
+ON CONFLICT (quote_id) DO NOTHING;
+
+-- name: FetchActiveRfqQuotes :many
+SELECT *
+FROM rfq_quotes
+WHERE expiry > @now
+ORDER BY created_at, id;
+
+-- name: FetchExpiredRfqAliases :many
+SELECT *
+FROM rfq_quotes
+WHERE expiry <= @now
+    AND base_scid IS NOT NULL
+    AND alias_deleted = FALSE;
+
+-- name: MarkRfqAliasDeleted :exec
+UPDATE rfq_quotes
+SET alias_deleted = TRUE
+WHERE quote_id = @quote_id;
+
+-- name: QueryRfqQuotes :many
+SELECT *
+FROM rfq_quotes
+WHERE created_at >= @created_after
+    AND created_at <= @created_before
+    AND (peer = sqlc.narg('peer') OR sqlc.narg('peer') IS NULL)
+    AND (asset_id = sqlc.narg('asset_id') OR sqlc.narg('asset_id') IS NULL)
+    AND (group_key = sqlc.narg('group_key') OR
+         sqlc.narg('group_key') IS NULL)
+ORDER BY created_at DESC, id DESC
+LIMIT @num_limit OFFSET @num_offset;
+
+-- name: InsertRfqHtlcFill :exec
+INSERT INTO rfq_htlc_fills (
+    quote_id, incoming_chan_id, incoming_htlc_id, outgoing_chan_id,
+    amount_in_msat, amount_out_msat, accepted_at
+) VALUES (
+    (SELECT id FROM rfq_quotes WHERE rfq_quotes.quote_id = @quote_id),
+    @incoming_chan_id, @incoming_htlc_id, @outgoing_chan_id,
+    @amount_in_msat, @amount_out_msat, @accepted_at
+)
+ON CONFLICT (quote_id, incoming_chan_id, incoming_htlc_id) DO NOTHING;
+
+-- name: FetchRfqHtlcFills :many
+SELECT fills.incoming_chan_id, fills.incoming_htlc_id, fills.outgoing_chan_id,
+       fills.amount_in_msat, fills.amount_out_msat, fills.accepted_at,
+       quotes.quote_id AS quote_id
+FROM rfq_htlc_fills fills
+JOIN rfq_quotes quotes
+    ON fills.quote_id = quotes.id
+WHERE quotes.quote_id = @quote_id
+ORDER BY fills.accepted_at, fills.id;
@@ -5,11 +5,19 @@ WITH target_txn(txn_id) AS (
     WHERE txid = @anchor_txid
 )
 INSERT INTO asset_transfers (
-    height_hint, anchor_txn_id, transfer_time_unix
+    height_hint, anchor_txn_id, transfer_time_unix, anchor_psbt,
+    change_output_index
 ) VALUES (
-    @height_hint, (SELECT txn_id FROM target_txn), @transfer_time_unix
+    @height_hint, (SELECT txn_id FROM target_txn), @transfer_time_unix,
+    @anchor_psbt, @change_output_index
 ) RETURNING id;
 
+-- name: UpdateAssetTransferAnchorTx :exec
+UPDATE asset_transfers
+SET anchor_txn_id = @anchor_txn_id, anchor_psbt = @anchor_psbt,
+    change_output_index = @change_output_index
+WHERE id = @transfer_id;
+
 -- name: InsertAssetTransferInput :exec
 INSERT INTO asset_transfer_inputs (
     transfer_id, anchor_point, asset_id, script_key, amount
@@ -28,9 +36,15 @@ INSERT INTO asset_transfer_outputs (
     $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
 );
 
+-- name: UpdateTransferOutputProofSuffix :exec
+UPDATE asset_transfer_outputs
+SET proof_suffix = @proof_suffix
+WHERE output_id = @output_id;
+
 -- name: QueryAssetTransfers :many
 SELECT
-    id, height_hint, txns.txid, transfer_time_unix
+    id, height_hint, txns.txid, transfer_time_unix, anchor_psbt,
+    change_output_index
 FROM asset_transfers transfers
 JOIN chain_txns txns
     ON transfers.anchor_txn_id = txns.txn_id
@@ -170,3 +184,8 @@ FROM passive_assets as passive
     JOIN managed_utxos utxos
         ON passive.new_anchor_utxo = utxos.utxo_id
 WHERE passive.transfer_id = @transfer_id;
+
+-- name: UpdatePassiveAssetProof :exec
+UPDATE passive_assets
+SET new_proof = @new_proof
+WHERE transfer_id = @transfer_id AND asset_id = @asset_id;
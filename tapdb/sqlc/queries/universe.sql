@@ -79,6 +79,12 @@ ORDER BY
     CASE WHEN sqlc.narg('sort_direction') = 1 THEN leaves.id END DESC
 LIMIT @num_limit OFFSET @num_offset;
 
+-- name: FetchUniverseLeafKey :one
+SELECT leaves.minting_point, leaves.script_key_bytes
+FROM universe_leaves leaves
+WHERE leaves.leaf_node_namespace = @namespace AND
+    leaves.leaf_node_key = @leaf_node_key;
+
 -- name: UniverseLeaves :many
 SELECT * FROM universe_leaves;
 
@@ -336,16 +342,17 @@ ORDER BY day;
 
 -- name: UpsertFederationGlobalSyncConfig :exec
 INSERT INTO federation_global_sync_config (
-    proof_type, allow_sync_insert, allow_sync_export
+    proof_type, allow_sync_insert, allow_sync_export, sync_quorum
 )
-VALUES (@proof_type, @allow_sync_insert, @allow_sync_export)
+VALUES (@proof_type, @allow_sync_insert, @allow_sync_export, @sync_quorum)
 ON CONFLICT(proof_type)
     DO UPDATE SET
     allow_sync_insert = @allow_sync_insert,
-    allow_sync_export = @allow_sync_export;
+    allow_sync_export = @allow_sync_export,
+    sync_quorum = @sync_quorum;
 
 -- name: QueryFederationGlobalSyncConfigs :many
-SELECT proof_type, allow_sync_insert, allow_sync_export
+SELECT proof_type, allow_sync_insert, allow_sync_export, sync_quorum
 FROM federation_global_sync_config
 ORDER BY proof_type;
 
@@ -366,6 +373,24 @@ SELECT namespace, asset_id, group_key, proof_type, allow_sync_insert, allow_sync
 FROM federation_uni_sync_config
 ORDER BY group_key NULLS LAST, asset_id NULLS LAST, proof_type;
 
+-- name: UpsertFederationServerSyncConfig :exec
+INSERT INTO federation_server_sync_config (
+    server_host, allow_sync_insert, allow_sync_export, trust_weight
+)
+VALUES (
+    @server_host, @allow_sync_insert, @allow_sync_export, @trust_weight
+)
+ON CONFLICT(server_host)
+    DO UPDATE SET
+    allow_sync_insert = @allow_sync_insert,
+    allow_sync_export = @allow_sync_export,
+    trust_weight = @trust_weight;
+
+-- name: QueryFederationServerSyncConfigs :many
+SELECT server_host, allow_sync_insert, allow_sync_export, trust_weight
+FROM federation_server_sync_config
+ORDER BY server_host;
+
 -- name: UpsertFederationProofSyncLog :one
 INSERT INTO federation_proof_sync_log as log (
     status, timestamp, sync_direction, proof_leaf_id, universe_root_id,
@@ -529,3 +554,116 @@ JOIN multiverse_roots r
 WHERE r.proof_type = @proof_type AND
       (l.asset_id = @asset_id OR @asset_id IS NULL) AND
       (l.group_key = @group_key OR @group_key IS NULL);
+
+-- name: InsertUniverseCommitment :one
+INSERT INTO universe_commitments (
+    chain_txn_id, output_index, internal_key_id, issuance_root_hash,
+    issuance_root_sum, transfer_root_hash, transfer_root_sum, block_header,
+    merkle_proof
+) VALUES (
+    @chain_txn_id, @output_index, @internal_key_id, @issuance_root_hash,
+    @issuance_root_sum, @transfer_root_hash, @transfer_root_sum, @block_header,
+    @merkle_proof
+)
+RETURNING id;
+
+-- name: FetchLatestUniverseCommitment :one
+SELECT commitments.id, commitments.output_index, commitments.issuance_root_hash,
+       commitments.issuance_root_sum, commitments.transfer_root_hash,
+       commitments.transfer_root_sum, commitments.block_header,
+       commitments.merkle_proof, txns.raw_tx, txns.block_height, keys.raw_key,
+       keys.key_family, keys.key_index
+FROM universe_commitments commitments
+JOIN chain_txns txns
+    ON commitments.chain_txn_id = txns.txn_id
+JOIN internal_keys keys
+    ON commitments.internal_key_id = keys.key_id
+ORDER BY txns.block_height DESC, commitments.id DESC
+LIMIT 1;
+
+-- name: FetchUniverseCommitmentByRoot :one
+SELECT commitments.id, commitments.output_index, commitments.issuance_root_hash,
+       commitments.issuance_root_sum, commitments.transfer_root_hash,
+       commitments.transfer_root_sum, commitments.block_header,
+       commitments.merkle_proof, txns.raw_tx, txns.block_height, keys.raw_key,
+       keys.key_family, keys.key_index
+FROM universe_commitments commitments
+JOIN chain_txns txns
+    ON commitments.chain_txn_id = txns.txn_id
+JOIN internal_keys keys
+    ON commitments.internal_key_id = keys.key_id
+WHERE (commitments.issuance_root_hash = @root_hash AND
+       commitments.issuance_root_sum = @root_sum) OR
+      (commitments.transfer_root_hash = @root_hash AND
+       commitments.transfer_root_sum = @root_sum)
+ORDER BY txns.block_height ASC, commitments.id ASC
+LIMIT 1;
+
+-- name: FetchUniverseCommitmentByLeaf :one
+SELECT commitments.id, commitments.output_index, commitments.issuance_root_hash,
+       commitments.issuance_root_sum, commitments.transfer_root_hash,
+       commitments.transfer_root_sum, commitments.block_header,
+       commitments.merkle_proof, txns.raw_tx, txns.block_height, keys.raw_key,
+       keys.key_family, keys.key_index
+FROM universe_commitment_leaves leaves
+JOIN universe_commitments commitments
+    ON leaves.commitment_id = commitments.id
+JOIN chain_txns txns
+    ON commitments.chain_txn_id = txns.txn_id
+JOIN internal_keys keys
+    ON commitments.internal_key_id = keys.key_id
+WHERE leaves.proof_type = @proof_type AND
+      (leaves.asset_id = @asset_id OR @asset_id IS NULL) AND
+      (leaves.group_key = @group_key OR @group_key IS NULL) AND
+      leaves.universe_root_hash = @universe_root_hash AND
+      leaves.universe_root_sum = @universe_root_sum
+ORDER BY txns.block_height DESC, commitments.id DESC
+LIMIT 1;
+
+-- name: InsertPendingUniverseCommitment :one
+INSERT INTO universe_pending_commitments (
+    raw_tx, output_index, chain_fees, internal_key_id, issuance_root_hash,
+    issuance_root_sum, transfer_root_hash, transfer_root_sum, height_hint
+) VALUES (
+    @raw_tx, @output_index, @chain_fees, @internal_key_id, @issuance_root_hash,
+    @issuance_root_sum, @transfer_root_hash, @transfer_root_sum, @height_hint
+)
+RETURNING id;
+
+-- name: FetchPendingUniverseCommitment :one
+SELECT pending.id, pending.raw_tx, pending.output_index, pending.chain_fees,
+       pending.issuance_root_hash, pending.issuance_root_sum,
+       pending.transfer_root_hash, pending.transfer_root_sum,
+       pending.height_hint, keys.raw_key, keys.key_family, keys.key_index
+FROM universe_pending_commitments pending
+JOIN internal_keys keys
+    ON pending.internal_key_id = keys.key_id
+ORDER BY pending.id DESC
+LIMIT 1;
+
+-- name: DeletePendingUniverseCommitment :exec
+DELETE FROM universe_pending_commitments
+WHERE id = @id;
+
+-- name: InsertUniverseCommitmentLeaf :exec
+INSERT INTO universe_commitment_leaves (
+    pending_commitment_id, proof_type, asset_id, group_key,
+    universe_root_hash, universe_root_sum
+) VALUES (
+    @pending_commitment_id, @proof_type, @asset_id, @group_key,
+    @universe_root_hash, @universe_root_sum
+);
+
+-- name: DeletePendingUniverseCommitmentLeaves :exec
+DELETE FROM universe_commitment_leaves
+WHERE pending_commitment_id = @pending_commitment_id;
+
+-- name: ConfirmUniverseCommitmentLeaves :exec
+UPDATE universe_commitment_leaves
+SET commitment_id = @commitment_id, pending_commitment_id = NULL
+WHERE pending_commitment_id = @pending_commitment_id;
+
+-- name: FetchUniverseCommitmentLeaves :many
+SELECT asset_id, group_key, universe_root_hash, universe_root_sum
+FROM universe_commitment_leaves
+WHERE commitment_id = @commitment_id AND proof_type = @proof_type;
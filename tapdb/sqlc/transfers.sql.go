@@ -248,23 +248,33 @@ const insertAssetTransfer = `-- name: InsertAssetTransfer :one
 WITH target_txn(txn_id) AS (
     SELECT txn_id
     FROM chain_txns
-    WHERE txid = $3
+    WHERE txid = $5
 )
 INSERT INTO asset_transfers (
-    height_hint, anchor_txn_id, transfer_time_unix
+    height_hint, anchor_txn_id, transfer_time_unix, anchor_psbt,
+    change_output_index
 ) VALUES (
-    $1, (SELECT txn_id FROM target_txn), $2
+    $1, (SELECT txn_id FROM target_txn), $2,
+    $3, $4
 ) RETURNING id
 `
 
 type InsertAssetTransferParams struct {
-	HeightHint       int32
-	TransferTimeUnix time.Time
-	AnchorTxid       []byte
+	HeightHint        int32
+	TransferTimeUnix  time.Time
+	AnchorPsbt        []byte
+	ChangeOutputIndex sql.NullInt32
+	AnchorTxid        []byte
 }
 
 func (q *Queries) InsertAssetTransfer(ctx context.Context, arg InsertAssetTransferParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, insertAssetTransfer, arg.HeightHint, arg.TransferTimeUnix, arg.AnchorTxid)
+	row := q.db.QueryRowContext(ctx, insertAssetTransfer,
+		arg.HeightHint,
+		arg.TransferTimeUnix,
+		arg.AnchorPsbt,
+		arg.ChangeOutputIndex,
+		arg.AnchorTxid,
+	)
 	var id int64
 	err := row.Scan(&id)
 	return id, err
@@ -417,7 +427,8 @@ func (q *Queries) LogProofTransferAttempt(ctx context.Context, arg LogProofTrans
 
 const queryAssetTransfers = `-- name: QueryAssetTransfers :many
 SELECT
-    id, height_hint, txns.txid, transfer_time_unix
+    id, height_hint, txns.txid, transfer_time_unix, anchor_psbt,
+    change_output_index
 FROM asset_transfers transfers
 JOIN chain_txns txns
     ON transfers.anchor_txn_id = txns.txn_id
@@ -435,10 +446,12 @@ type QueryAssetTransfersParams struct {
 }
 
 type QueryAssetTransfersRow struct {
-	ID               int64
-	HeightHint       int32
-	Txid             []byte
-	TransferTimeUnix time.Time
+	ID                int64
+	HeightHint        int32
+	Txid              []byte
+	TransferTimeUnix  time.Time
+	AnchorPsbt        []byte
+	ChangeOutputIndex sql.NullInt32
 }
 
 // We'll use this clause to filter out for only transfers that are
@@ -459,6 +472,8 @@ func (q *Queries) QueryAssetTransfers(ctx context.Context, arg QueryAssetTransfe
 			&i.HeightHint,
 			&i.Txid,
 			&i.TransferTimeUnix,
+			&i.AnchorPsbt,
+			&i.ChangeOutputIndex,
 		); err != nil {
 			return nil, err
 		}
@@ -584,3 +599,60 @@ func (q *Queries) ReAnchorPassiveAssets(ctx context.Context, arg ReAnchorPassive
 	_, err := q.db.ExecContext(ctx, reAnchorPassiveAssets, arg.NewAnchorUtxoID, arg.AssetID)
 	return err
 }
+
+const updateAssetTransferAnchorTx = `-- name: UpdateAssetTransferAnchorTx :exec
+UPDATE asset_transfers
+SET anchor_txn_id = $1, anchor_psbt = $2,
+    change_output_index = $3
+WHERE id = $4
+`
+
+type UpdateAssetTransferAnchorTxParams struct {
+	AnchorTxnID       int64
+	AnchorPsbt        []byte
+	ChangeOutputIndex sql.NullInt32
+	TransferID        int64
+}
+
+func (q *Queries) UpdateAssetTransferAnchorTx(ctx context.Context, arg UpdateAssetTransferAnchorTxParams) error {
+	_, err := q.db.ExecContext(ctx, updateAssetTransferAnchorTx,
+		arg.AnchorTxnID,
+		arg.AnchorPsbt,
+		arg.ChangeOutputIndex,
+		arg.TransferID,
+	)
+	return err
+}
+
+const updatePassiveAssetProof = `-- name: UpdatePassiveAssetProof :exec
+UPDATE passive_assets
+SET new_proof = $1
+WHERE transfer_id = $2 AND asset_id = $3
+`
+
+type UpdatePassiveAssetProofParams struct {
+	NewProof   []byte
+	TransferID int64
+	AssetID    int64
+}
+
+func (q *Queries) UpdatePassiveAssetProof(ctx context.Context, arg UpdatePassiveAssetProofParams) error {
+	_, err := q.db.ExecContext(ctx, updatePassiveAssetProof, arg.NewProof, arg.TransferID, arg.AssetID)
+	return err
+}
+
+const updateTransferOutputProofSuffix = `-- name: UpdateTransferOutputProofSuffix :exec
+UPDATE asset_transfer_outputs
+SET proof_suffix = $1
+WHERE output_id = $2
+`
+
+type UpdateTransferOutputProofSuffixParams struct {
+	ProofSuffix []byte
+	OutputID    int64
+}
+
+func (q *Queries) UpdateTransferOutputProofSuffix(ctx context.Context, arg UpdateTransferOutputProofSuffixParams) error {
+	_, err := q.db.ExecContext(ctx, updateTransferOutputProofSuffix, arg.ProofSuffix, arg.OutputID)
+	return err
+}
@@ -11,6 +11,22 @@ import (
 	"time"
 )
 
+const confirmUniverseCommitmentLeaves = `-- name: ConfirmUniverseCommitmentLeaves :exec
+UPDATE universe_commitment_leaves
+SET commitment_id = $1, pending_commitment_id = NULL
+WHERE pending_commitment_id = $2
+`
+
+type ConfirmUniverseCommitmentLeavesParams struct {
+	CommitmentID        sql.NullInt64
+	PendingCommitmentID sql.NullInt64
+}
+
+func (q *Queries) ConfirmUniverseCommitmentLeaves(ctx context.Context, arg ConfirmUniverseCommitmentLeavesParams) error {
+	_, err := q.db.ExecContext(ctx, confirmUniverseCommitmentLeaves, arg.CommitmentID, arg.PendingCommitmentID)
+	return err
+}
+
 const deleteFederationProofSyncLog = `-- name: DeleteFederationProofSyncLog :exec
 WITH selected_server_id AS (
     -- Select the server ids from the universe_servers table for the specified
@@ -64,6 +80,26 @@ func (q *Queries) DeleteMultiverseLeaf(ctx context.Context, arg DeleteMultiverse
 	return err
 }
 
+const deletePendingUniverseCommitment = `-- name: DeletePendingUniverseCommitment :exec
+DELETE FROM universe_pending_commitments
+WHERE id = $1
+`
+
+func (q *Queries) DeletePendingUniverseCommitment(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deletePendingUniverseCommitment, id)
+	return err
+}
+
+const deletePendingUniverseCommitmentLeaves = `-- name: DeletePendingUniverseCommitmentLeaves :exec
+DELETE FROM universe_commitment_leaves
+WHERE pending_commitment_id = $1
+`
+
+func (q *Queries) DeletePendingUniverseCommitmentLeaves(ctx context.Context, pendingCommitmentID sql.NullInt64) error {
+	_, err := q.db.ExecContext(ctx, deletePendingUniverseCommitmentLeaves, pendingCommitmentID)
+	return err
+}
+
 const deleteUniverseEvents = `-- name: DeleteUniverseEvents :exec
 WITH root_id AS (
     SELECT id
@@ -114,6 +150,58 @@ func (q *Queries) DeleteUniverseServer(ctx context.Context, arg DeleteUniverseSe
 	return err
 }
 
+const fetchLatestUniverseCommitment = `-- name: FetchLatestUniverseCommitment :one
+SELECT commitments.id, commitments.output_index, commitments.issuance_root_hash,
+       commitments.issuance_root_sum, commitments.transfer_root_hash,
+       commitments.transfer_root_sum, commitments.block_header,
+       commitments.merkle_proof, txns.raw_tx, txns.block_height, keys.raw_key,
+       keys.key_family, keys.key_index
+FROM universe_commitments commitments
+JOIN chain_txns txns
+    ON commitments.chain_txn_id = txns.txn_id
+JOIN internal_keys keys
+    ON commitments.internal_key_id = keys.key_id
+ORDER BY txns.block_height DESC, commitments.id DESC
+LIMIT 1
+`
+
+type FetchLatestUniverseCommitmentRow struct {
+	ID               int64
+	OutputIndex      int32
+	IssuanceRootHash []byte
+	IssuanceRootSum  int64
+	TransferRootHash []byte
+	TransferRootSum  int64
+	BlockHeader      []byte
+	MerkleProof      []byte
+	RawTx            []byte
+	BlockHeight      sql.NullInt32
+	RawKey           []byte
+	KeyFamily        int32
+	KeyIndex         int32
+}
+
+func (q *Queries) FetchLatestUniverseCommitment(ctx context.Context) (FetchLatestUniverseCommitmentRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchLatestUniverseCommitment)
+	var i FetchLatestUniverseCommitmentRow
+	err := row.Scan(
+		&i.ID,
+		&i.OutputIndex,
+		&i.IssuanceRootHash,
+		&i.IssuanceRootSum,
+		&i.TransferRootHash,
+		&i.TransferRootSum,
+		&i.BlockHeader,
+		&i.MerkleProof,
+		&i.RawTx,
+		&i.BlockHeight,
+		&i.RawKey,
+		&i.KeyFamily,
+		&i.KeyIndex,
+	)
+	return i, err
+}
+
 const fetchMultiverseRoot = `-- name: FetchMultiverseRoot :one
 SELECT proof_type, n.hash_key as multiverse_root_hash, n.sum as multiverse_root_sum
 FROM multiverse_roots r
@@ -138,6 +226,233 @@ func (q *Queries) FetchMultiverseRoot(ctx context.Context, namespaceRoot string)
 	return i, err
 }
 
+const fetchPendingUniverseCommitment = `-- name: FetchPendingUniverseCommitment :one
+SELECT pending.id, pending.raw_tx, pending.output_index, pending.chain_fees,
+       pending.issuance_root_hash, pending.issuance_root_sum,
+       pending.transfer_root_hash, pending.transfer_root_sum,
+       pending.height_hint, keys.raw_key, keys.key_family, keys.key_index
+FROM universe_pending_commitments pending
+JOIN internal_keys keys
+    ON pending.internal_key_id = keys.key_id
+ORDER BY pending.id DESC
+LIMIT 1
+`
+
+type FetchPendingUniverseCommitmentRow struct {
+	ID               int64
+	RawTx            []byte
+	OutputIndex      int32
+	ChainFees        int64
+	IssuanceRootHash []byte
+	IssuanceRootSum  int64
+	TransferRootHash []byte
+	TransferRootSum  int64
+	HeightHint       int32
+	RawKey           []byte
+	KeyFamily        int32
+	KeyIndex         int32
+}
+
+func (q *Queries) FetchPendingUniverseCommitment(ctx context.Context) (FetchPendingUniverseCommitmentRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchPendingUniverseCommitment)
+	var i FetchPendingUniverseCommitmentRow
+	err := row.Scan(
+		&i.ID,
+		&i.RawTx,
+		&i.OutputIndex,
+		&i.ChainFees,
+		&i.IssuanceRootHash,
+		&i.IssuanceRootSum,
+		&i.TransferRootHash,
+		&i.TransferRootSum,
+		&i.HeightHint,
+		&i.RawKey,
+		&i.KeyFamily,
+		&i.KeyIndex,
+	)
+	return i, err
+}
+
+const fetchUniverseCommitmentByLeaf = `-- name: FetchUniverseCommitmentByLeaf :one
+SELECT commitments.id, commitments.output_index, commitments.issuance_root_hash,
+       commitments.issuance_root_sum, commitments.transfer_root_hash,
+       commitments.transfer_root_sum, commitments.block_header,
+       commitments.merkle_proof, txns.raw_tx, txns.block_height, keys.raw_key,
+       keys.key_family, keys.key_index
+FROM universe_commitment_leaves leaves
+JOIN universe_commitments commitments
+    ON leaves.commitment_id = commitments.id
+JOIN chain_txns txns
+    ON commitments.chain_txn_id = txns.txn_id
+JOIN internal_keys keys
+    ON commitments.internal_key_id = keys.key_id
+WHERE leaves.proof_type = $1 AND
+      (leaves.asset_id = $2 OR $2 IS NULL) AND
+      (leaves.group_key = $3 OR $3 IS NULL) AND
+      leaves.universe_root_hash = $4 AND
+      leaves.universe_root_sum = $5
+ORDER BY txns.block_height DESC, commitments.id DESC
+LIMIT 1
+`
+
+type FetchUniverseCommitmentByLeafParams struct {
+	ProofType        string
+	AssetID          []byte
+	GroupKey         []byte
+	UniverseRootHash []byte
+	UniverseRootSum  int64
+}
+
+type FetchUniverseCommitmentByLeafRow struct {
+	ID               int64
+	OutputIndex      int32
+	IssuanceRootHash []byte
+	IssuanceRootSum  int64
+	TransferRootHash []byte
+	TransferRootSum  int64
+	BlockHeader      []byte
+	MerkleProof      []byte
+	RawTx            []byte
+	BlockHeight      sql.NullInt32
+	RawKey           []byte
+	KeyFamily        int32
+	KeyIndex         int32
+}
+
+func (q *Queries) FetchUniverseCommitmentByLeaf(ctx context.Context, arg FetchUniverseCommitmentByLeafParams) (FetchUniverseCommitmentByLeafRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchUniverseCommitmentByLeaf,
+		arg.ProofType,
+		arg.AssetID,
+		arg.GroupKey,
+		arg.UniverseRootHash,
+		arg.UniverseRootSum,
+	)
+	var i FetchUniverseCommitmentByLeafRow
+	err := row.Scan(
+		&i.ID,
+		&i.OutputIndex,
+		&i.IssuanceRootHash,
+		&i.IssuanceRootSum,
+		&i.TransferRootHash,
+		&i.TransferRootSum,
+		&i.BlockHeader,
+		&i.MerkleProof,
+		&i.RawTx,
+		&i.BlockHeight,
+		&i.RawKey,
+		&i.KeyFamily,
+		&i.KeyIndex,
+	)
+	return i, err
+}
+
+const fetchUniverseCommitmentByRoot = `-- name: FetchUniverseCommitmentByRoot :one
+SELECT commitments.id, commitments.output_index, commitments.issuance_root_hash,
+       commitments.issuance_root_sum, commitments.transfer_root_hash,
+       commitments.transfer_root_sum, commitments.block_header,
+       commitments.merkle_proof, txns.raw_tx, txns.block_height, keys.raw_key,
+       keys.key_family, keys.key_index
+FROM universe_commitments commitments
+JOIN chain_txns txns
+    ON commitments.chain_txn_id = txns.txn_id
+JOIN internal_keys keys
+    ON commitments.internal_key_id = keys.key_id
+WHERE (commitments.issuance_root_hash = $1 AND
+       commitments.issuance_root_sum = $2) OR
+      (commitments.transfer_root_hash = $1 AND
+       commitments.transfer_root_sum = $2)
+ORDER BY txns.block_height ASC, commitments.id ASC
+LIMIT 1
+`
+
+type FetchUniverseCommitmentByRootParams struct {
+	RootHash []byte
+	RootSum  int64
+}
+
+type FetchUniverseCommitmentByRootRow struct {
+	ID               int64
+	OutputIndex      int32
+	IssuanceRootHash []byte
+	IssuanceRootSum  int64
+	TransferRootHash []byte
+	TransferRootSum  int64
+	BlockHeader      []byte
+	MerkleProof      []byte
+	RawTx            []byte
+	BlockHeight      sql.NullInt32
+	RawKey           []byte
+	KeyFamily        int32
+	KeyIndex         int32
+}
+
+func (q *Queries) FetchUniverseCommitmentByRoot(ctx context.Context, arg FetchUniverseCommitmentByRootParams) (FetchUniverseCommitmentByRootRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchUniverseCommitmentByRoot, arg.RootHash, arg.RootSum)
+	var i FetchUniverseCommitmentByRootRow
+	err := row.Scan(
+		&i.ID,
+		&i.OutputIndex,
+		&i.IssuanceRootHash,
+		&i.IssuanceRootSum,
+		&i.TransferRootHash,
+		&i.TransferRootSum,
+		&i.BlockHeader,
+		&i.MerkleProof,
+		&i.RawTx,
+		&i.BlockHeight,
+		&i.RawKey,
+		&i.KeyFamily,
+		&i.KeyIndex,
+	)
+	return i, err
+}
+
+const fetchUniverseCommitmentLeaves = `-- name: FetchUniverseCommitmentLeaves :many
+SELECT asset_id, group_key, universe_root_hash, universe_root_sum
+FROM universe_commitment_leaves
+WHERE commitment_id = $1 AND proof_type = $2
+`
+
+type FetchUniverseCommitmentLeavesParams struct {
+	CommitmentID sql.NullInt64
+	ProofType    string
+}
+
+type FetchUniverseCommitmentLeavesRow struct {
+	AssetID          []byte
+	GroupKey         []byte
+	UniverseRootHash []byte
+	UniverseRootSum  int64
+}
+
+func (q *Queries) FetchUniverseCommitmentLeaves(ctx context.Context, arg FetchUniverseCommitmentLeavesParams) ([]FetchUniverseCommitmentLeavesRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchUniverseCommitmentLeaves, arg.CommitmentID, arg.ProofType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchUniverseCommitmentLeavesRow
+	for rows.Next() {
+		var i FetchUniverseCommitmentLeavesRow
+		if err := rows.Scan(
+			&i.AssetID,
+			&i.GroupKey,
+			&i.UniverseRootHash,
+			&i.UniverseRootSum,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchUniverseKeys = `-- name: FetchUniverseKeys :many
 SELECT leaves.minting_point, leaves.script_key_bytes
 FROM universe_leaves leaves
@@ -188,6 +503,30 @@ func (q *Queries) FetchUniverseKeys(ctx context.Context, arg FetchUniverseKeysPa
 	return items, nil
 }
 
+const fetchUniverseLeafKey = `-- name: FetchUniverseLeafKey :one
+SELECT leaves.minting_point, leaves.script_key_bytes
+FROM universe_leaves leaves
+WHERE leaves.leaf_node_namespace = $1 AND
+    leaves.leaf_node_key = $2
+`
+
+type FetchUniverseLeafKeyParams struct {
+	Namespace   string
+	LeafNodeKey []byte
+}
+
+type FetchUniverseLeafKeyRow struct {
+	MintingPoint   []byte
+	ScriptKeyBytes []byte
+}
+
+func (q *Queries) FetchUniverseLeafKey(ctx context.Context, arg FetchUniverseLeafKeyParams) (FetchUniverseLeafKeyRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchUniverseLeafKey, arg.Namespace, arg.LeafNodeKey)
+	var i FetchUniverseLeafKeyRow
+	err := row.Scan(&i.MintingPoint, &i.ScriptKeyBytes)
+	return i, err
+}
+
 const fetchUniverseRoot = `-- name: FetchUniverseRoot :one
 SELECT universe_roots.asset_id, group_key, proof_type,
        mssmt_nodes.hash_key root_hash, mssmt_nodes.sum root_sum,
@@ -324,6 +663,119 @@ func (q *Queries) InsertNewSyncEvent(ctx context.Context, arg InsertNewSyncEvent
 	return err
 }
 
+const insertPendingUniverseCommitment = `-- name: InsertPendingUniverseCommitment :one
+INSERT INTO universe_pending_commitments (
+    raw_tx, output_index, chain_fees, internal_key_id, issuance_root_hash,
+    issuance_root_sum, transfer_root_hash, transfer_root_sum, height_hint
+) VALUES (
+    $1, $2, $3, $4, $5,
+    $6, $7, $8, $9
+)
+RETURNING id
+`
+
+type InsertPendingUniverseCommitmentParams struct {
+	RawTx            []byte
+	OutputIndex      int32
+	ChainFees        int64
+	InternalKeyID    int64
+	IssuanceRootHash []byte
+	IssuanceRootSum  int64
+	TransferRootHash []byte
+	TransferRootSum  int64
+	HeightHint       int32
+}
+
+func (q *Queries) InsertPendingUniverseCommitment(ctx context.Context, arg InsertPendingUniverseCommitmentParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, insertPendingUniverseCommitment,
+		arg.RawTx,
+		arg.OutputIndex,
+		arg.ChainFees,
+		arg.InternalKeyID,
+		arg.IssuanceRootHash,
+		arg.IssuanceRootSum,
+		arg.TransferRootHash,
+		arg.TransferRootSum,
+		arg.HeightHint,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertUniverseCommitment = `-- name: InsertUniverseCommitment :one
+INSERT INTO universe_commitments (
+    chain_txn_id, output_index, internal_key_id, issuance_root_hash,
+    issuance_root_sum, transfer_root_hash, transfer_root_sum, block_header,
+    merkle_proof
+) VALUES (
+    $1, $2, $3, $4,
+    $5, $6, $7, $8,
+    $9
+)
+RETURNING id
+`
+
+type InsertUniverseCommitmentParams struct {
+	ChainTxnID       int64
+	OutputIndex      int32
+	InternalKeyID    int64
+	IssuanceRootHash []byte
+	IssuanceRootSum  int64
+	TransferRootHash []byte
+	TransferRootSum  int64
+	BlockHeader      []byte
+	MerkleProof      []byte
+}
+
+func (q *Queries) InsertUniverseCommitment(ctx context.Context, arg InsertUniverseCommitmentParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, insertUniverseCommitment,
+		arg.ChainTxnID,
+		arg.OutputIndex,
+		arg.InternalKeyID,
+		arg.IssuanceRootHash,
+		arg.IssuanceRootSum,
+		arg.TransferRootHash,
+		arg.TransferRootSum,
+		arg.BlockHeader,
+		arg.MerkleProof,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertUniverseCommitmentLeaf = `-- name: InsertUniverseCommitmentLeaf :exec
+INSERT INTO universe_commitment_leaves (
+    pending_commitment_id, proof_type, asset_id, group_key,
+    universe_root_hash, universe_root_sum
+) VALUES (
+    $1, $2, $3, $4,
+    $5, $6
+)
+`
+
+type InsertUniverseCommitmentLeafParams struct {
+	PendingCommitmentID sql.NullInt64
+	ProofType           string
+	AssetID             []byte
+	GroupKey            []byte
+	UniverseRootHash    []byte
+	UniverseRootSum     int64
+}
+
+func (q *Queries) InsertUniverseCommitmentLeaf(ctx context.Context, arg InsertUniverseCommitmentLeafParams) error {
+	_, err := q.db.ExecContext(ctx, insertUniverseCommitmentLeaf,
+		arg.PendingCommitmentID,
+		arg.ProofType,
+		arg.AssetID,
+		arg.GroupKey,
+		arg.UniverseRootHash,
+		arg.UniverseRootSum,
+	)
+	return err
+}
+
 const insertUniverseServer = `-- name: InsertUniverseServer :exec
 INSERT INTO universe_servers(
     server_host, last_sync_time
@@ -451,7 +903,7 @@ func (q *Queries) QueryAssetStatsPerDaySqlite(ctx context.Context, arg QueryAsse
 }
 
 const queryFederationGlobalSyncConfigs = `-- name: QueryFederationGlobalSyncConfigs :many
-SELECT proof_type, allow_sync_insert, allow_sync_export
+SELECT proof_type, allow_sync_insert, allow_sync_export, sync_quorum
 FROM federation_global_sync_config
 ORDER BY proof_type
 `
@@ -465,7 +917,12 @@ func (q *Queries) QueryFederationGlobalSyncConfigs(ctx context.Context) ([]Feder
 	var items []FederationGlobalSyncConfig
 	for rows.Next() {
 		var i FederationGlobalSyncConfig
-		if err := rows.Scan(&i.ProofType, &i.AllowSyncInsert, &i.AllowSyncExport); err != nil {
+		if err := rows.Scan(
+			&i.ProofType,
+			&i.AllowSyncInsert,
+			&i.AllowSyncExport,
+			&i.SyncQuorum,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -607,6 +1064,40 @@ func (q *Queries) QueryFederationProofSyncLog(ctx context.Context, arg QueryFede
 	return items, nil
 }
 
+const queryFederationServerSyncConfigs = `-- name: QueryFederationServerSyncConfigs :many
+SELECT server_host, allow_sync_insert, allow_sync_export, trust_weight
+FROM federation_server_sync_config
+ORDER BY server_host
+`
+
+func (q *Queries) QueryFederationServerSyncConfigs(ctx context.Context) ([]FederationServerSyncConfig, error) {
+	rows, err := q.db.QueryContext(ctx, queryFederationServerSyncConfigs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FederationServerSyncConfig
+	for rows.Next() {
+		var i FederationServerSyncConfig
+		if err := rows.Scan(
+			&i.ServerHost,
+			&i.AllowSyncInsert,
+			&i.AllowSyncExport,
+			&i.TrustWeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const queryFederationUniSyncConfigs = `-- name: QueryFederationUniSyncConfigs :many
 SELECT namespace, asset_id, group_key, proof_type, allow_sync_insert, allow_sync_export
 FROM federation_uni_sync_config
@@ -1121,23 +1612,30 @@ func (q *Queries) UniverseRoots(ctx context.Context, arg UniverseRootsParams) ([
 
 const upsertFederationGlobalSyncConfig = `-- name: UpsertFederationGlobalSyncConfig :exec
 INSERT INTO federation_global_sync_config (
-    proof_type, allow_sync_insert, allow_sync_export
+    proof_type, allow_sync_insert, allow_sync_export, sync_quorum
 )
-VALUES ($1, $2, $3)
+VALUES ($1, $2, $3, $4)
 ON CONFLICT(proof_type)
     DO UPDATE SET
     allow_sync_insert = $2,
-    allow_sync_export = $3
+    allow_sync_export = $3,
+    sync_quorum = $4
 `
 
 type UpsertFederationGlobalSyncConfigParams struct {
 	ProofType       string
 	AllowSyncInsert bool
 	AllowSyncExport bool
+	SyncQuorum      int32
 }
 
 func (q *Queries) UpsertFederationGlobalSyncConfig(ctx context.Context, arg UpsertFederationGlobalSyncConfigParams) error {
-	_, err := q.db.ExecContext(ctx, upsertFederationGlobalSyncConfig, arg.ProofType, arg.AllowSyncInsert, arg.AllowSyncExport)
+	_, err := q.db.ExecContext(ctx, upsertFederationGlobalSyncConfig,
+		arg.ProofType,
+		arg.AllowSyncInsert,
+		arg.AllowSyncExport,
+		arg.SyncQuorum,
+	)
 	return err
 }
 
@@ -1211,6 +1709,37 @@ func (q *Queries) UpsertFederationProofSyncLog(ctx context.Context, arg UpsertFe
 	return id, err
 }
 
+const upsertFederationServerSyncConfig = `-- name: UpsertFederationServerSyncConfig :exec
+INSERT INTO federation_server_sync_config (
+    server_host, allow_sync_insert, allow_sync_export, trust_weight
+)
+VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT(server_host)
+    DO UPDATE SET
+    allow_sync_insert = $2,
+    allow_sync_export = $3,
+    trust_weight = $4
+`
+
+type UpsertFederationServerSyncConfigParams struct {
+	ServerHost      string
+	AllowSyncInsert bool
+	AllowSyncExport bool
+	TrustWeight     int32
+}
+
+func (q *Queries) UpsertFederationServerSyncConfig(ctx context.Context, arg UpsertFederationServerSyncConfigParams) error {
+	_, err := q.db.ExecContext(ctx, upsertFederationServerSyncConfig,
+		arg.ServerHost,
+		arg.AllowSyncInsert,
+		arg.AllowSyncExport,
+		arg.TrustWeight,
+	)
+	return err
+}
+
 const upsertFederationUniSyncConfig = `-- name: UpsertFederationUniSyncConfig :exec
 INSERT INTO federation_uni_sync_config  (
     namespace, asset_id, group_key, proof_type, allow_sync_insert, allow_sync_export
@@ -42,6 +42,13 @@ type (
 	// currently stored for a given namespace.
 	UniverseLeafKeysQuery = sqlc.FetchUniverseKeysParams
 
+	// UniverseLeafKeyQuery is used to query for the key of a universe leaf
+	// by its MS-SMT leaf node key.
+	UniverseLeafKeyQuery = sqlc.FetchUniverseLeafKeyParams
+
+	// UniverseLeafKey is the key of a single leaf of a universe.
+	UniverseLeafKey = sqlc.FetchUniverseLeafKeyRow
+
 	// UpsertMultiverseRoot is used to upsert a multiverse root.
 	UpsertMultiverseRoot = sqlc.UpsertMultiverseRootParams
 
@@ -99,6 +106,11 @@ type BaseUniverseStore interface {
 	FetchUniverseKeys(ctx context.Context,
 		arg UniverseLeafKeysQuery) ([]UniverseKeys, error)
 
+	// FetchUniverseLeafKey fetches the key of the universe leaf that is
+	// stored at the given MS-SMT leaf node key.
+	FetchUniverseLeafKey(ctx context.Context,
+		arg UniverseLeafKeyQuery) (UniverseLeafKey, error)
+
 	// UpsertMultiverseRoot upserts a multiverse root in the database.
 	UpsertMultiverseRoot(ctx context.Context,
 		arg UpsertMultiverseRoot) (int64, error)
@@ -736,27 +748,14 @@ func mintingKeys(ctx context.Context, dbTx BaseUniverseStore,
 
 	var leafKeys []universe.LeafKey
 	err = fn.ForEachErr(universeKeys, func(key UniverseKeys) error {
-		scriptKeyPub, err := schnorr.ParsePubKey(
-			key.ScriptKeyBytes,
-		)
-		if err != nil {
-			return err
-		}
-		scriptKey := asset.NewScriptKey(scriptKeyPub)
-
-		var genPoint wire.OutPoint
-		err = readOutPoint(
-			bytes.NewReader(key.MintingPoint), 0, 0,
-			&genPoint,
+		leafKey, err := parseUniverseLeafKey(
+			key.MintingPoint, key.ScriptKeyBytes,
 		)
 		if err != nil {
 			return err
 		}
 
-		leafKeys = append(leafKeys, universe.LeafKey{
-			OutPoint:  genPoint,
-			ScriptKey: &scriptKey,
-		})
+		leafKeys = append(leafKeys, leafKey)
 
 		return nil
 	})
@@ -767,6 +766,29 @@ func mintingKeys(ctx context.Context, dbTx BaseUniverseStore,
 	return leafKeys, nil
 }
 
+// parseUniverseLeafKey parses a universe leaf key from the minting point and
+// the x-only script key stored for a universe leaf.
+func parseUniverseLeafKey(mintingPoint,
+	scriptKeyBytes []byte) (universe.LeafKey, error) {
+
+	scriptKeyPub, err := schnorr.ParsePubKey(scriptKeyBytes)
+	if err != nil {
+		return universe.LeafKey{}, err
+	}
+	scriptKey := asset.NewScriptKey(scriptKeyPub)
+
+	var genPoint wire.OutPoint
+	err = readOutPoint(bytes.NewReader(mintingPoint), 0, 0, &genPoint)
+	if err != nil {
+		return universe.LeafKey{}, err
+	}
+
+	return universe.LeafKey{
+		OutPoint:  genPoint,
+		ScriptKey: &scriptKey,
+	}, nil
+}
+
 // MintingKeys returns all the keys inserted in the universe.
 func (b *BaseUniverseTree) MintingKeys(ctx context.Context,
 	q universe.UniverseLeafKeysQuery) ([]universe.LeafKey, error) {
@@ -0,0 +1,666 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+type (
+	// NewUniverseCommitment is used to insert a new universe commitment.
+	NewUniverseCommitment = sqlc.InsertUniverseCommitmentParams
+
+	// UniverseCommitmentByRoot is used to query for a universe commitment
+	// by one of its multiverse roots.
+	UniverseCommitmentByRoot = sqlc.FetchUniverseCommitmentByRootParams
+
+	// UniverseCommitmentByLeaf is used to query for a universe commitment
+	// by one of the multiverse leaves it committed to.
+	UniverseCommitmentByLeaf = sqlc.FetchUniverseCommitmentByLeafParams
+
+	// NewPendingCommitment is used to insert a new pending universe
+	// commitment.
+	NewPendingCommitment = sqlc.InsertPendingUniverseCommitmentParams
+
+	// NewUniverseCommitmentLeaf is used to insert a multiverse leaf of a
+	// pending universe commitment.
+	NewUniverseCommitmentLeaf = sqlc.InsertUniverseCommitmentLeafParams
+
+	// ConfirmCommitmentLeaves is used to move the multiverse
+	// leaves of a pending universe commitment to the confirmed one.
+	ConfirmCommitmentLeaves = sqlc.ConfirmUniverseCommitmentLeavesParams
+
+	// UniverseCommitmentLeavesQuery is used to query for the multiverse
+	// leaves of a universe commitment.
+	UniverseCommitmentLeavesQuery = sqlc.FetchUniverseCommitmentLeavesParams
+)
+
+// UniverseCommitmentStore is the main storage interface for on chain universe
+// commitments.
+type UniverseCommitmentStore interface {
+	// UpsertChainTx inserts a new or updates an existing chain tx into the
+	// DB.
+	UpsertChainTx(ctx context.Context, arg ChainTxParams) (int64, error)
+
+	// UpsertInternalKey inserts a new or updates an existing internal key
+	// into the database and returns the primary key.
+	UpsertInternalKey(ctx context.Context, arg InternalKey) (int64, error)
+
+	// InsertUniverseCommitment inserts a new universe commitment.
+	InsertUniverseCommitment(ctx context.Context,
+		arg NewUniverseCommitment) (int64, error)
+
+	// FetchLatestUniverseCommitment fetches the most recent universe
+	// commitment.
+	FetchLatestUniverseCommitment(
+		ctx context.Context) (sqlc.FetchLatestUniverseCommitmentRow,
+		error)
+
+	// FetchUniverseCommitmentByRoot fetches the earliest universe
+	// commitment that committed to the given multiverse root.
+	FetchUniverseCommitmentByRoot(ctx context.Context,
+		arg UniverseCommitmentByRoot) (
+		sqlc.FetchUniverseCommitmentByRootRow, error)
+
+	// FetchUniverseCommitmentByLeaf fetches the latest universe
+	// commitment that committed to the given multiverse leaf.
+	FetchUniverseCommitmentByLeaf(ctx context.Context,
+		arg UniverseCommitmentByLeaf) (
+		sqlc.FetchUniverseCommitmentByLeafRow, error)
+
+	// InsertPendingUniverseCommitment inserts a new pending universe
+	// commitment.
+	InsertPendingUniverseCommitment(ctx context.Context,
+		arg NewPendingCommitment) (int64, error)
+
+	// FetchPendingUniverseCommitment fetches the pending universe
+	// commitment.
+	FetchPendingUniverseCommitment(ctx context.Context) (
+		sqlc.FetchPendingUniverseCommitmentRow, error)
+
+	// DeletePendingUniverseCommitment deletes the pending universe
+	// commitment with the given ID.
+	DeletePendingUniverseCommitment(ctx context.Context, id int64) error
+
+	// InsertUniverseCommitmentLeaf inserts a new multiverse leaf of a
+	// pending universe commitment.
+	InsertUniverseCommitmentLeaf(ctx context.Context,
+		arg NewUniverseCommitmentLeaf) error
+
+	// DeletePendingUniverseCommitmentLeaves deletes the multiverse leaves
+	// of a pending universe commitment.
+	DeletePendingUniverseCommitmentLeaves(ctx context.Context,
+		pendingCommitmentID sql.NullInt64) error
+
+	// ConfirmUniverseCommitmentLeaves moves the multiverse leaves of a
+	// pending universe commitment over to the confirmed commitment.
+	ConfirmUniverseCommitmentLeaves(ctx context.Context,
+		arg ConfirmCommitmentLeaves) error
+
+	// FetchUniverseCommitmentLeaves fetches the multiverse leaves of the
+	// given proof type of a universe commitment.
+	FetchUniverseCommitmentLeaves(ctx context.Context,
+		arg UniverseCommitmentLeavesQuery) (
+		[]sqlc.FetchUniverseCommitmentLeavesRow, error)
+}
+
+// UniverseCommitmentOptions is the database tx object for the universe
+// commitment store.
+type UniverseCommitmentOptions struct {
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+func (u *UniverseCommitmentOptions) ReadOnly() bool {
+	return u.readOnly
+}
+
+// NewUniverseCommitmentReadTx returns a new read tx for the universe
+// commitment store.
+func NewUniverseCommitmentReadTx() UniverseCommitmentOptions {
+	return UniverseCommitmentOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedUniverseCommitmentStore allows for batched DB transactions for the
+// universe commitment store.
+type BatchedUniverseCommitmentStore interface {
+	UniverseCommitmentStore
+
+	BatchedTx[UniverseCommitmentStore]
+}
+
+// UniverseCommitmentDB is used to persist and query on chain commitments to
+// the multiverse roots.
+type UniverseCommitmentDB struct {
+	db BatchedUniverseCommitmentStore
+}
+
+// A compile-time check to ensure that UniverseCommitmentDB meets the
+// universe.CommitmentArchive interface.
+var _ universe.CommitmentArchive = (*UniverseCommitmentDB)(nil)
+
+// NewUniverseCommitmentDB creates a new universe commitment DB.
+func NewUniverseCommitmentDB(
+	db BatchedUniverseCommitmentStore) *UniverseCommitmentDB {
+
+	return &UniverseCommitmentDB{
+		db: db,
+	}
+}
+
+// InsertCommitment stores a new confirmed universe commitment.
+func (u *UniverseCommitmentDB) InsertCommitment(ctx context.Context,
+	c *universe.Commitment) error {
+
+	if c.AnchorTx == nil || c.MerkleProof == nil {
+		return fmt.Errorf("commitment is missing anchor tx or merkle " +
+			"proof")
+	}
+
+	var txBuf bytes.Buffer
+	if err := c.AnchorTx.Serialize(&txBuf); err != nil {
+		return err
+	}
+
+	var headerBuf bytes.Buffer
+	if err := c.BlockHeader.Serialize(&headerBuf); err != nil {
+		return err
+	}
+
+	var proofBuf bytes.Buffer
+	if err := c.MerkleProof.Encode(&proofBuf); err != nil {
+		return err
+	}
+
+	issuanceRootHash := c.IssuanceRoot.NodeHash()
+	issuanceRootSum := int64(c.IssuanceRoot.NodeSum())
+	transferRootHash := c.TransferRoot.NodeHash()
+	transferRootSum := int64(c.TransferRoot.NodeSum())
+	anchorTXID := c.AnchorTx.TxHash()
+	blockHash := c.BlockHeader.BlockHash()
+
+	var writeTx UniverseCommitmentOptions
+	dbErr := u.db.ExecTx(ctx, &writeTx, func(
+		db UniverseCommitmentStore) error {
+
+		chainTxID, err := db.UpsertChainTx(ctx, ChainTxParams{
+			Txid:        anchorTXID[:],
+			RawTx:       txBuf.Bytes(),
+			ChainFees:   c.ChainFees,
+			BlockHeight: sqlInt32(c.BlockHeight),
+			BlockHash:   blockHash[:],
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert chain tx: %w", err)
+		}
+
+		internalKeyID, err := db.UpsertInternalKey(ctx, InternalKey{
+			RawKey:    c.InternalKey.PubKey.SerializeCompressed(),
+			KeyFamily: int32(c.InternalKey.Family),
+			KeyIndex:  int32(c.InternalKey.Index),
+		})
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrUpsertInternalKey, err)
+		}
+
+		commitmentID, err := db.InsertUniverseCommitment(
+			ctx, NewUniverseCommitment{
+				ChainTxnID:       chainTxID,
+				OutputIndex:      int32(c.OutputIndex),
+				InternalKeyID:    internalKeyID,
+				IssuanceRootHash: issuanceRootHash[:],
+				IssuanceRootSum:  issuanceRootSum,
+				TransferRootHash: transferRootHash[:],
+				TransferRootSum:  transferRootSum,
+				BlockHeader:      headerBuf.Bytes(),
+				MerkleProof:      proofBuf.Bytes(),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to insert universe "+
+				"commitment: %w", err)
+		}
+
+		// If this commitment was pending before, its multiverse leaves
+		// now belong to the confirmed commitment.
+		pending, err := db.FetchPendingUniverseCommitment(ctx)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil
+
+		case err != nil:
+			return fmt.Errorf("unable to fetch pending universe "+
+				"commitment: %w", err)
+		}
+
+		if !bytes.Equal(pending.RawTx, txBuf.Bytes()) {
+			return nil
+		}
+
+		err = db.ConfirmUniverseCommitmentLeaves(
+			ctx, ConfirmCommitmentLeaves{
+				CommitmentID:        sqlInt64(commitmentID),
+				PendingCommitmentID: sqlInt64(pending.ID),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to confirm universe "+
+				"commitment leaves: %w", err)
+		}
+
+		return db.DeletePendingUniverseCommitment(ctx, pending.ID)
+	})
+
+	return dbErr
+}
+
+// InsertPendingCommitment stores a new commitment that hasn't confirmed yet,
+// along with the multiverse leaves of the committed issuance and transfer
+// multiverse trees.
+func (u *UniverseCommitmentDB) InsertPendingCommitment(ctx context.Context,
+	c *universe.Commitment, leaves []universe.MultiverseLeaf) error {
+
+	if c.AnchorTx == nil {
+		return fmt.Errorf("commitment is missing anchor tx")
+	}
+
+	var txBuf bytes.Buffer
+	if err := c.AnchorTx.Serialize(&txBuf); err != nil {
+		return err
+	}
+
+	issuanceRootHash := c.IssuanceRoot.NodeHash()
+	issuanceRootSum := int64(c.IssuanceRoot.NodeSum())
+	transferRootHash := c.TransferRoot.NodeHash()
+	transferRootSum := int64(c.TransferRoot.NodeSum())
+
+	var writeTx UniverseCommitmentOptions
+	return u.db.ExecTx(ctx, &writeTx, func(
+		db UniverseCommitmentStore) error {
+
+		internalKeyID, err := db.UpsertInternalKey(ctx, InternalKey{
+			RawKey:    c.InternalKey.PubKey.SerializeCompressed(),
+			KeyFamily: int32(c.InternalKey.Family),
+			KeyIndex:  int32(c.InternalKey.Index),
+		})
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrUpsertInternalKey, err)
+		}
+
+		pendingID, err := db.InsertPendingUniverseCommitment(
+			ctx, NewPendingCommitment{
+				RawTx:            txBuf.Bytes(),
+				OutputIndex:      int32(c.OutputIndex),
+				ChainFees:        c.ChainFees,
+				InternalKeyID:    internalKeyID,
+				IssuanceRootHash: issuanceRootHash[:],
+				IssuanceRootSum:  issuanceRootSum,
+				TransferRootHash: transferRootHash[:],
+				TransferRootSum:  transferRootSum,
+				HeightHint:       int32(c.HeightHint),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to insert pending universe "+
+				"commitment: %w", err)
+		}
+
+		for _, leaf := range leaves {
+			assetID, groupKey := multiverseLeafKey(leaf.ID)
+			newLeaf := NewUniverseCommitmentLeaf{
+				PendingCommitmentID: sqlInt64(pendingID),
+				ProofType:           leaf.ID.ProofType.String(),
+				AssetID:             assetID,
+				GroupKey:            groupKey,
+				UniverseRootHash:    leaf.Value,
+				UniverseRootSum:     int64(leaf.NodeSum()),
+			}
+
+			err := db.InsertUniverseCommitmentLeaf(ctx, newLeaf)
+			if err != nil {
+				return fmt.Errorf("unable to insert universe "+
+					"commitment leaf: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// PendingCommitment returns the pending universe commitment. If there is no
+// pending commitment, then universe.ErrNoCommitment is returned.
+func (u *UniverseCommitmentDB) PendingCommitment(
+	ctx context.Context) (*universe.Commitment, error) {
+
+	var commitment *universe.Commitment
+
+	readTx := NewUniverseCommitmentReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(
+		db UniverseCommitmentStore) error {
+
+		row, err := db.FetchPendingUniverseCommitment(ctx)
+		if err != nil {
+			return err
+		}
+
+		commitment, err = parsePendingUniverseCommitment(row)
+		return err
+	})
+	switch {
+	case errors.Is(dbErr, sql.ErrNoRows):
+		return nil, universe.ErrNoCommitment
+
+	case dbErr != nil:
+		return nil, dbErr
+	}
+
+	return commitment, nil
+}
+
+// DeletePendingCommitment removes the pending universe commitment along with
+// its multiverse leaves.
+func (u *UniverseCommitmentDB) DeletePendingCommitment(
+	ctx context.Context) error {
+
+	var writeTx UniverseCommitmentOptions
+	return u.db.ExecTx(ctx, &writeTx, func(
+		db UniverseCommitmentStore) error {
+
+		pending, err := db.FetchPendingUniverseCommitment(ctx)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		err = db.DeletePendingUniverseCommitmentLeaves(
+			ctx, sqlInt64(pending.ID),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to delete pending universe "+
+				"commitment leaves: %w", err)
+		}
+
+		return db.DeletePendingUniverseCommitment(ctx, pending.ID)
+	})
+}
+
+// LatestCommitment returns the most recent universe commitment. If no
+// commitment exists, then universe.ErrNoCommitment is returned.
+func (u *UniverseCommitmentDB) LatestCommitment(
+	ctx context.Context) (*universe.Commitment, error) {
+
+	var commitment *universe.Commitment
+
+	readTx := NewUniverseCommitmentReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(
+		db UniverseCommitmentStore) error {
+
+		row, err := db.FetchLatestUniverseCommitment(ctx)
+		if err != nil {
+			return err
+		}
+
+		commitment, err = parseUniverseCommitment(
+			universeCommitmentRow(row),
+		)
+		return err
+	})
+	switch {
+	case errors.Is(dbErr, sql.ErrNoRows):
+		return nil, universe.ErrNoCommitment
+
+	case dbErr != nil:
+		return nil, dbErr
+	}
+
+	return commitment, nil
+}
+
+// FetchCommitmentByRoot returns the earliest universe commitment that
+// committed to the given multiverse root, either as its issuance or transfer
+// root. If no such commitment exists, then universe.ErrNoCommitment is
+// returned.
+func (u *UniverseCommitmentDB) FetchCommitmentByRoot(ctx context.Context,
+	multiverseRoot mssmt.Node) (*universe.Commitment, error) {
+
+	var (
+		commitment *universe.Commitment
+		rootHash   = multiverseRoot.NodeHash()
+	)
+
+	readTx := NewUniverseCommitmentReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(
+		db UniverseCommitmentStore) error {
+
+		row, err := db.FetchUniverseCommitmentByRoot(
+			ctx, UniverseCommitmentByRoot{
+				RootHash: rootHash[:],
+				RootSum:  int64(multiverseRoot.NodeSum()),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		commitment, err = parseUniverseCommitment(
+			universeCommitmentRow(row),
+		)
+		return err
+	})
+	switch {
+	case errors.Is(dbErr, sql.ErrNoRows):
+		return nil, universe.ErrNoCommitment
+
+	case dbErr != nil:
+		return nil, dbErr
+	}
+
+	return commitment, nil
+}
+
+// FetchCommitmentByLeaf returns the latest universe commitment that includes
+// the given multiverse leaf, along with all leaves of the committed multiverse
+// tree of the leaf's proof type. If no such commitment exists, then
+// universe.ErrNoCommitment is returned.
+func (u *UniverseCommitmentDB) FetchCommitmentByLeaf(ctx context.Context,
+	leaf universe.MultiverseLeaf) (*universe.Commitment,
+	[]universe.MultiverseLeaf, error) {
+
+	var (
+		commitment *universe.Commitment
+		leaves     []universe.MultiverseLeaf
+		proofType  = leaf.ID.ProofType
+	)
+
+	assetID, groupKey := multiverseLeafKey(leaf.ID)
+
+	readTx := NewUniverseCommitmentReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(
+		db UniverseCommitmentStore) error {
+
+		leaves = nil
+
+		row, err := db.FetchUniverseCommitmentByLeaf(
+			ctx, UniverseCommitmentByLeaf{
+				ProofType:        proofType.String(),
+				AssetID:          assetID,
+				GroupKey:         groupKey,
+				UniverseRootHash: leaf.Value,
+				UniverseRootSum:  int64(leaf.NodeSum()),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		commitment, err = parseUniverseCommitment(
+			universeCommitmentRow(row),
+		)
+		if err != nil {
+			return err
+		}
+
+		leafRows, err := db.FetchUniverseCommitmentLeaves(
+			ctx, UniverseCommitmentLeavesQuery{
+				CommitmentID: sqlInt64(row.ID),
+				ProofType:    proofType.String(),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch universe "+
+				"commitment leaves: %w", err)
+		}
+
+		for _, leafRow := range leafRows {
+			id := universe.Identifier{
+				ProofType: proofType,
+			}
+			copy(id.AssetID[:], leafRow.AssetID)
+			if len(leafRow.GroupKey) > 0 {
+				id.GroupKey, err = schnorr.ParsePubKey(
+					leafRow.GroupKey,
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			leaves = append(leaves, universe.MultiverseLeaf{
+				ID: id,
+				LeafNode: mssmt.NewLeafNode(
+					leafRow.UniverseRootHash,
+					uint64(leafRow.UniverseRootSum),
+				),
+			})
+		}
+
+		return nil
+	})
+	switch {
+	case errors.Is(dbErr, sql.ErrNoRows):
+		return nil, nil, universe.ErrNoCommitment
+
+	case dbErr != nil:
+		return nil, nil, dbErr
+	}
+
+	return commitment, leaves, nil
+}
+
+// multiverseLeafKey returns the asset ID and group key columns of the
+// multiverse leaf of the given universe. Only one of them is set.
+func multiverseLeafKey(id universe.Identifier) ([]byte, []byte) {
+	if id.GroupKey != nil {
+		return nil, schnorr.SerializePubKey(id.GroupKey)
+	}
+
+	return id.AssetID[:], nil
+}
+
+// universeCommitmentRow is the common set of columns returned by the
+// universe commitment queries.
+type universeCommitmentRow sqlc.FetchLatestUniverseCommitmentRow
+
+// parseUniverseCommitment parses a universe commitment from a database row.
+func parseUniverseCommitment(
+	row universeCommitmentRow) (*universe.Commitment, error) {
+
+	commitment, err := parsePendingUniverseCommitment(
+		sqlc.FetchPendingUniverseCommitmentRow{
+			RawTx:            row.RawTx,
+			OutputIndex:      row.OutputIndex,
+			IssuanceRootHash: row.IssuanceRootHash,
+			IssuanceRootSum:  row.IssuanceRootSum,
+			TransferRootHash: row.TransferRootHash,
+			TransferRootSum:  row.TransferRootSum,
+			RawKey:           row.RawKey,
+			KeyFamily:        row.KeyFamily,
+			KeyIndex:         row.KeyIndex,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var header wire.BlockHeader
+	err = header.Deserialize(bytes.NewReader(row.BlockHeader))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode block header: %w", err)
+	}
+
+	var merkleProof proof.TxMerkleProof
+	err = merkleProof.Decode(bytes.NewReader(row.MerkleProof))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode merkle proof: %w", err)
+	}
+
+	commitment.BlockHeight = extractSqlInt32[uint32](row.BlockHeight)
+	commitment.BlockHeader = header
+	commitment.MerkleProof = &merkleProof
+
+	return commitment, nil
+}
+
+// parsePendingUniverseCommitment parses a universe commitment that hasn't
+// confirmed yet from a database row.
+func parsePendingUniverseCommitment(
+	row sqlc.FetchPendingUniverseCommitmentRow) (*universe.Commitment,
+	error) {
+
+	var anchorTx wire.MsgTx
+	err := anchorTx.Deserialize(bytes.NewReader(row.RawTx))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode anchor tx: %w", err)
+	}
+
+	internalKey, err := btcec.ParsePubKey(row.RawKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse internal key: %w", err)
+	}
+
+	var issuanceRootHash, transferRootHash mssmt.NodeHash
+	copy(issuanceRootHash[:], row.IssuanceRootHash)
+	copy(transferRootHash[:], row.TransferRootHash)
+
+	issuanceRoot := mssmt.NewComputedBranch(
+		issuanceRootHash, uint64(row.IssuanceRootSum),
+	)
+	transferRoot := mssmt.NewComputedBranch(
+		transferRootHash, uint64(row.TransferRootSum),
+	)
+
+	return &universe.Commitment{
+		AnchorTx:    &anchorTx,
+		OutputIndex: uint32(row.OutputIndex),
+		ChainFees:   row.ChainFees,
+		HeightHint:  uint32(row.HeightHint),
+		InternalKey: keychain.KeyDescriptor{
+			PubKey: internalKey,
+			KeyLocator: keychain.KeyLocator{
+				Index:  uint32(row.KeyIndex),
+				Family: keychain.KeyFamily(row.KeyFamily),
+			},
+		},
+		IssuanceRoot: issuanceRoot,
+		TransferRoot: transferRoot,
+		UniverseRoot: universe.NewCommitmentRoot(
+			issuanceRoot, transferRoot,
+		),
+	}, nil
+}
@@ -0,0 +1,289 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestUniverseCommitmentDB(t *testing.T) *UniverseCommitmentDB {
+	db := NewTestDB(t)
+
+	dbTxer := NewTransactionExecutor(db,
+		func(tx *sql.Tx) UniverseCommitmentStore {
+			return db.WithTx(tx)
+		},
+	)
+
+	return NewUniverseCommitmentDB(dbTxer)
+}
+
+// randMultiverseRoot returns a random multiverse root node.
+func randMultiverseRoot() mssmt.Node {
+	return mssmt.NewComputedBranch(
+		mssmt.NodeHash(test.RandHash()), uint64(test.RandInt[uint32]()),
+	)
+}
+
+// randUniverseCommitment creates a random, but valid, universe commitment to
+// the given multiverse roots that is mined at the given height.
+func randUniverseCommitment(t *testing.T, issuanceRoot,
+	transferRoot mssmt.Node, height uint32) *universe.Commitment {
+
+	internalKey, _ := test.RandKeyDesc(t)
+	commitment := &universe.Commitment{
+		BlockHeight:  height,
+		InternalKey:  internalKey,
+		IssuanceRoot: issuanceRoot,
+		TransferRoot: transferRoot,
+		UniverseRoot: universe.NewCommitmentRoot(
+			issuanceRoot, transferRoot,
+		),
+		ChainFees: int64(test.RandInt[uint16]()),
+	}
+
+	pkScript, err := commitment.PkScript()
+	require.NoError(t, err)
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t),
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		Value:    int64(universe.CommitmentOutputValue),
+		PkScript: pkScript,
+	})
+
+	// We'll place the anchor transaction in a block with a few other
+	// transactions, so the merkle proof isn't trivial.
+	blockTxs := []*wire.MsgTx{anchorTx}
+	for i := 0; i < 3; i++ {
+		tx := wire.NewMsgTx(2)
+		tx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: test.RandOp(t),
+		})
+		blockTxs = append(blockTxs, tx)
+	}
+
+	merkleProof, err := proof.NewTxMerkleProof(blockTxs, 0)
+	require.NoError(t, err)
+
+	merkleRoot := blockchain.CalcMerkleRoot(
+		fn.Map(blockTxs, btcutil.NewTx), false,
+	)
+
+	commitment.AnchorTx = anchorTx
+	commitment.MerkleProof = merkleProof
+	commitment.BlockHeader = *wire.NewBlockHeader(
+		0, fn.Ptr(test.RandHash()), &merkleRoot, 0, 0,
+	)
+
+	return commitment
+}
+
+// assertEqualCommitment asserts that the two commitments are equal.
+func assertEqualCommitment(t *testing.T, expected,
+	actual *universe.Commitment) {
+
+	require.Equal(t, expected.BlockHeight, actual.BlockHeight)
+	require.Equal(t, expected.BlockHeader, actual.BlockHeader)
+	require.Equal(t, expected.MerkleProof, actual.MerkleProof)
+	require.Equal(t, expected.AnchorTx.TxHash(), actual.AnchorTx.TxHash())
+	require.Equal(t, expected.OutputIndex, actual.OutputIndex)
+	require.Equal(
+		t, expected.InternalKey.KeyLocator,
+		actual.InternalKey.KeyLocator,
+	)
+	require.True(t, expected.InternalKey.PubKey.IsEqual(
+		actual.InternalKey.PubKey,
+	))
+
+	nodesEqual := func(a, b mssmt.Node) {
+		require.True(t, mssmt.IsEqualNode(a, b))
+	}
+	nodesEqual(expected.IssuanceRoot, actual.IssuanceRoot)
+	nodesEqual(expected.TransferRoot, actual.TransferRoot)
+	nodesEqual(expected.UniverseRoot, actual.UniverseRoot)
+}
+
+// TestUniverseCommitments tests that we can store universe commitments, and
+// query for the latest one as well as the earliest one that committed to a
+// given multiverse root.
+func TestUniverseCommitments(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	commitmentDB := newTestUniverseCommitmentDB(t)
+
+	// Without any commitments, we should get the expected error.
+	_, err := commitmentDB.LatestCommitment(ctx)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+
+	_, err = commitmentDB.FetchCommitmentByRoot(ctx, randMultiverseRoot())
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+
+	// We'll now make three commitments. The second one only updates the
+	// transfer root, so the issuance root is committed to twice.
+	var (
+		issuanceRoot1 = randMultiverseRoot()
+		issuanceRoot2 = randMultiverseRoot()
+		transferRoot1 = randMultiverseRoot()
+		transferRoot2 = randMultiverseRoot()
+		transferRoot3 = randMultiverseRoot()
+	)
+	commitment1 := randUniverseCommitment(
+		t, issuanceRoot1, transferRoot1, 100,
+	)
+	commitment2 := randUniverseCommitment(
+		t, issuanceRoot1, transferRoot2, 200,
+	)
+	commitment3 := randUniverseCommitment(
+		t, issuanceRoot2, transferRoot3, 300,
+	)
+
+	// We'll insert them out of order, to make sure we sort by height.
+	for _, c := range []*universe.Commitment{
+		commitment2, commitment3, commitment1,
+	} {
+		require.NoError(t, c.Verify(
+			proof.MockHeaderVerifier, proof.DefaultMerkleVerifier,
+		))
+		require.NoError(t, commitmentDB.InsertCommitment(ctx, c))
+	}
+
+	// The latest commitment should be the one with the highest height.
+	latest, err := commitmentDB.LatestCommitment(ctx)
+	require.NoError(t, err)
+	assertEqualCommitment(t, commitment3, latest)
+
+	// The stored commitment should still be valid.
+	require.NoError(t, latest.Verify(
+		proof.MockHeaderVerifier, proof.DefaultMerkleVerifier,
+	))
+
+	// Looking up the first issuance root should return the earliest
+	// commitment, even though it was committed to twice.
+	byRoot, err := commitmentDB.FetchCommitmentByRoot(ctx, issuanceRoot1)
+	require.NoError(t, err)
+	assertEqualCommitment(t, commitment1, byRoot)
+
+	// We should also be able to look up commitments by transfer root.
+	byRoot, err = commitmentDB.FetchCommitmentByRoot(ctx, transferRoot2)
+	require.NoError(t, err)
+	assertEqualCommitment(t, commitment2, byRoot)
+
+	// A root with the same hash but a different sum shouldn't match.
+	wrongSum := mssmt.NewComputedBranch(
+		transferRoot3.NodeHash(), transferRoot3.NodeSum()+1,
+	)
+	_, err = commitmentDB.FetchCommitmentByRoot(ctx, wrongSum)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+}
+
+// TestPendingUniverseCommitment tests that a pending universe commitment can be
+// stored along with its multiverse leaves, and that the leaves are moved over
+// to the confirmed commitment.
+func TestPendingUniverseCommitment(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	commitmentDB := newTestUniverseCommitmentDB(t)
+
+	_, err := commitmentDB.PendingCommitment(ctx)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+
+	// We'll create one issuance leaf of an ungrouped asset and one
+	// transfer leaf of a grouped asset.
+	randLeaf := func(id universe.Identifier) universe.MultiverseLeaf {
+		return universe.MultiverseLeaf{
+			ID: id,
+			LeafNode: mssmt.NewLeafNode(
+				test.RandBytes(32),
+				uint64(test.RandInt[uint32]()),
+			),
+		}
+	}
+	issuanceLeaf := randLeaf(universe.Identifier{
+		AssetID:   asset.RandID(t),
+		ProofType: universe.ProofTypeIssuance,
+	})
+	transferLeaf := randLeaf(universe.Identifier{
+		GroupKey:  test.RandPubKey(t),
+		ProofType: universe.ProofTypeTransfer,
+	})
+	leaves := []universe.MultiverseLeaf{issuanceLeaf, transferLeaf}
+
+	commitment := randUniverseCommitment(
+		t, randMultiverseRoot(), randMultiverseRoot(), 100,
+	)
+	commitment.HeightHint = 90
+
+	err = commitmentDB.InsertPendingCommitment(ctx, commitment, leaves)
+	require.NoError(t, err)
+
+	pending, err := commitmentDB.PendingCommitment(ctx)
+	require.NoError(t, err)
+	require.Equal(
+		t, commitment.AnchorTx.TxHash(), pending.AnchorTx.TxHash(),
+	)
+	require.Equal(t, commitment.HeightHint, pending.HeightHint)
+	require.Equal(t, commitment.ChainFees, pending.ChainFees)
+	require.Nil(t, pending.MerkleProof)
+	require.True(t, mssmt.IsEqualNode(
+		commitment.UniverseRoot, pending.UniverseRoot,
+	))
+
+	// As long as the commitment is pending, its leaves don't count.
+	_, _, err = commitmentDB.FetchCommitmentByLeaf(ctx, issuanceLeaf)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+
+	// Once confirmed, the commitment is no longer pending, and we can look
+	// it up by either of its leaves.
+	require.NoError(t, commitmentDB.InsertCommitment(ctx, commitment))
+
+	_, err = commitmentDB.PendingCommitment(ctx)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+
+	for _, leaf := range leaves {
+		byLeaf, typeLeaves, err := commitmentDB.FetchCommitmentByLeaf(
+			ctx, leaf,
+		)
+		require.NoError(t, err)
+		assertEqualCommitment(t, commitment, byLeaf)
+
+		require.Len(t, typeLeaves, 1)
+		require.Equal(t, leaf.ID.Bytes(), typeLeaves[0].ID.Bytes())
+		require.True(t, mssmt.IsEqualNode(
+			leaf.LeafNode, typeLeaves[0].LeafNode,
+		))
+	}
+
+	// A leaf with a different universe root doesn't match.
+	otherLeaf := randLeaf(issuanceLeaf.ID)
+	_, _, err = commitmentDB.FetchCommitmentByLeaf(ctx, otherLeaf)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+
+	// An abandoned pending commitment is removed along with its leaves.
+	abandoned := randUniverseCommitment(
+		t, randMultiverseRoot(), randMultiverseRoot(), 0,
+	)
+	err = commitmentDB.InsertPendingCommitment(
+		ctx, abandoned, []universe.MultiverseLeaf{otherLeaf},
+	)
+	require.NoError(t, err)
+	require.NoError(t, commitmentDB.DeletePendingCommitment(ctx))
+
+	_, err = commitmentDB.PendingCommitment(ctx)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+}
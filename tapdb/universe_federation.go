@@ -60,6 +60,14 @@ type (
 	// returned from a query.
 	FedUniSyncConfigs = sqlc.FederationUniSyncConfig
 
+	// UpsertFedServerSyncConfigParams is used to set the server specific
+	// federation sync configuration.
+	UpsertFedServerSyncConfigParams = sqlc.UpsertFederationServerSyncConfigParams
+
+	// FedServerSyncConfig is the server specific federation sync config
+	// returned from a query.
+	FedServerSyncConfig = sqlc.FederationServerSyncConfig
+
 	// QueryUniServersParams is used to query for universe servers.
 	QueryUniServersParams = sqlc.QueryUniverseServersParams
 )
@@ -123,6 +131,16 @@ type FederationSyncConfigStore interface {
 	// federation sync configs.
 	QueryFederationUniSyncConfigs(ctx context.Context) ([]FedUniSyncConfigs,
 		error)
+
+	// UpsertFederationServerSyncConfig inserts or updates a server
+	// specific federation sync config.
+	UpsertFederationServerSyncConfig(ctx context.Context,
+		arg UpsertFedServerSyncConfigParams) error
+
+	// QueryFederationServerSyncConfigs returns the set of server specific
+	// federation sync configs.
+	QueryFederationServerSyncConfigs(
+		ctx context.Context) ([]FedServerSyncConfig, error)
 }
 
 // UniverseServerStore is used to manage the set of Universe servers as part
@@ -179,6 +197,11 @@ type globalSyncCfgs = lnutils.SyncMap[
 	universe.ProofType, *universe.FedGlobalSyncConfig,
 ]
 
+// serverSyncCfgs is the cached set of server specific sync configs.
+type serverSyncCfgs struct {
+	configs []*universe.FedServerSyncConfig
+}
+
 // UniverseFederationDB is used to manage the set of universe servers by
 // sub-systems that need to manage syncing and pushing new proofs amongst the
 // federation set.
@@ -187,8 +210,9 @@ type UniverseFederationDB struct {
 
 	clock clock.Clock
 
-	globalCfg *atomic.Pointer[globalSyncCfgs]
-	assetCfgs *atomic.Pointer[assetSyncCfgs]
+	globalCfg  *atomic.Pointer[globalSyncCfgs]
+	assetCfgs  *atomic.Pointer[assetSyncCfgs]
+	serverCfgs *atomic.Pointer[serverSyncCfgs]
 }
 
 // NewUniverseFederationDB makes a new Universe federation DB.
@@ -196,18 +220,20 @@ func NewUniverseFederationDB(db BatchedUniverseServerStore,
 	clock clock.Clock) *UniverseFederationDB {
 
 	var (
-		globalCfgPtr atomic.Pointer[globalSyncCfgs]
-		assetCfgsPtr atomic.Pointer[assetSyncCfgs]
+		globalCfgPtr  atomic.Pointer[globalSyncCfgs]
+		assetCfgsPtr  atomic.Pointer[assetSyncCfgs]
+		serverCfgsPtr atomic.Pointer[serverSyncCfgs]
 	)
 
 	globalCfgPtr.Store(&globalSyncCfgs{})
 	assetCfgsPtr.Store(&assetSyncCfgs{})
 
 	return &UniverseFederationDB{
-		db:        db,
-		clock:     clock,
-		globalCfg: &globalCfgPtr,
-		assetCfgs: &assetCfgsPtr,
+		db:         db,
+		clock:      clock,
+		globalCfg:  &globalCfgPtr,
+		assetCfgs:  &assetCfgsPtr,
+		serverCfgs: &serverCfgsPtr,
 	}
 }
 
@@ -620,6 +646,7 @@ func (u *UniverseFederationDB) UpsertFederationSyncConfig(
 				ProofType:       config.ProofType.String(),
 				AllowSyncInsert: config.AllowSyncInsert,
 				AllowSyncExport: config.AllowSyncExport,
+				SyncQuorum:      int32(config.SyncQuorum),
 			}
 			err := db.UpsertFederationGlobalSyncConfig(ctx, params)
 			if err != nil {
@@ -748,6 +775,9 @@ func (u *UniverseFederationDB) QueryFederationSyncConfigs(
 					ProofType:       proofType,
 					AllowSyncInsert: config.AllowSyncInsert,
 					AllowSyncExport: config.AllowSyncExport,
+					SyncQuorum: uint32(
+						config.SyncQuorum,
+					),
 				}
 
 				//nolint:lll
@@ -826,6 +856,97 @@ func (u *UniverseFederationDB) QueryFederationSyncConfigs(
 	return globalConfigs, uniConfigs, nil
 }
 
+// UpsertFederationServerSyncConfigs upserts server specific federation sync
+// configs.
+func (u *UniverseFederationDB) UpsertFederationServerSyncConfigs(
+	ctx context.Context,
+	serverSyncConfigs []*universe.FedServerSyncConfig) error {
+
+	var writeTx UniverseFederationOptions
+	dbErr := u.db.ExecTx(ctx, &writeTx, func(db UniverseServerStore) error {
+		for _, config := range serverSyncConfigs {
+			serverHost := config.ServerAddr.HostStr()
+			if serverHost == "" {
+				return fmt.Errorf("server host must be set")
+			}
+
+			err := db.UpsertFederationServerSyncConfig(
+				ctx, UpsertFedServerSyncConfigParams{
+					ServerHost:      serverHost,
+					AllowSyncInsert: config.AllowSyncInsert,
+					AllowSyncExport: config.AllowSyncExport,
+					TrustWeight: int32(
+						config.TrustWeight,
+					),
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return dbErr
+	}
+
+	// We just updated the config, so wipe our cached version.
+	u.serverCfgs.Store(nil)
+
+	return nil
+}
+
+// QueryFederationServerSyncConfigs returns the server specific federation sync
+// configs.
+func (u *UniverseFederationDB) QueryFederationServerSyncConfigs(
+	ctx context.Context) ([]*universe.FedServerSyncConfig, error) {
+
+	// Check to see if our cache is populated, if so, then we can just
+	// return the configs directly.
+	if cached := u.serverCfgs.Load(); cached != nil {
+		return cached.configs, nil
+	}
+
+	var (
+		readTx        = NewUniverseFederationReadTx()
+		serverConfigs []*universe.FedServerSyncConfig
+	)
+	err := u.db.ExecTx(ctx, &readTx, func(db UniverseServerStore) error {
+		dbConfigs, err := db.QueryFederationServerSyncConfigs(ctx)
+		if err != nil {
+			return err
+		}
+
+		serverConfigs = make(
+			[]*universe.FedServerSyncConfig, 0, len(dbConfigs),
+		)
+		for _, config := range dbConfigs {
+			serverConfig := &universe.FedServerSyncConfig{
+				ServerAddr: universe.NewServerAddrFromStr(
+					config.ServerHost,
+				),
+				AllowSyncInsert: config.AllowSyncInsert,
+				AllowSyncExport: config.AllowSyncExport,
+				TrustWeight:     uint32(config.TrustWeight),
+			}
+			serverConfigs = append(serverConfigs, serverConfig)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Update our cache with what we've read from disk.
+	u.serverCfgs.Store(&serverSyncCfgs{
+		configs: serverConfigs,
+	})
+
+	return serverConfigs, nil
+}
+
 // Check at compile time that we implement the correct interfaces.
 var (
 	_ universe.FederationLog          = (*UniverseFederationDB)(nil)
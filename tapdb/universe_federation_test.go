@@ -513,3 +513,77 @@ func TestFederationConfigCRUD(t *testing.T) {
 	localCfg = fn.MakeSlice(groupNewCfg, assetCfg)
 	require.Equal(t, localCfg, dbLocalCfg)
 }
+
+// TestFederationServerConfigCRUD tests that we're able to properly update the
+// server specific federation configs and the global sync quorum.
+func TestFederationServerConfigCRUD(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	fedDB, _ := newTestFederationDb(t, testClock)
+
+	ctx := context.Background()
+
+	// Without any configs added, there should be no server configs.
+	dbServerCfgs, err := fedDB.QueryFederationServerSyncConfigs(ctx)
+	require.NoError(t, err)
+	require.Empty(t, dbServerCfgs)
+
+	// A read-only and a write-only server are stored and returned sorted
+	// by their host.
+	readOnlyCfg := &universe.FedServerSyncConfig{
+		ServerAddr:      universe.NewServerAddrFromStr("a.example.com"),
+		AllowSyncInsert: true,
+		TrustWeight:     3,
+	}
+	writeOnlyCfg := &universe.FedServerSyncConfig{
+		ServerAddr:      universe.NewServerAddrFromStr("b.example.com"),
+		AllowSyncExport: true,
+	}
+	serverCfgs := fn.MakeSlice(readOnlyCfg, writeOnlyCfg)
+	err = fedDB.UpsertFederationServerSyncConfigs(ctx, serverCfgs)
+	require.NoError(t, err)
+
+	dbServerCfgs, err = fedDB.QueryFederationServerSyncConfigs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, serverCfgs, dbServerCfgs)
+
+	// We should be able to overwrite a stored config.
+	newReadOnlyCfg := &universe.FedServerSyncConfig{
+		ServerAddr:      readOnlyCfg.ServerAddr,
+		AllowSyncInsert: true,
+		AllowSyncExport: true,
+		TrustWeight:     5,
+	}
+	err = fedDB.UpsertFederationServerSyncConfigs(
+		ctx, fn.MakeSlice(newReadOnlyCfg),
+	)
+	require.NoError(t, err)
+
+	dbServerCfgs, err = fedDB.QueryFederationServerSyncConfigs(ctx)
+	require.NoError(t, err)
+	require.Equal(
+		t, fn.MakeSlice(newReadOnlyCfg, writeOnlyCfg), dbServerCfgs,
+	)
+
+	// A config without a server host is rejected.
+	err = fedDB.UpsertFederationServerSyncConfigs(
+		ctx, fn.MakeSlice(&universe.FedServerSyncConfig{}),
+	)
+	require.ErrorContains(t, err, "server host must be set")
+
+	// Finally, the sync quorum is stored with the global config.
+	quorumCfg := fn.MakeSlice(&universe.FedGlobalSyncConfig{
+		ProofType:       universe.ProofTypeIssuance,
+		AllowSyncInsert: true,
+		SyncQuorum:      4,
+	})
+	err = fedDB.UpsertFederationSyncConfig(ctx, quorumCfg, nil)
+	require.NoError(t, err)
+
+	dbGlobalCfg, _, err := fedDB.QueryFederationSyncConfigs(ctx)
+	require.NoError(t, err)
+	require.Equal(
+		t, append(quorumCfg, defaultGlobalSyncConfigs[1]), dbGlobalCfg,
+	)
+}
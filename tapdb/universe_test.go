@@ -1073,3 +1073,76 @@ func TestMultiverseRootSum(t *testing.T) {
 		})
 	}
 }
+
+// TestMultiverseTreeDiff tests that the nodes of a universe tree can be walked
+// top-down and that the leaves found that way can be mapped back to their
+// universe leaf keys.
+func TestMultiverseTreeDiff(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	multiverse, _ := newTestMultiverse(t)
+
+	id := randUniverseID(t, false)
+	id.GroupKey = nil
+
+	const numLeaves = 10
+	leafKeys := make(map[[32]byte]universe.LeafKey, numLeaves)
+	for i := 0; i < numLeaves; i++ {
+		assetGen := asset.RandGenesis(t, asset.Normal)
+		leaf := randMintingLeaf(t, assetGen, nil)
+		targetKey := randLeafKey(t)
+
+		_, err := multiverse.UpsertProofLeaf(
+			ctx, id, targetKey, &leaf, nil,
+		)
+		require.NoError(t, err)
+
+		leafKeys[targetKey.UniverseKey()] = targetKey
+	}
+
+	rootNode, err := multiverse.UniverseRootNode(ctx, id)
+	require.NoError(t, err)
+
+	// Walking the tree without a local tree to compare against must find
+	// all the leaves of the universe.
+	fetchChildren := func(ctx context.Context, height int,
+		nodes []mssmt.NodeHash) ([][2]mssmt.DiffNode, error) {
+
+		return multiverse.UniverseDiffChildren(ctx, id, height, nodes)
+	}
+	nodeKeys, err := mssmt.DiffLeafKeys(
+		ctx, nil, rootNode.Node, fetchChildren, fetchChildren,
+	)
+	require.NoError(t, err)
+	require.Len(t, nodeKeys, numLeaves)
+
+	// Each of the found leaves maps back to the key it was inserted with.
+	foundKeys, err := multiverse.UniverseLeafKeysByNodeKey(
+		ctx, id, nodeKeys,
+	)
+	require.NoError(t, err)
+	require.Len(t, foundKeys, numLeaves)
+	for idx, foundKey := range foundKeys {
+		leafKey, ok := leafKeys[nodeKeys[idx]]
+		require.True(t, ok)
+
+		require.Equal(t, leafKey.OutPoint, foundKey.OutPoint)
+		require.True(t, leafKey.ScriptKey.PubKey.IsEqual(
+			foundKey.ScriptKey.PubKey,
+		))
+	}
+
+	// A node key that isn't part of the tree can't be found.
+	_, err = multiverse.UniverseLeafKeysByNodeKey(
+		ctx, id, [][32]byte{test.RandHash()},
+	)
+	require.ErrorIs(t, err, universe.ErrNoUniverseProofFound)
+
+	// And there are no branches at or below the leaf level.
+	_, err = multiverse.UniverseDiffChildren(
+		ctx, id, mssmt.MaxTreeLevels,
+		[]mssmt.NodeHash{rootNode.NodeHash()},
+	)
+	require.ErrorContains(t, err, "invalid branch height")
+}
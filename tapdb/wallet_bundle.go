@@ -0,0 +1,1215 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// WalletBundleVersion denotes the versioning scheme for wallet bundles.
+type WalletBundleVersion uint32
+
+const (
+	// WalletBundleV0 is the first version of the wallet bundle.
+	WalletBundleV0 WalletBundleVersion = 0
+
+	// walletBundleMaxElements is the maximum number of elements we allow in
+	// a single section of a wallet bundle, to avoid OOM attacks.
+	walletBundleMaxElements = 10_000_000
+
+	// walletBundleMaxElementSize is the maximum size of a single element of
+	// a wallet bundle. The largest elements are proof files, so we use the
+	// same limit.
+	walletBundleMaxElementSize = proof.FileMaxSizeBytes
+)
+
+var (
+	// walletBundleMagicBytes are the magic bytes that identify an encoded
+	// wallet bundle ("TAPW").
+	walletBundleMagicBytes = [4]byte{0x54, 0x41, 0x50, 0x57}
+
+	// ErrPendingTransfers is returned when a wallet is exported while it
+	// still has unconfirmed outbound transfers.
+	ErrPendingTransfers = errors.New("wallet has unconfirmed transfers")
+
+	// ErrWalletNotEmpty is returned when a wallet bundle is imported into a
+	// wallet that already holds assets or transfers that aren't part of
+	// the bundle.
+	ErrWalletNotEmpty = errors.New("wallet bundle can only be imported " +
+		"into an empty wallet")
+
+	// ErrUnknownWalletBundleVersion is returned when a wallet bundle with
+	// an unknown version is decoded.
+	ErrUnknownWalletBundleVersion = errors.New("unknown wallet bundle " +
+		"version")
+)
+
+// WalletProof is a proof file of an asset held by the wallet.
+type WalletProof struct {
+	// Blob is the full proof file of the asset.
+	Blob proof.Blob
+
+	// Spent indicates that the asset was already spent.
+	Spent bool
+}
+
+// WalletTransfer is a confirmed outbound transfer of the wallet.
+type WalletTransfer struct {
+	// Parcel is the outbound parcel of the transfer.
+	Parcel *tapfreighter.OutboundParcel
+
+	// BlockHash is the hash of the block the anchor transaction of the
+	// transfer was confirmed in.
+	BlockHash chainhash.Hash
+
+	// BlockHeight is the height of the block the anchor transaction of the
+	// transfer was confirmed in.
+	BlockHeight uint32
+
+	// TxIndex is the index of the anchor transaction within its block.
+	TxIndex uint32
+}
+
+// WalletBundle is a portable snapshot of the state of an asset wallet. It
+// holds everything that is needed to rebuild the asset database of a wallet on
+// another host: the proofs of all assets, the derivation information of all
+// internal and script keys and the history of all outbound transfers.
+type WalletBundle struct {
+	// Version is the version of the wallet bundle.
+	Version WalletBundleVersion
+
+	// Proofs is the list of proof files of all assets of the wallet,
+	// including the spent ones.
+	Proofs []*WalletProof
+
+	// InternalKeys is the list of all internal keys known to the wallet.
+	InternalKeys []keychain.KeyDescriptor
+
+	// ScriptKeys is the list of all script keys known to the wallet,
+	// including the derivation information of their internal key.
+	ScriptKeys []asset.ScriptKey
+
+	// Transfers is the list of all confirmed outbound transfers.
+	Transfers []*WalletTransfer
+}
+
+// Encode encodes the wallet bundle into `w`.
+func (b *WalletBundle) Encode(w io.Writer) error {
+	if _, err := w.Write(walletBundleMagicBytes[:]); err != nil {
+		return err
+	}
+
+	err := binary.Write(w, binary.BigEndian, uint32(b.Version))
+	if err != nil {
+		return err
+	}
+
+	proofs, err := fn.MapErr(b.Proofs, encodeWalletProof)
+	if err != nil {
+		return fmt.Errorf("unable to encode proofs: %w", err)
+	}
+	internalKeys, err := fn.MapErr(b.InternalKeys, encodeKeyDescriptor)
+	if err != nil {
+		return fmt.Errorf("unable to encode internal keys: %w", err)
+	}
+	scriptKeys, err := fn.MapErr(b.ScriptKeys, encodeWalletScriptKey)
+	if err != nil {
+		return fmt.Errorf("unable to encode script keys: %w", err)
+	}
+	transfers, err := fn.MapErr(b.Transfers, encodeWalletTransfer)
+	if err != nil {
+		return fmt.Errorf("unable to encode transfers: %w", err)
+	}
+
+	sections := [][][]byte{proofs, internalKeys, scriptKeys, transfers}
+	for _, section := range sections {
+		if err := writeBundleElements(w, section); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Decode decodes a wallet bundle from `r`.
+func (b *WalletBundle) Decode(r io.Reader) error {
+	var magicBytes [4]byte
+	if _, err := io.ReadFull(r, magicBytes[:]); err != nil {
+		return err
+	}
+	if magicBytes != walletBundleMagicBytes {
+		return fmt.Errorf("invalid prefix magic bytes, expected %s, "+
+			"got %s", string(walletBundleMagicBytes[:]),
+			string(magicBytes[:]))
+	}
+
+	var version uint32
+	if err := binary.Read(r, binary.BigEndian, &version); err != nil {
+		return err
+	}
+	b.Version = WalletBundleVersion(version)
+	if b.Version != WalletBundleV0 {
+		return fmt.Errorf("%w: %d", ErrUnknownWalletBundleVersion,
+			b.Version)
+	}
+
+	proofs, err := readBundleElements(r)
+	if err != nil {
+		return fmt.Errorf("unable to read proofs: %w", err)
+	}
+	b.Proofs, err = fn.MapErr(proofs, decodeWalletProof)
+	if err != nil {
+		return fmt.Errorf("unable to decode proofs: %w", err)
+	}
+
+	internalKeys, err := readBundleElements(r)
+	if err != nil {
+		return fmt.Errorf("unable to read internal keys: %w", err)
+	}
+	b.InternalKeys, err = fn.MapErr(internalKeys, decodeKeyDescriptor)
+	if err != nil {
+		return fmt.Errorf("unable to decode internal keys: %w", err)
+	}
+
+	scriptKeys, err := readBundleElements(r)
+	if err != nil {
+		return fmt.Errorf("unable to read script keys: %w", err)
+	}
+	b.ScriptKeys, err = fn.MapErr(scriptKeys, decodeWalletScriptKey)
+	if err != nil {
+		return fmt.Errorf("unable to decode script keys: %w", err)
+	}
+
+	transfers, err := readBundleElements(r)
+	if err != nil {
+		return fmt.Errorf("unable to read transfers: %w", err)
+	}
+	b.Transfers, err = fn.MapErr(transfers, decodeWalletTransfer)
+	if err != nil {
+		return fmt.Errorf("unable to decode transfers: %w", err)
+	}
+
+	return nil
+}
+
+// DecodeWalletBundle decodes a wallet bundle from the given bytes.
+func DecodeWalletBundle(blob []byte) (*WalletBundle, error) {
+	var b WalletBundle
+	if err := b.Decode(bytes.NewReader(blob)); err != nil {
+		return nil, err
+	}
+
+	return &b, nil
+}
+
+// writeBundleElements writes the number of elements, followed by each element
+// prefixed with its length, to `w`.
+func writeBundleElements(w io.Writer, elements [][]byte) error {
+	var tlvBuf [8]byte
+	err := tlv.WriteVarInt(w, uint64(len(elements)), &tlvBuf)
+	if err != nil {
+		return err
+	}
+
+	for _, element := range elements {
+		err := tlv.WriteVarInt(w, uint64(len(element)), &tlvBuf)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(element); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readBundleElements reads a list of elements that was written with
+// writeBundleElements from `r`.
+func readBundleElements(r io.Reader) ([][]byte, error) {
+	var tlvBuf [8]byte
+	numElements, err := tlv.ReadVarInt(r, &tlvBuf)
+	if err != nil {
+		return nil, err
+	}
+	if numElements > walletBundleMaxElements {
+		return nil, fmt.Errorf("too many elements: %d", numElements)
+	}
+
+	var elements [][]byte
+	for i := uint64(0); i < numElements; i++ {
+		numBytes, err := tlv.ReadVarInt(r, &tlvBuf)
+		if err != nil {
+			return nil, err
+		}
+		if numBytes > walletBundleMaxElementSize {
+			return nil, fmt.Errorf("element too large: %d bytes",
+				numBytes)
+		}
+
+		element := make([]byte, numBytes)
+		if _, err := io.ReadFull(r, element); err != nil {
+			return nil, err
+		}
+		elements = append(elements, element)
+	}
+
+	return elements, nil
+}
+
+// encodeRecords encodes the given records as a TLV stream.
+func encodeRecords(records ...tlv.Record) ([]byte, error) {
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeRecords decodes the given TLV stream into the given records and
+// returns the types that were present in the stream.
+func decodeRecords(blob []byte, records ...tlv.Record) (tlv.TypeMap, error) {
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	return stream.DecodeWithParsedTypes(bytes.NewReader(blob))
+}
+
+// boolToUint8 converts a boolean to its TLV representation.
+func boolToUint8(b bool) uint8 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+// encodeWalletProof encodes a wallet proof as a TLV stream.
+func encodeWalletProof(p *WalletProof) ([]byte, error) {
+	blob := []byte(p.Blob)
+	spent := boolToUint8(p.Spent)
+
+	return encodeRecords(
+		tlv.MakePrimitiveRecord(0, &blob),
+		tlv.MakePrimitiveRecord(2, &spent),
+	)
+}
+
+// decodeWalletProof decodes a wallet proof from a TLV stream.
+func decodeWalletProof(blob []byte) (*WalletProof, error) {
+	var (
+		proofBlob []byte
+		spent     uint8
+	)
+	_, err := decodeRecords(
+		blob, tlv.MakePrimitiveRecord(0, &proofBlob),
+		tlv.MakePrimitiveRecord(2, &spent),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WalletProof{
+		Blob:  proofBlob,
+		Spent: spent != 0,
+	}, nil
+}
+
+// encodeKeyDescriptor encodes a key descriptor as a TLV stream.
+func encodeKeyDescriptor(keyDesc keychain.KeyDescriptor) ([]byte, error) {
+	if keyDesc.PubKey == nil {
+		return nil, fmt.Errorf("key descriptor is missing public key")
+	}
+
+	pubKey := keyDesc.PubKey
+	family := uint32(keyDesc.Family)
+	index := keyDesc.Index
+
+	return encodeRecords(
+		tlv.MakePrimitiveRecord(0, &pubKey),
+		tlv.MakePrimitiveRecord(2, &family),
+		tlv.MakePrimitiveRecord(4, &index),
+	)
+}
+
+// decodeKeyDescriptor decodes a key descriptor from a TLV stream.
+func decodeKeyDescriptor(blob []byte) (keychain.KeyDescriptor, error) {
+	var (
+		pubKey        *btcec.PublicKey
+		family, index uint32
+	)
+	_, err := decodeRecords(
+		blob, tlv.MakePrimitiveRecord(0, &pubKey),
+		tlv.MakePrimitiveRecord(2, &family),
+		tlv.MakePrimitiveRecord(4, &index),
+	)
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	return keychain.KeyDescriptor{
+		PubKey: pubKey,
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamily(family),
+			Index:  index,
+		},
+	}, nil
+}
+
+// encodeWalletScriptKey encodes a script key, including the derivation
+// information of its internal key, as a TLV stream.
+func encodeWalletScriptKey(scriptKey asset.ScriptKey) ([]byte, error) {
+	if scriptKey.PubKey == nil || scriptKey.TweakedScriptKey == nil {
+		return nil, fmt.Errorf("script key is missing tweak info")
+	}
+
+	rawKey, err := encodeKeyDescriptor(scriptKey.RawKey)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey := scriptKey.PubKey
+	tweak := scriptKey.Tweak
+	declaredKnown := boolToUint8(scriptKey.DeclaredKnown)
+
+	return encodeRecords(
+		tlv.MakePrimitiveRecord(0, &pubKey),
+		tlv.MakePrimitiveRecord(2, &rawKey),
+		tlv.MakePrimitiveRecord(4, &tweak),
+		tlv.MakePrimitiveRecord(6, &declaredKnown),
+	)
+}
+
+// decodeWalletScriptKey decodes a script key from a TLV stream.
+func decodeWalletScriptKey(blob []byte) (asset.ScriptKey, error) {
+	var (
+		pubKey         *btcec.PublicKey
+		rawKey, tweak  []byte
+		declaredKnown  uint8
+		emptyScriptKey asset.ScriptKey
+	)
+	_, err := decodeRecords(
+		blob, tlv.MakePrimitiveRecord(0, &pubKey),
+		tlv.MakePrimitiveRecord(2, &rawKey),
+		tlv.MakePrimitiveRecord(4, &tweak),
+		tlv.MakePrimitiveRecord(6, &declaredKnown),
+	)
+	if err != nil {
+		return emptyScriptKey, err
+	}
+
+	rawKeyDesc, err := decodeKeyDescriptor(rawKey)
+	if err != nil {
+		return emptyScriptKey, err
+	}
+
+	// An empty tweak is stored as NULL in the database, which means a
+	// BIP-0086 tweak.
+	if len(tweak) == 0 {
+		tweak = nil
+	}
+
+	return asset.ScriptKey{
+		PubKey: pubKey,
+		TweakedScriptKey: &asset.TweakedScriptKey{
+			RawKey:        rawKeyDesc,
+			Tweak:         tweak,
+			DeclaredKnown: declaredKnown != 0,
+		},
+	}, nil
+}
+
+// encodeWalletTransfer encodes a confirmed transfer as a TLV stream.
+func encodeWalletTransfer(t *WalletTransfer) ([]byte, error) {
+	parcel := t.Parcel
+
+	var txBuf bytes.Buffer
+	if err := parcel.AnchorTx.Serialize(&txBuf); err != nil {
+		return nil, err
+	}
+	anchorTx := txBuf.Bytes()
+
+	inputs, err := fn.MapErr(parcel.Inputs, encodeTransferInput)
+	if err != nil {
+		return nil, err
+	}
+	outputs, err := fn.MapErr(parcel.Outputs, encodeTransferOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	var inputsBuf, outputsBuf bytes.Buffer
+	if err := writeBundleElements(&inputsBuf, inputs); err != nil {
+		return nil, err
+	}
+	if err := writeBundleElements(&outputsBuf, outputs); err != nil {
+		return nil, err
+	}
+	encodedInputs, encodedOutputs := inputsBuf.Bytes(), outputsBuf.Bytes()
+
+	var (
+		heightHint   = parcel.AnchorTxHeightHint
+		transferTime = uint64(parcel.TransferTime.UnixNano())
+		chainFees    = uint64(parcel.ChainFees)
+		blockHash    = [32]byte(t.BlockHash)
+		blockHeight  = t.BlockHeight
+		txIndex      = t.TxIndex
+	)
+
+	return encodeRecords(
+		tlv.MakePrimitiveRecord(0, &anchorTx),
+		tlv.MakePrimitiveRecord(2, &heightHint),
+		tlv.MakePrimitiveRecord(4, &transferTime),
+		tlv.MakePrimitiveRecord(6, &chainFees),
+		tlv.MakePrimitiveRecord(8, &blockHash),
+		tlv.MakePrimitiveRecord(10, &blockHeight),
+		tlv.MakePrimitiveRecord(12, &txIndex),
+		tlv.MakePrimitiveRecord(14, &encodedInputs),
+		tlv.MakePrimitiveRecord(16, &encodedOutputs),
+	)
+}
+
+// decodeWalletTransfer decodes a confirmed transfer from a TLV stream.
+func decodeWalletTransfer(blob []byte) (*WalletTransfer, error) {
+	var (
+		anchorTx, encodedInputs, encodedOutputs []byte
+		heightHint, blockHeight, txIndex        uint32
+		transferTime, chainFees                 uint64
+		blockHash                               [32]byte
+	)
+	_, err := decodeRecords(
+		blob, tlv.MakePrimitiveRecord(0, &anchorTx),
+		tlv.MakePrimitiveRecord(2, &heightHint),
+		tlv.MakePrimitiveRecord(4, &transferTime),
+		tlv.MakePrimitiveRecord(6, &chainFees),
+		tlv.MakePrimitiveRecord(8, &blockHash),
+		tlv.MakePrimitiveRecord(10, &blockHeight),
+		tlv.MakePrimitiveRecord(12, &txIndex),
+		tlv.MakePrimitiveRecord(14, &encodedInputs),
+		tlv.MakePrimitiveRecord(16, &encodedOutputs),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := wire.NewMsgTx(2)
+	if err := tx.Deserialize(bytes.NewReader(anchorTx)); err != nil {
+		return nil, fmt.Errorf("unable to decode anchor tx: %w", err)
+	}
+
+	inputs, err := readBundleElements(bytes.NewReader(encodedInputs))
+	if err != nil {
+		return nil, err
+	}
+	outputs, err := readBundleElements(bytes.NewReader(encodedOutputs))
+	if err != nil {
+		return nil, err
+	}
+
+	parcel := &tapfreighter.OutboundParcel{
+		AnchorTx:           tx,
+		AnchorTxHeightHint: heightHint,
+		TransferTime:       time.Unix(0, int64(transferTime)).UTC(),
+		ChainFees:          int64(chainFees),
+	}
+	parcel.Inputs, err = fn.MapErr(inputs, decodeTransferInput)
+	if err != nil {
+		return nil, err
+	}
+	parcel.Outputs, err = fn.MapErr(outputs, decodeTransferOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WalletTransfer{
+		Parcel:      parcel,
+		BlockHash:   blockHash,
+		BlockHeight: blockHeight,
+		TxIndex:     txIndex,
+	}, nil
+}
+
+// encodeTransferInput encodes a transfer input as a TLV stream.
+func encodeTransferInput(input tapfreighter.TransferInput) ([]byte, error) {
+	outPoint, err := encodeOutpoint(input.OutPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		assetID   = [32]byte(input.ID)
+		scriptKey = [33]byte(input.ScriptKey)
+		amount    = input.Amount
+	)
+
+	return encodeRecords(
+		tlv.MakePrimitiveRecord(0, &outPoint),
+		tlv.MakePrimitiveRecord(2, &assetID),
+		tlv.MakePrimitiveRecord(4, &scriptKey),
+		tlv.MakePrimitiveRecord(6, &amount),
+	)
+}
+
+// decodeTransferInput decodes a transfer input from a TLV stream.
+func decodeTransferInput(blob []byte) (tapfreighter.TransferInput, error) {
+	var (
+		input     tapfreighter.TransferInput
+		outPoint  []byte
+		assetID   [32]byte
+		scriptKey [33]byte
+	)
+	_, err := decodeRecords(
+		blob, tlv.MakePrimitiveRecord(0, &outPoint),
+		tlv.MakePrimitiveRecord(2, &assetID),
+		tlv.MakePrimitiveRecord(4, &scriptKey),
+		tlv.MakePrimitiveRecord(6, &input.Amount),
+	)
+	if err != nil {
+		return input, err
+	}
+
+	err = readOutPoint(bytes.NewReader(outPoint), 0, 0, &input.OutPoint)
+	if err != nil {
+		return input, err
+	}
+	input.ID = assetID
+	input.ScriptKey = scriptKey
+
+	return input, nil
+}
+
+// encodeTransferOutput encodes a transfer output as a TLV stream.
+func encodeTransferOutput(output tapfreighter.TransferOutput) ([]byte,
+	error) {
+
+	anchor := output.Anchor
+	outPoint, err := encodeOutpoint(anchor.OutPoint)
+	if err != nil {
+		return nil, err
+	}
+	internalKey, err := encodeKeyDescriptor(anchor.InternalKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var witnessBuf bytes.Buffer
+	err = asset.WitnessEncoder(&witnessBuf, &output.WitnessData, &[8]byte{})
+	if err != nil {
+		return nil, err
+	}
+	witnesses := witnessBuf.Bytes()
+
+	var (
+		anchorValue      = uint64(anchor.Value)
+		taprootAssetRoot = anchor.TaprootAssetRoot
+		merkleRoot       = anchor.MerkleRoot
+		tapscriptSibling = anchor.TapscriptSibling
+		numPassiveAssets = anchor.NumPassiveAssets
+		outputType       = uint8(output.Type)
+		scriptKey        = output.ScriptKey.PubKey
+		scriptKeyLocal   = boolToUint8(output.ScriptKeyLocal)
+		amount           = output.Amount
+		lockTime         = output.LockTime
+		relativeLockTime = output.RelativeLockTime
+		assetVersion     = uint8(output.AssetVersion)
+		proofSuffix      = output.ProofSuffix
+		proofCourierAddr = output.ProofCourierAddr
+	)
+	records := []tlv.Record{
+		tlv.MakePrimitiveRecord(0, &outPoint),
+		tlv.MakePrimitiveRecord(2, &anchorValue),
+		tlv.MakePrimitiveRecord(4, &internalKey),
+		tlv.MakePrimitiveRecord(6, &taprootAssetRoot),
+	}
+
+	// The commitment version is optional, so we only encode it if set.
+	if anchor.CommitmentVersion != nil {
+		commitmentVersion := *anchor.CommitmentVersion
+		records = append(
+			records, tlv.MakePrimitiveRecord(8, &commitmentVersion),
+		)
+	}
+
+	records = append(
+		records, tlv.MakePrimitiveRecord(10, &merkleRoot),
+		tlv.MakePrimitiveRecord(12, &tapscriptSibling),
+		tlv.MakePrimitiveRecord(14, &numPassiveAssets),
+		tlv.MakePrimitiveRecord(16, &outputType),
+		tlv.MakePrimitiveRecord(18, &scriptKey),
+		tlv.MakePrimitiveRecord(20, &scriptKeyLocal),
+		tlv.MakePrimitiveRecord(22, &amount),
+		tlv.MakePrimitiveRecord(24, &lockTime),
+		tlv.MakePrimitiveRecord(26, &relativeLockTime),
+		tlv.MakePrimitiveRecord(28, &assetVersion),
+		tlv.MakePrimitiveRecord(30, &witnesses),
+	)
+
+	// There might not have been a split, so the split commitment root is
+	// optional as well. The DB returns an empty root in that case.
+	var emptyHash mssmt.NodeHash
+	splitRoot := output.SplitCommitmentRoot
+	if splitRoot != nil && splitRoot.NodeHash() != emptyHash {
+		splitRootHash := [32]byte(splitRoot.NodeHash())
+		splitRootSum := splitRoot.NodeSum()
+		records = append(
+			records, tlv.MakePrimitiveRecord(32, &splitRootHash),
+			tlv.MakePrimitiveRecord(33, &splitRootSum),
+		)
+	}
+
+	records = append(
+		records, tlv.MakePrimitiveRecord(34, &proofSuffix),
+		tlv.MakePrimitiveRecord(36, &proofCourierAddr),
+	)
+
+	return encodeRecords(records...)
+}
+
+// decodeTransferOutput decodes a transfer output from a TLV stream.
+func decodeTransferOutput(blob []byte) (tapfreighter.TransferOutput, error) {
+	var (
+		output                       tapfreighter.TransferOutput
+		outPoint, internalKey        []byte
+		witnesses                    []byte
+		anchorValue, splitRootSum    uint64
+		commitmentVersion, outType   uint8
+		scriptKeyLocal, assetVersion uint8
+		splitRootHash                [32]byte
+		scriptKey                    *btcec.PublicKey
+	)
+	anchor := &output.Anchor
+	parsedTypes, err := decodeRecords(
+		blob, tlv.MakePrimitiveRecord(0, &outPoint),
+		tlv.MakePrimitiveRecord(2, &anchorValue),
+		tlv.MakePrimitiveRecord(4, &internalKey),
+		tlv.MakePrimitiveRecord(6, &anchor.TaprootAssetRoot),
+		tlv.MakePrimitiveRecord(8, &commitmentVersion),
+		tlv.MakePrimitiveRecord(10, &anchor.MerkleRoot),
+		tlv.MakePrimitiveRecord(12, &anchor.TapscriptSibling),
+		tlv.MakePrimitiveRecord(14, &anchor.NumPassiveAssets),
+		tlv.MakePrimitiveRecord(16, &outType),
+		tlv.MakePrimitiveRecord(18, &scriptKey),
+		tlv.MakePrimitiveRecord(20, &scriptKeyLocal),
+		tlv.MakePrimitiveRecord(22, &output.Amount),
+		tlv.MakePrimitiveRecord(24, &output.LockTime),
+		tlv.MakePrimitiveRecord(26, &output.RelativeLockTime),
+		tlv.MakePrimitiveRecord(28, &assetVersion),
+		tlv.MakePrimitiveRecord(30, &witnesses),
+		tlv.MakePrimitiveRecord(32, &splitRootHash),
+		tlv.MakePrimitiveRecord(33, &splitRootSum),
+		tlv.MakePrimitiveRecord(34, &output.ProofSuffix),
+		tlv.MakePrimitiveRecord(36, &output.ProofCourierAddr),
+	)
+	if err != nil {
+		return output, err
+	}
+
+	err = readOutPoint(bytes.NewReader(outPoint), 0, 0, &anchor.OutPoint)
+	if err != nil {
+		return output, err
+	}
+	anchor.Value = btcutil.Amount(anchorValue)
+	anchor.InternalKey, err = decodeKeyDescriptor(internalKey)
+	if err != nil {
+		return output, err
+	}
+	if _, ok := parsedTypes[8]; ok {
+		anchor.CommitmentVersion = fn.Ptr(commitmentVersion)
+	}
+
+	err = asset.WitnessDecoder(
+		bytes.NewReader(witnesses), &output.WitnessData, &[8]byte{},
+		uint64(len(witnesses)),
+	)
+	if err != nil {
+		return output, fmt.Errorf("unable to decode witness: %w", err)
+	}
+
+	if _, ok := parsedTypes[32]; ok {
+		output.SplitCommitmentRoot = mssmt.NewComputedNode(
+			splitRootHash, splitRootSum,
+		)
+	}
+
+	output.Type = tappsbt.VOutputType(outType)
+	output.ScriptKey = asset.NewScriptKey(scriptKey)
+	output.ScriptKeyLocal = scriptKeyLocal != 0
+	output.AssetVersion = asset.Version(assetVersion)
+
+	return output, nil
+}
+
+// ExportWallet exports the proofs of all assets, the derivation information of
+// all internal and script keys and the history of all outbound transfers into
+// a wallet bundle. A wallet with unconfirmed outbound transfers can't be
+// exported, as the state of the assets involved isn't final yet.
+func (a *AssetStore) ExportWallet(ctx context.Context) (*WalletBundle,
+	error) {
+
+	pendingParcels, err := a.PendingParcels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query pending parcels: %w",
+			err)
+	}
+	if len(pendingParcels) > 0 {
+		return nil, fmt.Errorf("%w: %d transfers need to confirm "+
+			"first", ErrPendingTransfers, len(pendingParcels))
+	}
+
+	parcels, err := a.QueryParcels(ctx, nil, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query parcels: %w", err)
+	}
+
+	var bundle *WalletBundle
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		bundle = &WalletBundle{
+			Version: WalletBundleV0,
+		}
+
+		dbProofs, err := q.FetchAllAssetProofs(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to fetch asset proofs: %w",
+				err)
+		}
+		for _, dbProof := range dbProofs {
+			bundle.Proofs = append(bundle.Proofs, &WalletProof{
+				Blob:  dbProof.ProofFile,
+				Spent: dbProof.Spent,
+			})
+		}
+
+		dbInternalKeys, err := q.AllInternalKeys(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to fetch internal keys: %w",
+				err)
+		}
+		for _, dbKey := range dbInternalKeys {
+			keyDesc, err := parseInternalKey(
+				dbKey.RawKey, dbKey.KeyFamily, dbKey.KeyIndex,
+			)
+			if err != nil {
+				return err
+			}
+			bundle.InternalKeys = append(
+				bundle.InternalKeys, keyDesc,
+			)
+		}
+
+		dbScriptKeys, err := q.FetchAllScriptKeys(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to fetch script keys: %w",
+				err)
+		}
+		for _, dbKey := range dbScriptKeys {
+			tweakedKey, err := btcec.ParsePubKey(
+				dbKey.TweakedScriptKey,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to parse script "+
+					"key: %w", err)
+			}
+			rawKey, err := parseInternalKey(
+				dbKey.RawKey, dbKey.KeyFamily, dbKey.KeyIndex,
+			)
+			if err != nil {
+				return err
+			}
+
+			declaredKnown := dbKey.DeclaredKnown.Valid
+			scriptKey := asset.ScriptKey{
+				PubKey: tweakedKey,
+				TweakedScriptKey: &asset.TweakedScriptKey{
+					RawKey:        rawKey,
+					Tweak:         dbKey.Tweak,
+					DeclaredKnown: declaredKnown,
+				},
+			}
+			bundle.ScriptKeys = append(bundle.ScriptKeys, scriptKey)
+		}
+
+		// The parcels don't carry the confirmation information of
+		// their anchor transaction, so we look it up separately.
+		for _, parcel := range parcels {
+			anchorTXID := parcel.AnchorTx.TxHash()
+			chainTx, err := q.FetchChainTx(ctx, anchorTXID[:])
+			if err != nil {
+				return fmt.Errorf("unable to fetch chain tx: "+
+					"%w", err)
+			}
+
+			transfer := &WalletTransfer{
+				Parcel: parcel,
+				BlockHeight: extractSqlInt32[uint32](
+					chainTx.BlockHeight,
+				),
+				TxIndex: extractSqlInt32[uint32](
+					chainTx.TxIndex,
+				),
+			}
+			copy(transfer.BlockHash[:], chainTx.BlockHash)
+
+			bundle.Transfers = append(bundle.Transfers, transfer)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return bundle, nil
+}
+
+// parseInternalKey parses a raw internal key and its derivation information
+// into a key descriptor.
+func parseInternalKey(rawKey []byte, family,
+	index int32) (keychain.KeyDescriptor, error) {
+
+	pubKey, err := btcec.ParsePubKey(rawKey)
+	if err != nil {
+		return keychain.KeyDescriptor{}, fmt.Errorf("unable to parse "+
+			"internal key: %w", err)
+	}
+
+	return keychain.KeyDescriptor{
+		PubKey: pubKey,
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamily(family),
+			Index:  uint32(index),
+		},
+	}, nil
+}
+
+// ImportWallet restores a wallet from the given bundle. The given proofs must
+// be the verified proofs of the bundle, in the same order, with their asset
+// snapshot populated. The keys, transfers and assets of the bundle are written
+// in a single database transaction, so either all or nothing of the bundle is
+// imported. Unlike a newly logged parcel, the inputs of a restored transfer
+// aren't leased, and its passive assets aren't re-anchored, as the resulting
+// asset state is restored from the proofs instead.
+//
+// Merging a bundle into an existing wallet could result in conflicting
+// transfer histories, so the wallet must either be empty, or already hold
+// exactly the assets and transfers of the bundle. In the latter case, the
+// bundle was already imported and nothing is written.
+func (a *AssetStore) ImportWallet(ctx context.Context, bundle *WalletBundle,
+	proofs []*proof.AnnotatedProof) error {
+
+	if len(proofs) != len(bundle.Proofs) {
+		return fmt.Errorf("expected %d proofs, got %d",
+			len(bundle.Proofs), len(proofs))
+	}
+
+	var (
+		writeTxOpts AssetStoreTxOptions
+		imported    bool
+	)
+	err := a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		var err error
+		imported, err = walletBundleImported(ctx, q, bundle)
+		if err != nil || imported {
+			return err
+		}
+
+		// The keys need to be inserted first, as inserting the assets
+		// would otherwise create the keys without their derivation
+		// information.
+		for _, keyDesc := range bundle.InternalKeys {
+			_, err := q.UpsertInternalKey(ctx, InternalKey{
+				RawKey:    keyDesc.PubKey.SerializeCompressed(),
+				KeyFamily: int32(keyDesc.Family),
+				KeyIndex:  int32(keyDesc.Index),
+			})
+			if err != nil {
+				return fmt.Errorf("unable to insert internal "+
+					"key: %w", err)
+			}
+		}
+		for _, scriptKey := range bundle.ScriptKeys {
+			err := importWalletScriptKey(ctx, q, scriptKey)
+			if err != nil {
+				return fmt.Errorf("unable to insert script "+
+					"key: %w", err)
+			}
+		}
+
+		// The transfers are imported before the assets, since
+		// importing an asset creates its anchor transaction without
+		// the chain fees paid.
+		for _, transfer := range bundle.Transfers {
+			err := importWalletTransfer(ctx, q, transfer)
+			if err != nil {
+				return fmt.Errorf("unable to import "+
+					"transfer: %w", err)
+			}
+		}
+
+		// Imported assets are always unspent, so we restore the spent
+		// state of the assets that were already spent at the time of
+		// the export.
+		var spentLocators []proof.Locator
+		for idx, p := range proofs {
+			err := a.importAssetFromProof(ctx, q, p)
+			if err != nil {
+				return fmt.Errorf("unable to import asset: %w",
+					err)
+			}
+
+			if bundle.Proofs[idx].Spent {
+				spentLocators = append(
+					spentLocators, p.Locator,
+				)
+			}
+		}
+
+		return markAssetsSpent(ctx, q, spentLocators)
+	})
+	if err != nil {
+		return err
+	}
+
+	if imported {
+		log.Infof("Wallet bundle was already imported")
+		return nil
+	}
+
+	// Notify any event subscribers that there are new proofs. We do this
+	// outside of the transaction to avoid the subscribers trying to look up
+	// the proofs before they are committed.
+	proofBlobs := fn.Map(proofs, func(p *proof.AnnotatedProof) proof.Blob {
+		return p.Blob
+	})
+	a.eventDistributor.NotifySubscribers(proofBlobs...)
+
+	return nil
+}
+
+// walletBundleImported returns true if the wallet already holds exactly the
+// proofs and transfers of the given bundle. If the wallet is empty, false is
+// returned. In any other case, ErrWalletNotEmpty is returned.
+func walletBundleImported(ctx context.Context, q ActiveAssetsStore,
+	bundle *WalletBundle) (bool, error) {
+
+	dbProofs, err := q.FetchAllAssetProofs(ctx)
+	if err != nil {
+		return false, fmt.Errorf("unable to fetch asset proofs: %w",
+			err)
+	}
+	dbTransfers, err := q.QueryAssetTransfers(ctx, TransferQuery{})
+	if err != nil {
+		return false, fmt.Errorf("unable to query transfers: %w", err)
+	}
+
+	if len(dbProofs) == 0 && len(dbTransfers) == 0 {
+		return false, nil
+	}
+
+	if len(dbProofs) != len(bundle.Proofs) ||
+		len(dbTransfers) != len(bundle.Transfers) {
+
+		return false, ErrWalletNotEmpty
+	}
+
+	bundleProofs := make(map[[32]byte]struct{}, len(bundle.Proofs))
+	for _, walletProof := range bundle.Proofs {
+		bundleProofs[sha256.Sum256(walletProof.Blob)] = struct{}{}
+	}
+	for _, dbProof := range dbProofs {
+		proofHash := sha256.Sum256(dbProof.ProofFile)
+		if _, ok := bundleProofs[proofHash]; !ok {
+			return false, ErrWalletNotEmpty
+		}
+	}
+
+	bundleTransfers := make(
+		map[chainhash.Hash]struct{}, len(bundle.Transfers),
+	)
+	for _, transfer := range bundle.Transfers {
+		bundleTransfers[transfer.Parcel.AnchorTx.TxHash()] = struct{}{}
+	}
+	for _, dbTransfer := range dbTransfers {
+		txid, err := chainhash.NewHash(dbTransfer.Txid)
+		if err != nil {
+			return false, err
+		}
+
+		if _, ok := bundleTransfers[*txid]; !ok {
+			return false, ErrWalletNotEmpty
+		}
+	}
+
+	return true, nil
+}
+
+// importWalletScriptKey inserts a script key of a wallet bundle, including the
+// derivation information of its internal key, into the DB.
+func importWalletScriptKey(ctx context.Context, q ActiveAssetsStore,
+	scriptKey asset.ScriptKey) error {
+
+	if scriptKey.TweakedScriptKey == nil {
+		return fmt.Errorf("script key %x is missing its internal key",
+			scriptKey.PubKey.SerializeCompressed())
+	}
+
+	internalKeyID, err := q.UpsertInternalKey(ctx, InternalKey{
+		RawKey:    scriptKey.RawKey.PubKey.SerializeCompressed(),
+		KeyFamily: int32(scriptKey.RawKey.Family),
+		KeyIndex:  int32(scriptKey.RawKey.Index),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUpsertInternalKey, err)
+	}
+
+	scriptKeyID, err := q.UpsertScriptKey(ctx, NewScriptKey{
+		InternalKeyID:    internalKeyID,
+		TweakedScriptKey: scriptKey.PubKey.SerializeCompressed(),
+		Tweak:            scriptKey.Tweak,
+		DeclaredKnown:    sqlBool(scriptKey.DeclaredAsKnown()),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUpsertScriptKey, err)
+	}
+
+	err = upsertMuSig2ScriptKey(ctx, q, scriptKeyID, scriptKey.MuSig2)
+	if err != nil {
+		return err
+	}
+
+	return upsertScriptKeyDescriptor(
+		ctx, q, scriptKeyID, scriptKey.Descriptor,
+	)
+}
+
+// importWalletTransfer inserts a single confirmed transfer into the DB.
+func importWalletTransfer(ctx context.Context, q ActiveAssetsStore,
+	transfer *WalletTransfer) error {
+
+	parcel := transfer.Parcel
+	anchorTXID := parcel.AnchorTx.TxHash()
+
+	var txBuf bytes.Buffer
+	if err := parcel.AnchorTx.Serialize(&txBuf); err != nil {
+		return err
+	}
+
+	txnID, err := q.UpsertChainTx(ctx, ChainTxParams{
+		Txid:        anchorTXID[:],
+		RawTx:       txBuf.Bytes(),
+		ChainFees:   parcel.ChainFees,
+		BlockHeight: sqlInt32(transfer.BlockHeight),
+		BlockHash:   transfer.BlockHash[:],
+		TxIndex:     sqlInt32(transfer.TxIndex),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to insert chain tx: %w", err)
+	}
+
+	transferID, err := q.InsertAssetTransfer(ctx, NewAssetTransfer{
+		HeightHint:       int32(parcel.AnchorTxHeightHint),
+		AnchorTxid:       anchorTXID[:],
+		TransferTimeUnix: parcel.TransferTime,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to insert asset transfer: %w", err)
+	}
+
+	for _, input := range parcel.Inputs {
+		anchorPointBytes, err := encodeOutpoint(input.OutPoint)
+		if err != nil {
+			return err
+		}
+
+		err = q.InsertAssetTransferInput(ctx, NewTransferInput{
+			TransferID:  transferID,
+			AnchorPoint: anchorPointBytes,
+			AssetID:     input.ID[:],
+			ScriptKey:   input.ScriptKey[:],
+			Amount:      int64(input.Amount),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert transfer input: "+
+				"%w", err)
+		}
+	}
+
+	for _, output := range parcel.Outputs {
+		err := insertAssetTransferOutput(
+			ctx, q, transferID, txnID, output,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to insert transfer output: "+
+				"%w", err)
+		}
+	}
+
+	return nil
+}
+
+// MarkAssetsSpent marks the assets identified by the given locators as spent.
+// This is used to restore the spent state of assets that were imported from
+// the proofs in a wallet bundle.
+func (a *AssetStore) MarkAssetsSpent(ctx context.Context,
+	locators ...proof.Locator) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		return markAssetsSpent(ctx, q, locators)
+	})
+}
+
+// markAssetsSpent marks the assets identified by the given locators as spent.
+func markAssetsSpent(ctx context.Context, q ActiveAssetsStore,
+	locators []proof.Locator) error {
+
+	for _, locator := range locators {
+		if locator.AssetID == nil || locator.OutPoint == nil {
+			return fmt.Errorf("locator must specify asset ID and " +
+				"outpoint")
+		}
+
+		anchorPoint, err := encodeOutpoint(*locator.OutPoint)
+		if err != nil {
+			return err
+		}
+
+		scriptKey := locator.ScriptKey.SerializeCompressed()
+		_, err = q.SetAssetSpent(ctx, SetAssetSpentParams{
+			ScriptKey:   scriptKey,
+			GenAssetID:  locator.AssetID[:],
+			AnchorPoint: anchorPoint,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to set asset spent: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWalletBundle tests that a wallet can be exported into a wallet bundle,
+// and that the bundle survives an encoding round trip and can be used to
+// restore the transfer history in a new database.
+func TestWalletBundle(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	// We'll start with two assets, one of which we'll spend in a transfer
+	// to a foreign script key.
+	assetGen := newAssetGenerator(t, 2, 2)
+	assetGen.genAssets(t, assetsStore, []assetDesc{{
+		assetGen:    assetGen.assetGens[0],
+		anchorPoint: assetGen.anchorPoints[0],
+		amt:         16,
+	}, {
+		assetGen:    assetGen.assetGens[1],
+		anchorPoint: assetGen.anchorPoints[1],
+		amt:         32,
+	}})
+
+	allAssets, err := assetsStore.FetchAllAssets(ctx, true, false, nil)
+	require.NoError(t, err)
+	require.Len(t, allAssets, 2)
+
+	var inputAsset, otherAsset *asset.ChainAsset
+	for _, a := range allAssets {
+		if a.AnchorOutpoint == assetGen.anchorPoints[0] {
+			inputAsset = a
+		} else {
+			otherAsset = a
+		}
+	}
+	require.NotNil(t, inputAsset)
+	require.NotNil(t, otherAsset)
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: assetGen.anchorPoints[0],
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		PkScript: bytes.Repeat([]byte{0x01}, 34),
+		Value:    1000,
+	})
+	anchorTxHash := anchorTx.TxHash()
+
+	parcel := &tapfreighter.OutboundParcel{
+		AnchorTx:           anchorTx,
+		AnchorTxHeightHint: 1450,
+		TransferTime:       time.Unix(1_700_000_000, 0).UTC(),
+		ChainFees:          100,
+		Inputs: []tapfreighter.TransferInput{{
+			PrevID: asset.PrevID{
+				OutPoint: assetGen.anchorPoints[0],
+				ID:       inputAsset.ID(),
+				ScriptKey: asset.ToSerialized(
+					inputAsset.ScriptKey.PubKey,
+				),
+			},
+			Amount: inputAsset.Amount,
+		}},
+		Outputs: []tapfreighter.TransferOutput{{
+			Anchor: tapfreighter.Anchor{
+				Value: 1000,
+				OutPoint: wire.OutPoint{
+					Hash:  anchorTxHash,
+					Index: 0,
+				},
+				InternalKey: keychain.KeyDescriptor{
+					PubKey: test.RandPubKey(t),
+				},
+				TaprootAssetRoot: bytes.Repeat([]byte{0x1}, 32),
+				MerkleRoot:       bytes.Repeat([]byte{0x1}, 32),
+			},
+			ScriptKey:    asset.NewScriptKey(test.RandPubKey(t)),
+			Amount:       inputAsset.Amount,
+			AssetVersion: asset.V0,
+			ProofSuffix:  bytes.Repeat([]byte{0x2}, 100),
+		}},
+	}
+	require.NoError(t, assetsStore.LogPendingParcel(
+		ctx, parcel, fn.ToArray[[32]byte](test.RandBytes(32)),
+		time.Now().Add(time.Hour),
+	))
+
+	// A wallet with a pending transfer can't be exported.
+	_, err = assetsStore.ExportWallet(ctx)
+	require.ErrorIs(t, err, ErrPendingTransfers)
+
+	blockHash := chainhash.Hash(test.RandHash())
+	err = assetsStore.ConfirmParcelDelivery(
+		ctx, &tapfreighter.AssetConfirmEvent{
+			AnchorTXID:  anchorTxHash,
+			BlockHeight: 100,
+			BlockHash:   blockHash,
+			TxIndex:     10,
+		},
+	)
+	require.NoError(t, err)
+
+	// Now that the transfer is confirmed, the export contains both asset
+	// proofs, the transfer and all keys.
+	bundle, err := assetsStore.ExportWallet(ctx)
+	require.NoError(t, err)
+	require.Equal(t, WalletBundleV0, bundle.Version)
+	require.Len(t, bundle.Proofs, 2)
+	require.Equal(
+		t, 1, fn.Count(bundle.Proofs, func(p *WalletProof) bool {
+			return p.Spent
+		}),
+	)
+	require.NotEmpty(t, bundle.InternalKeys)
+	require.NotEmpty(t, bundle.ScriptKeys)
+	require.Len(t, bundle.Transfers, 1)
+
+	transfer := bundle.Transfers[0]
+	require.Equal(t, blockHash, transfer.BlockHash)
+	require.EqualValues(t, 100, transfer.BlockHeight)
+	require.EqualValues(t, 10, transfer.TxIndex)
+
+	// Encoding the decoded bundle must result in the same bytes.
+	var bundleBuf bytes.Buffer
+	require.NoError(t, bundle.Encode(&bundleBuf))
+
+	decoded, err := DecodeWalletBundle(bundleBuf.Bytes())
+	require.NoError(t, err)
+
+	var decodedBuf bytes.Buffer
+	require.NoError(t, decoded.Encode(&decodedBuf))
+	require.Equal(t, bundleBuf.Bytes(), decodedBuf.Bytes())
+
+	// A bundle with an unknown version is rejected.
+	unknownVersion := bytes.Clone(bundleBuf.Bytes())
+	unknownVersion[7] = 1
+	_, err = DecodeWalletBundle(unknownVersion)
+	require.ErrorIs(t, err, ErrUnknownWalletBundleVersion)
+
+	// We now restore the keys and the transfer history in a fresh
+	// database. The restored transfer must be confirmed.
+	_, newStore, _ := newAssetStore(t)
+	transferBundle := &WalletBundle{
+		Version:      decoded.Version,
+		InternalKeys: decoded.InternalKeys,
+		ScriptKeys:   decoded.ScriptKeys,
+		Transfers:    decoded.Transfers,
+	}
+	require.NoError(t, newStore.ImportWallet(ctx, transferBundle, nil))
+
+	pending, err := newStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	restored, err := newStore.QueryParcels(ctx, nil, false)
+	require.NoError(t, err)
+	require.Len(t, restored, 1)
+
+	restoredParcel := restored[0]
+	require.Equal(t, anchorTxHash, restoredParcel.AnchorTx.TxHash())
+	require.Equal(t, parcel.ChainFees, restoredParcel.ChainFees)
+	require.Equal(
+		t, parcel.AnchorTxHeightHint, restoredParcel.AnchorTxHeightHint,
+	)
+	require.True(t, parcel.TransferTime.Equal(restoredParcel.TransferTime))
+	require.Equal(t, parcel.Inputs, restoredParcel.Inputs)
+	require.Len(t, restoredParcel.Outputs, 1)
+
+	restoredOutput := restoredParcel.Outputs[0]
+	require.Equal(
+		t, parcel.Outputs[0].Anchor.OutPoint,
+		restoredOutput.Anchor.OutPoint,
+	)
+	require.Equal(t, parcel.Outputs[0].Amount, restoredOutput.Amount)
+	require.Equal(
+		t, parcel.Outputs[0].ProofSuffix, restoredOutput.ProofSuffix,
+	)
+
+	// Importing the same bundle again is a no-op, so a failed import can
+	// safely be retried.
+	require.NoError(t, newStore.ImportWallet(ctx, transferBundle, nil))
+
+	restored, err = newStore.QueryParcels(ctx, nil, false)
+	require.NoError(t, err)
+	require.Len(t, restored, 1)
+
+	// A different bundle can't be imported into the now non-empty wallet.
+	err = newStore.ImportWallet(ctx, &WalletBundle{
+		Version: WalletBundleV0,
+	}, nil)
+	require.ErrorIs(t, err, ErrWalletNotEmpty)
+
+	// Finally, we make sure assets can be marked as spent by their
+	// locator.
+	otherID := otherAsset.ID()
+	require.NoError(t, assetsStore.MarkAssetsSpent(ctx, proof.Locator{
+		AssetID:   &otherID,
+		ScriptKey: *otherAsset.ScriptKey.PubKey,
+		OutPoint:  &otherAsset.AnchorOutpoint,
+	}))
+
+	unspent, err := assetsStore.FetchAllAssets(ctx, false, false, nil)
+	require.NoError(t, err)
+	require.Empty(t, unspent)
+}
@@ -5,12 +5,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
+	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
@@ -70,6 +72,17 @@ type ChainPorterConfig struct {
 	// genesis proof.
 	GroupVerifier proof.GroupVerifier
 
+	// CheckpointVerifier is used to verify the first proof of proof files
+	// that were pruned and therefore don't start at the genesis of the
+	// asset. If this is nil, pruned proof files can't be spent from.
+	CheckpointVerifier proof.CheckpointVerifier
+
+	// HistoryFetcher is used to restore the full history of pruned input
+	// proof files, so the proofs we hand out to receivers always start at
+	// the genesis of the asset. If this is nil, pruned proof files can't
+	// be spent from.
+	HistoryFetcher proof.HistoryFetcher
+
 	// Wallet is used to fund+sign PSBTs for the transfer transaction.
 	Wallet WalletAnchor
 
@@ -119,9 +132,42 @@ type ChainPorter struct {
 	// subscriberMtx guards the subscribers map.
 	subscriberMtx sync.Mutex
 
+	// feeBumpers is a map of the parcels that are waiting for their anchor
+	// transaction to confirm, keyed by the anchor transaction hash. It is
+	// used to deliver fee bump requests to them.
+	feeBumpers map[chainhash.Hash]*feeBumper
+
+	// feeBumpersMtx guards the feeBumpers map.
+	feeBumpersMtx sync.Mutex
+
 	*fn.ContextGuard
 }
 
+// feeBumpReq is a request to replace the anchor transaction of a pending
+// parcel with one that pays a higher fee rate.
+type feeBumpReq struct {
+	// feeRate is the fee rate the replacement should pay.
+	feeRate chainfee.SatPerKWeight
+
+	// respChan receives the updated parcel once the replacement was
+	// published.
+	respChan chan *OutboundParcel
+
+	// errChan receives an error if the fee couldn't be bumped.
+	errChan chan error
+}
+
+// feeBumper receives the fee bump requests for a single pending parcel.
+type feeBumper struct {
+	// reqs is used to send fee bump requests to the goroutine waiting for
+	// the confirmation of the parcel.
+	reqs chan *feeBumpReq
+
+	// done is closed once the parcel no longer waits for its anchor
+	// transaction to confirm.
+	done chan struct{}
+}
+
 // NewChainPorter creates a new instance of the ChainPorter given a valid
 // config.
 func NewChainPorter(cfg *ChainPorterConfig) *ChainPorter {
@@ -132,6 +178,7 @@ func NewChainPorter(cfg *ChainPorterConfig) *ChainPorter {
 		cfg:         cfg,
 		exportReqs:  make(chan Parcel),
 		subscribers: subscribers,
+		feeBumpers:  make(map[chainhash.Hash]*feeBumper),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: tapgarden.DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -239,6 +286,54 @@ func (p *ChainPorter) QueryParcels(ctx context.Context,
 	)
 }
 
+// BumpTransferFee replaces the unconfirmed anchor transaction of the pending
+// parcel with the given anchor transaction hash with one that pays the given
+// fee rate. The updated parcel is returned.
+func (p *ChainPorter) BumpTransferFee(ctx context.Context,
+	anchorTxHash chainhash.Hash,
+	feeRate chainfee.SatPerKWeight) (*OutboundParcel, error) {
+
+	p.feeBumpersMtx.Lock()
+	bumper, ok := p.feeBumpers[anchorTxHash]
+	p.feeBumpersMtx.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: anchor_txid=%v",
+			ErrNoPendingTransfer, anchorTxHash)
+	}
+
+	req := &feeBumpReq{
+		feeRate:  feeRate,
+		respChan: make(chan *OutboundParcel, 1),
+		errChan:  make(chan error, 1),
+	}
+
+	select {
+	case bumper.reqs <- req:
+
+	case <-bumper.done:
+		return nil, fmt.Errorf("%w: anchor_txid=%v",
+			ErrNoPendingTransfer, anchorTxHash)
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+
+	case <-p.Quit:
+		return nil, fmt.Errorf("ChainPorter shutting down")
+	}
+
+	select {
+	case err := <-req.errChan:
+		return nil, err
+
+	case resp := <-req.respChan:
+		return resp, nil
+
+	case <-p.Quit:
+		return nil, fmt.Errorf("ChainPorter shutting down")
+	}
+}
+
 // assetsPorter is the main goroutine of the ChainPorter. This takes in incoming
 // requests, and attempt to complete a transfer. A response is sent back to the
 // caller if a transfer can be completed. Otherwise, an error is returned.
@@ -314,8 +409,140 @@ func (p *ChainPorter) advanceState(pkg *sendPackage, kit *parcelKit) {
 
 // waitForTransferTxConf waits for the confirmation of the final transaction
 // within the delta. Once confirmed, the parcel will be marked as delivered on
-// chain, with the goroutine cleaning up its state.
+// chain, with the goroutine cleaning up its state. While waiting, the anchor
+// transaction can be replaced by one that pays a higher fee.
 func (p *ChainPorter) waitForTransferTxConf(pkg *sendPackage) error {
+	bumper := &feeBumper{
+		reqs: make(chan *feeBumpReq),
+		done: make(chan struct{}),
+	}
+
+	p.feeBumpersMtx.Lock()
+	p.feeBumpers[pkg.OutboundPkg.AnchorTx.TxHash()] = bumper
+	p.feeBumpersMtx.Unlock()
+
+	defer func() {
+		p.feeBumpersMtx.Lock()
+		for txHash, b := range p.feeBumpers {
+			if b == bumper {
+				delete(p.feeBumpers, txHash)
+			}
+		}
+		p.feeBumpersMtx.Unlock()
+
+		close(bumper.done)
+	}()
+
+	// Any previous version of the anchor transaction may still confirm
+	// instead of the latest replacement. All of them spend the same
+	// inputs, so we watch for the spend of the first one to find out
+	// which of them actually made it into the chain.
+	spendCtx, spendCancel := p.WithCtxQuitNoTimeout()
+	defer spendCancel()
+
+	spendConfs := make(chan *chainntnfs.TxConfirmation, 1)
+	err := p.watchAnchorInputSpend(spendCtx, pkg, spendConfs)
+	if err != nil {
+		return err
+	}
+
+	// Each time the anchor transaction is replaced, we'll need to wait for
+	// the confirmation of the replacement instead.
+	for {
+		replaced, err := p.waitForAnchorTxConf(pkg, bumper, spendConfs)
+		if err != nil || !replaced {
+			return err
+		}
+	}
+}
+
+// watchAnchorInputSpend registers for the spend of the first input of the
+// anchor transaction of the package. Once the input is spent, the confirmation
+// of the spending transaction is delivered on the given channel.
+func (p *ChainPorter) watchAnchorInputSpend(ctx context.Context,
+	pkg *sendPackage, confs chan<- *chainntnfs.TxConfirmation) error {
+
+	anchorPsbt := pkg.OutboundPkg.AnchorPsbt
+	if anchorPsbt == nil || anchorPsbt.Pkt == nil ||
+		len(anchorPsbt.Pkt.Inputs) == 0 ||
+		anchorPsbt.Pkt.Inputs[0].WitnessUtxo == nil {
+
+		log.Warnf("No anchor input info for transfer_txid=%v, not "+
+			"watching for replaced versions to confirm",
+			pkg.OutboundPkg.AnchorTx.TxHash())
+
+		return nil
+	}
+
+	heightHint := pkg.OutboundPkg.AnchorTxHeightHint
+	outpoint := anchorPsbt.Pkt.UnsignedTx.TxIn[0].PreviousOutPoint
+	spendChan, spendErrChan, err := p.cfg.ChainBridge.RegisterSpendNtfn(
+		ctx, &outpoint, anchorPsbt.Pkt.Inputs[0].WitnessUtxo.PkScript,
+		heightHint,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to register for anchor input "+
+			"spend: %w", err)
+	}
+
+	p.Wg.Add(1)
+	go func() {
+		defer p.Wg.Done()
+
+		var spendingTx *wire.MsgTx
+		select {
+		case spend := <-spendChan:
+			if spend == nil || spend.SpendingTx == nil {
+				return
+			}
+			spendingTx = spend.SpendingTx
+
+		case err := <-spendErrChan:
+			log.Errorf("Error whilst waiting for spend of anchor "+
+				"input %v: %v", outpoint, err)
+			return
+
+		case <-ctx.Done():
+			return
+		}
+
+		txHash := spendingTx.TxHash()
+		confNtfn, confErrChan, err :=
+			p.cfg.ChainBridge.RegisterConfirmationsNtfn(
+				ctx, &txHash, spendingTx.TxOut[0].PkScript, 1,
+				heightHint, true, nil,
+			)
+		if err != nil {
+			log.Errorf("Unable to register for conf of anchor "+
+				"input spending tx %v: %v", txHash, err)
+			return
+		}
+
+		select {
+		case confEvent := <-confNtfn.Confirmed:
+			select {
+			case confs <- confEvent:
+			case <-ctx.Done():
+			}
+
+		case err := <-confErrChan:
+			log.Errorf("Error whilst waiting for conf of anchor "+
+				"input spending tx %v: %v", txHash, err)
+
+		case <-ctx.Done():
+		}
+	}()
+
+	return nil
+}
+
+// waitForAnchorTxConf waits for the confirmation of the current anchor
+// transaction of the package, or of any transaction spending its inputs that
+// is delivered on spendConfs. If the anchor transaction is replaced by one
+// that pays a higher fee in the meantime, true is returned.
+func (p *ChainPorter) waitForAnchorTxConf(pkg *sendPackage, bumper *feeBumper,
+	spendConfs <-chan *chainntnfs.TxConfirmation) (bool, error) {
+
 	outboundPkg := pkg.OutboundPkg
 
 	txHash := outboundPkg.AnchorTx.TxHash()
@@ -327,40 +554,297 @@ func (p *ChainPorter) waitForTransferTxConf(pkg *sendPackage) error {
 		outboundPkg.AnchorTxHeightHint, true, nil,
 	)
 	if err != nil {
-		return fmt.Errorf("unable to register for package tx conf: %w",
-			err)
+		confCancel()
+		return false, fmt.Errorf("unable to register for package tx "+
+			"conf: %w", err)
 	}
 
 	// Launch a goroutine that'll notify us when the transaction confirms.
 	defer confCancel()
 
-	var confEvent *chainntnfs.TxConfirmation
-	select {
-	case confEvent = <-confNtfn.Confirmed:
-		log.Debugf("Got chain confirmation: %v", confEvent.Tx.TxHash())
-		pkg.TransferTxConfEvent = confEvent
-		pkg.SendState = SendStateStoreProofs
+	for {
+		select {
+		case confEvent := <-confNtfn.Confirmed:
+			if confEvent == nil {
+				return false, fmt.Errorf("got empty package " +
+					"tx confirmation event in batch")
+			}
 
-	case err := <-errChan:
-		return fmt.Errorf("error whilst waiting for package tx "+
-			"confirmation: %w", err)
+			log.Debugf("Got chain confirmation: %v",
+				confEvent.Tx.TxHash())
+			pkg.TransferTxConfEvent = confEvent
+			pkg.SendState = SendStateStoreProofs
 
-	case <-confCtx.Done():
-		log.Debugf("Skipping TX confirmation, context done")
+			return false, nil
 
-	case <-p.Quit:
-		log.Debugf("Skipping TX confirmation, exiting")
+		// A previous version of the anchor transaction confirmed
+		// instead of the current one, so we'll go back to it before
+		// continuing with the confirmed transaction.
+		case confEvent := <-spendConfs:
+			if confEvent == nil || confEvent.Tx == nil {
+				return false, fmt.Errorf("got empty anchor " +
+					"input spend confirmation event")
+			}
+
+			log.Debugf("Got chain confirmation of anchor input "+
+				"spend: %v", confEvent.Tx.TxHash())
+
+			err := p.matchConfirmedAnchorTx(pkg, confEvent.Tx)
+			if err != nil {
+				return false, err
+			}
+
+			pkg.TransferTxConfEvent = confEvent
+			pkg.SendState = SendStateStoreProofs
+
+			return false, nil
+
+		case err := <-errChan:
+			return false, fmt.Errorf("error whilst waiting for "+
+				"package tx confirmation: %w", err)
+
+		case req := <-bumper.reqs:
+			err := p.bumpTransferFee(pkg, req.feeRate)
+			if err != nil {
+				log.Errorf("Unable to bump fee of "+
+					"transfer_txid=%v: %v", txHash, err)
+				req.errChan <- err
+
+				continue
+			}
+
+			newTxHash := pkg.OutboundPkg.AnchorTx.TxHash()
+			p.feeBumpersMtx.Lock()
+			delete(p.feeBumpers, txHash)
+			p.feeBumpers[newTxHash] = bumper
+			p.feeBumpersMtx.Unlock()
+
+			req.respChan <- pkg.OutboundPkg.Copy()
+
+			return true, nil
+
+		case <-confCtx.Done():
+			log.Debugf("Skipping TX confirmation, context done")
+			return false, fmt.Errorf("got empty package tx " +
+				"confirmation event in batch")
+
+		case <-p.Quit:
+			log.Debugf("Skipping TX confirmation, exiting")
+			return false, nil
+		}
+	}
+}
+
+// bumpTransferFee replaces the published, but still unconfirmed, anchor
+// transaction of the package with one that pays the given fee rate. The
+// replacement spends the same inputs and only reduces the change output, so
+// all asset outputs and their commitments stay the same. The replacement is
+// written to disk before it is published, so we'll always resume with the
+// transaction that pays the highest fee.
+func (p *ChainPorter) bumpTransferFee(pkg *sendPackage,
+	feeRate chainfee.SatPerKWeight) error {
+
+	parcel := pkg.OutboundPkg
+	if parcel.AnchorPsbt == nil || parcel.AnchorPsbt.Pkt == nil {
+		return fmt.Errorf("no anchor psbt stored for transfer")
+	}
+
+	ctx, cancel := p.WithCtxQuit()
+	defer cancel()
+
+	anchorPsbt := parcel.AnchorPsbt.Copy()
+	_, err := tapsend.BumpAnchorFee(
+		anchorPsbt.Pkt, anchorPsbt.ChangeOutputIndex, parcel.AnchorTx,
+		parcel.ChainFees, feeRate,
+	)
+	if err != nil {
+		return err
+	}
+
+	signedPsbt, err := p.cfg.Wallet.SignPsbt(ctx, anchorPsbt.Pkt)
+	if err != nil {
+		return fmt.Errorf("unable to sign psbt: %w", err)
+	}
+
+	chainFees, err := signedPsbt.GetTxFee()
+	if err != nil {
+		return fmt.Errorf("unable to get on-chain fees for psbt: %w",
+			err)
+	}
+
+	err = psbt.MaybeFinalizeAll(signedPsbt)
+	if err != nil {
+		return fmt.Errorf("unable to finalize psbt: %w", err)
+	}
+
+	finalTx, err := psbt.Extract(signedPsbt)
+	if err != nil {
+		return fmt.Errorf("unable to extract psbt: %w", err)
+	}
+
+	err = blockchain.CheckTransactionSanity(btcutil.NewTx(finalTx))
+	if err != nil {
+		return fmt.Errorf("anchor TX failed final checks: %w", err)
+	}
+
+	anchorPsbt.ChainFees = int64(chainFees)
+	newParcel, err := replaceAnchorTx(parcel, anchorPsbt, finalTx)
+	if err != nil {
+		return err
+	}
+
+	oldTxHash := parcel.AnchorTx.TxHash()
+	newTxHash := finalTx.TxHash()
+	log.Infof("Replacing transfer tx %v with %v (absolute_fee_sats: "+
+		"%d -> %d)", oldTxHash, newTxHash, parcel.ChainFees,
+		newParcel.ChainFees)
+
+	err = p.cfg.ExportLog.ReplaceParcelAnchorTx(ctx, oldTxHash, newParcel)
+	if err != nil {
+		return fmt.Errorf("unable to store replacement anchor tx: %w",
+			err)
+	}
+
+	err = p.cfg.ChainBridge.PublishTransaction(ctx, finalTx)
+	if err != nil {
+		// The original transaction is still valid, so we go back to
+		// waiting for it.
+		revertErr := p.cfg.ExportLog.ReplaceParcelAnchorTx(
+			ctx, newTxHash, parcel,
+		)
+		if revertErr != nil {
+			log.Errorf("Unable to restore replaced transfer tx "+
+				"%v: %v", oldTxHash, revertErr)
+		}
+
+		return fmt.Errorf("unable to broadcast transaction %v: %w",
+			newTxHash, err)
+	}
+
+	setPkgAnchorTx(pkg, newParcel)
+	p.publishSubscriberEvent(newAssetSendEvent(SendStateBroadcast, *pkg))
+
+	return nil
+}
+
+// matchConfirmedAnchorTx makes sure the package is anchored in the given
+// confirmed transaction. If a previous version of the anchor transaction
+// confirmed instead of its replacement, the parcel on disk and all proof
+// suffixes are reverted to the confirmed transaction.
+func (p *ChainPorter) matchConfirmedAnchorTx(pkg *sendPackage,
+	confirmedTx *wire.MsgTx) error {
+
+	parcel := pkg.OutboundPkg
+	currentTxHash := parcel.AnchorTx.TxHash()
+	confirmedTxHash := confirmedTx.TxHash()
+	if confirmedTxHash == currentTxHash {
 		return nil
 	}
 
-	if confEvent == nil {
-		return fmt.Errorf("got empty package tx confirmation event " +
-			"in batch")
+	if parcel.AnchorPsbt == nil || parcel.AnchorPsbt.Pkt == nil {
+		return fmt.Errorf("no anchor psbt stored for transfer")
+	}
+
+	anchorPsbt := parcel.AnchorPsbt.Copy()
+	chainFees, err := tapsend.MatchAnchorReplacement(
+		anchorPsbt.Pkt, anchorPsbt.ChangeOutputIndex, parcel.ChainFees,
+		confirmedTx,
+	)
+	if err != nil {
+		return fmt.Errorf("unexpected anchor tx %v confirmed: %w",
+			confirmedTxHash, err)
 	}
+	anchorPsbt.ChainFees = chainFees
+
+	newParcel, err := replaceAnchorTx(parcel, anchorPsbt, confirmedTx)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Transfer tx %v confirmed instead of its replacement %v",
+		confirmedTxHash, currentTxHash)
+
+	ctx, cancel := p.WithCtxQuit()
+	defer cancel()
+
+	err = p.cfg.ExportLog.ReplaceParcelAnchorTx(
+		ctx, currentTxHash, newParcel,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to store confirmed anchor tx: %w",
+			err)
+	}
+
+	setPkgAnchorTx(pkg, newParcel)
 
 	return nil
 }
 
+// setPkgAnchorTx updates the send package to refer to the anchor transaction
+// of the given parcel, which replaced the one the package was anchored in.
+func setPkgAnchorTx(pkg *sendPackage, parcel *OutboundParcel) {
+	pkg.OutboundPkg = parcel
+	pkg.PassiveAssets = parcel.PassiveAssets
+	if pkg.AnchorTx != nil {
+		pkg.AnchorTx.FundedPsbt = parcel.AnchorPsbt
+		pkg.AnchorTx.FinalTx = parcel.AnchorTx
+		pkg.AnchorTx.ChainFees = parcel.ChainFees
+	}
+}
+
+// replaceAnchorTx returns a copy of the given parcel that is anchored in the
+// given replacement transaction. The replacement must have the same outputs as
+// the original anchor transaction, apart from the value of the change output.
+func replaceAnchorTx(parcel *OutboundParcel, anchorPsbt *tapsend.FundedPsbt,
+	anchorTx *wire.MsgTx) (*OutboundParcel, error) {
+
+	newParcel := parcel.Copy()
+	newParcel.AnchorTx = anchorTx
+	newParcel.AnchorPsbt = anchorPsbt
+	newParcel.ChainFees = anchorPsbt.ChainFees
+
+	txHash := anchorTx.TxHash()
+	for idx := range newParcel.Outputs {
+		out := &newParcel.Outputs[idx]
+
+		var proofSuffix proof.Proof
+		err := proofSuffix.Decode(bytes.NewReader(out.ProofSuffix))
+		if err != nil {
+			return nil, fmt.Errorf("error decoding proof suffix "+
+				"%d: %w", idx, err)
+		}
+
+		proofSuffix.AnchorTx = *anchorTx
+
+		var buf bytes.Buffer
+		if err := proofSuffix.Encode(&buf); err != nil {
+			return nil, fmt.Errorf("error encoding proof suffix "+
+				"%d: %w", idx, err)
+		}
+
+		out.ProofSuffix = buf.Bytes()
+		out.Anchor.OutPoint.Hash = txHash
+	}
+
+	if newParcel.PassiveAssetsAnchor != nil {
+		newParcel.PassiveAssetsAnchor.OutPoint.Hash = txHash
+	}
+
+	for _, vPkt := range newParcel.PassiveAssets {
+		for _, vOut := range vPkt.Outputs {
+			if vOut.ProofSuffix == nil {
+				continue
+			}
+
+			proofSuffix := *vOut.ProofSuffix
+			proofSuffix.AnchorTx = *anchorTx
+			vOut.ProofSuffix = &proofSuffix
+		}
+	}
+
+	return newParcel, nil
+}
+
 // storeProofs writes the updated sender and receiver proof files to the proof
 // archive.
 func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
@@ -488,7 +972,9 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 
 		// Before we import the proof into the proof archive, we'll
 		// validate it.
-		verifier := &proof.BaseVerifier{}
+		verifier := &proof.BaseVerifier{
+			CheckpointVerifier: p.cfg.CheckpointVerifier,
+		}
 		_, err = verifier.Verify(
 			ctx, bytes.NewReader(outputProof.Blob),
 			headerVerifier, proof.DefaultMerkleVerifier,
@@ -562,6 +1048,27 @@ func (p *ChainPorter) fetchInputProof(ctx context.Context,
 		return nil, fmt.Errorf("error decoding input proof: %w", err)
 	}
 
+	// The receiver might not accept proof files that were pruned, so we
+	// restore the full history of the input proof before we append to it.
+	pruned, err := inputProofFile.IsPruned()
+	if err != nil {
+		return nil, fmt.Errorf("error checking input proof: %w", err)
+	}
+	if pruned {
+		if p.cfg.HistoryFetcher == nil {
+			return nil, fmt.Errorf("input proof was pruned, but " +
+				"proof history can't be restored")
+		}
+
+		inputProofFile, err = proof.RestorePrunedFile(
+			ctx, inputProofFile, p.cfg.HistoryFetcher,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error restoring pruned input "+
+				"proof: %w", err)
+		}
+	}
+
 	return inputProofFile, nil
 }
 
@@ -857,7 +1364,7 @@ func (p *ChainPorter) importLocalAddresses(ctx context.Context,
 		// On restart, we'll get an error that the output has already
 		// been added to the wallet, so we'll catch this now and move
 		// along if so.
-		case strings.Contains(err.Error(), "already exists"):
+		case errors.Is(err, tapsend.ErrOutputAlreadyImported):
 			break
 
 		default:
@@ -887,18 +1394,27 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 			return nil, fmt.Errorf("unable to cast parcel to " +
 				"address parcel")
 		}
-		fundSendRes, err := p.cfg.AssetWallet.FundAddressSend(
-			ctx, addrParcel.destAddrs...,
+		// The addresses might request different assets, in which case
+		// we get one virtual packet for each of them. They are all
+		// anchored in the same BTC level transaction.
+		fundSendRes, err := p.cfg.AssetWallet.FundAddressSends(
+			ctx, addrParcel.destAddrs, addrParcel.fundOpts...,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to fund address send: "+
 				"%w", err)
 		}
 
-		currentPkg.VirtualPackets = []*tappsbt.VPacket{
-			fundSendRes.VPacket,
+		currentPkg.InputCommitments = make(tappsbt.InputCommitments)
+		for _, fundedPkt := range fundSendRes {
+			currentPkg.VirtualPackets = append(
+				currentPkg.VirtualPackets, fundedPkt.VPacket,
+			)
+
+			for prevID, c := range fundedPkt.InputCommitments {
+				currentPkg.InputCommitments[prevID] = c
+			}
 		}
-		currentPkg.InputCommitments = fundSendRes.InputCommitments
 
 		currentPkg.SendState = SendStateVirtualSign
 
@@ -946,10 +1462,18 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		)
 
 		// First, use a manual fee rate if specified by the parcel.
-		addrParcel, ok := currentPkg.Parcel.(*AddressParcel)
+		var manualFeeRate *chainfee.SatPerKWeight
+		switch parcel := currentPkg.Parcel.(type) {
+		case *AddressParcel:
+			manualFeeRate = parcel.transferFeeRate
+
+		case *PreSignedParcel:
+			manualFeeRate = parcel.transferFeeRate
+		}
+
 		switch {
-		case ok && addrParcel.transferFeeRate != nil:
-			feeRate = *addrParcel.transferFeeRate
+		case manualFeeRate != nil:
+			feeRate = *manualFeeRate
 			log.Infof("sending with manual fee rate")
 
 		default:
@@ -8,10 +8,17 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/fn"
 )
 
+const (
+	// maxExactMatchTries is the maximum number of branches the exact match
+	// coin selection strategy explores before giving up.
+	maxExactMatchTries = 100_000
+)
+
 // NewCoinSelect creates a new CoinSelect.
 func NewCoinSelect(coinLister CoinLister) *CoinSelect {
 	return &CoinSelect{
@@ -45,19 +52,218 @@ func (s *CoinSelect) SelectCoins(ctx context.Context,
 	s.coinLock.Lock()
 	defer s.coinLock.Unlock()
 
-	// Before we select any coins, let's do some cleanup of expired leases.
-	if err := s.coinLister.DeleteExpiredLeases(ctx); err != nil {
-		return nil, fmt.Errorf("unable to delete expired leases: %w",
-			err)
+	selectedCoins, err := s.selectCoins(
+		ctx, constraints, strategy, maxVersion, nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// We now need to lock/lease/reserve those selected coins so
+	// that they can't be used by other processes.
+	if err := s.leaseSelected(ctx, selectedCoins); err != nil {
+		return nil, err
+	}
+
+	return selectedCoins, nil
+}
+
+// SelectCoinsForAssets selects coins for each of the given requests, the same
+// way SelectCoins does for a single set of constraints. This is used to fund a
+// send of several assets that are anchored in the same transaction. Anchor
+// outputs can only be leased as a whole, so we only lease them once the coins
+// of all requests were selected. That way a coin of one asset can be selected
+// even if a coin of another asset in the same anchor output was already
+// selected for a previous request. The coins are returned in the order of the
+// requests.
+func (s *CoinSelect) SelectCoinsForAssets(ctx context.Context,
+	requests []CoinRequest,
+	strategy MultiCommitmentSelectStrategy) ([][]*AnchoredCommitment,
+	error) {
+
+	s.coinLock.Lock()
+	defer s.coinLock.Unlock()
+
+	var (
+		selectedCoins = make([][]*AnchoredCommitment, 0, len(requests))
+		allCoins      []*AnchoredCommitment
+		selectedIDs   = fn.NewSet[asset.PrevID]()
+	)
+	for idx, req := range requests {
+		coins, err := s.selectCoins(
+			ctx, req.Constraints, strategy, req.MaxVersion,
+			selectedIDs,
+		)
+		if err != nil {
+			return nil, &AssetSelectionError{
+				Index: idx,
+				Err:   err,
+			}
+		}
+
+		for _, coin := range coins {
+			selectedIDs.Add(coin.PrevID())
+		}
+
+		selectedCoins = append(selectedCoins, coins)
+		allCoins = append(allCoins, coins...)
 	}
 
+	if err := s.leaseSelected(ctx, allCoins); err != nil {
+		return nil, err
+	}
+
+	return selectedCoins, nil
+}
+
+// selectCoins returns a set of not yet leased coins that satisfy the given
+// constraints and strategy, without leasing them. Coins with one of the given
+// excluded previous IDs are never selected.
+//
+// NOTE: The coin lock must be held when calling this method.
+func (s *CoinSelect) selectCoins(ctx context.Context,
+	constraints CommitmentConstraints,
+	strategy MultiCommitmentSelectStrategy,
+	maxVersion commitment.TapCommitmentVersion,
+	excluded fn.Set[asset.PrevID]) ([]*AnchoredCommitment, error) {
+
 	listConstraints := CommitmentConstraints{
 		GroupKey: constraints.GroupKey,
 		AssetID:  constraints.AssetID,
 		MinAmt:   1,
 	}
+	compatibleCommitments, err := s.listCompatibleCoins(
+		ctx, listConstraints, maxVersion,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(excluded) > 0 {
+		compatibleCommitments = fn.Filter(
+			compatibleCommitments,
+			func(c *AnchoredCommitment) bool {
+				return !excluded.Contains(c.PrevID())
+			},
+		)
+		if len(compatibleCommitments) == 0 {
+			return nil, ErrMatchingAssetsNotFound
+		}
+	}
+
+	log.Infof("Identified %v eligible asset inputs for send of %d to %v",
+		len(compatibleCommitments), constraints.MinAmt, constraints)
+
+	var selectedCoins []*AnchoredCommitment
+	switch {
+	// If the caller pinned the inputs to spend, we don't apply any
+	// strategy and just make sure the pinned inputs are sufficient.
+	case len(constraints.PinnedInputs) > 0:
+		selectedCoins, err = selectPinned(
+			constraints.MinAmt, compatibleCommitments,
+			constraints.PinnedInputs,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to select pinned "+
+				"coins: %w", err)
+		}
+
+	default:
+		// The proof chain length is only fetched if the strategy
+		// actually needs it, as it requires reading the proof files.
+		if strategy == PreferShortProofChain {
+			err := s.fetchProofChainLengths(
+				ctx, compatibleCommitments,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		selectedCoins, err = s.selectForAmount(
+			constraints.MinAmt, compatibleCommitments, strategy,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to select coins: %w",
+				err)
+		}
+	}
+
+	return selectedCoins, nil
+}
+
+// SelectAllCoins returns all not yet leased coins that satisfy the given
+// constraints, ignoring the minimum amount. The coins returned are leased for
+// the default lease duration.
+func (s *CoinSelect) SelectAllCoins(ctx context.Context,
+	constraints CommitmentConstraints,
+	maxVersion commitment.TapCommitmentVersion) ([]*AnchoredCommitment,
+	error) {
+
+	s.coinLock.Lock()
+	defer s.coinLock.Unlock()
+
+	selectedCoins, err := s.listAllCoins(ctx, constraints, maxVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.leaseSelected(ctx, selectedCoins); err != nil {
+		return nil, err
+	}
+
+	return selectedCoins, nil
+}
+
+// ListAllCoins returns all not yet leased coins that satisfy the given
+// constraints, ignoring the minimum amount. In contrast to SelectAllCoins, the
+// coins are not leased.
+func (s *CoinSelect) ListAllCoins(ctx context.Context,
+	constraints CommitmentConstraints,
+	maxVersion commitment.TapCommitmentVersion) ([]*AnchoredCommitment,
+	error) {
+
+	s.coinLock.Lock()
+	defer s.coinLock.Unlock()
+
+	return s.listAllCoins(ctx, constraints, maxVersion)
+}
+
+// listAllCoins returns all not yet leased coins that satisfy the given
+// constraints, ignoring the minimum amount.
+//
+// NOTE: The coin lock must be held when calling this method.
+func (s *CoinSelect) listAllCoins(ctx context.Context,
+	constraints CommitmentConstraints,
+	maxVersion commitment.TapCommitmentVersion) ([]*AnchoredCommitment,
+	error) {
+
+	listConstraints := CommitmentConstraints{
+		GroupKey:            constraints.GroupKey,
+		AssetID:             constraints.AssetID,
+		MinAmt:              1,
+		Bip86ScriptKeysOnly: constraints.Bip86ScriptKeysOnly,
+	}
+	return s.listCompatibleCoins(ctx, listConstraints, maxVersion)
+}
+
+// listCompatibleCoins returns all not yet leased coins that satisfy the given
+// constraints and are anchored in a commitment of at most the given version.
+//
+// NOTE: The coin lock must be held when calling this method.
+func (s *CoinSelect) listCompatibleCoins(ctx context.Context,
+	constraints CommitmentConstraints,
+	maxVersion commitment.TapCommitmentVersion) ([]*AnchoredCommitment,
+	error) {
+
+	// Before we select any coins, let's do some cleanup of expired leases.
+	if err := s.coinLister.DeleteExpiredLeases(ctx); err != nil {
+		return nil, fmt.Errorf("unable to delete expired leases: %w",
+			err)
+	}
+
 	eligibleCommitments, err := s.coinLister.ListEligibleCoins(
-		ctx, listConstraints,
+		ctx, constraints,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to list eligible coins: %w", err)
@@ -67,9 +273,6 @@ func (s *CoinSelect) SelectCoins(ctx context.Context,
 		return nil, ErrMatchingAssetsNotFound
 	}
 
-	log.Infof("Identified %v eligible asset inputs for send of %d to %v",
-		len(eligibleCommitments), constraints.MinAmt, constraints)
-
 	// Only select coins anchored in a compatible commitment.
 	compatibleCommitments := fn.Filter(
 		eligibleCommitments, func(c *AnchoredCommitment) bool {
@@ -80,29 +283,30 @@ func (s *CoinSelect) SelectCoins(ctx context.Context,
 		return nil, ErrMatchingAssetsNotFound
 	}
 
-	selectedCoins, err := s.selectForAmount(
-		constraints.MinAmt, compatibleCommitments, strategy,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("unable to select coins: %w", err)
-	}
+	return compatibleCommitments, nil
+}
+
+// leaseSelected leases the anchor outpoints of the given selected coins for
+// the default lease duration, so they can't be used by other processes.
+//
+// NOTE: The coin lock must be held when calling this method.
+func (s *CoinSelect) leaseSelected(ctx context.Context,
+	selectedCoins []*AnchoredCommitment) error {
 
-	// We now need to lock/lease/reserve those selected coins so
-	// that they can't be used by other processes.
 	expiry := time.Now().Add(defaultCoinLeaseDuration)
 	coinOutPoints := fn.Map(
 		selectedCoins, func(c *AnchoredCommitment) wire.OutPoint {
 			return c.AnchorPoint
 		},
 	)
-	err = s.coinLister.LeaseCoins(
+	err := s.coinLister.LeaseCoins(
 		ctx, defaultWalletLeaseIdentifier, expiry, coinOutPoints...,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to lease coin: %w", err)
+		return fmt.Errorf("unable to lease coin: %w", err)
 	}
 
-	return selectedCoins, nil
+	return nil
 }
 
 // LeaseCoins leases/locks/reserves coins for the given lease owner until the
@@ -130,6 +334,62 @@ func (s *CoinSelect) ReleaseCoins(ctx context.Context,
 	return s.coinLister.ReleaseCoins(ctx, utxoOutpoints...)
 }
 
+// fetchProofChainLengths populates the proof chain length of each of the
+// given commitments.
+func (s *CoinSelect) fetchProofChainLengths(ctx context.Context,
+	commitments []*AnchoredCommitment) error {
+
+	for _, c := range commitments {
+		chainLength, err := s.coinLister.ProofChainLength(
+			ctx, c.PrevID(),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch proof chain length "+
+				"of %v: %w", c.AnchorPoint, err)
+		}
+
+		c.ProofChainLength = chainLength
+	}
+
+	return nil
+}
+
+// selectPinned selects all eligible commitments that are anchored at one of
+// the pinned outpoints. Every pinned outpoint must anchor at least one
+// eligible commitment, and the selected commitments must cumulatively sum to
+// at least the minimum required amount.
+func selectPinned(minTotalAmount uint64,
+	eligibleCommitments []*AnchoredCommitment,
+	pinnedInputs []wire.OutPoint) ([]*AnchoredCommitment, error) {
+
+	pinned := fn.NewSet(pinnedInputs...)
+	selectedCommitments := fn.Filter(
+		eligibleCommitments, func(c *AnchoredCommitment) bool {
+			return pinned.Contains(c.AnchorPoint)
+		},
+	)
+
+	found := fn.NewSet(fn.Map(
+		selectedCommitments, func(c *AnchoredCommitment) wire.OutPoint {
+			return c.AnchorPoint
+		},
+	)...)
+	for _, pinnedInput := range pinnedInputs {
+		if !found.Contains(pinnedInput) {
+			return nil, fmt.Errorf("%w: pinned input %v is not "+
+				"eligible", ErrMatchingAssetsNotFound,
+				pinnedInput)
+		}
+	}
+
+	if sumAmounts(selectedCommitments) < minTotalAmount {
+		return nil, fmt.Errorf("%w: pinned inputs are insufficient",
+			ErrMatchingAssetsNotFound)
+	}
+
+	return selectedCommitments, nil
+}
+
 // selectForAmount selects a subset of the given eligible commitments which
 // cumulatively sum to at least the minimum required amount. The selection
 // strategy determines how the commitments are selected.
@@ -138,48 +398,70 @@ func (s *CoinSelect) selectForAmount(minTotalAmount uint64,
 	strategy MultiCommitmentSelectStrategy) ([]*AnchoredCommitment,
 	error) {
 
-	// Select the first subset of eligible commitments which cumulatively
-	// sum to at least the minimum required amount.
-	var selectedCommitments []*AnchoredCommitment
-	amountSum := uint64(0)
+	// descending is a sort function for eligible commitments from the
+	// largest amount to smallest.
+	descending := func(i, j int) bool {
+		return eligibleCommitments[i].Asset.Amount >
+			eligibleCommitments[j].Asset.Amount
+	}
 
 	switch strategy {
 	case PreferMaxAmount:
-		// Sort eligible commitments from the largest amount to
-		// smallest.
-		sort.Slice(
-			eligibleCommitments, func(i, j int) bool {
-				isLess := eligibleCommitments[i].Asset.Amount <
-					eligibleCommitments[j].Asset.Amount
-
-				// Negate the result to sort in descending
-				// order.
-				return !isLess
-			},
+		sort.SliceStable(eligibleCommitments, descending)
+
+	case PreferMinAmount:
+		sort.SliceStable(eligibleCommitments, func(i, j int) bool {
+			return eligibleCommitments[i].Asset.Amount <
+				eligibleCommitments[j].Asset.Amount
+		})
+
+	case PreferExactMatch:
+		sort.SliceStable(eligibleCommitments, descending)
+
+		exactMatch := selectExactMatch(
+			minTotalAmount, eligibleCommitments,
 		)
+		if exactMatch != nil {
+			return exactMatch, nil
+		}
 
-		// Select the first subset of eligible commitments which
-		// cumulatively sum to at least the minimum required amount.
-		for _, anchoredCommitment := range eligibleCommitments {
-			selectedCommitments = append(
-				selectedCommitments, anchoredCommitment,
-			)
+		log.Debugf("No exact match found for amount %d, falling "+
+			"back to %v", minTotalAmount, PreferMaxAmount)
 
-			// Keep track of the total amount of assets we've seen
-			// so far.
-			amountSum += anchoredCommitment.Asset.Amount
-			if amountSum >= minTotalAmount {
-				// At this point a target min amount was
-				// specified and has been reached.
-				break
+	case PreferShortProofChain:
+		sort.SliceStable(eligibleCommitments, func(i, j int) bool {
+			iLen := eligibleCommitments[i].ProofChainLength
+			jLen := eligibleCommitments[j].ProofChainLength
+			if iLen != jLen {
+				return iLen < jLen
 			}
-		}
+
+			return descending(i, j)
+		})
 
 	default:
 		return nil, fmt.Errorf("unknown multi coin selection "+
 			"strategy: %v", strategy)
 	}
 
+	// Select the first subset of eligible commitments which cumulatively
+	// sum to at least the minimum required amount.
+	var selectedCommitments []*AnchoredCommitment
+	amountSum := uint64(0)
+	for _, anchoredCommitment := range eligibleCommitments {
+		selectedCommitments = append(
+			selectedCommitments, anchoredCommitment,
+		)
+
+		// Keep track of the total amount of assets we've seen so far.
+		amountSum += anchoredCommitment.Asset.Amount
+		if amountSum >= minTotalAmount {
+			// At this point a target min amount was specified and
+			// has been reached.
+			break
+		}
+	}
+
 	// Having examined all the eligible commitments, return an error if the
 	// minimal funding amount was not reached.
 	if amountSum < minTotalAmount {
@@ -188,4 +470,68 @@ func (s *CoinSelect) selectForAmount(minTotalAmount uint64,
 	return selectedCommitments, nil
 }
 
+// selectExactMatch runs a depth first branch and bound search for a subset of
+// the given commitments, which must be sorted by descending amount, that sums
+// to exactly the target amount. The search gives up after maxExactMatchTries
+// branches were explored, in which case nil is returned.
+func selectExactMatch(target uint64,
+	sortedCommitments []*AnchoredCommitment) []*AnchoredCommitment {
+
+	// remaining[i] is the sum of all commitment amounts starting at index
+	// i, which allows us to prune branches that can't reach the target
+	// anymore.
+	remaining := make([]uint64, len(sortedCommitments)+1)
+	for i := len(sortedCommitments) - 1; i >= 0; i-- {
+		amount := sortedCommitments[i].Asset.Amount
+		remaining[i] = remaining[i+1] + amount
+	}
+
+	var (
+		tries    int
+		selected []*AnchoredCommitment
+		search   func(idx int, sum uint64) bool
+	)
+	search = func(idx int, sum uint64) bool {
+		tries++
+
+		switch {
+		case sum == target:
+			return true
+
+		// Either we overshot the target, can't reach it with the
+		// remaining commitments or ran out of tries.
+		case sum > target, sum+remaining[idx] < target,
+			tries > maxExactMatchTries:
+
+			return false
+		}
+
+		// We first explore the branch that includes the commitment at
+		// the current index, then the one that omits it.
+		c := sortedCommitments[idx]
+		selected = append(selected, c)
+		if search(idx+1, sum+c.Asset.Amount) {
+			return true
+		}
+		selected = selected[:len(selected)-1]
+
+		return search(idx+1, sum)
+	}
+
+	if !search(0, 0) {
+		return nil
+	}
+
+	return selected
+}
+
+// sumAmounts returns the cumulative asset amount of the given commitments.
+func sumAmounts(commitments []*AnchoredCommitment) uint64 {
+	return fn.Reduce(
+		commitments, func(sum uint64, c *AnchoredCommitment) uint64 {
+			return sum + c.Asset.Amount
+		},
+	)
+}
+
 var _ CoinSelector = (*CoinSelect)(nil)
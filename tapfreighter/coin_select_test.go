@@ -9,12 +9,14 @@ import (
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/stretchr/testify/require"
 )
 
 // mockCoinLister is a mock implementation of the CoinLister interface.
 type mockCoinLister struct {
 	eligibleCommitments []*AnchoredCommitment
+	proofChainLengths   map[wire.OutPoint]uint32
 
 	listSignals    chan struct{}
 	leaseSignals   chan struct{}
@@ -60,6 +62,12 @@ func (m *mockCoinLister) DeleteExpiredLeases(ctx context.Context) error {
 	return nil
 }
 
+func (m *mockCoinLister) ProofChainLength(_ context.Context,
+	prevID asset.PrevID) (uint32, error) {
+
+	return m.proofChainLengths[prevID.OutPoint], nil
+}
+
 // TestCoinSelector tests that the coin selector behaves as expected.
 func TestCoinSelector(t *testing.T) {
 	var (
@@ -235,6 +243,167 @@ func TestCoinSelection(t *testing.T) {
 			strategy:    PreferMaxAmount,
 			expectedErr: ErrMatchingAssetsNotFound.Error(),
 		},
+
+		// Test that when the PreferMinAmount strategy is employed
+		// the smallest commitments are selected first.
+		{
+			name:           "prefer min amount",
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 2000,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 600,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 500,
+					},
+				},
+			},
+			strategy: PreferMinAmount,
+			expectedCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 500,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 600,
+					},
+				},
+			},
+		},
+
+		// Test that when the PreferExactMatch strategy is employed
+		// a subset that exactly matches the amount is selected, even
+		// if a single larger commitment would suffice.
+		{
+			name:           "prefer exact match",
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 2000,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 700,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 400,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 300,
+					},
+				},
+			},
+			strategy: PreferExactMatch,
+			expectedCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 700,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 300,
+					},
+				},
+			},
+		},
+
+		// Test that the PreferExactMatch strategy falls back to the
+		// PreferMaxAmount strategy if there is no exact match.
+		{
+			name:           "prefer exact match without match",
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 600,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 800,
+					},
+				},
+			},
+			strategy: PreferExactMatch,
+			expectedCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 800,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 600,
+					},
+				},
+			},
+		},
+
+		// Test that when the PreferShortProofChain strategy is
+		// employed the commitments with the shortest proof chains are
+		// selected, using the larger amount on a tie.
+		{
+			name:           "prefer short proof chain",
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 5000,
+					},
+					ProofChainLength: 10,
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 400,
+					},
+					ProofChainLength: 2,
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 700,
+					},
+					ProofChainLength: 2,
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 900,
+					},
+					ProofChainLength: 1,
+				},
+			},
+			strategy: PreferShortProofChain,
+			expectedCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 900,
+					},
+					ProofChainLength: 1,
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 700,
+					},
+					ProofChainLength: 2,
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -264,3 +433,232 @@ func TestCoinSelection(t *testing.T) {
 		})
 	}
 }
+
+// TestPinnedCoinSelection tests that pinned inputs are selected regardless of
+// the selection strategy.
+func TestPinnedCoinSelection(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxb      = context.Background()
+		pinnedOp1 = test.RandOp(t)
+		pinnedOp2 = test.RandOp(t)
+		otherOp   = test.RandOp(t)
+	)
+
+	newCommitment := func(op wire.OutPoint,
+		amount uint64) *AnchoredCommitment {
+
+		return &AnchoredCommitment{
+			AnchorPoint: op,
+			Asset: &asset.Asset{
+				Amount: amount,
+			},
+			Commitment: &commitment.TapCommitment{
+				Version: commitment.TapCommitmentV1,
+			},
+		}
+	}
+
+	coinLister := newMockCoinLister([]*AnchoredCommitment{
+		newCommitment(pinnedOp1, 300),
+		newCommitment(otherOp, 5000),
+		newCommitment(pinnedOp2, 800),
+	})
+
+	// The lister signals are buffered, so we drain them in the
+	// background.
+	done := make(chan struct{})
+	t.Cleanup(func() {
+		close(done)
+	})
+	go func() {
+		for {
+			select {
+			case <-coinLister.listSignals:
+			case <-coinLister.leaseSignals:
+			case <-coinLister.deleteSignals:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	coinSelect := NewCoinSelect(coinLister)
+	selectPinned := func(amount uint64,
+		pinned ...wire.OutPoint) ([]*AnchoredCommitment, error) {
+
+		return coinSelect.SelectCoins(
+			ctxb, CommitmentConstraints{
+				MinAmt:       amount,
+				PinnedInputs: pinned,
+			}, PreferMaxAmount, commitment.TapCommitmentV1,
+		)
+	}
+
+	// Even though the largest commitment would be preferred by the
+	// strategy, only the pinned inputs should be selected.
+	selected, err := selectPinned(1000, pinnedOp1, pinnedOp2)
+	require.NoError(t, err)
+	require.Len(t, selected, 2)
+	require.ElementsMatch(
+		t, []wire.OutPoint{pinnedOp1, pinnedOp2},
+		fn.Map(selected, func(c *AnchoredCommitment) wire.OutPoint {
+			return c.AnchorPoint
+		}),
+	)
+
+	// If the pinned inputs don't cover the amount, we expect an error.
+	_, err = selectPinned(1000, pinnedOp1)
+	require.ErrorIs(t, err, ErrMatchingAssetsNotFound)
+
+	// The same is true if a pinned input isn't eligible.
+	_, err = selectPinned(100, pinnedOp1, test.RandOp(t))
+	require.ErrorIs(t, err, ErrMatchingAssetsNotFound)
+}
+
+// TestSelectAllCoins tests that all compatible coins are returned regardless
+// of their amount, and that they're only leased when selected.
+func TestSelectAllCoins(t *testing.T) {
+	t.Parallel()
+
+	ctxb := context.Background()
+	newCommitment := func(amount uint64,
+		version commitment.TapCommitmentVersion) *AnchoredCommitment {
+
+		return &AnchoredCommitment{
+			AnchorPoint: test.RandOp(t),
+			Asset: &asset.Asset{
+				Amount: amount,
+			},
+			Commitment: &commitment.TapCommitment{
+				Version: version,
+			},
+		}
+	}
+
+	coinLister := newMockCoinLister([]*AnchoredCommitment{
+		newCommitment(1, commitment.TapCommitmentV1),
+		newCommitment(5000, commitment.TapCommitmentV2),
+		newCommitment(300, commitment.TapCommitmentV1),
+	})
+	coinSelect := NewCoinSelect(coinLister)
+
+	// Listing all coins shouldn't lease any of them, and should skip the
+	// coin anchored in a newer commitment version.
+	listed, err := coinSelect.ListAllCoins(
+		ctxb, CommitmentConstraints{}, commitment.TapCommitmentV1,
+	)
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	require.Equal(t, uint64(301), sumAmounts(listed))
+
+	<-coinLister.deleteSignals
+	<-coinLister.listSignals
+	select {
+	case <-coinLister.leaseSignals:
+		t.Fatalf("listed coins should not be leased")
+	default:
+	}
+
+	// Selecting all coins should return all compatible coins and lease
+	// them.
+	selected, err := coinSelect.SelectAllCoins(
+		ctxb, CommitmentConstraints{}, commitment.TapCommitmentV2,
+	)
+	require.NoError(t, err)
+	require.Len(t, selected, 3)
+
+	<-coinLister.deleteSignals
+	<-coinLister.listSignals
+	<-coinLister.leaseSignals
+}
+
+// TestSelectCoinsForAssets tests that the coins of several assets can be
+// selected from the same anchor output, that each coin is only selected once
+// and that the anchor outputs are only leased if all requests succeed.
+func TestSelectCoinsForAssets(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxb     = context.Background()
+		sharedOp = test.RandOp(t)
+		timeout  = 20 * time.Millisecond
+	)
+
+	newCommitment := func(op wire.OutPoint,
+		amount uint64) *AnchoredCommitment {
+
+		coinAsset := asset.RandAsset(t, asset.Normal)
+		coinAsset.Amount = amount
+
+		return &AnchoredCommitment{
+			AnchorPoint: op,
+			Asset:       coinAsset,
+			Commitment: &commitment.TapCommitment{
+				Version: commitment.TapCommitmentV1,
+			},
+		}
+	}
+
+	coinA := newCommitment(sharedOp, 600)
+	coinB := newCommitment(sharedOp, 500)
+	coinLister := newMockCoinLister([]*AnchoredCommitment{coinA, coinB})
+
+	// Each request lists the coins, so we drain those signals in the
+	// background.
+	done := make(chan struct{})
+	t.Cleanup(func() {
+		close(done)
+	})
+	go func() {
+		for {
+			select {
+			case <-coinLister.listSignals:
+			case <-coinLister.deleteSignals:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	coinSelect := NewCoinSelect(coinLister)
+	newRequest := func(amount uint64) CoinRequest {
+		return CoinRequest{
+			Constraints: CommitmentConstraints{
+				MinAmt: amount,
+			},
+			MaxVersion: commitment.TapCommitmentV1,
+		}
+	}
+
+	// The mock lister returns both coins for each request, but the second
+	// request can't select the coin the first one already selected.
+	selected, err := coinSelect.SelectCoinsForAssets(
+		ctxb, []CoinRequest{newRequest(500), newRequest(500)},
+		PreferMaxAmount,
+	)
+	require.NoError(t, err)
+	require.Equal(t, [][]*AnchoredCommitment{{coinA}, {coinB}}, selected)
+
+	_, err = fn.RecvOrTimeout(coinLister.leaseSignals, timeout)
+	require.NoError(t, err)
+
+	// If the coins of one request can't be selected, we learn which one
+	// and nothing is leased.
+	coinLister.eligibleCommitments = []*AnchoredCommitment{
+		newCommitment(test.RandOp(t), 1000),
+	}
+	_, err = coinSelect.SelectCoinsForAssets(
+		ctxb, []CoinRequest{newRequest(100), newRequest(100)},
+		PreferMaxAmount,
+	)
+
+	var selectErr *AssetSelectionError
+	require.ErrorAs(t, err, &selectErr)
+	require.Equal(t, 1, selectErr.Index)
+	require.ErrorIs(t, err, ErrMatchingAssetsNotFound)
+
+	_, err = fn.RecvOrTimeout(coinLister.leaseSignals, timeout)
+	require.Error(t, err)
+}
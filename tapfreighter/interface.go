@@ -2,6 +2,7 @@ package tapfreighter
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -18,7 +19,9 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/tappsbt"
 	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightninglabs/taproot-assets/tapsend"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 // CommitmentConstraints conveys the constraints on the type of Taproot asset
@@ -43,6 +46,12 @@ type CommitmentConstraints struct {
 	// Bip86ScriptKeysOnly is a flag that when set, will exclude any assets
 	// that have a script key with a tapscript tree (a non-empty tweak).
 	Bip86ScriptKeysOnly bool
+
+	// PinnedInputs is an optional list of anchor outpoints that must be
+	// spent. If set, only assets anchored at these outpoints are selected,
+	// regardless of the selection strategy, and their sum must satisfy
+	// the minimum amount.
+	PinnedInputs []wire.OutPoint
 }
 
 // String returns the string representation of the commitment constraints.
@@ -85,6 +94,22 @@ type AnchoredCommitment struct {
 	// Asset is the asset that ratifies the above constraints, and should
 	// be used as an input to a transaction.
 	Asset *asset.Asset
+
+	// ProofChainLength is the number of proofs in the proof file of the
+	// asset, which is the number of state transitions since its genesis
+	// plus one. This is only populated by the coin selector when a
+	// strategy that depends on it is used.
+	ProofChainLength uint32
+}
+
+// PrevID returns the previous ID of the asset of the commitment, which
+// identifies it as an input of a transfer.
+func (c *AnchoredCommitment) PrevID() asset.PrevID {
+	return asset.PrevID{
+		OutPoint:  c.AnchorPoint,
+		ID:        c.Asset.ID(),
+		ScriptKey: asset.ToSerialized(c.Asset.ScriptKey.PubKey),
+	}
 }
 
 var (
@@ -94,6 +119,11 @@ var (
 	ErrMatchingAssetsNotFound = fmt.Errorf("failed to find coin(s) that " +
 		"satisfy given constraints; if previous transfers are un-" +
 		"confirmed, wait for them to confirm before trying again")
+
+	// ErrNoPendingTransfer is returned when a fee bump is requested for a
+	// transfer that isn't waiting for its anchor transaction to confirm.
+	ErrNoPendingTransfer = errors.New("no transfer waiting for " +
+		"confirmation")
 )
 
 // CoinLister attracts over the coin selection process needed to be
@@ -120,6 +150,11 @@ type CoinLister interface {
 
 	// DeleteExpiredLeases deletes all expired leases from the database.
 	DeleteExpiredLeases(ctx context.Context) error
+
+	// ProofChainLength returns the number of proofs in the proof file of
+	// the asset identified by the given previous ID.
+	ProofChainLength(ctx context.Context, prevID asset.PrevID) (uint32,
+		error)
 }
 
 // MultiCommitmentSelectStrategy is an enum that describes the strategy that
@@ -131,8 +166,46 @@ const (
 	// descending amounts and selects the first subset which cumulatively
 	// sums to at least the minimum target amount.
 	PreferMaxAmount MultiCommitmentSelectStrategy = iota
+
+	// PreferMinAmount is a strategy which considers commitments in order of
+	// ascending amounts and selects the first subset which cumulatively
+	// sums to at least the minimum target amount. This consolidates small
+	// commitments over time, at the cost of larger transfers.
+	PreferMinAmount
+
+	// PreferExactMatch is a strategy which searches for a subset of
+	// commitments that sums to exactly the minimum target amount, so no
+	// asset change output is created. If no such subset is found within
+	// the search bound, the PreferMaxAmount strategy is used instead.
+	PreferExactMatch
+
+	// PreferShortProofChain is a strategy which considers commitments in
+	// order of ascending proof chain length, so the proofs of the created
+	// outputs stay as small as possible. Commitments with the same proof
+	// chain length are considered in order of descending amounts.
+	PreferShortProofChain
 )
 
+// String returns a human-readable string for the strategy.
+func (s MultiCommitmentSelectStrategy) String() string {
+	switch s {
+	case PreferMaxAmount:
+		return "PreferMaxAmount"
+
+	case PreferMinAmount:
+		return "PreferMinAmount"
+
+	case PreferExactMatch:
+		return "PreferExactMatch"
+
+	case PreferShortProofChain:
+		return "PreferShortProofChain"
+
+	default:
+		return fmt.Sprintf("<unknown strategy %d>", s)
+	}
+}
+
 // CoinSelector is an interface that describes the functionality used in
 // selecting coins during the asset send process.
 type CoinSelector interface {
@@ -145,11 +218,62 @@ type CoinSelector interface {
 	) ([]*AnchoredCommitment,
 		error)
 
+	// SelectCoinsForAssets selects coins for each of the given requests,
+	// the same way SelectCoins does for a single set of constraints. A
+	// coin is selected at most once, but the coins of different requests
+	// can share an anchor output. The coins are returned in the order of
+	// the requests and are leased for the default lease duration. If the
+	// coins of a request can't be selected, an *AssetSelectionError is
+	// returned.
+	SelectCoinsForAssets(ctx context.Context, requests []CoinRequest,
+		strategy MultiCommitmentSelectStrategy,
+	) ([][]*AnchoredCommitment, error)
+
+	// SelectAllCoins returns all not yet leased coins that satisfy the
+	// given constraints, ignoring the minimum amount. The coins returned
+	// are leased for the default lease duration.
+	SelectAllCoins(ctx context.Context, constraints CommitmentConstraints,
+		maxVersion commitment.TapCommitmentVersion,
+	) ([]*AnchoredCommitment, error)
+
 	// ReleaseCoins releases/unlocks coins that were previously leased and
 	// makes them available for coin selection again.
 	ReleaseCoins(ctx context.Context, utxoOutpoints ...wire.OutPoint) error
 }
 
+// CoinRequest describes the coins to select for one of the assets spent by a
+// send of several assets.
+type CoinRequest struct {
+	// Constraints are the constraints the selected coins must satisfy.
+	Constraints CommitmentConstraints
+
+	// MaxVersion is the maximum version of the Taproot Asset commitment
+	// the selected coins can be anchored in.
+	MaxVersion commitment.TapCommitmentVersion
+}
+
+// AssetSelectionError is returned if the coins for one of several coin
+// requests couldn't be selected.
+type AssetSelectionError struct {
+	// Index is the index of the request the coins couldn't be selected
+	// for.
+	Index int
+
+	// Err is the reason the coins couldn't be selected.
+	Err error
+}
+
+// Error returns the error message of the failed selection.
+func (e *AssetSelectionError) Error() string {
+	return fmt.Sprintf("unable to select coins for request %d: %v",
+		e.Index, e.Err)
+}
+
+// Unwrap returns the reason the coins couldn't be selected.
+func (e *AssetSelectionError) Unwrap() error {
+	return e.Err
+}
+
 // TransferInput represents the database level input to an asset transfer.
 type TransferInput struct {
 	// PrevID contains the anchor point, ID and script key of the asset that
@@ -270,6 +394,12 @@ type OutboundParcel struct {
 	// anchor transaction.
 	ChainFees int64
 
+	// AnchorPsbt is the funded, but unsigned, PSBT of the anchor
+	// transaction. It is used to create a replacement transaction that
+	// pays a higher fee. This is nil for parcels that were logged before
+	// the PSBT was stored.
+	AnchorPsbt *tapsend.FundedPsbt
+
 	// PassiveAssets is the set of passive assets that are re-anchored
 	// during the parcel confirmation process.
 	PassiveAssets []*tappsbt.VPacket
@@ -303,6 +433,10 @@ func (o *OutboundParcel) Copy() *OutboundParcel {
 		newParcel.AnchorTx = o.AnchorTx.Copy()
 	}
 
+	if o.AnchorPsbt != nil {
+		newParcel.AnchorPsbt = o.AnchorPsbt.Copy()
+	}
+
 	if o.PassiveAssetsAnchor != nil {
 		oldAnchor := o.PassiveAssetsAnchor
 		newParcel.PassiveAssetsAnchor = &Anchor{
@@ -369,6 +503,13 @@ type ExportLog interface {
 	// QueryParcels returns the set of confirmed or unconfirmed parcels.
 	QueryParcels(ctx context.Context, anchorTxHash *chainhash.Hash,
 		pending bool) ([]*OutboundParcel, error)
+
+	// ReplaceParcelAnchorTx replaces the anchor transaction of the pending
+	// parcel that is anchored in the transaction with the given ID with
+	// the anchor transaction of the given parcel. The anchor outputs and
+	// proofs of the parcel are updated to reference the new transaction.
+	ReplaceParcelAnchorTx(ctx context.Context,
+		oldAnchorTxHash chainhash.Hash, parcel *OutboundParcel) error
 }
 
 // ChainBridge aliases into the ChainBridge of the tapgarden package.
@@ -404,6 +545,12 @@ type Porter interface {
 		anchorTxHash fn.Option[chainhash.Hash], pending bool,
 	) ([]*OutboundParcel, error)
 
+	// BumpTransferFee replaces the unconfirmed anchor transaction of the
+	// pending parcel with the given anchor transaction hash with one that
+	// pays the given fee rate. The updated parcel is returned.
+	BumpTransferFee(ctx context.Context, anchorTxHash chainhash.Hash,
+		feeRate chainfee.SatPerKWeight) (*OutboundParcel, error)
+
 	// Start signals that the asset minter should being operations.
 	Start() error
 
@@ -135,6 +135,10 @@ type AddressParcel struct {
 	// transferFeeRate is an optional manually-set feerate specified when
 	// requesting an asset transfer.
 	transferFeeRate *chainfee.SatPerKWeight
+
+	// fundOpts are the options that modify the coin selection of the
+	// transfer.
+	fundOpts []FundOption
 }
 
 // A compile-time assertion to ensure AddressParcel implements the parcel
@@ -143,7 +147,7 @@ var _ Parcel = (*AddressParcel)(nil)
 
 // NewAddressParcel creates a new AddressParcel.
 func NewAddressParcel(feeRate *chainfee.SatPerKWeight,
-	destAddrs ...*address.Tap) *AddressParcel {
+	destAddrs []*address.Tap, fundOpts ...FundOption) *AddressParcel {
 
 	return &AddressParcel{
 		parcelKit: &parcelKit{
@@ -152,6 +156,7 @@ func NewAddressParcel(feeRate *chainfee.SatPerKWeight,
 		},
 		destAddrs:       destAddrs,
 		transferFeeRate: feeRate,
+		fundOpts:        fundOpts,
 	}
 }
 
@@ -255,6 +260,10 @@ type PreSignedParcel struct {
 	// inputCommitments are the commitments for the input that are being
 	// spent in the virtual transaction.
 	inputCommitments tappsbt.InputCommitments
+
+	// transferFeeRate is an optional manually-set feerate specified when
+	// requesting an asset transfer.
+	transferFeeRate *chainfee.SatPerKWeight
 }
 
 // A compile-time assertion to ensure PreSignedParcel implements the parcel
@@ -263,7 +272,8 @@ var _ Parcel = (*PreSignedParcel)(nil)
 
 // NewPreSignedParcel creates a new PreSignedParcel.
 func NewPreSignedParcel(vPackets []*tappsbt.VPacket,
-	inputCommitments tappsbt.InputCommitments) *PreSignedParcel {
+	inputCommitments tappsbt.InputCommitments,
+	feeRate *chainfee.SatPerKWeight) *PreSignedParcel {
 
 	return &PreSignedParcel{
 		parcelKit: &parcelKit{
@@ -272,6 +282,7 @@ func NewPreSignedParcel(vPackets []*tappsbt.VPacket,
 		},
 		vPackets:         vPackets,
 		inputCommitments: inputCommitments,
+		transferFeeRate:  feeRate,
 	}
 }
 
@@ -505,6 +516,7 @@ func ConvertToTransfer(currentHeight uint32, activeTransfers []*tappsbt.VPacket,
 		// TODO(bhandras): use clock.Clock instead.
 		TransferTime: time.Now(),
 		ChainFees:    anchorTx.ChainFees,
+		AnchorPsbt:   anchorTx.FundedPsbt,
 		Inputs:       make([]TransferInput, 0, len(activeTransfers)),
 		Outputs: make(
 			// This is just a heuristic to pre-allocate something,
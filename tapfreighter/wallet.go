@@ -20,11 +20,13 @@ import (
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/tappsbt"
 	"github.com/lightninglabs/taproot-assets/tapscript"
 	"github.com/lightninglabs/taproot-assets/tapsend"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -67,20 +69,37 @@ type Wallet interface {
 	// data which assists in processing the virtual transaction: passive
 	// asset re-anchors and the Taproot Asset level commitment of the
 	// selected assets.
-	FundAddressSend(ctx context.Context,
-		receiverAddrs ...*address.Tap) (*FundedVPacket, error)
+	FundAddressSend(ctx context.Context, receiverAddrs []*address.Tap,
+		fundOpts ...FundOption) (*FundedVPacket, error)
+
+	// FundAddressSends funds the virtual transactions needed to pay the
+	// given addresses, which may request different assets. One virtual
+	// packet is funded for each asset ID, and all packets can be anchored
+	// in the same BTC level anchor transaction. A payment to a reusable
+	// address of an asset group may be funded with several asset IDs of
+	// the group.
+	FundAddressSends(ctx context.Context, receiverAddrs []*address.Tap,
+		fundOpts ...FundOption) ([]*FundedVPacket, error)
 
 	// FundPacket funds a virtual transaction, selecting assets to spend
 	// in order to pay the given recipient. The selected input is then added
 	// to the given virtual transaction.
 	FundPacket(ctx context.Context, fundDesc *tapsend.FundingDescriptor,
-		vPkt *tappsbt.VPacket) (*FundedVPacket, error)
+		vPkt *tappsbt.VPacket,
+		fundOpts ...FundOption) (*FundedVPacket, error)
 
 	// FundBurn funds a virtual transaction for burning the given amount of
 	// units of the given asset.
 	FundBurn(ctx context.Context,
 		fundDesc *tapsend.FundingDescriptor) (*FundedVPacket, error)
 
+	// FundConsolidation funds virtual transactions that spend all
+	// available coins matching the given constraints back to the wallet,
+	// into a single new anchor output. One virtual packet is returned for
+	// each asset ID of the selected coins.
+	FundConsolidation(ctx context.Context,
+		constraints CommitmentConstraints) ([]*FundedVPacket, error)
+
 	// SignVirtualPacket signs the virtual transaction of the given packet
 	// and returns the input indexes that were signed.
 	SignVirtualPacket(vPkt *tappsbt.VPacket,
@@ -120,6 +139,12 @@ type Wallet interface {
 		tweakedScriptKey *btcec.PublicKey) (*asset.TweakedScriptKey,
 		error)
 
+	// AddScriptKeySpendInfo adds the key aggregation context or the output
+	// descriptor of a local script key to the asset of the given virtual
+	// input, if the script key was imported with either of them. Without
+	// that information, the input can't be signed.
+	AddScriptKeySpendInfo(ctx context.Context, vIn *tappsbt.VInput) error
+
 	// FetchInternalKeyLocator attempts to fetch the key locator information
 	// for the given raw internal key. If the key cannot be found, then
 	// address.ErrInternalKeyNotFound is returned.
@@ -183,6 +208,11 @@ type WalletConfig struct {
 	// virtual transaction.
 	Signer Signer
 
+	// MuSig2Signer implements the Taproot Asset level MuSig2 signing we
+	// need to sign inputs of a virtual transaction that are locked to a
+	// MuSig2 script key.
+	MuSig2Signer tapscript.MuSig2Signer
+
 	// TxValidator allows us to validate each Taproot Asset virtual
 	// transaction we create.
 	TxValidator tapscript.TxValidator
@@ -224,6 +254,45 @@ type FundedVPacket struct {
 	InputCommitments tappsbt.InputCommitments
 }
 
+// FundOptions is a set of options that can be used to modify the coin
+// selection when funding a virtual transaction.
+type FundOptions struct {
+	// Strategy is the coin selection strategy to use.
+	Strategy MultiCommitmentSelectStrategy
+
+	// PinnedInputs is an optional list of anchor outpoints that must be
+	// spent instead of selecting coins with the above strategy.
+	PinnedInputs []wire.OutPoint
+}
+
+// defaultFundOptions returns the set of default options for the funding
+// functions.
+func defaultFundOptions() *FundOptions {
+	return &FundOptions{
+		Strategy: PreferMaxAmount,
+	}
+}
+
+// FundOption is a functional option that allows a caller to modify the coin
+// selection when funding a virtual transaction.
+type FundOption func(*FundOptions)
+
+// WithCoinSelectStrategy sets the coin selection strategy to use when funding
+// a virtual transaction.
+func WithCoinSelectStrategy(strategy MultiCommitmentSelectStrategy) FundOption {
+	return func(o *FundOptions) {
+		o.Strategy = strategy
+	}
+}
+
+// WithPinnedInputs sets the anchor outpoints that must be spent when funding
+// a virtual transaction.
+func WithPinnedInputs(pinnedInputs ...wire.OutPoint) FundOption {
+	return func(o *FundOptions) {
+		o.PinnedInputs = pinnedInputs
+	}
+}
+
 // FundAddressSend funds a virtual transaction, selecting assets to spend in
 // order to pay the given address. It also returns supporting data which assists
 // in processing the virtual transaction: passive asset re-anchors and the
@@ -231,7 +300,8 @@ type FundedVPacket struct {
 //
 // NOTE: This is part of the Wallet interface.
 func (f *AssetWallet) FundAddressSend(ctx context.Context,
-	receiverAddrs ...*address.Tap) (*FundedVPacket, error) {
+	receiverAddrs []*address.Tap,
+	fundOpts ...FundOption) (*FundedVPacket, error) {
 
 	// We start by creating a new virtual transaction that will be used to
 	// hold the asset transfer. Because sending to an address is always a
@@ -248,14 +318,495 @@ func (f *AssetWallet) FundAddressSend(ctx context.Context,
 		return nil, fmt.Errorf("unable to describe recipients: %w", err)
 	}
 
-	fundedVPkt, err := f.FundPacket(ctx, fundDesc, vPkt)
+	// A single virtual packet can only spend coins of one asset ID, so
+	// addresses of an asset group can't be funded with several asset IDs
+	// of the group here, even if they support it.
+	fundedVPkt, err := f.FundPacket(ctx, fundDesc, vPkt, fundOpts...)
 	if err != nil {
-		return nil, err
+		return nil, groupFundingErr(receiverAddrs, true, err)
 	}
 
 	return fundedVPkt, nil
 }
 
+// FundAddressSends funds the virtual transactions needed to pay the given
+// addresses, which may request different assets. One virtual packet is funded
+// for each asset ID, in the order the asset IDs first appear in the list of
+// addresses. All packets send their change to the anchor output with index
+// zero, while each address gets its own anchor output. That way all packets
+// can be anchored in a single BTC level anchor transaction.
+//
+// Payments to addresses that can be paid with any asset ID of their asset
+// group (see tapgarden.GroupSpendable) are funded with coins of all asset IDs
+// of the group. Such a payment is split over as many virtual packets as there
+// are asset IDs in the selected coins, each with its own change output.
+//
+// The coins of all packets are selected at once, so a single anchor output
+// can provide the coins of several assets of the send.
+//
+// NOTE: This is part of the Wallet interface.
+func (f *AssetWallet) FundAddressSends(ctx context.Context,
+	receiverAddrs []*address.Tap,
+	fundOpts ...FundOption) ([]*FundedVPacket, error) {
+
+	if len(receiverAddrs) == 0 {
+		return nil, fmt.Errorf("at least one address must be " +
+			"specified")
+	}
+
+	opts := defaultFundOptions()
+	for _, optFunc := range fundOpts {
+		optFunc(opts)
+	}
+
+	// The input and address networks must match.
+	for _, addr := range receiverAddrs {
+		hrp := addr.ChainParams.TapHRP
+		if !address.IsForNet(hrp, f.cfg.ChainParams) {
+			return nil, address.ErrMismatchedHRP
+		}
+	}
+
+	// Each asset ID of a group of collectibles is a distinct collectible,
+	// so those are sent by asset ID. We only know that a group is one of
+	// collectibles once we selected its coins, in which case we release
+	// them and select the coins of its addresses by asset ID instead.
+	var (
+		sends             []*addrSend
+		selectedCoins     []*AnchoredCommitment
+		collectibleGroups = fn.NewSet[asset.SerializedKey]()
+	)
+	for {
+		sends = newAddrSends(receiverAddrs, collectibleGroups)
+		err := f.selectAddrSendCoins(ctx, sends, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		selectedCoins = nil
+		numCollectibleGroups := len(collectibleGroups)
+		for _, send := range sends {
+			selectedCoins = append(selectedCoins, send.coins...)
+
+			if send.groupKey != nil &&
+				send.coins[0].Asset.Type != asset.Normal {
+
+				collectibleGroups.Add(
+					asset.ToSerialized(send.groupKey),
+				)
+			}
+		}
+
+		if len(collectibleGroups) == numCollectibleGroups {
+			break
+		}
+
+		f.releaseCoins(ctx, selectedCoins)
+	}
+
+	// If we return with an error, we want to release the coins we've
+	// selected.
+	success := false
+	defer func() {
+		if !success {
+			f.releaseCoins(ctx, selectedCoins)
+		}
+	}()
+
+	// We can't fund two packets for the same asset ID, as their change
+	// outputs would collide in the same anchor output.
+	fundedGroupIDs := fn.NewSet[asset.ID]()
+	for _, send := range sends {
+		if send.groupKey == nil {
+			continue
+		}
+
+		for _, coin := range send.coins {
+			fundedGroupIDs.Add(coin.Asset.ID())
+		}
+	}
+	for _, send := range sends {
+		assetID := send.addrs[0].AssetID
+		if send.groupKey == nil && fundedGroupIDs.Contains(assetID) {
+			return nil, fmt.Errorf("asset %v was already selected "+
+				"to pay a reusable address of its asset "+
+				"group, send to the other addresses separately",
+				assetID)
+		}
+	}
+
+	// The change of all packets goes to the same anchor output, which
+	// therefore needs the same internal key in each of them.
+	changeInternalKey, err := f.cfg.KeyRing.DeriveNextKey(
+		ctx, asset.TaprootAssetsKeyFamily,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	fundedPackets := make([]*FundedVPacket, 0, len(sends))
+	nextOutputIndex := uint32(1)
+	for _, send := range sends {
+		var sendPackets []*FundedVPacket
+		switch {
+		case send.groupKey != nil:
+			sendPackets, err = f.fundGroupSend(
+				ctx, send.addrs, send.coins, changeInternalKey,
+				nextOutputIndex,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to fund send "+
+					"of asset group %x: %w",
+					send.groupKey.SerializeCompressed(),
+					err)
+			}
+
+		default:
+			assetID := send.addrs[0].AssetID
+			fundDesc, err := tapsend.DescribeAddrs(send.addrs)
+			if err != nil {
+				return nil, fmt.Errorf("unable to describe "+
+					"recipients: %w", err)
+			}
+
+			fundedPkt, err := f.fundAddrsPacket(
+				ctx, send.addrs, fundDesc, send.coins,
+				changeInternalKey, nextOutputIndex,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to fund send "+
+					"of asset %v: %w", assetID, err)
+			}
+
+			sendPackets = []*FundedVPacket{fundedPkt}
+		}
+
+		for _, fundedPkt := range sendPackets {
+			numOutputs := len(fundedPkt.VPacket.Outputs)
+			nextOutputIndex += uint32(numOutputs - 1)
+		}
+		fundedPackets = append(fundedPackets, sendPackets...)
+	}
+
+	success = true
+	return fundedPackets, nil
+}
+
+// addrSend is the part of a send to several addresses that is funded with the
+// coins of a single asset ID, or with the coins of any asset ID of an asset
+// group.
+type addrSend struct {
+	// addrs are the addresses that are paid by this part of the send.
+	addrs []*address.Tap
+
+	// groupKey is the key of the asset group whose coins of any asset ID
+	// can pay the addresses. If this is nil, only coins of the asset ID
+	// of the addresses can be used.
+	groupKey *btcec.PublicKey
+
+	// coins are the coins that were selected to pay the addresses.
+	coins []*AnchoredCommitment
+}
+
+// newAddrSends splits a send to the given addresses into the parts that are
+// funded with the same coins. Within a single virtual packet we can only have
+// inputs and outputs of the same asset ID, so we group the addresses by asset
+// ID. The addresses that can be paid with any asset ID of their group are
+// grouped by group key instead, unless their group is one of the given groups
+// of collectibles. The parts of asset groups come first, followed by the parts
+// of asset IDs, each in the order they first appear in the addresses.
+func newAddrSends(addrs []*address.Tap,
+	collectibleGroups fn.Set[asset.SerializedKey]) []*addrSend {
+
+	var (
+		groupSends   []*addrSend
+		idSends      []*addrSend
+		sendsByGroup = make(map[asset.SerializedKey]*addrSend)
+		sendsByID    = make(map[asset.ID]*addrSend)
+	)
+	for _, addr := range addrs {
+		if tapgarden.GroupSpendable(addr) {
+			groupKey := asset.ToSerialized(addr.GroupKey)
+			if !collectibleGroups.Contains(groupKey) {
+				send, ok := sendsByGroup[groupKey]
+				if !ok {
+					send = &addrSend{
+						groupKey: addr.GroupKey,
+					}
+					sendsByGroup[groupKey] = send
+					groupSends = append(groupSends, send)
+				}
+				send.addrs = append(send.addrs, addr)
+
+				continue
+			}
+		}
+
+		send, ok := sendsByID[addr.AssetID]
+		if !ok {
+			send = &addrSend{}
+			sendsByID[addr.AssetID] = send
+			idSends = append(idSends, send)
+		}
+		send.addrs = append(send.addrs, addr)
+	}
+
+	return append(groupSends, idSends...)
+}
+
+// selectAddrSendCoins selects the coins for all the given parts of a send to
+// several addresses at once.
+func (f *AssetWallet) selectAddrSendCoins(ctx context.Context,
+	sends []*addrSend, opts *FundOptions) error {
+
+	requests := make([]CoinRequest, 0, len(sends))
+	for _, send := range sends {
+		firstAddr := send.addrs[0]
+
+		var totalAmt uint64
+		for _, addr := range send.addrs {
+			totalAmt += addr.Amount
+		}
+
+		// If we select coins by group key only, the selected coins can
+		// have any asset ID of the group.
+		constraints := CommitmentConstraints{
+			GroupKey:            firstAddr.GroupKey,
+			MinAmt:              totalAmt,
+			Bip86ScriptKeysOnly: true,
+			PinnedInputs:        opts.PinnedInputs,
+		}
+		if send.groupKey == nil {
+			constraints.AssetID = fn.Ptr(firstAddr.AssetID)
+		}
+
+		anchorVersion, err := address.CommitmentVersion(
+			firstAddr.Version,
+		)
+		if err != nil {
+			return err
+		}
+
+		if anchorVersion == nil {
+			anchorVersion = fn.Ptr(commitment.TapCommitmentV1)
+		}
+
+		requests = append(requests, CoinRequest{
+			Constraints: constraints,
+			MaxVersion:  *anchorVersion,
+		})
+	}
+
+	selectedCoins, err := f.cfg.CoinSelector.SelectCoinsForAssets(
+		ctx, requests, opts.Strategy,
+	)
+	var selectErr *AssetSelectionError
+	switch {
+	case errors.As(err, &selectErr):
+		send := sends[selectErr.Index]
+		if send.groupKey != nil {
+			return fmt.Errorf("unable to fund send of asset group "+
+				"%x: %w", send.groupKey.SerializeCompressed(),
+				selectErr.Err)
+		}
+
+		return fmt.Errorf("unable to fund send of asset %v: %w",
+			send.addrs[0].AssetID,
+			groupFundingErr(send.addrs, false, selectErr.Err))
+
+	case err != nil:
+		return err
+	}
+
+	for idx, send := range sends {
+		send.coins = selectedCoins[idx]
+	}
+
+	return nil
+}
+
+// groupFundingErr explains why a send to the given addresses couldn't be
+// funded, if the coin selection failed for an address of an asset group that
+// can only be paid with the asset ID it was created for. The receiver of such
+// an address only watches for the on-chain output of that asset ID, so the
+// coins of the other asset IDs of the group can't be used. If singlePacket is
+// true, the send is funded with a single virtual packet, which can't spend
+// more than one asset ID even for addresses that support it.
+func groupFundingErr(addrs []*address.Tap, singlePacket bool,
+	err error) error {
+
+	if !errors.Is(err, ErrMatchingAssetsNotFound) {
+		return err
+	}
+
+	for _, addr := range addrs {
+		if addr.GroupKey == nil {
+			continue
+		}
+
+		switch {
+		case !tapgarden.GroupSpendable(addr):
+			return fmt.Errorf("%w: only coins of asset %v can be "+
+				"used to pay the V%d address of asset group "+
+				"%x, several asset IDs of the group can only "+
+				"be sent to V2 addresses that use a universe "+
+				"or file proof courier", err, addr.AssetID,
+				addr.Version,
+				addr.GroupKey.SerializeCompressed())
+
+		case singlePacket:
+			return fmt.Errorf("%w: only coins of asset %v can be "+
+				"used to pay the address of asset group %x "+
+				"with a single virtual transaction, use "+
+				"SendAsset to spend several asset IDs of the "+
+				"group", err, addr.AssetID,
+				addr.GroupKey.SerializeCompressed())
+		}
+	}
+
+	return err
+}
+
+// fundGroupSend funds a send to the given addresses, which all belong to the
+// same asset group, with the given coins of any asset ID of that group. The
+// amount of each address is distributed over the asset IDs of the coins in the
+// order they were selected, and one virtual packet is funded for each asset
+// ID. Each packet gets its own change output in the anchor output with index
+// zero, so change is returned per asset ID. Every part of an address' amount
+// is sent to a separate anchor output, starting at the given index.
+func (f *AssetWallet) fundGroupSend(ctx context.Context,
+	receiverAddrs []*address.Tap, selectedCommitments []*AnchoredCommitment,
+	changeInternalKey keychain.KeyDescriptor,
+	firstOutputIndex uint32) ([]*FundedVPacket, error) {
+
+	// We split the selected coins by asset ID, keeping the order in which
+	// they were selected.
+	var (
+		coinsByID = make(map[asset.ID][]*AnchoredCommitment)
+		assetIDs  []asset.ID
+	)
+	for _, coin := range selectedCommitments {
+		assetID := coin.Asset.ID()
+		if _, ok := coinsByID[assetID]; !ok {
+			assetIDs = append(assetIDs, assetID)
+		}
+		coinsByID[assetID] = append(coinsByID[assetID], coin)
+	}
+
+	// Then we fill the addresses with the coins of each asset ID. Only the
+	// last asset ID we use can have change left over.
+	var (
+		firstAddr = receiverAddrs[0]
+		addrsByID = make(map[asset.ID][]*address.Tap)
+		addrIdx   int
+		addrAmt   = firstAddr.Amount
+	)
+	for _, assetID := range assetIDs {
+		var available uint64
+		for _, coin := range coinsByID[assetID] {
+			available += coin.Asset.Amount
+		}
+
+		for available > 0 && addrIdx < len(receiverAddrs) {
+			amt := min(available, addrAmt)
+
+			addrPart := receiverAddrs[addrIdx].Copy()
+			addrPart.Amount = amt
+			addrsByID[assetID] = append(
+				addrsByID[assetID], addrPart,
+			)
+
+			available -= amt
+			addrAmt -= amt
+			if addrAmt == 0 {
+				addrIdx++
+				if addrIdx < len(receiverAddrs) {
+					addrAmt = receiverAddrs[addrIdx].Amount
+				}
+			}
+		}
+	}
+
+	fundedPackets := make([]*FundedVPacket, 0, len(assetIDs))
+	nextOutputIndex := firstOutputIndex
+	for _, assetID := range assetIDs {
+		addrs := addrsByID[assetID]
+
+		// Pinned inputs might contain more asset IDs than we need to
+		// pay the addresses.
+		if len(addrs) == 0 {
+			return nil, fmt.Errorf("selected coins of asset %v "+
+				"aren't needed for the send", assetID)
+		}
+
+		fundDesc := &tapsend.FundingDescriptor{
+			ID:       assetID,
+			GroupKey: firstAddr.GroupKey,
+		}
+		for _, addr := range addrs {
+			fundDesc.Amount += addr.Amount
+		}
+
+		fundedPkt, err := f.fundAddrsPacket(
+			ctx, addrs, fundDesc, coinsByID[assetID],
+			changeInternalKey, nextOutputIndex,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fund send of asset "+
+				"%v: %w", assetID, err)
+		}
+		nextOutputIndex += uint32(len(addrs))
+
+		fundedPackets = append(fundedPackets, fundedPkt)
+	}
+
+	return fundedPackets, nil
+}
+
+// fundAddrsPacket creates a virtual packet that pays the given addresses, all
+// of the same asset ID, and funds it with the given coins. The addresses are
+// paid to separate anchor outputs, starting at the given index, while the
+// change goes to the anchor output with index zero, using the given internal
+// key.
+func (f *AssetWallet) fundAddrsPacket(ctx context.Context,
+	addrs []*address.Tap, fundDesc *tapsend.FundingDescriptor,
+	selectedCommitments []*AnchoredCommitment,
+	changeInternalKey keychain.KeyDescriptor,
+	firstOutputIndex uint32) (*FundedVPacket, error) {
+
+	vPkt, err := tappsbt.FromAddresses(addrs, firstOutputIndex)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create virtual transaction "+
+			"from addresses: %w", err)
+	}
+
+	// The first output created from the addresses is the split root change
+	// output.
+	vPkt.Outputs[0].SetAnchorInternalKey(
+		changeInternalKey, f.cfg.ChainParams.HDCoinType,
+	)
+
+	return f.fundPacketWithInputs(ctx, fundDesc, vPkt, selectedCommitments)
+}
+
+// releaseCoins releases the anchor outputs of the given coins. Any error is
+// only logged, as this is used to clean up after a failed funding attempt.
+func (f *AssetWallet) releaseCoins(ctx context.Context,
+	coins []*AnchoredCommitment) {
+
+	if len(coins) == 0 {
+		return
+	}
+
+	outpoints := fn.Map(coins, func(c *AnchoredCommitment) wire.OutPoint {
+		return c.AnchorPoint
+	})
+	err := f.cfg.CoinSelector.ReleaseCoins(ctx, outpoints...)
+	if err != nil {
+		log.Errorf("Unable to release coins: %v", err)
+	}
+}
+
 // createPassivePacket creates a virtual packet for the given passive asset.
 func createPassivePacket(params *address.ChainParams, passiveAsset *asset.Asset,
 	activePackets []*tappsbt.VPacket, anchorOutputIndex uint32,
@@ -354,8 +905,13 @@ func createPassivePacket(params *address.ChainParams, passiveAsset *asset.Asset,
 // pay the given recipient. The selected input is then added to the given
 // virtual transaction.
 func (f *AssetWallet) FundPacket(ctx context.Context,
-	fundDesc *tapsend.FundingDescriptor,
-	vPkt *tappsbt.VPacket) (*FundedVPacket, error) {
+	fundDesc *tapsend.FundingDescriptor, vPkt *tappsbt.VPacket,
+	fundOpts ...FundOption) (*FundedVPacket, error) {
+
+	opts := defaultFundOptions()
+	for _, optFunc := range fundOpts {
+		optFunc(opts)
+	}
 
 	// The input and address networks must match.
 	if !address.IsForNet(vPkt.ChainParams.TapHRP, f.cfg.ChainParams) {
@@ -370,6 +926,7 @@ func (f *AssetWallet) FundPacket(ctx context.Context,
 		AssetID:             &fundDesc.ID,
 		MinAmt:              fundDesc.Amount,
 		Bip86ScriptKeysOnly: true,
+		PinnedInputs:        opts.PinnedInputs,
 	}
 
 	anchorVersion, err := tappsbt.CommitmentVersion(vPkt.Version)
@@ -382,7 +939,7 @@ func (f *AssetWallet) FundPacket(ctx context.Context,
 	}
 
 	selectedCommitments, err := f.cfg.CoinSelector.SelectCoins(
-		ctx, constraints, PreferMaxAmount, *anchorVersion,
+		ctx, constraints, opts.Strategy, *anchorVersion,
 	)
 	if err != nil {
 		return nil, err
@@ -554,6 +1111,152 @@ func (f *AssetWallet) FundBurn(ctx context.Context,
 	return fundedPkt, nil
 }
 
+// FundConsolidation funds virtual transactions that spend all available coins
+// matching the given constraints back to the wallet, into a single new anchor
+// output. One virtual packet is returned for each asset ID of the selected
+// coins.
+func (f *AssetWallet) FundConsolidation(ctx context.Context,
+	constraints CommitmentConstraints) ([]*FundedVPacket, error) {
+
+	// We only consolidate coins we can sign for without any additional
+	// information.
+	constraints.Bip86ScriptKeysOnly = true
+	selectedCommitments, err := f.cfg.CoinSelector.SelectAllCoins(
+		ctx, constraints, commitment.TapCommitmentV2,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// If we return with an error, we want to release the coins we've
+	// selected.
+	success := false
+	defer func() {
+		if !success {
+			outpoints := fn.Map(
+				selectedCommitments,
+				func(c *AnchoredCommitment) wire.OutPoint {
+					return c.AnchorPoint
+				},
+			)
+			err := f.cfg.CoinSelector.ReleaseCoins(
+				ctx, outpoints...,
+			)
+			if err != nil {
+				log.Errorf("Unable to release coins: %v", err)
+			}
+		}
+	}()
+
+	if len(selectedCommitments) < 2 {
+		return nil, fmt.Errorf("need at least two coins to "+
+			"consolidate, found %d", len(selectedCommitments))
+	}
+
+	// Collectibles can't be merged, so we only allow consolidating
+	// normal assets.
+	coinsByID := make(map[asset.ID][]*AnchoredCommitment)
+	var assetIDs []asset.ID
+	for _, c := range selectedCommitments {
+		if c.Asset.Type != asset.Normal {
+			return nil, fmt.Errorf("cannot consolidate asset %v "+
+				"of type %v", c.Asset.ID(), c.Asset.Type)
+		}
+
+		assetID := c.Asset.ID()
+		if _, ok := coinsByID[assetID]; !ok {
+			assetIDs = append(assetIDs, assetID)
+		}
+		coinsByID[assetID] = append(coinsByID[assetID], c)
+	}
+
+	// All assets are sent to the same anchor output, so we only need a
+	// single new internal key.
+	newInternalKey, err := f.cfg.KeyRing.DeriveNextKey(
+		ctx, asset.TaprootAssetsKeyFamily,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	fundedPackets := make([]*FundedVPacket, 0, len(assetIDs))
+	for _, assetID := range assetIDs {
+		coins := coinsByID[assetID]
+
+		totalAmount := sumAmounts(coins)
+		maxVersion := fn.Reduce(
+			coins, func(maxVersion asset.Version,
+				c *AnchoredCommitment) asset.Version {
+
+				if c.Asset.Version > maxVersion {
+					return c.Asset.Version
+				}
+
+				return maxVersion
+			},
+		)
+
+		scriptKeyDesc, err := f.cfg.KeyRing.DeriveNextKey(
+			ctx, asset.TaprootAssetsKeyFamily,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// The full amount of each asset ID goes to a fresh script key
+		// in the shared anchor output, so there's no change.
+		vPkt := &tappsbt.VPacket{
+			Inputs: []*tappsbt.VInput{{
+				PrevID: asset.PrevID{
+					ID: assetID,
+				},
+			}},
+			Outputs: []*tappsbt.VOutput{{
+				Amount:            totalAmount,
+				Type:              tappsbt.TypeSimple,
+				Interactive:       true,
+				AnchorOutputIndex: 0,
+				AssetVersion:      maxVersion,
+				ScriptKey: asset.NewScriptKeyBip86(
+					scriptKeyDesc,
+				),
+			}},
+			ChainParams: f.cfg.ChainParams,
+			Version:     tappsbt.V1,
+		}
+		vPkt.Outputs[0].SetAnchorInternalKey(
+			newInternalKey, f.cfg.ChainParams.HDCoinType,
+		)
+
+		// The group key is part of the commitment key, so we need to
+		// take it from the selected coins, even if we selected by
+		// asset ID.
+		var groupKey *btcec.PublicKey
+		if coins[0].Asset.GroupKey != nil {
+			groupKey = &coins[0].Asset.GroupKey.GroupPubKey
+		}
+
+		fundDesc := &tapsend.FundingDescriptor{
+			ID:       assetID,
+			GroupKey: groupKey,
+			Amount:   totalAmount,
+		}
+		fundedPkt, err := f.fundPacketWithInputs(
+			ctx, fundDesc, vPkt, coins,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		fundedPackets = append(fundedPackets, fundedPkt)
+	}
+
+	// Don't release the coins we've selected, as so far we've been
+	// successful.
+	success = true
+	return fundedPackets, nil
+}
+
 // hasOtherAssets returns true if the given input commitments contain any other
 // assets than the ones given in the virtual packets.
 func (f *AssetWallet) hasOtherAssets(inputCommitments tappsbt.InputCommitments,
@@ -790,6 +1493,15 @@ func (f *AssetWallet) setVPacketInputs(ctx context.Context,
 				"input: %w", err)
 		}
 
+		// The assets loaded from the database only carry the raw key
+		// and tweak of their script key. Inputs locked to a MuSig2 or
+		// descriptor script key need the full spend information to be
+		// signed.
+		err = f.AddScriptKeySpendInfo(ctx, vPkt.Inputs[idx])
+		if err != nil {
+			return nil, err
+		}
+
 		prevID := vPkt.Inputs[idx].PrevID
 		inputCommitments[prevID] = assetInput.Commitment
 	}
@@ -942,6 +1654,9 @@ type SignVirtualPacketOptions struct {
 
 	// WitnessValidator validates a signature after it's been created.
 	WitnessValidator tapscript.WitnessValidator
+
+	// Ctx is the context used for the calls to the MuSig2 signer.
+	Ctx context.Context
 }
 
 // defaultSignVirtualPacketOptions returns the set of default options for the
@@ -951,6 +1666,7 @@ func defaultSignVirtualPacketOptions(
 
 	return &SignVirtualPacketOptions{
 		WitnessValidator: defaultValidator,
+		Ctx:              context.Background(),
 	}
 }
 
@@ -976,6 +1692,15 @@ func WithValidator(
 	}
 }
 
+// WithSignContext sets an optional argument that allows the caller to specify
+// the context of the calls to the MuSig2 signer, so they are canceled together
+// with the caller.
+func WithSignContext(ctx context.Context) SignVirtualPacketOption {
+	return func(o *SignVirtualPacketOptions) {
+		o.Ctx = ctx
+	}
+}
+
 // SignVirtualPacket signs the virtual transaction of the given packet and
 // returns the input indexes that were signed (referring to the virtual
 // transaction's inputs).
@@ -1006,10 +1731,21 @@ func (f *AssetWallet) SignVirtualPacket(vPkt *tappsbt.VPacket,
 		}
 	}
 
-	// Now we'll use the signer to sign all the inputs for the new Taproot
-	// Asset leaves. The witness data for each input will be assigned for
-	// us.
-	err := tapsend.SignVirtualTransaction(
+	// Inputs that are locked to a MuSig2 script key are signed in multiple
+	// rounds together with the other signers of the key. We advance each
+	// of those inputs to the next step we can take on our own.
+	muSig2Inputs, err := tapsend.SignMuSig2Inputs(
+		opts.Ctx, vPkt, f.cfg.MuSig2Signer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to advance MuSig2 signing: %w",
+			err)
+	}
+
+	// Now we'll use the signer to sign all the other inputs for the new
+	// Taproot Asset leaves. The witness data for each input will be
+	// assigned for us.
+	err = tapsend.SignVirtualTransaction(
 		vPkt, f.cfg.Signer, opts.WitnessValidator,
 	)
 	if err != nil {
@@ -1017,10 +1753,17 @@ func (f *AssetWallet) SignVirtualPacket(vPkt *tappsbt.VPacket,
 			"witness data: %w", err)
 	}
 
-	// Mark all inputs as signed.
-	signedInputs := make([]uint32, len(vPkt.Inputs))
-	for idx := range vPkt.Inputs {
-		signedInputs[idx] = uint32(idx)
+	// Mark all inputs as signed, except for the MuSig2 inputs we didn't
+	// make any progress on.
+	var signedInputs []uint32
+	for idx, vIn := range vPkt.Inputs {
+		if tapsend.IsMuSig2Input(vIn) &&
+			!slices.Contains(muSig2Inputs, uint32(idx)) {
+
+			continue
+		}
+
+		signedInputs = append(signedInputs, uint32(idx))
 	}
 
 	return signedInputs, nil
@@ -1243,6 +1986,13 @@ func (f *AssetWallet) CreatePassiveAssets(ctx context.Context,
 					"passive packet: %w", err)
 			}
 
+			err = f.AddScriptKeySpendInfo(
+				ctx, passivePacket.Inputs[0],
+			)
+			if err != nil {
+				return nil, err
+			}
+
 			passivePackets = append(passivePackets, passivePacket)
 		}
 	}
@@ -1442,6 +2192,34 @@ func (f *AssetWallet) FetchScriptKey(ctx context.Context,
 	return f.cfg.AddrBook.FetchScriptKey(ctx, tweakedScriptKey)
 }
 
+// AddScriptKeySpendInfo adds the key aggregation context or the output
+// descriptor of a local script key to the asset of the given virtual input, if
+// the script key was imported with either of them. Without that information,
+// the input can't be signed.
+func (f *AssetWallet) AddScriptKeySpendInfo(ctx context.Context,
+	vIn *tappsbt.VInput) error {
+
+	scriptKey := &vIn.Asset().ScriptKey
+	tweakedScriptKey, err := f.cfg.AddrBook.FetchScriptKey(
+		ctx, scriptKey.PubKey,
+	)
+	switch {
+	case errors.Is(err, address.ErrScriptKeyNotFound):
+		return nil
+
+	case err != nil:
+		return fmt.Errorf("error fetching script key: %w", err)
+	}
+
+	if tweakedScriptKey.MuSig2 != nil ||
+		tweakedScriptKey.Descriptor != nil {
+
+		scriptKey.TweakedScriptKey = tweakedScriptKey
+	}
+
+	return nil
+}
+
 // FetchInternalKeyLocator attempts to fetch the key locator information for the
 // given raw internal key. If the key cannot be found, then
 // address.ErrInternalKeyNotFound is returned.
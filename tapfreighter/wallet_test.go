@@ -0,0 +1,261 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/lightninglabs/taproot-assets/vm"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAddrBook is a mock implementation of the AddrBook interface that knows
+// about a single script key.
+type mockAddrBook struct {
+	scriptKey *asset.TweakedScriptKey
+	pubKey    *btcec.PublicKey
+}
+
+// FetchScriptKey returns the known script key if it matches the given key.
+func (m *mockAddrBook) FetchScriptKey(_ context.Context,
+	tweakedScriptKey *btcec.PublicKey) (*asset.TweakedScriptKey, error) {
+
+	if !m.pubKey.IsEqual(tweakedScriptKey) {
+		return nil, address.ErrScriptKeyNotFound
+	}
+
+	return m.scriptKey, nil
+}
+
+// FetchInternalKeyLocator always returns address.ErrInternalKeyNotFound.
+func (m *mockAddrBook) FetchInternalKeyLocator(_ context.Context,
+	_ *btcec.PublicKey) (keychain.KeyLocator, error) {
+
+	return keychain.KeyLocator{}, address.ErrInternalKeyNotFound
+}
+
+// vmWitnessValidator validates witnesses with the Taproot Asset VM.
+type vmWitnessValidator struct{}
+
+// ValidateWitnesses validates the created witnesses of an asset transfer.
+func (v *vmWitnessValidator) ValidateWitnesses(newAsset *asset.Asset,
+	splitAssets []*commitment.SplitAsset,
+	prevAssets commitment.InputSet) error {
+
+	return vm.ValidateWitnesses(newAsset, splitAssets, prevAssets)
+}
+
+// TestSendDescriptorCoin tests that a coin locked to a script key imported from
+// an output descriptor can be funded and signed through the wallet, even though
+// the asset loaded from the database doesn't carry the descriptor.
+func TestSendDescriptorCoin(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	localPrivKey := test.RandPrivKey(t)
+	localKey := localPrivKey.PubKey()
+
+	// The local key can only spend through a time locked script path, so
+	// the input can't be signed without knowing the descriptor.
+	template := fmt.Sprintf("tr(%x,and_v(v:pk(@local),after(100)))",
+		test.RandPubKey(t).SerializeCompressed())
+	desc, err := tapscript.ParseDescriptor(template)
+	require.NoError(t, err)
+	derivedDesc, err := desc.Derive(0, localKey)
+	require.NoError(t, err)
+	scriptTree, err := derivedDesc.ScriptTree()
+	require.NoError(t, err)
+
+	// The wallet knows the full script key, while the asset only has the
+	// raw key and tweak, just like the coins loaded from the database.
+	scriptKey := scriptTree.ScriptKey()
+	fullScriptKey := *scriptKey.TweakedScriptKey
+	fullScriptKey.Descriptor = &asset.DescriptorInfo{
+		Descriptor: derivedDesc.String(),
+		LocalKey:   keychain.KeyDescriptor{PubKey: localKey},
+	}
+
+	inputAsset, err := asset.New(
+		asset.RandGenesis(t, asset.Normal), 5, 0, 0, scriptKey, nil,
+	)
+	require.NoError(t, err)
+	tapCommitment, err := commitment.FromAssets(nil, inputAsset)
+	require.NoError(t, err)
+
+	internalKey := test.RandPubKey(t)
+	pkScript, _, _, err := tapsend.AnchorOutputScript(
+		internalKey, nil, tapCommitment,
+	)
+	require.NoError(t, err)
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxOut(wire.NewTxOut(1000, pkScript))
+	anchorPoint := wire.OutPoint{Hash: anchorTx.TxHash()}
+
+	// Store the proof of the coin, so the wallet can add it to the input.
+	block := wire.MsgBlock{Transactions: []*wire.MsgTx{anchorTx}}
+	inputProof := proof.RandProof(
+		t, inputAsset.Genesis, scriptKey.PubKey, block, 0, 0,
+	)
+	inputProof.Asset = *inputAsset.Copy()
+	proofFile, err := proof.NewFile(proof.V0, inputProof)
+	require.NoError(t, err)
+
+	var proofBuf bytes.Buffer
+	require.NoError(t, proofFile.Encode(&proofBuf))
+
+	assetID := inputAsset.ID()
+	proofArchive := proof.NewMockProofArchive()
+	err = proofArchive.ImportProofs(
+		ctx, nil, nil, nil, nil, false, &proof.AnnotatedProof{
+			Locator: proof.Locator{
+				AssetID:   &assetID,
+				ScriptKey: *scriptKey.PubKey,
+				OutPoint:  &anchorPoint,
+			},
+			Blob: proofBuf.Bytes(),
+		},
+	)
+	require.NoError(t, err)
+
+	wallet := NewAssetWallet(&WalletConfig{
+		AssetProofs: proofArchive,
+		AddrBook: &mockAddrBook{
+			scriptKey: &fullScriptKey,
+			pubKey:    scriptKey.PubKey,
+		},
+		Signer:           tapscript.NewMockSigner(localPrivKey),
+		WitnessValidator: &vmWitnessValidator{},
+		ChainParams:      &address.RegressionNetTap,
+	})
+
+	vPkt := &tappsbt.VPacket{
+		Outputs: []*tappsbt.VOutput{{
+			Interactive:             true,
+			Amount:                  inputAsset.Amount,
+			ScriptKey:               asset.RandScriptKey(t),
+			AnchorOutputInternalKey: test.RandPubKey(t),
+		}},
+		ChainParams: &address.RegressionNetTap,
+		Version:     tappsbt.V1,
+	}
+	coin := &AnchoredCommitment{
+		AnchorPoint:       anchorPoint,
+		AnchorOutputValue: 1000,
+		InternalKey:       keychain.KeyDescriptor{PubKey: internalKey},
+		Commitment:        tapCommitment,
+		Asset:             inputAsset,
+	}
+	_, err = wallet.setVPacketInputs(
+		ctx, []*AnchoredCommitment{coin}, vPkt,
+	)
+	require.NoError(t, err)
+
+	// The funded input now knows about the descriptor of its script key.
+	require.True(t, tapsend.IsDescriptorInput(vPkt.Inputs[0]))
+
+	require.NoError(t, tapsend.PrepareOutputAssets(ctx, vPkt))
+	vPkt.Outputs[0].Asset.LockTime = 100
+
+	signedInputs, err := wallet.SignVirtualPacket(
+		vPkt, SkipInputProofVerify(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{0}, signedInputs)
+
+	witness := vPkt.Outputs[0].Asset.PrevWitnesses[0].TxWitness
+	require.Len(t, witness, 3)
+}
+
+// TestGroupFundingErr tests that a failed coin selection for an address of an
+// asset group explains which asset IDs can be used to pay it.
+func TestGroupFundingErr(t *testing.T) {
+	t.Parallel()
+
+	groupKey := test.RandPubKey(t)
+	newAddr := func(version address.Version, scheme string,
+		groupKey *btcec.PublicKey) *address.Tap {
+
+		return &address.Tap{
+			Version:  version,
+			AssetID:  asset.RandID(t),
+			GroupKey: groupKey,
+			ProofCourierAddr: url.URL{
+				Scheme: scheme,
+				Host:   "localhost:10029",
+			},
+		}
+	}
+
+	var (
+		noGroupAddr = newAddr(
+			address.V2, proof.UniverseRpcCourierType, nil,
+		)
+		v1GroupAddr = newAddr(
+			address.V1, proof.UniverseRpcCourierType, groupKey,
+		)
+		v2GroupAddr = newAddr(
+			address.V2, proof.UniverseRpcCourierType, groupKey,
+		)
+		otherErr = errors.New("other error")
+	)
+
+	testCases := []struct {
+		name         string
+		addr         *address.Tap
+		singlePacket bool
+		err          error
+		errContains  string
+	}{{
+		name:        "no group",
+		addr:        noGroupAddr,
+		err:         ErrMatchingAssetsNotFound,
+		errContains: ErrMatchingAssetsNotFound.Error(),
+	}, {
+		name:        "other error",
+		addr:        v1GroupAddr,
+		err:         otherErr,
+		errContains: otherErr.Error(),
+	}, {
+		name:        "V1 group address",
+		addr:        v1GroupAddr,
+		err:         ErrMatchingAssetsNotFound,
+		errContains: "several asset IDs of the group can only be sent",
+	}, {
+		name:        "V2 group address",
+		addr:        v2GroupAddr,
+		err:         ErrMatchingAssetsNotFound,
+		errContains: ErrMatchingAssetsNotFound.Error(),
+	}, {
+		name:         "V2 group address in single packet",
+		addr:         v2GroupAddr,
+		singlePacket: true,
+		err:          ErrMatchingAssetsNotFound,
+		errContains:  "use SendAsset to spend several asset IDs",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := groupFundingErr(
+				[]*address.Tap{tc.addr}, tc.singlePacket,
+				tc.err,
+			)
+			require.ErrorIs(t, err, tc.err)
+			require.ErrorContains(t, err, tc.errContains)
+		})
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
@@ -91,6 +90,15 @@ type BatchCaretakerConfig struct {
 	// attempted batch cancellation to the planter.
 	CancelRespChan chan CancelResp
 
+	// BumpFeeReqChan is used by the BatchPlanter to request that the
+	// caretaker replaces the broadcast genesis transaction with one that
+	// pays the given fee rate.
+	BumpFeeReqChan chan chainfee.SatPerKWeight
+
+	// BumpFeeRespChan is used by the BatchCaretaker to report the result of
+	// an attempted fee bump to the planter.
+	BumpFeeRespChan chan BumpFeeResp
+
 	// UpdateMintingProofs is used to update the minting proofs in the
 	// database in case of a re-org. This cannot be done by the caretaker
 	// itself, because its job is already done at the point that a re-org
@@ -126,6 +134,16 @@ type BatchCaretaker struct {
 	// the Taproot Asset commitment.
 	anchorOutputIndex uint32
 
+	// cancelConf stops waiting for the confirmation of the currently
+	// broadcast genesis transaction. This is used once the transaction is
+	// replaced by one that pays a higher fee.
+	cancelConf func()
+
+	// watchingSpend is true once we're watching for the spend of the
+	// genesis point, which tells us which version of the genesis
+	// transaction confirmed if it was replaced to bump its fee.
+	watchingSpend bool
+
 	// ContextGuard provides a wait group and main quit channel that can be
 	// used to create guarded contexts.
 	*fn.ContextGuard
@@ -366,9 +384,8 @@ func (b *BatchCaretaker) assetCultivator() {
 
 	// At this point, we've advanced all the way to broadcasting the
 	// minting transaction, so we'll wait until we need to exit, or we get
-	// the confirmation notification.
-	//
-	// TODO(roasbeef): eventually should attempt to RBF if needed?
+	// the confirmation notification. While waiting, the fee of the minting
+	// transaction can be bumped if it doesn't confirm in time.
 	for {
 		select {
 		// We've received the confirmation notification, so we can
@@ -378,6 +395,15 @@ func (b *BatchCaretaker) assetCultivator() {
 				"hash=%v, height=%v)", b.batchKey[:],
 				confInfo.BlockHash, confInfo.BlockHeight)
 
+			// The genesis transaction that confirmed might be one
+			// we replaced to bump its fee, so we need to make sure
+			// the batch describes the confirmed version.
+			err = b.matchConfirmedTx(confInfo.Tx)
+			if err != nil {
+				log.Error(err)
+				return
+			}
+
 			b.confInfo = confInfo
 			b.cfg.Batch.UpdateState(BatchStateConfirmed)
 			currentBatchState = b.cfg.Batch.State()
@@ -406,12 +432,402 @@ func (b *BatchCaretaker) assetCultivator() {
 
 			log.Error(cancelErr)
 
+		case feeRate := <-b.cfg.BumpFeeReqChan:
+			txid, err := b.bumpFee(feeRate)
+			if err != nil {
+				err = fmt.Errorf("BatchCaretaker(%x), unable "+
+					"to bump fee: %w", b.batchKey[:], err)
+				log.Error(err)
+			}
+
+			b.cfg.BumpFeeRespChan <- BumpFeeResp{txid, err}
+
 		case <-b.Quit:
 			return
 		}
 	}
 }
 
+// bumpFee replaces the broadcast, but still unconfirmed, genesis transaction
+// of the batch with one that pays the given fee rate. The replacement spends
+// the same inputs, so the genesis point and with it the IDs of all assets in
+// the batch stay the same. Only the change output is reduced to pay for the
+// additional fee. The ID of the replacement transaction is returned.
+func (b *BatchCaretaker) bumpFee(
+	feeRate chainfee.SatPerKWeight) (*chainhash.Hash, error) {
+
+	batchState := b.cfg.Batch.State()
+	if batchState != BatchStateBroadcast {
+		return nil, fmt.Errorf("batch state(%v) not bumpable",
+			batchState)
+	}
+
+	ctx, cancel := b.WithCtxQuit()
+	defer cancel()
+
+	prevPkt := b.cfg.Batch.GenesisPacket
+	prevTx, err := psbt.Extract(prevPkt.Pkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract genesis tx: %w", err)
+	}
+
+	newPkt := prevPkt.Copy()
+	_, err = tapsend.BumpAnchorFee(
+		newPkt.Pkt, newPkt.ChangeOutputIndex, prevTx,
+		prevPkt.ChainFees, feeRate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	signedPkt, err := b.cfg.Wallet.SignAndFinalizePsbt(ctx, newPkt.Pkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign psbt: %w", err)
+	}
+
+	signedTx, err := psbt.Extract(signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract psbt: %w", err)
+	}
+
+	err = blockchain.CheckTransactionSanity(btcutil.NewTx(signedTx))
+	if err != nil {
+		return nil, fmt.Errorf("genesis TX failed final checks: %w",
+			err)
+	}
+
+	chainFees, err := signedPkt.GetTxFee()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get on-chain fees for "+
+			"psbt: %w", err)
+	}
+
+	newPkt.Pkt = signedPkt
+	newPkt.ChainFees = int64(chainFees)
+
+	txid := signedTx.TxHash()
+	log.Infof("BatchCaretaker(%x): replacing genesis tx %v with %v "+
+		"(absolute_fee_sats: %d -> %d)", b.batchKey[:],
+		prevTx.TxHash(), txid, prevPkt.ChainFees, chainFees)
+
+	// We write the replacement to disk before broadcasting it, so we'll
+	// always resume with the transaction that has the highest fee.
+	b.cfg.Batch.GenesisPacket = newPkt
+	if _, err := b.commitGenesisTx(ctx); err != nil {
+		b.cfg.Batch.GenesisPacket = prevPkt
+
+		return nil, err
+	}
+
+	// Re-running the broadcast state publishes the replacement and
+	// registers for its confirmation. If that fails, the previous
+	// transaction is still valid, so we go back to waiting for it.
+	cancelPrevConf := b.cancelConf
+	if _, err := b.stateStep(BatchStateBroadcast); err != nil {
+		b.cfg.Batch.GenesisPacket = prevPkt
+		if _, revertErr := b.commitGenesisTx(ctx); revertErr != nil {
+			log.Errorf("BatchCaretaker(%x): unable to restore "+
+				"replaced genesis tx: %v", b.batchKey[:],
+				revertErr)
+		}
+
+		return nil, err
+	}
+
+	// We don't need to wait for the confirmation of the previous
+	// transaction anymore. If it still confirms instead of its replacement,
+	// for example because the replacement didn't propagate, we'll learn
+	// about it through the spend of the genesis point.
+	if cancelPrevConf != nil {
+		cancelPrevConf()
+	}
+
+	b.cfg.PublishMintEvent(newAssetMintEvent(
+		BatchStateBroadcast, b.cfg.Batch,
+	))
+
+	return &txid, nil
+}
+
+// watchConf registers for the confirmation of the given genesis transaction
+// and launches a goroutine that delivers the confirmation event to the main
+// loop of the caretaker. The returned function stops waiting for the
+// confirmation.
+func (b *BatchCaretaker) watchConf(txHash chainhash.Hash, pkScript []byte,
+	heightHint uint32) (func(), error) {
+
+	confCtx, confCancel := b.WithCtxQuitNoTimeout()
+	confNtfn, errChan, err := b.cfg.ChainBridge.RegisterConfirmationsNtfn(
+		confCtx, &txHash, pkScript, 1, heightHint, true, nil,
+	)
+	if err != nil {
+		confCancel()
+		return nil, fmt.Errorf("unable to register for minting tx "+
+			"conf: %w", err)
+	}
+
+	// Launch a goroutine that'll notify us when the transaction
+	// confirms.
+	//
+	// TODO(roasbeef): make blocking here?
+	b.Wg.Add(1)
+	go func() {
+		defer confCancel()
+		defer b.Wg.Done()
+
+		var (
+			confEvent *chainntnfs.TxConfirmation
+			confRecv  bool
+		)
+
+		for !confRecv {
+			select {
+			case confEvent = <-confNtfn.Confirmed:
+				confRecv = true
+
+			case err := <-errChan:
+				confErr := fmt.Errorf("error getting "+
+					"confirmation: %w", err)
+				log.Info(confErr)
+				b.cfg.ErrChan <- confErr
+
+				return
+
+			case <-confCtx.Done():
+				log.Debugf("Skipping TX confirmation, " +
+					"context done")
+				return
+
+			case <-b.cfg.CancelReqChan:
+				cancelErr := b.Cancel()
+				if cancelErr == nil {
+					return
+				}
+
+				// Cancellation failed, continue to wait
+				// for transaction confirmation.
+				log.Info(cancelErr)
+
+			case <-b.Quit:
+				log.Debugf("Skipping TX confirmation, " +
+					"exiting")
+				return
+			}
+		}
+
+		if confEvent == nil {
+			confErr := fmt.Errorf("got empty " +
+				"confirmation event in batch")
+			log.Info(confErr)
+			b.cfg.ErrChan <- confErr
+
+			return
+		}
+
+		if confEvent.Tx != nil {
+			log.Debugf("Got chain confirmation: %v",
+				confEvent.Tx.TxHash())
+		}
+
+		for {
+			select {
+			case b.confEvent <- confEvent:
+				return
+
+			case <-confCtx.Done():
+				log.Debugf("Skipping TX confirmation, " +
+					"context done")
+				return
+
+			case <-b.cfg.CancelReqChan:
+				cancelErr := b.Cancel()
+				if cancelErr == nil {
+					return
+				}
+
+				// Cancellation failed, continue to try
+				// and send the confirmation event.
+				log.Info(cancelErr)
+
+			case <-b.Quit:
+				log.Debugf("Skipping TX confirmation, " +
+					"exiting")
+				return
+			}
+		}
+	}()
+
+	return confCancel, nil
+}
+
+// watchGenesisSpend registers for the spend of the genesis point of the batch,
+// which every version of the genesis transaction spends. Once it is spent, we
+// wait for the confirmation of the spending transaction, which might be a
+// version of the genesis transaction that was replaced to bump its fee.
+func (b *BatchCaretaker) watchGenesisSpend(heightHint uint32) error {
+	genesisPkt := b.cfg.Batch.GenesisPacket.Pkt
+	genesisPoint := genesisPkt.UnsignedTx.TxIn[0].PreviousOutPoint
+
+	prevOut := genesisPkt.Inputs[0].WitnessUtxo
+	if prevOut == nil {
+		log.Warnf("BatchCaretaker(%x): unable to watch genesis point "+
+			"%v, missing previous output", b.batchKey[:],
+			genesisPoint)
+
+		return nil
+	}
+
+	spendCtx, spendCancel := b.WithCtxQuitNoTimeout()
+	spendChan, errChan, err := b.cfg.ChainBridge.RegisterSpendNtfn(
+		spendCtx, &genesisPoint, prevOut.PkScript, heightHint,
+	)
+	if err != nil {
+		spendCancel()
+		return fmt.Errorf("unable to register for genesis point "+
+			"spend: %w", err)
+	}
+
+	b.Wg.Add(1)
+	go func() {
+		defer spendCancel()
+		defer b.Wg.Done()
+
+		select {
+		case spend := <-spendChan:
+			if spend == nil || spend.SpendingTx == nil {
+				log.Warnf("BatchCaretaker(%x): got empty "+
+					"spend event for genesis point",
+					b.batchKey[:])
+				return
+			}
+
+			log.Infof("BatchCaretaker(%x): genesis point spent by "+
+				"tx %v", b.batchKey[:], spend.SpenderTxHash)
+
+			// We might already wait for the confirmation of the
+			// spending transaction, in which case the confirmation
+			// event is just delivered twice and the duplicate is
+			// ignored.
+			spendingTx := spend.SpendingTx
+			_, err := b.watchConf(
+				spendingTx.TxHash(),
+				spendingTx.TxOut[0].PkScript, heightHint,
+			)
+			if err != nil {
+				b.cfg.ErrChan <- err
+			}
+
+		case err := <-errChan:
+			log.Errorf("BatchCaretaker(%x): error watching "+
+				"genesis point: %v", b.batchKey[:], err)
+
+		case <-spendCtx.Done():
+		}
+	}()
+
+	return nil
+}
+
+// matchConfirmedTx makes sure the genesis packet of the batch describes the
+// given confirmed transaction. If the genesis transaction was replaced to bump
+// its fee, the version that confirmed isn't necessarily the last one we
+// broadcast. In that case, we go back to the version that confirmed and write
+// it to disk again. All versions only differ in their change output, so the
+// minting output and the assets it commits to are the same.
+func (b *BatchCaretaker) matchConfirmedTx(confirmedTx *wire.MsgTx) error {
+	genesisPkt := b.cfg.Batch.GenesisPacket
+	if confirmedTx == nil ||
+		confirmedTx.TxHash() == genesisPkt.Pkt.UnsignedTx.TxHash() {
+
+		return nil
+	}
+
+	newPkt := genesisPkt.Copy()
+	chainFees, err := tapsend.MatchAnchorReplacement(
+		newPkt.Pkt, newPkt.ChangeOutputIndex, newPkt.ChainFees,
+		confirmedTx,
+	)
+	if err != nil {
+		return fmt.Errorf("unexpected genesis tx %v confirmed: %w",
+			confirmedTx.TxHash(), err)
+	}
+	newPkt.ChainFees = chainFees
+
+	log.Infof("BatchCaretaker(%x): genesis tx %v confirmed instead of "+
+		"its replacement %v", b.batchKey[:], confirmedTx.TxHash(),
+		genesisPkt.Pkt.UnsignedTx.TxHash())
+
+	ctx, cancel := b.WithCtxQuit()
+	defer cancel()
+
+	b.cfg.Batch.GenesisPacket = newPkt
+	if _, err := b.commitGenesisTx(ctx); err != nil {
+		b.cfg.Batch.GenesisPacket = genesisPkt
+
+		return fmt.Errorf("unable to store confirmed genesis tx: %w",
+			err)
+	}
+
+	return nil
+}
+
+// commitGenesisTx writes the fully signed genesis packet of the batch to disk,
+// anchoring all assets of the batch in its minting output. The output key of
+// the minting output is returned.
+func (b *BatchCaretaker) commitGenesisTx(
+	ctx context.Context) (*btcec.PublicKey, error) {
+
+	// The sibling here can always be nil as we'll fetch the output key
+	// computed previously in BatchStateFrozen.
+	mintingOutputKey, merkleRoot, err := b.cfg.Batch.MintingOutputKey(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// To spend this output in the future, we must also commit the Taproot
+	// Asset commitment root and batch tapscript sibling.
+	tapCommitmentRoot := b.cfg.Batch.RootAssetCommitment.TapscriptRoot(nil)
+
+	// Fetch the optional Tapscript sibling for this batch, and encode it to
+	// bytes.
+	var siblingBytes []byte
+	if b.cfg.Batch.tapSibling != nil {
+		tapSibling, err := b.cfg.TreeStore.LoadTapscriptTree(
+			ctx, *b.cfg.Batch.tapSibling,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		batchSibling, err := commitment.
+			NewPreimageFromTapscriptTreeNodes(*tapSibling)
+		if err != nil {
+			return nil, err
+		}
+
+		siblingBytes, _, err = commitment.MaybeEncodeTapscriptPreimage(
+			batchSibling,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The anchor output index is derived from the genesis packet, as the
+	// batch might have been resumed after the frozen state.
+	genesisPkt := b.cfg.Batch.GenesisPacket
+	err = b.cfg.Log.CommitSignedGenesisTx(
+		ctx, b.cfg.Batch.BatchKey.PubKey, genesisPkt,
+		extractAnchorOutputIndex(genesisPkt), merkleRoot,
+		tapCommitmentRoot[:], siblingBytes,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to commit genesis tx: %w", err)
+	}
+
+	return mintingOutputKey, nil
+}
+
 // extractAnchorOutputIndex extracts the anchor output index from a funded
 // genesis packet.
 func extractAnchorOutputIndex(genesisPkt *tapsend.FundedPsbt) uint32 {
@@ -750,57 +1166,15 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 
 		// At this point we have a fully signed PSBT packet which'll
 		// create our set of assets once mined. We'll write this to
-		// disk, then import the public key into the wallet. The sibling
-		// here can always be nil as we'll fetch the output key computed
-		// previously in BatchStateFrozen.
+		// disk, then import the public key into the wallet.
 		//
 		// TODO(roasbeef): re-run during the broadcast phase to ensure
 		// it's fully imported?
-		mintingOutputKey, merkleRoot, err := b.cfg.Batch.
-			MintingOutputKey(nil)
+		mintingOutputKey, err := b.commitGenesisTx(ctx)
 		if err != nil {
 			return 0, err
 		}
 
-		// To spend this output in the future, we must also commit the
-		// Taproot Asset commitment root and batch tapscript sibling.
-		tapCommitmentRoot := b.cfg.Batch.RootAssetCommitment.
-			TapscriptRoot(nil)
-
-		// Fetch the optional Tapscript sibling for this batch, and
-		// encode it to bytes.
-		var siblingBytes []byte
-		if b.cfg.Batch.tapSibling != nil {
-			tapSibling, err := b.cfg.TreeStore.LoadTapscriptTree(
-				ctx, *b.cfg.Batch.tapSibling,
-			)
-			if err != nil {
-				return 0, err
-			}
-
-			batchSibling, err := commitment.
-				NewPreimageFromTapscriptTreeNodes(*tapSibling)
-			if err != nil {
-				return 0, err
-			}
-
-			siblingBytes, _, err = commitment.
-				MaybeEncodeTapscriptPreimage(batchSibling)
-			if err != nil {
-				return 0, err
-			}
-		}
-
-		err = b.cfg.Log.CommitSignedGenesisTx(
-			ctx, b.cfg.Batch.BatchKey.PubKey,
-			b.cfg.Batch.GenesisPacket, b.anchorOutputIndex,
-			merkleRoot, tapCommitmentRoot[:], siblingBytes,
-		)
-		if err != nil {
-			return 0, fmt.Errorf("unable to commit genesis "+
-				"tx: %w", err)
-		}
-
 		// With the genesis transaction committed to disk, we'll also
 		// import this public key into the backing wallet, so it
 		// recognizes the de minimis amt sats under out control.
@@ -817,7 +1191,7 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 		// On restart, we'll get an error that the output has already
 		// been added to the wallet, so we'll catch this now and move
 		// along if so.
-		case strings.Contains(err.Error(), "already exists"):
+		case errors.Is(err, tapsend.ErrOutputAlreadyImported):
 			break
 
 		default:
@@ -861,110 +1235,31 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 		// sure to request that the block is included as well, since we
 		// need this to construct the proof files for each of the
 		// assets later.
-		//
-		// TODO(roasbeef): eventually want to be able to RBF the bump
 		heightHint := b.cfg.Batch.HeightHint
-		txHash := signedTx.TxHash()
-		confCtx, confCancel := b.WithCtxQuitNoTimeout()
-		confNtfn, errChan, err := b.cfg.ChainBridge.RegisterConfirmationsNtfn(
-			confCtx, &txHash, signedTx.TxOut[0].PkScript, 1,
-			heightHint, true, nil,
+		confCancel, err := b.watchConf(
+			signedTx.TxHash(), signedTx.TxOut[0].PkScript,
+			heightHint,
 		)
 		if err != nil {
-			return 0, fmt.Errorf("unable to register for "+
-				"minting tx conf: %w", err)
+			return 0, err
 		}
 
-		// Launch a goroutine that'll notify us when the transaction
-		// confirms.
-		//
-		// TODO(roasbeef): make blocking here?
-		b.Wg.Add(1)
-		go func() {
-			defer confCancel()
-			defer b.Wg.Done()
-
-			var (
-				confEvent *chainntnfs.TxConfirmation
-				confRecv  bool
-			)
-
-			for !confRecv {
-				select {
-				case confEvent = <-confNtfn.Confirmed:
-					confRecv = true
-
-				case err := <-errChan:
-					confErr := fmt.Errorf("error getting "+
-						"confirmation: %w", err)
-					log.Info(confErr)
-					b.cfg.ErrChan <- confErr
+		// If the transaction is replaced to bump its fee, we'll need to
+		// stop waiting for it to confirm.
+		b.cancelConf = confCancel
 
-					return
-
-				case <-confCtx.Done():
-					log.Debugf("Skipping TX confirmation, " +
-						"context done")
-					confRecv = true
-
-				case <-b.cfg.CancelReqChan:
-					cancelErr := b.Cancel()
-					if cancelErr == nil {
-						return
-					}
-
-					// Cancellation failed, continue to wait
-					// for transaction confirmation.
-					log.Info(cancelErr)
-
-				case <-b.Quit:
-					log.Debugf("Skipping TX confirmation, " +
-						"exiting")
-					return
-				}
-			}
-
-			if confEvent == nil {
-				confErr := fmt.Errorf("got empty " +
-					"confirmation event in batch")
-				log.Info(confErr)
-				b.cfg.ErrChan <- confErr
-
-				return
-			}
-
-			if confEvent.Tx != nil {
-				log.Debugf("Got chain confirmation: %v",
-					confEvent.Tx.TxHash())
+		// Any replacement spends the same inputs, but it might not
+		// propagate, so the transaction we replaced can still confirm
+		// instead. We'll watch the genesis point to find out which
+		// version of the genesis transaction made it into a block.
+		if !b.watchingSpend {
+			err := b.watchGenesisSpend(heightHint)
+			if err != nil {
+				return 0, err
 			}
 
-			for {
-				select {
-				case b.confEvent <- confEvent:
-					return
-
-				case <-confCtx.Done():
-					log.Debugf("Skipping TX confirmation, " +
-						"context done")
-					return
-
-				case <-b.cfg.CancelReqChan:
-					cancelErr := b.Cancel()
-					if cancelErr == nil {
-						return
-					}
-
-					// Cancellation failed, continue to try
-					// and send the confirmation event.
-					log.Info(cancelErr)
-
-				case <-b.Quit:
-					log.Debugf("Skipping TX confirmation, " +
-						"exiting")
-					return
-				}
-			}
-		}()
+			b.watchingSpend = true
+		}
 
 		log.Infof("BatchCaretaker(%x): transition states: %v -> %v",
 			b.batchKey, BatchStateBroadcast, BatchStateBroadcast)
@@ -1506,6 +1801,49 @@ func GenGroupVerifier(ctx context.Context,
 	}
 }
 
+// GenProofFileGroupVerifier generates a group verification callback function
+// that accepts the group keys revealed by the genesis proofs in the given proof
+// files. Any other group key is passed to the fallback verifier. This is used
+// when importing proofs of asset groups that aren't known to the local
+// database yet. A revealed group key is only accepted if the proof that
+// reveals it also passes verification, so the proof files must be verified as
+// a whole.
+func GenProofFileGroupVerifier(files []*proof.File,
+	fallback func(*btcec.PublicKey) error) (func(*btcec.PublicKey) error,
+	error) {
+
+	revealedKeys := make(map[asset.SerializedKey]struct{})
+	for _, file := range files {
+		for i := 0; i < file.NumProofs(); i++ {
+			p, err := file.ProofAt(uint32(i))
+			if err != nil {
+				return nil, fmt.Errorf("unable to extract "+
+					"proof: %w", err)
+			}
+
+			if p.GroupKeyReveal == nil || p.Asset.GroupKey == nil {
+				continue
+			}
+
+			groupKey := asset.ToSerialized(
+				&p.Asset.GroupKey.GroupPubKey,
+			)
+			revealedKeys[groupKey] = struct{}{}
+		}
+	}
+
+	return func(groupKey *btcec.PublicKey) error {
+		if groupKey != nil {
+			_, ok := revealedKeys[asset.ToSerialized(groupKey)]
+			if ok {
+				return nil
+			}
+		}
+
+		return fallback(groupKey)
+	}, nil
+}
+
 // GenGroupAnchorVerifier generates a caching group anchor verification
 // callback function given a DB handle.
 func GenGroupAnchorVerifier(ctx context.Context,
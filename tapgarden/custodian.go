@@ -4,8 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/btcsuite/btcd/wire"
@@ -15,6 +15,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapsend"
 	"github.com/lightningnetwork/lnd/lnrpc"
 )
 
@@ -124,6 +125,14 @@ type CustodianConfig struct {
 	// to be confirmed safely with a minimum number of confirmations.
 	ProofWatcher proof.Watcher
 
+	// ReusableAddrPollInterval is the interval at which the proof courier
+	// of each reusable address that doesn't specify an amount or belongs
+	// to an asset group is polled for new incoming transfers. Such
+	// transfers can't always be detected on-chain, as the on-chain output
+	// key depends on the amount and asset ID that is sent. A value of
+	// zero disables polling.
+	ReusableAddrPollInterval time.Duration
+
 	// ErrChan is the main error channel the custodian will report back
 	// critical errors to the main server.
 	ErrChan chan<- error
@@ -159,6 +168,26 @@ type Custodian struct {
 	// address events of inbound assets.
 	events map[wire.OutPoint]*address.Event
 
+	// polledAddrs is the list of reusable addresses that we need to poll
+	// the proof courier for, see PollsCourier. This is only accessed by
+	// the main event loop.
+	polledAddrs []*address.AddrWithKeyInfo
+
+	// polling is set while a poll for transfers to reusable addresses is
+	// in progress, so we never run two polls at the same time.
+	polling atomic.Bool
+
+	// pollCursors holds the proof courier cursor of each polled reusable
+	// address, keyed by its script key, so a poll only lists the proofs
+	// the courier received since the previous poll. This is only accessed
+	// by the poll goroutine, of which there is never more than one.
+	pollCursors map[asset.SerializedKey]uint64
+
+	// polledReceives is the channel through which the poll goroutine
+	// hands newly discovered transfers to reusable addresses to the main
+	// event loop.
+	polledReceives chan *polledReceive
+
 	// ContextGuard provides a wait group and main quit channel that can be
 	// used to create guarded contexts.
 	*fn.ContextGuard
@@ -178,6 +207,8 @@ func NewCustodian(cfg *CustodianConfig) *Custodian {
 		proofSubscription: proofSub,
 		statusEventsSubs:  statusEventsSubs,
 		events:            make(map[wire.OutPoint]*address.Event),
+		pollCursors:       make(map[asset.SerializedKey]uint64),
+		polledReceives:    make(chan *polledReceive),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -378,6 +409,17 @@ func (c *Custodian) watchInboundAssets() {
 		}
 	}
 
+	// Transfers to some reusable addresses are discovered by polling the
+	// proof courier. A nil channel is never ready, so polling
+	// is disabled if no interval is configured.
+	var pollChan <-chan time.Time
+	if c.cfg.ReusableAddrPollInterval > 0 {
+		pollTicker := time.NewTicker(c.cfg.ReusableAddrPollInterval)
+		defer pollTicker.Stop()
+
+		pollChan = pollTicker.C
+	}
+
 	log.Infof("Starting main custodian event loop")
 	for {
 		var err error
@@ -385,6 +427,12 @@ func (c *Custodian) watchInboundAssets() {
 		case newAddr := <-c.addrSubscription.NewItemCreated.ChanOut():
 			err = c.importAddrToWallet(newAddr)
 
+		case <-pollChan:
+			c.pollReusableAddrs()
+
+		case receive := <-c.polledReceives:
+			err = c.completePolledReceive(receive)
+
 		case tx := <-newTxChan:
 			err = c.inspectWalletTx(&tx)
 
@@ -722,6 +770,23 @@ func (c *Custodian) importAddrToWallet(addr *address.AddrWithKeyInfo) error {
 		return fmt.Errorf("unable to encode address: %w", err)
 	}
 
+	// Some transfers to reusable addresses can't be detected on-chain, so
+	// we poll the proof courier for them. We don't mark such an address as
+	// managed, so it is delivered to us again on the next startup.
+	pollCourier := PollsCourier(addr.Tap)
+	if pollCourier {
+		log.Infof("Watching proof courier for transfers to reusable "+
+			"Taproot Asset address %v", addrStr)
+		c.polledAddrs = append(c.polledAddrs, addr)
+	}
+
+	// The on-chain output key of a reusable address without an amount
+	// depends on the amount the sender decides to send, so there's nothing
+	// we can import into the wallet.
+	if addr.Version.IsReusable() && !addr.HasAmount() {
+		return nil
+	}
+
 	// Let's not be interrupted by a shutdown.
 	ctxt, cancel := c.CtxBlocking()
 	defer cancel()
@@ -737,7 +802,7 @@ func (c *Custodian) importAddrToWallet(addr *address.AddrWithKeyInfo) error {
 	// On restart, we'll get an error that the output has already
 	// been added to the wallet, so we'll catch this now and move
 	// along if so.
-	case strings.Contains(err.Error(), "already exists"):
+	case errors.Is(err, tapsend.ErrOutputAlreadyImported):
 
 	default:
 		return err
@@ -748,9 +813,277 @@ func (c *Custodian) importAddrToWallet(addr *address.AddrWithKeyInfo) error {
 		log.Infof("Watching p2tr address %v on chain", p2trAddr)
 	}
 
+	if pollCourier {
+		return nil
+	}
+
 	return c.cfg.AddrBook.SetAddrManaged(ctxt, addr, time.Now())
 }
 
+// polledReceive is a transfer to a reusable address that was discovered by
+// polling the address' proof courier.
+type polledReceive struct {
+	// addr is the address the assets were sent to.
+	addr *address.AddrWithKeyInfo
+
+	// proof is the proof file of the received asset.
+	proof *proof.AnnotatedProof
+
+	// file is the decoded proof file.
+	file *proof.File
+
+	// lastProof is the last proof in the proof file, which is the transfer
+	// to the address.
+	lastProof *proof.Proof
+}
+
+// pollReusableAddrs starts a goroutine that polls the proof courier of each
+// polled reusable address for new transfers. If a previous poll is still
+// running, this is a no-op.
+func (c *Custodian) pollReusableAddrs() {
+	if len(c.polledAddrs) == 0 || !c.polling.CompareAndSwap(
+		false, true,
+	) {
+
+		return
+	}
+
+	// The address list is only modified by the main event loop, so we
+	// hand a copy to the goroutine.
+	addrs := make([]*address.AddrWithKeyInfo, len(c.polledAddrs))
+	copy(addrs, c.polledAddrs)
+
+	c.Wg.Add(1)
+	go func() {
+		defer c.Wg.Done()
+		defer c.polling.Store(false)
+
+		for _, addr := range addrs {
+			err := c.pollReusableAddr(addr)
+			if err != nil {
+				log.Errorf("Unable to poll proof courier for "+
+					"transfers to address with script key "+
+					"%x: %v",
+					addr.ScriptKey.SerializeCompressed(),
+					err)
+			}
+		}
+	}()
+}
+
+// pollReusableAddr asks the proof courier of the given address for transfers
+// to the address that we don't know of yet. Any new transfer is fetched and
+// handed to the main event loop. For an address of an asset group, the
+// courier lists the transfers of all asset IDs of the group.
+func (c *Custodian) pollReusableAddr(addr *address.AddrWithKeyInfo) error {
+	ctx, cancel := c.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	recipient := proof.Recipient{
+		ScriptKey: &addr.ScriptKey,
+		AssetID:   addr.AssetID,
+	}
+	courier, err := c.cfg.ProofCourierDispatcher.NewCourier(
+		&addr.ProofCourierAddr, recipient,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to initiate proof courier service "+
+			"handle: %w", err)
+	}
+	defer courier.Close()
+
+	lister, ok := courier.(proof.ProofLister)
+	if !ok {
+		return fmt.Errorf("proof courier %v can't list proofs",
+			addr.ProofCourierAddr.String())
+	}
+
+	cursorKey := asset.ToSerialized(&addr.ScriptKey)
+	locators, nextCursor, err := lister.ListProofs(ctx, proof.Locator{
+		AssetID:   fn.Ptr(addr.AssetID),
+		GroupKey:  addr.GroupKey,
+		ScriptKey: addr.ScriptKey,
+	}, c.pollCursors[cursorKey])
+	if err != nil {
+		return fmt.Errorf("unable to list proofs: %w", err)
+	}
+
+	for _, loc := range locators {
+		// We only care about transfers we don't have an event for.
+		ctxt, cancel := c.WithCtxQuit()
+		_, err := c.cfg.AddrBook.QueryEvent(ctxt, addr, *loc.OutPoint)
+		cancel()
+		switch {
+		case err == nil:
+			continue
+
+		case !errors.Is(err, address.ErrNoEvent):
+			return fmt.Errorf("error querying event: %w", err)
+		}
+
+		log.Infof("Found inbound asset transfer to reusable address "+
+			"with script key %x in %v",
+			addr.ScriptKey.SerializeCompressed(), loc.OutPoint)
+
+		addrProof, err := courier.ReceiveProof(ctx, loc)
+		if err != nil {
+			return fmt.Errorf("unable to receive proof using "+
+				"courier: %w", err)
+		}
+
+		file, err := addrProof.Blob.AsFile()
+		if err != nil {
+			return fmt.Errorf("error extracting proof file: %w",
+				err)
+		}
+		lastProof, err := file.LastProof()
+		if err != nil {
+			return fmt.Errorf("error fetching last proof: %w", err)
+		}
+
+		// Make sure the courier gave us what we asked for.
+		if !addrMatchesPolledAsset(addr, &lastProof.Asset) ||
+			lastProof.OutPoint() != *loc.OutPoint {
+
+			return fmt.Errorf("proof for %v doesn't match "+
+				"address", loc.OutPoint)
+		}
+
+		// The locator we asked for carries the asset ID of the
+		// address, which isn't necessarily the one that was received.
+		receivedID := lastProof.Asset.ID()
+		addrProof.Locator.AssetID = &receivedID
+
+		receive := &polledReceive{
+			addr:      addr,
+			proof:     addrProof,
+			file:      file,
+			lastProof: lastProof,
+		}
+		if !fn.SendOrQuit(c.polledReceives, receive, c.Quit) {
+			return nil
+		}
+	}
+
+	// All listed transfers were handed over, so the next poll can start
+	// where this one ended. If we failed before, the next poll lists the
+	// same proofs again and skips the ones we already know of.
+	c.pollCursors[cursorKey] = nextCursor
+
+	return nil
+}
+
+// completePolledReceive records a transfer to a reusable address that was
+// discovered through the proof courier. Because we might never have seen the
+// transfer on-chain, the event is created from the information in the proof.
+func (c *Custodian) completePolledReceive(r *polledReceive) error {
+	op := r.lastProof.OutPoint()
+	if _, ok := c.events[op]; ok {
+		return nil
+	}
+
+	// The commitment of the received output depends on the asset and
+	// amount that was sent, so we create the event for a copy of the
+	// address with the received asset and amount. The on-chain output key
+	// of the copy still points to the stored address.
+	// A sender may split the amount of an address of an asset group over
+	// several asset IDs, so the received amount doesn't need to match the
+	// amount of the address.
+	receivedAsset := &r.lastProof.Asset
+	tapAddr := r.addr.Copy()
+	tapAddr.AssetID = receivedAsset.ID()
+	tapAddr.AttachGenesis(receivedAsset.Genesis)
+	tapAddr.AssetVersion = receivedAsset.Version
+	tapAddr.Amount = receivedAsset.Amount
+
+	addr := *r.addr
+	addr.Tap = tapAddr
+
+	anchorTx := r.lastProof.AnchorTx
+	walletTx := &lndclient.Transaction{
+		Tx:            &anchorTx,
+		TxHash:        op.Hash.String(),
+		Confirmations: 1,
+		BlockHash:     r.lastProof.BlockHeader.BlockHash().String(),
+		BlockHeight:   int32(r.lastProof.BlockHeight),
+		OutputDetails: make(
+			[]*lnrpc.OutputDetail, len(anchorTx.TxOut),
+		),
+	}
+	for idx, txOut := range anchorTx.TxOut {
+		walletTx.OutputDetails[idx] = &lnrpc.OutputDetail{
+			OutputIndex: int64(idx),
+			Amount:      txOut.Value,
+		}
+	}
+
+	// The on-chain output key of the received output depends on what the
+	// sender decided to send, so we only learn it now. We import it into
+	// the lnd wallet, so the wallet knows about the anchor output and can
+	// sign for it once the received assets are spent.
+	err := c.importAnchorOutput(&anchorTx, op.Index)
+	if err != nil {
+		return fmt.Errorf("unable to import anchor output %v: %w", op,
+			err)
+	}
+
+	ctxt, cancel := c.CtxBlocking()
+	event, err := c.cfg.AddrBook.GetOrCreateEvent(
+		ctxt, address.StatusTransactionConfirmed, &addr, walletTx,
+		op.Index,
+	)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("error creating event: %w", err)
+	}
+
+	c.publishSubscriberStatusEvent(NewAssetReceiveEvent(
+		*event.Addr.Tap, event.Outpoint, event.ConfirmationHeight,
+		address.StatusTransactionConfirmed,
+	))
+	c.events[op] = event
+
+	err = c.assertProofInLocalArchive(r.proof)
+	if err != nil {
+		return fmt.Errorf("error asserting proof in local archive: %w",
+			err)
+	}
+
+	return c.setReceiveCompleted(event, r.lastProof, r.file)
+}
+
+// importAnchorOutput imports the Taproot output key of the given anchor output
+// into the lnd wallet.
+func (c *Custodian) importAnchorOutput(anchorTx *wire.MsgTx,
+	outputIndex uint32) error {
+
+	outputKey, err := proof.ExtractTaprootKey(anchorTx, outputIndex)
+	if err != nil {
+		return fmt.Errorf("unable to extract output key: %w", err)
+	}
+
+	// Let's not be interrupted by a shutdown.
+	ctxt, cancel := c.CtxBlocking()
+	defer cancel()
+
+	p2trAddr, err := c.cfg.WalletAnchor.ImportTaprootOutput(
+		ctxt, outputKey,
+	)
+	switch {
+	case err == nil:
+		log.Infof("Watching p2tr address %v on chain", p2trAddr)
+
+	// If we're restarting, the output might already have been added to
+	// the wallet.
+	case errors.Is(err, tapsend.ErrOutputAlreadyImported):
+
+	default:
+		return err
+	}
+
+	return nil
+}
+
 // checkProofAvailable checks the proof storage if a proof for the given event
 // is already available. If it is, and it checks out, the event is updated.
 func (c *Custodian) checkProofAvailable(event *address.Event) (bool, error) {
@@ -1150,6 +1483,41 @@ func AddrMatchesAsset(addr *address.AddrWithKeyInfo, a *asset.Asset) bool {
 		addr.ScriptKey.IsEqual(a.ScriptKey.PubKey)
 }
 
+// GroupSpendable returns true if a payment to the given address can be funded
+// with any asset ID of the address' asset group. The receiver can only learn
+// about a transfer of an asset ID other than the one the address was created
+// for by polling its proof courier, which requires a reusable address with a
+// courier that can list proofs.
+func GroupSpendable(addr *address.Tap) bool {
+	return addr.GroupKey != nil && addr.Version.IsReusable() &&
+		proof.CanListProofs(&addr.ProofCourierAddr)
+}
+
+// PollsCourier returns true if transfers to the given address need to be
+// discovered by polling its proof courier. This is the case for reusable
+// addresses without an amount, as their on-chain output key depends on the
+// amount that is sent, and for addresses that can be paid with any asset ID
+// of their asset group.
+func PollsCourier(addr *address.Tap) bool {
+	return (addr.Version.IsReusable() && !addr.HasAmount()) ||
+		GroupSpendable(addr)
+}
+
+// addrMatchesPolledAsset returns true if the given asset was received through
+// the given polled address. Any asset ID of the address' asset group matches
+// an address that is group spendable.
+func addrMatchesPolledAsset(addr *address.AddrWithKeyInfo,
+	a *asset.Asset) bool {
+
+	if !GroupSpendable(addr.Tap) {
+		return AddrMatchesAsset(addr, a)
+	}
+
+	return a.GroupKey != nil &&
+		addr.GroupKey.IsEqual(&a.GroupKey.GroupPubKey) &&
+		addr.ScriptKey.IsEqual(a.ScriptKey.PubKey)
+}
+
 // EventMatchesProof returns true if the given event matches the given proof.
 func EventMatchesProof(event *address.Event, p *proof.Proof) bool {
 	return AddrMatchesAsset(event.Addr, &p.Asset) &&
@@ -240,6 +240,30 @@ func (h *custodianHarness) assertAddrsRegistered(
 	}
 }
 
+// assertOutputImported makes sure that the Taproot output key of the given
+// transaction output was imported into the wallet.
+func (h *custodianHarness) assertOutputImported(tx *wire.MsgTx,
+	outputIdx int) {
+
+	outputKey, err := proof.ExtractTaprootKey(tx, uint32(outputIdx))
+	require.NoError(h.t, err)
+
+	err = wait.NoError(func() error {
+		select {
+		case pubKey := <-h.walletAnchor.ImportPubKeySignal:
+			if !pubKey.IsEqual(outputKey) {
+				return fmt.Errorf("unexpected key imported")
+			}
+
+			return nil
+
+		default:
+			return fmt.Errorf("output key not imported")
+		}
+	}, testTimeout)
+	require.NoError(h.t, err)
+}
+
 // addProofFileToMultiverse adds the given proof to the multiverse store.
 func (h *custodianHarness) addProofFileToMultiverse(p *proof.AnnotatedProof) {
 	f := &proof.File{}
@@ -313,15 +337,16 @@ func newHarness(t *testing.T,
 
 	errChan := make(chan error, 1)
 	cfg := &tapgarden.CustodianConfig{
-		ChainParams:            chainParams,
-		ChainBridge:            chainBridge,
-		WalletAnchor:           walletAnchor,
-		AddrBook:               addrBook,
-		ProofArchive:           archive,
-		ProofNotifier:          notifier,
-		ProofCourierDispatcher: courierDispatch,
-		ProofWatcher:           proofWatcher,
-		ErrChan:                errChan,
+		ChainParams:              chainParams,
+		ChainBridge:              chainBridge,
+		WalletAnchor:             walletAnchor,
+		AddrBook:                 addrBook,
+		ProofArchive:             archive,
+		ProofNotifier:            notifier,
+		ProofCourierDispatcher:   courierDispatch,
+		ProofWatcher:             proofWatcher,
+		ReusableAddrPollInterval: testPollInterval,
+		ErrChan:                  errChan,
 	}
 	return &custodianHarness{
 		t:            t,
@@ -746,6 +771,162 @@ func runTransactionConfirmedOnlyTest(t *testing.T, withRestart bool) {
 	h.assertEventsPresent(numAddrs, address.StatusCompleted)
 }
 
+// TestAmountlessAddrReceive tests that the custodian discovers a transfer to a
+// reusable address without an amount by polling the proof courier.
+func TestAmountlessAddrReceive(t *testing.T) {
+	h := newHarness(t, nil)
+
+	// Addresses without an amount can only be created for normal assets.
+	ctx := context.Background()
+	addr, genesis := randAddr(h)
+	for genesis.Type != asset.Normal {
+		addr, genesis = randAddr(h)
+	}
+
+	sentAmt := addr.Amount
+	addr.Tap.Version = address.V2
+	addr.Tap.Amount = 0
+	err := h.tapdbBook.InsertAddrs(ctx, *addr)
+	require.NoError(t, err)
+
+	require.NoError(t, h.c.Start())
+	t.Cleanup(func() {
+		require.NoError(t, h.c.Stop())
+	})
+	h.assertStartup()
+
+	// The on-chain output key of the address isn't known, so nothing
+	// should be imported into the wallet.
+	select {
+	case <-h.walletAnchor.ImportPubKeySignal:
+		t.Fatalf("unexpected import of amount-less address")
+
+	case <-time.After(testPollInterval):
+	}
+
+	// The sender now decides on an amount and delivers the proof for the
+	// transfer to the proof courier, which is all we need to discover it.
+	paidTapAddr, err := addr.WithAmount(sentAmt)
+	require.NoError(t, err)
+	paidAddr := *addr
+	paidAddr.Tap = paidTapAddr
+
+	outputIdx, tx := randWalletTx(&paidAddr)
+	mockProof := randProof(t, outputIdx, tx.Tx, genesis, &paidAddr)
+	require.NoError(t, h.courier.DeliverProof(nil, mockProof))
+
+	// Only now that the output key is known, the anchor output can be
+	// imported into the wallet.
+	h.assertOutputImported(tx.Tx, outputIdx)
+
+	events := h.assertEventsPresent(1, address.StatusCompleted)
+	require.EqualValues(t, outputIdx, events[0].Outpoint.Index)
+	require.Equal(t, tx.Tx.TxHash(), events[0].Outpoint.Hash)
+
+	dbProof, err := h.assetDB.FetchProof(ctx, mockProof.Locator)
+	require.NoError(t, err)
+	require.EqualValues(t, mockProof.Blob, dbProof)
+}
+
+// TestGroupAddrReceive tests that the custodian discovers a transfer of a
+// different asset ID of the same asset group to a reusable group address by
+// polling the proof courier.
+func TestGroupAddrReceive(t *testing.T) {
+	h := newHarness(t, nil)
+
+	ctx := context.Background()
+	addr, _ := randAddr(h)
+
+	// We create an asset group with two tranches. The address is created
+	// for the first tranche, while the second one is issued into the same
+	// group later on.
+	firstTranche := asset.RandGenesis(t, asset.Normal)
+	groupAnchor := asset.NewAssetNoErr(
+		t, firstTranche, addr.Amount, 0, 0,
+		asset.NewScriptKey(&addr.ScriptKey), nil,
+	)
+	groupKey, groupPrivBytes := asset.RandGroupKeyWithSigner(
+		t, firstTranche, groupAnchor,
+	)
+	err := h.tapdbBook.InsertAssetGen(ctx, &firstTranche, groupKey)
+	require.NoError(t, err)
+
+	secondTranche := asset.RandGenesis(t, asset.Normal)
+	secondAsset := asset.NewAssetNoErr(
+		t, secondTranche, addr.Amount, 0, 0,
+		asset.NewScriptKey(&addr.ScriptKey), nil,
+	)
+	groupPriv, groupPub := btcec.PrivKeyFromBytes(groupPrivBytes)
+	groupReq := asset.NewGroupKeyRequestNoErr(
+		t, test.PubToKeyDesc(groupPub), firstTranche, secondAsset, nil,
+	)
+	genTx, err := groupReq.BuildGroupVirtualTx(&asset.MockGroupTxBuilder{})
+	require.NoError(t, err)
+	secondGroupKey, err := asset.DeriveGroupKey(
+		asset.NewMockGenesisSigner(groupPriv), *genTx, *groupReq, nil,
+	)
+	require.NoError(t, err)
+	require.True(t, groupKey.GroupPubKey.IsEqual(
+		&secondGroupKey.GroupPubKey,
+	))
+
+	err = h.tapdbBook.InsertAssetGen(ctx, &secondTranche, secondGroupKey)
+	require.NoError(t, err)
+
+	courierAddr := url.URL{
+		Scheme: proof.UniverseRpcCourierType,
+		Host:   "localhost:10029",
+	}
+	addr.Tap, err = address.New(
+		address.V2, firstTranche, &groupKey.GroupPubKey,
+		groupKey.Witness, addr.ScriptKey, addr.InternalKey,
+		addr.Amount, nil, &address.RegressionNetTap, courierAddr,
+	)
+	require.NoError(t, err)
+	outputKey, err := addr.Tap.TaprootOutputKey()
+	require.NoError(t, err)
+	addr.TaprootOutputKey = *outputKey
+
+	err = h.tapdbBook.InsertAddrs(ctx, *addr)
+	require.NoError(t, err)
+
+	require.NoError(t, h.c.Start())
+	t.Cleanup(func() {
+		require.NoError(t, h.c.Stop())
+	})
+	h.assertStartup()
+
+	// The sender pays part of the address amount with the second tranche
+	// of the group, which changes the on-chain output key.
+	paidTapAddr := addr.Tap.Copy()
+	paidTapAddr.AssetID = secondTranche.ID()
+	paidTapAddr.AttachGenesis(secondTranche)
+	paidTapAddr.Amount = addr.Amount / 2
+	if paidTapAddr.Amount == 0 {
+		paidTapAddr.Amount = 1
+	}
+	paidOutputKey, err := paidTapAddr.TaprootOutputKey()
+	require.NoError(t, err)
+	require.False(t, paidOutputKey.IsEqual(outputKey))
+
+	paidAddr := *addr
+	paidAddr.Tap = paidTapAddr
+	paidAddr.TaprootOutputKey = *paidOutputKey
+
+	outputIdx, tx := randWalletTx(&paidAddr)
+	mockProof := randProof(t, outputIdx, tx.Tx, &secondTranche, &paidAddr)
+	require.NoError(t, h.courier.DeliverProof(nil, mockProof))
+	h.assertOutputImported(tx.Tx, outputIdx)
+
+	events := h.assertEventsPresent(1, address.StatusCompleted)
+	require.EqualValues(t, outputIdx, events[0].Outpoint.Index)
+	require.Equal(t, tx.Tx.TxHash(), events[0].Outpoint.Hash)
+
+	dbProof, err := h.assetDB.FetchProof(ctx, mockProof.Locator)
+	require.NoError(t, err)
+	require.EqualValues(t, mockProof.Blob, dbProof)
+}
+
 func mustMakeAddr(t *testing.T,
 	gen asset.Genesis, groupKey *btcec.PublicKey,
 	groupWitness wire.TxWitness, scriptKey btcec.PublicKey) *address.Tap {
@@ -41,21 +41,31 @@ type Planter interface {
 	// returned.
 	CancelSeedling() error
 
-	// FundBatch attempts to provide a genesis point for the current batch,
-	// or create a new funded batch.
+	// FundBatch attempts to provide a genesis point for the target batch,
+	// or create a new funded batch. If no batch key is given, the only
+	// pending batch is funded.
 	FundBatch(params FundParams) (*MintingBatch, error)
 
-	// SealBatch attempts to seal the current batch, by providing or
-	// deriving all witnesses necessary to create the final genesis TX.
+	// SealBatch attempts to seal the target batch, by providing or
+	// deriving all witnesses necessary to create the final genesis TX. If
+	// no batch key is given, the only pending batch is sealed.
 	SealBatch(params SealParams) (*MintingBatch, error)
 
-	// FinalizeBatch signals that the asset minter should finalize
-	// the current batch, if one exists.
+	// FinalizeBatch signals that the asset minter should finalize the
+	// target batch, if it exists. If no batch key is given, the only
+	// pending batch is finalized.
 	FinalizeBatch(params FinalizeParams) (*MintingBatch, error)
 
-	// CancelBatch signals that the asset minter should cancel the
-	// current batch, if one exists.
-	CancelBatch() (*btcec.PublicKey, error)
+	// CancelBatch signals that the asset minter should cancel the target
+	// batch, if it exists. If no batch key is given, the only pending or
+	// unconfirmed batch is cancelled.
+	CancelBatch(params CancelParams) (*btcec.PublicKey, error)
+
+	// BumpBatchFee signals that the asset minter should replace the
+	// broadcast genesis transaction of the target batch with one that pays
+	// a higher fee. If no batch key is given, the only unconfirmed batch is
+	// targeted. The ID of the replacement transaction is returned.
+	BumpBatchFee(params BumpFeeParams) (*chainhash.Hash, error)
 
 	// Start signals that the asset minter should being operations.
 	Start() error
@@ -193,8 +203,8 @@ type MintingStore interface {
 
 	// AddSeedlingsToBatch adds a new seedling to an existing batch. Once
 	// added this batch should remain in the BatchStatePending state.
-	//
-	// TODO(roasbeef): assumption that only one pending batch at a time?
+	// Multiple batches can be pending at the same time, so the target
+	// batch is always identified by its batch key.
 	AddSeedlingsToBatch(ctx context.Context, batchKey *btcec.PublicKey,
 		seedlings ...*Seedling) error
 
@@ -299,6 +309,13 @@ type ChainBridge interface {
 		reOrgChan chan struct{}) (*chainntnfs.ConfirmationEvent,
 		chan error, error)
 
+	// RegisterSpendNtfn registers an intent to be notified once the given
+	// outpoint is spent by a transaction that confirmed on chain.
+	RegisterSpendNtfn(ctx context.Context, outpoint *wire.OutPoint,
+		pkScript []byte,
+		heightHint uint32) (chan *chainntnfs.SpendDetail, chan error,
+		error)
+
 	// RegisterBlockEpochNtfn registers an intent to be notified of each
 	// new block connected to the main chain.
 	RegisterBlockEpochNtfn(ctx context.Context) (chan int32, chan error,
@@ -402,4 +419,19 @@ var (
 	// ErrBatchAlreadySealed is returned when a minting batch is already
 	// sealed.
 	ErrBatchAlreadySealed = errors.New("batch is already sealed")
+
+	// ErrNoPendingBatch is returned when a minting batch operation targets
+	// a pending batch that doesn't exist.
+	ErrNoPendingBatch = errors.New("no pending batch")
+
+	// ErrBatchKeyRequired is returned when a minting batch operation does
+	// not specify a batch key, but multiple batches are pending, which
+	// makes the target batch ambiguous.
+	ErrBatchKeyRequired = errors.New("multiple pending batches, batch " +
+		"key must be specified")
+
+	// ErrNoUnconfirmedBatch is returned when a minting batch operation
+	// targets a batch that has been broadcast but not yet confirmed, and
+	// no such batch exists.
+	ErrNoUnconfirmedBatch = errors.New("no unconfirmed batch")
 )
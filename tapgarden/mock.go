@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/rand"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -183,7 +184,12 @@ func (m *MockWalletAnchor) FundPsbt(_ context.Context, packet *psbt.Packet,
 	packet.Outputs = append(packet.Outputs, psbt.POutput{})
 
 	// We always have the change output be the second output, so this means
-	// the Taproot Asset commitment will live in the first output.
+	// the Taproot Asset commitment will live in the first output. Just like
+	// lnd, we report the index of the change output we added if the caller
+	// didn't specify one.
+	if changeIdx < 0 {
+		changeIdx = int32(len(packet.UnsignedTx.TxOut) - 1)
+	}
 	pkt := &tapsend.FundedPsbt{
 		Pkt:               packet,
 		ChangeOutputIndex: changeIdx,
@@ -313,6 +319,9 @@ type MockChainBridge struct {
 	ReqCount int
 	ConfReqs map[int]*chainntnfs.ConfirmationEvent
 
+	spendReqsMtx sync.Mutex
+	spendReqs    map[wire.OutPoint][]chan *chainntnfs.SpendDetail
+
 	failFeeEstimates atomic.Bool
 	emptyConf        bool
 	errConf          bool
@@ -327,6 +336,9 @@ func NewMockChainBridge() *MockChainBridge {
 		ConfReqSignal:     make(chan int),
 		BlockEpochSignal:  make(chan struct{}, 1),
 		NewBlocks:         make(chan int32),
+		spendReqs: make(
+			map[wire.OutPoint][]chan *chainntnfs.SpendDetail,
+		),
 	}
 }
 
@@ -394,6 +406,61 @@ func (m *MockChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	return req, m.confErr, nil
 }
 
+// RegisterSpendNtfn registers an intent to be notified once the given outpoint
+// is spent. The notification is only sent once SendSpendNtfn is called for the
+// outpoint.
+func (m *MockChainBridge) RegisterSpendNtfn(ctx context.Context,
+	outpoint *wire.OutPoint, _ []byte,
+	_ uint32) (chan *chainntnfs.SpendDetail, chan error, error) {
+
+	select {
+	case <-ctx.Done():
+		return nil, nil, fmt.Errorf("shutting down")
+	default:
+	}
+
+	m.spendReqsMtx.Lock()
+	defer m.spendReqsMtx.Unlock()
+
+	spendChan := make(chan *chainntnfs.SpendDetail, 1)
+	m.spendReqs[*outpoint] = append(m.spendReqs[*outpoint], spendChan)
+
+	return spendChan, make(chan error, 1), nil
+}
+
+// HasSpendReq returns true if there is a pending spend notification request
+// for the given outpoint.
+func (m *MockChainBridge) HasSpendReq(outpoint wire.OutPoint) bool {
+	m.spendReqsMtx.Lock()
+	defer m.spendReqsMtx.Unlock()
+
+	return len(m.spendReqs[outpoint]) > 0
+}
+
+// SendSpendNtfn notifies all pending spend notification requests for the
+// outpoint spent by the given input of the spending transaction.
+func (m *MockChainBridge) SendSpendNtfn(spendingTx *wire.MsgTx,
+	inputIndex uint32, spendingHeight int32) {
+
+	outpoint := spendingTx.TxIn[inputIndex].PreviousOutPoint
+	spenderHash := spendingTx.TxHash()
+
+	m.spendReqsMtx.Lock()
+	reqs := m.spendReqs[outpoint]
+	delete(m.spendReqs, outpoint)
+	m.spendReqsMtx.Unlock()
+
+	for _, req := range reqs {
+		req <- &chainntnfs.SpendDetail{
+			SpentOutPoint:     &outpoint,
+			SpenderTxHash:     &spenderHash,
+			SpendingTx:        spendingTx,
+			SpenderInputIndex: inputIndex,
+			SpendingHeight:    spendingHeight,
+		}
+	}
+}
+
 func (m *MockChainBridge) RegisterBlockEpochNtfn(
 	ctx context.Context) (chan int32, chan error, error) {
 
@@ -99,6 +99,13 @@ type CancelResp struct {
 	err             error
 }
 
+// BumpFeeResp is the response from a caretaker attempting to bump the fee of
+// the genesis transaction of a batch.
+type BumpFeeResp struct {
+	txid *chainhash.Hash
+	err  error
+}
+
 type stateRequest interface {
 	Resolve(any)
 	Error(error)
@@ -149,24 +156,46 @@ type UnsealedSeedling struct {
 }
 
 // FinalizeParams are the options available to change how a batch is finalized,
-// and how the genesis TX is constructed.
+// and how the genesis TX is constructed. If no batch key is set, the only
+// pending batch is targeted.
 type FinalizeParams struct {
+	BatchKey       *btcec.PublicKey
 	FeeRate        fn.Option[chainfee.SatPerKWeight]
 	SiblingTapTree fn.Option[asset.TapscriptTreeNodes]
 }
 
 // FundParams are the options available to change how a batch is funded, and how
-// the genesis TX is constructed.
+// the genesis TX is constructed. If no batch key is set, the only pending batch
+// is targeted, or a new batch is created if there is none. If NewBatch is set,
+// a new batch is created even if other batches are pending.
 type FundParams struct {
+	BatchKey       *btcec.PublicKey
+	NewBatch       bool
 	FeeRate        fn.Option[chainfee.SatPerKWeight]
 	SiblingTapTree fn.Option[asset.TapscriptTreeNodes]
 }
 
-// SealParams change how asset groups in a minting batch are created.
+// SealParams change how asset groups in a minting batch are created. If no
+// batch key is set, the only pending batch is targeted.
 type SealParams struct {
+	BatchKey       *btcec.PublicKey
 	GroupWitnesses []asset.PendingGroupWitness
 }
 
+// CancelParams specify which minting batch should be cancelled. If no batch key
+// is set, the only pending or unconfirmed batch is targeted.
+type CancelParams struct {
+	BatchKey *btcec.PublicKey
+}
+
+// BumpFeeParams specify the broadcast, but unconfirmed, minting batch whose
+// genesis transaction should be replaced by one that pays the given fee rate.
+// If no batch key is set, the only unconfirmed batch is targeted.
+type BumpFeeParams struct {
+	BatchKey *btcec.PublicKey
+	FeeRate  chainfee.SatPerKWeight
+}
+
 func newStateParamReq[T, S any](req reqType, param S) *stateParamReq[T, S] {
 	return &stateParamReq[T, S]{
 		stateReq: *newStateReq[T](req),
@@ -213,12 +242,14 @@ type reqType uint8
 
 const (
 	reqTypePendingBatch = iota
+	reqTypePendingBatches
 	reqTypeNumActiveBatches
 	reqTypeListBatches
 	reqTypeFinalizeBatch
 	reqTypeCancelBatch
 	reqTypeFundBatch
 	reqTypeSealBatch
+	reqTypeBumpBatchFee
 )
 
 // ChainPlanter is responsible for accepting new incoming requests to create
@@ -235,9 +266,10 @@ type ChainPlanter struct {
 	// seedlingReqs is used to accept new asset issuance requests.
 	seedlingReqs chan *Seedling
 
-	// pendingBatch is the current pending, non-frozen batch. Only one of
-	// these will exist at any given time.
-	pendingBatch *MintingBatch
+	// pendingBatches maps a batch key to a pending, non-frozen batch.
+	// Multiple batches can be pending at the same time, with each of them
+	// being funded, sealed and finalized independently.
+	pendingBatches map[BatchKey]*MintingBatch
 
 	// caretakers maps a batch key (which is used as the internal key for
 	// the transaction that mints the assets) to the caretaker that will
@@ -269,6 +301,7 @@ type ChainPlanter struct {
 func NewChainPlanter(cfg PlanterConfig) *ChainPlanter {
 	return &ChainPlanter{
 		cfg:               cfg,
+		pendingBatches:    make(map[BatchKey]*MintingBatch),
 		caretakers:        make(map[BatchKey]*BatchCaretaker),
 		completionSignals: make(chan BatchKey),
 		seedlingReqs:      make(chan *Seedling),
@@ -297,6 +330,8 @@ func (c *ChainPlanter) newCaretakerForBatch(batch *MintingBatch,
 		},
 		CancelReqChan:       make(chan struct{}, 1),
 		CancelRespChan:      make(chan CancelResp, 1),
+		BumpFeeReqChan:      make(chan chainfee.SatPerKWeight, 1),
+		BumpFeeRespChan:     make(chan BumpFeeResp, 1),
 		UpdateMintingProofs: c.updateMintingProofs,
 		PublishMintEvent:    c.publishSubscriberEvent,
 		ErrChan:             c.cfg.ErrChan,
@@ -397,7 +432,7 @@ func (c *ChainPlanter) Start() error {
 				if !batch.IsFunded() {
 					log.Infof("Funding non-finalized "+
 						"batch from DB (%x)", batchKey)
-					fundErr = c.fundBatch(
+					_, fundErr = c.fundBatch(
 						ctx, FundParams{}, batch,
 					)
 				}
@@ -1070,41 +1105,74 @@ func listBatches(ctx context.Context, batchStore MintingStore,
 	return verboseBatches, nil
 }
 
-// canCancelBatch returns a batch key if the planter is in a state where a batch
-// can be cancelled. This does not account for the state of a caretaker that
-// may be managing a batch.
-func (c *ChainPlanter) canCancelBatch() (*btcec.PublicKey, error) {
-	caretakerCount := len(c.caretakers)
+// pendingBatch returns the pending batch identified by the given batch key. If
+// no batch key is given, the only pending batch is returned, or nil if there
+// are no pending batches. If there are multiple pending batches, a batch key
+// must be given.
+func (c *ChainPlanter) pendingBatch(
+	batchKey *btcec.PublicKey) (*MintingBatch, error) {
 
-	switch caretakerCount {
-	case 0:
-		// If there are no caretakers, the only batch we could cancel
-		// would be the current pending batch.
-		if c.pendingBatch == nil {
-			return nil, fmt.Errorf("no pending batch")
+	if batchKey != nil {
+		batch, ok := c.pendingBatches[asset.ToSerialized(batchKey)]
+		if !ok {
+			return nil, fmt.Errorf("%w: batch_key=%x",
+				ErrNoPendingBatch, batchKey.SerializeCompressed())
 		}
 
-		return c.pendingBatch.BatchKey.PubKey, nil
+		return batch, nil
+	}
+
+	switch len(c.pendingBatches) {
+	case 0:
+		return nil, nil
+
 	case 1:
-		// TODO(jhb): Update once we support multiple batches.
-		// If there is exactly one caretaker, our pending batch should
-		// be empty. Otherwise, the batch to cancel is ambiguous.
-		if c.pendingBatch != nil {
-			return nil, fmt.Errorf("multiple batches not supported")
+		return maps.Values(c.pendingBatches)[0], nil
+
+	default:
+		return nil, ErrBatchKeyRequired
+	}
+}
+
+// canCancelBatch returns a batch key if the planter is in a state where the
+// target batch can be cancelled. If no batch key is given, the only pending
+// batch or batch managed by a caretaker is targeted. This does not account for
+// the state of a caretaker that may be managing a batch.
+func (c *ChainPlanter) canCancelBatch(
+	batchKey *btcec.PublicKey) (*btcec.PublicKey, error) {
+
+	if batchKey != nil {
+		batchKeySerialized := asset.ToSerialized(batchKey)
+		_, isPending := c.pendingBatches[batchKeySerialized]
+		_, hasCaretaker := c.caretakers[batchKeySerialized]
+		if !isPending && !hasCaretaker {
+			return nil, fmt.Errorf("%w: batch_key=%x",
+				ErrNoPendingBatch, batchKeySerialized[:])
 		}
 
-		batchKeys := maps.Keys(c.caretakers)
+		return batchKey, nil
+	}
+
+	// Without a batch key, the batch to cancel is only unambiguous if
+	// there is exactly one pending batch or caretaker.
+	batchKeys := append(
+		maps.Keys(c.pendingBatches), maps.Keys(c.caretakers)...,
+	)
+	switch len(batchKeys) {
+	case 0:
+		return nil, ErrNoPendingBatch
+
+	case 1:
 		batchKey, err := btcec.ParsePubKey(batchKeys[0][:])
 		if err != nil {
-			return nil, fmt.Errorf("bad caretaker key: %w", err)
+			return nil, fmt.Errorf("bad batch key: %w", err)
 		}
 
 		return batchKey, nil
+
 	default:
+		return nil, ErrBatchKeyRequired
 	}
-
-	// TODO(jhb): Update once we support multiple batches.
-	return nil, fmt.Errorf("multiple caretakers not supported")
 }
 
 // cancelMintingBatch attempts to cancel a target minting batch. This can fail
@@ -1143,7 +1211,8 @@ func (c *ChainPlanter) cancelMintingBatch(ctx context.Context,
 	}
 
 	log.Infof("Cancelling MintingBatch(key=%x, num_assets=%v)",
-		batchKeySerialized, len(c.pendingBatch.Seedlings))
+		batchKeySerialized,
+		len(c.pendingBatches[batchKeySerialized].Seedlings))
 
 	// If the target batch was not assigned a caretaker, we only need to
 	// update the batch state on disk to cancel it.
@@ -1157,6 +1226,69 @@ func (c *ChainPlanter) cancelMintingBatch(ctx context.Context,
 	return nil
 }
 
+// unconfirmedCaretaker returns the caretaker of the target batch, which must
+// have been broadcast but not yet confirmed. If no batch key is given, the only
+// unconfirmed batch is targeted.
+func (c *ChainPlanter) unconfirmedCaretaker(
+	batchKey *btcec.PublicKey) (*BatchCaretaker, error) {
+
+	if batchKey != nil {
+		batchKeySerialized := asset.ToSerialized(batchKey)
+		caretaker, ok := c.caretakers[batchKeySerialized]
+		if !ok || caretaker.cfg.Batch.State() != BatchStateBroadcast {
+			return nil, fmt.Errorf("%w: batch_key=%x",
+				ErrNoUnconfirmedBatch, batchKeySerialized[:])
+		}
+
+		return caretaker, nil
+	}
+
+	var unconfirmed []*BatchCaretaker
+	for _, caretaker := range c.caretakers {
+		if caretaker.cfg.Batch.State() == BatchStateBroadcast {
+			unconfirmed = append(unconfirmed, caretaker)
+		}
+	}
+
+	switch len(unconfirmed) {
+	case 0:
+		return nil, ErrNoUnconfirmedBatch
+
+	case 1:
+		return unconfirmed[0], nil
+
+	default:
+		return nil, ErrBatchKeyRequired
+	}
+}
+
+// bumpBatchFee asks the caretaker of the target batch to replace the genesis
+// transaction of the batch with one that pays a higher fee. The ID of the
+// replacement transaction is returned.
+func (c *ChainPlanter) bumpBatchFee(
+	params BumpFeeParams) (*chainhash.Hash, error) {
+
+	caretaker, err := c.unconfirmedCaretaker(params.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Bumping fee of MintingBatch(key=%x) to fee_rate=%v",
+		caretaker.batchKey[:], params.FeeRate.FeePerKVByte())
+
+	caretaker.cfg.BumpFeeReqChan <- params.FeeRate
+
+	// The caretaker replaces and broadcasts the genesis transaction, then
+	// reports back the ID of the replacement.
+	select {
+	case bumpResp := <-caretaker.cfg.BumpFeeRespChan:
+		return bumpResp.txid, bumpResp.err
+
+	case <-c.Quit:
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+}
+
 // gardener is responsible for collecting new potential taproot asset
 // seeds/seedlings into a batch to ultimately be anchored in a genesis output
 // creating the assets from seedlings into sprouts, and eventually fully grown
@@ -1180,7 +1312,7 @@ func (c *ChainPlanter) gardener() {
 			// seedling (soon to be a sprout) by committing it to
 			// disk as part of the latest batch.
 			ctx, cancel := c.WithCtxQuit()
-			err := c.prepAssetSeedling(ctx, req)
+			batch, err := c.prepAssetSeedling(ctx, req)
 			cancel()
 			if err != nil {
 				// Something went wrong, so then an error
@@ -1200,7 +1332,7 @@ func (c *ChainPlanter) gardener() {
 			// TODO(roasbeef): extend the ticker by a certain
 			// portion?
 			req.updates <- SeedlingUpdate{
-				PendingBatch: c.pendingBatch,
+				PendingBatch: batch,
 				NewState:     MintingStateSeed,
 			}
 
@@ -1231,7 +1363,14 @@ func (c *ChainPlanter) gardener() {
 		case req := <-c.stateReqs:
 			switch req.Type() {
 			case reqTypePendingBatch:
-				req.Resolve(c.pendingBatch)
+				// If the pending batch is ambiguous, we'll
+				// report that there is no single pending
+				// batch.
+				batch, _ := c.pendingBatch(nil)
+				req.Resolve(batch)
+
+			case reqTypePendingBatches:
+				req.Resolve(maps.Values(c.pendingBatches))
 
 			case reqTypeNumActiveBatches:
 				req.Resolve(len(c.caretakers))
@@ -1259,14 +1398,6 @@ func (c *ChainPlanter) gardener() {
 				req.Resolve(batches)
 
 			case reqTypeFundBatch:
-				if c.pendingBatch != nil &&
-					c.pendingBatch.IsFunded() {
-
-					req.Error(fmt.Errorf("batch already " +
-						"funded"))
-					break
-				}
-
 				fundReqParams, err :=
 					typedParam[FundParams](req)
 				if err != nil {
@@ -1275,9 +1406,37 @@ func (c *ChainPlanter) gardener() {
 					break
 				}
 
+				// If no batch is pending yet, or a new batch
+				// was requested, a new batch will be created
+				// during funding.
+				var batch *MintingBatch
+				switch {
+				case fundReqParams.NewBatch &&
+					fundReqParams.BatchKey != nil:
+
+					err = fmt.Errorf("cannot specify a " +
+						"batch key when requesting a " +
+						"new batch")
+
+				case !fundReqParams.NewBatch:
+					batch, err = c.pendingBatch(
+						fundReqParams.BatchKey,
+					)
+				}
+				if err != nil {
+					req.Error(err)
+					break
+				}
+
+				if batch != nil && batch.IsFunded() {
+					req.Error(fmt.Errorf("batch already " +
+						"funded"))
+					break
+				}
+
 				ctx, cancel := c.WithCtxQuit()
-				err = c.fundBatch(
-					ctx, *fundReqParams, c.pendingBatch,
+				fundedBatch, err := c.fundBatch(
+					ctx, *fundReqParams, batch,
 				)
 				cancel()
 				if err != nil {
@@ -1286,15 +1445,9 @@ func (c *ChainPlanter) gardener() {
 					break
 				}
 
-				req.Resolve(c.pendingBatch)
+				req.Resolve(fundedBatch)
 
 			case reqTypeSealBatch:
-				if c.pendingBatch == nil {
-					req.Error(fmt.Errorf("no pending " +
-						"batch"))
-					break
-				}
-
 				sealReqParams, err :=
 					typedParam[SealParams](req)
 				if err != nil {
@@ -1303,9 +1456,22 @@ func (c *ChainPlanter) gardener() {
 					break
 				}
 
+				batch, err := c.pendingBatch(
+					sealReqParams.BatchKey,
+				)
+				if err != nil {
+					req.Error(err)
+					break
+				}
+
+				if batch == nil {
+					req.Error(ErrNoPendingBatch)
+					break
+				}
+
 				ctx, cancel := c.WithCtxQuit()
 				sealedBatch, err := c.sealBatch(
-					ctx, *sealReqParams, c.pendingBatch,
+					ctx, *sealReqParams, batch,
 				)
 				cancel()
 				if err != nil {
@@ -1317,16 +1483,6 @@ func (c *ChainPlanter) gardener() {
 				req.Resolve(sealedBatch)
 
 			case reqTypeFinalizeBatch:
-				if c.pendingBatch == nil {
-					req.Error(fmt.Errorf("no pending " +
-						"batch"))
-					break
-				}
-
-				batchKey := c.pendingBatch.BatchKey.PubKey
-				batchKeySerial := asset.ToSerialized(batchKey)
-				log.Infof("Finalizing batch %x", batchKeySerial)
-
 				finalizeReqParams, err :=
 					typedParam[FinalizeParams](req)
 				if err != nil {
@@ -1335,8 +1491,25 @@ func (c *ChainPlanter) gardener() {
 					break
 				}
 
+				batch, err := c.pendingBatch(
+					finalizeReqParams.BatchKey,
+				)
+				if err != nil {
+					req.Error(err)
+					break
+				}
+
+				if batch == nil {
+					req.Error(ErrNoPendingBatch)
+					break
+				}
+
+				batchKey := batch.BatchKey.PubKey
+				batchKeySerial := asset.ToSerialized(batchKey)
+				log.Infof("Finalizing batch %x", batchKeySerial)
+
 				caretaker, err := c.finalizeBatch(
-					*finalizeReqParams,
+					*finalizeReqParams, batch,
 				)
 				if err != nil {
 					freezeErr := fmt.Errorf("unable to "+
@@ -1374,25 +1547,56 @@ func (c *ChainPlanter) gardener() {
 				// Now that we have a caretaker launched for
 				// this batch and broadcast its minting
 				// transaction, we can remove the pending batch.
-				c.pendingBatch = nil
+				delete(c.pendingBatches, batchKeySerial)
 
 			case reqTypeCancelBatch:
-				batchKey, err := c.canCancelBatch()
+				cancelReqParams, err :=
+					typedParam[CancelParams](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad cancel "+
+						"params: %w", err))
+					break
+				}
+
+				batchKey, err := c.canCancelBatch(
+					cancelReqParams.BatchKey,
+				)
 				if err != nil {
 					req.Error(err)
 					break
 				}
 
-				// Attempt to cancel the current batch, and then
-				// clear the pending batch in the planter.
+				// Attempt to cancel the target batch, and then
+				// remove it from the pending batches in the
+				// planter.
 				ctx, cancel := c.WithCtxQuit()
 				err = c.cancelMintingBatch(ctx, batchKey)
 				cancel()
-				c.pendingBatch = nil
+				delete(
+					c.pendingBatches,
+					asset.ToSerialized(batchKey),
+				)
 
 				// Always return the key of the batch we tried
 				// to cancel.
 				req.Return(batchKey, err)
+
+			case reqTypeBumpBatchFee:
+				bumpReqParams, err :=
+					typedParam[BumpFeeParams](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad bump fee "+
+						"params: %w", err))
+					break
+				}
+
+				txid, err := c.bumpBatchFee(*bumpReqParams)
+				if err != nil {
+					req.Error(err)
+					break
+				}
+
+				req.Resolve(txid)
 			}
 
 		case <-c.Quit:
@@ -1404,11 +1608,11 @@ func (c *ChainPlanter) gardener() {
 // fundBatch attempts to fund a minting batch and create a funded genesis PSBT.
 // This PSBT is a template that the caretaker will modify when finalizing the
 // batch. If a feerate or tapscript sibling are provided, those will be used
-// when funding the batch. If no pending batch exists, a batch will be created
-// with the funded genesis PSBT. After funding, the pending batch will be
-// saved to disk and updated in memory.
+// when funding the batch. If no working batch is given, a new pending batch
+// will be created with the funded genesis PSBT. After funding, the batch will
+// be saved to disk and updated in memory.
 func (c *ChainPlanter) fundBatch(ctx context.Context, params FundParams,
-	workingBatch *MintingBatch) error {
+	workingBatch *MintingBatch) (*MintingBatch, error) {
 
 	var (
 		feeRate  *chainfee.SatPerKWeight
@@ -1425,8 +1629,8 @@ func (c *ChainPlanter) fundBatch(ctx context.Context, params FundParams,
 	})
 
 	if err != nil {
-		return fmt.Errorf("unable to store tapscript tree for minting "+
-			"batch: %w", err)
+		return nil, fmt.Errorf("unable to store tapscript tree for "+
+			"minting batch: %w", err)
 	}
 
 	// Update the batch by adding the sibling root hash and genesis TX.
@@ -1454,30 +1658,33 @@ func (c *ChainPlanter) fundBatch(ctx context.Context, params FundParams,
 	if workingBatch == nil {
 		newBatch, err := c.newBatch()
 		if err != nil {
-			return fmt.Errorf("unable to create new batch: %w", err)
+			return nil, fmt.Errorf("unable to create new batch: %w",
+				err)
 		}
 
 		err = updateBatch(newBatch)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// Now that we're done populating parts of the batch, write it
 		// to disk.
 		err = c.cfg.Log.CommitMintingBatch(ctx, newBatch)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		c.pendingBatch = newBatch
-		return nil
+		batchKey := asset.ToSerialized(newBatch.BatchKey.PubKey)
+		c.pendingBatches[batchKey] = newBatch
+
+		return newBatch, nil
 	}
 
 	// If we already have a batch, we need to attach the optional sibling
 	// root hash and fund the batch.
 	err = updateBatch(workingBatch)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Write the associated sibling root hash and TX to disk.
@@ -1486,7 +1693,7 @@ func (c *ChainPlanter) fundBatch(ctx context.Context, params FundParams,
 			ctx, workingBatch.BatchKey.PubKey, rootHash,
 		)
 		if err != nil {
-			return fmt.Errorf("unable to commit tapscript "+
+			return nil, fmt.Errorf("unable to commit tapscript "+
 				"sibling for minting batch %w", err)
 		}
 	}
@@ -1495,10 +1702,10 @@ func (c *ChainPlanter) fundBatch(ctx context.Context, params FundParams,
 		ctx, workingBatch.BatchKey.PubKey, workingBatch.GenesisPacket,
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return workingBatch, nil
 }
 
 // sealBatch will verify that each grouped asset in the pending batch has an
@@ -1680,9 +1887,10 @@ func (c *ChainPlanter) sealBatch(ctx context.Context, params SealParams,
 	return batchWithGroupInfo, nil
 }
 
-// finalizeBatch creates a new caretaker for the batch and starts it.
-func (c *ChainPlanter) finalizeBatch(params FinalizeParams) (*BatchCaretaker,
-	error) {
+// finalizeBatch creates a new caretaker for the given pending batch and starts
+// it.
+func (c *ChainPlanter) finalizeBatch(params FinalizeParams,
+	workingBatch *MintingBatch) (*BatchCaretaker, error) {
 
 	var (
 		feeRate *chainfee.SatPerKWeight
@@ -1693,7 +1901,7 @@ func (c *ChainPlanter) finalizeBatch(params FinalizeParams) (*BatchCaretaker,
 	// funded. If so, reject any provided parameters, as they would conflict
 	// with those previously used for batch funding.
 	haveParams := params.FeeRate.IsSome() || params.SiblingTapTree.IsSome()
-	if haveParams && c.pendingBatch.IsFunded() {
+	if haveParams && workingBatch.IsFunded() {
 		return nil, fmt.Errorf("cannot provide finalize parameters " +
 			"if batch already funded")
 	}
@@ -1714,21 +1922,30 @@ func (c *ChainPlanter) finalizeBatch(params FinalizeParams) (*BatchCaretaker,
 	}
 	// At this point, we have a non-empty batch, so we'll first finalize it
 	// on disk. This means no further seedlings can be added to this batch.
-	err = freezeMintingBatch(ctx, c.cfg.Log, c.pendingBatch)
+	err = freezeMintingBatch(ctx, c.cfg.Log, workingBatch)
 	if err != nil {
 		return nil, err
 	}
 
 	// If the batch already has a funded TX, we can skip funding the batch.
-	if !c.pendingBatch.IsFunded() {
+	if !workingBatch.IsFunded() {
 		// Fund the batch before starting the caretaker. If funding
 		// fails, we can't start a caretaker for the batch, so we'll
-		// clear the pending batch. The batch will exist on disk for
-		// the user to recreate it if necessary.
+		// remove it from the pending batches. The batch will exist on
+		// disk for the user to recreate it if necessary.
 		// TODO(jhb): Don't clear pending batch here
-		err = c.fundBatch(ctx, FundParams(params), c.pendingBatch)
+		fundParams := FundParams{
+			BatchKey:       params.BatchKey,
+			FeeRate:        params.FeeRate,
+			SiblingTapTree: params.SiblingTapTree,
+		}
+		_, err = c.fundBatch(ctx, fundParams, workingBatch)
 		if err != nil {
-			c.pendingBatch = nil
+			batchKey := asset.ToSerialized(
+				workingBatch.BatchKey.PubKey,
+			)
+			delete(c.pendingBatches, batchKey)
+
 			return nil, err
 		}
 	}
@@ -1736,7 +1953,7 @@ func (c *ChainPlanter) finalizeBatch(params FinalizeParams) (*BatchCaretaker,
 	// If the batch needs to be sealed, we'll use the default behavior for
 	// generating asset group witnesses. Any custom behavior requires
 	// calling SealBatch() explicitly, before batch finalization.
-	_, err = c.sealBatch(ctx, SealParams{}, c.pendingBatch)
+	_, err = c.sealBatch(ctx, SealParams{}, workingBatch)
 	if err != nil {
 		if !errors.Is(err, ErrBatchAlreadySealed) {
 			return nil, err
@@ -1746,8 +1963,8 @@ func (c *ChainPlanter) finalizeBatch(params FinalizeParams) (*BatchCaretaker,
 	// Now that the batch has been frozen on disk, we can update the batch
 	// state to frozen before launching a new caretaker state machine for
 	// the batch that'll drive all the seedlings do adulthood.
-	c.pendingBatch.UpdateState(BatchStateFrozen)
-	caretaker := c.newCaretakerForBatch(c.pendingBatch, feeRate)
+	workingBatch.UpdateState(BatchStateFrozen)
+	caretaker := c.newCaretakerForBatch(workingBatch, feeRate)
 	if err := caretaker.Start(); err != nil {
 		return nil, fmt.Errorf("unable to start new caretaker: %w", err)
 	}
@@ -1756,7 +1973,7 @@ func (c *ChainPlanter) finalizeBatch(params FinalizeParams) (*BatchCaretaker,
 }
 
 // PendingBatch returns the current pending batch. If there's no pending batch,
-// then an error is returned.
+// or if there are multiple pending batches, then nil is returned.
 func (c *ChainPlanter) PendingBatch() (*MintingBatch, error) {
 	req := newStateReq[*MintingBatch](reqTypePendingBatch)
 
@@ -1767,6 +1984,17 @@ func (c *ChainPlanter) PendingBatch() (*MintingBatch, error) {
 	return <-req.resp, nil
 }
 
+// PendingBatches returns all pending batches, in no particular order.
+func (c *ChainPlanter) PendingBatches() ([]*MintingBatch, error) {
+	req := newStateReq[[]*MintingBatch](reqTypePendingBatches)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, nil
+}
+
 // NumActiveBatches returns the total number of active batches that have an
 // outstanding caretaker assigned.
 func (c *ChainPlanter) NumActiveBatches() (int, error) {
@@ -1793,7 +2021,7 @@ func (c *ChainPlanter) ListBatches(params ListBatchesParams) ([]*VerboseBatch,
 	return <-req.resp, <-req.err
 }
 
-// FundBatch sends a signal to the planter to fund the current batch, or create
+// FundBatch sends a signal to the planter to fund the target batch, or create
 // a funded batch.
 func (c *ChainPlanter) FundBatch(params FundParams) (*MintingBatch, error) {
 	req := newStateParamReq[*MintingBatch](reqTypeFundBatch, params)
@@ -1805,7 +2033,7 @@ func (c *ChainPlanter) FundBatch(params FundParams) (*MintingBatch, error) {
 	return <-req.resp, <-req.err
 }
 
-// SealBatch attempts to seal the current batch, by providing or deriving all
+// SealBatch attempts to seal the target batch, by providing or deriving all
 // witnesses necessary to create the final genesis TX.
 func (c *ChainPlanter) SealBatch(params SealParams) (*MintingBatch, error) {
 	req := newStateParamReq[*MintingBatch](reqTypeSealBatch, params)
@@ -1817,7 +2045,7 @@ func (c *ChainPlanter) SealBatch(params SealParams) (*MintingBatch, error) {
 	return <-req.resp, <-req.err
 }
 
-// FinalizeBatch sends a signal to the planter to finalize the current batch.
+// FinalizeBatch sends a signal to the planter to finalize the target batch.
 func (c *ChainPlanter) FinalizeBatch(params FinalizeParams) (*MintingBatch,
 	error) {
 
@@ -1830,9 +2058,25 @@ func (c *ChainPlanter) FinalizeBatch(params FinalizeParams) (*MintingBatch,
 	return <-req.resp, <-req.err
 }
 
-// CancelBatch sends a signal to the planter to cancel the current batch.
-func (c *ChainPlanter) CancelBatch() (*btcec.PublicKey, error) {
-	req := newStateReq[*btcec.PublicKey](reqTypeCancelBatch)
+// CancelBatch sends a signal to the planter to cancel the target batch.
+func (c *ChainPlanter) CancelBatch(params CancelParams) (*btcec.PublicKey,
+	error) {
+
+	req := newStateParamReq[*btcec.PublicKey](reqTypeCancelBatch, params)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// BumpBatchFee sends a signal to the planter to replace the genesis transaction
+// of the target batch with one that pays a higher fee.
+func (c *ChainPlanter) BumpBatchFee(params BumpFeeParams) (*chainhash.Hash,
+	error) {
+
+	req := newStateParamReq[*chainhash.Hash](reqTypeBumpBatchFee, params)
 
 	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
 		return nil, fmt.Errorf("chain planter shutting down")
@@ -1842,21 +2086,40 @@ func (c *ChainPlanter) CancelBatch() (*btcec.PublicKey, error) {
 }
 
 // prepAssetSeedling performs some basic validation for the Seedling, then
-// either adds it to an existing pending batch or creates a new batch for it. A
-// bool indicating if a new batch should immediately be created is returned.
+// either adds it to an existing pending batch or creates a new batch for it.
+// The batch the seedling was added to is returned.
 func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
-	req *Seedling) error {
+	req *Seedling) (*MintingBatch, error) {
 
 	// First, we'll perform some basic validation for the seedling.
 	if err := req.validateFields(); err != nil {
-		return err
+		return nil, err
+	}
+
+	// Next, we'll find the pending batch the seedling should be added to.
+	// If a new batch was requested, or there is no pending batch yet, the
+	// target batch remains nil and a new batch is created below.
+	var (
+		targetBatch *MintingBatch
+		err         error
+	)
+	switch {
+	case req.NewBatch && req.BatchKey != nil:
+		return nil, fmt.Errorf("cannot specify a batch key when " +
+			"requesting a new batch")
+
+	case !req.NewBatch:
+		targetBatch, err = c.pendingBatch(req.BatchKey)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	// The seedling name must be unique within the pending batch.
-	if c.pendingBatch != nil {
-		if _, ok := c.pendingBatch.Seedlings[req.AssetName]; ok {
-			return fmt.Errorf("asset with name %v already in batch",
-				req.AssetName)
+	// The seedling name must be unique within the target batch.
+	if targetBatch != nil {
+		if _, ok := targetBatch.Seedlings[req.AssetName]; ok {
+			return nil, fmt.Errorf("asset with name %v already in "+
+				"batch", req.AssetName)
 		}
 	}
 
@@ -1869,7 +2132,7 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 			ctx, &req.GroupInfo.GroupPubKey,
 		)
 		if err != nil {
-			return fmt.Errorf("group key %x not found: %w",
+			return nil, fmt.Errorf("group key %x not found: %w",
 				groupKeyBytes, err,
 			)
 		}
@@ -1878,14 +2141,14 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 			ctx, groupInfo.Genesis.ID(),
 		)
 		if err != nil {
-			return fmt.Errorf("group anchor genesis %x not found: "+
+			return nil, fmt.Errorf("group anchor genesis %x not found: "+
 				"%w", groupKeyBytes, err,
 			)
 		}
 
 		err = req.validateGroupKey(*groupInfo, anchorMeta)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		req.GroupInfo = groupInfo
@@ -1894,14 +2157,14 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 	// If a group anchor is specified, we need to ensure that the anchor
 	// seedling is already in the batch and has emission enabled.
 	if req.GroupAnchor != nil {
-		if c.pendingBatch == nil {
-			return fmt.Errorf("batch empty, group anchor %v "+
+		if targetBatch == nil {
+			return nil, fmt.Errorf("batch empty, group anchor %v "+
 				"invalid", *req.GroupAnchor)
 		}
 
-		err := c.pendingBatch.validateGroupAnchor(req)
+		err := targetBatch.validateGroupAnchor(req)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -1909,12 +2172,12 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 	// also be enabled.
 	if !req.EnableEmission {
 		if req.GroupInternalKey != nil {
-			return fmt.Errorf("cannot specify group internal key " +
+			return nil, fmt.Errorf("cannot specify group internal key " +
 				"without enabling emission")
 		}
 
 		if req.GroupTapscriptRoot != nil {
-			return fmt.Errorf("cannot specify group tapscript " +
+			return nil, fmt.Errorf("cannot specify group tapscript " +
 				"root without enabling emission")
 		}
 	}
@@ -1926,7 +2189,7 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 			ctx, asset.TaprootAssetsKeyFamily,
 		)
 		if err != nil {
-			return fmt.Errorf("unable to obtain internal key for "+
+			return nil, fmt.Errorf("unable to obtain internal key for "+
 				"group key for seedling: %s %w", req.AssetName,
 				err)
 		}
@@ -1941,7 +2204,7 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 			ctx, asset.TaprootAssetsKeyFamily,
 		)
 		if err != nil {
-			return fmt.Errorf("unable to obtain script key for "+
+			return nil, fmt.Errorf("unable to obtain script key for "+
 				"seedling: %s %w", req.AssetName, err)
 		}
 
@@ -1954,10 +2217,10 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 	switch {
 	// No batch, so we'll create a new one with only this seedling as part
 	// of the batch.
-	case c.pendingBatch == nil:
+	case targetBatch == nil:
 		newBatch, err := c.newBatch()
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		log.Infof("Adding %v to new MintingBatch", req)
@@ -1968,33 +2231,34 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 		defer cancel()
 		err = c.cfg.Log.CommitMintingBatch(ctx, newBatch)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		c.pendingBatch = newBatch
+		batchKey := asset.ToSerialized(newBatch.BatchKey.PubKey)
+		c.pendingBatches[batchKey] = newBatch
+		targetBatch = newBatch
 
 	// A batch already exists, so we'll add this seedling to the batch,
 	// committing it to disk fully before we move on.
-	case c.pendingBatch != nil:
+	default:
 		log.Infof("Adding %v to existing MintingBatch", req)
 
-		c.pendingBatch.Seedlings[req.AssetName] = req
+		targetBatch.Seedlings[req.AssetName] = req
 
 		// Now that we know the seedling is ok, we'll write it to disk.
 		ctx, cancel := c.WithCtxQuit()
 		defer cancel()
 		err := c.cfg.Log.AddSeedlingsToBatch(
-			ctx, c.pendingBatch.BatchKey.PubKey, req,
+			ctx, targetBatch.BatchKey.PubKey, req,
 		)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	// Now that we have the batch committed to disk, we'll return back to
-	// the caller if we should finalize the batch immediately or not based
-	// on its preference.
-	return nil
+	// Now that we have the batch committed to disk, we'll return the batch
+	// back to the caller.
+	return targetBatch, nil
 }
 
 // updateMintingProofs is called by the re-org watcher when it detects a re-org
@@ -36,6 +36,7 @@ import (
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/maps"
@@ -616,7 +617,7 @@ func (t *mintingTestHarness) assertNewBatchFrozen(
 func (t *mintingTestHarness) cancelMintingBatch(noBatch bool) *btcec.PublicKey {
 	t.Helper()
 
-	batchKey, err := t.planter.CancelBatch()
+	batchKey, err := t.planter.CancelBatch(tapgarden.CancelParams{})
 	if noBatch {
 		require.ErrorContains(t, err, "no pending batch")
 		require.Nil(t, batchKey)
@@ -1535,6 +1536,125 @@ func testFinalizeBatch(t *mintingTestHarness) {
 	t.assertLastBatchState(batchCount, tapgarden.BatchStateFinalized)
 }
 
+// testMultiplePendingBatches tests that multiple batches can be pending at the
+// same time, and that each of them can be targeted by its batch key.
+func testMultiplePendingBatches(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	// Create an initial batch of 3 seedlings, which will be the only
+	// pending batch.
+	const numSeedlings = 3
+	firstSeedlings := t.queueInitialBatch(numSeedlings)
+
+	firstBatch, err := t.planter.PendingBatch()
+	require.NoError(t, err)
+	require.NotNil(t, firstBatch)
+	firstBatchKey := firstBatch.BatchKey.PubKey
+
+	// We now request a new batch for another set of seedlings. The first
+	// seedling will create the batch, the rest are added to it by
+	// targeting its batch key.
+	secondSeedlings := t.newRandSeedlings(numSeedlings)
+	secondSeedlings[0].NewBatch = true
+	t.queueSeedlingsInBatch(false, secondSeedlings[0])
+
+	pendingBatches, err := t.planter.PendingBatches()
+	require.NoError(t, err)
+	require.Len(t, pendingBatches, 2)
+
+	secondBatch, err := fn.First(
+		pendingBatches, func(b *tapgarden.MintingBatch) bool {
+			return !b.BatchKey.PubKey.IsEqual(firstBatchKey)
+		},
+	)
+	require.NoError(t, err)
+	secondBatchKey := secondBatch.BatchKey.PubKey
+
+	for _, seedling := range secondSeedlings[1:] {
+		seedling.BatchKey = secondBatchKey
+	}
+	t.queueSeedlingsInBatch(true, secondSeedlings[1:]...)
+
+	t.assertSeedlingsExist(firstSeedlings, firstBatchKey)
+	t.assertSeedlingsExist(secondSeedlings, secondBatchKey)
+
+	// With two pending batches, there is no single pending batch anymore.
+	pendingBatch, err := t.planter.PendingBatch()
+	require.NoError(t, err)
+	require.Nil(t, pendingBatch)
+
+	// A seedling that doesn't specify the target batch should be rejected,
+	// as should a seedling that specifies a batch key and a new batch.
+	assertSeedlingRejected := func(seedling *tapgarden.Seedling,
+		errString string) {
+
+		updates, err := t.planter.QueueNewSeedling(seedling)
+		require.NoError(t, err)
+
+		update, err := fn.RecvOrTimeout(updates, defaultTimeout)
+		require.NoError(t, err)
+		require.ErrorContains(t, update.Error, errString)
+	}
+
+	seedling := t.newRandSeedlings(1)[0]
+	assertSeedlingRejected(seedling, "batch key must be specified")
+
+	seedling.BatchKey = firstBatchKey
+	seedling.NewBatch = true
+	assertSeedlingRejected(seedling, "cannot specify a batch key")
+
+	// Batch operations without a batch key are ambiguous as well.
+	_, err = t.planter.CancelBatch(tapgarden.CancelParams{})
+	require.ErrorIs(t, err, tapgarden.ErrBatchKeyRequired)
+
+	var (
+		wg       sync.WaitGroup
+		respChan = make(chan *FinalizeBatchResp, 1)
+	)
+	t.finalizeBatch(&wg, respChan, nil)
+	t.assertFinalizeBatch(&wg, respChan, "batch key must be specified")
+
+	// Targeting an unknown batch should also fail.
+	t.finalizeBatch(&wg, respChan, &tapgarden.FinalizeParams{
+		BatchKey: test.RandPubKey(t),
+	})
+	t.assertFinalizeBatch(&wg, respChan, "no pending batch")
+
+	// We'll now finalize the second batch by its batch key and drive it to
+	// a successful minting, while the first batch remains pending.
+	t.finalizeBatch(&wg, respChan, &tapgarden.FinalizeParams{
+		BatchKey: secondBatchKey,
+	})
+
+	sendConfNtfn := t.progressCaretaker(false, nil, nil)
+	sendConfNtfn()
+
+	mintedBatch := t.assertFinalizeBatch(&wg, respChan, "")
+	require.True(t, mintedBatch.BatchKey.PubKey.IsEqual(secondBatchKey))
+
+	t.assertNoError()
+	t.assertNumCaretakersActive(0)
+	t.assertBatchState(secondBatchKey, tapgarden.BatchStateFinalized)
+
+	// The first batch should now be the only pending batch again.
+	pendingBatch, err = t.planter.PendingBatch()
+	require.NoError(t, err)
+	require.True(t, pendingBatch.BatchKey.PubKey.IsEqual(firstBatchKey))
+	t.assertBatchState(firstBatchKey, tapgarden.BatchStatePending)
+
+	// Finally, we'll cancel the first batch by its batch key.
+	cancelledKey, err := t.planter.CancelBatch(tapgarden.CancelParams{
+		BatchKey: firstBatchKey,
+	})
+	require.NoError(t, err)
+	require.True(t, cancelledKey.IsEqual(firstBatchKey))
+
+	t.assertNoPendingBatch()
+	t.assertBatchState(firstBatchKey, tapgarden.BatchStateSeedlingCancelled)
+}
+
 func testFinalizeWithTapscriptTree(t *mintingTestHarness) {
 	// First, create a new chain planter instance using the supplied test
 	// harness.
@@ -1762,6 +1882,42 @@ func testFundSealBeforeFinalize(t *mintingTestHarness) {
 	t.fundBatch(&wg, respChan, &fundReq)
 	t.assertFundBatch(&wg, respChan, "batch already funded")
 
+	// The same is true if the funded batch is targeted by its key.
+	fundedBatchKey := fundedEmptyBatch.BatchKey.PubKey
+	fundReq = tapgarden.FundParams{
+		BatchKey: fundedBatchKey,
+	}
+	t.fundBatch(&wg, respChan, &fundReq)
+	t.assertFundBatch(&wg, respChan, "batch already funded")
+
+	// A new batch can't be requested for a given batch key.
+	fundReq = tapgarden.FundParams{
+		BatchKey: fundedBatchKey,
+		NewBatch: true,
+	}
+	t.fundBatch(&wg, respChan, &fundReq)
+	t.assertFundBatch(&wg, respChan, "cannot specify a batch key")
+
+	// Only if a new batch is requested explicitly, a second batch is
+	// funded, which we cancel again right away.
+	fundReq = tapgarden.FundParams{
+		NewBatch: true,
+		FeeRate:  fn.Some(manualFee),
+	}
+	t.fundBatch(&wg, respChan, &fundReq)
+
+	t.assertKeyDerived()
+	t.assertGenesisTxFunded(&manualFee)
+	newBatch := t.assertFundBatch(&wg, respChan, "")
+	newBatchKey := newBatch.BatchKey.PubKey
+	require.False(t, newBatchKey.IsEqual(fundedBatchKey))
+
+	cancelledKey, err := t.planter.CancelBatch(tapgarden.CancelParams{
+		BatchKey: newBatchKey,
+	})
+	require.NoError(t, err)
+	require.True(t, cancelledKey.IsEqual(newBatchKey))
+
 	// Trying to finalize the batch with finalize parameters should also
 	// fail, as those parameters should have been provided during batch
 	// funding.
@@ -1780,7 +1936,8 @@ func testFundSealBeforeFinalize(t *mintingTestHarness) {
 
 	verboseBatches, err := t.planter.ListBatches(
 		tapgarden.ListBatchesParams{
-			Verbose: true,
+			BatchKey: fundedBatchKey,
+			Verbose:  true,
 		},
 	)
 	require.NoError(t, err)
@@ -1874,7 +2031,8 @@ func testFundSealBeforeFinalize(t *mintingTestHarness) {
 	sendConfNtfn()
 
 	t.assertNumCaretakersActive(0)
-	t.assertLastBatchState(1, tapgarden.BatchStateFinalized)
+	t.assertBatchState(fundedBatchKey, tapgarden.BatchStateFinalized)
+	t.assertNumBatchesWithState(1, tapgarden.BatchStateSeedlingCancelled)
 	t.assertMintOutputKey(mintedBatch, &defaultTapHash)
 }
 
@@ -2012,7 +2170,200 @@ type mintingStoreTestCase struct {
 	testFunc func(t *mintingTestHarness)
 }
 
+// blockWithTx creates a block that only contains the given transaction.
+func blockWithTx(tx *wire.MsgTx) *wire.MsgBlock {
+	merkleTree := blockchain.BuildMerkleTreeStore(
+		[]*btcutil.Tx{btcutil.NewTx(tx)}, false,
+	)
+	merkleRoot := merkleTree[len(merkleTree)-1]
+	blockHeader := wire.NewBlockHeader(
+		0, chaincfg.MainNetParams.GenesisHash, merkleRoot, 0, 0,
+	)
+
+	return &wire.MsgBlock{
+		Header:       *blockHeader,
+		Transactions: []*wire.MsgTx{tx},
+	}
+}
+
+// bumpBatchFee bumps the fee of the only broadcast batch so that it pays an
+// additional 10k sats and returns the replacement transaction.
+func (t *mintingTestHarness) bumpBatchFee(batchKey *btcec.PublicKey,
+	tx *wire.MsgTx) *wire.MsgTx {
+
+	batch := t.fetchSingleBatch(batchKey)
+	weight := lntypes.WeightUnit(
+		blockchain.GetTransactionWeight(btcutil.NewTx(tx)),
+	)
+	feeRate := chainfee.NewSatPerKWeight(
+		btcutil.Amount(batch.GenesisPacket.ChainFees+10_000), weight,
+	)
+
+	type bumpResp struct {
+		txid *chainhash.Hash
+		err  error
+	}
+	respChan := make(chan bumpResp, 1)
+	go func() {
+		txid, err := t.planter.BumpBatchFee(tapgarden.BumpFeeParams{
+			FeeRate: feeRate,
+		})
+		respChan <- bumpResp{txid, err}
+	}()
+
+	// The caretaker should sign and publish the replacement, then register
+	// for its confirmation.
+	_, err := fn.RecvOrTimeout(t.wallet.SignPsbtSignal, defaultTimeout)
+	require.NoError(t, err)
+	replacementTx := t.assertTxPublished()
+	_ = t.assertConfReqSent(replacementTx, nil)
+
+	resp, err := fn.RecvOrTimeout(respChan, defaultTimeout)
+	require.NoError(t, err)
+	require.NoError(t, resp.err)
+	require.Equal(t, replacementTx.TxHash(), *resp.txid)
+
+	return replacementTx
+}
+
+// testBumpBatchFee tests that the genesis transaction of a broadcast batch can
+// be replaced by one that pays a higher fee, and that the replacement is
+// resumed after a restart.
+func testBumpBatchFee(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	// Without any broadcast batch, there's nothing to bump.
+	_, err := t.planter.BumpBatchFee(tapgarden.BumpFeeParams{
+		FeeRate: chainfee.FeePerKwFloor,
+	})
+	require.ErrorIs(t, err, tapgarden.ErrNoUnconfirmedBatch)
+
+	// Create an initial batch of 5 seedlings and broadcast it.
+	const numSeedlings = 5
+	_ = t.queueInitialBatch(numSeedlings)
+	batch := t.finalizeBatchAssertFrozen(false)
+	batchKey := batch.BatchKey.PubKey
+
+	t.assertBatchProgressing()
+	t.assertGenesisPsbtFinalized(nil)
+	tx := t.assertTxPublished()
+	_ = t.assertConfReqSent(tx, nil)
+
+	// A fee rate lower than the current one is rejected by the caretaker.
+	_, err = t.planter.BumpBatchFee(tapgarden.BumpFeeParams{
+		BatchKey: batchKey,
+		FeeRate:  chainfee.FeePerKwFloor,
+	})
+	require.ErrorIs(t, err, tapsend.ErrFeeRateTooLow)
+
+	// We'll now bump the fee so that we pay an additional 10k sats.
+	batch = t.fetchSingleBatch(batchKey)
+	replacementTx := t.bumpBatchFee(batchKey, tx)
+
+	// The replacement spends the same inputs, so the genesis point doesn't
+	// change. Only the change output should pay for the additional fee.
+	changeIdx := batch.GenesisPacket.ChangeOutputIndex
+	require.Equal(t, tx.TxIn, replacementTx.TxIn[:len(tx.TxIn)])
+	require.Len(t, replacementTx.TxOut, len(tx.TxOut))
+	for idx := range tx.TxOut {
+		if idx == int(changeIdx) {
+			continue
+		}
+
+		require.Equal(t, tx.TxOut[idx], replacementTx.TxOut[idx])
+	}
+	require.Less(
+		t, replacementTx.TxOut[changeIdx].Value,
+		tx.TxOut[changeIdx].Value,
+	)
+
+	// The replacement should be stored on disk.
+	batch = t.fetchSingleBatch(batchKey)
+	require.Equal(
+		t, replacementTx.TxHash(),
+		batch.GenesisPacket.Pkt.UnsignedTx.TxHash(),
+	)
+	require.Greater(t, batch.GenesisPacket.ChainFees, int64(10_000))
+
+	// After a restart, the replacement should be published again.
+	t.refreshChainPlanter()
+	select {
+	case <-t.errChan:
+	default:
+	}
+
+	republishedTx := t.assertTxPublished()
+	require.Equal(t, replacementTx.TxHash(), republishedTx.TxHash())
+
+	// Once the replacement confirms, the batch should be finalized.
+	sendConfNtfn := t.assertConfReqSent(
+		republishedTx, blockWithTx(republishedTx),
+	)
+	sendConfNtfn()
+
+	t.assertNoError()
+	t.assertNumCaretakersActive(0)
+	t.assertBatchState(batchKey, tapgarden.BatchStateFinalized)
+}
+
+// testBumpBatchFeeOriginalConfirms tests that a batch is finalized with the
+// original genesis transaction if it confirms instead of its replacement.
+func testBumpBatchFeeOriginalConfirms(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	// Create an initial batch of 5 seedlings and broadcast it.
+	const numSeedlings = 5
+	_ = t.queueInitialBatch(numSeedlings)
+	batch := t.finalizeBatchAssertFrozen(false)
+	batchKey := batch.BatchKey.PubKey
+
+	t.assertBatchProgressing()
+	t.assertGenesisPsbtFinalized(nil)
+	tx := t.assertTxPublished()
+	_ = t.assertConfReqSent(tx, nil)
+
+	batch = t.fetchSingleBatch(batchKey)
+	originalFees := batch.GenesisPacket.ChainFees
+
+	// We bump the fee, which stores the replacement on disk.
+	replacementTx := t.bumpBatchFee(batchKey, tx)
+	batch = t.fetchSingleBatch(batchKey)
+	require.Equal(
+		t, replacementTx.TxHash(),
+		batch.GenesisPacket.Pkt.UnsignedTx.TxHash(),
+	)
+
+	// The replacement doesn't propagate and the original transaction
+	// confirms instead. The caretaker learns about it through the spend of
+	// the genesis point and waits for the original to confirm.
+	genesisPoint := tx.TxIn[0].PreviousOutPoint
+	require.Eventually(t, func() bool {
+		return t.chain.HasSpendReq(genesisPoint)
+	}, defaultTimeout, wait.PollInterval)
+	t.chain.SendSpendNtfn(tx, 0, 1)
+
+	sendConfNtfn := t.assertConfReqSent(tx, blockWithTx(tx))
+	sendConfNtfn()
+
+	t.assertNoError()
+	t.assertNumCaretakersActive(0)
+	t.assertBatchState(batchKey, tapgarden.BatchStateFinalized)
+
+	// The batch should be reverted to the original transaction, which is
+	// the one its assets are anchored in.
+	batch = t.fetchSingleBatch(batchKey)
+	require.Equal(
+		t, tx.TxHash(), batch.GenesisPacket.Pkt.UnsignedTx.TxHash(),
+	)
+	require.Equal(t, originalFees, batch.GenesisPacket.ChainFees)
+}
+
 // testCases houses the set of minting store test cases.
+
 var testCases = []mintingStoreTestCase{
 	{
 		name:     "basic_asset_creation",
@@ -2030,6 +2381,18 @@ var testCases = []mintingStoreTestCase{
 		name:     "finalize_batch",
 		testFunc: testFinalizeBatch,
 	},
+	{
+		name:     "multiple_pending_batches",
+		testFunc: testMultiplePendingBatches,
+	},
+	{
+		name:     "bump_batch_fee",
+		testFunc: testBumpBatchFee,
+	},
+	{
+		name:     "bump_batch_fee_original_confirms",
+		testFunc: testBumpBatchFeeOriginalConfirms,
+	},
 	{
 		name:     "finalize_with_tapscript_tree",
 		testFunc: testFinalizeWithTapscriptTree,
@@ -0,0 +1,887 @@
+package tapgarden
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+const (
+	// DefaultRecoveryKeyWindow is the default number of consecutive unused
+	// keys that are derived after the last used key before the recovery
+	// scan stops.
+	DefaultRecoveryKeyWindow = 2500
+)
+
+// RecoveryKeyStore is used to store the derivation information of the keys
+// that were found to be in use during a recovery scan.
+type RecoveryKeyStore interface {
+	// InsertInternalKey inserts an internal key into the database to make
+	// sure it is identified as a local key later on when importing proofs.
+	InsertInternalKey(ctx context.Context,
+		keyDesc keychain.KeyDescriptor) error
+
+	// InsertScriptKey inserts a script key into the database, so it can be
+	// recognized as belonging to the wallet.
+	InsertScriptKey(ctx context.Context, scriptKey asset.ScriptKey,
+		declaredKnown bool) error
+}
+
+// RecoveryWallet is used to import the anchor outputs of the recovered assets
+// into the backing wallet.
+type RecoveryWallet interface {
+	// ImportTaprootOutput imports a new public key into the wallet, as a
+	// P2TR output.
+	ImportTaprootOutput(context.Context, *btcec.PublicKey) (btcutil.Address,
+		error)
+}
+
+// RecovererConfig is the main config for the wallet recoverer.
+type RecovererConfig struct {
+	// Enabled indicates whether the recovery scan should be run when the
+	// recoverer is started.
+	Enabled bool
+
+	// KeyWindow is the number of consecutive unused keys that are derived
+	// after the last used key before the scan stops.
+	KeyWindow uint32
+
+	// KeyRing is used to re-derive the keys of the Taproot Assets key
+	// family.
+	KeyRing KeyRing
+
+	// UniverseServers returns the set of universe servers that should be
+	// scanned for proofs of owned assets.
+	UniverseServers func(context.Context) ([]universe.ServerAddr, error)
+
+	// NewDiffEngine returns a new diff engine for the given universe
+	// server, which is used to query the server's universe trees.
+	NewDiffEngine func(universe.ServerAddr) (universe.DiffEngine, error)
+
+	// KeyStore is used to store the derivation information of the keys
+	// that are found to be in use.
+	KeyStore RecoveryKeyStore
+
+	// Wallet is used to import the anchor outputs of the recovered assets,
+	// so the backing wallet can sign for them when they're spent.
+	Wallet RecoveryWallet
+
+	// ProofArchive is used to verify and store the recovered proofs.
+	ProofArchive proof.Archiver
+
+	// HeaderVerifier is used to verify the validity of the block header
+	// of the recovered proofs.
+	HeaderVerifier proof.HeaderVerifier
+
+	// GroupVerifier is used to verify the validity of the group key of
+	// the recovered proofs, if the group key isn't revealed by one of the
+	// recovered proof files.
+	GroupVerifier proof.GroupVerifier
+
+	// ChainLookupGen is used to look up chain information when verifying
+	// the recovered proofs.
+	ChainLookupGen proof.ChainLookupGenerator
+}
+
+// RecoveryResult is a summary of a completed recovery scan.
+type RecoveryResult struct {
+	// NumScriptKeys is the number of derived script keys that were found
+	// in the scanned universes.
+	NumScriptKeys int
+
+	// NumProofs is the number of proof files of unspent assets that were
+	// imported.
+	NumProofs int
+
+	// NumSpent is the number of owned assets that were found to be spent
+	// already and were therefore not imported.
+	NumSpent int
+
+	// LastKeyIndex is the highest key index that was found to be in use.
+	LastKeyIndex fn.Option[uint32]
+
+	// FailedServers is the set of universe servers that couldn't be
+	// scanned.
+	FailedServers []universe.ServerAddr
+
+	// Failed is the set of owned assets that were found, but couldn't be
+	// recovered.
+	Failed []RecoveryFailure
+}
+
+// RecoveryFailure describes an owned asset that was found during a recovery
+// scan, but couldn't be recovered.
+type RecoveryFailure struct {
+	// OutPoint is the anchor outpoint of the asset.
+	OutPoint wire.OutPoint
+
+	// Err is the reason the asset couldn't be recovered.
+	Err error
+}
+
+// recoveryLeaf is a universe leaf found during a recovery scan, together with
+// the server and universe it was found in.
+type recoveryLeaf struct {
+	server universe.ServerAddr
+
+	id universe.Identifier
+
+	key universe.LeafKey
+}
+
+// recoveryCandidate is an owned asset leaf, for which we fetched the last
+// proof from a universe server.
+type recoveryCandidate struct {
+	leaf recoveryLeaf
+
+	scriptKey asset.ScriptKey
+
+	locator proof.Locator
+
+	lastProof *proof.Proof
+}
+
+// Recoverer rediscovers the assets owned by the keys of the Taproot Assets
+// key family after the local database was lost. It derives the keys from the
+// backing wallet's seed, scans the universe servers for leaves that use one
+// of the derived script keys and imports the proofs of all unspent assets
+// that are found.
+type Recoverer struct {
+	cfg *RecovererConfig
+
+	*fn.ContextGuard
+
+	startOnce sync.Once
+
+	stopOnce sync.Once
+}
+
+// NewRecoverer creates a new wallet recoverer from the passed config.
+func NewRecoverer(cfg *RecovererConfig) *Recoverer {
+	return &Recoverer{
+		cfg: cfg,
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start launches the recovery scan in the background if recovery is enabled.
+func (r *Recoverer) Start() error {
+	r.startOnce.Do(func() {
+		if !r.cfg.Enabled {
+			return
+		}
+
+		log.Infof("Starting wallet recovery with a key window of %d",
+			r.cfg.KeyWindow)
+
+		r.Wg.Add(1)
+		go func() {
+			defer r.Wg.Done()
+
+			ctx, cancel := r.WithCtxQuitNoTimeout()
+			defer cancel()
+
+			result, err := r.Recover(ctx)
+			if err != nil {
+				// A failed recovery isn't critical for the
+				// operation of the daemon, so we just log the
+				// error. The scan can be restarted by
+				// restarting the daemon.
+				log.Errorf("Wallet recovery failed: %v", err)
+				return
+			}
+
+			log.Infof("Wallet recovery complete: found %d script "+
+				"keys, imported %d proofs, skipped %d spent "+
+				"assets", result.NumScriptKeys,
+				result.NumProofs, result.NumSpent)
+
+			for _, server := range result.FailedServers {
+				log.Warnf("Wallet recovery incomplete: unable "+
+					"to scan universe server %v",
+					server.HostStr())
+			}
+			for _, failure := range result.Failed {
+				log.Warnf("Wallet recovery incomplete: unable "+
+					"to recover asset at %v: %v",
+					failure.OutPoint, failure.Err)
+			}
+		}()
+	})
+
+	return nil
+}
+
+// Stop signals the recoverer to stop any running recovery scan.
+func (r *Recoverer) Stop() error {
+	r.stopOnce.Do(func() {
+		log.Infof("Stopping wallet recoverer")
+
+		close(r.Quit)
+		r.Wg.Wait()
+	})
+
+	return nil
+}
+
+// Recover runs a full recovery scan. All leaves of all universes known to the
+// configured universe servers are matched against the BIP-0086 script keys
+// derived from the Taproot Assets key family. The proofs of all matching
+// assets that haven't been spent yet are then fetched, verified and imported.
+//
+// NOTE: Only BIP-0086 script keys can be recovered, as assets locked to a
+// script key with a tapscript tree can't be found from the seed alone. An
+// asset is considered spent only if a leaf spending it is known to one of the
+// scanned universes, the chain itself isn't consulted. Servers and assets that
+// fail to be scanned or imported are reported in the result.
+func (r *Recoverer) Recover(ctx context.Context) (*RecoveryResult, error) {
+	servers, err := r.cfg.UniverseServers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch universe servers: %w",
+			err)
+	}
+
+	// We keep one diff engine per server open for the duration of the
+	// scan, as we need to query the servers again once we know which
+	// leaves are ours.
+	diffEngines := make(map[string]universe.DiffEngine, len(servers))
+	defer func() {
+		for _, diffEngine := range diffEngines {
+			_ = diffEngine.Close()
+		}
+	}()
+
+	var (
+		leavesByKey   = make(map[asset.SerializedKey][]recoveryLeaf)
+		universeIDs   = make(map[string][]recoveryLeaf)
+		failedServers []universe.ServerAddr
+	)
+	for _, server := range servers {
+		if _, ok := diffEngines[server.HostStr()]; ok {
+			continue
+		}
+
+		diffEngine, err := r.cfg.NewDiffEngine(server)
+		if err != nil {
+			log.Warnf("Unable to connect to universe server %v: "+
+				"%v", server.HostStr(), err)
+			failedServers = append(failedServers, server)
+			continue
+		}
+		diffEngines[server.HostStr()] = diffEngine
+
+		leaves, err := fetchAllUniverseLeaves(ctx, server, diffEngine)
+		if err != nil {
+			log.Warnf("Unable to scan universe server %v: %v",
+				server.HostStr(), err)
+			failedServers = append(failedServers, server)
+			continue
+		}
+
+		for _, leaf := range leaves {
+			if leaf.key.ScriptKey == nil {
+				continue
+			}
+
+			xOnlyKey, err := xOnlySerializedKey(
+				leaf.key.ScriptKey.PubKey,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			leavesByKey[xOnlyKey] = append(
+				leavesByKey[xOnlyKey], leaf,
+			)
+
+			idKey := leaf.server.HostStr() + leaf.id.String()
+			universeIDs[idKey] = append(universeIDs[idKey], leaf)
+		}
+	}
+
+	if len(diffEngines) == 0 {
+		return nil, fmt.Errorf("unable to connect to any universe " +
+			"server")
+	}
+
+	log.Infof("Scanned %d universe servers, matching %d unique script "+
+		"keys against derived keys", len(diffEngines),
+		len(leavesByKey))
+
+	// Now that we know all script keys used in the universes, we derive
+	// our keys until we reach a window of unused keys after the last used
+	// one.
+	var (
+		result      RecoveryResult
+		derivedKeys = make(
+			map[asset.SerializedKey]keychain.KeyDescriptor,
+		)
+		ownedLeaves  []recoveryLeaf
+		ownedScripts = make(map[asset.SerializedKey]asset.ScriptKey)
+		nextUnused   uint32
+	)
+	for index := uint32(0); index < nextUnused+r.cfg.KeyWindow; index++ {
+		keyDesc, err := r.cfg.KeyRing.DeriveKey(
+			ctx, keychain.KeyLocator{
+				Family: asset.TaprootAssetsKeyFamily,
+				Index:  index,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to derive key: %w", err)
+		}
+		derivedKeys[asset.ToSerialized(keyDesc.PubKey)] = keyDesc
+
+		scriptKey := asset.NewScriptKeyBip86(keyDesc)
+		xOnlyKey, err := xOnlySerializedKey(scriptKey.PubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		leaves, ok := leavesByKey[xOnlyKey]
+		if !ok {
+			continue
+		}
+
+		ownedLeaves = append(ownedLeaves, leaves...)
+		ownedScripts[xOnlyKey] = scriptKey
+		result.NumScriptKeys++
+		result.LastKeyIndex = fn.Some(index)
+		nextUnused = index + 1
+	}
+
+	// An asset is spent if any other leaf in the same universe spends it.
+	// So we collect the inputs of all leaves in the universes we own
+	// assets in.
+	spentIDs, err := r.spentPrevIDs(
+		ctx, diffEngines, universeIDs, ownedLeaves,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		candidates []*recoveryCandidate
+		seen       = make(map[[32]byte]struct{})
+	)
+	for _, leaf := range ownedLeaves {
+		candidate, err := r.fetchCandidate(
+			ctx, diffEngines[leaf.server.HostStr()], leaf,
+			ownedScripts,
+		)
+		if err != nil {
+			result.Failed = append(result.Failed, RecoveryFailure{
+				OutPoint: leaf.key.OutPoint,
+				Err:      err,
+			})
+			continue
+		}
+
+		// The same asset might be known to multiple servers, or be
+		// part of both the issuance and the transfer universe.
+		locatorHash, err := candidate.locator.Hash()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[locatorHash]; ok {
+			continue
+		}
+		seen[locatorHash] = struct{}{}
+
+		prevIDHash, err := xOnlyPrevIDHash(asset.PrevID{
+			OutPoint: *candidate.locator.OutPoint,
+			ID:       *candidate.locator.AssetID,
+			ScriptKey: asset.ToSerialized(
+				&candidate.locator.ScriptKey,
+			),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := spentIDs[prevIDHash]; ok {
+			result.NumSpent++
+			continue
+		}
+
+		// We don't import assets we already know about, which allows
+		// the recovery to be run more than once.
+		haveProof, err := r.cfg.ProofArchive.HasProof(
+			ctx, candidate.locator,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to check for existing "+
+				"proof: %w", err)
+		}
+		if haveProof {
+			continue
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	err = r.importCandidates(
+		ctx, diffEngines, candidates, derivedKeys, &result,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The backing wallet might have been recovered from the seed as well,
+	// in which case it would hand out keys we already used. So we advance
+	// the key family's index past the highest key index we found.
+	if err := r.advanceKeyIndex(ctx, result.LastKeyIndex); err != nil {
+		return nil, err
+	}
+
+	result.FailedServers = failedServers
+
+	return &result, nil
+}
+
+// fetchCandidate fetches the last proof of an owned universe leaf.
+func (r *Recoverer) fetchCandidate(ctx context.Context,
+	diffEngine universe.DiffEngine, leaf recoveryLeaf,
+	ownedScripts map[asset.SerializedKey]asset.ScriptKey) (
+	*recoveryCandidate, error) {
+
+	uniProofs, err := diffEngine.FetchProofLeaf(ctx, leaf.id, leaf.key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch proof for leaf %v: "+
+			"%w", leaf.key.OutPoint, err)
+	}
+	if len(uniProofs) == 0 {
+		return nil, fmt.Errorf("no proof found for leaf %v",
+			leaf.key.OutPoint)
+	}
+
+	lastProof, err := uniProofs[0].Leaf.RawProof.AsSingleProof()
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode proof: %w", err)
+	}
+
+	xOnlyKey, err := xOnlySerializedKey(lastProof.Asset.ScriptKey.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	scriptKey, ok := ownedScripts[xOnlyKey]
+	if !ok {
+		return nil, fmt.Errorf("proof for leaf %v has unexpected "+
+			"script key", leaf.key.OutPoint)
+	}
+
+	var groupKey *btcec.PublicKey
+	if lastProof.Asset.GroupKey != nil {
+		groupKey = &lastProof.Asset.GroupKey.GroupPubKey
+	}
+
+	return &recoveryCandidate{
+		leaf:      leaf,
+		scriptKey: scriptKey,
+		locator: proof.Locator{
+			AssetID:   fn.Ptr(lastProof.Asset.ID()),
+			GroupKey:  groupKey,
+			ScriptKey: *lastProof.Asset.ScriptKey.PubKey,
+			OutPoint:  fn.Ptr(lastProof.OutPoint()),
+		},
+		lastProof: lastProof,
+	}, nil
+}
+
+// spentPrevIDs returns the hashes of the previous IDs of all inputs that are
+// spent by any leaf of the universes the given owned leaves are part of.
+func (r *Recoverer) spentPrevIDs(ctx context.Context,
+	diffEngines map[string]universe.DiffEngine,
+	universeLeaves map[string][]recoveryLeaf,
+	ownedLeaves []recoveryLeaf) (map[[32]byte]struct{}, error) {
+
+	spent := make(map[[32]byte]struct{})
+
+	// Issuance proofs don't spend any assets, so we only need to look at
+	// the transfer universes.
+	scanned := make(map[string]struct{})
+	for _, owned := range ownedLeaves {
+		transferID := owned.id
+		transferID.ProofType = universe.ProofTypeTransfer
+
+		idKey := owned.server.HostStr() + transferID.String()
+		if _, ok := scanned[idKey]; ok {
+			continue
+		}
+		scanned[idKey] = struct{}{}
+
+		diffEngine := diffEngines[owned.server.HostStr()]
+		for _, leaf := range universeLeaves[idKey] {
+			uniProofs, err := diffEngine.FetchProofLeaf(
+				ctx, leaf.id, leaf.key,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to fetch "+
+					"proof for leaf %v: %w",
+					leaf.key.OutPoint, err)
+			}
+
+			for _, uniProof := range uniProofs {
+				prevIDs, err := proofPrevIDHashes(
+					uniProof.Leaf.RawProof,
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, prevIDHash := range prevIDs {
+					spent[prevIDHash] = struct{}{}
+				}
+			}
+		}
+	}
+
+	return spent, nil
+}
+
+// importCandidates fetches the full proof files of the given candidates,
+// stores the derivation information of their keys, imports their anchor
+// outputs into the backing wallet and imports the proofs. Candidates that
+// can't be recovered are added to the failures of the result.
+func (r *Recoverer) importCandidates(ctx context.Context,
+	diffEngines map[string]universe.DiffEngine,
+	candidates []*recoveryCandidate,
+	derivedKeys map[asset.SerializedKey]keychain.KeyDescriptor,
+	result *RecoveryResult) error {
+
+	addFailure := func(candidate *recoveryCandidate, err error) {
+		log.Warnf("Unable to recover asset at %v: %v",
+			candidate.locator.OutPoint, err)
+
+		result.Failed = append(result.Failed, RecoveryFailure{
+			OutPoint: *candidate.locator.OutPoint,
+			Err:      err,
+		})
+	}
+
+	var (
+		fetched         = make([]*recoveryCandidate, 0, len(candidates))
+		proofFiles      = make([]*proof.File, 0, len(candidates))
+		annotatedProofs = make(
+			[]*proof.AnnotatedProof, 0, len(candidates),
+		)
+	)
+	for _, candidate := range candidates {
+		diffEngine := diffEngines[candidate.leaf.server.HostStr()]
+		proofFile, err := proof.FetchProofProvenance(
+			ctx, nil, candidate.locator,
+			func(ctx context.Context,
+				loc proof.Locator) (proof.Blob, error) {
+
+				return fetchLocatorProof(ctx, diffEngine, loc)
+			},
+		)
+		if err != nil {
+			addFailure(candidate, fmt.Errorf("unable to fetch "+
+				"proof file: %w", err))
+			continue
+		}
+
+		var buf bytes.Buffer
+		if err := proofFile.Encode(&buf); err != nil {
+			return fmt.Errorf("unable to encode proof file: %w",
+				err)
+		}
+
+		fetched = append(fetched, candidate)
+		proofFiles = append(proofFiles, proofFile)
+		annotatedProofs = append(
+			annotatedProofs, &proof.AnnotatedProof{
+				Locator: candidate.locator,
+				Blob:    buf.Bytes(),
+			},
+		)
+	}
+
+	if len(annotatedProofs) == 0 {
+		return nil
+	}
+
+	// The group keys of the recovered assets aren't known to the local
+	// database yet, unless they're revealed by one of the recovered proof
+	// files.
+	groupVerifier, err := GenProofFileGroupVerifier(
+		proofFiles, r.cfg.GroupVerifier,
+	)
+	if err != nil {
+		return err
+	}
+
+	for idx, candidate := range fetched {
+		// The keys need to be known before the proofs are imported,
+		// otherwise they'd be stored without their derivation
+		// information.
+		err = r.cfg.KeyStore.InsertScriptKey(
+			ctx, candidate.scriptKey, true,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to insert script key: %w",
+				err)
+		}
+
+		internalKey := asset.ToSerialized(
+			candidate.lastProof.InclusionProof.InternalKey,
+		)
+		keyDesc, ok := derivedKeys[internalKey]
+		if ok {
+			err = r.cfg.KeyStore.InsertInternalKey(ctx, keyDesc)
+			if err != nil {
+				return fmt.Errorf("unable to insert internal "+
+					"key: %w", err)
+			}
+
+			result.LastKeyIndex = fn.Some(max(
+				result.LastKeyIndex.UnwrapOr(0), keyDesc.Index,
+			))
+		}
+
+		// The backing wallet needs to know about the anchor output to
+		// be able to spend it, which it won't if it was restored from
+		// the seed as well.
+		err = r.importAnchorOutput(ctx, candidate.lastProof)
+		if err != nil {
+			addFailure(candidate, err)
+			continue
+		}
+
+		err = r.cfg.ProofArchive.ImportProofs(
+			ctx, r.cfg.HeaderVerifier, proof.DefaultMerkleVerifier,
+			groupVerifier, r.cfg.ChainLookupGen, false,
+			annotatedProofs[idx],
+		)
+		if err != nil {
+			addFailure(candidate, fmt.Errorf("unable to import "+
+				"proof: %w", err))
+			continue
+		}
+
+		result.NumProofs++
+	}
+
+	return nil
+}
+
+// importAnchorOutput imports the Taproot output key of the anchor output of
+// the given proof into the backing wallet.
+func (r *Recoverer) importAnchorOutput(ctx context.Context,
+	lastProof *proof.Proof) error {
+
+	outputKey, err := proof.ExtractTaprootKey(
+		&lastProof.AnchorTx, lastProof.InclusionProof.OutputIndex,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to extract anchor output key: %w",
+			err)
+	}
+
+	_, err = r.cfg.Wallet.ImportTaprootOutput(ctx, outputKey)
+	switch {
+	case err == nil:
+
+	// The output might already be known to the wallet if the recovery is
+	// run more than once.
+	case errors.Is(err, tapsend.ErrOutputAlreadyImported):
+
+	default:
+		return fmt.Errorf("unable to import anchor output: %w", err)
+	}
+
+	return nil
+}
+
+// advanceKeyIndex derives new keys from the Taproot Assets key family until
+// the backing wallet's key index is past the given last used index.
+func (r *Recoverer) advanceKeyIndex(ctx context.Context,
+	lastUsed fn.Option[uint32]) error {
+
+	if lastUsed.IsNone() {
+		return nil
+	}
+
+	lastIndex := lastUsed.UnwrapOr(0)
+	for {
+		keyDesc, err := r.cfg.KeyRing.DeriveNextKey(
+			ctx, asset.TaprootAssetsKeyFamily,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to derive next key: %w", err)
+		}
+
+		if keyDesc.Index > lastIndex {
+			return nil
+		}
+	}
+}
+
+// fetchLocatorProof fetches the single proof identified by the given locator
+// from a universe server.
+func fetchLocatorProof(ctx context.Context, diffEngine universe.DiffEngine,
+	loc proof.Locator) (proof.Blob, error) {
+
+	if loc.AssetID == nil || loc.OutPoint == nil {
+		return nil, fmt.Errorf("proof locator is missing asset ID or " +
+			"outpoint")
+	}
+
+	// The proof might either be in the issuance or the transfer universe,
+	// so we let the server decide by not specifying a proof type.
+	uniID := universe.Identifier{
+		AssetID:   *loc.AssetID,
+		GroupKey:  loc.GroupKey,
+		ProofType: universe.ProofTypeUnspecified,
+	}
+	leafKey := universe.LeafKey{
+		OutPoint:  *loc.OutPoint,
+		ScriptKey: fn.Ptr(asset.NewScriptKey(&loc.ScriptKey)),
+	}
+
+	uniProofs, err := diffEngine.FetchProofLeaf(ctx, uniID, leafKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(uniProofs) == 0 {
+		return nil, fmt.Errorf("no proof found for %v",
+			loc.OutPoint)
+	}
+
+	return uniProofs[0].Leaf.RawProof, nil
+}
+
+// fetchAllUniverseLeaves fetches the leaf keys of all universes known to the
+// given universe server.
+func fetchAllUniverseLeaves(ctx context.Context, server universe.ServerAddr,
+	diffEngine universe.DiffEngine) ([]recoveryLeaf, error) {
+
+	var roots []universe.Root
+	for offset := int32(0); ; offset += universe.MaxPageSize {
+		page, err := diffEngine.RootNodes(ctx, universe.RootNodesQuery{
+			SortDirection: universe.SortAscending,
+			Offset:        offset,
+			Limit:         universe.MaxPageSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch roots: %w", err)
+		}
+
+		roots = append(roots, page...)
+		if len(page) < universe.MaxPageSize {
+			break
+		}
+	}
+
+	var leaves []recoveryLeaf
+	for _, root := range roots {
+		for offset := int32(0); ; offset += universe.MaxPageSize {
+			keys, err := diffEngine.UniverseLeafKeys(
+				ctx, universe.UniverseLeafKeysQuery{
+					Id:            root.ID,
+					SortDirection: universe.SortAscending,
+					Offset:        offset,
+					Limit:         universe.MaxPageSize,
+				},
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to fetch leaf "+
+					"keys: %w", err)
+			}
+
+			for _, key := range keys {
+				leaves = append(leaves, recoveryLeaf{
+					server: server,
+					id:     root.ID,
+					key:    key,
+				})
+			}
+
+			if len(keys) < universe.MaxPageSize {
+				break
+			}
+		}
+	}
+
+	return leaves, nil
+}
+
+// xOnlySerializedKey returns the serialized form of the even-parity version of
+// the given key. Universe servers might return script keys in their x-only
+// form, so we always compare keys in that form.
+func xOnlySerializedKey(key *btcec.PublicKey) (asset.SerializedKey, error) {
+	if key == nil {
+		return asset.SerializedKey{}, fmt.Errorf("missing key")
+	}
+
+	evenKey, err := schnorr.ParsePubKey(schnorr.SerializePubKey(key))
+	if err != nil {
+		return asset.SerializedKey{}, err
+	}
+
+	return asset.ToSerialized(evenKey), nil
+}
+
+// proofPrevIDHashes returns the hashes of the previous IDs of all inputs spent
+// by the asset of the given single proof.
+func proofPrevIDHashes(rawProof proof.Blob) ([][32]byte, error) {
+	var proofAsset asset.Asset
+	err := proof.SparseDecode(
+		bytes.NewReader(rawProof), proof.AssetLeafRecord(&proofAsset),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode proof: %w", err)
+	}
+
+	var prevIDs [][32]byte
+	for _, witness := range proofAsset.PrevWitnesses {
+		// Genesis assets don't spend any previous asset.
+		prevID := witness.PrevID
+		if prevID == nil || *prevID == asset.ZeroPrevID {
+			continue
+		}
+
+		prevIDHash, err := xOnlyPrevIDHash(*prevID)
+		if err != nil {
+			return nil, err
+		}
+		prevIDs = append(prevIDs, prevIDHash)
+	}
+
+	return prevIDs, nil
+}
+
+// xOnlyPrevIDHash returns the hash of the given previous ID with its script key
+// converted to the even-parity form. Proofs only carry the x-only script key of
+// an asset, while the previous IDs of the inputs spending it carry the full
+// key.
+func xOnlyPrevIDHash(prevID asset.PrevID) ([32]byte, error) {
+	scriptKey, err := btcec.ParsePubKey(prevID.ScriptKey[:])
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("unable to parse script key: %w",
+			err)
+	}
+
+	prevID.ScriptKey, err = xOnlySerializedKey(scriptKey)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return prevID.Hash(), nil
+}
@@ -0,0 +1,385 @@
+package tapgarden
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// recoveryKeyRing is a key ring that derives the same keys for the same key
+// locators, just like a wallet restored from a seed would.
+type recoveryKeyRing struct {
+	nextIndex uint32
+}
+
+func (r *recoveryKeyRing) privKey(index uint32) *btcec.PrivateKey {
+	var indexBytes [4]byte
+	binary.BigEndian.PutUint32(indexBytes[:], index)
+	keyBytes := sha256.Sum256(indexBytes[:])
+
+	privKey, _ := btcec.PrivKeyFromBytes(keyBytes[:])
+	return privKey
+}
+
+func (r *recoveryKeyRing) DeriveNextKey(ctx context.Context,
+	keyFam keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	defer func() {
+		r.nextIndex++
+	}()
+
+	return r.DeriveKey(ctx, keychain.KeyLocator{
+		Family: keyFam,
+		Index:  r.nextIndex,
+	})
+}
+
+func (r *recoveryKeyRing) DeriveKey(_ context.Context,
+	loc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	return keychain.KeyDescriptor{
+		KeyLocator: loc,
+		PubKey:     r.privKey(loc.Index).PubKey(),
+	}, nil
+}
+
+func (r *recoveryKeyRing) IsLocalKey(context.Context,
+	keychain.KeyDescriptor) bool {
+
+	return true
+}
+
+// recoveryKeyStore records the keys inserted during a recovery scan.
+type recoveryKeyStore struct {
+	internalKeys []keychain.KeyDescriptor
+	scriptKeys   []asset.ScriptKey
+}
+
+func (r *recoveryKeyStore) InsertInternalKey(_ context.Context,
+	keyDesc keychain.KeyDescriptor) error {
+
+	r.internalKeys = append(r.internalKeys, keyDesc)
+	return nil
+}
+
+func (r *recoveryKeyStore) InsertScriptKey(_ context.Context,
+	scriptKey asset.ScriptKey, _ bool) error {
+
+	r.scriptKeys = append(r.scriptKeys, scriptKey)
+	return nil
+}
+
+// recoveryWallet records the anchor outputs imported during a recovery scan.
+type recoveryWallet struct {
+	outputKeys []*btcec.PublicKey
+}
+
+func (r *recoveryWallet) ImportTaprootOutput(_ context.Context,
+	key *btcec.PublicKey) (btcutil.Address, error) {
+
+	r.outputKeys = append(r.outputKeys, key)
+
+	return btcutil.NewAddressTaproot(
+		schnorr.SerializePubKey(key), &chaincfg.RegressionNetParams,
+	)
+}
+
+// recoveryDiffEngine is a diff engine that serves a static set of universes.
+type recoveryDiffEngine struct {
+	roots  []universe.Root
+	leaves map[string][]universe.LeafKey
+	proofs map[[32]byte]proof.Blob
+}
+
+func newRecoveryDiffEngine() *recoveryDiffEngine {
+	return &recoveryDiffEngine{
+		leaves: make(map[string][]universe.LeafKey),
+		proofs: make(map[[32]byte]proof.Blob),
+	}
+}
+
+// addProof adds the given proof as a leaf of the given universe.
+func (r *recoveryDiffEngine) addProof(t *testing.T, id universe.Identifier,
+	p *proof.Proof) {
+
+	idStr := id.String()
+	if _, ok := r.leaves[idStr]; !ok {
+		r.roots = append(r.roots, universe.Root{ID: id})
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, p.Encode(&buf))
+
+	leafKey := universe.LeafKey{
+		OutPoint:  p.OutPoint(),
+		ScriptKey: &p.Asset.ScriptKey,
+	}
+	r.leaves[idStr] = append(r.leaves[idStr], leafKey)
+	r.proofs[leafKey.UniverseKey()] = buf.Bytes()
+}
+
+func (r *recoveryDiffEngine) RootNode(context.Context,
+	universe.Identifier) (universe.Root, error) {
+
+	return universe.Root{}, nil
+}
+
+func (r *recoveryDiffEngine) RootNodes(_ context.Context,
+	q universe.RootNodesQuery) ([]universe.Root, error) {
+
+	if int(q.Offset) >= len(r.roots) {
+		return nil, nil
+	}
+
+	return r.roots[q.Offset:], nil
+}
+
+func (r *recoveryDiffEngine) UniverseLeafKeys(_ context.Context,
+	q universe.UniverseLeafKeysQuery) ([]universe.LeafKey, error) {
+
+	leaves := r.leaves[q.Id.String()]
+	if int(q.Offset) >= len(leaves) {
+		return nil, nil
+	}
+
+	return leaves[q.Offset:], nil
+}
+
+func (r *recoveryDiffEngine) FetchProofLeaf(_ context.Context,
+	_ universe.Identifier, key universe.LeafKey) ([]*universe.Proof,
+	error) {
+
+	blob, ok := r.proofs[key.UniverseKey()]
+	if !ok {
+		return nil, universe.ErrNoUniverseProofFound
+	}
+
+	return []*universe.Proof{{
+		LeafKey: key,
+		Leaf: &universe.Leaf{
+			RawProof: blob,
+		},
+	}}, nil
+}
+
+func (r *recoveryDiffEngine) Close() error {
+	return nil
+}
+
+// TestRecoverer tests that the recoverer finds the unspent assets owned by the
+// derived script keys and imports their proofs.
+func TestRecoverer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	keyRing := &recoveryKeyRing{}
+	bip86Key := func(index uint32) asset.ScriptKey {
+		keyDesc, err := keyRing.DeriveKey(ctx, keychain.KeyLocator{
+			Family: asset.TaprootAssetsKeyFamily,
+			Index:  index,
+		})
+		require.NoError(t, err)
+
+		return asset.NewScriptKeyBip86(keyDesc)
+	}
+
+	anchorTx := wire.NewMsgTx(2)
+	for i := 0; i < 5; i++ {
+		pkScript, err := tapscript.PayToTaprootScript(
+			test.RandPubKey(t),
+		)
+		require.NoError(t, err)
+
+		anchorTx.AddTxOut(&wire.TxOut{
+			PkScript: pkScript,
+			Value:    1000,
+		})
+	}
+	block := wire.MsgBlock{
+		Transactions: []*wire.MsgTx{anchorTx},
+	}
+
+	genesis := asset.RandGenesis(t, asset.Normal)
+	newProof := func(scriptKey asset.ScriptKey, outputIndex uint32,
+		prevID *asset.PrevID) *proof.Proof {
+
+		p := proof.RandProof(
+			t, genesis, scriptKey.PubKey, block, 0, outputIndex,
+		)
+		if prevID != nil {
+			p.Asset.PrevWitnesses = []asset.Witness{{
+				PrevID:    prevID,
+				TxWitness: wire.TxWitness{{0x01}},
+			}}
+		}
+
+		return &p
+	}
+	prevIDOf := func(p *proof.Proof,
+		scriptKey asset.ScriptKey) *asset.PrevID {
+
+		return &asset.PrevID{
+			OutPoint:  p.OutPoint(),
+			ID:        p.Asset.ID(),
+			ScriptKey: asset.ToSerialized(scriptKey.PubKey),
+		}
+	}
+
+	// We own an unspent genesis asset, which is anchored to an output with
+	// one of our internal keys.
+	ourKey0 := bip86Key(0)
+	ownedGenesis := newProof(ourKey0, 0, nil)
+	ownedGenesis.InclusionProof.InternalKey = ourKey0.RawKey.PubKey
+
+	// We also own a genesis asset that was sent to a foreign script key
+	// already.
+	ourKey3 := bip86Key(3)
+	spentGenesis := newProof(ourKey3, 1, nil)
+	spendingTransfer := newProof(
+		asset.NewScriptKey(test.RandPubKey(t)), 2,
+		prevIDOf(spentGenesis, ourKey3),
+	)
+
+	// And finally, we received an asset that was issued to someone else.
+	foreignKey := asset.NewScriptKey(test.RandPubKey(t))
+	foreignGenesis := newProof(foreignKey, 3, nil)
+	ourKey1 := bip86Key(1)
+	receivedTransfer := newProof(
+		ourKey1, 4, prevIDOf(foreignGenesis, foreignKey),
+	)
+
+	issuanceID := universe.Identifier{
+		AssetID:   genesis.ID(),
+		ProofType: universe.ProofTypeIssuance,
+	}
+	transferID := universe.Identifier{
+		AssetID:   genesis.ID(),
+		ProofType: universe.ProofTypeTransfer,
+	}
+
+	diffEngine := newRecoveryDiffEngine()
+	diffEngine.addProof(t, issuanceID, ownedGenesis)
+	diffEngine.addProof(t, issuanceID, spentGenesis)
+	diffEngine.addProof(t, issuanceID, foreignGenesis)
+	diffEngine.addProof(t, transferID, spendingTransfer)
+	diffEngine.addProof(t, transferID, receivedTransfer)
+
+	server := universe.NewServerAddrFromStr("localhost:10029")
+	keyStore := &recoveryKeyStore{}
+	wallet := &recoveryWallet{}
+	proofArchive := proof.NewMockProofArchive()
+	recoverer := NewRecoverer(&RecovererConfig{
+		Enabled:   true,
+		KeyWindow: 2,
+		KeyRing:   keyRing,
+		UniverseServers: func(context.Context) ([]universe.ServerAddr,
+			error) {
+
+			return []universe.ServerAddr{server}, nil
+		},
+		NewDiffEngine: func(universe.ServerAddr) (universe.DiffEngine,
+			error) {
+
+			return diffEngine, nil
+		},
+		KeyStore:       keyStore,
+		Wallet:         wallet,
+		ProofArchive:   proofArchive,
+		HeaderVerifier: proof.MockHeaderVerifier,
+		GroupVerifier:  proof.MockGroupVerifier,
+		ChainLookupGen: proof.MockChainLookup,
+	})
+
+	// The keys at index 0, 1 and 3 are in use. Index 3 is still found,
+	// because it's within the key window after index 1.
+	result, err := recoverer.Recover(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, result.NumScriptKeys)
+	require.Equal(t, 1, result.NumSpent)
+	require.Equal(t, 2, result.NumProofs)
+	require.Equal(t, fn.Some[uint32](3), result.LastKeyIndex)
+	require.Empty(t, result.FailedServers)
+	require.Empty(t, result.Failed)
+
+	// Only the keys of the imported assets are stored.
+	require.Len(t, keyStore.scriptKeys, 2)
+	require.Len(t, keyStore.internalKeys, 1)
+	require.Equal(
+		t, ourKey0.RawKey.PubKey, keyStore.internalKeys[0].PubKey,
+	)
+
+	// The proofs of both unspent assets were imported, the received asset
+	// with its full provenance. Their anchor outputs were imported into
+	// the wallet.
+	importedFiles := []struct {
+		lastProof *proof.Proof
+		numProofs uint32
+	}{
+		{lastProof: ownedGenesis, numProofs: 1},
+		{lastProof: receivedTransfer, numProofs: 2},
+	}
+	require.Len(t, wallet.outputKeys, len(importedFiles))
+	for idx, imported := range importedFiles {
+		p := imported.lastProof
+		outputKey, err := proof.ExtractTaprootKey(
+			&p.AnchorTx, p.InclusionProof.OutputIndex,
+		)
+		require.NoError(t, err)
+		require.True(t, outputKey.IsEqual(wallet.outputKeys[idx]))
+
+		blob, err := proofArchive.FetchProof(ctx, proof.Locator{
+			AssetID:   fn.Ptr(p.Asset.ID()),
+			GroupKey:  &p.Asset.GroupKey.GroupPubKey,
+			ScriptKey: *p.Asset.ScriptKey.PubKey,
+			OutPoint:  fn.Ptr(p.OutPoint()),
+		})
+		require.NoError(t, err)
+
+		file, err := blob.AsFile()
+		require.NoError(t, err)
+		require.EqualValues(t, imported.numProofs, file.NumProofs())
+	}
+
+	// The key index of the wallet was advanced past the last used key.
+	require.Greater(t, keyRing.nextIndex, uint32(3))
+
+	// Running the recovery again doesn't import anything new.
+	result, err = recoverer.Recover(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, result.NumScriptKeys)
+	require.Equal(t, 1, result.NumSpent)
+	require.Zero(t, result.NumProofs)
+
+	// An asset whose provenance can't be fetched is reported as failed,
+	// instead of failing the whole recovery.
+	missingGenesis := newProof(foreignKey, 1, nil)
+	ourKey2 := bip86Key(2)
+	brokenTransfer := newProof(
+		ourKey2, 2, prevIDOf(missingGenesis, foreignKey),
+	)
+	diffEngine.addProof(t, transferID, brokenTransfer)
+
+	result, err = recoverer.Recover(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 4, result.NumScriptKeys)
+	require.Zero(t, result.NumProofs)
+	require.Len(t, result.Failed, 1)
+	require.Equal(t, brokenTransfer.OutPoint(), result.Failed[0].OutPoint)
+	require.Error(t, result.Failed[0].Err)
+}
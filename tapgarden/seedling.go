@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/proof"
@@ -114,6 +115,15 @@ type Seedling struct {
 	// a Schnorr signature for reissuing assets. A group key with an empty
 	// Tapscript root can only authorize reissuance with a signature.
 	GroupTapscriptRoot []byte
+
+	// BatchKey is the optional key of the pending batch the seedling
+	// should be added to. If this isn't set, the seedling is added to the
+	// only pending batch, or a new batch if there is none.
+	BatchKey *btcec.PublicKey
+
+	// NewBatch if true, then the seedling is added to a new pending batch,
+	// even if other batches are already pending.
+	NewBatch bool
 }
 
 // validateFields attempts to validate the set of input fields for the passed
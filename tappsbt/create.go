@@ -13,6 +13,21 @@ import (
 	"github.com/lightningnetwork/lnd/keychain"
 )
 
+// packetVersion returns the virtual packet version that must be used to pay
+// to an address of the given version.
+func packetVersion(addrVersion address.Version) (VPacketVersion, error) {
+	switch addrVersion {
+	case address.V0:
+		return V0, nil
+
+	case address.V1, address.V2:
+		return V1, nil
+
+	default:
+		return 0, address.ErrUnknownVersion
+	}
+}
+
 // FromAddresses creates an empty virtual transaction packet from the given
 // addresses. Because sending to an address is always non-interactive, a change
 // output is also added to the packet.
@@ -36,14 +51,11 @@ func FromAddresses(receiverAddrs []*address.Tap,
 		ChainParams: firstAddr.ChainParams,
 	}
 
-	switch firstAddr.Version {
-	case address.V0:
-		pkt.Version = V0
-	case address.V1:
-		pkt.Version = V1
-	default:
-		return nil, address.ErrUnknownVersion
+	version, err := packetVersion(firstAddr.Version)
+	if err != nil {
+		return nil, err
 	}
+	pkt.Version = version
 
 	// If we are sending the full value of the input asset, or sending a
 	// collectible, we will need to create a split with un-spendable change.
@@ -64,10 +76,21 @@ func FromAddresses(receiverAddrs []*address.Tap,
 	// index, but start at the first one indicated by the caller.
 	for idx := range receiverAddrs {
 		addr := receiverAddrs[idx]
-		if addr.Version != firstAddr.Version {
+		addrPktVersion, err := packetVersion(addr.Version)
+		if err != nil {
+			return nil, err
+		}
+		if addrPktVersion != pkt.Version {
 			return nil, fmt.Errorf("mixed address versions")
 		}
 
+		// Reusable addresses don't need to specify an amount, so the
+		// sender needs to set one before the address can be paid.
+		if addr.Amount == 0 {
+			return nil, fmt.Errorf("address for asset %v has no "+
+				"amount specified", addr.AssetID)
+		}
+
 		pkt.Outputs = append(pkt.Outputs, &VOutput{
 			AssetVersion:      addr.AssetVersion,
 			Amount:            addr.Amount,
@@ -9,6 +9,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/txscript"
@@ -17,6 +18,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/tlv"
 )
 
@@ -179,6 +181,9 @@ func (i *VInput) decode(pIn psbt.PInput) error {
 	}, {
 		key:     PsbtKeyTypeInputTapAssetProof,
 		decoder: proofDecoder(&i.Proof),
+	}, {
+		key:     PsbtKeyTypeInputTapMuSig2,
+		decoder: muSig2Decoder(&i.MuSig2),
 	}}
 
 	for idx := range mapping {
@@ -309,6 +314,12 @@ func (o *VOutput) decode(pOut psbt.POutput, txOut *wire.TxOut) error {
 			key:     PsbtKeyTypeOutputTapAssetRelativeLockTime,
 			decoder: tlvDecoder(&o.RelativeLockTime, tlv.DUint64),
 		},
+		{
+			key: PsbtKeyTypeOutputTapAssetScriptVersion,
+			decoder: tlvDecoder(
+				&o.ScriptVersion, asset.ScriptVersionDecoder,
+			),
+		},
 	}
 
 	for idx := range mapping {
@@ -517,3 +528,58 @@ func urlDecoder(u **url.URL) decoderFunc {
 		return tlvDecoder(*u, address.UrlDecoder)(key, byteVal)
 	}
 }
+
+// muSig2Decoder returns a decoder function that decodes the MuSig2 signing
+// state of an input, as encoded by muSig2Encoder.
+func muSig2Decoder(m **MuSig2Input) decoderFunc {
+	const recordSize = btcec.PubKeyBytesLenCompressed +
+		musig2.PubNonceSize + input.MuSig2PartialSigSize
+
+	return func(key, byteVal []byte) error {
+		if len(byteVal)%recordSize != 0 {
+			return fmt.Errorf("invalid MuSig2 input length %d",
+				len(byteVal))
+		}
+
+		var (
+			zeroNonce   [musig2.PubNonceSize]byte
+			zeroSig     [input.MuSig2PartialSigSize]byte
+			muSig2Input = NewMuSig2Input(nil)
+		)
+		for len(byteVal) > 0 {
+			record := byteVal[:recordSize]
+			byteVal = byteVal[recordSize:]
+
+			signerKey, err := btcec.ParsePubKey(
+				record[:btcec.PubKeyBytesLenCompressed],
+			)
+			if err != nil {
+				return fmt.Errorf("invalid MuSig2 signer key: "+
+					"%w", err)
+			}
+			muSig2Input.SignerKeys = append(
+				muSig2Input.SignerKeys, signerKey,
+			)
+
+			var (
+				serializedKey = asset.ToSerialized(signerKey)
+				nonce         [musig2.PubNonceSize]byte
+				sig           [input.MuSig2PartialSigSize]byte
+			)
+			record = record[btcec.PubKeyBytesLenCompressed:]
+			copy(nonce[:], record[:musig2.PubNonceSize])
+			copy(sig[:], record[musig2.PubNonceSize:])
+
+			if nonce != zeroNonce {
+				muSig2Input.PubNonces[serializedKey] = nonce
+			}
+			if sig != zeroSig {
+				muSig2Input.PartialSigs[serializedKey] = sig
+			}
+		}
+
+		*m = muSig2Input
+
+		return nil
+	}
+}
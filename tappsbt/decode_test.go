@@ -10,12 +10,16 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightningnetwork/lnd/input"
 	"github.com/stretchr/testify/require"
 )
 
@@ -186,6 +190,97 @@ func TestEncodingDecoding(t *testing.T) {
 	test.WriteTestVectors(t, generatedTestVectorName, testVectors)
 }
 
+// TestMuSig2InputEncoding tests that the MuSig2 signing state of a virtual
+// input survives an encoding round trip, including signers that haven't added
+// their nonce or partial signature yet.
+func TestMuSig2InputEncoding(t *testing.T) {
+	t.Parallel()
+
+	signerKeys := []*btcec.PublicKey{
+		test.RandPubKey(t), test.RandPubKey(t), test.RandPubKey(t),
+	}
+	muSig2Input := NewMuSig2Input(signerKeys)
+
+	var (
+		nonce [musig2.PubNonceSize]byte
+		sig   [input.MuSig2PartialSigSize]byte
+	)
+	copy(nonce[:], test.RandBytes(musig2.PubNonceSize))
+	copy(sig[:], test.RandBytes(input.MuSig2PartialSigSize))
+
+	firstKey := asset.ToSerialized(signerKeys[0])
+	secondKey := asset.ToSerialized(signerKeys[1])
+	muSig2Input.PubNonces[firstKey] = nonce
+	muSig2Input.PubNonces[secondKey] = nonce
+	muSig2Input.PartialSigs[firstKey] = sig
+
+	pkg := RandPacket(t, true)
+	pkg.Inputs[0].MuSig2 = muSig2Input
+
+	var buf bytes.Buffer
+	require.NoError(t, pkg.Serialize(&buf))
+
+	decoded, err := NewFromRawBytes(&buf, false)
+	require.NoError(t, err)
+
+	decodedMuSig2 := decoded.Inputs[0].MuSig2
+	require.NotNil(t, decodedMuSig2)
+	require.Nil(t, decoded.Inputs[1].MuSig2)
+
+	require.Len(t, decodedMuSig2.SignerKeys, len(signerKeys))
+	for idx := range signerKeys {
+		decodedKey := decodedMuSig2.SignerKeys[idx]
+		require.True(t, signerKeys[idx].IsEqual(decodedKey))
+	}
+	require.Equal(t, muSig2Input.PubNonces, decodedMuSig2.PubNonces)
+	require.Equal(t, muSig2Input.PartialSigs, decodedMuSig2.PartialSigs)
+
+	require.True(t, decodedMuSig2.IsSigner(signerKeys[2]))
+	require.False(t, decodedMuSig2.IsSigner(test.RandPubKey(t)))
+	require.False(t, decodedMuSig2.HaveAllNonces())
+	require.False(t, decodedMuSig2.HaveAllPartialSigs())
+
+	// A value that isn't a multiple of the record size is rejected.
+	err = muSig2Decoder(&decodedMuSig2)(
+		PsbtKeyTypeInputTapMuSig2, test.RandBytes(42),
+	)
+	require.ErrorContains(t, err, "invalid MuSig2 input length")
+}
+
+// TestOutputScriptVersionEncoding tests that the script version of a virtual
+// output survives an encoding round trip, while the default script version
+// isn't encoded at all.
+func TestOutputScriptVersionEncoding(t *testing.T) {
+	t.Parallel()
+
+	pkg := RandPacket(t, true)
+	pkg.Outputs[0].ScriptVersion = asset.ScriptV1
+
+	var buf bytes.Buffer
+	require.NoError(t, pkg.Serialize(&buf))
+
+	decoded, err := NewFromRawBytes(&buf, false)
+	require.NoError(t, err)
+	require.Equal(t, asset.ScriptV1, decoded.Outputs[0].ScriptVersion)
+	require.Equal(t, asset.ScriptV0, decoded.Outputs[1].ScriptVersion)
+
+	packet, err := pkg.EncodeAsPsbt()
+	require.NoError(t, err)
+
+	key := PsbtKeyTypeOutputTapAssetScriptVersion
+	hasScriptVersion := func(unknowns []*psbt.Unknown) bool {
+		for _, unknown := range unknowns {
+			if bytes.Equal(unknown.Key, key) {
+				return true
+			}
+		}
+
+		return false
+	}
+	require.True(t, hasScriptVersion(packet.Outputs[0].Unknowns))
+	require.False(t, hasScriptVersion(packet.Outputs[1].Unknowns))
+}
+
 // TestBIPTestVectors tests that the BIP test vectors are passing.
 func TestBIPTestVectors(t *testing.T) {
 	t.Parallel()
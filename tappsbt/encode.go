@@ -10,6 +10,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
@@ -18,6 +19,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/tlv"
 )
 
@@ -186,6 +188,10 @@ func (i *VInput) encode() (psbt.PInput, error) {
 			key:     PsbtKeyTypeInputTapAssetProof,
 			encoder: proofEncoder(i.Proof),
 		},
+		{
+			key:     PsbtKeyTypeInputTapMuSig2,
+			encoder: muSig2Encoder(i.MuSig2),
+		},
 	}
 
 	for idx := range mapping {
@@ -301,6 +307,10 @@ func (o *VOutput) encode(coinType uint32) (psbt.POutput, *wire.TxOut, error) {
 			key:     PsbtKeyTypeOutputTapAssetRelativeLockTime,
 			encoder: tlvEncoder(&o.RelativeLockTime, tlv.EUint64),
 		},
+		{
+			key:     PsbtKeyTypeOutputTapAssetScriptVersion,
+			encoder: scriptVersionEncoder(o.ScriptVersion),
+		},
 	}
 
 	for idx := range mapping {
@@ -500,6 +510,19 @@ func vOutputAssetVersionEncoder(w io.Writer, val any, buf *[8]byte) error {
 	return tlv.NewTypeForEncodingErr(val, "VOutputAssetVersion")
 }
 
+// scriptVersionEncoder returns a function that encodes the given script
+// version as a custom PSBT field. The default ScriptV0 isn't encoded, so
+// packets that don't use any other script version encode as before.
+func scriptVersionEncoder(val asset.ScriptVersion) encoderFunc {
+	return func(key []byte) ([]*customPsbtField, error) {
+		if val == asset.ScriptV0 {
+			return nil, nil
+		}
+
+		return tlvEncoder(&val, asset.ScriptVersionEncoder)(key)
+	}
+}
+
 // urlEncoder returns a function that encodes the given URL as a custom PSBT
 // field.
 func urlEncoder(val *url.URL) encoderFunc {
@@ -525,3 +548,42 @@ func urlEncoder(val *url.URL) encoderFunc {
 		}, nil
 	}
 }
+
+// muSig2Encoder returns a function that encodes the given MuSig2 signing state
+// as a custom PSBT field. Each signer is encoded as a fixed size record of the
+// compressed signer key, followed by the public nonce and partial signature of
+// that signer, or all zeroes if they aren't known yet.
+func muSig2Encoder(m *MuSig2Input) encoderFunc {
+	return func(key []byte) ([]*customPsbtField, error) {
+		if m == nil {
+			return nil, nil
+		}
+
+		var b bytes.Buffer
+		for _, signerKey := range m.SignerKeys {
+			serializedKey := asset.ToSerialized(signerKey)
+
+			var (
+				nonce [musig2.PubNonceSize]byte
+				sig   [input.MuSig2PartialSigSize]byte
+			)
+			if n, ok := m.PubNonces[serializedKey]; ok {
+				nonce = n
+			}
+			if s, ok := m.PartialSigs[serializedKey]; ok {
+				sig = s
+			}
+
+			b.Write(serializedKey[:])
+			b.Write(nonce[:])
+			b.Write(sig[:])
+		}
+
+		return []*customPsbtField{
+			{
+				Key:   fn.CopySlice(key),
+				Value: b.Bytes(),
+			},
+		}, nil
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net/url"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/btcsuite/btcd/btcutil/psbt"
@@ -15,6 +16,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
 )
 
@@ -42,6 +44,7 @@ var (
 	PsbtKeyTypeInputTapAnchorTapscriptSibling             = []byte{0x78}
 	PsbtKeyTypeInputTapAsset                              = []byte{0x79}
 	PsbtKeyTypeInputTapAssetProof                         = []byte{0x7a}
+	PsbtKeyTypeInputTapMuSig2                             = []byte{0x7b}
 
 	PsbtKeyTypeOutputTapType                               = []byte{0x70}
 	PsbtKeyTypeOutputTapIsInteractive                      = []byte{0x71}
@@ -57,6 +60,7 @@ var (
 	PsbtKeyTypeOutputTapAssetProofSuffix                   = []byte{0x7b}
 	PsbtKeyTypeOutputTapAssetLockTime                      = []byte{0x7c}
 	PsbtKeyTypeOutputTapAssetRelativeLockTime              = []byte{0x7d}
+	PsbtKeyTypeOutputTapAssetScriptVersion                 = []byte{0x7e}
 )
 
 // The following keys are used as custom fields on the BTC level anchor
@@ -370,11 +374,16 @@ type VInput struct {
 	// Proof is a transition proof that proves the asset being spent was
 	// committed to in the anchor transaction above.
 	Proof *proof.Proof
+
+	// MuSig2 holds the public nonces and partial signatures collected from
+	// the signers of an input asset that is locked to a MuSig2 script key.
+	// This is nil for all other inputs.
+	MuSig2 *MuSig2Input
 }
 
 // Copy creates a deep copy of the VInput.
 func (i *VInput) Copy() *VInput {
-	return &VInput{
+	vIn := &VInput{
 		PInput: i.PInput,
 		PrevID: i.PrevID,
 		Anchor: i.Anchor,
@@ -384,6 +393,73 @@ func (i *VInput) Copy() *VInput {
 		// it here is fine.
 		Proof: i.Proof,
 	}
+
+	if i.MuSig2 != nil {
+		vIn.MuSig2 = i.MuSig2.Copy()
+	}
+
+	return vIn
+}
+
+// MuSig2Input holds the state of the MuSig2 signing session of a virtual input
+// that is spent through the key path of a MuSig2 script key. Each signer adds
+// their public nonce first and, once all nonces are known, their partial
+// signature.
+type MuSig2Input struct {
+	// SignerKeys is the sorted list of all keys that were aggregated into
+	// the script key of the input asset.
+	SignerKeys []*btcec.PublicKey
+
+	// PubNonces maps the signer key to the public nonce of that signer.
+	PubNonces map[asset.SerializedKey][musig2.PubNonceSize]byte
+
+	// PartialSigs maps the signer key to the serialized partial signature
+	// of that signer.
+	PartialSigs map[asset.SerializedKey][input.MuSig2PartialSigSize]byte
+}
+
+// NewMuSig2Input creates a new, empty MuSig2 signing state for the given
+// signer keys.
+func NewMuSig2Input(signerKeys []*btcec.PublicKey) *MuSig2Input {
+	nonces := make(map[asset.SerializedKey][musig2.PubNonceSize]byte)
+	sigs := make(map[asset.SerializedKey][input.MuSig2PartialSigSize]byte)
+
+	return &MuSig2Input{
+		SignerKeys:  fn.CopySlice(signerKeys),
+		PubNonces:   nonces,
+		PartialSigs: sigs,
+	}
+}
+
+// Copy creates a deep copy of the MuSig2 signing state.
+func (m *MuSig2Input) Copy() *MuSig2Input {
+	mCopy := NewMuSig2Input(m.SignerKeys)
+	for key, nonce := range m.PubNonces {
+		mCopy.PubNonces[key] = nonce
+	}
+	for key, sig := range m.PartialSigs {
+		mCopy.PartialSigs[key] = sig
+	}
+
+	return mCopy
+}
+
+// IsSigner returns true if the given key is one of the signer keys.
+func (m *MuSig2Input) IsSigner(key *btcec.PublicKey) bool {
+	return fn.Any(m.SignerKeys, func(signerKey *btcec.PublicKey) bool {
+		return signerKey.IsEqual(key)
+	})
+}
+
+// HaveAllNonces returns true if the public nonces of all signers are known.
+func (m *MuSig2Input) HaveAllNonces() bool {
+	return len(m.PubNonces) == len(m.SignerKeys)
+}
+
+// HaveAllPartialSigs returns true if the partial signatures of all signers are
+// known.
+func (m *MuSig2Input) HaveAllPartialSigs() bool {
+	return len(m.PartialSigs) == len(m.SignerKeys)
 }
 
 // Asset returns the input's asset that's being spent.
@@ -491,6 +567,11 @@ type VOutput struct {
 	// create.
 	AssetVersion asset.Version
 
+	// ScriptVersion is the script version of the asset that this output
+	// should create. With ScriptV1, the tapscript leaves of the script key
+	// can inspect the asset outputs of the transfer that spends the asset.
+	ScriptVersion asset.ScriptVersion
+
 	// Type indicates what type of output this is, which has an influence on
 	// whether the asset is set or what witness type is expected to be
 	// generated for the asset.
@@ -570,6 +651,7 @@ func (o *VOutput) Copy() *VOutput {
 	return &VOutput{
 		Amount:            o.Amount,
 		AssetVersion:      o.AssetVersion,
+		ScriptVersion:     o.ScriptVersion,
 		Type:              o.Type,
 		Interactive:       o.Interactive,
 		AnchorOutputIndex: o.AnchorOutputIndex,
@@ -597,11 +679,12 @@ func (o *VOutput) Copy() *VOutput {
 // in for cases in which the asset is not yet set on the output.
 func (o *VOutput) SplitLocator(assetID asset.ID) commitment.SplitLocator {
 	return commitment.SplitLocator{
-		OutputIndex:  o.AnchorOutputIndex,
-		AssetID:      assetID,
-		ScriptKey:    asset.ToSerialized(o.ScriptKey.PubKey),
-		Amount:       o.Amount,
-		AssetVersion: o.AssetVersion,
+		OutputIndex:   o.AnchorOutputIndex,
+		AssetID:       assetID,
+		ScriptKey:     asset.ToSerialized(o.ScriptKey.PubKey),
+		Amount:        o.Amount,
+		AssetVersion:  o.AssetVersion,
+		ScriptVersion: o.ScriptVersion,
 	}
 }
 
@@ -23,7 +23,14 @@ type AssetWalletClient interface {
 	// a virtual transaction matching the template.
 	FundVirtualPsbt(ctx context.Context, in *FundVirtualPsbtRequest, opts ...grpc.CallOption) (*FundVirtualPsbtResponse, error)
 	// SignVirtualPsbt signs the inputs of a virtual transaction and prepares the
-	// commitments of the inputs and outputs.
+	// commitments of the inputs and outputs. Inputs locked to a MuSig2 script key
+	// are signed in multiple rounds: each call adds the local public nonce, then
+	// the local partial signature once the nonces of all signers are known and
+	// finally combines all partial signatures into the witness. The copies of the
+	// virtual PSBT of all signers need to be combined with CombineVirtualPsbts
+	// between the rounds. Inputs locked to a script key imported from an output
+	// descriptor are signed through the key or script path the local key can
+	// satisfy with the lock times of the virtual transaction.
 	SignVirtualPsbt(ctx context.Context, in *SignVirtualPsbtRequest, opts ...grpc.CallOption) (*SignVirtualPsbtResponse, error)
 	// AnchorVirtualPsbts merges and then commits multiple virtual transactions in
 	// a single BTC level anchor transaction. This RPC should be used if the BTC
@@ -77,6 +84,95 @@ type AssetWalletClient interface {
 	// recognized by the wallet automatically. Declaring a script key will make any
 	// assets sent to the script key be recognized as being local assets.
 	DeclareScriptKey(ctx context.Context, in *DeclareScriptKeyRequest, opts ...grpc.CallOption) (*DeclareScriptKeyResponse, error)
+	// ConsolidateAssets spends all available coins of the given asset ID or asset
+	// group back to the wallet in a single transfer. All coins are merged into a
+	// single new anchor output, with one fresh script key per asset ID. If
+	// dry_run is set, no transfer is created and only the expected chain fee and
+	// proof size are returned.
+	ConsolidateAssets(ctx context.Context, in *ConsolidateAssetsRequest, opts ...grpc.CallOption) (*ConsolidateAssetsResponse, error)
+	// ExportWallet exports the asset wallet into a single, versioned bundle. The
+	// bundle contains all proof files, the derivation information of all
+	// internal and script keys and the history of all confirmed transfers.
+	// Addresses, address events and minting batches are not part of the bundle.
+	// The export fails if there are any unconfirmed transfers.
+	ExportWallet(ctx context.Context, in *ExportWalletRequest, opts ...grpc.CallOption) (*ExportWalletResponse, error)
+	// ImportWallet restores an asset wallet from a bundle created by the
+	// ExportWallet RPC. Every proof in the bundle is verified and the anchor
+	// outputs of all unspent assets are imported into the lnd wallet before the
+	// database is restored in a single transaction. Importing a wallet is only
+	// possible into a node that doesn't own any assets or transfers yet, or that
+	// already holds exactly the content of the bundle, so a failed import can be
+	// retried.
+	ImportWallet(ctx context.Context, in *ImportWalletRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error)
+	// ProposeSwap creates the terms of an atomic swap of assets for other assets
+	// or for BTC, with this node acting as the maker of the swap. A new address
+	// to receive the requested assets or BTC on is derived and included in the
+	// terms. The terms must be handed to the taker of the swap, who accepts them
+	// by calling AcceptSwap.
+	ProposeSwap(ctx context.Context, in *ProposeSwapRequest, opts ...grpc.CallOption) (*ProposeSwapResponse, error)
+	// AcceptSwap accepts the terms of an atomic swap with this node acting as the
+	// taker of the swap. A new address to receive the offered assets on is
+	// derived, and the virtual transactions that pay the requested assets to the
+	// maker are funded and signed. If the maker requests BTC instead, the BTC
+	// payment is funded from the lnd wallet. The acceptance must be handed to the
+	// maker, who completes the swap by calling SignSwap.
+	AcceptSwap(ctx context.Context, in *AcceptSwapRequest, opts ...grpc.CallOption) (*AcceptSwapResponse, error)
+	// SignSwap verifies the leg of the taker of an atomic swap and then funds and
+	// signs the virtual transactions that pay the offered assets to the taker.
+	// Both legs are committed to a single BTC level anchor transaction that is
+	// funded from the lnd wallet of the maker, who pays the chain fees and signs
+	// all its BTC level inputs. The signed swap must be handed to the taker, who
+	// publishes it by calling PublishSwap.
+	SignSwap(ctx context.Context, in *SignSwapRequest, opts ...grpc.CallOption) (*SignSwapResponse, error)
+	// PublishSwap verifies the leg of the counterparty of an atomic swap, signs
+	// the remaining BTC level inputs of the anchor transaction that belong to
+	// this node and then publishes the transaction and logs the transfer of the
+	// local leg. The taker calls this RPC first, with the swap returned by
+	// SignSwap. The maker then calls it with the final anchor transaction
+	// returned to the taker, to log the transfer of its own leg and deliver the
+	// proofs of the assets it paid to the taker.
+	PublishSwap(ctx context.Context, in *PublishSwapRequest, opts ...grpc.CallOption) (*PublishSwapResponse, error)
+	// CancelSwap cancels an atomic swap that wasn't published yet by releasing
+	// the leases of the asset and BTC inputs this node acquired for its side of
+	// the swap. The taker calls this RPC with the acceptance returned by
+	// AcceptSwap, the maker with the signed swap returned by SignSwap. A maker
+	// that already handed the signed swap to the taker should spend one of its
+	// inputs to make sure the taker can't publish the swap anymore.
+	CancelSwap(ctx context.Context, in *CancelSwapRequest, opts ...grpc.CallOption) (*CancelSwapResponse, error)
+	// RefundHtlc reclaims an asset locked to the script key of an asset level
+	// hash time locked contract (HTLC) through its timeout path, once the
+	// relative time lock of the HTLC has expired. The asset must be held by the
+	// local wallet, which requires the HTLC script key to be declared with
+	// DeclareScriptKey before the asset is received. The full amount is sent to
+	// a new script key of the wallet.
+	RefundHtlc(ctx context.Context, in *RefundHtlcRequest, opts ...grpc.CallOption) (*taprpc.SendAssetResponse, error)
+	// CombineVirtualPsbts combines several copies of the same virtual transaction
+	// that were each signed by a different party into a single virtual PSBT. The
+	// witnesses of inputs owned by different parties are merged, as are the
+	// signatures of inputs spent through a threshold multisig script leaf that
+	// uses OP_CHECKSIGADD. Once all inputs are signed, the combined virtual
+	// transaction is validated.
+	CombineVirtualPsbts(ctx context.Context, in *CombineVirtualPsbtsRequest, opts ...grpc.CallOption) (*CombineVirtualPsbtsResponse, error)
+	// VirtualPsbtStatus returns the signing status of each input of a virtual
+	// PSBT, which shows which inputs still need to be signed by which party
+	// before the virtual transaction can be committed to an anchor transaction.
+	VirtualPsbtStatus(ctx context.Context, in *VirtualPsbtStatusRequest, opts ...grpc.CallOption) (*VirtualPsbtStatusResponse, error)
+	// NewMuSig2ScriptKey aggregates a local key and the keys of one or more
+	// remote signers into a MuSig2 script key and declares it to the wallet.
+	// Assets sent to the script key are recognized as local assets and can be
+	// spent through the key path by all signers together, using
+	// SignVirtualPsbt. All signers must use the same set of keys to arrive at
+	// the same script key.
+	NewMuSig2ScriptKey(ctx context.Context, in *NewMuSig2ScriptKeyRequest, opts ...grpc.CallOption) (*NewMuSig2ScriptKeyResponse, error)
+	// ImportScriptKeyDescriptor derives a script key from a tr() output
+	// descriptor template and declares it to the wallet. The template must
+	// contain the @local placeholder, which is replaced with a newly derived key
+	// of the wallet. Script paths can use the pk(), multi_a() and time locked
+	// and_v(v:pk(),older()) or and_v(v:multi_a(),after()) style fragments.
+	// Assets sent to the script key are recognized as local assets and are
+	// signed through the spend path the local key can satisfy when calling
+	// SignVirtualPsbt.
+	ImportScriptKeyDescriptor(ctx context.Context, in *ImportScriptKeyDescriptorRequest, opts ...grpc.CallOption) (*ImportScriptKeyDescriptorResponse, error)
 }
 
 type assetWalletClient struct {
@@ -204,6 +300,123 @@ func (c *assetWalletClient) DeclareScriptKey(ctx context.Context, in *DeclareScr
 	return out, nil
 }
 
+func (c *assetWalletClient) ConsolidateAssets(ctx context.Context, in *ConsolidateAssetsRequest, opts ...grpc.CallOption) (*ConsolidateAssetsResponse, error) {
+	out := new(ConsolidateAssetsResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/ConsolidateAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) ExportWallet(ctx context.Context, in *ExportWalletRequest, opts ...grpc.CallOption) (*ExportWalletResponse, error) {
+	out := new(ExportWalletResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/ExportWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) ImportWallet(ctx context.Context, in *ImportWalletRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error) {
+	out := new(ImportWalletResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/ImportWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) ProposeSwap(ctx context.Context, in *ProposeSwapRequest, opts ...grpc.CallOption) (*ProposeSwapResponse, error) {
+	out := new(ProposeSwapResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/ProposeSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) AcceptSwap(ctx context.Context, in *AcceptSwapRequest, opts ...grpc.CallOption) (*AcceptSwapResponse, error) {
+	out := new(AcceptSwapResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/AcceptSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) SignSwap(ctx context.Context, in *SignSwapRequest, opts ...grpc.CallOption) (*SignSwapResponse, error) {
+	out := new(SignSwapResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/SignSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) PublishSwap(ctx context.Context, in *PublishSwapRequest, opts ...grpc.CallOption) (*PublishSwapResponse, error) {
+	out := new(PublishSwapResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/PublishSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) CancelSwap(ctx context.Context, in *CancelSwapRequest, opts ...grpc.CallOption) (*CancelSwapResponse, error) {
+	out := new(CancelSwapResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/CancelSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) RefundHtlc(ctx context.Context, in *RefundHtlcRequest, opts ...grpc.CallOption) (*taprpc.SendAssetResponse, error) {
+	out := new(taprpc.SendAssetResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/RefundHtlc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) CombineVirtualPsbts(ctx context.Context, in *CombineVirtualPsbtsRequest, opts ...grpc.CallOption) (*CombineVirtualPsbtsResponse, error) {
+	out := new(CombineVirtualPsbtsResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/CombineVirtualPsbts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) VirtualPsbtStatus(ctx context.Context, in *VirtualPsbtStatusRequest, opts ...grpc.CallOption) (*VirtualPsbtStatusResponse, error) {
+	out := new(VirtualPsbtStatusResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/VirtualPsbtStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) NewMuSig2ScriptKey(ctx context.Context, in *NewMuSig2ScriptKeyRequest, opts ...grpc.CallOption) (*NewMuSig2ScriptKeyResponse, error) {
+	out := new(NewMuSig2ScriptKeyResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/NewMuSig2ScriptKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) ImportScriptKeyDescriptor(ctx context.Context, in *ImportScriptKeyDescriptorRequest, opts ...grpc.CallOption) (*ImportScriptKeyDescriptorResponse, error) {
+	out := new(ImportScriptKeyDescriptorResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/ImportScriptKeyDescriptor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AssetWalletServer is the server API for AssetWallet service.
 // All implementations must embed UnimplementedAssetWalletServer
 // for forward compatibility
@@ -212,7 +425,14 @@ type AssetWalletServer interface {
 	// a virtual transaction matching the template.
 	FundVirtualPsbt(context.Context, *FundVirtualPsbtRequest) (*FundVirtualPsbtResponse, error)
 	// SignVirtualPsbt signs the inputs of a virtual transaction and prepares the
-	// commitments of the inputs and outputs.
+	// commitments of the inputs and outputs. Inputs locked to a MuSig2 script key
+	// are signed in multiple rounds: each call adds the local public nonce, then
+	// the local partial signature once the nonces of all signers are known and
+	// finally combines all partial signatures into the witness. The copies of the
+	// virtual PSBT of all signers need to be combined with CombineVirtualPsbts
+	// between the rounds. Inputs locked to a script key imported from an output
+	// descriptor are signed through the key or script path the local key can
+	// satisfy with the lock times of the virtual transaction.
 	SignVirtualPsbt(context.Context, *SignVirtualPsbtRequest) (*SignVirtualPsbtResponse, error)
 	// AnchorVirtualPsbts merges and then commits multiple virtual transactions in
 	// a single BTC level anchor transaction. This RPC should be used if the BTC
@@ -266,6 +486,95 @@ type AssetWalletServer interface {
 	// recognized by the wallet automatically. Declaring a script key will make any
 	// assets sent to the script key be recognized as being local assets.
 	DeclareScriptKey(context.Context, *DeclareScriptKeyRequest) (*DeclareScriptKeyResponse, error)
+	// ConsolidateAssets spends all available coins of the given asset ID or asset
+	// group back to the wallet in a single transfer. All coins are merged into a
+	// single new anchor output, with one fresh script key per asset ID. If
+	// dry_run is set, no transfer is created and only the expected chain fee and
+	// proof size are returned.
+	ConsolidateAssets(context.Context, *ConsolidateAssetsRequest) (*ConsolidateAssetsResponse, error)
+	// ExportWallet exports the asset wallet into a single, versioned bundle. The
+	// bundle contains all proof files, the derivation information of all
+	// internal and script keys and the history of all confirmed transfers.
+	// Addresses, address events and minting batches are not part of the bundle.
+	// The export fails if there are any unconfirmed transfers.
+	ExportWallet(context.Context, *ExportWalletRequest) (*ExportWalletResponse, error)
+	// ImportWallet restores an asset wallet from a bundle created by the
+	// ExportWallet RPC. Every proof in the bundle is verified and the anchor
+	// outputs of all unspent assets are imported into the lnd wallet before the
+	// database is restored in a single transaction. Importing a wallet is only
+	// possible into a node that doesn't own any assets or transfers yet, or that
+	// already holds exactly the content of the bundle, so a failed import can be
+	// retried.
+	ImportWallet(context.Context, *ImportWalletRequest) (*ImportWalletResponse, error)
+	// ProposeSwap creates the terms of an atomic swap of assets for other assets
+	// or for BTC, with this node acting as the maker of the swap. A new address
+	// to receive the requested assets or BTC on is derived and included in the
+	// terms. The terms must be handed to the taker of the swap, who accepts them
+	// by calling AcceptSwap.
+	ProposeSwap(context.Context, *ProposeSwapRequest) (*ProposeSwapResponse, error)
+	// AcceptSwap accepts the terms of an atomic swap with this node acting as the
+	// taker of the swap. A new address to receive the offered assets on is
+	// derived, and the virtual transactions that pay the requested assets to the
+	// maker are funded and signed. If the maker requests BTC instead, the BTC
+	// payment is funded from the lnd wallet. The acceptance must be handed to the
+	// maker, who completes the swap by calling SignSwap.
+	AcceptSwap(context.Context, *AcceptSwapRequest) (*AcceptSwapResponse, error)
+	// SignSwap verifies the leg of the taker of an atomic swap and then funds and
+	// signs the virtual transactions that pay the offered assets to the taker.
+	// Both legs are committed to a single BTC level anchor transaction that is
+	// funded from the lnd wallet of the maker, who pays the chain fees and signs
+	// all its BTC level inputs. The signed swap must be handed to the taker, who
+	// publishes it by calling PublishSwap.
+	SignSwap(context.Context, *SignSwapRequest) (*SignSwapResponse, error)
+	// PublishSwap verifies the leg of the counterparty of an atomic swap, signs
+	// the remaining BTC level inputs of the anchor transaction that belong to
+	// this node and then publishes the transaction and logs the transfer of the
+	// local leg. The taker calls this RPC first, with the swap returned by
+	// SignSwap. The maker then calls it with the final anchor transaction
+	// returned to the taker, to log the transfer of its own leg and deliver the
+	// proofs of the assets it paid to the taker.
+	PublishSwap(context.Context, *PublishSwapRequest) (*PublishSwapResponse, error)
+	// CancelSwap cancels an atomic swap that wasn't published yet by releasing
+	// the leases of the asset and BTC inputs this node acquired for its side of
+	// the swap. The taker calls this RPC with the acceptance returned by
+	// AcceptSwap, the maker with the signed swap returned by SignSwap. A maker
+	// that already handed the signed swap to the taker should spend one of its
+	// inputs to make sure the taker can't publish the swap anymore.
+	CancelSwap(context.Context, *CancelSwapRequest) (*CancelSwapResponse, error)
+	// RefundHtlc reclaims an asset locked to the script key of an asset level
+	// hash time locked contract (HTLC) through its timeout path, once the
+	// relative time lock of the HTLC has expired. The asset must be held by the
+	// local wallet, which requires the HTLC script key to be declared with
+	// DeclareScriptKey before the asset is received. The full amount is sent to
+	// a new script key of the wallet.
+	RefundHtlc(context.Context, *RefundHtlcRequest) (*taprpc.SendAssetResponse, error)
+	// CombineVirtualPsbts combines several copies of the same virtual transaction
+	// that were each signed by a different party into a single virtual PSBT. The
+	// witnesses of inputs owned by different parties are merged, as are the
+	// signatures of inputs spent through a threshold multisig script leaf that
+	// uses OP_CHECKSIGADD. Once all inputs are signed, the combined virtual
+	// transaction is validated.
+	CombineVirtualPsbts(context.Context, *CombineVirtualPsbtsRequest) (*CombineVirtualPsbtsResponse, error)
+	// VirtualPsbtStatus returns the signing status of each input of a virtual
+	// PSBT, which shows which inputs still need to be signed by which party
+	// before the virtual transaction can be committed to an anchor transaction.
+	VirtualPsbtStatus(context.Context, *VirtualPsbtStatusRequest) (*VirtualPsbtStatusResponse, error)
+	// NewMuSig2ScriptKey aggregates a local key and the keys of one or more
+	// remote signers into a MuSig2 script key and declares it to the wallet.
+	// Assets sent to the script key are recognized as local assets and can be
+	// spent through the key path by all signers together, using
+	// SignVirtualPsbt. All signers must use the same set of keys to arrive at
+	// the same script key.
+	NewMuSig2ScriptKey(context.Context, *NewMuSig2ScriptKeyRequest) (*NewMuSig2ScriptKeyResponse, error)
+	// ImportScriptKeyDescriptor derives a script key from a tr() output
+	// descriptor template and declares it to the wallet. The template must
+	// contain the @local placeholder, which is replaced with a newly derived key
+	// of the wallet. Script paths can use the pk(), multi_a() and time locked
+	// and_v(v:pk(),older()) or and_v(v:multi_a(),after()) style fragments.
+	// Assets sent to the script key are recognized as local assets and are
+	// signed through the spend path the local key can satisfy when calling
+	// SignVirtualPsbt.
+	ImportScriptKeyDescriptor(context.Context, *ImportScriptKeyDescriptorRequest) (*ImportScriptKeyDescriptorResponse, error)
 	mustEmbedUnimplementedAssetWalletServer()
 }
 
@@ -312,6 +621,45 @@ func (UnimplementedAssetWalletServer) RemoveUTXOLease(context.Context, *RemoveUT
 func (UnimplementedAssetWalletServer) DeclareScriptKey(context.Context, *DeclareScriptKeyRequest) (*DeclareScriptKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeclareScriptKey not implemented")
 }
+func (UnimplementedAssetWalletServer) ConsolidateAssets(context.Context, *ConsolidateAssetsRequest) (*ConsolidateAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsolidateAssets not implemented")
+}
+func (UnimplementedAssetWalletServer) ExportWallet(context.Context, *ExportWalletRequest) (*ExportWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportWallet not implemented")
+}
+func (UnimplementedAssetWalletServer) ImportWallet(context.Context, *ImportWalletRequest) (*ImportWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportWallet not implemented")
+}
+func (UnimplementedAssetWalletServer) ProposeSwap(context.Context, *ProposeSwapRequest) (*ProposeSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeSwap not implemented")
+}
+func (UnimplementedAssetWalletServer) AcceptSwap(context.Context, *AcceptSwapRequest) (*AcceptSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptSwap not implemented")
+}
+func (UnimplementedAssetWalletServer) SignSwap(context.Context, *SignSwapRequest) (*SignSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignSwap not implemented")
+}
+func (UnimplementedAssetWalletServer) PublishSwap(context.Context, *PublishSwapRequest) (*PublishSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishSwap not implemented")
+}
+func (UnimplementedAssetWalletServer) CancelSwap(context.Context, *CancelSwapRequest) (*CancelSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSwap not implemented")
+}
+func (UnimplementedAssetWalletServer) RefundHtlc(context.Context, *RefundHtlcRequest) (*taprpc.SendAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundHtlc not implemented")
+}
+func (UnimplementedAssetWalletServer) CombineVirtualPsbts(context.Context, *CombineVirtualPsbtsRequest) (*CombineVirtualPsbtsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CombineVirtualPsbts not implemented")
+}
+func (UnimplementedAssetWalletServer) VirtualPsbtStatus(context.Context, *VirtualPsbtStatusRequest) (*VirtualPsbtStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VirtualPsbtStatus not implemented")
+}
+func (UnimplementedAssetWalletServer) NewMuSig2ScriptKey(context.Context, *NewMuSig2ScriptKeyRequest) (*NewMuSig2ScriptKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewMuSig2ScriptKey not implemented")
+}
+func (UnimplementedAssetWalletServer) ImportScriptKeyDescriptor(context.Context, *ImportScriptKeyDescriptorRequest) (*ImportScriptKeyDescriptorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportScriptKeyDescriptor not implemented")
+}
 func (UnimplementedAssetWalletServer) mustEmbedUnimplementedAssetWalletServer() {}
 
 // UnsafeAssetWalletServer may be embedded to opt out of forward compatibility for this service.
@@ -559,6 +907,240 @@ func _AssetWallet_DeclareScriptKey_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AssetWallet_ConsolidateAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsolidateAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).ConsolidateAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/ConsolidateAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).ConsolidateAssets(ctx, req.(*ConsolidateAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_ExportWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).ExportWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/ExportWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).ExportWallet(ctx, req.(*ExportWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_ImportWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).ImportWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/ImportWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).ImportWallet(ctx, req.(*ImportWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_ProposeSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).ProposeSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/ProposeSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).ProposeSwap(ctx, req.(*ProposeSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_AcceptSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).AcceptSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/AcceptSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).AcceptSwap(ctx, req.(*AcceptSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_SignSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).SignSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/SignSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).SignSwap(ctx, req.(*SignSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_PublishSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).PublishSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/PublishSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).PublishSwap(ctx, req.(*PublishSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_CancelSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).CancelSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/CancelSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).CancelSwap(ctx, req.(*CancelSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_RefundHtlc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundHtlcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).RefundHtlc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/RefundHtlc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).RefundHtlc(ctx, req.(*RefundHtlcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_CombineVirtualPsbts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CombineVirtualPsbtsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).CombineVirtualPsbts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/CombineVirtualPsbts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).CombineVirtualPsbts(ctx, req.(*CombineVirtualPsbtsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_VirtualPsbtStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualPsbtStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).VirtualPsbtStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/VirtualPsbtStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).VirtualPsbtStatus(ctx, req.(*VirtualPsbtStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_NewMuSig2ScriptKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewMuSig2ScriptKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).NewMuSig2ScriptKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/NewMuSig2ScriptKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).NewMuSig2ScriptKey(ctx, req.(*NewMuSig2ScriptKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_ImportScriptKeyDescriptor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportScriptKeyDescriptorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).ImportScriptKeyDescriptor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/ImportScriptKeyDescriptor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).ImportScriptKeyDescriptor(ctx, req.(*ImportScriptKeyDescriptorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AssetWallet_ServiceDesc is the grpc.ServiceDesc for AssetWallet service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -618,6 +1200,58 @@ var AssetWallet_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeclareScriptKey",
 			Handler:    _AssetWallet_DeclareScriptKey_Handler,
 		},
+		{
+			MethodName: "ConsolidateAssets",
+			Handler:    _AssetWallet_ConsolidateAssets_Handler,
+		},
+		{
+			MethodName: "ExportWallet",
+			Handler:    _AssetWallet_ExportWallet_Handler,
+		},
+		{
+			MethodName: "ImportWallet",
+			Handler:    _AssetWallet_ImportWallet_Handler,
+		},
+		{
+			MethodName: "ProposeSwap",
+			Handler:    _AssetWallet_ProposeSwap_Handler,
+		},
+		{
+			MethodName: "AcceptSwap",
+			Handler:    _AssetWallet_AcceptSwap_Handler,
+		},
+		{
+			MethodName: "SignSwap",
+			Handler:    _AssetWallet_SignSwap_Handler,
+		},
+		{
+			MethodName: "PublishSwap",
+			Handler:    _AssetWallet_PublishSwap_Handler,
+		},
+		{
+			MethodName: "CancelSwap",
+			Handler:    _AssetWallet_CancelSwap_Handler,
+		},
+		{
+			MethodName: "RefundHtlc",
+			Handler:    _AssetWallet_RefundHtlc_Handler,
+		},
+		{
+			MethodName: "CombineVirtualPsbts",
+			Handler:    _AssetWallet_CombineVirtualPsbts_Handler,
+		},
+		{
+			MethodName: "VirtualPsbtStatus",
+			Handler:    _AssetWallet_VirtualPsbtStatus_Handler,
+		},
+		{
+			MethodName: "NewMuSig2ScriptKey",
+			Handler:    _AssetWallet_NewMuSig2ScriptKey_Handler,
+		},
+		{
+			MethodName: "ImportScriptKeyDescriptor",
+			Handler:    _AssetWallet_ImportScriptKeyDescriptor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "assetwalletrpc/assetwallet.proto",
@@ -6,6 +6,12 @@ set -e
 function generate() {
   echo "Generating root gRPC server protos"
 
+  # Some of our protos import lnd's RPC protos, so we need to add lnd's lnrpc
+  # directory (in the version we depend on) to the include path.
+  go mod download github.com/lightningnetwork/lnd
+  LND_DIR=$(go list -f '{{.Dir}}' -m github.com/lightningnetwork/lnd)
+  LND_INCLUDE="-I${LND_DIR}/lnrpc"
+
   PROTOS="taprootassets.proto assetwalletrpc/assetwallet.proto
 mintrpc/mint.proto rfqrpc/rfq.proto priceoraclerpc/price_oracle.proto
 universerpc/universe.proto tapdevrpc/tapdev.proto
@@ -18,14 +24,14 @@ tapchannelrpc/tapchannel.proto"
     echo "Generating protos from ${file}, into ${DIRECTORY}"
 
     # Generate the protos.
-    protoc -I/usr/local/include -I. \
+    protoc -I/usr/local/include -I. ${LND_INCLUDE} \
       --go_out . --go_opt paths=source_relative \
       --go-grpc_out . --go-grpc_opt paths=source_relative \
       "${file}"
 
     # Generate the REST reverse proxy.
     annotationsFile=${file//proto/yaml}
-    protoc -I/usr/local/include -I. \
+    protoc -I/usr/local/include -I. ${LND_INCLUDE} \
       --grpc-gateway_out . \
       --grpc-gateway_opt logtostderr=true \
       --grpc-gateway_opt paths=source_relative \
@@ -33,7 +39,7 @@ tapchannelrpc/tapchannel.proto"
       "${file}"
 
     # Generate the swagger file which describes the REST API in detail.
-    protoc -I/usr/local/include -I. \
+    protoc -I/usr/local/include -I. ${LND_INCLUDE} \
       --openapiv2_out . \
       --openapiv2_opt logtostderr=true \
       --openapiv2_opt grpc_api_configuration=${annotationsFile} \
@@ -45,7 +51,7 @@ tapchannelrpc/tapchannel.proto"
   falafel=$(which falafel)
   pkg="taprpc"
   opts="package_name=$pkg,js_stubs=1"
-  protoc -I/usr/local/include -I. -I.. \
+  protoc -I/usr/local/include -I. -I.. ${LND_INCLUDE} \
     --plugin=protoc-gen-custom=$falafel\
     --custom_out=. \
     --custom_opt="$opts" \
@@ -57,7 +63,7 @@ tapchannelrpc/tapchannel.proto"
 
     opts="package_name=$package,manual_import=$manual_import,js_stubs=1"
     pushd $package
-    protoc -I/usr/local/include -I. -I.. \
+    protoc -I/usr/local/include -I. -I.. ${LND_INCLUDE} \
       --plugin=protoc-gen-custom=$falafel\
       --custom_out=. \
       --custom_opt="$opts" \
@@ -9,6 +9,7 @@ import (
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/address"
@@ -158,9 +159,10 @@ func MarshalAssetVersion(version asset.Version) (AssetVersion, error) {
 
 // UnmarshalAddressVerion parses an address version from the RPC variant.
 func UnmarshalAddressVersion(version AddrVersion) (address.Version, error) {
-	// For now we'll only support two address versions. The ones in the
+	// For now we'll only support three address versions. The ones in the
 	// future should be reserved for future use, so we disallow unknown
-	// versions.
+	// versions. Reusable addresses must be requested explicitly, so an
+	// unspecified version still maps to V1.
 	switch version {
 	case AddrVersion_ADDR_VERSION_UNSPECIFIED:
 		return address.V1, nil
@@ -171,6 +173,9 @@ func UnmarshalAddressVersion(version AddrVersion) (address.Version, error) {
 	case AddrVersion_ADDR_VERSION_V1:
 		return address.V1, nil
 
+	case AddrVersion_ADDR_VERSION_V2:
+		return address.V2, nil
+
 	default:
 		return 0, fmt.Errorf("unknown address version: %v", version)
 	}
@@ -179,7 +184,7 @@ func UnmarshalAddressVersion(version AddrVersion) (address.Version, error) {
 // MarshalAddressVerion marshals the native address version into the RPC
 // variant.
 func MarshalAddressVersion(version address.Version) (AddrVersion, error) {
-	// For now we'll only support two address versions. The ones in the
+	// For now we'll only support three address versions. The ones in the
 	// future should be reserved for future use, so we disallow unknown
 	// versions.
 	switch version {
@@ -189,11 +194,32 @@ func MarshalAddressVersion(version address.Version) (AddrVersion, error) {
 	case address.V1:
 		return AddrVersion_ADDR_VERSION_V1, nil
 
+	case address.V2:
+		return AddrVersion_ADDR_VERSION_V2, nil
+
 	default:
 		return 0, fmt.Errorf("unknown address version: %v", version)
 	}
 }
 
+// UnmarshalOutPoint parses an outpoint from the RPC variant.
+func UnmarshalOutPoint(op *OutPoint) (wire.OutPoint, error) {
+	if op == nil {
+		return wire.OutPoint{}, fmt.Errorf("outpoint cannot be nil")
+	}
+
+	txid, err := chainhash.NewHash(op.Txid)
+	if err != nil {
+		return wire.OutPoint{}, fmt.Errorf("invalid outpoint txid: %w",
+			err)
+	}
+
+	return wire.OutPoint{
+		Hash:  *txid,
+		Index: op.OutputIndex,
+	}, nil
+}
+
 // MarshalGenesisInfo marshals the native asset genesis into the RPC
 // counterpart.
 func MarshalGenesisInfo(gen *asset.Genesis, assetType asset.Type) *GenesisInfo {
@@ -556,6 +582,7 @@ func MarshalAsset(ctx context.Context, a *asset.Asset,
 func MarshalAcceptedSellQuoteEvent(
 	event *rfq.PeerAcceptedSellQuoteEvent) *rfqrpc.PeerAcceptedSellQuote {
 
+	sig := event.Sig()
 	return &rfqrpc.PeerAcceptedSellQuote{
 		Peer:        event.Peer.String(),
 		Id:          event.ID[:],
@@ -563,6 +590,8 @@ func MarshalAcceptedSellQuoteEvent(
 		AssetAmount: event.Request.AssetAmount,
 		BidPrice:    uint64(event.BidPrice),
 		Expiry:      event.Expiry,
+		Signature:   sig[:],
+		SignedMsg:   event.SigMsg(),
 	}
 }
 
@@ -571,6 +600,7 @@ func MarshalAcceptedSellQuoteEvent(
 func MarshalAcceptedBuyQuoteEvent(
 	event *rfq.PeerAcceptedBuyQuoteEvent) *rfqrpc.PeerAcceptedBuyQuote {
 
+	sig := event.Sig()
 	return &rfqrpc.PeerAcceptedBuyQuote{
 		Peer:        event.Peer.String(),
 		Id:          event.ID[:],
@@ -578,6 +608,8 @@ func MarshalAcceptedBuyQuoteEvent(
 		AssetAmount: event.Request.AssetAmount,
 		AskPrice:    uint64(event.AskPrice),
 		Expiry:      event.Expiry,
+		Signature:   sig[:],
+		SignedMsg:   event.SigMsg(),
 	}
 }
 
@@ -22,17 +22,18 @@ type MintClient interface {
 	// MintAsset will attempt to mint the set of assets (async by default to
 	// ensure proper batching) specified in the request. The pending batch is
 	// returned that shows the other pending assets that are part of the next
-	// batch. This call will block until the operation succeeds (asset is staged
-	// in the batch) or fails.
+	// batch. If multiple batches are pending, the target batch must be specified
+	// by its batch key, or a new batch can be requested. This call will block
+	// until the operation succeeds (asset is staged in the batch) or fails.
 	MintAsset(ctx context.Context, in *MintAssetRequest, opts ...grpc.CallOption) (*MintAssetResponse, error)
 	// tapcli `assets mint fund`
-	// FundBatch will attempt to fund the current pending batch with a genesis
+	// FundBatch will attempt to fund the target pending batch with a genesis
 	// input, or create a new funded batch if no batch exists yet. This RPC is only
 	// needed if a custom witness is needed to finalize the batch. Otherwise,
 	// FinalizeBatch can be called directly.
 	FundBatch(ctx context.Context, in *FundBatchRequest, opts ...grpc.CallOption) (*FundBatchResponse, error)
 	// tapcli `assets mint seal`
-	// SealBatch will attempt to seal the current pending batch by creating and
+	// SealBatch will attempt to seal the target pending batch by creating and
 	// validating asset group witness for all assets in the batch. If a witness
 	// is not provided, a signature will be derived to serve as the witness. This
 	// RPC is only needed if any assets in the batch have a custom asset group key
@@ -40,11 +41,17 @@ type MintClient interface {
 	// directly.
 	SealBatch(ctx context.Context, in *SealBatchRequest, opts ...grpc.CallOption) (*SealBatchResponse, error)
 	// tapcli: `assets mint finalize`
-	// FinalizeBatch will attempt to finalize the current pending batch.
+	// FinalizeBatch will attempt to finalize the target pending batch.
 	FinalizeBatch(ctx context.Context, in *FinalizeBatchRequest, opts ...grpc.CallOption) (*FinalizeBatchResponse, error)
 	// tapcli: `assets mint cancel`
-	// CancelBatch will attempt to cancel the current pending batch.
+	// CancelBatch will attempt to cancel the target pending batch.
 	CancelBatch(ctx context.Context, in *CancelBatchRequest, opts ...grpc.CallOption) (*CancelBatchResponse, error)
+	// tapcli: `assets mint bumpfee`
+	// BumpBatchFee replaces the broadcast, but still unconfirmed, minting
+	// transaction of a batch with one that pays a higher fee rate. The assets of
+	// the batch keep their IDs, only the change output pays for the additional
+	// fee.
+	BumpBatchFee(ctx context.Context, in *BumpBatchFeeRequest, opts ...grpc.CallOption) (*BumpBatchFeeResponse, error)
 	// tapcli: `assets mint batches`
 	// ListBatches lists the set of batches submitted to the daemon, including
 	// pending and cancelled batches.
@@ -108,6 +115,15 @@ func (c *mintClient) CancelBatch(ctx context.Context, in *CancelBatchRequest, op
 	return out, nil
 }
 
+func (c *mintClient) BumpBatchFee(ctx context.Context, in *BumpBatchFeeRequest, opts ...grpc.CallOption) (*BumpBatchFeeResponse, error) {
+	out := new(BumpBatchFeeResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/BumpBatchFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mintClient) ListBatches(ctx context.Context, in *ListBatchRequest, opts ...grpc.CallOption) (*ListBatchResponse, error) {
 	out := new(ListBatchResponse)
 	err := c.cc.Invoke(ctx, "/mintrpc.Mint/ListBatches", in, out, opts...)
@@ -157,17 +173,18 @@ type MintServer interface {
 	// MintAsset will attempt to mint the set of assets (async by default to
 	// ensure proper batching) specified in the request. The pending batch is
 	// returned that shows the other pending assets that are part of the next
-	// batch. This call will block until the operation succeeds (asset is staged
-	// in the batch) or fails.
+	// batch. If multiple batches are pending, the target batch must be specified
+	// by its batch key, or a new batch can be requested. This call will block
+	// until the operation succeeds (asset is staged in the batch) or fails.
 	MintAsset(context.Context, *MintAssetRequest) (*MintAssetResponse, error)
 	// tapcli `assets mint fund`
-	// FundBatch will attempt to fund the current pending batch with a genesis
+	// FundBatch will attempt to fund the target pending batch with a genesis
 	// input, or create a new funded batch if no batch exists yet. This RPC is only
 	// needed if a custom witness is needed to finalize the batch. Otherwise,
 	// FinalizeBatch can be called directly.
 	FundBatch(context.Context, *FundBatchRequest) (*FundBatchResponse, error)
 	// tapcli `assets mint seal`
-	// SealBatch will attempt to seal the current pending batch by creating and
+	// SealBatch will attempt to seal the target pending batch by creating and
 	// validating asset group witness for all assets in the batch. If a witness
 	// is not provided, a signature will be derived to serve as the witness. This
 	// RPC is only needed if any assets in the batch have a custom asset group key
@@ -175,11 +192,17 @@ type MintServer interface {
 	// directly.
 	SealBatch(context.Context, *SealBatchRequest) (*SealBatchResponse, error)
 	// tapcli: `assets mint finalize`
-	// FinalizeBatch will attempt to finalize the current pending batch.
+	// FinalizeBatch will attempt to finalize the target pending batch.
 	FinalizeBatch(context.Context, *FinalizeBatchRequest) (*FinalizeBatchResponse, error)
 	// tapcli: `assets mint cancel`
-	// CancelBatch will attempt to cancel the current pending batch.
+	// CancelBatch will attempt to cancel the target pending batch.
 	CancelBatch(context.Context, *CancelBatchRequest) (*CancelBatchResponse, error)
+	// tapcli: `assets mint bumpfee`
+	// BumpBatchFee replaces the broadcast, but still unconfirmed, minting
+	// transaction of a batch with one that pays a higher fee rate. The assets of
+	// the batch keep their IDs, only the change output pays for the additional
+	// fee.
+	BumpBatchFee(context.Context, *BumpBatchFeeRequest) (*BumpBatchFeeResponse, error)
 	// tapcli: `assets mint batches`
 	// ListBatches lists the set of batches submitted to the daemon, including
 	// pending and cancelled batches.
@@ -210,6 +233,9 @@ func (UnimplementedMintServer) FinalizeBatch(context.Context, *FinalizeBatchRequ
 func (UnimplementedMintServer) CancelBatch(context.Context, *CancelBatchRequest) (*CancelBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelBatch not implemented")
 }
+func (UnimplementedMintServer) BumpBatchFee(context.Context, *BumpBatchFeeRequest) (*BumpBatchFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpBatchFee not implemented")
+}
 func (UnimplementedMintServer) ListBatches(context.Context, *ListBatchRequest) (*ListBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBatches not implemented")
 }
@@ -319,6 +345,24 @@ func _Mint_CancelBatch_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mint_BumpBatchFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpBatchFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).BumpBatchFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/BumpBatchFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).BumpBatchFee(ctx, req.(*BumpBatchFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Mint_ListBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBatchRequest)
 	if err := dec(in); err != nil {
@@ -385,6 +429,10 @@ var Mint_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelBatch",
 			Handler:    _Mint_CancelBatch_Handler,
 		},
+		{
+			MethodName: "BumpBatchFee",
+			Handler:    _Mint_BumpBatchFee_Handler,
+		},
 		{
 			MethodName: "ListBatches",
 			Handler:    _Mint_ListBatches_Handler,
@@ -33,6 +33,9 @@ const (
 	// PRICE_ORACLE_QUERY_ERR indicates that an error occurred when querying the
 	// price oracle whilst evaluating the quote response.
 	QuoteRespStatus_PRICE_ORACLE_QUERY_ERR QuoteRespStatus = 2
+	// INVALID_SIGNATURE indicates that the quote response isn't signed by the
+	// responding peer's node key.
+	QuoteRespStatus_INVALID_SIGNATURE QuoteRespStatus = 3
 )
 
 // Enum value maps for QuoteRespStatus.
@@ -41,11 +44,13 @@ var (
 		0: "INVALID_RATE_TICK",
 		1: "INVALID_EXPIRY",
 		2: "PRICE_ORACLE_QUERY_ERR",
+		3: "INVALID_SIGNATURE",
 	}
 	QuoteRespStatus_value = map[string]int32{
 		"INVALID_RATE_TICK":      0,
 		"INVALID_EXPIRY":         1,
 		"PRICE_ORACLE_QUERY_ERR": 2,
+		"INVALID_SIGNATURE":      3,
 	}
 )
 
@@ -76,6 +81,110 @@ func (QuoteRespStatus) EnumDescriptor() ([]byte, []int) {
 	return file_rfqrpc_rfq_proto_rawDescGZIP(), []int{0}
 }
 
+// RateTickType is an enum that represents the type of a rate tick.
+type RateTickType int32
+
+const (
+	// RATE_TICK_TYPE_UNSPECIFIED is used to not filter by rate tick type.
+	RateTickType_RATE_TICK_TYPE_UNSPECIFIED RateTickType = 0
+	// RATE_TICK_TYPE_ASK indicates an ask price returned by the price oracle.
+	RateTickType_RATE_TICK_TYPE_ASK RateTickType = 1
+	// RATE_TICK_TYPE_BID indicates a bid price returned by the price oracle.
+	RateTickType_RATE_TICK_TYPE_BID RateTickType = 2
+)
+
+// Enum value maps for RateTickType.
+var (
+	RateTickType_name = map[int32]string{
+		0: "RATE_TICK_TYPE_UNSPECIFIED",
+		1: "RATE_TICK_TYPE_ASK",
+		2: "RATE_TICK_TYPE_BID",
+	}
+	RateTickType_value = map[string]int32{
+		"RATE_TICK_TYPE_UNSPECIFIED": 0,
+		"RATE_TICK_TYPE_ASK":         1,
+		"RATE_TICK_TYPE_BID":         2,
+	}
+)
+
+func (x RateTickType) Enum() *RateTickType {
+	p := new(RateTickType)
+	*p = x
+	return p
+}
+
+func (x RateTickType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RateTickType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rfqrpc_rfq_proto_enumTypes[1].Descriptor()
+}
+
+func (RateTickType) Type() protoreflect.EnumType {
+	return &file_rfqrpc_rfq_proto_enumTypes[1]
+}
+
+func (x RateTickType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RateTickType.Descriptor instead.
+func (RateTickType) EnumDescriptor() ([]byte, []int) {
+	return file_rfqrpc_rfq_proto_rawDescGZIP(), []int{1}
+}
+
+// QuoteType is an enum that represents the type of an accepted quote.
+type QuoteType int32
+
+const (
+	// QUOTE_TYPE_BUY indicates a quote accepted in response to an asset buy
+	// request.
+	QuoteType_QUOTE_TYPE_BUY QuoteType = 0
+	// QUOTE_TYPE_SELL indicates a quote accepted in response to an asset sell
+	// request.
+	QuoteType_QUOTE_TYPE_SELL QuoteType = 1
+)
+
+// Enum value maps for QuoteType.
+var (
+	QuoteType_name = map[int32]string{
+		0: "QUOTE_TYPE_BUY",
+		1: "QUOTE_TYPE_SELL",
+	}
+	QuoteType_value = map[string]int32{
+		"QUOTE_TYPE_BUY":  0,
+		"QUOTE_TYPE_SELL": 1,
+	}
+)
+
+func (x QuoteType) Enum() *QuoteType {
+	p := new(QuoteType)
+	*p = x
+	return p
+}
+
+func (x QuoteType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuoteType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rfqrpc_rfq_proto_enumTypes[2].Descriptor()
+}
+
+func (QuoteType) Type() protoreflect.EnumType {
+	return &file_rfqrpc_rfq_proto_enumTypes[2]
+}
+
+func (x QuoteType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuoteType.Descriptor instead.
+func (QuoteType) EnumDescriptor() ([]byte, []int) {
+	return file_rfqrpc_rfq_proto_rawDescGZIP(), []int{2}
+}
+
 type AssetSpecifier struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -828,6 +937,13 @@ type PeerAcceptedBuyQuote struct {
 	AskPrice uint64 `protobuf:"varint,5,opt,name=ask_price,json=askPrice,proto3" json:"ask_price,omitempty"`
 	// The unix timestamp in seconds after which the quote is no longer valid.
 	Expiry uint64 `protobuf:"varint,6,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// signature is the BIP-340 Schnorr signature of the accepting peer's node
+	// key over the SHA256 hash of signed_msg.
+	Signature []byte `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	// signed_msg is the serialized quote the signature commits to. Together
+	// with the peer's public key it can be used to prove the quote terms to a
+	// third party.
+	SignedMsg []byte `protobuf:"bytes,8,opt,name=signed_msg,json=signedMsg,proto3" json:"signed_msg,omitempty"`
 }
 
 func (x *PeerAcceptedBuyQuote) Reset() {
@@ -904,6 +1020,20 @@ func (x *PeerAcceptedBuyQuote) GetExpiry() uint64 {
 	return 0
 }
 
+func (x *PeerAcceptedBuyQuote) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *PeerAcceptedBuyQuote) GetSignedMsg() []byte {
+	if x != nil {
+		return x.SignedMsg
+	}
+	return nil
+}
+
 type PeerAcceptedSellQuote struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -922,6 +1052,13 @@ type PeerAcceptedSellQuote struct {
 	BidPrice uint64 `protobuf:"varint,5,opt,name=bid_price,json=bidPrice,proto3" json:"bid_price,omitempty"`
 	// The unix timestamp in seconds after which the quote is no longer valid.
 	Expiry uint64 `protobuf:"varint,6,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// signature is the BIP-340 Schnorr signature of the accepting peer's node
+	// key over the SHA256 hash of signed_msg.
+	Signature []byte `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	// signed_msg is the serialized quote the signature commits to. Together
+	// with the peer's public key it can be used to prove the quote terms to a
+	// third party.
+	SignedMsg []byte `protobuf:"bytes,8,opt,name=signed_msg,json=signedMsg,proto3" json:"signed_msg,omitempty"`
 }
 
 func (x *PeerAcceptedSellQuote) Reset() {
@@ -998,6 +1135,20 @@ func (x *PeerAcceptedSellQuote) GetExpiry() uint64 {
 	return 0
 }
 
+func (x *PeerAcceptedSellQuote) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *PeerAcceptedSellQuote) GetSignedMsg() []byte {
+	if x != nil {
+		return x.SignedMsg
+	}
+	return nil
+}
+
 // InvalidQuoteResponse is a message that is returned when a quote response is
 // invalid or insufficient.
 type InvalidQuoteResponse struct {
@@ -1202,14 +1353,38 @@ func (x *QueryPeerAcceptedQuotesResponse) GetSellQuotes() []*PeerAcceptedSellQuo
 	return nil
 }
 
-type SubscribeRfqEventNtfnsRequest struct {
+type QueryRateTicksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *SubscribeRfqEventNtfnsRequest) Reset() {
-	*x = SubscribeRfqEventNtfnsRequest{}
+	// asset_specifier is the optional asset to query the rate ticks for. If
+	// not set, the rate ticks of all assets are returned.
+	AssetSpecifier *AssetSpecifier `protobuf:"bytes,1,opt,name=asset_specifier,json=assetSpecifier,proto3" json:"asset_specifier,omitempty"`
+	// tick_type is the optional type of rate ticks to return.
+	TickType RateTickType `protobuf:"varint,2,opt,name=tick_type,json=tickType,proto3,enum=rfqrpc.RateTickType" json:"tick_type,omitempty"`
+	// start_timestamp is the optional unix timestamp in seconds of the
+	// earliest rate tick to return.
+	StartTimestamp uint64 `protobuf:"varint,3,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// end_timestamp is the optional unix timestamp in seconds of the latest
+	// rate tick to return.
+	EndTimestamp uint64 `protobuf:"varint,4,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	// limit is the maximum number of rate ticks to return. If zero, a default
+	// limit is applied.
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// valid_at_timestamp is an optional unix timestamp in seconds. If set,
+	// only the most recent rate tick that was valid at that time is returned,
+	// which can be used to audit a quote after the fact. This requires the
+	// asset_specifier and tick_type to be set.
+	ValidAtTimestamp uint64 `protobuf:"varint,6,opt,name=valid_at_timestamp,json=validAtTimestamp,proto3" json:"valid_at_timestamp,omitempty"`
+	// asset_amount is the optional asset amount the price oracle was queried
+	// for. The price oracle can return different rates for different amounts,
+	// so this should be set to the amount of a quote to audit it.
+	AssetAmount uint64 `protobuf:"varint,7,opt,name=asset_amount,json=assetAmount,proto3" json:"asset_amount,omitempty"`
+}
+
+func (x *QueryRateTicksRequest) Reset() {
+	*x = QueryRateTicksRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rfqrpc_rfq_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1217,13 +1392,13 @@ func (x *SubscribeRfqEventNtfnsRequest) Reset() {
 	}
 }
 
-func (x *SubscribeRfqEventNtfnsRequest) String() string {
+func (x *QueryRateTicksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeRfqEventNtfnsRequest) ProtoMessage() {}
+func (*QueryRateTicksRequest) ProtoMessage() {}
 
-func (x *SubscribeRfqEventNtfnsRequest) ProtoReflect() protoreflect.Message {
+func (x *QueryRateTicksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rfqrpc_rfq_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1235,24 +1410,85 @@ func (x *SubscribeRfqEventNtfnsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeRfqEventNtfnsRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeRfqEventNtfnsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueryRateTicksRequest.ProtoReflect.Descriptor instead.
+func (*QueryRateTicksRequest) Descriptor() ([]byte, []int) {
 	return file_rfqrpc_rfq_proto_rawDescGZIP(), []int{15}
 }
 
-type PeerAcceptedBuyQuoteEvent struct {
+func (x *QueryRateTicksRequest) GetAssetSpecifier() *AssetSpecifier {
+	if x != nil {
+		return x.AssetSpecifier
+	}
+	return nil
+}
+
+func (x *QueryRateTicksRequest) GetTickType() RateTickType {
+	if x != nil {
+		return x.TickType
+	}
+	return RateTickType_RATE_TICK_TYPE_UNSPECIFIED
+}
+
+func (x *QueryRateTicksRequest) GetStartTimestamp() uint64 {
+	if x != nil {
+		return x.StartTimestamp
+	}
+	return 0
+}
+
+func (x *QueryRateTicksRequest) GetEndTimestamp() uint64 {
+	if x != nil {
+		return x.EndTimestamp
+	}
+	return 0
+}
+
+func (x *QueryRateTicksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueryRateTicksRequest) GetValidAtTimestamp() uint64 {
+	if x != nil {
+		return x.ValidAtTimestamp
+	}
+	return 0
+}
+
+func (x *QueryRateTicksRequest) GetAssetAmount() uint64 {
+	if x != nil {
+		return x.AssetAmount
+	}
+	return 0
+}
+
+type RateTick struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Unix timestamp in microseconds.
-	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// The asset buy quote that was accepted by out peer.
-	PeerAcceptedBuyQuote *PeerAcceptedBuyQuote `protobuf:"bytes,2,opt,name=peer_accepted_buy_quote,json=peerAcceptedBuyQuote,proto3" json:"peer_accepted_buy_quote,omitempty"`
+	// tick_type is the type of the rate tick.
+	TickType RateTickType `protobuf:"varint,1,opt,name=tick_type,json=tickType,proto3,enum=rfqrpc.RateTickType" json:"tick_type,omitempty"`
+	// asset_id is the ID of the asset the rate tick is for, if known.
+	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// group_key is the group key of the asset the rate tick is for, if known.
+	GroupKey []byte `protobuf:"bytes,3,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+	// asset_amount is the asset amount the price oracle was queried for.
+	AssetAmount uint64 `protobuf:"varint,4,opt,name=asset_amount,json=assetAmount,proto3" json:"asset_amount,omitempty"`
+	// rate is the price in milli-satoshi per asset unit.
+	Rate uint64 `protobuf:"varint,5,opt,name=rate,proto3" json:"rate,omitempty"`
+	// The unix timestamp in seconds after which the rate tick is no longer
+	// valid.
+	Expiry uint64 `protobuf:"varint,6,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// The unix timestamp in seconds at which the rate tick was received from
+	// the price oracle.
+	Timestamp uint64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *PeerAcceptedBuyQuoteEvent) Reset() {
-	*x = PeerAcceptedBuyQuoteEvent{}
+func (x *RateTick) Reset() {
+	*x = RateTick{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rfqrpc_rfq_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1260,13 +1496,13 @@ func (x *PeerAcceptedBuyQuoteEvent) Reset() {
 	}
 }
 
-func (x *PeerAcceptedBuyQuoteEvent) String() string {
+func (x *RateTick) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeerAcceptedBuyQuoteEvent) ProtoMessage() {}
+func (*RateTick) ProtoMessage() {}
 
-func (x *PeerAcceptedBuyQuoteEvent) ProtoReflect() protoreflect.Message {
+func (x *RateTick) ProtoReflect() protoreflect.Message {
 	mi := &file_rfqrpc_rfq_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1278,38 +1514,72 @@ func (x *PeerAcceptedBuyQuoteEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeerAcceptedBuyQuoteEvent.ProtoReflect.Descriptor instead.
-func (*PeerAcceptedBuyQuoteEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use RateTick.ProtoReflect.Descriptor instead.
+func (*RateTick) Descriptor() ([]byte, []int) {
 	return file_rfqrpc_rfq_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *PeerAcceptedBuyQuoteEvent) GetTimestamp() uint64 {
+func (x *RateTick) GetTickType() RateTickType {
 	if x != nil {
-		return x.Timestamp
+		return x.TickType
 	}
-	return 0
+	return RateTickType_RATE_TICK_TYPE_UNSPECIFIED
 }
 
-func (x *PeerAcceptedBuyQuoteEvent) GetPeerAcceptedBuyQuote() *PeerAcceptedBuyQuote {
+func (x *RateTick) GetAssetId() []byte {
 	if x != nil {
-		return x.PeerAcceptedBuyQuote
+		return x.AssetId
 	}
 	return nil
 }
 
-type PeerAcceptedSellQuoteEvent struct {
+func (x *RateTick) GetGroupKey() []byte {
+	if x != nil {
+		return x.GroupKey
+	}
+	return nil
+}
+
+func (x *RateTick) GetAssetAmount() uint64 {
+	if x != nil {
+		return x.AssetAmount
+	}
+	return 0
+}
+
+func (x *RateTick) GetRate() uint64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *RateTick) GetExpiry() uint64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *RateTick) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type QueryRateTicksResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Unix timestamp in microseconds.
-	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// The asset sell quote that was accepted by out peer.
-	PeerAcceptedSellQuote *PeerAcceptedSellQuote `protobuf:"bytes,2,opt,name=peer_accepted_sell_quote,json=peerAcceptedSellQuote,proto3" json:"peer_accepted_sell_quote,omitempty"`
+	// rate_ticks is the list of rate ticks, ordered from the most recent to
+	// the oldest.
+	RateTicks []*RateTick `protobuf:"bytes,1,rep,name=rate_ticks,json=rateTicks,proto3" json:"rate_ticks,omitempty"`
 }
 
-func (x *PeerAcceptedSellQuoteEvent) Reset() {
-	*x = PeerAcceptedSellQuoteEvent{}
+func (x *QueryRateTicksResponse) Reset() {
+	*x = QueryRateTicksResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rfqrpc_rfq_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1317,13 +1587,13 @@ func (x *PeerAcceptedSellQuoteEvent) Reset() {
 	}
 }
 
-func (x *PeerAcceptedSellQuoteEvent) String() string {
+func (x *QueryRateTicksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeerAcceptedSellQuoteEvent) ProtoMessage() {}
+func (*QueryRateTicksResponse) ProtoMessage() {}
 
-func (x *PeerAcceptedSellQuoteEvent) ProtoReflect() protoreflect.Message {
+func (x *QueryRateTicksResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rfqrpc_rfq_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1335,39 +1605,43 @@ func (x *PeerAcceptedSellQuoteEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeerAcceptedSellQuoteEvent.ProtoReflect.Descriptor instead.
-func (*PeerAcceptedSellQuoteEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueryRateTicksResponse.ProtoReflect.Descriptor instead.
+func (*QueryRateTicksResponse) Descriptor() ([]byte, []int) {
 	return file_rfqrpc_rfq_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *PeerAcceptedSellQuoteEvent) GetTimestamp() uint64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
-}
-
-func (x *PeerAcceptedSellQuoteEvent) GetPeerAcceptedSellQuote() *PeerAcceptedSellQuote {
+func (x *QueryRateTicksResponse) GetRateTicks() []*RateTick {
 	if x != nil {
-		return x.PeerAcceptedSellQuote
+		return x.RateTicks
 	}
 	return nil
 }
 
-type AcceptHtlcEvent struct {
+type QueryQuoteHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Unix timestamp in microseconds.
-	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// scid is the short channel ID of the channel over which the payment for
-	// the quote is made.
-	Scid uint64 `protobuf:"varint,2,opt,name=scid,proto3" json:"scid,omitempty"`
-}
-
-func (x *AcceptHtlcEvent) Reset() {
-	*x = AcceptHtlcEvent{}
+	// asset_specifier is the optional asset to query the quotes for. If not
+	// set, the quotes of all assets are returned.
+	AssetSpecifier *AssetSpecifier `protobuf:"bytes,1,opt,name=asset_specifier,json=assetSpecifier,proto3" json:"asset_specifier,omitempty"`
+	// peer is the optional public key of the peer to query the quotes for.
+	Peer []byte `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	// start_timestamp is the optional unix timestamp in seconds of the
+	// earliest quote to return.
+	StartTimestamp uint64 `protobuf:"varint,3,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// end_timestamp is the optional unix timestamp in seconds of the latest
+	// quote to return.
+	EndTimestamp uint64 `protobuf:"varint,4,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	// limit is the maximum number of quotes to return. If zero, a default
+	// limit is applied.
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// offset is the number of quotes to skip.
+	Offset int32 `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *QueryQuoteHistoryRequest) Reset() {
+	*x = QueryQuoteHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rfqrpc_rfq_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1375,13 +1649,13 @@ func (x *AcceptHtlcEvent) Reset() {
 	}
 }
 
-func (x *AcceptHtlcEvent) String() string {
+func (x *QueryQuoteHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptHtlcEvent) ProtoMessage() {}
+func (*QueryQuoteHistoryRequest) ProtoMessage() {}
 
-func (x *AcceptHtlcEvent) ProtoReflect() protoreflect.Message {
+func (x *QueryQuoteHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rfqrpc_rfq_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1393,40 +1667,74 @@ func (x *AcceptHtlcEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptHtlcEvent.ProtoReflect.Descriptor instead.
-func (*AcceptHtlcEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueryQuoteHistoryRequest.ProtoReflect.Descriptor instead.
+func (*QueryQuoteHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_rfqrpc_rfq_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *AcceptHtlcEvent) GetTimestamp() uint64 {
+func (x *QueryQuoteHistoryRequest) GetAssetSpecifier() *AssetSpecifier {
 	if x != nil {
-		return x.Timestamp
+		return x.AssetSpecifier
+	}
+	return nil
+}
+
+func (x *QueryQuoteHistoryRequest) GetPeer() []byte {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+func (x *QueryQuoteHistoryRequest) GetStartTimestamp() uint64 {
+	if x != nil {
+		return x.StartTimestamp
 	}
 	return 0
 }
 
-func (x *AcceptHtlcEvent) GetScid() uint64 {
+func (x *QueryQuoteHistoryRequest) GetEndTimestamp() uint64 {
 	if x != nil {
-		return x.Scid
+		return x.EndTimestamp
 	}
 	return 0
 }
 
-type RfqEvent struct {
+func (x *QueryQuoteHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueryQuoteHistoryRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type HtlcFill struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Event:
-	//
-	//	*RfqEvent_PeerAcceptedBuyQuote
-	//	*RfqEvent_PeerAcceptedSellQuote
-	//	*RfqEvent_AcceptHtlc
-	Event isRfqEvent_Event `protobuf_oneof:"event"`
-}
-
-func (x *RfqEvent) Reset() {
-	*x = RfqEvent{}
+	// incoming_chan_id is the SCID of the channel the HTLC came in through.
+	IncomingChanId uint64 `protobuf:"varint,1,opt,name=incoming_chan_id,json=incomingChanId,proto3" json:"incoming_chan_id,omitempty"`
+	// incoming_htlc_id is the ID of the HTLC on the incoming channel.
+	IncomingHtlcId uint64 `protobuf:"varint,2,opt,name=incoming_htlc_id,json=incomingHtlcId,proto3" json:"incoming_htlc_id,omitempty"`
+	// outgoing_chan_id is the SCID of the channel the HTLC was forwarded to.
+	OutgoingChanId uint64 `protobuf:"varint,3,opt,name=outgoing_chan_id,json=outgoingChanId,proto3" json:"outgoing_chan_id,omitempty"`
+	// amount_in_msat is the incoming amount of the HTLC.
+	AmountInMsat uint64 `protobuf:"varint,4,opt,name=amount_in_msat,json=amountInMsat,proto3" json:"amount_in_msat,omitempty"`
+	// amount_out_msat is the outgoing amount of the HTLC.
+	AmountOutMsat uint64 `protobuf:"varint,5,opt,name=amount_out_msat,json=amountOutMsat,proto3" json:"amount_out_msat,omitempty"`
+	// The unix timestamp in seconds at which the HTLC was accepted.
+	Timestamp uint64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *HtlcFill) Reset() {
+	*x = HtlcFill{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rfqrpc_rfq_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1434,13 +1742,13 @@ func (x *RfqEvent) Reset() {
 	}
 }
 
-func (x *RfqEvent) String() string {
+func (x *HtlcFill) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RfqEvent) ProtoMessage() {}
+func (*HtlcFill) ProtoMessage() {}
 
-func (x *RfqEvent) ProtoReflect() protoreflect.Message {
+func (x *HtlcFill) ProtoReflect() protoreflect.Message {
 	mi := &file_rfqrpc_rfq_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1452,21 +1760,547 @@ func (x *RfqEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RfqEvent.ProtoReflect.Descriptor instead.
-func (*RfqEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use HtlcFill.ProtoReflect.Descriptor instead.
+func (*HtlcFill) Descriptor() ([]byte, []int) {
 	return file_rfqrpc_rfq_proto_rawDescGZIP(), []int{19}
 }
 
-func (m *RfqEvent) GetEvent() isRfqEvent_Event {
-	if m != nil {
-		return m.Event
+func (x *HtlcFill) GetIncomingChanId() uint64 {
+	if x != nil {
+		return x.IncomingChanId
 	}
-	return nil
+	return 0
 }
 
-func (x *RfqEvent) GetPeerAcceptedBuyQuote() *PeerAcceptedBuyQuoteEvent {
-	if x, ok := x.GetEvent().(*RfqEvent_PeerAcceptedBuyQuote); ok {
-		return x.PeerAcceptedBuyQuote
+func (x *HtlcFill) GetIncomingHtlcId() uint64 {
+	if x != nil {
+		return x.IncomingHtlcId
+	}
+	return 0
+}
+
+func (x *HtlcFill) GetOutgoingChanId() uint64 {
+	if x != nil {
+		return x.OutgoingChanId
+	}
+	return 0
+}
+
+func (x *HtlcFill) GetAmountInMsat() uint64 {
+	if x != nil {
+		return x.AmountInMsat
+	}
+	return 0
+}
+
+func (x *HtlcFill) GetAmountOutMsat() uint64 {
+	if x != nil {
+		return x.AmountOutMsat
+	}
+	return 0
+}
+
+func (x *HtlcFill) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type HistoricQuote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique identifier of the quote request.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// scid is the short channel ID alias of the quote.
+	Scid uint64 `protobuf:"varint,2,opt,name=scid,proto3" json:"scid,omitempty"`
+	// peer is the public key of the quote counterparty.
+	Peer string `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer,omitempty"`
+	// quote_type is the type of the quote.
+	QuoteType QuoteType `protobuf:"varint,4,opt,name=quote_type,json=quoteType,proto3,enum=rfqrpc.QuoteType" json:"quote_type,omitempty"`
+	// locally_accepted is true if the quote was requested by the peer and
+	// accepted by our node, and false if it was requested by our node and
+	// accepted by the peer.
+	LocallyAccepted bool `protobuf:"varint,5,opt,name=locally_accepted,json=locallyAccepted,proto3" json:"locally_accepted,omitempty"`
+	// asset_id is the ID of the subject asset of the quote, if known.
+	AssetId []byte `protobuf:"bytes,6,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// group_key is the group key of the subject asset of the quote, if known.
+	GroupKey []byte `protobuf:"bytes,7,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+	// asset_amount is the asset amount of the quote request.
+	AssetAmount uint64 `protobuf:"varint,8,opt,name=asset_amount,json=assetAmount,proto3" json:"asset_amount,omitempty"`
+	// request_price is the price in milli-satoshi per asset unit that was
+	// suggested in the quote request.
+	RequestPrice uint64 `protobuf:"varint,9,opt,name=request_price,json=requestPrice,proto3" json:"request_price,omitempty"`
+	// accepted_price is the price in milli-satoshi per asset unit that was
+	// accepted.
+	AcceptedPrice uint64 `protobuf:"varint,10,opt,name=accepted_price,json=acceptedPrice,proto3" json:"accepted_price,omitempty"`
+	// The unix timestamp in seconds after which the quote is no longer valid.
+	Expiry uint64 `protobuf:"varint,11,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// The unix timestamp in seconds at which the quote was accepted.
+	Timestamp uint64 `protobuf:"varint,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// htlc_fills is the list of HTLCs that were accepted against the quote.
+	HtlcFills []*HtlcFill `protobuf:"bytes,13,rep,name=htlc_fills,json=htlcFills,proto3" json:"htlc_fills,omitempty"`
+	// signature is the BIP-340 Schnorr signature of the accepting node's key
+	// over the SHA256 hash of signed_msg. It is empty for quotes that were
+	// accepted without a signature.
+	Signature []byte `protobuf:"bytes,14,opt,name=signature,proto3" json:"signature,omitempty"`
+	// signed_msg is the serialized quote the signature commits to.
+	SignedMsg []byte `protobuf:"bytes,15,opt,name=signed_msg,json=signedMsg,proto3" json:"signed_msg,omitempty"`
+}
+
+func (x *HistoricQuote) Reset() {
+	*x = HistoricQuote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rfqrpc_rfq_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoricQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoricQuote) ProtoMessage() {}
+
+func (x *HistoricQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_rfqrpc_rfq_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoricQuote.ProtoReflect.Descriptor instead.
+func (*HistoricQuote) Descriptor() ([]byte, []int) {
+	return file_rfqrpc_rfq_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *HistoricQuote) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *HistoricQuote) GetScid() uint64 {
+	if x != nil {
+		return x.Scid
+	}
+	return 0
+}
+
+func (x *HistoricQuote) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *HistoricQuote) GetQuoteType() QuoteType {
+	if x != nil {
+		return x.QuoteType
+	}
+	return QuoteType_QUOTE_TYPE_BUY
+}
+
+func (x *HistoricQuote) GetLocallyAccepted() bool {
+	if x != nil {
+		return x.LocallyAccepted
+	}
+	return false
+}
+
+func (x *HistoricQuote) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *HistoricQuote) GetGroupKey() []byte {
+	if x != nil {
+		return x.GroupKey
+	}
+	return nil
+}
+
+func (x *HistoricQuote) GetAssetAmount() uint64 {
+	if x != nil {
+		return x.AssetAmount
+	}
+	return 0
+}
+
+func (x *HistoricQuote) GetRequestPrice() uint64 {
+	if x != nil {
+		return x.RequestPrice
+	}
+	return 0
+}
+
+func (x *HistoricQuote) GetAcceptedPrice() uint64 {
+	if x != nil {
+		return x.AcceptedPrice
+	}
+	return 0
+}
+
+func (x *HistoricQuote) GetExpiry() uint64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *HistoricQuote) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *HistoricQuote) GetHtlcFills() []*HtlcFill {
+	if x != nil {
+		return x.HtlcFills
+	}
+	return nil
+}
+
+func (x *HistoricQuote) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *HistoricQuote) GetSignedMsg() []byte {
+	if x != nil {
+		return x.SignedMsg
+	}
+	return nil
+}
+
+type QueryQuoteHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// quotes is the list of quotes, ordered from the most recent to the
+	// oldest.
+	Quotes []*HistoricQuote `protobuf:"bytes,1,rep,name=quotes,proto3" json:"quotes,omitempty"`
+}
+
+func (x *QueryQuoteHistoryResponse) Reset() {
+	*x = QueryQuoteHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rfqrpc_rfq_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryQuoteHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryQuoteHistoryResponse) ProtoMessage() {}
+
+func (x *QueryQuoteHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rfqrpc_rfq_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryQuoteHistoryResponse.ProtoReflect.Descriptor instead.
+func (*QueryQuoteHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_rfqrpc_rfq_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *QueryQuoteHistoryResponse) GetQuotes() []*HistoricQuote {
+	if x != nil {
+		return x.Quotes
+	}
+	return nil
+}
+
+type SubscribeRfqEventNtfnsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribeRfqEventNtfnsRequest) Reset() {
+	*x = SubscribeRfqEventNtfnsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rfqrpc_rfq_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeRfqEventNtfnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRfqEventNtfnsRequest) ProtoMessage() {}
+
+func (x *SubscribeRfqEventNtfnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rfqrpc_rfq_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRfqEventNtfnsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRfqEventNtfnsRequest) Descriptor() ([]byte, []int) {
+	return file_rfqrpc_rfq_proto_rawDescGZIP(), []int{22}
+}
+
+type PeerAcceptedBuyQuoteEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unix timestamp in microseconds.
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The asset buy quote that was accepted by out peer.
+	PeerAcceptedBuyQuote *PeerAcceptedBuyQuote `protobuf:"bytes,2,opt,name=peer_accepted_buy_quote,json=peerAcceptedBuyQuote,proto3" json:"peer_accepted_buy_quote,omitempty"`
+}
+
+func (x *PeerAcceptedBuyQuoteEvent) Reset() {
+	*x = PeerAcceptedBuyQuoteEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rfqrpc_rfq_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerAcceptedBuyQuoteEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerAcceptedBuyQuoteEvent) ProtoMessage() {}
+
+func (x *PeerAcceptedBuyQuoteEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rfqrpc_rfq_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerAcceptedBuyQuoteEvent.ProtoReflect.Descriptor instead.
+func (*PeerAcceptedBuyQuoteEvent) Descriptor() ([]byte, []int) {
+	return file_rfqrpc_rfq_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PeerAcceptedBuyQuoteEvent) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *PeerAcceptedBuyQuoteEvent) GetPeerAcceptedBuyQuote() *PeerAcceptedBuyQuote {
+	if x != nil {
+		return x.PeerAcceptedBuyQuote
+	}
+	return nil
+}
+
+type PeerAcceptedSellQuoteEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unix timestamp in microseconds.
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The asset sell quote that was accepted by out peer.
+	PeerAcceptedSellQuote *PeerAcceptedSellQuote `protobuf:"bytes,2,opt,name=peer_accepted_sell_quote,json=peerAcceptedSellQuote,proto3" json:"peer_accepted_sell_quote,omitempty"`
+}
+
+func (x *PeerAcceptedSellQuoteEvent) Reset() {
+	*x = PeerAcceptedSellQuoteEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rfqrpc_rfq_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerAcceptedSellQuoteEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerAcceptedSellQuoteEvent) ProtoMessage() {}
+
+func (x *PeerAcceptedSellQuoteEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rfqrpc_rfq_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerAcceptedSellQuoteEvent.ProtoReflect.Descriptor instead.
+func (*PeerAcceptedSellQuoteEvent) Descriptor() ([]byte, []int) {
+	return file_rfqrpc_rfq_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PeerAcceptedSellQuoteEvent) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *PeerAcceptedSellQuoteEvent) GetPeerAcceptedSellQuote() *PeerAcceptedSellQuote {
+	if x != nil {
+		return x.PeerAcceptedSellQuote
+	}
+	return nil
+}
+
+type AcceptHtlcEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unix timestamp in microseconds.
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// scid is the short channel ID of the channel over which the payment for
+	// the quote is made.
+	Scid uint64 `protobuf:"varint,2,opt,name=scid,proto3" json:"scid,omitempty"`
+}
+
+func (x *AcceptHtlcEvent) Reset() {
+	*x = AcceptHtlcEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rfqrpc_rfq_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptHtlcEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptHtlcEvent) ProtoMessage() {}
+
+func (x *AcceptHtlcEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rfqrpc_rfq_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptHtlcEvent.ProtoReflect.Descriptor instead.
+func (*AcceptHtlcEvent) Descriptor() ([]byte, []int) {
+	return file_rfqrpc_rfq_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AcceptHtlcEvent) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *AcceptHtlcEvent) GetScid() uint64 {
+	if x != nil {
+		return x.Scid
+	}
+	return 0
+}
+
+type RfqEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//
+	//	*RfqEvent_PeerAcceptedBuyQuote
+	//	*RfqEvent_PeerAcceptedSellQuote
+	//	*RfqEvent_AcceptHtlc
+	Event isRfqEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *RfqEvent) Reset() {
+	*x = RfqEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rfqrpc_rfq_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RfqEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RfqEvent) ProtoMessage() {}
+
+func (x *RfqEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rfqrpc_rfq_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RfqEvent.ProtoReflect.Descriptor instead.
+func (*RfqEvent) Descriptor() ([]byte, []int) {
+	return file_rfqrpc_rfq_proto_rawDescGZIP(), []int{26}
+}
+
+func (m *RfqEvent) GetEvent() isRfqEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *RfqEvent) GetPeerAcceptedBuyQuote() *PeerAcceptedBuyQuoteEvent {
+	if x, ok := x.GetEvent().(*RfqEvent_PeerAcceptedBuyQuote); ok {
+		return x.PeerAcceptedBuyQuote
 	}
 	return nil
 }
@@ -1612,7 +2446,7 @@ var file_rfqrpc_rfq_proto_rawDesc = []byte{
 	0x41, 0x64, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x79, 0x4f, 0x66, 0x66, 0x65, 0x72,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72,
 	0x79, 0x50, 0x65, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x51, 0x75, 0x6f,
-	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa6, 0x01, 0x0a, 0x14, 0x50,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe3, 0x01, 0x0a, 0x14, 0x50,
 	0x65, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x42, 0x75, 0x79, 0x51, 0x75,
 	0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
@@ -1623,42 +2457,155 @@ var file_rfqrpc_rfq_proto_rawDesc = []byte{
 	0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
 	0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65,
 	0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x79, 0x22, 0xa7, 0x01, 0x0a, 0x15, 0x50, 0x65, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65,
-	0x70, 0x74, 0x65, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a,
+	0x69, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x22, 0xe4, 0x01, 0x0a, 0x15, 0x50, 0x65, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x53, 0x65, 0x6c, 0x6c, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x63,
+	0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x69, 0x64, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x6b, 0x0a, 0x14, 0x49, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x65, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x7f, 0x0a, 0x15, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
 	0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x65,
 	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x04, 0x73, 0x63, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x64, 0x5f,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x69, 0x64,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x6b, 0x0a,
-	0x14, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x51,
-	0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7f, 0x0a, 0x15, 0x52, 0x65,
-	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x1f,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
-	0x64, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3b, 0x0a, 0x0a, 0x62, 0x75, 0x79, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x65, 0x65,
-	0x72, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x42, 0x75, 0x79, 0x51, 0x75, 0x6f, 0x74,
-	0x65, 0x52, 0x09, 0x62, 0x75, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0b,
-	0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x41,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x51, 0x75, 0x6f, 0x74, 0x65,
-	0x52, 0x0a, 0x73, 0x65, 0x6c, 0x6c, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x1f, 0x0a, 0x1d,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x65, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x62, 0x75, 0x79,
+	0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x65, 0x64, 0x42, 0x75, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x09, 0x62, 0x75, 0x79,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x71,
+	0x75, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x66,
+	0x71, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x53, 0x65, 0x6c, 0x6c, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x6c,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x22, 0xc0, 0x02, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3f, 0x0a, 0x0f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x66, 0x71, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x31, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x23, 0x0a,
+	0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x5f, 0x61, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe2, 0x01, 0x0a, 0x08, 0x52, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x31, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x66, 0x71, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x08, 0x74, 0x69, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65,
+	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x49,
+	0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72,
+	0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x09,
+	0x72, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x22, 0xeb, 0x01, 0x0a, 0x18, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x0f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xf4, 0x01, 0x0a, 0x08, 0x48, 0x74, 0x6c, 0x63,
+	0x46, 0x69, 0x6c, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x28,
+	0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69,
+	0x6e, 0x67, 0x48, 0x74, 0x6c, 0x63, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x67,
+	0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f,
+	0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x6e, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x4d, 0x73, 0x61, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xef,
+	0x03, 0x0a, 0x0d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x73, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x0a, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x72,
+	0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x09, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x6c, 0x79, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x2f, 0x0a, 0x0a, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x73,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e,
+	0x48, 0x74, 0x6c, 0x63, 0x46, 0x69, 0x6c, 0x6c, 0x52, 0x09, 0x68, 0x74, 0x6c, 0x63, 0x46, 0x69,
+	0x6c, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x22, 0x4a, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x06, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x1f, 0x0a, 0x1d,
 	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x66, 0x71, 0x45, 0x76, 0x65, 0x6e,
 	0x74, 0x4e, 0x74, 0x66, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8e, 0x01,
 	0x0a, 0x19, 0x50, 0x65, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x42, 0x75,
@@ -1700,51 +2647,73 @@ var file_rfqrpc_rfq_proto_rawDesc = []byte{
 	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x41,
 	0x63, 0x63, 0x65, 0x70, 0x74, 0x48, 0x74, 0x6c, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
 	0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x48, 0x74, 0x6c, 0x63, 0x42, 0x07, 0x0a, 0x05,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x2a, 0x58, 0x0a, 0x0f, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x2a, 0x6f, 0x0a, 0x0f, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65,
 	0x73, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x56, 0x41,
 	0x4c, 0x49, 0x44, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x49, 0x43, 0x4b, 0x10, 0x00, 0x12,
 	0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52,
 	0x59, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x4f, 0x52, 0x41,
-	0x43, 0x4c, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x02, 0x32,
-	0xa8, 0x04, 0x0a, 0x03, 0x52, 0x66, 0x71, 0x12, 0x55, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x42, 0x75, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x72, 0x66,
-	0x71, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x79,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72,
-	0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75,
-	0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58,
-	0x0a, 0x11, 0x41, 0x64, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x43, 0x4c, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x02, 0x12,
+	0x15, 0x0a, 0x11, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41,
+	0x54, 0x55, 0x52, 0x45, 0x10, 0x03, 0x2a, 0x5e, 0x0a, 0x0c, 0x52, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x54,
+	0x49, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x54,
+	0x49, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x53, 0x4b, 0x10, 0x01, 0x12, 0x16,
+	0x0a, 0x12, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x49, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x42, 0x49, 0x44, 0x10, 0x02, 0x2a, 0x34, 0x0a, 0x09, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x51, 0x55, 0x4f, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x42, 0x55, 0x59, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x51, 0x55, 0x4f, 0x54, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x4c, 0x10, 0x01, 0x32, 0xd3, 0x05, 0x0a,
+	0x03, 0x52, 0x66, 0x71, 0x12, 0x55, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x42, 0x75, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x79, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x66, 0x71, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x79, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x41,
 	0x64, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x20, 0x2e,
-	0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53,
-	0x65, 0x6c, 0x6c, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75,
-	0x79, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e,
-	0x41, 0x64, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x79, 0x4f, 0x66, 0x66, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63,
-	0x2e, 0x41, 0x64, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x79, 0x4f, 0x66, 0x66, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x17, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x65, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x51, 0x75,
-	0x6f, 0x74, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x50, 0x65, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x51,
-	0x75, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72,
-	0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x65, 0x72, 0x41,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x52, 0x66, 0x71, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x74, 0x66, 0x6e, 0x73, 0x12,
-	0x25, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x52, 0x66, 0x71, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x74, 0x66, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e,
-	0x52, 0x66, 0x71, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69,
-	0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2d, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2f, 0x72, 0x66, 0x71,
-	0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x20, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x72, 0x66, 0x71,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x6c,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72,
+	0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x65,
+	0x6c, 0x6c, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x55, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x79, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x79, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64,
+	0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x79, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x65, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x73, 0x12, 0x26, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x65, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x66, 0x71, 0x72,
+	0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x65, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x63, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x61, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70,
+	0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x66, 0x71,
+	0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x66, 0x71, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x4e, 0x74, 0x66, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x66, 0x71, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x4e, 0x74, 0x66, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x66, 0x71, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x30, 0x01, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74,
+	0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2f, 0x72, 0x66, 0x71, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1759,67 +2728,88 @@ func file_rfqrpc_rfq_proto_rawDescGZIP() []byte {
 	return file_rfqrpc_rfq_proto_rawDescData
 }
 
-var file_rfqrpc_rfq_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_rfqrpc_rfq_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_rfqrpc_rfq_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_rfqrpc_rfq_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_rfqrpc_rfq_proto_goTypes = []interface{}{
 	(QuoteRespStatus)(0),                    // 0: rfqrpc.QuoteRespStatus
-	(*AssetSpecifier)(nil),                  // 1: rfqrpc.AssetSpecifier
-	(*AddAssetBuyOrderRequest)(nil),         // 2: rfqrpc.AddAssetBuyOrderRequest
-	(*AddAssetBuyOrderResponse)(nil),        // 3: rfqrpc.AddAssetBuyOrderResponse
-	(*AddAssetSellOrderRequest)(nil),        // 4: rfqrpc.AddAssetSellOrderRequest
-	(*AddAssetSellOrderResponse)(nil),       // 5: rfqrpc.AddAssetSellOrderResponse
-	(*AddAssetSellOfferRequest)(nil),        // 6: rfqrpc.AddAssetSellOfferRequest
-	(*AddAssetSellOfferResponse)(nil),       // 7: rfqrpc.AddAssetSellOfferResponse
-	(*AddAssetBuyOfferRequest)(nil),         // 8: rfqrpc.AddAssetBuyOfferRequest
-	(*AddAssetBuyOfferResponse)(nil),        // 9: rfqrpc.AddAssetBuyOfferResponse
-	(*QueryPeerAcceptedQuotesRequest)(nil),  // 10: rfqrpc.QueryPeerAcceptedQuotesRequest
-	(*PeerAcceptedBuyQuote)(nil),            // 11: rfqrpc.PeerAcceptedBuyQuote
-	(*PeerAcceptedSellQuote)(nil),           // 12: rfqrpc.PeerAcceptedSellQuote
-	(*InvalidQuoteResponse)(nil),            // 13: rfqrpc.InvalidQuoteResponse
-	(*RejectedQuoteResponse)(nil),           // 14: rfqrpc.RejectedQuoteResponse
-	(*QueryPeerAcceptedQuotesResponse)(nil), // 15: rfqrpc.QueryPeerAcceptedQuotesResponse
-	(*SubscribeRfqEventNtfnsRequest)(nil),   // 16: rfqrpc.SubscribeRfqEventNtfnsRequest
-	(*PeerAcceptedBuyQuoteEvent)(nil),       // 17: rfqrpc.PeerAcceptedBuyQuoteEvent
-	(*PeerAcceptedSellQuoteEvent)(nil),      // 18: rfqrpc.PeerAcceptedSellQuoteEvent
-	(*AcceptHtlcEvent)(nil),                 // 19: rfqrpc.AcceptHtlcEvent
-	(*RfqEvent)(nil),                        // 20: rfqrpc.RfqEvent
+	(RateTickType)(0),                       // 1: rfqrpc.RateTickType
+	(QuoteType)(0),                          // 2: rfqrpc.QuoteType
+	(*AssetSpecifier)(nil),                  // 3: rfqrpc.AssetSpecifier
+	(*AddAssetBuyOrderRequest)(nil),         // 4: rfqrpc.AddAssetBuyOrderRequest
+	(*AddAssetBuyOrderResponse)(nil),        // 5: rfqrpc.AddAssetBuyOrderResponse
+	(*AddAssetSellOrderRequest)(nil),        // 6: rfqrpc.AddAssetSellOrderRequest
+	(*AddAssetSellOrderResponse)(nil),       // 7: rfqrpc.AddAssetSellOrderResponse
+	(*AddAssetSellOfferRequest)(nil),        // 8: rfqrpc.AddAssetSellOfferRequest
+	(*AddAssetSellOfferResponse)(nil),       // 9: rfqrpc.AddAssetSellOfferResponse
+	(*AddAssetBuyOfferRequest)(nil),         // 10: rfqrpc.AddAssetBuyOfferRequest
+	(*AddAssetBuyOfferResponse)(nil),        // 11: rfqrpc.AddAssetBuyOfferResponse
+	(*QueryPeerAcceptedQuotesRequest)(nil),  // 12: rfqrpc.QueryPeerAcceptedQuotesRequest
+	(*PeerAcceptedBuyQuote)(nil),            // 13: rfqrpc.PeerAcceptedBuyQuote
+	(*PeerAcceptedSellQuote)(nil),           // 14: rfqrpc.PeerAcceptedSellQuote
+	(*InvalidQuoteResponse)(nil),            // 15: rfqrpc.InvalidQuoteResponse
+	(*RejectedQuoteResponse)(nil),           // 16: rfqrpc.RejectedQuoteResponse
+	(*QueryPeerAcceptedQuotesResponse)(nil), // 17: rfqrpc.QueryPeerAcceptedQuotesResponse
+	(*QueryRateTicksRequest)(nil),           // 18: rfqrpc.QueryRateTicksRequest
+	(*RateTick)(nil),                        // 19: rfqrpc.RateTick
+	(*QueryRateTicksResponse)(nil),          // 20: rfqrpc.QueryRateTicksResponse
+	(*QueryQuoteHistoryRequest)(nil),        // 21: rfqrpc.QueryQuoteHistoryRequest
+	(*HtlcFill)(nil),                        // 22: rfqrpc.HtlcFill
+	(*HistoricQuote)(nil),                   // 23: rfqrpc.HistoricQuote
+	(*QueryQuoteHistoryResponse)(nil),       // 24: rfqrpc.QueryQuoteHistoryResponse
+	(*SubscribeRfqEventNtfnsRequest)(nil),   // 25: rfqrpc.SubscribeRfqEventNtfnsRequest
+	(*PeerAcceptedBuyQuoteEvent)(nil),       // 26: rfqrpc.PeerAcceptedBuyQuoteEvent
+	(*PeerAcceptedSellQuoteEvent)(nil),      // 27: rfqrpc.PeerAcceptedSellQuoteEvent
+	(*AcceptHtlcEvent)(nil),                 // 28: rfqrpc.AcceptHtlcEvent
+	(*RfqEvent)(nil),                        // 29: rfqrpc.RfqEvent
 }
 var file_rfqrpc_rfq_proto_depIdxs = []int32{
-	1,  // 0: rfqrpc.AddAssetBuyOrderRequest.asset_specifier:type_name -> rfqrpc.AssetSpecifier
-	11, // 1: rfqrpc.AddAssetBuyOrderResponse.accepted_quote:type_name -> rfqrpc.PeerAcceptedBuyQuote
-	13, // 2: rfqrpc.AddAssetBuyOrderResponse.invalid_quote:type_name -> rfqrpc.InvalidQuoteResponse
-	14, // 3: rfqrpc.AddAssetBuyOrderResponse.rejected_quote:type_name -> rfqrpc.RejectedQuoteResponse
-	1,  // 4: rfqrpc.AddAssetSellOrderRequest.asset_specifier:type_name -> rfqrpc.AssetSpecifier
-	12, // 5: rfqrpc.AddAssetSellOrderResponse.accepted_quote:type_name -> rfqrpc.PeerAcceptedSellQuote
-	13, // 6: rfqrpc.AddAssetSellOrderResponse.invalid_quote:type_name -> rfqrpc.InvalidQuoteResponse
-	14, // 7: rfqrpc.AddAssetSellOrderResponse.rejected_quote:type_name -> rfqrpc.RejectedQuoteResponse
-	1,  // 8: rfqrpc.AddAssetSellOfferRequest.asset_specifier:type_name -> rfqrpc.AssetSpecifier
-	1,  // 9: rfqrpc.AddAssetBuyOfferRequest.asset_specifier:type_name -> rfqrpc.AssetSpecifier
+	3,  // 0: rfqrpc.AddAssetBuyOrderRequest.asset_specifier:type_name -> rfqrpc.AssetSpecifier
+	13, // 1: rfqrpc.AddAssetBuyOrderResponse.accepted_quote:type_name -> rfqrpc.PeerAcceptedBuyQuote
+	15, // 2: rfqrpc.AddAssetBuyOrderResponse.invalid_quote:type_name -> rfqrpc.InvalidQuoteResponse
+	16, // 3: rfqrpc.AddAssetBuyOrderResponse.rejected_quote:type_name -> rfqrpc.RejectedQuoteResponse
+	3,  // 4: rfqrpc.AddAssetSellOrderRequest.asset_specifier:type_name -> rfqrpc.AssetSpecifier
+	14, // 5: rfqrpc.AddAssetSellOrderResponse.accepted_quote:type_name -> rfqrpc.PeerAcceptedSellQuote
+	15, // 6: rfqrpc.AddAssetSellOrderResponse.invalid_quote:type_name -> rfqrpc.InvalidQuoteResponse
+	16, // 7: rfqrpc.AddAssetSellOrderResponse.rejected_quote:type_name -> rfqrpc.RejectedQuoteResponse
+	3,  // 8: rfqrpc.AddAssetSellOfferRequest.asset_specifier:type_name -> rfqrpc.AssetSpecifier
+	3,  // 9: rfqrpc.AddAssetBuyOfferRequest.asset_specifier:type_name -> rfqrpc.AssetSpecifier
 	0,  // 10: rfqrpc.InvalidQuoteResponse.status:type_name -> rfqrpc.QuoteRespStatus
-	11, // 11: rfqrpc.QueryPeerAcceptedQuotesResponse.buy_quotes:type_name -> rfqrpc.PeerAcceptedBuyQuote
-	12, // 12: rfqrpc.QueryPeerAcceptedQuotesResponse.sell_quotes:type_name -> rfqrpc.PeerAcceptedSellQuote
-	11, // 13: rfqrpc.PeerAcceptedBuyQuoteEvent.peer_accepted_buy_quote:type_name -> rfqrpc.PeerAcceptedBuyQuote
-	12, // 14: rfqrpc.PeerAcceptedSellQuoteEvent.peer_accepted_sell_quote:type_name -> rfqrpc.PeerAcceptedSellQuote
-	17, // 15: rfqrpc.RfqEvent.peer_accepted_buy_quote:type_name -> rfqrpc.PeerAcceptedBuyQuoteEvent
-	18, // 16: rfqrpc.RfqEvent.peer_accepted_sell_quote:type_name -> rfqrpc.PeerAcceptedSellQuoteEvent
-	19, // 17: rfqrpc.RfqEvent.accept_htlc:type_name -> rfqrpc.AcceptHtlcEvent
-	2,  // 18: rfqrpc.Rfq.AddAssetBuyOrder:input_type -> rfqrpc.AddAssetBuyOrderRequest
-	4,  // 19: rfqrpc.Rfq.AddAssetSellOrder:input_type -> rfqrpc.AddAssetSellOrderRequest
-	6,  // 20: rfqrpc.Rfq.AddAssetSellOffer:input_type -> rfqrpc.AddAssetSellOfferRequest
-	8,  // 21: rfqrpc.Rfq.AddAssetBuyOffer:input_type -> rfqrpc.AddAssetBuyOfferRequest
-	10, // 22: rfqrpc.Rfq.QueryPeerAcceptedQuotes:input_type -> rfqrpc.QueryPeerAcceptedQuotesRequest
-	16, // 23: rfqrpc.Rfq.SubscribeRfqEventNtfns:input_type -> rfqrpc.SubscribeRfqEventNtfnsRequest
-	3,  // 24: rfqrpc.Rfq.AddAssetBuyOrder:output_type -> rfqrpc.AddAssetBuyOrderResponse
-	5,  // 25: rfqrpc.Rfq.AddAssetSellOrder:output_type -> rfqrpc.AddAssetSellOrderResponse
-	7,  // 26: rfqrpc.Rfq.AddAssetSellOffer:output_type -> rfqrpc.AddAssetSellOfferResponse
-	9,  // 27: rfqrpc.Rfq.AddAssetBuyOffer:output_type -> rfqrpc.AddAssetBuyOfferResponse
-	15, // 28: rfqrpc.Rfq.QueryPeerAcceptedQuotes:output_type -> rfqrpc.QueryPeerAcceptedQuotesResponse
-	20, // 29: rfqrpc.Rfq.SubscribeRfqEventNtfns:output_type -> rfqrpc.RfqEvent
-	24, // [24:30] is the sub-list for method output_type
-	18, // [18:24] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	13, // 11: rfqrpc.QueryPeerAcceptedQuotesResponse.buy_quotes:type_name -> rfqrpc.PeerAcceptedBuyQuote
+	14, // 12: rfqrpc.QueryPeerAcceptedQuotesResponse.sell_quotes:type_name -> rfqrpc.PeerAcceptedSellQuote
+	3,  // 13: rfqrpc.QueryRateTicksRequest.asset_specifier:type_name -> rfqrpc.AssetSpecifier
+	1,  // 14: rfqrpc.QueryRateTicksRequest.tick_type:type_name -> rfqrpc.RateTickType
+	1,  // 15: rfqrpc.RateTick.tick_type:type_name -> rfqrpc.RateTickType
+	19, // 16: rfqrpc.QueryRateTicksResponse.rate_ticks:type_name -> rfqrpc.RateTick
+	3,  // 17: rfqrpc.QueryQuoteHistoryRequest.asset_specifier:type_name -> rfqrpc.AssetSpecifier
+	2,  // 18: rfqrpc.HistoricQuote.quote_type:type_name -> rfqrpc.QuoteType
+	22, // 19: rfqrpc.HistoricQuote.htlc_fills:type_name -> rfqrpc.HtlcFill
+	23, // 20: rfqrpc.QueryQuoteHistoryResponse.quotes:type_name -> rfqrpc.HistoricQuote
+	13, // 21: rfqrpc.PeerAcceptedBuyQuoteEvent.peer_accepted_buy_quote:type_name -> rfqrpc.PeerAcceptedBuyQuote
+	14, // 22: rfqrpc.PeerAcceptedSellQuoteEvent.peer_accepted_sell_quote:type_name -> rfqrpc.PeerAcceptedSellQuote
+	26, // 23: rfqrpc.RfqEvent.peer_accepted_buy_quote:type_name -> rfqrpc.PeerAcceptedBuyQuoteEvent
+	27, // 24: rfqrpc.RfqEvent.peer_accepted_sell_quote:type_name -> rfqrpc.PeerAcceptedSellQuoteEvent
+	28, // 25: rfqrpc.RfqEvent.accept_htlc:type_name -> rfqrpc.AcceptHtlcEvent
+	4,  // 26: rfqrpc.Rfq.AddAssetBuyOrder:input_type -> rfqrpc.AddAssetBuyOrderRequest
+	6,  // 27: rfqrpc.Rfq.AddAssetSellOrder:input_type -> rfqrpc.AddAssetSellOrderRequest
+	8,  // 28: rfqrpc.Rfq.AddAssetSellOffer:input_type -> rfqrpc.AddAssetSellOfferRequest
+	10, // 29: rfqrpc.Rfq.AddAssetBuyOffer:input_type -> rfqrpc.AddAssetBuyOfferRequest
+	12, // 30: rfqrpc.Rfq.QueryPeerAcceptedQuotes:input_type -> rfqrpc.QueryPeerAcceptedQuotesRequest
+	18, // 31: rfqrpc.Rfq.QueryRateTicks:input_type -> rfqrpc.QueryRateTicksRequest
+	21, // 32: rfqrpc.Rfq.QueryQuoteHistory:input_type -> rfqrpc.QueryQuoteHistoryRequest
+	25, // 33: rfqrpc.Rfq.SubscribeRfqEventNtfns:input_type -> rfqrpc.SubscribeRfqEventNtfnsRequest
+	5,  // 34: rfqrpc.Rfq.AddAssetBuyOrder:output_type -> rfqrpc.AddAssetBuyOrderResponse
+	7,  // 35: rfqrpc.Rfq.AddAssetSellOrder:output_type -> rfqrpc.AddAssetSellOrderResponse
+	9,  // 36: rfqrpc.Rfq.AddAssetSellOffer:output_type -> rfqrpc.AddAssetSellOfferResponse
+	11, // 37: rfqrpc.Rfq.AddAssetBuyOffer:output_type -> rfqrpc.AddAssetBuyOfferResponse
+	17, // 38: rfqrpc.Rfq.QueryPeerAcceptedQuotes:output_type -> rfqrpc.QueryPeerAcceptedQuotesResponse
+	20, // 39: rfqrpc.Rfq.QueryRateTicks:output_type -> rfqrpc.QueryRateTicksResponse
+	24, // 40: rfqrpc.Rfq.QueryQuoteHistory:output_type -> rfqrpc.QueryQuoteHistoryResponse
+	29, // 41: rfqrpc.Rfq.SubscribeRfqEventNtfns:output_type -> rfqrpc.RfqEvent
+	34, // [34:42] is the sub-list for method output_type
+	26, // [26:34] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_rfqrpc_rfq_proto_init() }
@@ -2009,7 +2999,7 @@ func file_rfqrpc_rfq_proto_init() {
 			}
 		}
 		file_rfqrpc_rfq_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeRfqEventNtfnsRequest); i {
+			switch v := v.(*QueryRateTicksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2021,7 +3011,7 @@ func file_rfqrpc_rfq_proto_init() {
 			}
 		}
 		file_rfqrpc_rfq_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeerAcceptedBuyQuoteEvent); i {
+			switch v := v.(*RateTick); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2033,7 +3023,7 @@ func file_rfqrpc_rfq_proto_init() {
 			}
 		}
 		file_rfqrpc_rfq_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeerAcceptedSellQuoteEvent); i {
+			switch v := v.(*QueryRateTicksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2045,7 +3035,7 @@ func file_rfqrpc_rfq_proto_init() {
 			}
 		}
 		file_rfqrpc_rfq_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptHtlcEvent); i {
+			switch v := v.(*QueryQuoteHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2057,6 +3047,90 @@ func file_rfqrpc_rfq_proto_init() {
 			}
 		}
 		file_rfqrpc_rfq_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HtlcFill); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rfqrpc_rfq_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoricQuote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rfqrpc_rfq_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryQuoteHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rfqrpc_rfq_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRfqEventNtfnsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rfqrpc_rfq_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerAcceptedBuyQuoteEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rfqrpc_rfq_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerAcceptedSellQuoteEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rfqrpc_rfq_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptHtlcEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rfqrpc_rfq_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RfqEvent); i {
 			case 0:
 				return &v.state
@@ -2085,7 +3159,7 @@ func file_rfqrpc_rfq_proto_init() {
 		(*AddAssetSellOrderResponse_InvalidQuote)(nil),
 		(*AddAssetSellOrderResponse_RejectedQuote)(nil),
 	}
-	file_rfqrpc_rfq_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_rfqrpc_rfq_proto_msgTypes[26].OneofWrappers = []interface{}{
 		(*RfqEvent_PeerAcceptedBuyQuote)(nil),
 		(*RfqEvent_PeerAcceptedSellQuote)(nil),
 		(*RfqEvent_AcceptHtlc)(nil),
@@ -2095,8 +3169,8 @@ func file_rfqrpc_rfq_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rfqrpc_rfq_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   20,
+			NumEnums:      3,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
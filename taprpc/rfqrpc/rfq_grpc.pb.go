@@ -41,6 +41,16 @@ type RfqClient interface {
 	// QueryPeerAcceptedQuotes is used to query for quotes that were requested by
 	// our node and have been accepted our peers.
 	QueryPeerAcceptedQuotes(ctx context.Context, in *QueryPeerAcceptedQuotesRequest, opts ...grpc.CallOption) (*QueryPeerAcceptedQuotesResponse, error)
+	// tapcli: `rfq rateticks`
+	// QueryRateTicks is used to query the history of rate ticks that were
+	// received from the price oracle. This requires the node to be configured to
+	// record rate ticks (experimental.rfq.recordrateticks).
+	QueryRateTicks(ctx context.Context, in *QueryRateTicksRequest, opts ...grpc.CallOption) (*QueryRateTicksResponse, error)
+	// tapcli: `rfq quotehistory`
+	// QueryQuoteHistory is used to query the history of quotes that were
+	// accepted by our node or by our peers, including the HTLCs that were
+	// accepted against each quote.
+	QueryQuoteHistory(ctx context.Context, in *QueryQuoteHistoryRequest, opts ...grpc.CallOption) (*QueryQuoteHistoryResponse, error)
 	// SubscribeRfqEventNtfns is used to subscribe to RFQ events.
 	SubscribeRfqEventNtfns(ctx context.Context, in *SubscribeRfqEventNtfnsRequest, opts ...grpc.CallOption) (Rfq_SubscribeRfqEventNtfnsClient, error)
 }
@@ -98,6 +108,24 @@ func (c *rfqClient) QueryPeerAcceptedQuotes(ctx context.Context, in *QueryPeerAc
 	return out, nil
 }
 
+func (c *rfqClient) QueryRateTicks(ctx context.Context, in *QueryRateTicksRequest, opts ...grpc.CallOption) (*QueryRateTicksResponse, error) {
+	out := new(QueryRateTicksResponse)
+	err := c.cc.Invoke(ctx, "/rfqrpc.Rfq/QueryRateTicks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rfqClient) QueryQuoteHistory(ctx context.Context, in *QueryQuoteHistoryRequest, opts ...grpc.CallOption) (*QueryQuoteHistoryResponse, error) {
+	out := new(QueryQuoteHistoryResponse)
+	err := c.cc.Invoke(ctx, "/rfqrpc.Rfq/QueryQuoteHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rfqClient) SubscribeRfqEventNtfns(ctx context.Context, in *SubscribeRfqEventNtfnsRequest, opts ...grpc.CallOption) (Rfq_SubscribeRfqEventNtfnsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Rfq_ServiceDesc.Streams[0], "/rfqrpc.Rfq/SubscribeRfqEventNtfns", opts...)
 	if err != nil {
@@ -157,6 +185,16 @@ type RfqServer interface {
 	// QueryPeerAcceptedQuotes is used to query for quotes that were requested by
 	// our node and have been accepted our peers.
 	QueryPeerAcceptedQuotes(context.Context, *QueryPeerAcceptedQuotesRequest) (*QueryPeerAcceptedQuotesResponse, error)
+	// tapcli: `rfq rateticks`
+	// QueryRateTicks is used to query the history of rate ticks that were
+	// received from the price oracle. This requires the node to be configured to
+	// record rate ticks (experimental.rfq.recordrateticks).
+	QueryRateTicks(context.Context, *QueryRateTicksRequest) (*QueryRateTicksResponse, error)
+	// tapcli: `rfq quotehistory`
+	// QueryQuoteHistory is used to query the history of quotes that were
+	// accepted by our node or by our peers, including the HTLCs that were
+	// accepted against each quote.
+	QueryQuoteHistory(context.Context, *QueryQuoteHistoryRequest) (*QueryQuoteHistoryResponse, error)
 	// SubscribeRfqEventNtfns is used to subscribe to RFQ events.
 	SubscribeRfqEventNtfns(*SubscribeRfqEventNtfnsRequest, Rfq_SubscribeRfqEventNtfnsServer) error
 	mustEmbedUnimplementedRfqServer()
@@ -181,6 +219,12 @@ func (UnimplementedRfqServer) AddAssetBuyOffer(context.Context, *AddAssetBuyOffe
 func (UnimplementedRfqServer) QueryPeerAcceptedQuotes(context.Context, *QueryPeerAcceptedQuotesRequest) (*QueryPeerAcceptedQuotesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryPeerAcceptedQuotes not implemented")
 }
+func (UnimplementedRfqServer) QueryRateTicks(context.Context, *QueryRateTicksRequest) (*QueryRateTicksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRateTicks not implemented")
+}
+func (UnimplementedRfqServer) QueryQuoteHistory(context.Context, *QueryQuoteHistoryRequest) (*QueryQuoteHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryQuoteHistory not implemented")
+}
 func (UnimplementedRfqServer) SubscribeRfqEventNtfns(*SubscribeRfqEventNtfnsRequest, Rfq_SubscribeRfqEventNtfnsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeRfqEventNtfns not implemented")
 }
@@ -287,6 +331,42 @@ func _Rfq_QueryPeerAcceptedQuotes_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Rfq_QueryRateTicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateTicksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RfqServer).QueryRateTicks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rfqrpc.Rfq/QueryRateTicks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RfqServer).QueryRateTicks(ctx, req.(*QueryRateTicksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rfq_QueryQuoteHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RfqServer).QueryQuoteHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rfqrpc.Rfq/QueryQuoteHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RfqServer).QueryQuoteHistory(ctx, req.(*QueryQuoteHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Rfq_SubscribeRfqEventNtfns_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeRfqEventNtfnsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -335,6 +415,14 @@ var Rfq_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryPeerAcceptedQuotes",
 			Handler:    _Rfq_QueryPeerAcceptedQuotes_Handler,
 		},
+		{
+			MethodName: "QueryRateTicks",
+			Handler:    _Rfq_QueryRateTicks_Handler,
+		},
+		{
+			MethodName: "QueryQuoteHistory",
+			Handler:    _Rfq_QueryQuoteHistory_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -72,6 +72,13 @@ type TaprootAssetsClient interface {
 	// ExportProof exports the latest raw proof file anchored at the specified
 	// script_key.
 	ExportProof(ctx context.Context, in *ExportProofRequest, opts ...grpc.CallOption) (*ProofFile, error)
+	// tapcli: `proofs prune`
+	// PruneProof prunes the history of the local proof file anchored at the
+	// specified script_key, so the file starts at the latest proof that is a leaf
+	// of the local universe or a trusted checkpoint server. The pruned proofs
+	// must be restorable from those universes. This requires proof checkpoints
+	// to be enabled.
+	PruneProof(ctx context.Context, in *PruneProofRequest, opts ...grpc.CallOption) (*PruneProofResponse, error)
 	// tapcli: `assets send`
 	// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt
 	// to complete an asset send. The method returns information w.r.t the on chain
@@ -85,6 +92,12 @@ type TaprootAssetsClient interface {
 	// burning is such a destructive and non-reversible operation, some specific
 	// values need to be set in the request to avoid accidental burns.
 	BurnAsset(ctx context.Context, in *BurnAssetRequest, opts ...grpc.CallOption) (*BurnAssetResponse, error)
+	// tapcli: `assets bumpfee`
+	// BumpTransferFee replaces the broadcast, but still unconfirmed, anchor
+	// transaction of an outgoing asset transfer with one that pays a higher fee
+	// rate. All asset outputs of the transfer stay the same, only the change
+	// output pays for the additional fee.
+	BumpTransferFee(ctx context.Context, in *BumpTransferFeeRequest, opts ...grpc.CallOption) (*BumpTransferFeeResponse, error)
 	// tapcli: `getinfo`
 	// GetInfo returns the information for the node.
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
@@ -236,6 +249,15 @@ func (c *taprootAssetsClient) ExportProof(ctx context.Context, in *ExportProofRe
 	return out, nil
 }
 
+func (c *taprootAssetsClient) PruneProof(ctx context.Context, in *PruneProofRequest, opts ...grpc.CallOption) (*PruneProofResponse, error) {
+	out := new(PruneProofResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/PruneProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) SendAsset(ctx context.Context, in *SendAssetRequest, opts ...grpc.CallOption) (*SendAssetResponse, error) {
 	out := new(SendAssetResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SendAsset", in, out, opts...)
@@ -254,6 +276,15 @@ func (c *taprootAssetsClient) BurnAsset(ctx context.Context, in *BurnAssetReques
 	return out, nil
 }
 
+func (c *taprootAssetsClient) BumpTransferFee(ctx context.Context, in *BumpTransferFeeRequest, opts ...grpc.CallOption) (*BumpTransferFeeResponse, error) {
+	out := new(BumpTransferFeeResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/BumpTransferFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
 	out := new(GetInfoResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/GetInfo", in, out, opts...)
@@ -394,6 +425,13 @@ type TaprootAssetsServer interface {
 	// ExportProof exports the latest raw proof file anchored at the specified
 	// script_key.
 	ExportProof(context.Context, *ExportProofRequest) (*ProofFile, error)
+	// tapcli: `proofs prune`
+	// PruneProof prunes the history of the local proof file anchored at the
+	// specified script_key, so the file starts at the latest proof that is a leaf
+	// of the local universe or a trusted checkpoint server. The pruned proofs
+	// must be restorable from those universes. This requires proof checkpoints
+	// to be enabled.
+	PruneProof(context.Context, *PruneProofRequest) (*PruneProofResponse, error)
 	// tapcli: `assets send`
 	// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt
 	// to complete an asset send. The method returns information w.r.t the on chain
@@ -407,6 +445,12 @@ type TaprootAssetsServer interface {
 	// burning is such a destructive and non-reversible operation, some specific
 	// values need to be set in the request to avoid accidental burns.
 	BurnAsset(context.Context, *BurnAssetRequest) (*BurnAssetResponse, error)
+	// tapcli: `assets bumpfee`
+	// BumpTransferFee replaces the broadcast, but still unconfirmed, anchor
+	// transaction of an outgoing asset transfer with one that pays a higher fee
+	// rate. All asset outputs of the transfer stay the same, only the change
+	// output pays for the additional fee.
+	BumpTransferFee(context.Context, *BumpTransferFeeRequest) (*BumpTransferFeeResponse, error)
 	// tapcli: `getinfo`
 	// GetInfo returns the information for the node.
 	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
@@ -471,12 +515,18 @@ func (UnimplementedTaprootAssetsServer) DecodeProof(context.Context, *DecodeProo
 func (UnimplementedTaprootAssetsServer) ExportProof(context.Context, *ExportProofRequest) (*ProofFile, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportProof not implemented")
 }
+func (UnimplementedTaprootAssetsServer) PruneProof(context.Context, *PruneProofRequest) (*PruneProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneProof not implemented")
+}
 func (UnimplementedTaprootAssetsServer) SendAsset(context.Context, *SendAssetRequest) (*SendAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendAsset not implemented")
 }
 func (UnimplementedTaprootAssetsServer) BurnAsset(context.Context, *BurnAssetRequest) (*BurnAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BurnAsset not implemented")
 }
+func (UnimplementedTaprootAssetsServer) BumpTransferFee(context.Context, *BumpTransferFeeRequest) (*BumpTransferFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpTransferFee not implemented")
+}
 func (UnimplementedTaprootAssetsServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
 }
@@ -754,6 +804,24 @@ func _TaprootAssets_ExportProof_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_PruneProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).PruneProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/PruneProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).PruneProof(ctx, req.(*PruneProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_SendAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendAssetRequest)
 	if err := dec(in); err != nil {
@@ -790,6 +858,24 @@ func _TaprootAssets_BurnAsset_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_BumpTransferFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpTransferFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).BumpTransferFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/BumpTransferFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).BumpTransferFee(ctx, req.(*BumpTransferFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetInfoRequest)
 	if err := dec(in); err != nil {
@@ -931,6 +1017,10 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExportProof",
 			Handler:    _TaprootAssets_ExportProof_Handler,
 		},
+		{
+			MethodName: "PruneProof",
+			Handler:    _TaprootAssets_PruneProof_Handler,
+		},
 		{
 			MethodName: "SendAsset",
 			Handler:    _TaprootAssets_SendAsset_Handler,
@@ -939,6 +1029,10 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BurnAsset",
 			Handler:    _TaprootAssets_BurnAsset_Handler,
 		},
+		{
+			MethodName: "BumpTransferFee",
+			Handler:    _TaprootAssets_BumpTransferFee_Handler,
+		},
 		{
 			MethodName: "GetInfo",
 			Handler:    _TaprootAssets_GetInfo_Handler,
@@ -58,6 +58,13 @@ type UniverseClient interface {
 	// to the known Universe root, as well as a Taproot Asset state transition or
 	// issuance proof for the said asset.
 	QueryProof(ctx context.Context, in *UniverseKey, opts ...grpc.CallOption) (*AssetProofResponse, error)
+	// tapcli: `universe proofs multi`
+	// QueryMultiProof is the batched version of QueryProof. It returns the asset
+	// leaves at the given leaf keys of a universe, together with a single
+	// inclusion proof for all of them. If no leaf keys are given, then all the
+	// leaves of the universe are returned, like with AssetLeaves. The proof type
+	// of the universe ID must be specified.
+	QueryMultiProof(ctx context.Context, in *MultiProofRequest, opts ...grpc.CallOption) (*MultiProofResponse, error)
 	// tapcli: `universe proofs insert`
 	// InsertProof attempts to insert a new issuance or transfer proof into the
 	// Universe tree specified by the UniverseKey. If valid, then the proof is
@@ -79,6 +86,15 @@ type UniverseClient interface {
 	// the latest known root for each asset, performing tree based reconciliation
 	// to arrive at a new shared root.
 	SyncUniverse(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error)
+	// SyncDiff is used to compare a remote universe tree against a local tree
+	// top-down, exchanging only the subtrees that differ instead of the full set
+	// of leaf keys. The first request of the stream opens the session for a
+	// universe and is answered with the current root of that universe. All
+	// following requests either ask for the children of a set of branch nodes at
+	// the same height, or for the leaves stored at a set of MS-SMT keys along
+	// with a single inclusion proof for all of them. Each request is answered
+	// with exactly one response, in order.
+	SyncDiff(ctx context.Context, opts ...grpc.CallOption) (Universe_SyncDiffClient, error)
 	// tapcli: `universe federation list`
 	// ListFederationServers lists the set of servers that make up the federation
 	// of the local Universe server. This servers are used to push out new proofs,
@@ -188,6 +204,15 @@ func (c *universeClient) QueryProof(ctx context.Context, in *UniverseKey, opts .
 	return out, nil
 }
 
+func (c *universeClient) QueryMultiProof(ctx context.Context, in *MultiProofRequest, opts ...grpc.CallOption) (*MultiProofResponse, error) {
+	out := new(MultiProofResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryMultiProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) InsertProof(ctx context.Context, in *AssetProof, opts ...grpc.CallOption) (*AssetProofResponse, error) {
 	out := new(AssetProofResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/InsertProof", in, out, opts...)
@@ -224,6 +249,37 @@ func (c *universeClient) SyncUniverse(ctx context.Context, in *SyncRequest, opts
 	return out, nil
 }
 
+func (c *universeClient) SyncDiff(ctx context.Context, opts ...grpc.CallOption) (Universe_SyncDiffClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Universe_ServiceDesc.Streams[0], "/universerpc.Universe/SyncDiff", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &universeSyncDiffClient{stream}
+	return x, nil
+}
+
+type Universe_SyncDiffClient interface {
+	Send(*SyncDiffRequest) error
+	Recv() (*SyncDiffResponse, error)
+	grpc.ClientStream
+}
+
+type universeSyncDiffClient struct {
+	grpc.ClientStream
+}
+
+func (x *universeSyncDiffClient) Send(m *SyncDiffRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *universeSyncDiffClient) Recv() (*SyncDiffResponse, error) {
+	m := new(SyncDiffResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *universeClient) ListFederationServers(ctx context.Context, in *ListFederationServersRequest, opts ...grpc.CallOption) (*ListFederationServersResponse, error) {
 	out := new(ListFederationServersResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/ListFederationServers", in, out, opts...)
@@ -340,6 +396,13 @@ type UniverseServer interface {
 	// to the known Universe root, as well as a Taproot Asset state transition or
 	// issuance proof for the said asset.
 	QueryProof(context.Context, *UniverseKey) (*AssetProofResponse, error)
+	// tapcli: `universe proofs multi`
+	// QueryMultiProof is the batched version of QueryProof. It returns the asset
+	// leaves at the given leaf keys of a universe, together with a single
+	// inclusion proof for all of them. If no leaf keys are given, then all the
+	// leaves of the universe are returned, like with AssetLeaves. The proof type
+	// of the universe ID must be specified.
+	QueryMultiProof(context.Context, *MultiProofRequest) (*MultiProofResponse, error)
 	// tapcli: `universe proofs insert`
 	// InsertProof attempts to insert a new issuance or transfer proof into the
 	// Universe tree specified by the UniverseKey. If valid, then the proof is
@@ -361,6 +424,15 @@ type UniverseServer interface {
 	// the latest known root for each asset, performing tree based reconciliation
 	// to arrive at a new shared root.
 	SyncUniverse(context.Context, *SyncRequest) (*SyncResponse, error)
+	// SyncDiff is used to compare a remote universe tree against a local tree
+	// top-down, exchanging only the subtrees that differ instead of the full set
+	// of leaf keys. The first request of the stream opens the session for a
+	// universe and is answered with the current root of that universe. All
+	// following requests either ask for the children of a set of branch nodes at
+	// the same height, or for the leaves stored at a set of MS-SMT keys along
+	// with a single inclusion proof for all of them. Each request is answered
+	// with exactly one response, in order.
+	SyncDiff(Universe_SyncDiffServer) error
 	// tapcli: `universe federation list`
 	// ListFederationServers lists the set of servers that make up the federation
 	// of the local Universe server. This servers are used to push out new proofs,
@@ -425,6 +497,9 @@ func (UnimplementedUniverseServer) AssetLeaves(context.Context, *ID) (*AssetLeaf
 func (UnimplementedUniverseServer) QueryProof(context.Context, *UniverseKey) (*AssetProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryProof not implemented")
 }
+func (UnimplementedUniverseServer) QueryMultiProof(context.Context, *MultiProofRequest) (*MultiProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryMultiProof not implemented")
+}
 func (UnimplementedUniverseServer) InsertProof(context.Context, *AssetProof) (*AssetProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertProof not implemented")
 }
@@ -437,6 +512,9 @@ func (UnimplementedUniverseServer) Info(context.Context, *InfoRequest) (*InfoRes
 func (UnimplementedUniverseServer) SyncUniverse(context.Context, *SyncRequest) (*SyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncUniverse not implemented")
 }
+func (UnimplementedUniverseServer) SyncDiff(Universe_SyncDiffServer) error {
+	return status.Errorf(codes.Unimplemented, "method SyncDiff not implemented")
+}
 func (UnimplementedUniverseServer) ListFederationServers(context.Context, *ListFederationServersRequest) (*ListFederationServersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFederationServers not implemented")
 }
@@ -600,6 +678,24 @@ func _Universe_QueryProof_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_QueryMultiProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).QueryMultiProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/QueryMultiProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).QueryMultiProof(ctx, req.(*MultiProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Universe_InsertProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AssetProof)
 	if err := dec(in); err != nil {
@@ -672,6 +768,32 @@ func _Universe_SyncUniverse_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_SyncDiff_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UniverseServer).SyncDiff(&universeSyncDiffServer{stream})
+}
+
+type Universe_SyncDiffServer interface {
+	Send(*SyncDiffResponse) error
+	Recv() (*SyncDiffRequest, error)
+	grpc.ServerStream
+}
+
+type universeSyncDiffServer struct {
+	grpc.ServerStream
+}
+
+func (x *universeSyncDiffServer) Send(m *SyncDiffResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *universeSyncDiffServer) Recv() (*SyncDiffRequest, error) {
+	m := new(SyncDiffRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Universe_ListFederationServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListFederationServersRequest)
 	if err := dec(in); err != nil {
@@ -851,6 +973,10 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryProof",
 			Handler:    _Universe_QueryProof_Handler,
 		},
+		{
+			MethodName: "QueryMultiProof",
+			Handler:    _Universe_QueryMultiProof_Handler,
+		},
 		{
 			MethodName: "InsertProof",
 			Handler:    _Universe_InsertProof_Handler,
@@ -900,6 +1026,13 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Universe_QueryFederationSyncConfig_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SyncDiff",
+			Handler:       _Universe_SyncDiff_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "universerpc/universe.proto",
 }
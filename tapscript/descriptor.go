@@ -0,0 +1,1094 @@
+package tapscript
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+const (
+	// DescriptorLocalKey is the key expression that is used as the
+	// placeholder for a key of the local wallet in a script key descriptor
+	// template. It isn't part of the output descriptor specification
+	// (BIP-0380). All occurrences of the placeholder are replaced with the
+	// same wallet key when a script key is derived from the template.
+	DescriptorLocalKey = "@local"
+
+	// maxDescriptorTreeDepth is the maximum depth of the tapscript tree of
+	// a descriptor, as defined in BIP-0341.
+	maxDescriptorTreeDepth = 128
+
+	// maxMultiAKeys is the maximum number of keys of a multi_a() script
+	// expression.
+	maxMultiAKeys = 999
+
+	// descriptorInputCharset is the character set of descriptors, in the
+	// order used by the descriptor checksum algorithm.
+	descriptorInputCharset = "0123456789()[],'/*abcdefgh@:$%{}" +
+		"IJKLMNOPQRSTUVWXYZ&+-.;<=>?!^_|~" +
+		"ijklmnopqrstuvwxyzABCDEFGH`#\"\\ "
+
+	// descriptorChecksumCharset is the character set of the descriptor
+	// checksum.
+	descriptorChecksumCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+	// descriptorChecksumLength is the number of characters of a descriptor
+	// checksum.
+	descriptorChecksumLength = 8
+)
+
+var (
+	// ErrInvalidDescriptor is returned when a descriptor can't be parsed
+	// or uses expressions that aren't supported for asset script keys.
+	ErrInvalidDescriptor = errors.New("invalid script key descriptor")
+
+	// ErrDescriptorChecksum is returned when the checksum of a descriptor
+	// doesn't match its content.
+	ErrDescriptorChecksum = errors.New("invalid descriptor checksum")
+
+	// descriptorChecksumGenerator is the generator of the descriptor
+	// checksum, as defined in BIP-0380.
+	descriptorChecksumGenerator = [5]uint64{
+		0xf5dee51989, 0xa9fdca3312, 0x1bab10e32d, 0x3706b1677a,
+		0x644d626ffd,
+	}
+)
+
+// DescriptorKey is a key expression of a script key descriptor. It is either
+// a concrete public key, an extended public key that is derived along an
+// unhardened path or the placeholder for a key of the local wallet.
+type DescriptorKey struct {
+	// Origin is the optional key origin information of the expression,
+	// including the square brackets. It is only kept to serialize the
+	// expression again.
+	Origin string
+
+	// PubKey is the concrete public key of the expression. It is nil for
+	// extended keys and the local key placeholder until the descriptor is
+	// derived.
+	PubKey *btcec.PublicKey
+
+	// ExtendedKey is the extended public key of the expression, if any.
+	ExtendedKey *hdkeychain.ExtendedKey
+
+	// Path is the unhardened derivation path below the extended key,
+	// excluding the wildcard step.
+	Path []uint32
+
+	// Wildcard indicates that the extended key is derived one step further,
+	// at the index the descriptor is derived at.
+	Wildcard bool
+
+	// Local indicates that the expression is the placeholder for a key of
+	// the local wallet.
+	Local bool
+}
+
+// String returns the descriptor representation of the key expression.
+func (k *DescriptorKey) String() string {
+	switch {
+	case k.Local:
+		return DescriptorLocalKey
+
+	case k.ExtendedKey != nil:
+		var b strings.Builder
+		b.WriteString(k.Origin)
+		b.WriteString(k.ExtendedKey.String())
+		for _, step := range k.Path {
+			fmt.Fprintf(&b, "/%d", step)
+		}
+		if k.Wildcard {
+			b.WriteString("/*")
+		}
+
+		return b.String()
+
+	default:
+		pubKey := k.PubKey.SerializeCompressed()
+
+		return k.Origin + hex.EncodeToString(pubKey)
+	}
+}
+
+// derive returns the concrete key expression of the key at the given index.
+func (k *DescriptorKey) derive(index uint32,
+	localKey *btcec.PublicKey) (*DescriptorKey, error) {
+
+	switch {
+	case k.Local:
+		if localKey == nil {
+			return nil, fmt.Errorf("descriptor requires a local " +
+				"key")
+		}
+
+		return &DescriptorKey{PubKey: localKey}, nil
+
+	case k.ExtendedKey != nil:
+		path := k.Path
+		if k.Wildcard {
+			if index >= hdkeychain.HardenedKeyStart {
+				return nil, fmt.Errorf("invalid unhardened "+
+					"derivation index %d", index)
+			}
+
+			path = append(path[:len(path):len(path)], index)
+		}
+
+		extendedKey := k.ExtendedKey
+		for _, step := range path {
+			var err error
+			extendedKey, err = extendedKey.Derive(step)
+			if err != nil {
+				return nil, fmt.Errorf("unable to derive key: "+
+					"%w", err)
+			}
+		}
+
+		pubKey, err := extendedKey.ECPubKey()
+		if err != nil {
+			return nil, err
+		}
+
+		return &DescriptorKey{PubKey: pubKey}, nil
+
+	default:
+		return &DescriptorKey{PubKey: k.PubKey}, nil
+	}
+}
+
+// DescriptorLeafType is the type of the signature check of a script path of
+// a descriptor.
+type DescriptorLeafType uint8
+
+const (
+	// DescriptorLeafPk is a script path that requires a signature of a
+	// single key, described as pk(KEY).
+	DescriptorLeafPk DescriptorLeafType = iota
+
+	// DescriptorLeafMultiA is a script path that requires a threshold of
+	// signatures of several keys, described as multi_a(k,KEY_1,...,KEY_n).
+	DescriptorLeafMultiA
+)
+
+// DescriptorLeaf is a script path of a script key descriptor. Each script
+// path requires the signatures of one or more keys and can optionally be
+// restricted by either a relative or an absolute lock time. Time locked paths
+// are described in their miniscript form, as and_v(v:pk(KEY),older(n)) or
+// and_v(v:multi_a(k,KEY_1,...,KEY_n),after(n)) for example.
+type DescriptorLeaf struct {
+	// Type is the type of the signature check of the script path.
+	Type DescriptorLeafType
+
+	// Keys are the keys that can sign for the script path.
+	Keys []*DescriptorKey
+
+	// Threshold is the number of signatures required to satisfy the
+	// script path.
+	Threshold int
+
+	// RelativeLockTime is the relative lock time the spending asset needs
+	// to have, checked with OP_CHECKSEQUENCEVERIFY. Zero means the script
+	// path isn't restricted by a relative lock time.
+	RelativeLockTime uint32
+
+	// LockTime is the absolute lock time the spending asset needs to have,
+	// checked with OP_CHECKLOCKTIMEVERIFY. Zero means the script path
+	// isn't restricted by an absolute lock time.
+	LockTime uint32
+}
+
+// String returns the descriptor representation of the script path.
+func (l *DescriptorLeaf) String() string {
+	var check string
+	switch l.Type {
+	case DescriptorLeafPk:
+		check = fmt.Sprintf("pk(%v)", l.Keys[0])
+
+	case DescriptorLeafMultiA:
+		keys := make([]string, len(l.Keys))
+		for idx, key := range l.Keys {
+			keys[idx] = key.String()
+		}
+
+		check = fmt.Sprintf(
+			"multi_a(%d,%s)", l.Threshold, strings.Join(keys, ","),
+		)
+	}
+
+	switch {
+	case l.RelativeLockTime != 0:
+		return fmt.Sprintf("and_v(v:%s,older(%d))", check,
+			l.RelativeLockTime)
+
+	case l.LockTime != 0:
+		return fmt.Sprintf("and_v(v:%s,after(%d))", check, l.LockTime)
+
+	default:
+		return check
+	}
+}
+
+// Script returns the tapscript of the script path. All keys of the script
+// path must be concrete keys.
+func (l *DescriptorLeaf) Script() ([]byte, error) {
+	timeLocked := l.RelativeLockTime != 0 || l.LockTime != 0
+
+	builder := txscript.NewScriptBuilder()
+	for idx, key := range l.Keys {
+		if key.PubKey == nil {
+			return nil, fmt.Errorf("descriptor key %v is not "+
+				"derived", key)
+		}
+
+		builder.AddData(schnorr.SerializePubKey(key.PubKey))
+
+		switch {
+		case l.Type == DescriptorLeafPk && timeLocked:
+			builder.AddOp(txscript.OP_CHECKSIGVERIFY)
+
+		case idx == 0:
+			builder.AddOp(txscript.OP_CHECKSIG)
+
+		default:
+			builder.AddOp(txscript.OP_CHECKSIGADD)
+		}
+	}
+
+	if l.Type == DescriptorLeafMultiA {
+		builder.AddInt64(int64(l.Threshold))
+
+		if timeLocked {
+			builder.AddOp(txscript.OP_NUMEQUALVERIFY)
+		} else {
+			builder.AddOp(txscript.OP_NUMEQUAL)
+		}
+	}
+
+	switch {
+	case l.RelativeLockTime != 0:
+		builder.AddInt64(int64(l.RelativeLockTime))
+		builder.AddOp(txscript.OP_CHECKSEQUENCEVERIFY)
+
+	case l.LockTime != 0:
+		builder.AddInt64(int64(l.LockTime))
+		builder.AddOp(txscript.OP_CHECKLOCKTIMEVERIFY)
+	}
+
+	return builder.Script()
+}
+
+// KeyIndex returns the position of the given key within the keys of the
+// script path or -1 if the key can't sign for the script path.
+func (l *DescriptorLeaf) KeyIndex(pubKey *btcec.PublicKey) int {
+	xOnlyKey := schnorr.SerializePubKey(pubKey)
+	for idx, key := range l.Keys {
+		if key.PubKey == nil {
+			continue
+		}
+
+		if bytes.Equal(schnorr.SerializePubKey(key.PubKey), xOnlyKey) {
+			return idx
+		}
+	}
+
+	return -1
+}
+
+// LockTimeSatisfied returns true if an asset with the given absolute and
+// relative lock time satisfies the time lock of the script path, following
+// the rules of OP_CHECKLOCKTIMEVERIFY and OP_CHECKSEQUENCEVERIFY.
+func (l *DescriptorLeaf) LockTimeSatisfied(lockTime,
+	relativeLockTime uint64) bool {
+
+	if l.RelativeLockTime != 0 {
+		if relativeLockTime > math.MaxUint32 {
+			return false
+		}
+
+		sequence := uint32(relativeLockTime)
+		if sequence&wire.SequenceLockTimeDisabled != 0 {
+			return false
+		}
+
+		// Both the required and the actual relative lock time need to
+		// be of the same type, either blocks or seconds.
+		const typeFlag = wire.SequenceLockTimeIsSeconds
+		if sequence&typeFlag != l.RelativeLockTime&typeFlag {
+			return false
+		}
+
+		const mask = wire.SequenceLockTimeMask
+		if sequence&mask < l.RelativeLockTime&mask {
+			return false
+		}
+	}
+
+	if l.LockTime != 0 {
+		if lockTime > math.MaxUint32 {
+			return false
+		}
+
+		// Both the required and the actual lock time need to be of the
+		// same type, either a block height or a timestamp.
+		const threshold = txscript.LockTimeThreshold
+		if (lockTime < threshold) != (l.LockTime < threshold) {
+			return false
+		}
+
+		if lockTime < uint64(l.LockTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// derive returns the concrete script path at the given index.
+func (l *DescriptorLeaf) derive(index uint32,
+	localKey *btcec.PublicKey) (*DescriptorLeaf, error) {
+
+	leaf := *l
+	leaf.Keys = make([]*DescriptorKey, len(l.Keys))
+	for idx, key := range l.Keys {
+		var err error
+		leaf.Keys[idx], err = key.derive(index, localKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &leaf, nil
+}
+
+// DescriptorNode is a node of the tapscript tree of a script key descriptor.
+// A node is either a leaf with a single script path or a branch with exactly
+// two children.
+type DescriptorNode struct {
+	// Leaf is the script path of a leaf node.
+	Leaf *DescriptorLeaf
+
+	// Left is the left child of a branch node.
+	Left *DescriptorNode
+
+	// Right is the right child of a branch node.
+	Right *DescriptorNode
+}
+
+// String returns the descriptor representation of the tree node.
+func (n *DescriptorNode) String() string {
+	if n.Leaf != nil {
+		return n.Leaf.String()
+	}
+
+	return fmt.Sprintf("{%v,%v}", n.Left, n.Right)
+}
+
+// leaves returns all script paths below the node, from left to right.
+func (n *DescriptorNode) leaves() []*DescriptorLeaf {
+	if n.Leaf != nil {
+		return []*DescriptorLeaf{n.Leaf}
+	}
+
+	return append(n.Left.leaves(), n.Right.leaves()...)
+}
+
+// derive returns the concrete tree node at the given index.
+func (n *DescriptorNode) derive(index uint32,
+	localKey *btcec.PublicKey) (*DescriptorNode, error) {
+
+	if n.Leaf != nil {
+		leaf, err := n.Leaf.derive(index, localKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return &DescriptorNode{Leaf: leaf}, nil
+	}
+
+	left, err := n.Left.derive(index, localKey)
+	if err != nil {
+		return nil, err
+	}
+	right, err := n.Right.derive(index, localKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DescriptorNode{Left: left, Right: right}, nil
+}
+
+// compiledLeaf is a script path together with its tapscript leaf and the
+// merkle inclusion proof of the leaf in the tapscript tree.
+type compiledLeaf struct {
+	leaf    *DescriptorLeaf
+	tapLeaf txscript.TapLeaf
+	proof   []byte
+}
+
+// compile builds the tapscript tree below the node, keeping the exact tree
+// structure of the descriptor.
+func (n *DescriptorNode) compile() (txscript.TapNode, []compiledLeaf, error) {
+	if n.Leaf != nil {
+		script, err := n.Leaf.Script()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		tapLeaf := txscript.NewBaseTapLeaf(script)
+
+		return tapLeaf, []compiledLeaf{{
+			leaf:    n.Leaf,
+			tapLeaf: tapLeaf,
+		}}, nil
+	}
+
+	left, leftLeaves, err := n.Left.compile()
+	if err != nil {
+		return nil, nil, err
+	}
+	right, rightLeaves, err := n.Right.compile()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The inclusion proof of each leaf contains the hash of the sibling
+	// node on every level, starting from the leaf.
+	leftHash, rightHash := left.TapHash(), right.TapHash()
+	for idx := range leftLeaves {
+		leftLeaves[idx].proof = append(
+			leftLeaves[idx].proof, rightHash[:]...,
+		)
+	}
+	for idx := range rightLeaves {
+		rightLeaves[idx].proof = append(
+			rightLeaves[idx].proof, leftHash[:]...,
+		)
+	}
+
+	return txscript.NewTapBranch(left, right),
+		append(leftLeaves, rightLeaves...), nil
+}
+
+// Descriptor is a tr() output descriptor that describes the spending policy
+// of an asset script key. The internal key of the descriptor can spend the
+// asset through the key path, while the optional tapscript tree describes the
+// script paths. Each script path is a pk() or multi_a() signature check that
+// can be restricted by a relative or absolute lock time.
+//
+// A descriptor can be a template, in which case it either contains the local
+// key placeholder, extended keys with a wildcard derivation step or both. A
+// template needs to be derived before the script key can be computed.
+type Descriptor struct {
+	// InternalKey is the internal key of the taproot output.
+	InternalKey *DescriptorKey
+
+	// Tree is the root node of the tapscript tree. It is nil if the asset
+	// can only be spent through the key path.
+	Tree *DescriptorNode
+}
+
+// ParseDescriptor parses the given tr() output descriptor. If the descriptor
+// contains a checksum, it is verified.
+func ParseDescriptor(desc string) (*Descriptor, error) {
+	if idx := strings.IndexByte(desc, '#'); idx >= 0 {
+		checksum := desc[idx+1:]
+		desc = desc[:idx]
+
+		expectedChecksum, err := DescriptorChecksum(desc)
+		if err != nil {
+			return nil, err
+		}
+		if checksum != expectedChecksum {
+			return nil, fmt.Errorf("%w: expected %s, got %s",
+				ErrDescriptorChecksum, expectedChecksum,
+				checksum)
+		}
+	}
+
+	p := &descriptorParser{desc: desc}
+	d, err := p.parseDescriptor()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidDescriptor, err)
+	}
+
+	return d, nil
+}
+
+// String returns the descriptor including its checksum.
+func (d *Descriptor) String() string {
+	desc := "tr(" + d.InternalKey.String()
+	if d.Tree != nil {
+		desc += "," + d.Tree.String()
+	}
+	desc += ")"
+
+	// The descriptor only consists of characters of the input character
+	// set, so the checksum can always be computed.
+	checksum, _ := DescriptorChecksum(desc)
+
+	return desc + "#" + checksum
+}
+
+// Leaves returns all script paths of the descriptor, in the order they appear
+// in the descriptor.
+func (d *Descriptor) Leaves() []*DescriptorLeaf {
+	if d.Tree == nil {
+		return nil
+	}
+
+	return d.Tree.leaves()
+}
+
+// keys returns all key expressions of the descriptor.
+func (d *Descriptor) keys() []*DescriptorKey {
+	keys := []*DescriptorKey{d.InternalKey}
+	for _, leaf := range d.Leaves() {
+		keys = append(keys, leaf.Keys...)
+	}
+
+	return keys
+}
+
+// IsRanged returns true if the descriptor contains extended keys with a
+// wildcard derivation step, so it describes a different script key for each
+// derivation index.
+func (d *Descriptor) IsRanged() bool {
+	for _, key := range d.keys() {
+		if key.Wildcard {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasLocalKey returns true if the descriptor contains the local key
+// placeholder.
+func (d *Descriptor) HasLocalKey() bool {
+	for _, key := range d.keys() {
+		if key.Local {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Derive returns the concrete descriptor at the given derivation index, with
+// the local key placeholder replaced by the given local key. The index is
+// ignored if the descriptor isn't ranged, the local key is only required if
+// the descriptor contains the placeholder.
+func (d *Descriptor) Derive(index uint32,
+	localKey *btcec.PublicKey) (*Descriptor, error) {
+
+	internalKey, err := d.InternalKey.derive(index, localKey)
+	if err != nil {
+		return nil, err
+	}
+
+	derived := &Descriptor{
+		InternalKey: internalKey,
+	}
+	if d.Tree != nil {
+		derived.Tree, err = d.Tree.derive(index, localKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return derived, nil
+}
+
+// DescriptorTapLeaf is a script path of a derived descriptor together with
+// everything that is needed to spend an asset through it.
+type DescriptorTapLeaf struct {
+	*DescriptorLeaf
+
+	// TapLeaf is the tapscript leaf of the script path.
+	TapLeaf txscript.TapLeaf
+
+	// ControlBlock is the serialized control block that proves the
+	// inclusion of the leaf in the tapscript tree.
+	ControlBlock []byte
+}
+
+// DescriptorScriptTree is the taproot output of a derived descriptor.
+type DescriptorScriptTree struct {
+	// InternalKey is the internal key of the taproot output.
+	InternalKey *btcec.PublicKey
+
+	// TaprootKey is the taproot output key.
+	TaprootKey *btcec.PublicKey
+
+	// TapscriptRoot is the root hash of the tapscript tree. It is nil if
+	// the descriptor doesn't have any script paths.
+	TapscriptRoot []byte
+
+	// Leaves are the script paths of the descriptor, in the order they
+	// appear in the descriptor.
+	Leaves []*DescriptorTapLeaf
+}
+
+// ScriptTree computes the taproot output of the descriptor. The descriptor
+// must be derived, so all its keys are concrete keys.
+func (d *Descriptor) ScriptTree() (*DescriptorScriptTree, error) {
+	internalKey := d.InternalKey.PubKey
+	if internalKey == nil {
+		return nil, fmt.Errorf("descriptor key %v is not derived",
+			d.InternalKey)
+	}
+
+	// Without any script paths, the output key is tweaked BIP-0086 style.
+	if d.Tree == nil {
+		outputKey := txscript.ComputeTaprootKeyNoScript(internalKey)
+
+		return &DescriptorScriptTree{
+			InternalKey: internalKey,
+			TaprootKey:  outputKey,
+		}, nil
+	}
+
+	rootNode, leaves, err := d.Tree.compile()
+	if err != nil {
+		return nil, err
+	}
+
+	rootHash := rootNode.TapHash()
+	outputKey := txscript.ComputeTaprootOutputKey(internalKey, rootHash[:])
+	outputKeyYIsOdd := outputKey.SerializeCompressed()[0] ==
+		secp256k1.PubKeyFormatCompressedOdd
+
+	tree := &DescriptorScriptTree{
+		InternalKey:   internalKey,
+		TaprootKey:    outputKey,
+		TapscriptRoot: rootHash[:],
+		Leaves:        make([]*DescriptorTapLeaf, len(leaves)),
+	}
+	for idx, leaf := range leaves {
+		controlBlock := txscript.ControlBlock{
+			InternalKey:     internalKey,
+			OutputKeyYIsOdd: outputKeyYIsOdd,
+			LeafVersion:     leaf.tapLeaf.LeafVersion,
+			InclusionProof:  leaf.proof,
+		}
+		controlBlockBytes, err := controlBlock.ToBytes()
+		if err != nil {
+			return nil, err
+		}
+
+		tree.Leaves[idx] = &DescriptorTapLeaf{
+			DescriptorLeaf: leaf.leaf,
+			TapLeaf:        leaf.tapLeaf,
+			ControlBlock:   controlBlockBytes,
+		}
+	}
+
+	return tree, nil
+}
+
+// ScriptKey returns the asset script key of the descriptor's taproot output.
+// The tweaked script key information is included, so the key can be declared
+// to the local wallet.
+func (t *DescriptorScriptTree) ScriptKey() asset.ScriptKey {
+	return asset.ScriptKey{
+		PubKey: asset.NewScriptKey(t.TaprootKey).PubKey,
+		TweakedScriptKey: &asset.TweakedScriptKey{
+			RawKey: keychain.KeyDescriptor{
+				PubKey: t.InternalKey,
+			},
+			Tweak: t.TapscriptRoot,
+		},
+	}
+}
+
+// DescriptorChecksum computes the checksum of the given descriptor without
+// checksum, as defined in BIP-0380.
+func DescriptorChecksum(desc string) (string, error) {
+	var (
+		symbols = make([]uint64, 0, len(desc)*4/3+1)
+		groups  []uint64
+	)
+	for idx, c := range desc {
+		pos := strings.IndexRune(descriptorInputCharset, c)
+		if pos < 0 {
+			return "", fmt.Errorf("%w: invalid character %q at "+
+				"position %d", ErrInvalidDescriptor, c, idx)
+		}
+
+		symbols = append(symbols, uint64(pos&31))
+		groups = append(groups, uint64(pos>>5))
+		if len(groups) == 3 {
+			symbols = append(
+				symbols, groups[0]*9+groups[1]*3+groups[2],
+			)
+			groups = groups[:0]
+		}
+	}
+	switch len(groups) {
+	case 1:
+		symbols = append(symbols, groups[0])
+	case 2:
+		symbols = append(symbols, groups[0]*3+groups[1])
+	}
+
+	symbols = append(symbols, make([]uint64, descriptorChecksumLength)...)
+	checksum := descriptorPolymod(symbols) ^ 1
+
+	result := make([]byte, descriptorChecksumLength)
+	for idx := range result {
+		shift := 5 * (descriptorChecksumLength - 1 - idx)
+		result[idx] = descriptorChecksumCharset[(checksum>>shift)&31]
+	}
+
+	return string(result), nil
+}
+
+// descriptorPolymod computes the BCH code of the descriptor checksum over the
+// given symbols.
+func descriptorPolymod(symbols []uint64) uint64 {
+	chk := uint64(1)
+	for _, value := range symbols {
+		top := chk >> 35
+		chk = (chk&0x7ffffffff)<<5 ^ value
+		for idx, generator := range descriptorChecksumGenerator {
+			if (top>>idx)&1 == 1 {
+				chk ^= generator
+			}
+		}
+	}
+
+	return chk
+}
+
+// descriptorParser is a recursive descent parser for the subset of tr()
+// output descriptors that is supported for asset script keys.
+type descriptorParser struct {
+	desc string
+	pos  int
+}
+
+// consume advances the parser past the given token if the remaining input
+// starts with it.
+func (p *descriptorParser) consume(token string) bool {
+	if !strings.HasPrefix(p.desc[p.pos:], token) {
+		return false
+	}
+
+	p.pos += len(token)
+
+	return true
+}
+
+// expect advances the parser past the given token or returns an error if the
+// remaining input doesn't start with it.
+func (p *descriptorParser) expect(token string) error {
+	if !p.consume(token) {
+		return fmt.Errorf("expected '%s' at position %d", token, p.pos)
+	}
+
+	return nil
+}
+
+// token returns the next token, which ends at the next delimiter.
+func (p *descriptorParser) token() string {
+	start := p.pos
+	for p.pos < len(p.desc) {
+		if strings.IndexByte(",(){}", p.desc[p.pos]) >= 0 {
+			break
+		}
+
+		p.pos++
+	}
+
+	return p.desc[start:p.pos]
+}
+
+// parseDescriptor parses a full tr() descriptor.
+func (p *descriptorParser) parseDescriptor() (*Descriptor, error) {
+	if !p.consume("tr(") {
+		return nil, fmt.Errorf("only tr() descriptors are supported")
+	}
+
+	internalKey, err := p.parseKey()
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Descriptor{
+		InternalKey: internalKey,
+	}
+	if p.consume(",") {
+		d.Tree, err = p.parseTree(0)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.desc) {
+		return nil, fmt.Errorf("unexpected characters at position %d",
+			p.pos)
+	}
+
+	return d, nil
+}
+
+// parseTree parses a tapscript tree node at the given depth.
+func (p *descriptorParser) parseTree(depth int) (*DescriptorNode, error) {
+	if !p.consume("{") {
+		leaf, err := p.parseLeaf()
+		if err != nil {
+			return nil, err
+		}
+
+		return &DescriptorNode{Leaf: leaf}, nil
+	}
+
+	if depth == maxDescriptorTreeDepth {
+		return nil, fmt.Errorf("tapscript tree exceeds maximum depth "+
+			"of %d", maxDescriptorTreeDepth)
+	}
+
+	left, err := p.parseTree(depth + 1)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(","); err != nil {
+		return nil, err
+	}
+	right, err := p.parseTree(depth + 1)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect("}"); err != nil {
+		return nil, err
+	}
+
+	return &DescriptorNode{Left: left, Right: right}, nil
+}
+
+// parseLeaf parses a script path, optionally restricted by a time lock.
+func (p *descriptorParser) parseLeaf() (*DescriptorLeaf, error) {
+	if !p.consume("and_v(v:") {
+		return p.parseCheck()
+	}
+
+	leaf, err := p.parseCheck()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(","); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case p.consume("older("):
+		leaf.RelativeLockTime, err = p.parseLockTime()
+
+	case p.consume("after("):
+		leaf.LockTime, err = p.parseLockTime()
+
+	default:
+		err = fmt.Errorf("expected older() or after() at position %d",
+			p.pos)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+
+	return leaf, nil
+}
+
+// parseCheck parses a pk() or multi_a() signature check.
+func (p *descriptorParser) parseCheck() (*DescriptorLeaf, error) {
+	switch {
+	case p.consume("pk("):
+		key, err := p.parseKey()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+
+		return &DescriptorLeaf{
+			Type:      DescriptorLeafPk,
+			Keys:      []*DescriptorKey{key},
+			Threshold: 1,
+		}, nil
+
+	case p.consume("multi_a("):
+		thresholdPos := p.pos
+		threshold, err := strconv.Atoi(p.token())
+		if err != nil {
+			return nil, fmt.Errorf("invalid threshold at position "+
+				"%d: %w", thresholdPos, err)
+		}
+
+		var keys []*DescriptorKey
+		for p.consume(",") {
+			key, err := p.parseKey()
+			if err != nil {
+				return nil, err
+			}
+
+			for _, otherKey := range keys {
+				if key.String() == otherKey.String() {
+					return nil, fmt.Errorf("duplicate key "+
+						"%v in multi_a()", key)
+				}
+			}
+
+			keys = append(keys, key)
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+
+		if len(keys) == 0 || len(keys) > maxMultiAKeys {
+			return nil, fmt.Errorf("invalid number of keys %d in "+
+				"multi_a()", len(keys))
+		}
+		if threshold < 1 || threshold > len(keys) {
+			return nil, fmt.Errorf("invalid threshold %d for %d "+
+				"keys in multi_a()", threshold, len(keys))
+		}
+
+		return &DescriptorLeaf{
+			Type:      DescriptorLeafMultiA,
+			Keys:      keys,
+			Threshold: threshold,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported script expression at "+
+			"position %d, only pk(), multi_a() and and_v() with "+
+			"older() or after() are supported", p.pos)
+	}
+}
+
+// parseLockTime parses the argument of an older() or after() expression,
+// including the closing parenthesis.
+func (p *descriptorParser) parseLockTime() (uint32, error) {
+	lockTimePos := p.pos
+	lockTime, err := strconv.ParseUint(p.token(), 10, 31)
+	if err != nil || lockTime == 0 {
+		return 0, fmt.Errorf("invalid lock time at position %d",
+			lockTimePos)
+	}
+
+	if err := p.expect(")"); err != nil {
+		return 0, err
+	}
+
+	return uint32(lockTime), nil
+}
+
+// parseKey parses a key expression.
+func (p *descriptorParser) parseKey() (*DescriptorKey, error) {
+	keyPos := p.pos
+	key, err := parseDescriptorKey(p.token())
+	if err != nil {
+		return nil, fmt.Errorf("invalid key at position %d: %w",
+			keyPos, err)
+	}
+
+	return key, nil
+}
+
+// parseDescriptorKey parses a single key expression. Supported are 32-byte
+// x-only and 33-byte compressed public keys in hex, extended public keys with
+// an unhardened derivation path and an optional wildcard step, and the local
+// key placeholder. Hex and extended keys can be prefixed with the key origin.
+func parseDescriptorKey(expr string) (*DescriptorKey, error) {
+	if expr == DescriptorLocalKey {
+		return &DescriptorKey{Local: true}, nil
+	}
+
+	var key DescriptorKey
+	if strings.HasPrefix(expr, "[") {
+		end := strings.IndexByte(expr, ']')
+		if end < 0 {
+			return nil, fmt.Errorf("missing end of key origin")
+		}
+
+		key.Origin = expr[:end+1]
+		expr = expr[end+1:]
+	}
+
+	if keyBytes, err := hex.DecodeString(expr); err == nil {
+		switch len(keyBytes) {
+		case schnorr.PubKeyBytesLen:
+			key.PubKey, err = schnorr.ParsePubKey(keyBytes)
+
+		case btcec.PubKeyBytesLenCompressed:
+			key.PubKey, err = btcec.ParsePubKey(keyBytes)
+
+		default:
+			err = fmt.Errorf("invalid key length %d", len(keyBytes))
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return &key, nil
+	}
+
+	steps := strings.Split(expr, "/")
+	extendedKey, err := hdkeychain.NewKeyFromString(steps[0])
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse key: %w", err)
+	}
+	if extendedKey.IsPrivate() {
+		return nil, fmt.Errorf("private keys are not supported")
+	}
+	key.ExtendedKey = extendedKey
+
+	for idx, step := range steps[1:] {
+		if step == "*" {
+			if idx != len(steps)-2 {
+				return nil, fmt.Errorf("wildcard must be the " +
+					"last derivation step")
+			}
+
+			key.Wildcard = true
+			continue
+		}
+
+		hardened := strings.HasSuffix(step, "'") ||
+			strings.HasSuffix(step, "h")
+		if hardened {
+			return nil, fmt.Errorf("hardened derivation is not " +
+				"supported for extended public keys")
+		}
+
+		childIndex, err := strconv.ParseUint(step, 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid derivation step %q",
+				step)
+		}
+
+		key.Path = append(key.Path, uint32(childIndex))
+	}
+
+	return &key, nil
+}
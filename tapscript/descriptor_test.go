@@ -0,0 +1,252 @@
+package tapscript_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/stretchr/testify/require"
+)
+
+// testXPub is the extended public key of the first BIP-0032 test vector.
+const testXPub = "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGh" +
+	"ePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"
+
+// hexKey returns the hex encoded compressed serialization of the given key.
+func hexKey(key *btcec.PublicKey) string {
+	return hex.EncodeToString(key.SerializeCompressed())
+}
+
+// TestDescriptorChecksum tests the descriptor checksum against the test vector
+// of BIP-0380.
+func TestDescriptorChecksum(t *testing.T) {
+	t.Parallel()
+
+	checksum, err := tapscript.DescriptorChecksum("raw(deadbeef)")
+	require.NoError(t, err)
+	require.Equal(t, "89f8spxm", checksum)
+
+	_, err = tapscript.DescriptorChecksum("raw(deadbeef)\n")
+	require.ErrorIs(t, err, tapscript.ErrInvalidDescriptor)
+}
+
+// TestDescriptorScriptTree tests that a descriptor template is parsed, derived
+// and compiled into a script tree with valid control blocks for each of its
+// script paths.
+func TestDescriptorScriptTree(t *testing.T) {
+	t.Parallel()
+
+	var (
+		internalKey = test.RandPubKey(t)
+		keyA        = test.RandPubKey(t)
+		keyB        = test.RandPubKey(t)
+		keyC        = test.RandPubKey(t)
+		localKey    = test.RandPubKey(t)
+	)
+
+	template := fmt.Sprintf(
+		"tr(%s,{pk(%s),{and_v(v:multi_a(2,%s,%s),older(144)),"+
+			"and_v(v:pk(@local),after(800000))}})",
+		hexKey(internalKey), hexKey(keyA), hexKey(keyB), hexKey(keyC),
+	)
+	desc, err := tapscript.ParseDescriptor(template)
+	require.NoError(t, err)
+	require.True(t, desc.HasLocalKey())
+	require.False(t, desc.IsRanged())
+	require.Len(t, desc.Leaves(), 3)
+
+	// The descriptor must survive a round trip, including the checksum.
+	parsed, err := tapscript.ParseDescriptor(desc.String())
+	require.NoError(t, err)
+	require.Equal(t, desc.String(), parsed.String())
+
+	// A template can't be compiled before it is derived.
+	_, err = desc.ScriptTree()
+	require.ErrorContains(t, err, "not derived")
+	_, err = desc.Derive(0, nil)
+	require.ErrorContains(t, err, "requires a local key")
+
+	derived, err := desc.Derive(0, localKey)
+	require.NoError(t, err)
+	require.False(t, derived.HasLocalKey())
+
+	tree, err := derived.ScriptTree()
+	require.NoError(t, err)
+	require.Len(t, tree.Leaves, 3)
+
+	expectedScripts := make([][]byte, 3)
+	expectedScripts[0], err = txscript.NewScriptBuilder().
+		AddData(schnorr.SerializePubKey(keyA)).
+		AddOp(txscript.OP_CHECKSIG).
+		Script()
+	require.NoError(t, err)
+	expectedScripts[1], err = txscript.NewScriptBuilder().
+		AddData(schnorr.SerializePubKey(keyB)).
+		AddOp(txscript.OP_CHECKSIG).
+		AddData(schnorr.SerializePubKey(keyC)).
+		AddOp(txscript.OP_CHECKSIGADD).
+		AddInt64(2).
+		AddOp(txscript.OP_NUMEQUALVERIFY).
+		AddInt64(144).
+		AddOp(txscript.OP_CHECKSEQUENCEVERIFY).
+		Script()
+	require.NoError(t, err)
+	expectedScripts[2], err = txscript.NewScriptBuilder().
+		AddData(schnorr.SerializePubKey(localKey)).
+		AddOp(txscript.OP_CHECKSIGVERIFY).
+		AddInt64(800000).
+		AddOp(txscript.OP_CHECKLOCKTIMEVERIFY).
+		Script()
+	require.NoError(t, err)
+
+	witnessProgram := schnorr.SerializePubKey(tree.TaprootKey)
+	for idx, leaf := range tree.Leaves {
+		require.Equal(t, expectedScripts[idx], leaf.TapLeaf.Script)
+
+		controlBlock, err := txscript.ParseControlBlock(
+			leaf.ControlBlock,
+		)
+		require.NoError(t, err)
+		require.NoError(t, txscript.VerifyTaprootLeafCommitment(
+			controlBlock, witnessProgram, leaf.TapLeaf.Script,
+		))
+	}
+
+	// The local key is the only key of the last script path, which can
+	// only be spent once the absolute lock time is reached.
+	timeLockLeaf := tree.Leaves[2]
+	require.Equal(t, 0, timeLockLeaf.KeyIndex(localKey))
+	require.Equal(t, -1, timeLockLeaf.KeyIndex(keyA))
+	require.True(t, timeLockLeaf.LockTimeSatisfied(800000, 0))
+	require.False(t, timeLockLeaf.LockTimeSatisfied(799999, 0))
+	require.False(t, timeLockLeaf.LockTimeSatisfied(600000000, 0))
+
+	csvLeaf := tree.Leaves[1]
+	require.Equal(t, 1, csvLeaf.KeyIndex(keyC))
+	require.True(t, csvLeaf.LockTimeSatisfied(0, 144))
+	require.False(t, csvLeaf.LockTimeSatisfied(0, 143))
+
+	// The script key commits to the internal key and the tapscript root.
+	scriptKey := tree.ScriptKey()
+	require.Equal(
+		t, schnorr.SerializePubKey(tree.TaprootKey),
+		schnorr.SerializePubKey(scriptKey.PubKey),
+	)
+	require.Equal(t, tree.TapscriptRoot, scriptKey.Tweak)
+	require.True(t, internalKey.IsEqual(scriptKey.RawKey.PubKey))
+}
+
+// TestDescriptorDerive tests that ranged descriptors result in a different
+// script key for each derivation index.
+func TestDescriptorDerive(t *testing.T) {
+	t.Parallel()
+
+	desc, err := tapscript.ParseDescriptor(fmt.Sprintf(
+		"tr(%s/0/*,pk([d34db33f/86'/0'/0']%s/1))", testXPub, testXPub,
+	))
+	require.NoError(t, err)
+	require.True(t, desc.IsRanged())
+	require.False(t, desc.HasLocalKey())
+
+	derived0, err := desc.Derive(0, nil)
+	require.NoError(t, err)
+	derived1, err := desc.Derive(1, nil)
+	require.NoError(t, err)
+
+	require.False(t, derived0.InternalKey.PubKey.IsEqual(
+		derived1.InternalKey.PubKey,
+	))
+	require.True(t, derived0.Leaves()[0].Keys[0].PubKey.IsEqual(
+		derived1.Leaves()[0].Keys[0].PubKey,
+	))
+
+	tree0, err := derived0.ScriptTree()
+	require.NoError(t, err)
+	tree1, err := derived1.ScriptTree()
+	require.NoError(t, err)
+	require.False(t, tree0.TaprootKey.IsEqual(tree1.TaprootKey))
+
+	// A key path only descriptor is tweaked BIP-0086 style.
+	keyOnly, err := tapscript.ParseDescriptor(
+		fmt.Sprintf("tr(%s/0/*)", testXPub),
+	)
+	require.NoError(t, err)
+	derivedKeyOnly, err := keyOnly.Derive(0, nil)
+	require.NoError(t, err)
+	keyOnlyTree, err := derivedKeyOnly.ScriptTree()
+	require.NoError(t, err)
+	require.Nil(t, keyOnlyTree.TapscriptRoot)
+
+	bip86Key := txscript.ComputeTaprootKeyNoScript(tree0.InternalKey)
+	require.True(t, keyOnlyTree.TaprootKey.IsEqual(bip86Key))
+}
+
+// TestParseDescriptorErrors tests that unsupported or malformed descriptors
+// are rejected.
+func TestParseDescriptorErrors(t *testing.T) {
+	t.Parallel()
+
+	key := hexKey(test.RandPubKey(t))
+	otherKey := hexKey(test.RandPubKey(t))
+
+	validDesc := fmt.Sprintf("tr(%s,pk(%s))", key, otherKey)
+	checksum, err := tapscript.DescriptorChecksum(validDesc)
+	require.NoError(t, err)
+
+	_, err = tapscript.ParseDescriptor(validDesc + "#" + checksum)
+	require.NoError(t, err)
+	_, err = tapscript.ParseDescriptor(validDesc + "#qqqqqqqq")
+	require.ErrorIs(t, err, tapscript.ErrDescriptorChecksum)
+
+	testCases := []struct {
+		name string
+		desc string
+	}{{
+		name: "not a tr descriptor",
+		desc: fmt.Sprintf("wpkh(%s)", key),
+	}, {
+		name: "single element branch",
+		desc: fmt.Sprintf("tr(%s,{pk(%s)})", key, otherKey),
+	}, {
+		name: "threshold above number of keys",
+		desc: fmt.Sprintf(
+			"tr(%s,multi_a(3,%s,%s))", key, key, otherKey,
+		),
+	}, {
+		name: "duplicate multi_a key",
+		desc: fmt.Sprintf(
+			"tr(%s,multi_a(1,%s,%s))", key, otherKey, otherKey,
+		),
+	}, {
+		name: "hardened derivation",
+		desc: fmt.Sprintf("tr(%s/0'/*)", testXPub),
+	}, {
+		name: "zero lock time",
+		desc: fmt.Sprintf(
+			"tr(%s,and_v(v:pk(%s),older(0)))", key, otherKey,
+		),
+	}, {
+		name: "unsupported fragment",
+		desc: fmt.Sprintf(
+			"tr(%s,and_v(v:pk(%s),sha256(%s)))", key, otherKey,
+			key,
+		),
+	}, {
+		name: "trailing characters",
+		desc: fmt.Sprintf("tr(%s)x", key),
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tapscript.ParseDescriptor(tc.desc)
+			require.ErrorIs(t, err, tapscript.ErrInvalidDescriptor)
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package tapscript
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+const (
+	// MaxHtlcCsvDelay is the maximum relative time lock in blocks that can
+	// be used for the timeout path of an asset level HTLC. This is the
+	// maximum value that can be encoded in the relative lock time of a
+	// transaction input.
+	MaxHtlcCsvDelay = wire.SequenceLockTimeMask
+)
+
+// HtlcScriptTree is the tapscript tree of an asset level hash time locked
+// contract (HTLC). An asset locked to the script key of the tree can either be
+// claimed by the receiver by revealing the preimage of the payment hash, or
+// reclaimed by the sender once the relative time lock of the timeout path has
+// expired. The internal key of the tree is the NUMS key, so the asset can only
+// be spent through one of the two script paths.
+type HtlcScriptTree struct {
+	input.ScriptTree
+
+	// SuccessTapLeaf is the tapscript leaf that allows the receiver to
+	// claim the asset by revealing the preimage of the payment hash.
+	SuccessTapLeaf txscript.TapLeaf
+
+	// TimeoutTapLeaf is the tapscript leaf that allows the sender to
+	// reclaim the asset after the relative time lock expired.
+	TimeoutTapLeaf txscript.TapLeaf
+
+	// CsvDelay is the relative time lock in blocks of the timeout path.
+	CsvDelay uint32
+}
+
+// NewHtlcScriptTree creates the tapscript tree of an asset level HTLC that
+// pays to the claim key if the preimage of the payment hash is revealed, or
+// back to the refund key after the given number of blocks.
+func NewHtlcScriptTree(claimKey, refundKey *btcec.PublicKey,
+	paymentHash lntypes.Hash, csvDelay uint32) (*HtlcScriptTree, error) {
+
+	if csvDelay == 0 || csvDelay > MaxHtlcCsvDelay {
+		return nil, fmt.Errorf("invalid HTLC CSV delay %d, must be "+
+			"between 1 and %d", csvDelay, MaxHtlcCsvDelay)
+	}
+
+	successScript, err := HtlcSuccessScript(claimKey, paymentHash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create success script: %w",
+			err)
+	}
+	timeoutScript, err := HtlcTimeoutScript(refundKey, csvDelay)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create timeout script: %w",
+			err)
+	}
+
+	successLeaf := txscript.NewBaseTapLeaf(successScript)
+	timeoutLeaf := txscript.NewBaseTapLeaf(timeoutScript)
+	tapscriptTree := txscript.AssembleTaprootScriptTree(
+		successLeaf, timeoutLeaf,
+	)
+	tapscriptRoot := tapscriptTree.RootNode.TapHash()
+
+	outputKey := txscript.ComputeTaprootOutputKey(
+		asset.NUMSPubKey, tapscriptRoot[:],
+	)
+
+	return &HtlcScriptTree{
+		ScriptTree: input.ScriptTree{
+			InternalKey:   asset.NUMSPubKey,
+			TaprootKey:    outputKey,
+			TapscriptTree: tapscriptTree,
+			TapscriptRoot: tapscriptRoot[:],
+		},
+		SuccessTapLeaf: successLeaf,
+		TimeoutTapLeaf: timeoutLeaf,
+		CsvDelay:       csvDelay,
+	}, nil
+}
+
+// HtlcSuccessScript returns the script of the success path of an asset level
+// HTLC. The script requires the 32-byte preimage of the payment hash and a
+// signature of the claim key:
+//
+//	OP_SIZE 32 OP_EQUALVERIFY OP_SHA256 <payment_hash> OP_EQUALVERIFY
+//	<claim_key> OP_CHECKSIG
+func HtlcSuccessScript(claimKey *btcec.PublicKey,
+	paymentHash lntypes.Hash) ([]byte, error) {
+
+	return txscript.NewScriptBuilder().
+		AddOp(txscript.OP_SIZE).
+		AddInt64(lntypes.PreimageSize).
+		AddOp(txscript.OP_EQUALVERIFY).
+		AddOp(txscript.OP_SHA256).
+		AddData(paymentHash[:]).
+		AddOp(txscript.OP_EQUALVERIFY).
+		AddData(schnorr.SerializePubKey(claimKey)).
+		AddOp(txscript.OP_CHECKSIG).
+		Script()
+}
+
+// HtlcTimeoutScript returns the script of the timeout path of an asset level
+// HTLC. The script requires a signature of the refund key and the relative
+// lock time of the spending asset to be at least the given CSV delay:
+//
+//	<refund_key> OP_CHECKSIGVERIFY <csv_delay> OP_CHECKSEQUENCEVERIFY
+func HtlcTimeoutScript(refundKey *btcec.PublicKey,
+	csvDelay uint32) ([]byte, error) {
+
+	return txscript.NewScriptBuilder().
+		AddData(schnorr.SerializePubKey(refundKey)).
+		AddOp(txscript.OP_CHECKSIGVERIFY).
+		AddInt64(int64(csvDelay)).
+		AddOp(txscript.OP_CHECKSEQUENCEVERIFY).
+		Script()
+}
+
+// ScriptKey returns the asset script key of the HTLC. The tweaked script key
+// information is included, so the key can be declared to the local wallet.
+func (h *HtlcScriptTree) ScriptKey() asset.ScriptKey {
+	return asset.ScriptKey{
+		PubKey: asset.NewScriptKey(h.TaprootKey).PubKey,
+		TweakedScriptKey: &asset.TweakedScriptKey{
+			RawKey: keychain.KeyDescriptor{
+				PubKey: h.InternalKey,
+			},
+			Tweak: h.TapscriptRoot,
+		},
+	}
+}
+
+// SuccessControlBlock returns the serialized control block that proves the
+// inclusion of the success leaf in the tapscript tree.
+func (h *HtlcScriptTree) SuccessControlBlock() ([]byte, error) {
+	return h.controlBlock(h.SuccessTapLeaf)
+}
+
+// TimeoutControlBlock returns the serialized control block that proves the
+// inclusion of the timeout leaf in the tapscript tree.
+func (h *HtlcScriptTree) TimeoutControlBlock() ([]byte, error) {
+	return h.controlBlock(h.TimeoutTapLeaf)
+}
+
+// controlBlock returns the serialized control block of the given leaf.
+func (h *HtlcScriptTree) controlBlock(leaf txscript.TapLeaf) ([]byte, error) {
+	leafIdx, ok := h.TapscriptTree.LeafProofIndex[leaf.TapHash()]
+	if !ok {
+		return nil, fmt.Errorf("leaf not found in HTLC script tree")
+	}
+
+	leafProof := h.TapscriptTree.LeafMerkleProofs[leafIdx]
+	controlBlock := leafProof.ToControlBlock(h.InternalKey)
+
+	return controlBlock.ToBytes()
+}
+
+// SuccessWitness returns the asset witness that spends the HTLC through the
+// success path with the given signature of the claim key and the preimage of
+// the payment hash.
+func (h *HtlcScriptTree) SuccessWitness(sig []byte,
+	preimage lntypes.Preimage) (wire.TxWitness, error) {
+
+	controlBlock, err := h.SuccessControlBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return wire.TxWitness{
+		sig, preimage[:], h.SuccessTapLeaf.Script, controlBlock,
+	}, nil
+}
+
+// TimeoutWitness returns the asset witness that spends the HTLC through the
+// timeout path with the given signature of the refund key. The spending asset
+// must have a relative lock time of at least the CSV delay of the HTLC.
+func (h *HtlcScriptTree) TimeoutWitness(sig []byte) (wire.TxWitness, error) {
+	controlBlock, err := h.TimeoutControlBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return wire.TxWitness{
+		sig, h.TimeoutTapLeaf.Script, controlBlock,
+	}, nil
+}
@@ -0,0 +1,193 @@
+package tapscript_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightninglabs/taproot-assets/vm"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// htlcSpend creates a state transition that spends an asset locked to the
+// given HTLC script key with the given relative lock time, and returns the
+// new asset, the input set and the signature hash for the given leaf.
+func htlcSpend(t *testing.T, htlcTree *tapscript.HtlcScriptTree,
+	leaf txscript.TapLeaf, relativeLockTime uint64) (*asset.Asset,
+	commitment.InputSet, []byte) {
+
+	t.Helper()
+
+	genesis := asset.RandGenesis(t, asset.Normal)
+	inputAsset := asset.NewAssetNoErr(
+		t, genesis, 1000, 0, 0, htlcTree.ScriptKey(), nil,
+	)
+	prevID := asset.PrevID{
+		OutPoint:  test.RandOp(t),
+		ID:        genesis.ID(),
+		ScriptKey: asset.ToSerialized(inputAsset.ScriptKey.PubKey),
+	}
+
+	newAsset := inputAsset.Copy()
+	newAsset.ScriptKey = asset.RandScriptKey(t)
+	newAsset.RelativeLockTime = relativeLockTime
+	newAsset.PrevWitnesses = []asset.Witness{{
+		PrevID: &prevID,
+	}}
+
+	inputs := commitment.InputSet{
+		prevID: inputAsset,
+	}
+	virtualTx, _, err := tapscript.VirtualTx(newAsset, inputs)
+	require.NoError(t, err)
+
+	sigHash, err := tapscript.InputScriptSpendSigHash(
+		virtualTx, inputAsset, newAsset, 0, txscript.SigHashDefault,
+		&leaf,
+	)
+	require.NoError(t, err)
+
+	return newAsset, inputs, sigHash
+}
+
+// sign creates a Schnorr signature over the given hash.
+func sign(t *testing.T, privKey *btcec.PrivateKey, sigHash []byte) []byte {
+	sig, err := schnorr.Sign(privKey, sigHash)
+	require.NoError(t, err)
+
+	return sig.Serialize()
+}
+
+// TestHtlcScriptTree tests that an asset locked to an HTLC script key can only
+// be claimed with the preimage and the claim key, or refunded with the refund
+// key after the CSV delay.
+func TestHtlcScriptTree(t *testing.T) {
+	t.Parallel()
+
+	const csvDelay = 144
+
+	var (
+		claimKey    = test.RandPrivKey(t)
+		refundKey   = test.RandPrivKey(t)
+		preimage    lntypes.Preimage
+		badPreimage lntypes.Preimage
+	)
+	test.RandRead(t, preimage[:])
+	test.RandRead(t, badPreimage[:])
+
+	htlcTree, err := tapscript.NewHtlcScriptTree(
+		claimKey.PubKey(), refundKey.PubKey(), preimage.Hash(),
+		csvDelay,
+	)
+	require.NoError(t, err)
+
+	// The script key must commit to both leaves and to no key spend path.
+	scriptKey := htlcTree.ScriptKey()
+	require.True(t, asset.NUMSPubKey.IsEqual(
+		scriptKey.TweakedScriptKey.RawKey.PubKey,
+	))
+	require.Equal(
+		t, schnorr.SerializePubKey(htlcTree.TaprootKey),
+		schnorr.SerializePubKey(scriptKey.PubKey),
+	)
+
+	testCases := []struct {
+		name     string
+		timeout  bool
+		lockTime uint64
+		witness  func([]byte) (wire.TxWitness, error)
+		signer   *btcec.PrivateKey
+		valid    bool
+	}{{
+		name:   "claim with preimage",
+		signer: claimKey,
+		witness: func(sig []byte) (wire.TxWitness, error) {
+			return htlcTree.SuccessWitness(sig, preimage)
+		},
+		valid: true,
+	}, {
+		name:   "claim with wrong preimage",
+		signer: claimKey,
+		witness: func(sig []byte) (wire.TxWitness, error) {
+			return htlcTree.SuccessWitness(sig, badPreimage)
+		},
+	}, {
+		name:   "claim with refund key",
+		signer: refundKey,
+		witness: func(sig []byte) (wire.TxWitness, error) {
+			return htlcTree.SuccessWitness(sig, preimage)
+		},
+	}, {
+		name:     "refund after timeout",
+		timeout:  true,
+		lockTime: csvDelay,
+		signer:   refundKey,
+		witness:  htlcTree.TimeoutWitness,
+		valid:    true,
+	}, {
+		name:     "refund before timeout",
+		timeout:  true,
+		lockTime: csvDelay - 1,
+		signer:   refundKey,
+		witness:  htlcTree.TimeoutWitness,
+	}, {
+		name:     "refund with claim key",
+		timeout:  true,
+		lockTime: csvDelay,
+		signer:   claimKey,
+		witness:  htlcTree.TimeoutWitness,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			leaf := htlcTree.SuccessTapLeaf
+			if tc.timeout {
+				leaf = htlcTree.TimeoutTapLeaf
+			}
+
+			newAsset, inputs, sigHash := htlcSpend(
+				t, htlcTree, leaf, tc.lockTime,
+			)
+			witness, err := tc.witness(sign(t, tc.signer, sigHash))
+			require.NoError(t, err)
+			newAsset.PrevWitnesses[0].TxWitness = witness
+
+			engine, err := vm.New(
+				newAsset, nil, inputs,
+				vm.WithSkipTimeLockValidation(),
+			)
+			require.NoError(t, err)
+
+			err = engine.Execute()
+			if tc.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+		})
+	}
+}
+
+// TestHtlcScriptTreeInvalidCsv tests that an HTLC can't be created with an
+// invalid CSV delay.
+func TestHtlcScriptTreeInvalidCsv(t *testing.T) {
+	t.Parallel()
+
+	var paymentHash lntypes.Hash
+	test.RandRead(t, paymentHash[:])
+
+	for _, csvDelay := range []uint32{0, tapscript.MaxHtlcCsvDelay + 1} {
+		_, err := tapscript.NewHtlcScriptTree(
+			test.RandPubKey(t), test.RandPubKey(t), paymentHash,
+			csvDelay,
+		)
+		require.ErrorContains(t, err, "invalid HTLC CSV delay")
+	}
+}
@@ -1,11 +1,15 @@
 package tapscript
 
 import (
+	"context"
+
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightningnetwork/lnd/input"
 )
 
 // TxValidator is the interface used to validate an asset transfer
@@ -37,3 +41,30 @@ type Signer interface {
 	SignVirtualTx(signDesc *lndclient.SignDescriptor, tx *wire.MsgTx,
 		prevOut *wire.TxOut) (*schnorr.Signature, error)
 }
+
+// MuSig2Signer is the interface used to compute the witness for a Taproot Asset
+// virtual TX input that is locked to a MuSig2 script key. Since MuSig2 requires
+// multiple rounds of communication between the signers, the signing session is
+// identified by the local public nonce that was created for it.
+type MuSig2Signer interface {
+	// MuSig2CreateNonce creates a new MuSig2 signing session for the given
+	// script key and returns the local public nonce of the session.
+	MuSig2CreateNonce(ctx context.Context,
+		scriptKey asset.ScriptKey) ([musig2.PubNonceSize]byte, error)
+
+	// MuSig2PartialSign registers the public nonces of the other signers
+	// with the session identified by the local nonce and creates the local
+	// partial signature for the given message.
+	MuSig2PartialSign(ctx context.Context, scriptKey asset.ScriptKey,
+		localNonce [musig2.PubNonceSize]byte,
+		otherNonces [][musig2.PubNonceSize]byte,
+		msg [32]byte) ([input.MuSig2PartialSigSize]byte, error)
+
+	// MuSig2CombineSig combines the local partial signature of the session
+	// identified by the local nonce with the partial signatures of the
+	// other signers into the final Schnorr signature.
+	MuSig2CombineSig(ctx context.Context, scriptKey asset.ScriptKey,
+		localNonce [musig2.PubNonceSize]byte,
+		otherSigs [][input.MuSig2PartialSigSize]byte) (
+		*schnorr.Signature, error)
+}
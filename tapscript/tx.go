@@ -220,7 +220,7 @@ func VirtualTx(newAsset *asset.Asset, prevAssets commitment.InputSet) (
 // Taproot Asset virtual TX.
 func InputAssetPrevOut(prevAsset asset.Asset) (*wire.TxOut, error) {
 	switch prevAsset.ScriptVersion {
-	case asset.ScriptV0:
+	case asset.ScriptV0, asset.ScriptV1:
 		pkScript, err := PayToTaprootScript(prevAsset.ScriptKey.PubKey)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,614 @@
+package tapsend
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+)
+
+var (
+	// ErrVPacketMismatch is an error returned when virtual packets that
+	// should be combined don't describe the same virtual transaction.
+	ErrVPacketMismatch = errors.New(
+		"combine: virtual packets describe different transactions",
+	)
+
+	// ErrVPacketIncomplete is an error returned when a virtual packet
+	// should be finalized while some of its inputs are still unsigned.
+	ErrVPacketIncomplete = errors.New(
+		"combine: virtual packet not fully signed",
+	)
+)
+
+// VInputState describes how far the witness of a virtual input is signed.
+type VInputState uint8
+
+const (
+	// VInputUnsigned means the input doesn't have a witness yet.
+	VInputUnsigned VInputState = iota
+
+	// VInputPartiallySigned means the input is spent through a threshold
+	// multisig script leaf that doesn't have enough signatures yet.
+	VInputPartiallySigned
+
+	// VInputSigned means the input has a complete witness.
+	VInputSigned
+)
+
+// String returns a human-readable representation of the input state.
+func (s VInputState) String() string {
+	switch s {
+	case VInputUnsigned:
+		return "unsigned"
+
+	case VInputPartiallySigned:
+		return "partially_signed"
+
+	case VInputSigned:
+		return "signed"
+
+	default:
+		return fmt.Sprintf("<unknown:%d>", s)
+	}
+}
+
+// VInputStatus is the signing status of a single input of a virtual packet.
+type VInputStatus struct {
+	// PrevID is the ID of the asset the input spends.
+	PrevID asset.PrevID
+
+	// State is the signing state of the input.
+	State VInputState
+
+	// NumSignatures is the number of signatures the witness of the input
+	// already carries. This is only set if the input is spent through a
+	// threshold multisig script leaf.
+	NumSignatures uint32
+
+	// Threshold is the number of signatures required to spend the input.
+	// This is only set if the input is spent through a threshold multisig
+	// script leaf.
+	Threshold uint32
+}
+
+// VPacketStatus returns the signing status of each input of the given virtual
+// packet, in the order of the packet's inputs.
+func VPacketStatus(vPkt *tappsbt.VPacket) ([]VInputStatus, error) {
+	newAsset, err := witnessAsset(vPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	status := make([]VInputStatus, len(vPkt.Inputs))
+	for idx, vIn := range vPkt.Inputs {
+		witness := newAsset.PrevWitnesses[idx].TxWitness
+
+		status[idx] = VInputStatus{
+			PrevID: vIn.PrevID,
+			State:  VInputSigned,
+		}
+
+		// An input that is locked to a MuSig2 script key requires the
+		// partial signatures of all signers before the final witness
+		// can be created.
+		if len(witness) == 0 && vIn.MuSig2 != nil {
+			numSigs := len(vIn.MuSig2.PartialSigs)
+			status[idx].NumSignatures = uint32(numSigs)
+			status[idx].Threshold = uint32(
+				len(vIn.MuSig2.SignerKeys),
+			)
+
+			status[idx].State = VInputUnsigned
+			if numSigs > 0 {
+				status[idx].State = VInputPartiallySigned
+			}
+			continue
+		}
+
+		if len(witness) == 0 {
+			status[idx].State = VInputUnsigned
+			continue
+		}
+
+		leaf, ok := parseThresholdWitness(witness)
+		if !ok {
+			continue
+		}
+
+		status[idx].NumSignatures = uint32(leaf.numSignatures())
+		status[idx].Threshold = uint32(leaf.threshold)
+		if !leaf.complete() {
+			status[idx].State = VInputPartiallySigned
+		}
+	}
+
+	return status, nil
+}
+
+// VPacketSession collects the witnesses of a virtual packet that needs to be
+// signed by multiple parties. Each party signs its own copy of the packet,
+// either by signing the inputs it owns or by adding its signature to the
+// witness of an input that is spent through a threshold multisig script leaf
+// (<key_1> OP_CHECKSIG <key_2> OP_CHECKSIGADD ... <m> OP_NUMEQUAL). The
+// session merges the witnesses of all copies until every input is signed.
+type VPacketSession struct {
+	// vPkt is the combined virtual packet.
+	vPkt *tappsbt.VPacket
+
+	// txHash is the hash of the virtual transaction all packets of the
+	// session must describe.
+	txHash chainhash.Hash
+}
+
+// NewVPacketSession creates a new combiner session for the given virtual
+// packet. The packet may already carry some of the witnesses.
+func NewVPacketSession(vPkt *tappsbt.VPacket) (*VPacketSession, error) {
+	if len(vPkt.Inputs) == 0 || len(vPkt.Outputs) == 0 {
+		return nil, fmt.Errorf("virtual packet has no inputs or " +
+			"outputs")
+	}
+
+	txHash, err := virtualTxHash(vPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VPacketSession{
+		vPkt:   vPkt.Copy(),
+		txHash: txHash,
+	}, nil
+}
+
+// Combine merges the witnesses of the given copy of the session's virtual
+// packet into the combined packet. Inputs that are already fully signed keep
+// their witness, additional signatures for a threshold multisig leaf are
+// ignored once the threshold is met. The public nonces and partial signatures
+// of inputs that are locked to a MuSig2 script key are merged as well.
+func (s *VPacketSession) Combine(vPkt *tappsbt.VPacket) error {
+	if err := s.assertSameTransaction(vPkt); err != nil {
+		return err
+	}
+
+	newAsset, err := witnessAsset(s.vPkt)
+	if err != nil {
+		return err
+	}
+	otherAsset, err := witnessAsset(vPkt)
+	if err != nil {
+		return err
+	}
+
+	for idx := range s.vPkt.Inputs {
+		prevWitness := &newAsset.PrevWitnesses[idx]
+		merged, err := mergeWitnesses(
+			prevWitness.TxWitness,
+			otherAsset.PrevWitnesses[idx].TxWitness,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to merge witness of input "+
+				"%d: %w", idx, err)
+		}
+
+		prevWitness.TxWitness = merged
+
+		err = mergeMuSig2(s.vPkt.Inputs[idx], vPkt.Inputs[idx])
+		if err != nil {
+			return fmt.Errorf("unable to merge MuSig2 state of "+
+				"input %d: %w", idx, err)
+		}
+	}
+
+	isSplit, err := s.vPkt.HasSplitCommitment()
+	if err != nil {
+		return err
+	}
+	if isSplit {
+		updateSplitRootAssets(s.vPkt.Outputs, newAsset)
+	}
+
+	return nil
+}
+
+// Status returns the signing status of each input of the combined packet.
+func (s *VPacketSession) Status() ([]VInputStatus, error) {
+	return VPacketStatus(s.vPkt)
+}
+
+// IsComplete returns true if all inputs of the combined packet are signed.
+func (s *VPacketSession) IsComplete() (bool, error) {
+	status, err := s.Status()
+	if err != nil {
+		return false, err
+	}
+
+	for _, inputStatus := range status {
+		if inputStatus.State != VInputSigned {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// Finalize validates the witnesses of the fully signed combined packet and
+// returns a copy of it.
+func (s *VPacketSession) Finalize(
+	validator tapscript.WitnessValidator) (*tappsbt.VPacket, error) {
+
+	complete, err := s.IsComplete()
+	if err != nil {
+		return nil, err
+	}
+	if !complete {
+		return nil, ErrVPacketIncomplete
+	}
+
+	err = ValidateVirtualTransaction(s.vPkt, validator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid combined virtual packet: %w",
+			err)
+	}
+
+	return s.vPkt.Copy(), nil
+}
+
+// Packet returns a copy of the combined packet.
+func (s *VPacketSession) Packet() *tappsbt.VPacket {
+	return s.vPkt.Copy()
+}
+
+// assertSameTransaction makes sure the given packet describes the same virtual
+// transaction as the combined packet of the session.
+func (s *VPacketSession) assertSameTransaction(vPkt *tappsbt.VPacket) error {
+	if len(vPkt.Inputs) != len(s.vPkt.Inputs) ||
+		len(vPkt.Outputs) != len(s.vPkt.Outputs) {
+
+		return fmt.Errorf("%w: number of inputs or outputs differs",
+			ErrVPacketMismatch)
+	}
+
+	for idx, vIn := range vPkt.Inputs {
+		if vIn.PrevID != s.vPkt.Inputs[idx].PrevID {
+			return fmt.Errorf("%w: input %d differs",
+				ErrVPacketMismatch, idx)
+		}
+	}
+
+	for idx, vOut := range vPkt.Outputs {
+		sessionOut := s.vPkt.Outputs[idx]
+		if vOut.AnchorOutputIndex != sessionOut.AnchorOutputIndex {
+			return fmt.Errorf("%w: anchor output index of output "+
+				"%d differs", ErrVPacketMismatch, idx)
+		}
+	}
+
+	txHash, err := virtualTxHash(vPkt)
+	if err != nil {
+		return err
+	}
+	if txHash != s.txHash {
+		return fmt.Errorf("%w: virtual transaction %v doesn't match "+
+			"%v", ErrVPacketMismatch, txHash, s.txHash)
+	}
+
+	return nil
+}
+
+// witnessAsset returns the asset of the given virtual packet that carries the
+// witnesses of all inputs.
+func witnessAsset(vPkt *tappsbt.VPacket) (*asset.Asset, error) {
+	if len(vPkt.Outputs) == 0 {
+		return nil, fmt.Errorf("virtual packet has no outputs")
+	}
+
+	newAsset, _, _, err := transitionAssets(vPkt)
+	if err != nil {
+		return nil, err
+	}
+	if newAsset == nil {
+		return nil, ErrAssetMissing
+	}
+
+	if len(newAsset.PrevWitnesses) != len(vPkt.Inputs) {
+		return nil, fmt.Errorf("asset has %d witnesses for %d inputs",
+			len(newAsset.PrevWitnesses), len(vPkt.Inputs))
+	}
+
+	return newAsset, nil
+}
+
+// virtualTxHash returns the hash of the virtual transaction of the given
+// packet. The hash doesn't commit to any of the witnesses.
+func virtualTxHash(vPkt *tappsbt.VPacket) (chainhash.Hash, error) {
+	newAsset, _, prevAssets, err := transitionAssets(vPkt)
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+	if newAsset == nil {
+		return chainhash.Hash{}, ErrAssetMissing
+	}
+
+	virtualTx, _, err := tapscript.VirtualTx(newAsset, prevAssets)
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+
+	return virtualTx.TxHash(), nil
+}
+
+// mergeWitnesses merges two witnesses of the same virtual input.
+func mergeWitnesses(ours, theirs wire.TxWitness) (wire.TxWitness, error) {
+	switch {
+	case len(theirs) == 0:
+		return ours, nil
+
+	case len(ours) == 0:
+		return copyWitness(theirs), nil
+	}
+
+	ourLeaf, ourThreshold := parseThresholdWitness(ours)
+	theirLeaf, theirThreshold := parseThresholdWitness(theirs)
+
+	// A complete witness is never replaced. If only their witness is
+	// complete, we take it over.
+	switch {
+	case !ourThreshold || ourLeaf.complete():
+		return ours, nil
+
+	case !theirThreshold || theirLeaf.complete():
+		return copyWitness(theirs), nil
+	}
+
+	// Both witnesses are incomplete threshold witnesses, so we can only
+	// merge them if they spend the same script leaf.
+	if !bytes.Equal(ourLeaf.script, theirLeaf.script) ||
+		!bytes.Equal(ourLeaf.controlBlock, theirLeaf.controlBlock) {
+
+		return nil, fmt.Errorf("witnesses spend different script " +
+			"leaves")
+	}
+
+	merged := copyWitness(ours)
+	numSignatures := ourLeaf.numSignatures()
+	for idx, sig := range theirLeaf.signatures {
+		if numSignatures >= ourLeaf.threshold {
+			break
+		}
+
+		if len(merged[idx]) == 0 && len(sig) > 0 {
+			merged[idx] = bytes.Clone(sig)
+			numSignatures++
+		}
+	}
+
+	return merged, nil
+}
+
+// mergeMuSig2 adds the public nonces and partial signatures of the MuSig2
+// signing state of the other input to our input's state.
+func mergeMuSig2(ours, theirs *tappsbt.VInput) error {
+	if theirs.MuSig2 == nil {
+		return nil
+	}
+
+	if ours.MuSig2 == nil {
+		ours.MuSig2 = theirs.MuSig2.Copy()
+		return nil
+	}
+
+	if !equalKeys(ours.MuSig2.SignerKeys, theirs.MuSig2.SignerKeys) {
+		return ErrMuSig2SignerMismatch
+	}
+
+	for key, nonce := range theirs.MuSig2.PubNonces {
+		ourNonce, ok := ours.MuSig2.PubNonces[key]
+		if ok && ourNonce != nonce {
+			return fmt.Errorf("conflicting nonces for signer %x",
+				key[:])
+		}
+
+		ours.MuSig2.PubNonces[key] = nonce
+	}
+
+	for key, sig := range theirs.MuSig2.PartialSigs {
+		ourSig, ok := ours.MuSig2.PartialSigs[key]
+		if ok && ourSig != sig {
+			return fmt.Errorf("conflicting partial signatures "+
+				"for signer %x", key[:])
+		}
+
+		ours.MuSig2.PartialSigs[key] = sig
+	}
+
+	return nil
+}
+
+// copyWitness returns a deep copy of the given witness.
+func copyWitness(witness wire.TxWitness) wire.TxWitness {
+	witnessCopy := make(wire.TxWitness, len(witness))
+	for idx := range witness {
+		witnessCopy[idx] = bytes.Clone(witness[idx])
+	}
+
+	return witnessCopy
+}
+
+// thresholdWitness is a witness that spends a threshold multisig script leaf.
+type thresholdWitness struct {
+	// signatures are the witness elements for each of the keys of the
+	// script, an empty element means the key didn't sign (yet).
+	signatures [][]byte
+
+	// script is the threshold multisig script.
+	script []byte
+
+	// controlBlock is the control block of the script leaf.
+	controlBlock []byte
+
+	// threshold is the number of signatures required by the script.
+	threshold int
+}
+
+// numSignatures returns the number of signatures the witness carries.
+func (t *thresholdWitness) numSignatures() int {
+	var numSignatures int
+	for _, sig := range t.signatures {
+		if len(sig) > 0 {
+			numSignatures++
+		}
+	}
+
+	return numSignatures
+}
+
+// complete returns true if the witness carries enough signatures.
+func (t *thresholdWitness) complete() bool {
+	return t.numSignatures() >= t.threshold
+}
+
+// parseThresholdWitness attempts to parse the given witness as a script path
+// spend of a threshold multisig script leaf.
+func parseThresholdWitness(witness wire.TxWitness) (*thresholdWitness, bool) {
+	if len(witness) < 3 {
+		return nil, false
+	}
+
+	script := witness[len(witness)-2]
+	numKeys, threshold, ok := parseThresholdScript(script)
+	if !ok || numKeys != len(witness)-2 {
+		return nil, false
+	}
+
+	return &thresholdWitness{
+		signatures:   witness[:numKeys],
+		script:       script,
+		controlBlock: witness[len(witness)-1],
+		threshold:    threshold,
+	}, true
+}
+
+// parseThresholdScript attempts to parse the given script as a threshold
+// multisig script of the form:
+//
+//	<key_1> OP_CHECKSIG <key_2> OP_CHECKSIGADD ... <key_n> OP_CHECKSIGADD
+//	<m> OP_NUMEQUAL
+//
+// OP_EQUAL is accepted in place of OP_NUMEQUAL. The threshold check can also be
+// time locked, as created for the and_v(v:multi_a(...),older(n)) and
+// and_v(v:multi_a(...),after(n)) descriptor fragments:
+//
+//	... <m> OP_NUMEQUALVERIFY <n> OP_CHECKSEQUENCEVERIFY
+//
+// The number of keys and the threshold are returned.
+func parseThresholdScript(script []byte) (int, int, bool) {
+	var (
+		tokenizer = txscript.MakeScriptTokenizer(0, script)
+		numKeys   int
+		threshold int
+	)
+	for tokenizer.Next() {
+		// Each key is a 32-byte x-only public key followed by either
+		// OP_CHECKSIG for the first or OP_CHECKSIGADD for all other
+		// keys.
+		if len(tokenizer.Data()) == 32 {
+			if !tokenizer.Next() {
+				return 0, 0, false
+			}
+
+			expectedOp := byte(txscript.OP_CHECKSIGADD)
+			if numKeys == 0 {
+				expectedOp = txscript.OP_CHECKSIG
+			}
+			if tokenizer.Opcode() != expectedOp {
+				return 0, 0, false
+			}
+
+			numKeys++
+			continue
+		}
+
+		// The first element that isn't a key must be the threshold,
+		// followed by the final comparison.
+		var ok bool
+		threshold, ok = scriptNumber(
+			tokenizer.Opcode(), tokenizer.Data(),
+		)
+		if !ok || numKeys == 0 || !tokenizer.Next() {
+			return 0, 0, false
+		}
+
+		switch tokenizer.Opcode() {
+		case txscript.OP_NUMEQUAL, txscript.OP_EQUAL:
+
+		// A time locked threshold check is followed by the lock time
+		// check, which must end the script.
+		case txscript.OP_NUMEQUALVERIFY:
+			if !parseLockTimeCheck(&tokenizer) {
+				return 0, 0, false
+			}
+
+		default:
+			return 0, 0, false
+		}
+
+		break
+	}
+
+	// The script must end right after the comparison.
+	if tokenizer.Err() != nil || !tokenizer.Done() || threshold == 0 ||
+		threshold > numKeys {
+
+		return 0, 0, false
+	}
+
+	return numKeys, threshold, true
+}
+
+// parseLockTimeCheck attempts to parse the next elements of the script as a
+// lock time check of the form <n> OP_CHECKSEQUENCEVERIFY or
+// <n> OP_CHECKLOCKTIMEVERIFY.
+func parseLockTimeCheck(tokenizer *txscript.ScriptTokenizer) bool {
+	if !tokenizer.Next() {
+		return false
+	}
+
+	lockTime, ok := scriptNumber(tokenizer.Opcode(), tokenizer.Data())
+	if !ok || lockTime == 0 || !tokenizer.Next() {
+		return false
+	}
+
+	switch tokenizer.Opcode() {
+	case txscript.OP_CHECKSEQUENCEVERIFY, txscript.OP_CHECKLOCKTIMEVERIFY:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// scriptNumber decodes a positive number that is pushed to the stack by either
+// a small integer opcode or a minimal data push of up to four bytes.
+func scriptNumber(opcode byte, data []byte) (int, bool) {
+	if opcode >= txscript.OP_1 && opcode <= txscript.OP_16 {
+		return int(opcode - (txscript.OP_1 - 1)), true
+	}
+
+	// Larger numbers are encoded as little-endian with the sign in the
+	// most significant bit of the last byte.
+	if len(data) == 0 || len(data) > 4 || data[len(data)-1]&0x80 != 0 {
+		return 0, false
+	}
+
+	var buf [4]byte
+	copy(buf[:], data)
+
+	return int(binary.LittleEndian.Uint32(buf[:])), true
+}
@@ -0,0 +1,185 @@
+package tapsend_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/stretchr/testify/require"
+)
+
+// thresholdScript creates a threshold multisig script that requires the given
+// number of signatures of the given number of random keys.
+func thresholdScript(t *testing.T, numKeys, threshold int) []byte {
+	builder := txscript.NewScriptBuilder()
+	for idx := 0; idx < numKeys; idx++ {
+		builder.AddData(schnorr.SerializePubKey(test.RandPubKey(t)))
+
+		if idx == 0 {
+			builder.AddOp(txscript.OP_CHECKSIG)
+		} else {
+			builder.AddOp(txscript.OP_CHECKSIGADD)
+		}
+	}
+	builder.AddInt64(int64(threshold))
+	builder.AddOp(txscript.OP_NUMEQUAL)
+
+	script, err := builder.Script()
+	require.NoError(t, err)
+
+	return script
+}
+
+// combinePacket creates an unsigned virtual packet that spends two inputs of
+// the same asset into a single interactive output.
+func combinePacket(t *testing.T) *tappsbt.VPacket {
+	genesis := asset.RandGenesis(t, asset.Normal)
+
+	vPkt := &tappsbt.VPacket{
+		ChainParams: &address.RegressionNetTap,
+		Version:     tappsbt.V1,
+	}
+
+	var prevWitnesses []asset.Witness
+	for idx := 0; idx < 2; idx++ {
+		inputAsset := asset.NewAssetNoErr(
+			t, genesis, 100, 0, 0, asset.RandScriptKey(t), nil,
+		)
+		prevID := asset.PrevID{
+			OutPoint: test.RandOp(t),
+			ID:       genesis.ID(),
+			ScriptKey: asset.ToSerialized(
+				inputAsset.ScriptKey.PubKey,
+			),
+		}
+
+		vPkt.Inputs = append(vPkt.Inputs, &tappsbt.VInput{
+			PrevID: prevID,
+		})
+		vPkt.SetInputAsset(idx, inputAsset)
+
+		prevWitnesses = append(prevWitnesses, asset.Witness{
+			PrevID: &prevID,
+		})
+	}
+
+	scriptKey := asset.RandScriptKey(t)
+	newAsset := asset.NewAssetNoErr(t, genesis, 200, 0, 0, scriptKey, nil)
+	newAsset.PrevWitnesses = prevWitnesses
+
+	vPkt.Outputs = []*tappsbt.VOutput{{
+		Amount:            200,
+		Type:              tappsbt.TypeSimple,
+		Interactive:       true,
+		AnchorOutputIndex: 0,
+		ScriptKey:         scriptKey,
+		Asset:             newAsset,
+	}}
+
+	return vPkt
+}
+
+// withWitnesses returns a copy of the given packet with the given witnesses
+// attached to its inputs.
+func withWitnesses(vPkt *tappsbt.VPacket,
+	witnesses ...wire.TxWitness) *tappsbt.VPacket {
+
+	vPktCopy := vPkt.Copy()
+	for idx, witness := range witnesses {
+		vPktCopy.Outputs[0].Asset.PrevWitnesses[idx].TxWitness = witness
+	}
+
+	return vPktCopy
+}
+
+// TestVPacketSession tests that the witnesses of several partially signed
+// copies of a virtual packet are combined correctly.
+func TestVPacketSession(t *testing.T) {
+	t.Parallel()
+
+	var (
+		vPkt         = combinePacket(t)
+		script       = thresholdScript(t, 3, 2)
+		otherScript  = thresholdScript(t, 3, 2)
+		controlBlock = test.RandBytes(33)
+		keySpendSig  = test.RandBytes(64)
+		sig1         = test.RandBytes(64)
+		sig2         = test.RandBytes(64)
+		sig3         = test.RandBytes(64)
+	)
+
+	// Alice signs the first input through the key path and adds the first
+	// signature to the threshold witness of the second input.
+	alicePkt := withWitnesses(
+		vPkt, wire.TxWitness{keySpendSig},
+		wire.TxWitness{nil, nil, sig1, script, controlBlock},
+	)
+
+	session, err := tapsend.NewVPacketSession(alicePkt)
+	require.NoError(t, err)
+
+	status, err := session.Status()
+	require.NoError(t, err)
+	require.Len(t, status, 2)
+	require.Equal(t, vPkt.Inputs[0].PrevID, status[0].PrevID)
+	require.Equal(t, tapsend.VInputSigned, status[0].State)
+	require.Equal(t, tapsend.VInputPartiallySigned, status[1].State)
+	require.EqualValues(t, 1, status[1].NumSignatures)
+	require.EqualValues(t, 2, status[1].Threshold)
+
+	_, err = session.Finalize(&mockWitnessValidator{})
+	require.ErrorIs(t, err, tapsend.ErrVPacketIncomplete)
+
+	// A packet that describes a different virtual transaction can't be
+	// combined.
+	otherPkt := withWitnesses(vPkt)
+	otherPkt.Outputs[0].Asset.ScriptKey = asset.RandScriptKey(t)
+	err = session.Combine(otherPkt)
+	require.ErrorIs(t, err, tapsend.ErrVPacketMismatch)
+
+	// A partial witness for a different script leaf can't be merged.
+	otherLeafPkt := withWitnesses(
+		vPkt, nil,
+		wire.TxWitness{nil, sig2, nil, otherScript, controlBlock},
+	)
+	err = session.Combine(otherLeafPkt)
+	require.ErrorContains(t, err, "different script leaves")
+
+	// Bob adds the second signature, which completes the packet.
+	bobPkt := withWitnesses(
+		vPkt, nil, wire.TxWitness{nil, sig2, nil, script, controlBlock},
+	)
+	require.NoError(t, session.Combine(bobPkt))
+
+	complete, err := session.IsComplete()
+	require.NoError(t, err)
+	require.True(t, complete)
+
+	// Any further signature is ignored once the threshold is met, since
+	// the script requires the exact number of signatures.
+	carolPkt := withWitnesses(
+		vPkt, nil, wire.TxWitness{sig3, nil, nil, script, controlBlock},
+	)
+	require.NoError(t, session.Combine(carolPkt))
+
+	finalPkt, err := session.Finalize(&mockWitnessValidator{})
+	require.NoError(t, err)
+
+	witnesses := finalPkt.Outputs[0].Asset.PrevWitnesses
+	require.Equal(t, wire.TxWitness{keySpendSig}, witnesses[0].TxWitness)
+	require.Equal(
+		t, wire.TxWitness{nil, sig2, sig1, script, controlBlock},
+		witnesses[1].TxWitness,
+	)
+
+	status, err = tapsend.VPacketStatus(finalPkt)
+	require.NoError(t, err)
+	require.Equal(t, tapsend.VInputSigned, status[1].State)
+	require.EqualValues(t, 2, status[1].NumSignatures)
+}
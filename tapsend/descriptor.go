@@ -0,0 +1,288 @@
+package tapsend
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+var (
+	// ErrDescriptorMismatch is returned when the descriptor of a virtual
+	// input's script key doesn't describe the script key.
+	ErrDescriptorMismatch = errors.New(
+		"send: descriptor doesn't match script key",
+	)
+
+	// ErrDescriptorUnsatisfiable is returned when none of the spend paths
+	// of the descriptor of a virtual input's script key can be satisfied
+	// by the local key with the lock times of the virtual transaction.
+	ErrDescriptorUnsatisfiable = errors.New(
+		"send: no spend path of descriptor can be satisfied",
+	)
+)
+
+// IsDescriptorInput returns true if the asset of the given virtual input is
+// locked to a script key that was imported from an output descriptor.
+func IsDescriptorInput(vIn *tappsbt.VInput) bool {
+	inputAsset := vIn.Asset()
+	if inputAsset == nil || inputAsset.ScriptKey.TweakedScriptKey == nil {
+		return false
+	}
+
+	return inputAsset.ScriptKey.Descriptor != nil
+}
+
+// signDescriptorInput creates the witness of the given virtual input, which is
+// locked to a script key that was imported from an output descriptor. The key
+// path is used if the local key is the internal key of the descriptor.
+// Otherwise, the first script path that the local key can satisfy with the
+// lock times of the new asset is used, preferring script paths that don't
+// require the signatures of other keys. If the input already carries a
+// partial threshold witness, for example because another signer already
+// signed and the packets were combined, the local signature is added to it.
+// The returned boolean indicates whether the witness is complete.
+func signDescriptorInput(vIn *tappsbt.VInput, virtualTx *wire.MsgTx,
+	newAsset *asset.Asset, idx int, coinType uint32,
+	signer tapscript.Signer) (wire.TxWitness, bool, error) {
+
+	scriptKey := vIn.Asset().ScriptKey
+	descInfo := scriptKey.Descriptor
+
+	desc, err := tapscript.ParseDescriptor(descInfo.Descriptor)
+	if err != nil {
+		return nil, false, err
+	}
+	scriptTree, err := desc.ScriptTree()
+	if err != nil {
+		return nil, false, err
+	}
+
+	// Make sure we're looking at the right descriptor before we sign
+	// anything.
+	if !bytes.Equal(
+		schnorr.SerializePubKey(scriptTree.TaprootKey),
+		schnorr.SerializePubKey(scriptKey.PubKey),
+	) {
+
+		return nil, false, ErrDescriptorMismatch
+	}
+
+	localKey := descInfo.LocalKey
+	existingWitness := newAsset.PrevWitnesses[idx].TxWitness
+	if len(existingWitness) > 0 {
+		return extendDescriptorWitness(
+			vIn, virtualTx, newAsset, existingWitness, scriptTree,
+			localKey, coinType, signer,
+		)
+	}
+
+	// If we control the internal key, we can simply spend through the key
+	// path.
+	if bytes.Equal(
+		schnorr.SerializePubKey(scriptTree.InternalKey),
+		schnorr.SerializePubKey(localKey.PubKey),
+	) {
+
+		setDescriptorDerivation(
+			vIn, localKey, coinType, scriptTree.TapscriptRoot, nil,
+		)
+		witness, err := CreateTaprootSignature(
+			vIn, virtualTx, 0, signer,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return witness, true, nil
+	}
+
+	leaf := selectDescriptorLeaf(
+		scriptTree.Leaves, localKey.PubKey, newAsset,
+	)
+	if leaf == nil {
+		return nil, false, fmt.Errorf("%w: lock time %d, relative "+
+			"lock time %d", ErrDescriptorUnsatisfiable,
+			newAsset.LockTime, newAsset.RelativeLockTime)
+	}
+
+	sig, err := signDescriptorLeaf(
+		vIn, virtualTx, scriptTree, leaf, localKey, coinType, signer,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// A single signature check only needs our signature.
+	if leaf.Type == tapscript.DescriptorLeafPk {
+		return wire.TxWitness{
+			sig, leaf.TapLeaf.Script, leaf.ControlBlock,
+		}, true, nil
+	}
+
+	// For a threshold check, the witness contains an element for each key
+	// in the reverse order of the keys in the script, since the signature
+	// for the first key is checked first and therefore needs to be on top
+	// of the stack. The elements of the keys that didn't sign are empty.
+	numKeys := len(leaf.Keys)
+	witness := make(wire.TxWitness, numKeys, numKeys+2)
+	witness[numKeys-1-leaf.KeyIndex(localKey.PubKey)] = sig
+	witness = append(witness, leaf.TapLeaf.Script, leaf.ControlBlock)
+
+	return witness, leaf.Threshold == 1, nil
+}
+
+// extendDescriptorWitness adds the local signature to the given partial
+// threshold witness of a virtual input that is locked to a script key that was
+// imported from an output descriptor. Witnesses that are already complete or
+// that the local key can't contribute to are returned unchanged.
+func extendDescriptorWitness(vIn *tappsbt.VInput, virtualTx *wire.MsgTx,
+	newAsset *asset.Asset, witness wire.TxWitness,
+	scriptTree *tapscript.DescriptorScriptTree,
+	localKey keychain.KeyDescriptor, coinType uint32,
+	signer tapscript.Signer) (wire.TxWitness, bool, error) {
+
+	partialWitness, ok := parseThresholdWitness(witness)
+	if !ok {
+		return witness, true, nil
+	}
+	if partialWitness.complete() {
+		return witness, true, nil
+	}
+
+	var leaf *tapscript.DescriptorTapLeaf
+	for _, descLeaf := range scriptTree.Leaves {
+		if bytes.Equal(descLeaf.TapLeaf.Script, partialWitness.script) {
+			leaf = descLeaf
+			break
+		}
+	}
+	if leaf == nil {
+		return nil, false, fmt.Errorf("partial witness spends a " +
+			"script path that isn't part of the descriptor")
+	}
+
+	keyIdx := leaf.KeyIndex(localKey.PubKey)
+	if keyIdx < 0 {
+		return witness, false, nil
+	}
+
+	sigIdx := len(partialWitness.signatures) - 1 - keyIdx
+	if len(partialWitness.signatures[sigIdx]) > 0 {
+		return witness, false, nil
+	}
+
+	if !leaf.LockTimeSatisfied(newAsset.LockTime,
+		newAsset.RelativeLockTime) {
+
+		return nil, false, fmt.Errorf("%w: lock time %d, relative "+
+			"lock time %d", ErrDescriptorUnsatisfiable,
+			newAsset.LockTime, newAsset.RelativeLockTime)
+	}
+
+	sig, err := signDescriptorLeaf(
+		vIn, virtualTx, scriptTree, leaf, localKey, coinType, signer,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	newWitness := copyWitness(witness)
+	newWitness[sigIdx] = sig
+
+	complete := partialWitness.numSignatures()+1 >= partialWitness.threshold
+
+	return newWitness, complete, nil
+}
+
+// selectDescriptorLeaf returns the first script path the given local key can
+// satisfy with the lock times of the given asset. Script paths that only
+// require the local key's signature are preferred over threshold checks that
+// also require the signatures of other keys. Nil is returned if there is no
+// such script path.
+func selectDescriptorLeaf(leaves []*tapscript.DescriptorTapLeaf,
+	localKey *btcec.PublicKey,
+	newAsset *asset.Asset) *tapscript.DescriptorTapLeaf {
+
+	var thresholdLeaf *tapscript.DescriptorTapLeaf
+	for _, leaf := range leaves {
+		if leaf.KeyIndex(localKey) < 0 {
+			continue
+		}
+
+		if !leaf.LockTimeSatisfied(
+			newAsset.LockTime, newAsset.RelativeLockTime,
+		) {
+
+			continue
+		}
+
+		if leaf.Threshold == 1 {
+			return leaf
+		}
+
+		if thresholdLeaf == nil {
+			thresholdLeaf = leaf
+		}
+	}
+
+	return thresholdLeaf
+}
+
+// signDescriptorLeaf creates the local signature for spending the given
+// virtual input through the given script path of its descriptor.
+func signDescriptorLeaf(vIn *tappsbt.VInput, virtualTx *wire.MsgTx,
+	scriptTree *tapscript.DescriptorScriptTree,
+	leaf *tapscript.DescriptorTapLeaf, localKey keychain.KeyDescriptor,
+	coinType uint32, signer tapscript.Signer) ([]byte, error) {
+
+	setDescriptorDerivation(
+		vIn, localKey, coinType, scriptTree.TapscriptRoot, leaf,
+	)
+
+	witness, err := CreateTaprootSignature(vIn, virtualTx, 0, signer)
+	if err != nil {
+		return nil, err
+	}
+
+	return witness[0], nil
+}
+
+// setDescriptorDerivation sets the derivation information of the local key and
+// the taproot spend information of the given virtual input, so it can be
+// signed with CreateTaprootSignature. If no script path is given, the input is
+// signed through the key path.
+func setDescriptorDerivation(vIn *tappsbt.VInput,
+	localKey keychain.KeyDescriptor, coinType uint32, tapscriptRoot []byte,
+	leaf *tapscript.DescriptorTapLeaf) {
+
+	derivation, trDerivation := tappsbt.Bip32DerivationFromKeyDesc(
+		localKey, coinType,
+	)
+	vIn.Bip32Derivation = []*psbt.Bip32Derivation{derivation}
+	vIn.TaprootBip32Derivation = []*psbt.TaprootBip32Derivation{
+		trDerivation,
+	}
+	vIn.TaprootMerkleRoot = tapscriptRoot
+	vIn.TaprootLeafScript = nil
+
+	if leaf == nil {
+		return
+	}
+
+	leafHash := leaf.TapLeaf.TapHash()
+	trDerivation.LeafHashes = [][]byte{leafHash[:]}
+	vIn.TaprootLeafScript = []*psbt.TaprootTapLeafScript{{
+		ControlBlock: leaf.ControlBlock,
+		Script:       leaf.TapLeaf.Script,
+		LeafVersion:  leaf.TapLeaf.LeafVersion,
+	}}
+}
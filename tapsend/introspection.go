@@ -0,0 +1,83 @@
+package tapsend
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/vm"
+)
+
+// IsIntrospectionInput returns true if the given virtual input spends a
+// ScriptV1 asset through a single tapscript leaf that isn't signed by any of
+// the keys of the input's Taproot BIP-0032 derivations, like a covenant leaf
+// that only constrains the asset outputs of the transfer.
+func IsIntrospectionInput(vIn *tappsbt.VInput) bool {
+	inputAsset := vIn.Asset()
+	if inputAsset == nil || inputAsset.ScriptVersion != asset.ScriptV1 {
+		return false
+	}
+
+	if len(vIn.TaprootLeafScript) != 1 {
+		return false
+	}
+
+	leafScript := vIn.TaprootLeafScript[0]
+	leafHash := txscript.NewTapLeaf(
+		leafScript.LeafVersion, leafScript.Script,
+	).TapHash()
+	for _, derivation := range vIn.TaprootBip32Derivation {
+		for _, signedLeafHash := range derivation.LeafHashes {
+			if bytes.Equal(signedLeafHash, leafHash[:]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// introspectionWitness creates the witness of the given introspection input,
+// which consists of the leaf script and its control block. The asset outputs
+// of a split transfer are only committed to by the split commitment root, so
+// they are revealed to the script in the annex of the witness, in the order of
+// the given split assets. The annex is committed to by signatures, which is
+// why the outputs can't be revealed to leaves that require a signature.
+func introspectionWitness(vIn *tappsbt.VInput,
+	splitAssets []*commitment.SplitAsset) (wire.TxWitness, error) {
+
+	leafScript := vIn.TaprootLeafScript[0]
+	witness := wire.TxWitness{
+		fn.CopySlice(leafScript.Script),
+		fn.CopySlice(leafScript.ControlBlock),
+	}
+
+	// A full value transfer doesn't have a split commitment, the new asset
+	// is the only output the script can inspect.
+	if len(splitAssets) == 0 {
+		return witness, nil
+	}
+
+	outputs := make([]*vm.IntrospectionOutput, 0, len(splitAssets))
+	for idx, splitAsset := range splitAssets {
+		output, err := vm.NewIntrospectionOutput(splitAsset)
+		if err != nil {
+			return nil, fmt.Errorf("unable to reveal output %d: "+
+				"%w", idx, err)
+		}
+
+		outputs = append(outputs, output)
+	}
+
+	annex, err := vm.EncodeIntrospectionAnnex(outputs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode annex: %w", err)
+	}
+
+	return append(witness, annex), nil
+}
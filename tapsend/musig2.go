@@ -0,0 +1,236 @@
+package tapsend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/input"
+)
+
+var (
+	// ErrMuSig2SignerMismatch is returned when the MuSig2 signing state of
+	// a virtual input doesn't list the same signers as the key aggregation
+	// context of the input's script key.
+	ErrMuSig2SignerMismatch = errors.New(
+		"send: MuSig2 signer keys don't match script key",
+	)
+)
+
+// IsMuSig2Input returns true if the asset of the given virtual input is locked
+// to a MuSig2 script key for which the key aggregation context is known.
+func IsMuSig2Input(vIn *tappsbt.VInput) bool {
+	inputAsset := vIn.Asset()
+	if inputAsset == nil || inputAsset.ScriptKey.TweakedScriptKey == nil {
+		return false
+	}
+
+	return inputAsset.ScriptKey.MuSig2 != nil
+}
+
+// SignMuSig2Inputs advances the MuSig2 signing session of each input of the
+// given virtual packet that is locked to a MuSig2 script key. Because MuSig2
+// requires multiple rounds of communication between the signers, each call
+// only performs the next step the local signer can take on its own: adding the
+// local public nonce, adding the local partial signature once the nonces of
+// all signers are known or combining the partial signatures of all signers
+// into the final key spend witness. The signing state is stored in the virtual
+// inputs, so copies of the packet signed by the different signers can be
+// combined with a VPacketSession between the rounds. The indexes of the
+// inputs that were advanced are returned.
+func SignMuSig2Inputs(ctx context.Context, vPkt *tappsbt.VPacket,
+	signer tapscript.MuSig2Signer) ([]uint32, error) {
+
+	if !fn.Any(vPkt.Inputs, IsMuSig2Input) {
+		return nil, nil
+	}
+	if signer == nil {
+		return nil, fmt.Errorf("no MuSig2 signer available")
+	}
+
+	newAsset, splitAssets, prevAssets, err := transitionAssets(vPkt)
+	if err != nil {
+		return nil, err
+	}
+	isSplit := len(splitAssets) > 0
+
+	virtualTx, _, err := tapscript.VirtualTx(newAsset, prevAssets)
+	if err != nil {
+		return nil, err
+	}
+
+	var signedInputs []uint32
+	for idx, vIn := range vPkt.Inputs {
+		if !IsMuSig2Input(vIn) {
+			continue
+		}
+
+		// There's nothing left to do for an input that already carries
+		// its final witness.
+		prevWitness := &newAsset.PrevWitnesses[idx]
+		if len(prevWitness.TxWitness) > 0 {
+			continue
+		}
+
+		sigHash, err := tapscript.InputKeySpendSigHash(
+			virtualTx, vIn.Asset(), newAsset, uint32(idx),
+			vIn.SighashType,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error computing sighash of "+
+				"input %d: %w", idx, err)
+		}
+
+		var msg [32]byte
+		copy(msg[:], sigHash)
+
+		witness, advanced, err := signMuSig2Input(
+			ctx, vIn, msg, signer,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error signing MuSig2 input "+
+				"%d: %w", idx, err)
+		}
+
+		if witness != nil {
+			prevWitness.TxWitness = witness
+		}
+		if advanced {
+			signedInputs = append(signedInputs, uint32(idx))
+		}
+	}
+
+	if isSplit && len(signedInputs) > 0 {
+		updateSplitRootAssets(vPkt.Outputs, newAsset)
+	}
+
+	return signedInputs, nil
+}
+
+// signMuSig2Input performs the next MuSig2 signing step of the local signer for
+// the given virtual input. The final witness is returned once the partial
+// signatures of all signers are known. The returned boolean indicates whether
+// the signing state of the input was advanced.
+func signMuSig2Input(ctx context.Context, vIn *tappsbt.VInput, msg [32]byte,
+	signer tapscript.MuSig2Signer) (wire.TxWitness, bool, error) {
+
+	scriptKey := vIn.Asset().ScriptKey
+	keyInfo := scriptKey.MuSig2
+
+	if vIn.MuSig2 == nil {
+		vIn.MuSig2 = tappsbt.NewMuSig2Input(keyInfo.SignerKeys)
+	}
+	state := vIn.MuSig2
+
+	if !equalKeys(state.SignerKeys, keyInfo.SignerKeys) {
+		return nil, false, ErrMuSig2SignerMismatch
+	}
+
+	// In the first round, every signer adds their public nonce. We can't
+	// do anything else until we know the nonces of all other signers.
+	localKey := asset.ToSerialized(keyInfo.LocalKey.PubKey)
+	localNonce, ok := state.PubNonces[localKey]
+	if !ok {
+		nonce, err := signer.MuSig2CreateNonce(ctx, scriptKey)
+		if err != nil {
+			return nil, false, fmt.Errorf("error creating "+
+				"nonce: %w", err)
+		}
+
+		state.PubNonces[localKey] = nonce
+
+		return nil, true, nil
+	}
+	if !state.HaveAllNonces() {
+		return nil, false, nil
+	}
+
+	// In the second round, every signer adds their partial signature.
+	var advanced bool
+	if _, ok := state.PartialSigs[localKey]; !ok {
+		otherNonces := make(
+			[][musig2.PubNonceSize]byte, 0, len(state.SignerKeys)-1,
+		)
+		for _, key := range remoteKeys(state, localKey) {
+			otherNonces = append(otherNonces, state.PubNonces[key])
+		}
+
+		partialSig, err := signer.MuSig2PartialSign(
+			ctx, scriptKey, localNonce, otherNonces, msg,
+		)
+		if err != nil {
+			return nil, false, fmt.Errorf("error creating "+
+				"partial signature: %w", err)
+		}
+
+		state.PartialSigs[localKey] = partialSig
+		advanced = true
+	}
+	if !state.HaveAllPartialSigs() {
+		return nil, advanced, nil
+	}
+
+	// Once all partial signatures are known, any of the signers can
+	// combine them into the final signature.
+	otherSigs := make(
+		[][input.MuSig2PartialSigSize]byte, 0, len(state.SignerKeys)-1,
+	)
+	for _, key := range remoteKeys(state, localKey) {
+		otherSigs = append(otherSigs, state.PartialSigs[key])
+	}
+
+	sig, err := signer.MuSig2CombineSig(
+		ctx, scriptKey, localNonce, otherSigs,
+	)
+	if err != nil {
+		return nil, false, fmt.Errorf("error combining partial "+
+			"signatures: %w", err)
+	}
+
+	witness := wire.TxWitness{sig.Serialize()}
+	if vIn.SighashType != txscript.SigHashDefault {
+		witness[0] = append(witness[0], byte(vIn.SighashType))
+	}
+
+	return witness, true, nil
+}
+
+// remoteKeys returns the serialized keys of all signers of the given MuSig2
+// signing state except the local one, in the order of the signer keys.
+func remoteKeys(state *tappsbt.MuSig2Input,
+	localKey asset.SerializedKey) []asset.SerializedKey {
+
+	keys := make([]asset.SerializedKey, 0, len(state.SignerKeys))
+	for _, signerKey := range state.SignerKeys {
+		key := asset.ToSerialized(signerKey)
+		if key != localKey {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// equalKeys returns true if both lists contain the same keys in the same
+// order.
+func equalKeys(a, b []*btcec.PublicKey) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for idx := range a {
+		if !a[idx].IsEqual(b[idx]) {
+			return false
+		}
+	}
+
+	return true
+}
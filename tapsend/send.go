@@ -11,6 +11,7 @@ import (
 	"math/bits"
 	"reflect"
 
+	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
@@ -27,7 +28,10 @@ import (
 	"github.com/lightninglabs/taproot-assets/tappsbt"
 	"github.com/lightninglabs/taproot-assets/tapscript"
 	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"golang.org/x/exp/maps"
 )
 
@@ -45,6 +49,12 @@ const (
 )
 
 var (
+	// ErrOutputAlreadyImported is returned by a wallet if a P2TR output
+	// that is being imported is already known to it.
+	ErrOutputAlreadyImported = errors.New(
+		"wallet: output already imported",
+	)
+
 	// ErrInvalidCollectibleSplit is returned when a collectible is split
 	// into more than two outputs.
 	ErrInvalidCollectibleSplit = errors.New(
@@ -130,6 +140,44 @@ var (
 	ErrNoRootLocator = errors.New(
 		"cannot create split commitment without split root output",
 	)
+
+	// ErrNoChangeOutput is an error returned when the fee of an anchor
+	// transaction should be bumped, but it doesn't have a change output
+	// the additional fee can be deducted from.
+	ErrNoChangeOutput = errors.New(
+		"bump fee: anchor transaction has no change output",
+	)
+
+	// ErrFeeRateTooLow is an error returned when the fee rate requested for
+	// a replacement anchor transaction isn't higher than the fee rate of
+	// the transaction it replaces.
+	ErrFeeRateTooLow = errors.New(
+		"bump fee: fee rate must be higher than current fee rate",
+	)
+
+	// ErrInsufficientChange is an error returned when the change output of
+	// an anchor transaction is too small to pay for the additional fee of
+	// a replacement transaction.
+	ErrInsufficientChange = errors.New(
+		"bump fee: change output too small for additional fee",
+	)
+
+	// ErrFeeIncreaseTooLow is an error returned when a replacement anchor
+	// transaction doesn't pay at least the incremental relay fee for its
+	// own size on top of the fee of the transaction it replaces (BIP 125,
+	// rule 4).
+	ErrFeeIncreaseTooLow = errors.New(
+		"bump fee: fee increase must at least pay the incremental " +
+			"relay fee",
+	)
+
+	// ErrNotAnchorReplacement is an error returned when a transaction
+	// spends the inputs of an anchor transaction, but isn't one of its fee
+	// bumped replacements.
+	ErrNotAnchorReplacement = errors.New(
+		"bump fee: transaction is not a replacement of anchor " +
+			"transaction",
+	)
 )
 
 var (
@@ -549,6 +597,7 @@ func PrepareOutputAssets(ctx context.Context, vPkt *tappsbt.VPacket) error {
 		vOut.Asset.ScriptKey = vOut.ScriptKey
 		vOut.Asset.LockTime = vOut.LockTime
 		vOut.Asset.RelativeLockTime = vOut.RelativeLockTime
+		vOut.Asset.ScriptVersion = vOut.ScriptVersion
 
 		// We also need to clear the split commitment root to avoid
 		// state from previous splits being carried over.
@@ -647,60 +696,24 @@ func PrepareOutputAssets(ctx context.Context, vPkt *tappsbt.VPacket) error {
 // change output in case of a non-interactive or partial amount send or the
 // full asset in case of an interactive full amount send) by creating a
 // signature over the asset transfer, verifying the transfer with the Taproot
-// Asset VM, and attaching that signature to the new Asset.
+// Asset VM, and attaching that signature to the new Asset. Inputs that are
+// locked to a MuSig2 script key are skipped, those are signed by
+// SignMuSig2Inputs instead. Inputs that are locked to a script key imported
+// from an output descriptor are signed through the key or script path the
+// local key can satisfy. Inputs that spend a ScriptV1 asset through a leaf
+// that doesn't require a signature only reveal that leaf, see
+// IsIntrospectionInput.
 func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer tapscript.Signer,
 	validator tapscript.WitnessValidator) error {
 
 	inputs := vPkt.Inputs
 	outputs := vPkt.Outputs
 
-	// If this is a split transfer, it means that the asset to be signed is
-	// the root asset, which is located at the change output.
-	isSplit, err := vPkt.HasSplitCommitment()
+	newAsset, splitAssets, prevAssets, err := transitionAssets(vPkt)
 	if err != nil {
 		return err
 	}
-
-	// Identify new output asset. For splits, the new asset that receives
-	// the signature is the one with the split root set to true.
-	newAsset := outputs[0].Asset
-	var splitAssets []*commitment.SplitAsset
-	if isSplit {
-		splitOut, err := vPkt.SplitRootOutput()
-		if err != nil {
-			return fmt.Errorf("no split root output found for "+
-				"split transaction: %w", err)
-		}
-		newAsset = splitOut.Asset
-
-		// If the transfer includes an asset split, we have to validate
-		// each split asset to ensure that our new Asset is committing
-		// to a valid SplitCommitment.
-		splitAssets = make([]*commitment.SplitAsset, len(outputs))
-		for idx := range outputs {
-			splitAssets[idx] = &commitment.SplitAsset{
-				Asset:       *outputs[idx].Asset,
-				OutputIndex: outputs[idx].AnchorOutputIndex,
-			}
-
-			// The output that houses the root asset in case of a
-			// split has a special field for the split asset, which
-			// actually contains the split commitment proof. We need
-			// to use that one for the validation, as the root asset
-			// is already validated as the newAsset.
-			if outputs[idx].Type.IsSplitRoot() {
-				splitAssets[idx].Asset =
-					*outputs[idx].SplitAsset
-			}
-		}
-	}
-
-	// Construct input set from all input assets.
-	prevAssets := make(commitment.InputSet, len(inputs))
-	for idx := range vPkt.Inputs {
-		input := vPkt.Inputs[idx]
-		prevAssets[input.PrevID] = input.Asset()
-	}
+	isSplit := len(splitAssets) > 0
 
 	// Create a Taproot Asset virtual transaction representing the asset
 	// transfer.
@@ -709,9 +722,35 @@ func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer tapscript.Signer,
 		return err
 	}
 
+	var witnessPending bool
 	for idx := range inputs {
 		in := inputs[idx]
 
+		// Inputs that are locked to a MuSig2 script key are signed in
+		// multiple rounds together with the other signers, see
+		// SignMuSig2Inputs.
+		if IsMuSig2Input(in) {
+			prevWitness := newAsset.PrevWitnesses[idx].TxWitness
+			witnessPending = witnessPending || len(prevWitness) == 0
+
+			continue
+		}
+
+		// Inputs that spend a ScriptV1 asset through a leaf that only
+		// inspects the asset outputs don't need a signature.
+		if IsIntrospectionInput(in) {
+			newWitness, err := introspectionWitness(in, splitAssets)
+			if err != nil {
+				return fmt.Errorf("error creating "+
+					"introspection witness for input %d: "+
+					"%w", idx, err)
+			}
+
+			newAsset.PrevWitnesses[idx].TxWitness = newWitness
+
+			continue
+		}
+
 		// For each input asset leaf, we need to produce a witness.
 		// Update the input of the virtual TX, generate a witness, and
 		// attach it to the copy of the new Asset.
@@ -721,6 +760,26 @@ func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer tapscript.Signer,
 			newAsset.RelativeLockTime, uint32(idx), nil,
 		)
 
+		// Inputs that are locked to a descriptor script key carry the
+		// information about their spend paths in the script key, so we
+		// can select the path ourselves. A threshold script path might
+		// still require the signatures of other parties.
+		if IsDescriptorInput(in) {
+			newWitness, complete, err := signDescriptorInput(
+				in, inputSpecificVirtualTx, newAsset, idx,
+				vPkt.ChainParams.HDCoinType, signer,
+			)
+			if err != nil {
+				return fmt.Errorf("error signing descriptor "+
+					"input %d: %w", idx, err)
+			}
+
+			newAsset.PrevWitnesses[idx].TxWitness = newWitness
+			witnessPending = witnessPending || !complete
+
+			continue
+		}
+
 		// Sign the virtual transaction based on the input script
 		// information (key spend or script spend).
 		newWitness, err := CreateTaprootSignature(
@@ -734,35 +793,128 @@ func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer tapscript.Signer,
 		newAsset.PrevWitnesses[idx].TxWitness = newWitness
 	}
 
-	err = validator.ValidateWitnesses(newAsset, splitAssets, prevAssets)
+	// We can only validate the witnesses once all of them are present,
+	// which isn't the case yet while the MuSig2 signing rounds of some of
+	// the inputs are still ongoing or threshold script paths still lack
+	// the signatures of other parties.
+	if !witnessPending {
+		err = validator.ValidateWitnesses(
+			newAsset, splitAssets, prevAssets,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if isSplit {
+		updateSplitRootAssets(outputs, newAsset)
+	}
+
+	return nil
+}
+
+// updateSplitRootAssets updates each split asset of the given outputs to store
+// the given root asset with its witnesses attached, so the receiver can verify
+// inclusion of the root asset.
+func updateSplitRootAssets(outputs []*tappsbt.VOutput,
+	rootAsset *asset.Asset) {
+
+	for idx := range outputs {
+		splitAsset := outputs[idx].Asset
+
+		// The output that houses the root asset in case of a split has
+		// a special field for the split asset. That asset is no longer
+		// needed (and isn't committed to anywhere), but in order for it
+		// to be validated externally, we still want to include it and
+		// therefore also want to update it with the signed root asset.
+		if outputs[idx].Type.IsSplitRoot() {
+			splitAsset = outputs[idx].SplitAsset
+		}
+
+		splitCommitment := splitAsset.PrevWitnesses[0].SplitCommitment
+		splitCommitment.RootAsset = *rootAsset.Copy()
+	}
+}
+
+// ValidateVirtualTransaction validates the witnesses of the new asset of the
+// given signed virtual packet with the Taproot Asset VM. This can be used to
+// make sure a packet signed by another party is valid before committing to it.
+func ValidateVirtualTransaction(vPkt *tappsbt.VPacket,
+	validator tapscript.WitnessValidator) error {
+
+	if len(vPkt.Outputs) == 0 {
+		return fmt.Errorf("virtual packet has no outputs")
+	}
+
+	newAsset, splitAssets, prevAssets, err := transitionAssets(vPkt)
 	if err != nil {
 		return err
 	}
+	if newAsset == nil {
+		return ErrAssetMissing
+	}
+
+	return validator.ValidateWitnesses(newAsset, splitAssets, prevAssets)
+}
+
+// transitionAssets extracts the new asset, the split assets (if the transfer
+// includes an asset split) and the input assets of the state transition of the
+// given virtual packet.
+func transitionAssets(vPkt *tappsbt.VPacket) (*asset.Asset,
+	[]*commitment.SplitAsset, commitment.InputSet, error) {
+
+	inputs := vPkt.Inputs
+	outputs := vPkt.Outputs
+
+	// If this is a split transfer, it means that the new asset is the root
+	// asset, which is located at the change output.
+	isSplit, err := vPkt.HasSplitCommitment()
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
+	// Identify new output asset. For splits, the new asset that receives
+	// the signature is the one with the split root set to true.
+	newAsset := outputs[0].Asset
+	var splitAssets []*commitment.SplitAsset
 	if isSplit {
-		// Update each split asset to store the root asset with the
-		// witness attached, so the receiver can verify inclusion of the
-		// root asset.
+		splitOut, err := vPkt.SplitRootOutput()
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("no split root "+
+				"output found for split transaction: %w", err)
+		}
+		newAsset = splitOut.Asset
+
+		// If the transfer includes an asset split, we have to validate
+		// each split asset to ensure that our new Asset is committing
+		// to a valid SplitCommitment.
+		splitAssets = make([]*commitment.SplitAsset, len(outputs))
 		for idx := range outputs {
-			splitAsset := outputs[idx].Asset
+			splitAssets[idx] = &commitment.SplitAsset{
+				Asset:       *outputs[idx].Asset,
+				OutputIndex: outputs[idx].AnchorOutputIndex,
+			}
 
 			// The output that houses the root asset in case of a
-			// split has a special field for the split asset. That
-			// asset is no longer needed (and isn't committed to
-			// anywhere), but in order for it to be validated
-			// externally, we still want to include it and therefore
-			// also want to update it with the signed root asset.
+			// split has a special field for the split asset, which
+			// actually contains the split commitment proof. We need
+			// to use that one for the validation, as the root asset
+			// is already validated as the newAsset.
 			if outputs[idx].Type.IsSplitRoot() {
-				splitAsset = outputs[idx].SplitAsset
+				splitAssets[idx].Asset =
+					*outputs[idx].SplitAsset
 			}
-
-			splitCommitment :=
-				splitAsset.PrevWitnesses[0].SplitCommitment
-			splitCommitment.RootAsset = *newAsset.Copy()
 		}
 	}
 
-	return nil
+	// Construct input set from all input assets.
+	prevAssets := make(commitment.InputSet, len(inputs))
+	for idx := range inputs {
+		input := inputs[idx]
+		prevAssets[input.PrevID] = input.Asset()
+	}
+
+	return newAsset, splitAssets, prevAssets, nil
 }
 
 // CreateTaprootSignature creates a Taproot signature for the given asset input.
@@ -1980,3 +2132,147 @@ func ValidateAnchorInputs(anchorPacket *psbt.Packet, packets []*tappsbt.VPacket,
 
 	return nil
 }
+
+// DefaultIncrementalRelayFee is the default incremental relay fee rate of
+// bitcoind, by which a replacement transaction needs to increase the fee of the
+// transaction it replaces, calculated over its own size.
+const DefaultIncrementalRelayFee = chainfee.SatPerKVByte(1000)
+
+// BumpAnchorFee prepares the given anchor PSBT packet to be signed again as a
+// replacement for the already broadcast signedTx that pays currentFee in total,
+// but with the given fee rate instead. The new fee must exceed currentFee by at
+// least the incremental relay fee. The additional fee is deducted from the
+// change output, all inputs and other outputs stay the same. That means any
+// Taproot Asset commitment in the anchor outputs stays valid, only the
+// transaction ID changes. Any final witness data is removed from the inputs,
+// so the packet needs to be signed again. The new total fee is returned.
+func BumpAnchorFee(pkt *psbt.Packet, changeIndex int32, signedTx *wire.MsgTx,
+	currentFee int64, feeRate chainfee.SatPerKWeight) (int64, error) {
+
+	if changeIndex < 0 || int(changeIndex) >= len(pkt.UnsignedTx.TxOut) {
+		return 0, ErrNoChangeOutput
+	}
+
+	// The replacement spends the same inputs as the signed transaction, so
+	// its witnesses will be of the same size. We can therefore use the
+	// weight of the signed transaction to calculate the new fee.
+	weight := lntypes.WeightUnit(
+		blockchain.GetTransactionWeight(btcutil.NewTx(signedTx)),
+	)
+	currentFeeRate := chainfee.NewSatPerKWeight(
+		btcutil.Amount(currentFee), weight,
+	)
+	if feeRate <= currentFeeRate {
+		return 0, fmt.Errorf("%w: current_fee_rate=%v, "+
+			"new_fee_rate=%v", ErrFeeRateTooLow,
+			currentFeeRate.FeePerKVByte(), feeRate.FeePerKVByte())
+	}
+
+	newFee := int64(feeRate.FeeForWeight(weight))
+	additionalFee := newFee - currentFee
+
+	// Nodes only relay the replacement if the additional fee pays for its
+	// own relay at the incremental relay fee rate.
+	minAdditionalFee := int64(
+		DefaultIncrementalRelayFee.FeeForVSize(weight.ToVB()),
+	)
+	if additionalFee < minAdditionalFee {
+		return 0, fmt.Errorf("%w: current_fee=%d, new_fee=%d, "+
+			"min_fee=%d", ErrFeeIncreaseTooLow, currentFee, newFee,
+			currentFee+minAdditionalFee)
+	}
+
+	changeOut := pkt.UnsignedTx.TxOut[changeIndex]
+	dustLimit := lnwallet.DustLimitForSize(len(changeOut.PkScript))
+	if changeOut.Value-additionalFee < int64(dustLimit) {
+		return 0, fmt.Errorf("%w: change_value=%d, additional_fee=%d",
+			ErrInsufficientChange, changeOut.Value, additionalFee)
+	}
+	changeOut.Value -= additionalFee
+
+	// The old signatures commit to the old change value, so they need to
+	// be removed for the wallet to sign the inputs again.
+	for idx := range pkt.Inputs {
+		pkt.Inputs[idx].FinalScriptSig = nil
+		pkt.Inputs[idx].FinalScriptWitness = nil
+	}
+
+	return newFee, nil
+}
+
+// MatchAnchorReplacement updates the given anchor PSBT packet to describe the
+// given transaction, which must be the anchor transaction itself or one of its
+// replacements created with BumpAnchorFee. Those only differ in the value of
+// the change output, so whichever of them confirms, the Taproot Asset
+// commitments in the anchor outputs are the same. The change output value and
+// the final witness data of the packet are taken from the given transaction and
+// the total fee it pays, given the packet pays currentFee, is returned.
+func MatchAnchorReplacement(pkt *psbt.Packet, changeIndex int32,
+	currentFee int64, tx *wire.MsgTx) (int64, error) {
+
+	unsignedTx := pkt.UnsignedTx
+	if len(unsignedTx.TxIn) != len(tx.TxIn) ||
+		len(unsignedTx.TxOut) != len(tx.TxOut) {
+
+		return 0, fmt.Errorf("%w: number of inputs or outputs "+
+			"differs", ErrNotAnchorReplacement)
+	}
+
+	for idx, txIn := range tx.TxIn {
+		prevOut := unsignedTx.TxIn[idx].PreviousOutPoint
+		if txIn.PreviousOutPoint != prevOut {
+			return 0, fmt.Errorf("%w: input %d spends %v instead "+
+				"of %v", ErrNotAnchorReplacement, idx,
+				txIn.PreviousOutPoint, prevOut)
+		}
+	}
+
+	var changeDelta int64
+	for idx, txOut := range tx.TxOut {
+		pktOut := unsignedTx.TxOut[idx]
+		if !bytes.Equal(txOut.PkScript, pktOut.PkScript) {
+			return 0, fmt.Errorf("%w: script of output %d differs",
+				ErrNotAnchorReplacement, idx)
+		}
+
+		switch {
+		case idx == int(changeIndex):
+			changeDelta = pktOut.Value - txOut.Value
+
+		case txOut.Value != pktOut.Value:
+			return 0, fmt.Errorf("%w: value of output %d differs",
+				ErrNotAnchorReplacement, idx)
+		}
+	}
+
+	// Now that we know the transaction is a replacement, we can copy over
+	// its change value and witnesses, so the packet can be extracted into
+	// the exact transaction again.
+	for idx, txIn := range tx.TxIn {
+		pIn := &pkt.Inputs[idx]
+		pIn.FinalScriptSig = nil
+		pIn.FinalScriptWitness = nil
+
+		if len(txIn.SignatureScript) > 0 {
+			pIn.FinalScriptSig = txIn.SignatureScript
+		}
+
+		if len(txIn.Witness) > 0 {
+			var witnessBuf bytes.Buffer
+			err := psbt.WriteTxWitness(&witnessBuf, txIn.Witness)
+			if err != nil {
+				return 0, fmt.Errorf("unable to serialize "+
+					"witness of input %d: %w", idx, err)
+			}
+
+			pIn.FinalScriptWitness = witnessBuf.Bytes()
+		}
+	}
+
+	if changeIndex >= 0 && int(changeIndex) < len(tx.TxOut) {
+		changeOut := unsignedTx.TxOut[changeIndex]
+		changeOut.Value = tx.TxOut[changeIndex].Value
+	}
+
+	return currentFee + changeDelta, nil
+}
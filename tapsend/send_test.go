@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
+	"fmt"
 	"testing"
 
 	"github.com/btcsuite/btcd/blockchain"
@@ -29,6 +31,8 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapsend"
 	"github.com/lightninglabs/taproot-assets/vm"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/maps"
 )
@@ -1080,6 +1084,139 @@ var signVirtualTransactionTestCases = []testCase{{
 	err: nil,
 }}
 
+// descriptorPacket creates a virtual packet that sends the full value of an
+// asset locked to the script key of the given descriptor template to the first
+// address of the spend scenario. The local key placeholder of the template is
+// replaced with the spender's key. The derived descriptor is returned as well.
+func descriptorPacket(t *testing.T, state *spendData,
+	template string) (*tappsbt.VPacket, string) {
+
+	desc, err := tapscript.ParseDescriptor(template)
+	require.NoError(t, err)
+	derivedDesc, err := desc.Derive(0, &state.spenderPubKey)
+	require.NoError(t, err)
+	scriptTree, err := derivedDesc.ScriptTree()
+	require.NoError(t, err)
+
+	scriptKey := scriptTree.ScriptKey()
+	scriptKey.Descriptor = &asset.DescriptorInfo{
+		Descriptor: derivedDesc.String(),
+		LocalKey:   state.spenderDescriptor,
+	}
+
+	inputAsset, err := asset.New(
+		state.genesis1, state.normalAmt1, 0, 0, scriptKey, nil,
+	)
+	require.NoError(t, err)
+	prevID := asset.PrevID{
+		ID:        inputAsset.ID(),
+		ScriptKey: asset.ToSerialized(scriptKey.PubKey),
+	}
+
+	pkt := createPacket(
+		state.address1, prevID, *state,
+		commitment.InputSet{prevID: inputAsset}, true,
+	)
+	err = tapsend.PrepareOutputAssets(context.Background(), pkt)
+	require.NoError(t, err)
+
+	return pkt, derivedDesc.String()
+}
+
+// TestSignVirtualTransactionDescriptor tests that inputs locked to a script key
+// imported from an output descriptor are signed through the spend path the
+// local key can satisfy.
+func TestSignVirtualTransactionDescriptor(t *testing.T) {
+	t.Parallel()
+
+	state := initSpendScenario(t)
+	remotePrivKey := test.RandPrivKey(t)
+	remoteKey := remotePrivKey.PubKey().SerializeCompressed()
+	internalKey := test.RandPubKey(t).SerializeCompressed()
+
+	// The local key can spend on its own once the lock time is reached,
+	// before that the remote key needs to sign as well.
+	template := fmt.Sprintf("tr(%x,{and_v(v:pk(@local),after(100)),"+
+		"multi_a(2,@local,%x)})", internalKey, remoteKey)
+
+	t.Run("key path", func(t *testing.T) {
+		pkt, _ := descriptorPacket(
+			t, &state, fmt.Sprintf("tr(@local,pk(%x))", remoteKey),
+		)
+
+		err := tapsend.SignVirtualTransaction(
+			pkt, state.signer, state.witnessValidator,
+		)
+		require.NoError(t, err)
+
+		witness := pkt.Outputs[0].Asset.PrevWitnesses[0].TxWitness
+		require.Len(t, witness, 1)
+	})
+
+	t.Run("time locked script path", func(t *testing.T) {
+		pkt, _ := descriptorPacket(t, &state, template)
+		pkt.Outputs[0].Asset.LockTime = 100
+
+		err := tapsend.SignVirtualTransaction(
+			pkt, state.signer, state.witnessValidator,
+		)
+		require.NoError(t, err)
+
+		witness := pkt.Outputs[0].Asset.PrevWitnesses[0].TxWitness
+		require.Len(t, witness, 3)
+	})
+
+	t.Run("threshold script path", func(t *testing.T) {
+		pkt, derivedDesc := descriptorPacket(t, &state, template)
+
+		// Before the lock time is reached, we can only add our
+		// signature to the threshold script path. The witness can't be
+		// validated yet, as the remote signature is still missing.
+		err := tapsend.SignVirtualTransaction(
+			pkt, state.signer, state.witnessValidator,
+		)
+		require.NoError(t, err)
+
+		witness := pkt.Outputs[0].Asset.PrevWitnesses[0].TxWitness
+		require.Len(t, witness, 4)
+		require.Empty(t, witness[0])
+		require.Len(t, witness[1], schnorr.SignatureSize)
+
+		// The remote signer knows the same descriptor, but with their
+		// own key as the local key. Their signature completes the
+		// witness, which is then validated.
+		remoteKeyDesc := keychain.KeyDescriptor{
+			PubKey: remotePrivKey.PubKey(),
+		}
+		inputScriptKey := &pkt.Inputs[0].Asset().ScriptKey
+		inputScriptKey.Descriptor = &asset.DescriptorInfo{
+			Descriptor: derivedDesc,
+			LocalKey:   remoteKeyDesc,
+		}
+		err = tapsend.SignVirtualTransaction(
+			pkt, tapscript.NewMockSigner(remotePrivKey),
+			state.witnessValidator,
+		)
+		require.NoError(t, err)
+
+		witness = pkt.Outputs[0].Asset.PrevWitnesses[0].TxWitness
+		require.Len(t, witness, 4)
+		require.Len(t, witness[0], schnorr.SignatureSize)
+		require.Len(t, witness[1], schnorr.SignatureSize)
+	})
+
+	t.Run("unsatisfiable", func(t *testing.T) {
+		pkt, _ := descriptorPacket(t, &state, fmt.Sprintf(
+			"tr(%x,and_v(v:pk(@local),after(100)))", internalKey,
+		))
+
+		err := tapsend.SignVirtualTransaction(
+			pkt, state.signer, state.witnessValidator,
+		)
+		require.ErrorIs(t, err, tapsend.ErrDescriptorUnsatisfiable)
+	})
+}
+
 // TestCreateOutputCommitments tests edge cases around creating TapCommitments
 // to represent an asset transfer.
 func TestCreateOutputCommitments(t *testing.T) {
@@ -1546,6 +1683,16 @@ func createSpend(t *testing.T, state *spendData, inputSet commitment.InputSet,
 	// correct outputs.
 	pkt.Outputs[1].AnchorOutputIndex = 1
 
+	btcPkt, outputCommitments := anchorSpend(t, state, pkt)
+
+	return btcPkt, pkt, outputCommitments
+}
+
+// anchorSpend prepares and signs the given virtual packet and creates the
+// anchor transaction that commits to its outputs.
+func anchorSpend(t *testing.T, state *spendData,
+	pkt *tappsbt.VPacket) (*psbt.Packet, tappsbt.OutputCommitments) {
+
 	err := tapsend.PrepareOutputAssets(context.Background(), pkt)
 	require.NoError(t, err)
 	err = tapsend.SignVirtualTransaction(
@@ -1564,7 +1711,7 @@ func createSpend(t *testing.T, state *spendData, inputSet commitment.InputSet,
 	err = tapsend.UpdateTaprootOutputKeys(btcPkt, pkt, outputCommitments)
 	require.NoError(t, err)
 
-	return btcPkt, pkt, outputCommitments
+	return btcPkt, outputCommitments
 }
 
 func createProofParams(t *testing.T, genesisTxIn wire.TxIn, state spendData,
@@ -1803,6 +1950,145 @@ func TestProofVerifyFullValueSplit(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// TestProofVerifyIntrospection tests that a ScriptV1 asset locked to a covenant
+// leaf can be spent through the virtual packet flow, and that the proofs of the
+// split transfer, which reveals its asset outputs in the annex of the witness,
+// verify.
+func TestProofVerifyIntrospection(t *testing.T) {
+	t.Parallel()
+
+	state := initSpendScenario(t)
+
+	// The covenant only allows spending asset 2 if the second output of
+	// the transfer sends the amount of address 1 to it.
+	minAmount := make([]byte, 8)
+	binary.LittleEndian.PutUint64(minAmount, state.address1.Amount)
+	assetID := state.asset2.ID()
+	covenant, err := txscript.NewScriptBuilder().
+		AddInt64(1).
+		AddOp(vm.OP_INSPECTOUTPUTSCRIPTKEY).
+		AddData(schnorr.SerializePubKey(&state.address1.ScriptKey)).
+		AddOp(txscript.OP_EQUALVERIFY).
+		AddInt64(1).
+		AddOp(vm.OP_INSPECTOUTPUTASSETID).
+		AddData(assetID[:]).
+		AddOp(txscript.OP_EQUALVERIFY).
+		AddInt64(1).
+		AddOp(vm.OP_INSPECTOUTPUTAMOUNT).
+		AddData(minAmount).
+		AddOp(vm.OP_GREATERTHANOREQUAL64).
+		Script()
+	require.NoError(t, err)
+
+	tapLeaf := txscript.NewBaseTapLeaf(covenant)
+	tapTree := txscript.AssembleTaprootScriptTree(tapLeaf)
+	tapTreeRoot := tapTree.RootNode.TapHash()
+	controlBlock := tapTree.LeafMerkleProofs[0].ToControlBlock(
+		&state.spenderPubKey,
+	)
+	controlBlockBytes, err := controlBlock.ToBytes()
+	require.NoError(t, err)
+
+	covenantKey := txscript.ComputeTaprootOutputKey(
+		&state.spenderPubKey, tapTreeRoot[:],
+	)
+	state.spenderScriptKey = *covenantKey
+	state.asset2.ScriptKey = asset.NewScriptKey(covenantKey)
+	state.asset2.ScriptVersion = asset.ScriptV1
+	updateScenarioCommitments(t, &state)
+
+	// Create a proof for the genesis of asset 2.
+	createGenesisProof(t, &state)
+
+	genesisProofFile, err := proof.NewFile(
+		proof.V0, state.asset2GenesisProof,
+	)
+	require.NoError(t, err)
+	var b bytes.Buffer
+	require.NoError(t, genesisProofFile.Encode(&b))
+	genesisProofBlob := b.Bytes()
+
+	genesisOutPoint := &wire.OutPoint{
+		Hash:  state.asset2GenesisProof.AnchorTx.TxHash(),
+		Index: state.asset2GenesisProof.InclusionProof.OutputIndex,
+	}
+	state.asset2PrevID = asset.PrevID{
+		OutPoint:  *genesisOutPoint,
+		ID:        assetID,
+		ScriptKey: asset.ToSerialized(covenantKey),
+	}
+	state.asset2InputAssets = commitment.InputSet{
+		state.asset2PrevID: &state.asset2,
+	}
+
+	// The change stays locked to the covenant, which requires the output
+	// to use the ScriptV1 script version again. The input is spent by only
+	// revealing the covenant leaf.
+	newPacket := func() *tappsbt.VPacket {
+		pkt := createPacket(
+			state.address1, state.asset2PrevID, state,
+			state.asset2InputAssets, false,
+		)
+		pkt.Outputs[0].ScriptVersion = asset.ScriptV1
+		pkt.Outputs[1].AnchorOutputIndex = 1
+		pkt.Inputs[0].TaprootLeafScript = []*psbt.TaprootTapLeafScript{{
+			ControlBlock: controlBlockBytes,
+			Script:       covenant,
+			LeafVersion:  txscript.BaseLeafVersion,
+		}}
+
+		return pkt
+	}
+
+	// Sending less than the covenant requires is rejected by the VM.
+	pkt := newPacket()
+	require.True(t, tapsend.IsIntrospectionInput(pkt.Inputs[0]))
+	pkt.Outputs[0].Amount++
+	pkt.Outputs[1].Amount--
+	err = tapsend.PrepareOutputAssets(context.Background(), pkt)
+	require.NoError(t, err)
+	err = tapsend.SignVirtualTransaction(
+		pkt, state.signer, state.witnessValidator,
+	)
+	var vmErr vm.Error
+	require.ErrorAs(t, err, &vmErr)
+	require.Equal(t, vm.ErrInvalidTransferWitness, vmErr.Kind)
+
+	pkt = newPacket()
+	btcPkt, outputCommitments := anchorSpend(t, &state, pkt)
+
+	// The outputs of the split are revealed in the annex.
+	rootWitness := pkt.Outputs[0].Asset.PrevWitnesses[0].TxWitness
+	require.Len(t, rootWitness, 3)
+	require.Equal(t, covenant, rootWitness[0])
+	require.Equal(t, asset.ScriptV1, pkt.Outputs[0].Asset.ScriptVersion)
+	require.Equal(t, asset.ScriptV0, pkt.Outputs[1].Asset.ScriptVersion)
+
+	genesisTxIn := wire.TxIn{PreviousOutPoint: *genesisOutPoint}
+	proofParams := createProofParams(
+		t, genesisTxIn, state, btcPkt, pkt, outputCommitments,
+	)
+
+	// The proofs of both outputs verify the covenant again.
+	for idx := range proofParams {
+		blob, _, err := proof.AppendTransition(
+			genesisProofBlob, &proofParams[idx],
+			proof.MockHeaderVerifier, proof.MockMerkleVerifier,
+			proof.MockGroupVerifier, proof.MockChainLookup,
+		)
+		require.NoError(t, err)
+
+		proofFile := proof.NewEmptyFile(proof.V0)
+		require.NoError(t, proofFile.Decode(bytes.NewReader(blob)))
+		_, err = proofFile.Verify(
+			context.Background(), proof.MockHeaderVerifier,
+			proof.MockMerkleVerifier, proof.MockGroupVerifier,
+			proof.MockChainLookup,
+		)
+		require.NoError(t, err)
+	}
+}
+
 // TestAddressValidInput tests edge cases around validating inputs for asset
 // transfers with isValidInput.
 func TestAddressValidInput(t *testing.T) {
@@ -2950,3 +3236,132 @@ func TestValidateAnchorInputs(t *testing.T) {
 		})
 	}
 }
+
+// TestBumpAnchorFee tests that the fee of an anchor transaction can be bumped
+// by only reducing the value of the change output.
+func TestBumpAnchorFee(t *testing.T) {
+	t.Parallel()
+
+	const (
+		anchorValue = 1_000
+		changeValue = 100_000
+		currentFee  = 500
+	)
+
+	// newPacket creates a signed anchor transaction with an asset anchor
+	// output and a change output, together with its PSBT packet.
+	newPacket := func() (*psbt.Packet, *wire.MsgTx) {
+		tx := wire.NewMsgTx(2)
+		tx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: test.RandOp(t),
+		})
+		tx.AddTxOut(&wire.TxOut{
+			Value:    anchorValue,
+			PkScript: tapsend.GenesisDummyScript,
+		})
+		tx.AddTxOut(&wire.TxOut{
+			Value:    changeValue,
+			PkScript: tapsend.GenesisDummyScript,
+		})
+
+		pkt, err := psbt.NewFromUnsignedTx(tx.Copy())
+		require.NoError(t, err)
+
+		witness := wire.TxWitness{bytes.Repeat([]byte{0x01}, 64)}
+		var witnessBuf bytes.Buffer
+		err = psbt.WriteTxWitness(&witnessBuf, witness)
+		require.NoError(t, err)
+
+		pkt.Inputs[0].FinalScriptWitness = witnessBuf.Bytes()
+		tx.TxIn[0].Witness = witness
+
+		return pkt, tx
+	}
+
+	pkt, signedTx := newPacket()
+	weight := lntypes.WeightUnit(
+		blockchain.GetTransactionWeight(btcutil.NewTx(signedTx)),
+	)
+	currentFeeRate := chainfee.NewSatPerKWeight(currentFee, weight)
+
+	// The fee rate must be higher than the current one.
+	_, err := tapsend.BumpAnchorFee(
+		pkt, 1, signedTx, currentFee, currentFeeRate,
+	)
+	require.ErrorIs(t, err, tapsend.ErrFeeRateTooLow)
+
+	// A higher fee rate must also increase the fee by at least the
+	// incremental relay fee for the size of the replacement.
+	_, err = tapsend.BumpAnchorFee(
+		pkt, 1, signedTx, currentFee, currentFeeRate+1,
+	)
+	require.ErrorIs(t, err, tapsend.ErrFeeIncreaseTooLow)
+
+	// We need a change output to deduct the fee from.
+	_, err = tapsend.BumpAnchorFee(
+		pkt, -1, signedTx, currentFee, currentFeeRate*2,
+	)
+	require.ErrorIs(t, err, tapsend.ErrNoChangeOutput)
+
+	// A fee that would turn the change output into dust is rejected.
+	_, err = tapsend.BumpAnchorFee(
+		pkt, 1, signedTx, currentFee, chainfee.NewSatPerKWeight(
+			changeValue+currentFee, weight,
+		),
+	)
+	require.ErrorIs(t, err, tapsend.ErrInsufficientChange)
+
+	// None of the failed attempts should have modified the packet.
+	require.EqualValues(t, changeValue, pkt.UnsignedTx.TxOut[1].Value)
+	require.NotEmpty(t, pkt.Inputs[0].FinalScriptWitness)
+
+	// A valid fee bump only reduces the change output by the additional
+	// fee and removes the old witness.
+	newFeeRate := currentFeeRate * 3
+	newFee, err := tapsend.BumpAnchorFee(
+		pkt, 1, signedTx, currentFee, newFeeRate,
+	)
+	require.NoError(t, err)
+	require.EqualValues(
+		t, newFeeRate.FeeForWeight(weight), newFee,
+	)
+	require.Greater(t, newFee, int64(currentFee))
+
+	require.EqualValues(
+		t, changeValue-(newFee-currentFee),
+		pkt.UnsignedTx.TxOut[1].Value,
+	)
+	require.EqualValues(t, anchorValue, pkt.UnsignedTx.TxOut[0].Value)
+	require.Equal(
+		t, signedTx.TxIn[0].PreviousOutPoint,
+		pkt.UnsignedTx.TxIn[0].PreviousOutPoint,
+	)
+	require.Nil(t, pkt.Inputs[0].FinalScriptWitness)
+
+	// If the original transaction confirms instead of the replacement, the
+	// packet can be matched to it again.
+	_, otherTx := newPacket()
+	_, err = tapsend.MatchAnchorReplacement(
+		pkt, 1, newFee, otherTx,
+	)
+	require.ErrorIs(t, err, tapsend.ErrNotAnchorReplacement)
+
+	otherTx.TxIn[0].PreviousOutPoint = signedTx.TxIn[0].PreviousOutPoint
+	otherTx.TxOut[0].Value++
+	_, err = tapsend.MatchAnchorReplacement(
+		pkt, 1, newFee, otherTx,
+	)
+	require.ErrorIs(t, err, tapsend.ErrNotAnchorReplacement)
+
+	originalFee, err := tapsend.MatchAnchorReplacement(
+		pkt, 1, newFee, signedTx,
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, currentFee, originalFee)
+	require.EqualValues(t, changeValue, pkt.UnsignedTx.TxOut[1].Value)
+
+	extractedTx, err := psbt.Extract(pkt)
+	require.NoError(t, err)
+	require.Equal(t, signedTx.TxHash(), extractedTx.TxHash())
+	require.Equal(t, signedTx.WitnessHash(), extractedTx.WitnessHash())
+}
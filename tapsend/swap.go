@@ -0,0 +1,183 @@
+package tapsend
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// ValidateSwapLeg checks that the signed virtual packets of one leg of an asset
+// swap are valid and pay exactly the amount requested by the given address of
+// the counterparty. The output paying the address must be the only asset
+// output of the leg that is committed to its anchor output, otherwise the
+// receiver wouldn't be able to detect the payment.
+func ValidateSwapLeg(vPackets []*tappsbt.VPacket, addr *address.Tap,
+	validator tapscript.WitnessValidator) error {
+
+	if len(vPackets) == 0 {
+		return fmt.Errorf("swap leg has no virtual packets")
+	}
+
+	addrSibling, _, err := commitment.MaybeEncodeTapscriptPreimage(
+		addr.TapscriptSibling,
+	)
+	if err != nil {
+		return fmt.Errorf("error encoding address tapscript sibling: "+
+			"%w", err)
+	}
+
+	var (
+		addrScriptKey   = schnorr.SerializePubKey(&addr.ScriptKey)
+		addrInternalKey = schnorr.SerializePubKey(&addr.InternalKey)
+		anchorOutputs   = make(map[uint32]int)
+		paymentOut      *tappsbt.VOutput
+	)
+	for pIdx, vPkt := range vPackets {
+		err := ValidateVirtualTransaction(vPkt, validator)
+		if err != nil {
+			return fmt.Errorf("invalid virtual transaction %d: %w",
+				pIdx, err)
+		}
+
+		for _, vOut := range vPkt.Outputs {
+			anchorOutputs[vOut.AnchorOutputIndex]++
+
+			if vOut.ScriptKey.PubKey == nil || !bytes.Equal(
+				schnorr.SerializePubKey(vOut.ScriptKey.PubKey),
+				addrScriptKey,
+			) {
+
+				continue
+			}
+
+			if paymentOut != nil {
+				return fmt.Errorf("swap leg pays address " +
+					"more than once")
+			}
+			paymentOut = vOut
+		}
+	}
+
+	if paymentOut == nil {
+		return fmt.Errorf("swap leg doesn't pay address script key "+
+			"%x", addrScriptKey)
+	}
+
+	switch {
+	case paymentOut.Asset == nil:
+		return ErrAssetMissing
+
+	case paymentOut.Asset.ID() != addr.AssetID:
+		return fmt.Errorf("swap leg pays asset %v instead of %v",
+			paymentOut.Asset.ID(), addr.AssetID)
+
+	case paymentOut.Amount != addr.Amount ||
+		paymentOut.Asset.Amount != addr.Amount:
+
+		return fmt.Errorf("swap leg pays %d units instead of %d",
+			paymentOut.Asset.Amount, addr.Amount)
+
+	case paymentOut.Asset.Version != addr.AssetVersion:
+		return fmt.Errorf("swap leg pays asset version %v instead of "+
+			"%v", paymentOut.Asset.Version, addr.AssetVersion)
+
+	case paymentOut.AnchorOutputInternalKey == nil || !bytes.Equal(
+		schnorr.SerializePubKey(paymentOut.AnchorOutputInternalKey),
+		addrInternalKey,
+	):
+		return fmt.Errorf("%w: swap leg anchor internal key doesn't "+
+			"match address", ErrInvalidAnchorOutputInfo)
+
+	case anchorOutputs[paymentOut.AnchorOutputIndex] != 1:
+		return fmt.Errorf("%w: swap leg anchor output %d carries "+
+			"other assets", ErrInvalidAnchorOutputInfo,
+			paymentOut.AnchorOutputIndex)
+	}
+
+	outSibling, _, err := commitment.MaybeEncodeTapscriptPreimage(
+		paymentOut.AnchorOutputTapscriptSibling,
+	)
+	if err != nil {
+		return fmt.Errorf("error encoding anchor output tapscript "+
+			"sibling: %w", err)
+	}
+	if !bytes.Equal(outSibling, addrSibling) {
+		return fmt.Errorf("%w: swap leg anchor tapscript sibling "+
+			"doesn't match address", ErrInvalidAnchorOutputInfo)
+	}
+
+	return nil
+}
+
+// PrepareHtlcRefund prepares the inputs of the given funded virtual packet
+// that spend assets locked to the script key of the given HTLC, so they are
+// signed through the timeout path with the given refund key when calling
+// SignVirtualTransaction. All outputs of the packet must carry a relative lock
+// time of at least the CSV delay of the HTLC, otherwise the timeout path can't
+// be satisfied.
+func PrepareHtlcRefund(vPkt *tappsbt.VPacket,
+	htlcTree *tapscript.HtlcScriptTree,
+	refundKey keychain.KeyDescriptor) error {
+
+	controlBlock, err := htlcTree.TimeoutControlBlock()
+	if err != nil {
+		return fmt.Errorf("unable to create control block: %w", err)
+	}
+
+	for idx, vOut := range vPkt.Outputs {
+		if vOut.RelativeLockTime < uint64(htlcTree.CsvDelay) {
+			return fmt.Errorf("output %d has relative lock time "+
+				"%d, HTLC requires %d", idx,
+				vOut.RelativeLockTime, htlcTree.CsvDelay)
+		}
+	}
+
+	var (
+		htlcKey     = schnorr.SerializePubKey(htlcTree.TaprootKey)
+		timeoutLeaf = htlcTree.TimeoutTapLeaf
+		leafHash    = timeoutLeaf.TapHash()
+		numRefunds  int
+	)
+	for _, vIn := range vPkt.Inputs {
+		inputAsset := vIn.Asset()
+		if inputAsset == nil || inputAsset.ScriptKey.PubKey == nil ||
+			!bytes.Equal(schnorr.SerializePubKey(
+				inputAsset.ScriptKey.PubKey,
+			), htlcKey) {
+
+			continue
+		}
+
+		derivation, trDerivation := tappsbt.Bip32DerivationFromKeyDesc(
+			refundKey, vPkt.ChainParams.HDCoinType,
+		)
+		trDerivation.LeafHashes = [][]byte{leafHash[:]}
+
+		vIn.Bip32Derivation = []*psbt.Bip32Derivation{derivation}
+		vIn.TaprootBip32Derivation = []*psbt.TaprootBip32Derivation{
+			trDerivation,
+		}
+		vIn.TaprootMerkleRoot = htlcTree.TapscriptRoot
+		vIn.TaprootLeafScript = []*psbt.TaprootTapLeafScript{{
+			ControlBlock: controlBlock,
+			Script:       timeoutLeaf.Script,
+			LeafVersion:  timeoutLeaf.LeafVersion,
+		}}
+
+		numRefunds++
+	}
+
+	if numRefunds == 0 {
+		return fmt.Errorf("no input spends HTLC script key %x",
+			htlcKey)
+	}
+
+	return nil
+}
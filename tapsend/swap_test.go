@@ -0,0 +1,260 @@
+package tapsend_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/lightninglabs/taproot-assets/vm"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// mockWitnessValidator is a witness validator that returns a fixed error.
+type mockWitnessValidator struct {
+	err error
+}
+
+// ValidateWitnesses returns the fixed error of the mock validator.
+func (m *mockWitnessValidator) ValidateWitnesses(*asset.Asset,
+	[]*commitment.SplitAsset, commitment.InputSet) error {
+
+	return m.err
+}
+
+// vmTimeLockSkipValidator validates witnesses with the Taproot Asset VM but
+// skips the validation of time locks, which requires a chain backend.
+type vmTimeLockSkipValidator struct{}
+
+// ValidateWitnesses validates the created witnesses of an asset transfer.
+func (v *vmTimeLockSkipValidator) ValidateWitnesses(newAsset *asset.Asset,
+	splitAssets []*commitment.SplitAsset,
+	prevAssets commitment.InputSet) error {
+
+	engine, err := vm.New(
+		newAsset, splitAssets, prevAssets,
+		vm.WithSkipTimeLockValidation(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return engine.Execute()
+}
+
+// swapLegPacket creates a virtual packet that fully pays the given address.
+func swapLegPacket(t *testing.T, addr *address.Tap, genesis asset.Genesis,
+	groupKey *asset.GroupKey) *tappsbt.VPacket {
+
+	scriptKey := asset.NewScriptKey(&addr.ScriptKey)
+	paymentAsset := asset.NewAssetNoErr(
+		t, genesis, addr.Amount, 0, 0, scriptKey, groupKey,
+		asset.WithAssetVersion(addr.AssetVersion),
+	)
+
+	return &tappsbt.VPacket{
+		Inputs: []*tappsbt.VInput{{
+			PrevID: asset.PrevID{
+				OutPoint: test.RandOp(t),
+				ID:       addr.AssetID,
+			},
+		}},
+		Outputs: []*tappsbt.VOutput{{
+			Amount:                       addr.Amount,
+			AssetVersion:                 addr.AssetVersion,
+			Type:                         tappsbt.TypeSimple,
+			Interactive:                  true,
+			AnchorOutputIndex:            1,
+			AnchorOutputInternalKey:      &addr.InternalKey,
+			AnchorOutputTapscriptSibling: addr.TapscriptSibling,
+			ScriptKey:                    scriptKey,
+			Asset:                        paymentAsset,
+		}},
+		ChainParams: addr.ChainParams,
+		Version:     tappsbt.V1,
+	}
+}
+
+// TestValidateSwapLeg tests that a swap leg is only accepted if it pays
+// exactly the amount requested by the counterparty's address.
+func TestValidateSwapLeg(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		modify    func(*tappsbt.VPacket, *address.Tap)
+		validator *mockWitnessValidator
+		err       string
+	}{{
+		name:      "valid payment",
+		validator: &mockWitnessValidator{},
+	}, {
+		name: "invalid witness",
+		validator: &mockWitnessValidator{
+			err: errors.New("invalid witness"),
+		},
+		err: "invalid witness",
+	}, {
+		name: "wrong script key",
+		modify: func(vPkt *tappsbt.VPacket, _ *address.Tap) {
+			vPkt.Outputs[0].ScriptKey = asset.RandScriptKey(t)
+		},
+		validator: &mockWitnessValidator{},
+		err:       "doesn't pay address script key",
+	}, {
+		name: "wrong amount",
+		modify: func(vPkt *tappsbt.VPacket, addr *address.Tap) {
+			vPkt.Outputs[0].Amount = addr.Amount + 1
+		},
+		validator: &mockWitnessValidator{},
+		err:       "units instead of",
+	}, {
+		name: "wrong internal key",
+		modify: func(vPkt *tappsbt.VPacket, _ *address.Tap) {
+			vPkt.Outputs[0].AnchorOutputInternalKey =
+				test.RandPubKey(t)
+		},
+		validator: &mockWitnessValidator{},
+		err:       "anchor internal key doesn't match",
+	}, {
+		name: "shared anchor output",
+		modify: func(vPkt *tappsbt.VPacket, _ *address.Tap) {
+			vPkt.Outputs = append(vPkt.Outputs, &tappsbt.VOutput{
+				Type:              tappsbt.TypeSplitRoot,
+				AnchorOutputIndex: 1,
+				ScriptKey:         asset.RandScriptKey(t),
+			})
+		},
+		validator: &mockWitnessValidator{},
+		err:       "carries other assets",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			addrInfo, genesis, groupKey := address.RandAddr(
+				t, &address.RegressionNetTap,
+				address.RandProofCourierAddr(t),
+			)
+			addr := addrInfo.Tap
+
+			vPkt := swapLegPacket(t, addr, *genesis, groupKey)
+			if tc.modify != nil {
+				tc.modify(vPkt, addr)
+			}
+
+			err := tapsend.ValidateSwapLeg(
+				[]*tappsbt.VPacket{vPkt}, addr, tc.validator,
+			)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+// TestPrepareHtlcRefund tests that an asset locked to an HTLC script key can be
+// refunded through the timeout path by the wallet signer, as long as the
+// outputs carry the relative lock time of the HTLC.
+func TestPrepareHtlcRefund(t *testing.T) {
+	t.Parallel()
+
+	const csvDelay = 144
+
+	var paymentHash lntypes.Hash
+	test.RandRead(t, paymentHash[:])
+
+	refundPrivKey := test.RandPrivKey(t)
+	refundKey := keychain.KeyDescriptor{
+		PubKey: refundPrivKey.PubKey(),
+	}
+	htlcTree, err := tapscript.NewHtlcScriptTree(
+		test.RandPubKey(t), refundKey.PubKey, paymentHash, csvDelay,
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name             string
+		relativeLockTime uint64
+		htlcInput        bool
+		err              string
+	}{{
+		name:             "refund after timeout",
+		relativeLockTime: csvDelay,
+		htlcInput:        true,
+	}, {
+		name:             "lock time too short",
+		relativeLockTime: csvDelay - 1,
+		htlcInput:        true,
+		err:              "HTLC requires 144",
+	}, {
+		name:             "no HTLC input",
+		relativeLockTime: csvDelay,
+		err:              "no input spends HTLC script key",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scriptKey := htlcTree.ScriptKey()
+			if !tc.htlcInput {
+				scriptKey = asset.RandScriptKey(t)
+			}
+
+			genesis := asset.RandGenesis(t, asset.Normal)
+			inputAsset := asset.NewAssetNoErr(
+				t, genesis, 1000, 0, 0, scriptKey, nil,
+			)
+
+			vPkt := tappsbt.ForInteractiveSend(
+				genesis.ID(), inputAsset.Amount,
+				asset.RandScriptKey(t), 0, tc.relativeLockTime,
+				0, keychain.KeyDescriptor{
+					PubKey: test.RandPubKey(t),
+				}, asset.V0, &address.RegressionNetTap,
+			)
+			vPkt.Inputs[0].PrevID = asset.PrevID{
+				OutPoint: test.RandOp(t),
+				ID:       genesis.ID(),
+				ScriptKey: asset.ToSerialized(
+					scriptKey.PubKey,
+				),
+			}
+			vPkt.SetInputAsset(0, inputAsset)
+
+			err := tapsend.PrepareOutputAssets(
+				context.Background(), vPkt,
+			)
+			require.NoError(t, err)
+
+			err = tapsend.PrepareHtlcRefund(
+				vPkt, htlcTree, refundKey,
+			)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			err = tapsend.SignVirtualTransaction(
+				vPkt, tapscript.NewMockSigner(refundPrivKey),
+				&vmTimeLockSkipValidator{},
+			)
+			require.NoError(t, err)
+
+			witness := vPkt.Outputs[0].Asset.PrevWitnesses[0]
+			require.Equal(
+				t, htlcTree.TimeoutTapLeaf.Script,
+				witness.TxWitness[1],
+			)
+		})
+	}
+}
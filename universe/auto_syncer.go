@@ -11,6 +11,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
 )
 
 const (
@@ -303,6 +304,16 @@ func (f *FederationEnvoy) pushProofToFederation(ctx context.Context,
 	uniID Identifier, key LeafKey, leaf *Leaf, fedServers []ServerAddr,
 	logProofSync bool) {
 
+	// We never push proofs to servers that are configured as read-only.
+	syncConfigs, err := f.QuerySyncConfigs(ctx)
+	if err != nil {
+		log.Errorf("unable to push proof to federation: %v", err)
+		return
+	}
+	fedServers = fn.Filter(
+		fedServers, syncConfigs.IsServerSyncExportEnabled,
+	)
+
 	log.Infof("Pushing proof to %v federation members, proof_key=%v",
 		len(fedServers), spew.Sdump(key))
 
@@ -336,7 +347,7 @@ func (f *FederationEnvoy) pushProofToFederation(ctx context.Context,
 
 	// To conclude, we'll attempt to push the new proof to all the universe
 	// servers in parallel.
-	err := fn.ParSlice(ctx, fedServers, pushNewProof)
+	err = fn.ParSlice(ctx, fedServers, pushNewProof)
 	if err != nil {
 		// TODO(roasbeef): retry in the background until successful?
 		log.Errorf("unable to push proof to federation: %v", err)
@@ -730,12 +741,149 @@ func (f *FederationEnvoy) QuerySyncConfigs(
 			"config(s): %w", err)
 	}
 
+	serverSyncConfigs, err := f.cfg.FederationDB.
+		QueryFederationServerSyncConfigs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query federation server "+
+			"sync config(s): %w", err)
+	}
+
 	return &SyncConfigs{
 		GlobalSyncConfigs: globalConfigs,
 		UniSyncConfigs:    uniSyncConfigs,
+		ServerSyncConfigs: serverSyncConfigs,
 	}, nil
 }
 
+// serverRoots is the set of universe roots reported by a federation server.
+type serverRoots struct {
+	addr  ServerAddr
+	roots []Root
+}
+
+// agreedRoots returns the universe roots, keyed by universe ID, for which the
+// combined trust weight of the servers reporting them reaches the sync quorum
+// of their proof type. If conflicting roots of the same universe reach the
+// quorum, the root with the highest combined trust weight is picked. If there
+// is no single such root, the universe isn't synced at all.
+//
+// NOTE: Only identical roots are counted together. Servers that are still
+// catching up on a universe report a different root than the servers that
+// already synced it, so a universe is only synced once enough servers report
+// the same state of it.
+func agreedRoots(reports []serverRoots,
+	syncConfigs SyncConfigs) map[string]mssmt.Node {
+
+	type rootVotes struct {
+		root   Root
+		weight uint64
+	}
+
+	// We first sum up the trust weight of all servers reporting the same
+	// root of a universe.
+	votes := make(map[string]map[mssmt.NodeHash]*rootVotes)
+	for _, report := range reports {
+		weight := syncConfigs.ServerSyncConfig(report.addr).TrustWeight
+		for _, root := range report.roots {
+			idStr := root.ID.String()
+			if votes[idStr] == nil {
+				votes[idStr] = make(
+					map[mssmt.NodeHash]*rootVotes,
+				)
+			}
+
+			rootHash := root.Node.NodeHash()
+			if votes[idStr][rootHash] == nil {
+				votes[idStr][rootHash] = &rootVotes{
+					root: root,
+				}
+			}
+			votes[idStr][rootHash].weight += uint64(weight)
+		}
+	}
+
+	agreed := make(map[string]mssmt.Node)
+	for idStr, uniVotes := range votes {
+		var (
+			best *rootVotes
+			tied bool
+		)
+		for _, rootVote := range uniVotes {
+			proofType := rootVote.root.ID.ProofType
+			quorum := uint64(syncConfigs.SyncQuorum(proofType))
+			if rootVote.weight < quorum {
+				continue
+			}
+
+			switch {
+			case best == nil || rootVote.weight > best.weight:
+				best = rootVote
+				tied = false
+
+			case rootVote.weight == best.weight:
+				tied = true
+			}
+		}
+
+		if tied {
+			log.Warnf("Federation servers report conflicting "+
+				"roots for universe %v, not syncing it", idStr)
+			continue
+		}
+
+		if best != nil {
+			agreed[idStr] = best.root.Node
+		}
+	}
+
+	return agreed
+}
+
+// fetchAgreedRoots fetches the universe roots of all federation servers we're
+// allowed to sync from and returns the roots that reach the sync quorum. If
+// no universe IDs are given, the roots of all universes that would be synced
+// with the given sync type are fetched.
+func (f *FederationEnvoy) fetchAgreedRoots(ctx context.Context,
+	syncType SyncType, syncConfigs SyncConfigs,
+	idsToSync ...Identifier) (map[string]mssmt.Node, error) {
+
+	fedServers, err := f.tryFetchServers()
+	if err != nil {
+		return nil, err
+	}
+	fedServers = fn.Filter(
+		fedServers, syncConfigs.IsServerSyncInsertEnabled,
+	)
+
+	// Servers that we can't fetch the roots from simply don't count
+	// towards the quorum.
+	reports := make(chan serverRoots, len(fedServers))
+	fetchRoots := func(ctx context.Context, addr ServerAddr) error {
+		roots, err := f.cfg.UniverseSyncer.FetchRoots(
+			ctx, addr, syncType, syncConfigs, idsToSync...,
+		)
+		if err != nil {
+			log.Warnf("Unable to fetch roots from server=%v: %v",
+				addr.HostStr(), err)
+			return nil
+		}
+
+		reports <- serverRoots{
+			addr:  addr,
+			roots: roots,
+		}
+
+		return nil
+	}
+
+	err = fn.ParSlice(ctx, fedServers, fetchRoots)
+	if err != nil {
+		return nil, err
+	}
+
+	return agreedRoots(fn.Collect(reports), syncConfigs), nil
+}
+
 func (f *FederationEnvoy) SyncServers(serverAddrs []ServerAddr) error {
 	// Sync servers in parallel without context timeout.
 	ctx, cancel := f.WithCtxQuitNoTimeout()
@@ -747,6 +895,24 @@ func (f *FederationEnvoy) SyncServers(serverAddrs []ServerAddr) error {
 		return err
 	}
 
+	// We never sync from servers that are configured as write-only.
+	serverAddrs = fn.Filter(
+		serverAddrs, syncConfigs.IsServerSyncInsertEnabled,
+	)
+
+	// If a sync quorum is configured, then we only sync the roots that
+	// enough servers of the whole federation agree on, no matter which
+	// servers we sync from.
+	if syncConfigs.isQuorumEnabled() {
+		syncConfigs.agreedRoots, err = f.fetchAgreedRoots(
+			ctx, SyncFull, *syncConfigs,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch agreed roots: %w",
+				err)
+		}
+	}
+
 	syncServer := func(ctx context.Context, serverAddr ServerAddr) error {
 		err := f.syncServerState(ctx, serverAddr, *syncConfigs)
 		if err != nil {
@@ -813,22 +979,48 @@ func (f *FederationEnvoy) SyncAssetInfo(ctx context.Context,
 		return fmt.Errorf("no asset ID provided")
 	}
 
-	// Fetch the set of universe servers in our federation.
+	// Fetch the set of universe servers in our federation that we're
+	// allowed to sync from.
 	fedServers, err := f.tryFetchServers()
 	if err != nil {
 		return err
 	}
 
+	syncConfigs, err := f.QuerySyncConfigs(ctx)
+	if err != nil {
+		return err
+	}
+	fedServers = fn.Filter(
+		fedServers, syncConfigs.IsServerSyncInsertEnabled,
+	)
+
+	// We only sync the issuance universe of the asset, but otherwise
+	// follow the federation sync configs, including the sync quorum. The
+	// asset specific config takes precedence over any existing config for
+	// the same universe.
+	uniID := Identifier{
+		AssetID:   *assetID,
+		ProofType: ProofTypeIssuance,
+	}
 	assetConfig := FedUniSyncConfig{
-		UniverseID: Identifier{
-			AssetID:   *assetID,
-			ProofType: ProofTypeIssuance,
-		},
+		UniverseID:      uniID,
 		AllowSyncInsert: true,
 		AllowSyncExport: false,
 	}
-	fullConfig := SyncConfigs{
-		UniSyncConfigs: []*FedUniSyncConfig{&assetConfig},
+	fullConfig := *syncConfigs
+	fullConfig.UniSyncConfigs = append(
+		[]*FedUniSyncConfig{&assetConfig},
+		syncConfigs.UniSyncConfigs...,
+	)
+
+	if fullConfig.SyncQuorum(ProofTypeIssuance) > 0 {
+		fullConfig.agreedRoots, err = f.fetchAgreedRoots(
+			ctx, SyncIssuance, fullConfig, uniID,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch agreed roots: %w",
+				err)
+		}
 	}
 	// We'll sync with Universe servers in parallel and collect the diffs
 	// from any successful syncs. There can only be one diff per server, as
@@ -840,7 +1032,7 @@ func (f *FederationEnvoy) SyncAssetInfo(ctx context.Context,
 	// part of the universe sync.
 	syncFromUni := func(ctxs context.Context, addr ServerAddr) error {
 		syncDiff, err := f.cfg.UniverseSyncer.SyncUniverse(
-			ctxs, addr, SyncIssuance, fullConfig,
+			ctxs, addr, SyncIssuance, fullConfig, uniID,
 		)
 
 		// Sync failures are expected from Universe servers that do not
@@ -919,6 +1111,79 @@ type SyncConfigs struct {
 
 	// UniSyncConfigs are the universe specific configs.
 	UniSyncConfigs []*FedUniSyncConfig
+
+	// ServerSyncConfigs are the server specific configs.
+	ServerSyncConfigs []*FedServerSyncConfig
+
+	// agreedRoots is the set of universe roots, keyed by universe ID, that
+	// enough federation servers agree on to reach the sync quorum. If nil,
+	// the sync quorum isn't enforced, which is the case for syncs that
+	// aren't initiated by the federation envoy.
+	agreedRoots map[string]mssmt.Node
+}
+
+// ServerSyncConfig returns the sync config of the given server. If there is
+// no server specific config, then the server may be synced with in both
+// directions and has the default trust weight.
+func (s *SyncConfigs) ServerSyncConfig(addr ServerAddr) FedServerSyncConfig {
+	for _, cfg := range s.ServerSyncConfigs {
+		if cfg.ServerAddr.IsSameServer(addr) {
+			return *cfg
+		}
+	}
+
+	return FedServerSyncConfig{
+		ServerAddr:      addr,
+		AllowSyncInsert: true,
+		AllowSyncExport: true,
+		TrustWeight:     DefaultServerTrustWeight,
+	}
+}
+
+// IsServerSyncInsertEnabled returns true if leaves may be inserted from the
+// given server via federation sync.
+func (s *SyncConfigs) IsServerSyncInsertEnabled(addr ServerAddr) bool {
+	return s.ServerSyncConfig(addr).AllowSyncInsert
+}
+
+// IsServerSyncExportEnabled returns true if leaves may be exported to the
+// given server via federation sync.
+func (s *SyncConfigs) IsServerSyncExportEnabled(addr ServerAddr) bool {
+	return s.ServerSyncConfig(addr).AllowSyncExport
+}
+
+// SyncQuorum returns the sync quorum for universes of the given proof type.
+func (s *SyncConfigs) SyncQuorum(proofType ProofType) uint32 {
+	for _, cfg := range s.GlobalSyncConfigs {
+		if cfg.ProofType == proofType {
+			return cfg.SyncQuorum
+		}
+	}
+
+	return 0
+}
+
+// isQuorumEnabled returns true if a sync quorum is configured for any proof
+// type.
+func (s *SyncConfigs) isQuorumEnabled() bool {
+	return fn.Any(
+		s.GlobalSyncConfigs, func(cfg *FedGlobalSyncConfig) bool {
+			return cfg.SyncQuorum > 0
+		},
+	)
+}
+
+// IsRootAgreed returns true if the given remote universe root may be synced.
+// This is the case if the sync quorum isn't enforced for the proof type of the
+// universe, or if enough federation servers reported the same root.
+func (s *SyncConfigs) IsRootAgreed(root Root) bool {
+	if s.agreedRoots == nil || s.SyncQuorum(root.ID.ProofType) == 0 {
+		return true
+	}
+
+	agreedRoot, ok := s.agreedRoots[root.ID.String()]
+
+	return ok && mssmt.IsEqualNode(agreedRoot, root.Node)
 }
 
 // IsSyncInsertEnabled returns true if the given universe is configured to allow
@@ -0,0 +1,146 @@
+package universe
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAgreedRoots tests that a universe root is only agreed on once the
+// combined trust weight of the servers reporting it reaches the sync quorum.
+func TestAgreedRoots(t *testing.T) {
+	t.Parallel()
+
+	var (
+		serverA = NewServerAddrFromStr("a.example.com")
+		serverB = NewServerAddrFromStr("b.example.com")
+		serverC = NewServerAddrFromStr("c.example.com")
+
+		issuanceID = Identifier{
+			AssetID:   asset.RandID(t),
+			ProofType: ProofTypeIssuance,
+		}
+		transferID = Identifier{
+			AssetID:   asset.RandID(t),
+			ProofType: ProofTypeTransfer,
+		}
+	)
+
+	randRoot := func(id Identifier) Root {
+		return Root{
+			ID: id,
+			Node: mssmt.NewComputedBranch(
+				mssmt.NodeHash(test.RandHash()), 1,
+			),
+		}
+	}
+	goodRoot := randRoot(issuanceID)
+	badRoot := randRoot(issuanceID)
+	transferRoot := randRoot(transferID)
+
+	// Server C is trusted more than the other two servers, and only the
+	// issuance universes require a quorum.
+	syncConfigs := SyncConfigs{
+		GlobalSyncConfigs: []*FedGlobalSyncConfig{{
+			ProofType:       ProofTypeIssuance,
+			AllowSyncInsert: true,
+			SyncQuorum:      3,
+		}, {
+			ProofType:       ProofTypeTransfer,
+			AllowSyncInsert: true,
+		}},
+		ServerSyncConfigs: []*FedServerSyncConfig{{
+			ServerAddr:      serverC,
+			AllowSyncInsert: true,
+			TrustWeight:     2,
+		}},
+	}
+
+	testCases := []struct {
+		name     string
+		reports  []serverRoots
+		expected map[string]mssmt.Node
+	}{{
+		name: "quorum reached with trust weights",
+		reports: []serverRoots{{
+			addr:  serverA,
+			roots: []Root{goodRoot, transferRoot},
+		}, {
+			addr:  serverB,
+			roots: []Root{badRoot},
+		}, {
+			addr:  serverC,
+			roots: []Root{goodRoot},
+		}},
+		expected: map[string]mssmt.Node{
+			issuanceID.String(): goodRoot.Node,
+			transferID.String(): transferRoot.Node,
+		},
+	}, {
+		name: "quorum not reached",
+		reports: []serverRoots{{
+			addr:  serverA,
+			roots: []Root{goodRoot},
+		}, {
+			addr:  serverB,
+			roots: []Root{badRoot},
+		}},
+		expected: map[string]mssmt.Node{},
+	}, {
+		name: "conflicting roots with equal weight",
+		reports: []serverRoots{{
+			addr:  serverA,
+			roots: []Root{goodRoot},
+		}, {
+			addr:  serverB,
+			roots: []Root{badRoot},
+		}, {
+			addr:  serverC,
+			roots: []Root{goodRoot, transferRoot},
+		}, {
+			addr:  NewServerAddrFromStr("d.example.com"),
+			roots: []Root{badRoot},
+		}, {
+			addr:  NewServerAddrFromStr("e.example.com"),
+			roots: []Root{badRoot},
+		}},
+		expected: map[string]mssmt.Node{
+			transferID.String(): transferRoot.Node,
+		},
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			agreed := agreedRoots(tc.reports, syncConfigs)
+			require.Equal(t, tc.expected, agreed)
+		})
+	}
+
+	// Only agreed roots may be synced if the quorum is enforced.
+	syncConfigs.agreedRoots = agreedRoots(
+		testCases[0].reports, syncConfigs,
+	)
+	require.True(t, syncConfigs.IsRootAgreed(goodRoot))
+	require.False(t, syncConfigs.IsRootAgreed(badRoot))
+	require.True(t, syncConfigs.IsRootAgreed(randRoot(transferID)))
+
+	// Servers without a server specific config may be synced with in both
+	// directions.
+	require.True(t, syncConfigs.IsServerSyncInsertEnabled(serverA))
+	require.True(t, syncConfigs.IsServerSyncExportEnabled(serverA))
+	require.False(t, syncConfigs.IsServerSyncExportEnabled(serverC))
+
+	// A server config applies to the server no matter whether the default
+	// port is specified or not.
+	serverCPort := NewServerAddrFromStr("c.example.com:10029")
+	require.EqualValues(
+		t, 2, syncConfigs.ServerSyncConfig(serverCPort).TrustWeight,
+	)
+	require.False(t, syncConfigs.IsServerSyncExportEnabled(serverCPort))
+	require.True(t, syncConfigs.IsServerSyncExportEnabled(
+		NewServerAddrFromStr("c.example.com:10030"),
+	))
+}
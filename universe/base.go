@@ -645,6 +645,218 @@ func (a *Archive) FetchProofLeaf(ctx context.Context, id Identifier,
 	return a.cfg.Multiverse.FetchProofLeaf(ctx, id, key)
 }
 
+// FetchMultiProof returns a single inclusion proof for the leaves at the given
+// keys of the specified universe. If no keys are given, then the proof covers
+// all the leaves of the universe.
+func (a *Archive) FetchMultiProof(ctx context.Context, id Identifier,
+	keys []LeafKey) (*MultiProof, error) {
+
+	log.Debugf("Retrieving Universe multi-proof for: id=%v, num_keys=%d",
+		id.StringForLog(), len(keys))
+
+	// If no keys were specified, we'll page through all the keys of the
+	// universe.
+	if len(keys) == 0 {
+		for offset := int32(0); ; offset += MaxPageSize {
+			query := UniverseLeafKeysQuery{
+				Id:     id,
+				Offset: offset,
+				Limit:  MaxPageSize,
+			}
+			page, err := a.UniverseLeafKeys(ctx, query)
+			if err != nil {
+				return nil, err
+			}
+
+			keys = append(keys, page...)
+			if len(page) < MaxPageSize {
+				break
+			}
+		}
+
+		if len(keys) == 0 {
+			return nil, ErrNoUniverseProofFound
+		}
+	}
+
+	multiProof := &MultiProof{
+		LeafKeys: make([]LeafKey, len(keys)),
+		Leaves:   make([]*Leaf, len(keys)),
+	}
+	smtKeys := make([][32]byte, len(keys))
+	proofs := make([]*mssmt.Proof, len(keys))
+	for idx, key := range keys {
+		if key.ScriptKey == nil {
+			return nil, fmt.Errorf("script key must be set for " +
+				"multi-proof")
+		}
+
+		leafProofs, err := a.FetchProofLeaf(ctx, id, key)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch proof for "+
+				"key %x: %w", key.UniverseKey(), err)
+		}
+
+		// With the script key set, there's exactly one leaf per key.
+		leafProof := leafProofs[0]
+
+		// The individual proofs are fetched one by one, so we need to
+		// make sure they're all against the same root.
+		switch {
+		case multiProof.UniverseRoot == nil:
+			multiProof.UniverseRoot = leafProof.UniverseRoot
+
+		case !mssmt.IsEqualNode(
+			multiProof.UniverseRoot, leafProof.UniverseRoot,
+		):
+			return nil, fmt.Errorf("universe root changed while " +
+				"fetching proofs")
+		}
+
+		multiProof.LeafKeys[idx] = leafProof.LeafKey
+		multiProof.Leaves[idx] = leafProof.Leaf
+		smtKeys[idx] = leafProof.LeafKey.UniverseKey()
+		proofs[idx] = leafProof.UniverseInclusionProof
+	}
+
+	var err error
+	multiProof.UniverseInclusionProof, err = mssmt.NewMultiProof(
+		smtKeys, proofs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create multi-proof: %w", err)
+	}
+
+	return multiProof, nil
+}
+
+// archiveDiffSession is a tree diff session that walks the tree of a local
+// universe.
+type archiveDiffSession struct {
+	archive *Archive
+	root    Root
+}
+
+// A compile-time assertion to ensure archiveDiffSession implements the
+// TreeDiffSession interface.
+var _ TreeDiffSession = (*archiveDiffSession)(nil)
+
+// NewTreeDiffSession opens a new tree diff session for the universe with the
+// given ID. ErrNoUniverseRoot is returned if the universe doesn't exist.
+//
+// NOTE: This is part of the TreeDiffEngine interface.
+func (a *Archive) NewTreeDiffSession(ctx context.Context,
+	id Identifier) (TreeDiffSession, error) {
+
+	log.Debugf("Opening tree diff session for Universe: id=%v",
+		id.StringForLog())
+
+	root, err := a.RootNode(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &archiveDiffSession{
+		archive: a,
+		root:    root,
+	}, nil
+}
+
+// Root returns the root of the universe tree at the time the session was
+// opened.
+//
+// NOTE: This is part of the TreeDiffSession interface.
+func (s *archiveDiffSession) Root() Root {
+	return s.root
+}
+
+// DiffChildren returns the left and right children of the branch nodes with
+// the given hashes, which are all located at the given height of the universe
+// tree.
+//
+// NOTE: This is part of the TreeDiffSession interface.
+func (s *archiveDiffSession) DiffChildren(ctx context.Context, height int,
+	nodes []mssmt.NodeHash) ([][2]mssmt.DiffNode, error) {
+
+	children, err := s.archive.cfg.Multiverse.UniverseDiffChildren(
+		ctx, s.root.ID, height, nodes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The nodes are looked up by their hash in the current tree. If the
+	// tree changed since the session was opened, nodes of the session root
+	// might have been removed and would be reported as empty.
+	if err := s.checkRoot(ctx); err != nil {
+		return nil, err
+	}
+
+	return children, nil
+}
+
+// FetchMultiProof returns the leaves stored at the given MS-SMT keys of the
+// universe tree, along with a single inclusion proof for all of them.
+//
+// NOTE: This is part of the TreeDiffSession interface.
+func (s *archiveDiffSession) FetchMultiProof(ctx context.Context,
+	nodeKeys [][32]byte) (*MultiProof, error) {
+
+	// An empty set of keys would return the proofs for the whole
+	// universe, which is not what a diff is about.
+	if len(nodeKeys) == 0 {
+		return nil, fmt.Errorf("no node keys specified")
+	}
+	if len(nodeKeys) > MaxTreeDiffProofs {
+		return nil, fmt.Errorf("too many node keys: %d, max %d",
+			len(nodeKeys), MaxTreeDiffProofs)
+	}
+
+	leafKeys, err := s.archive.cfg.Multiverse.UniverseLeafKeysByNodeKey(
+		ctx, s.root.ID, nodeKeys,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	multiProof, err := s.archive.FetchMultiProof(ctx, s.root.ID, leafKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	// The proofs are created against the current tree, which needs to be
+	// the one the session was opened with.
+	if !mssmt.IsEqualNode(multiProof.UniverseRoot, s.root.Node) {
+		return nil, fmt.Errorf("%w: %v", ErrTreeDiffRootChanged,
+			s.root.ID.StringForLog())
+	}
+
+	return multiProof, nil
+}
+
+// checkRoot makes sure the universe tree is still at the root the session was
+// opened with.
+func (s *archiveDiffSession) checkRoot(ctx context.Context) error {
+	root, err := s.archive.RootNode(ctx, s.root.ID)
+	if err != nil {
+		return err
+	}
+
+	if !mssmt.IsEqualNode(root.Node, s.root.Node) {
+		return fmt.Errorf("%w: %v", ErrTreeDiffRootChanged,
+			s.root.ID.StringForLog())
+	}
+
+	return nil
+}
+
+// Close ends the session.
+//
+// NOTE: This is part of the TreeDiffSession interface.
+func (s *archiveDiffSession) Close() error {
+	return nil
+}
+
 type UniverseLeafKeysQuery struct {
 	Id            Identifier
 	SortDirection SortDirection
@@ -0,0 +1,1124 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// universeCommitmentMarkerTag is the preimage to the
+	// UniverseCommitmentMarker included in the tapscript leaf of an on
+	// chain universe commitment.
+	universeCommitmentMarkerTag = "taproot-assets:universe-commitment"
+
+	// CommitmentConfTarget is the confirmation target we'll use to query
+	// for a fee estimate when committing to the universe on chain.
+	CommitmentConfTarget = 6
+
+	// CommitmentOutputValue is the amount of sats we'll place in the
+	// universe commitment output. This just needs to be greater than
+	// dust.
+	CommitmentOutputValue = tapsend.DummyAmtSats
+)
+
+var (
+	// UniverseCommitmentMarker is a static identifier included in the
+	// leaf script of a universe commitment to uniquely identify it from
+	// any other tapscript leaf.
+	UniverseCommitmentMarker = sha256.Sum256(
+		[]byte(universeCommitmentMarkerTag),
+	)
+
+	// ErrInvalidCommitment is returned when an on chain universe
+	// commitment fails verification.
+	ErrInvalidCommitment = errors.New("invalid universe commitment")
+
+	// ErrCommitmentUnchanged is returned when a new chain commitment is
+	// requested, but the multiverse roots haven't changed since the
+	// latest commitment.
+	ErrCommitmentUnchanged = errors.New("universe unchanged since " +
+		"latest commitment")
+
+	// ErrCommitmentPending is returned when a new chain commitment is
+	// requested while the previous one hasn't confirmed yet.
+	ErrCommitmentPending = errors.New("universe commitment pending " +
+		"confirmation")
+)
+
+// NewCommitmentRoot returns the universe root that an on chain commitment
+// commits to: a branch with the issuance multiverse root on the left, and the
+// transfer multiverse root on the right. A nil root is treated as an empty
+// multiverse.
+func NewCommitmentRoot(issuanceRoot,
+	transferRoot mssmt.Node) *mssmt.BranchNode {
+
+	if issuanceRoot == nil {
+		issuanceRoot = mssmt.EmptyTree[0]
+	}
+	if transferRoot == nil {
+		transferRoot = mssmt.EmptyTree[0]
+	}
+
+	return mssmt.NewBranch(issuanceRoot, transferRoot)
+}
+
+// TapLeaf returns the tapscript leaf that commits to the universe root. The
+// leaf script is `marker || root_hash || root_sum`.
+func (c *Commitment) TapLeaf() txscript.TapLeaf {
+	rootHash := c.UniverseRoot.NodeHash()
+
+	var rootSum [8]byte
+	binary.BigEndian.PutUint64(rootSum[:], c.UniverseRoot.NodeSum())
+
+	leafParts := [][]byte{
+		UniverseCommitmentMarker[:], rootHash[:], rootSum[:],
+	}
+	leafScript := bytes.Join(leafParts, nil)
+
+	return txscript.NewBaseTapLeaf(leafScript)
+}
+
+// TapscriptRoot returns the tapscript root of the commitment output.
+func (c *Commitment) TapscriptRoot() chainhash.Hash {
+	return c.TapLeaf().TapHash()
+}
+
+// OutputKey returns the taproot output key of the commitment output.
+func (c *Commitment) OutputKey() (*btcec.PublicKey, error) {
+	if c.InternalKey.PubKey == nil {
+		return nil, fmt.Errorf("missing internal key")
+	}
+	if c.UniverseRoot == nil {
+		return nil, fmt.Errorf("missing universe root")
+	}
+
+	tapscriptRoot := c.TapscriptRoot()
+
+	return txscript.ComputeTaprootOutputKey(
+		c.InternalKey.PubKey, tapscriptRoot[:],
+	), nil
+}
+
+// PkScript returns the pkScript of the commitment output.
+func (c *Commitment) PkScript() ([]byte, error) {
+	outputKey, err := c.OutputKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return txscript.PayToTaprootScript(outputKey)
+}
+
+// OutPoint returns the outpoint of the commitment output.
+func (c *Commitment) OutPoint() wire.OutPoint {
+	return wire.OutPoint{
+		Hash:  c.AnchorTx.TxHash(),
+		Index: c.OutputIndex,
+	}
+}
+
+// CommitsTo returns true if the commitment commits to the given multiverse
+// root for the given proof type.
+func (c *Commitment) CommitsTo(proofType ProofType,
+	multiverseRoot mssmt.Node) bool {
+
+	switch proofType {
+	case ProofTypeIssuance:
+		return mssmt.IsEqualNode(c.IssuanceRoot, multiverseRoot)
+
+	case ProofTypeTransfer:
+		return mssmt.IsEqualNode(c.TransferRoot, multiverseRoot)
+
+	default:
+		return false
+	}
+}
+
+// Verify verifies that the commitment is internally consistent, that the
+// anchor transaction carries the commitment output, and that the anchor
+// transaction was mined in the committed block.
+func (c *Commitment) Verify(headerVerifier proof.HeaderVerifier,
+	merkleVerifier proof.MerkleVerifier) error {
+
+	switch {
+	case c.AnchorTx == nil:
+		return fmt.Errorf("%w: missing anchor tx", ErrInvalidCommitment)
+
+	case c.MerkleProof == nil:
+		return fmt.Errorf("%w: missing merkle proof",
+			ErrInvalidCommitment)
+
+	case int(c.OutputIndex) >= len(c.AnchorTx.TxOut):
+		return fmt.Errorf("%w: output index %d out of range",
+			ErrInvalidCommitment, c.OutputIndex)
+	}
+
+	// The universe root must be derived from the two multiverse roots.
+	expectedRoot := NewCommitmentRoot(c.IssuanceRoot, c.TransferRoot)
+	if !mssmt.IsEqualNode(expectedRoot, c.UniverseRoot) {
+		return fmt.Errorf("%w: universe root mismatch",
+			ErrInvalidCommitment)
+	}
+
+	// The anchor output must pay to the key tweaked with the root.
+	pkScript, err := c.PkScript()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidCommitment, err)
+	}
+	txOut := c.AnchorTx.TxOut[c.OutputIndex]
+	if !bytes.Equal(pkScript, txOut.PkScript) {
+		return fmt.Errorf("%w: pkScript mismatch", ErrInvalidCommitment)
+	}
+
+	// Finally, the anchor transaction must be included in the block.
+	err = merkleVerifier(
+		c.AnchorTx, c.MerkleProof, c.BlockHeader.MerkleRoot,
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidCommitment, err)
+	}
+
+	return headerVerifier(c.BlockHeader, c.BlockHeight)
+}
+
+// Verify verifies that the universe proof is valid, and that the multiverse
+// root it was generated against was committed to on chain.
+func (p *CommittedIssuanceProof) Verify(headerVerifier proof.HeaderVerifier,
+	merkleVerifier proof.MerkleVerifier) error {
+
+	uniProof := p.TaprootAssetProof
+	switch {
+	case uniProof == nil || uniProof.Leaf == nil:
+		return fmt.Errorf("missing universe proof")
+
+	case p.ChainProof == nil:
+		return fmt.Errorf("%w: multiverse root not committed",
+			ErrInvalidCommitment)
+	}
+
+	if !uniProof.VerifyRoot(uniProof.UniverseRoot) {
+		return fmt.Errorf("invalid universe inclusion proof")
+	}
+
+	// The universe root must be included in the multiverse root that was
+	// committed to on chain.
+	multiverseLeaf, err := proofMultiverseLeaf(uniProof)
+	if err != nil {
+		return err
+	}
+
+	if uniProof.MultiverseInclusionProof == nil {
+		return fmt.Errorf("missing multiverse inclusion proof")
+	}
+
+	multiverseRoot := uniProof.MultiverseInclusionProof.Root(
+		multiverseLeaf.ID.Bytes(), multiverseLeaf.LeafNode,
+	)
+	if !mssmt.IsEqualNode(multiverseRoot, uniProof.MultiverseRoot) {
+		return fmt.Errorf("invalid multiverse inclusion proof")
+	}
+
+	proofType := multiverseLeaf.ID.ProofType
+	if !p.ChainProof.CommitsTo(proofType, uniProof.MultiverseRoot) {
+		return fmt.Errorf("%w: multiverse root mismatch",
+			ErrInvalidCommitment)
+	}
+
+	return p.ChainProof.Verify(headerVerifier, merkleVerifier)
+}
+
+// proofMultiverseLeaf returns the multiverse leaf that commits to the universe
+// root the given universe proof was generated against.
+func proofMultiverseLeaf(uniProof *Proof) (MultiverseLeaf, error) {
+	if uniProof.Leaf == nil || uniProof.UniverseRoot == nil {
+		return MultiverseLeaf{}, fmt.Errorf("missing universe proof")
+	}
+
+	proofType, err := NewProofTypeFromAsset(uniProof.Leaf.Asset)
+	if err != nil {
+		return MultiverseLeaf{}, err
+	}
+
+	uniID := Identifier{
+		AssetID:   uniProof.Leaf.ID(),
+		ProofType: proofType,
+	}
+	if uniProof.Leaf.GroupKey != nil {
+		uniID.GroupKey = &uniProof.Leaf.GroupKey.GroupPubKey
+	}
+
+	// The issuance multiverse only tracks the existence of a universe, so
+	// the sum of its leaves is always one.
+	uniRootHash := uniProof.UniverseRoot.NodeHash()
+	uniRootSum := uniProof.UniverseRoot.NodeSum()
+	if proofType == ProofTypeIssuance {
+		uniRootSum = 1
+	}
+
+	return MultiverseLeaf{
+		ID:       uniID,
+		LeafNode: mssmt.NewLeafNode(uniRootHash[:], uniRootSum),
+	}, nil
+}
+
+// CommitmentKeyRing is used to derive the internal keys of universe
+// commitment outputs.
+type CommitmentKeyRing interface {
+	// DeriveNextKey attempts to derive the *next* key within the key
+	// family specified.
+	DeriveNextKey(context.Context,
+		keychain.KeyFamily) (keychain.KeyDescriptor, error)
+}
+
+// CommitmentWallet is the wallet used to fund and sign universe commitment
+// transactions.
+type CommitmentWallet interface {
+	// FundPsbt attaches enough inputs to the target PSBT packet for it to
+	// be valid.
+	FundPsbt(ctx context.Context, packet *psbt.Packet, minConfs uint32,
+		feeRate chainfee.SatPerKWeight,
+		changeIdx int32) (*tapsend.FundedPsbt, error)
+
+	// SignAndFinalizePsbt fully signs and finalizes the target PSBT
+	// packet.
+	SignAndFinalizePsbt(context.Context, *psbt.Packet) (*psbt.Packet, error)
+
+	// ImportTaprootOutput imports a new public key into the wallet, as a
+	// P2TR output.
+	ImportTaprootOutput(context.Context, *btcec.PublicKey) (btcutil.Address,
+		error)
+
+	// UnlockInput unlocks the set of target inputs after a commitment
+	// transaction is abandoned.
+	UnlockInput(context.Context, wire.OutPoint) error
+}
+
+// CommitmentChainBridge is the bridge to the chain used to publish universe
+// commitment transactions and wait for them to confirm.
+type CommitmentChainBridge interface {
+	// RegisterConfirmationsNtfn registers an intent to be notified once
+	// txid reaches numConfs confirmations.
+	RegisterConfirmationsNtfn(ctx context.Context, txid *chainhash.Hash,
+		pkScript []byte, numConfs, heightHint uint32,
+		includeBlock bool,
+		reOrgChan chan struct{}) (*chainntnfs.ConfirmationEvent,
+		chan error, error)
+
+	// CurrentHeight return the current height of the main chain.
+	CurrentHeight(context.Context) (uint32, error)
+
+	// PublishTransaction attempts to publish a new transaction to the
+	// network.
+	PublishTransaction(context.Context, *wire.MsgTx) error
+
+	// EstimateFee returns a fee estimate for the confirmation target.
+	EstimateFee(ctx context.Context,
+		confTarget uint32) (chainfee.SatPerKWeight, error)
+}
+
+// OnChainCommitterConfig is the config for the OnChainCommitter.
+type OnChainCommitterConfig struct {
+	// KeyRing is used to derive the internal key of each commitment
+	// output.
+	KeyRing CommitmentKeyRing
+
+	// Wallet is used to fund and sign the commitment transaction.
+	Wallet CommitmentWallet
+
+	// ChainBridge is used to publish the commitment transaction and wait
+	// for it to confirm.
+	ChainBridge CommitmentChainBridge
+}
+
+// OnChainCommitter is a ChainCommitter that anchors the universe root in a
+// new P2TR output funded by the backing wallet.
+type OnChainCommitter struct {
+	cfg OnChainCommitterConfig
+}
+
+// A compile-time check to ensure that OnChainCommitter meets the
+// ChainCommitter interface.
+var _ ChainCommitter = (*OnChainCommitter)(nil)
+
+// NewOnChainCommitter creates a new on chain universe committer.
+func NewOnChainCommitter(cfg OnChainCommitterConfig) *OnChainCommitter {
+	return &OnChainCommitter{
+		cfg: cfg,
+	}
+}
+
+// NewCommitment creates a new fully signed, but unpublished commitment to the
+// given issuance and transfer multiverse roots. The wallet inputs spent by the
+// anchor transaction stay leased until the commitment is either confirmed or
+// abandoned.
+//
+// NOTE: This is part of the ChainCommitter interface.
+func (o *OnChainCommitter) NewCommitment(ctx context.Context, issuanceRoot,
+	transferRoot mssmt.Node) (*Commitment, error) {
+
+	internalKey, err := o.cfg.KeyRing.DeriveNextKey(
+		ctx, asset.TaprootAssetsKeyFamily,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive internal key: %w", err)
+	}
+
+	universeRoot := NewCommitmentRoot(issuanceRoot, transferRoot)
+	commitment := &Commitment{
+		InternalKey:  internalKey,
+		IssuanceRoot: universeRoot.Left,
+		TransferRoot: universeRoot.Right,
+		UniverseRoot: universeRoot,
+	}
+
+	outputKey, err := commitment.OutputKey()
+	if err != nil {
+		return nil, err
+	}
+	pkScript, err := txscript.PayToTaprootScript(outputKey)
+	if err != nil {
+		return nil, err
+	}
+
+	heightHint, err := o.cfg.ChainBridge.CurrentHeight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch current height: %w",
+			err)
+	}
+
+	anchorTx, chainFees, err := o.fundAndSign(ctx, pkScript)
+	if err != nil {
+		return nil, err
+	}
+
+	commitment.AnchorTx = anchorTx
+	commitment.ChainFees = chainFees
+	commitment.HeightHint = heightHint
+
+	outputIndex := -1
+	for idx, txOut := range anchorTx.TxOut {
+		if bytes.Equal(txOut.PkScript, pkScript) {
+			outputIndex = idx
+			break
+		}
+	}
+	if outputIndex < 0 {
+		o.unlockInputs(ctx, anchorTx)
+		return nil, fmt.Errorf("commitment output not found in " +
+			"anchor tx")
+	}
+
+	commitment.OutputIndex = uint32(outputIndex)
+
+	// We'll import the output key into the backing wallet, so it
+	// recognizes the de minimis amt sats under our control.
+	_, err = o.cfg.Wallet.ImportTaprootOutput(ctx, outputKey)
+	switch {
+	case err == nil:
+		break
+
+	case errors.Is(err, tapsend.ErrOutputAlreadyImported):
+		break
+
+	default:
+		o.unlockInputs(ctx, anchorTx)
+		return nil, fmt.Errorf("unable to import key: %w", err)
+	}
+
+	return commitment, nil
+}
+
+// PublishCommitment publishes the anchor transaction of the given pending
+// commitment.
+//
+// NOTE: This is part of the ChainCommitter interface.
+func (o *OnChainCommitter) PublishCommitment(ctx context.Context,
+	commitment *Commitment) error {
+
+	err := o.cfg.ChainBridge.PublishTransaction(ctx, commitment.AnchorTx)
+	if err != nil {
+		return fmt.Errorf("unable to publish commitment tx: %w", err)
+	}
+
+	rootHash := commitment.UniverseRoot.NodeHash()
+	log.Infof("Published universe commitment tx %v (universe_root=%x)",
+		commitment.AnchorTx.TxHash(), rootHash[:])
+
+	return nil
+}
+
+// ConfirmCommitment blocks until the anchor transaction of the given pending
+// commitment has confirmed, and returns the commitment with its block and
+// merkle proof populated.
+//
+// NOTE: This is part of the ChainCommitter interface.
+func (o *OnChainCommitter) ConfirmCommitment(ctx context.Context,
+	commitment *Commitment) (*Commitment, error) {
+
+	pkScript, err := commitment.PkScript()
+	if err != nil {
+		return nil, err
+	}
+
+	// We request the block to be included, as we need it to construct
+	// the merkle proof.
+	txHash := commitment.AnchorTx.TxHash()
+	confNtfn, errChan, err := o.cfg.ChainBridge.RegisterConfirmationsNtfn(
+		ctx, &txHash, pkScript, 1, commitment.HeightHint, true, nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register for commitment tx "+
+			"conf: %w", err)
+	}
+
+	var confEvent *chainntnfs.TxConfirmation
+	select {
+	case confEvent = <-confNtfn.Confirmed:
+
+	case err := <-errChan:
+		return nil, fmt.Errorf("error getting confirmation: %w", err)
+
+	case <-ctx.Done():
+		return nil, fmt.Errorf("context done while waiting for "+
+			"commitment tx conf: %w", ctx.Err())
+	}
+
+	if confEvent.Block == nil {
+		return nil, fmt.Errorf("confirmation for commitment tx %v is "+
+			"missing block", txHash)
+	}
+
+	merkleProof, err := proof.NewTxMerkleProof(
+		confEvent.Block.Transactions, int(confEvent.TxIndex),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create merkle proof: %w", err)
+	}
+
+	confirmed := *commitment
+	confirmed.BlockHeight = confEvent.BlockHeight
+	confirmed.BlockHeader = confEvent.Block.Header
+	confirmed.MerkleProof = merkleProof
+
+	log.Infof("Universe commitment tx %v confirmed at height %d", txHash,
+		confEvent.BlockHeight)
+
+	return &confirmed, nil
+}
+
+// AbandonCommitment releases the wallet inputs that were leased for the
+// anchor transaction of the given pending commitment.
+//
+// NOTE: This is part of the ChainCommitter interface.
+func (o *OnChainCommitter) AbandonCommitment(ctx context.Context,
+	commitment *Commitment) error {
+
+	if commitment.AnchorTx == nil {
+		return nil
+	}
+
+	o.unlockInputs(ctx, commitment.AnchorTx)
+
+	return nil
+}
+
+// unlockInputs releases the wallet leases of all inputs of the given anchor
+// transaction. Failures are only logged, as there's nothing else we can do
+// about them.
+func (o *OnChainCommitter) unlockInputs(ctx context.Context,
+	anchorTx *wire.MsgTx) {
+
+	for _, txIn := range anchorTx.TxIn {
+		op := txIn.PreviousOutPoint
+		if err := o.cfg.Wallet.UnlockInput(ctx, op); err != nil {
+			log.Warnf("Unable to unlock input %v: %v", op, err)
+		}
+	}
+}
+
+// fundAndSign creates, funds and signs a transaction that pays to the given
+// commitment pkScript. The signed transaction is returned along with the
+// on-chain fees it pays.
+func (o *OnChainCommitter) fundAndSign(ctx context.Context,
+	pkScript []byte) (*wire.MsgTx, int64, error) {
+
+	txTemplate := wire.NewMsgTx(2)
+	txTemplate.AddTxOut(&wire.TxOut{
+		Value:    int64(CommitmentOutputValue),
+		PkScript: pkScript,
+	})
+	pkt, err := psbt.NewFromUnsignedTx(txTemplate)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to make psbt packet: %w", err)
+	}
+
+	feeRate, err := o.cfg.ChainBridge.EstimateFee(
+		ctx, CommitmentConfTarget,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to estimate fee: %w", err)
+	}
+
+	fundedPkt, err := o.cfg.Wallet.FundPsbt(ctx, pkt, 1, feeRate, -1)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to fund psbt: %w", err)
+	}
+
+	// If anything fails from here on, we'll release the inputs that were
+	// locked by the wallet.
+	unlockInputs := func() {
+		for _, op := range fundedPkt.LockedUTXOs {
+			err := o.cfg.Wallet.UnlockInput(ctx, op)
+			if err != nil {
+				log.Warnf("Unable to unlock input %v: %v", op,
+					err)
+			}
+		}
+	}
+
+	signedPkt, err := o.cfg.Wallet.SignAndFinalizePsbt(ctx, fundedPkt.Pkt)
+	if err != nil {
+		unlockInputs()
+		return nil, 0, fmt.Errorf("unable to sign psbt: %w", err)
+	}
+
+	signedTx, err := psbt.Extract(signedPkt)
+	if err != nil {
+		unlockInputs()
+		return nil, 0, fmt.Errorf("unable to extract psbt: %w", err)
+	}
+
+	err = blockchain.CheckTransactionSanity(btcutil.NewTx(signedTx))
+	if err != nil {
+		unlockInputs()
+		return nil, 0, fmt.Errorf("commitment tx failed final "+
+			"checks: %w", err)
+	}
+
+	chainFees, err := signedPkt.GetTxFee()
+	if err != nil {
+		unlockInputs()
+		return nil, 0, fmt.Errorf("unable to get on-chain fees for "+
+			"psbt: %w", err)
+	}
+
+	return signedTx, int64(chainFees), nil
+}
+
+// ChainStamperConfig is the config for the ChainStamper.
+type ChainStamperConfig struct {
+	// Multiverse is used to fetch the current multiverse roots and
+	// universe proofs.
+	Multiverse MultiverseArchive
+
+	// Commitments is used to persist and query chain commitments.
+	Commitments CommitmentArchive
+
+	// Committer is used to anchor new universe roots on chain.
+	Committer ChainCommitter
+
+	// CommitInterval is the interval at which the multiverse roots are
+	// committed on chain. If zero, commitments are only created on
+	// demand.
+	CommitInterval time.Duration
+}
+
+// ChainStamper periodically anchors the multiverse roots in the chain, and
+// couples universe proofs with the chain commitments that cover them.
+type ChainStamper struct {
+	cfg ChainStamperConfig
+
+	*fn.ContextGuard
+
+	startOnce sync.Once
+
+	stopOnce sync.Once
+
+	// commitMtx ensures that only a single commitment is being created at
+	// any point in time. It isn't held while waiting for a commitment to
+	// confirm, the pending commitment in the archive guards against
+	// concurrent commitments then.
+	commitMtx sync.Mutex
+}
+
+// A compile-time check to ensure that ChainStamper meets the Canonical
+// interface.
+var _ Canonical = (*ChainStamper)(nil)
+
+// NewChainStamper creates a new chain stamper from the passed config.
+func NewChainStamper(cfg ChainStamperConfig) *ChainStamper {
+	return &ChainStamper{
+		cfg: cfg,
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start launches all goroutines needed by the chain stamper.
+func (s *ChainStamper) Start() error {
+	var startErr error
+	s.startOnce.Do(func() {
+		log.Infof("Starting ChainStamper")
+
+		// If we were shut down while waiting for a commitment to
+		// confirm, we'll pick it up again now.
+		ctx, cancel := s.WithCtxQuit()
+		pending, err := s.cfg.Commitments.PendingCommitment(ctx)
+		cancel()
+		switch {
+		case errors.Is(err, ErrNoCommitment):
+
+		case err != nil:
+			startErr = fmt.Errorf("unable to fetch pending "+
+				"universe commitment: %w", err)
+			return
+
+		default:
+			s.Wg.Add(1)
+			go s.resumeCommitment(pending)
+		}
+
+		if s.cfg.CommitInterval == 0 {
+			return
+		}
+
+		s.Wg.Add(1)
+		go s.stamper()
+	})
+
+	return startErr
+}
+
+// resumeCommitment re-publishes the anchor transaction of a pending
+// commitment and waits for it to confirm.
+func (s *ChainStamper) resumeCommitment(pending *Commitment) {
+	defer s.Wg.Done()
+
+	ctx, cancel := s.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	txHash := pending.AnchorTx.TxHash()
+	log.Infof("Resuming pending universe commitment tx %v", txHash)
+
+	// The transaction might already be in the mempool or even confirmed,
+	// so a failure to publish it isn't fatal.
+	err := s.cfg.Committer.PublishCommitment(ctx, pending)
+	if err != nil {
+		log.Warnf("Unable to re-publish universe commitment tx %v: %v",
+			txHash, err)
+	}
+
+	_, err = s.confirmCommitment(ctx, pending)
+	if err != nil {
+		log.Errorf("Unable to confirm universe commitment tx %v: %v",
+			txHash, err)
+	}
+}
+
+// Stop stops all goroutines of the chain stamper.
+func (s *ChainStamper) Stop() error {
+	s.stopOnce.Do(func() {
+		log.Infof("Stopping ChainStamper")
+
+		close(s.Quit)
+
+		s.Wg.Wait()
+
+		log.Infof("Stopped ChainStamper")
+	})
+
+	return nil
+}
+
+// stamper periodically commits the multiverse roots on chain.
+func (s *ChainStamper) stamper() {
+	defer s.Wg.Done()
+
+	commitTicker := time.NewTicker(s.cfg.CommitInterval)
+	defer commitTicker.Stop()
+
+	for {
+		select {
+		case <-commitTicker.C:
+			ctx, cancel := s.WithCtxQuitNoTimeout()
+			_, err := s.UpdateChainCommitment(ctx)
+			cancel()
+
+			switch {
+			case errors.Is(err, ErrCommitmentUnchanged),
+				errors.Is(err, ErrCommitmentPending):
+
+				log.Debugf("Skipping universe commitment: %v",
+					err)
+
+			// Warn, but don't exit the stamper. We'll try again
+			// on the next tick.
+			case err != nil:
+				log.Warnf("Unable to commit universe on "+
+					"chain: %v", err)
+			}
+
+		case <-s.Quit:
+			return
+		}
+	}
+}
+
+// multiverseRoots returns the current issuance and transfer multiverse
+// roots. An empty multiverse is represented by the empty tree root.
+func (s *ChainStamper) multiverseRoots(
+	ctx context.Context) (mssmt.Node, mssmt.Node, error) {
+
+	fetchRoot := func(proofType ProofType) (mssmt.Node, error) {
+		root, err := s.cfg.Multiverse.MultiverseRootNode(
+			ctx, proofType,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch %v multiverse "+
+				"root: %w", proofType, err)
+		}
+
+		return fn.MapOptionZ(root, func(r MultiverseRoot) mssmt.Node {
+			return r.Node
+		}), nil
+	}
+
+	issuanceRoot, err := fetchRoot(ProofTypeIssuance)
+	if err != nil {
+		return nil, nil, err
+	}
+	transferRoot, err := fetchRoot(ProofTypeTransfer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if issuanceRoot == nil {
+		issuanceRoot = mssmt.EmptyTree[0]
+	}
+	if transferRoot == nil {
+		transferRoot = mssmt.EmptyTree[0]
+	}
+
+	return issuanceRoot, transferRoot, nil
+}
+
+// newMultiverseTree creates an in-memory multiverse tree from the given
+// multiverse leaves.
+func newMultiverseTree(ctx context.Context,
+	leaves []MultiverseLeaf) (*mssmt.CompactedTree, error) {
+
+	tree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	for _, leaf := range leaves {
+		_, err := tree.Insert(ctx, leaf.ID.Bytes(), leaf.LeafNode)
+		if err != nil {
+			return nil, fmt.Errorf("unable to insert leaf: %w", err)
+		}
+	}
+
+	return tree, nil
+}
+
+// multiverseSnapshot fetches the leaves of the issuance and transfer
+// multiverse trees, and returns the roots computed from them along with the
+// leaves. Computing the roots from the leaves ensures that the leaves stored
+// with a commitment always match the committed roots.
+func (s *ChainStamper) multiverseSnapshot(
+	ctx context.Context) (mssmt.Node, mssmt.Node, []MultiverseLeaf, error) {
+
+	var (
+		roots     = make(map[ProofType]mssmt.Node, 2)
+		allLeaves []MultiverseLeaf
+	)
+	for _, proofType := range []ProofType{
+		ProofTypeIssuance, ProofTypeTransfer,
+	} {
+
+		leaves, err := s.cfg.Multiverse.FetchLeaves(
+			ctx, nil, proofType,
+		)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("unable to fetch %v "+
+				"multiverse leaves: %w", proofType, err)
+		}
+
+		tree, err := newMultiverseTree(ctx, leaves)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		root, err := tree.Root(ctx)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("unable to obtain %v "+
+				"multiverse root: %w", proofType, err)
+		}
+
+		roots[proofType] = root
+		allLeaves = append(allLeaves, leaves...)
+	}
+
+	return roots[ProofTypeIssuance], roots[ProofTypeTransfer], allLeaves,
+		nil
+}
+
+// Query returns a fully proved response for the target leaf key.
+//
+// NOTE: This is part of the Canonical interface.
+func (s *ChainStamper) Query(ctx context.Context, id Identifier,
+	key LeafKey) ([]*CommittedIssuanceProof, error) {
+
+	proofs, err := s.cfg.Multiverse.FetchProofLeaf(ctx, id, key)
+	if err != nil {
+		return nil, err
+	}
+
+	committedProofs := make([]*CommittedIssuanceProof, 0, len(proofs))
+	for _, uniProof := range proofs {
+		committedProof, err := s.CommitProof(ctx, uniProof)
+		if err != nil {
+			return nil, err
+		}
+
+		committedProofs = append(committedProofs, committedProof)
+	}
+
+	return committedProofs, nil
+}
+
+// CommitProof couples the given universe proof with the earliest chain
+// commitment of the multiverse root the proof was generated against. If that
+// root was never committed to, the latest commitment that still includes the
+// universe root of the proof is used instead, and the multiverse inclusion
+// proof is re-created against the committed multiverse root. If no commitment
+// covers the proof, the chain proof is left empty.
+//
+// NOTE: This is part of the Canonical interface.
+func (s *ChainStamper) CommitProof(ctx context.Context,
+	uniProof *Proof) (*CommittedIssuanceProof, error) {
+
+	committedProof := &CommittedIssuanceProof{
+		TaprootAssetProof: uniProof,
+	}
+
+	if uniProof.MultiverseRoot == nil {
+		return committedProof, nil
+	}
+
+	commitment, err := s.cfg.Commitments.FetchCommitmentByRoot(
+		ctx, uniProof.MultiverseRoot,
+	)
+	switch {
+	case err == nil:
+		committedProof.ChainProof = commitment
+		return committedProof, nil
+
+	case !errors.Is(err, ErrNoCommitment):
+		return nil, err
+	}
+
+	// The multiverse has moved on since the latest commitment. But as long
+	// as the universe root of the proof hasn't changed, an older
+	// commitment still covers it.
+	multiverseLeaf, err := proofMultiverseLeaf(uniProof)
+	if err != nil {
+		return nil, err
+	}
+
+	commitment, leaves, err := s.cfg.Commitments.FetchCommitmentByLeaf(
+		ctx, multiverseLeaf,
+	)
+	switch {
+	case errors.Is(err, ErrNoCommitment):
+		return committedProof, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	tree, err := newMultiverseTree(ctx, leaves)
+	if err != nil {
+		return nil, err
+	}
+	multiverseRoot, err := tree.Root(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proofType := multiverseLeaf.ID.ProofType
+	if !commitment.CommitsTo(proofType, multiverseRoot) {
+		return nil, fmt.Errorf("stored multiverse leaves don't match "+
+			"%v root of commitment %v", proofType,
+			commitment.OutPoint())
+	}
+
+	inclusionProof, err := tree.MerkleProof(
+		ctx, multiverseLeaf.ID.Bytes(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create multiverse inclusion "+
+			"proof: %w", err)
+	}
+
+	committedUniProof := *uniProof
+	committedUniProof.MultiverseRoot = multiverseRoot
+	committedUniProof.MultiverseInclusionProof = inclusionProof
+
+	return &CommittedIssuanceProof{
+		ChainProof:        commitment,
+		TaprootAssetProof: &committedUniProof,
+	}, nil
+}
+
+// LatestCommitment returns the latest chain commitment.
+//
+// NOTE: This is part of the Canonical interface.
+func (s *ChainStamper) LatestCommitment(
+	ctx context.Context) (*Commitment, error) {
+
+	return s.cfg.Commitments.LatestCommitment(ctx)
+}
+
+// UpdateChainCommitment commits the current multiverse roots on chain, unless
+// they're unchanged since the latest commitment. The commitment is persisted
+// as pending before its anchor transaction is published, so its confirmation
+// can be picked up again after a restart.
+//
+// NOTE: This is part of the Canonical interface.
+func (s *ChainStamper) UpdateChainCommitment(
+	ctx context.Context) (*Commitment, error) {
+
+	pending, err := s.publishCommitment(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.confirmCommitment(ctx, pending)
+}
+
+// publishCommitment creates, persists and publishes a new pending commitment
+// to the current multiverse roots.
+func (s *ChainStamper) publishCommitment(
+	ctx context.Context) (*Commitment, error) {
+
+	s.commitMtx.Lock()
+	defer s.commitMtx.Unlock()
+
+	_, err := s.cfg.Commitments.PendingCommitment(ctx)
+	switch {
+	case err == nil:
+		return nil, ErrCommitmentPending
+
+	case !errors.Is(err, ErrNoCommitment):
+		return nil, err
+	}
+
+	latest, err := s.cfg.Commitments.LatestCommitment(ctx)
+	if err != nil && !errors.Is(err, ErrNoCommitment) {
+		return nil, err
+	}
+
+	// We first do a cheap check against the current multiverse roots
+	// before we fetch all multiverse leaves.
+	unchanged := func(issuanceRoot, transferRoot mssmt.Node) bool {
+		return latest != nil &&
+			latest.CommitsTo(ProofTypeIssuance, issuanceRoot) &&
+			latest.CommitsTo(ProofTypeTransfer, transferRoot)
+	}
+
+	issuanceRoot, transferRoot, err := s.multiverseRoots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if unchanged(issuanceRoot, transferRoot) {
+		return nil, ErrCommitmentUnchanged
+	}
+
+	issuanceRoot, transferRoot, leaves, err := s.multiverseSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if unchanged(issuanceRoot, transferRoot) {
+		return nil, ErrCommitmentUnchanged
+	}
+
+	commitment, err := s.cfg.Committer.NewCommitment(
+		ctx, issuanceRoot, transferRoot,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// If we fail to persist or publish the commitment, we'll release the
+	// inputs of the anchor transaction again.
+	abandon := func() {
+		err := s.cfg.Committer.AbandonCommitment(ctx, commitment)
+		if err != nil {
+			log.Warnf("Unable to abandon universe commitment: %v",
+				err)
+		}
+	}
+
+	err = s.cfg.Commitments.InsertPendingCommitment(ctx, commitment, leaves)
+	if err != nil {
+		abandon()
+		return nil, fmt.Errorf("unable to store pending universe "+
+			"commitment: %w", err)
+	}
+
+	err = s.cfg.Committer.PublishCommitment(ctx, commitment)
+	if err != nil {
+		abandon()
+
+		dbErr := s.cfg.Commitments.DeletePendingCommitment(ctx)
+		if dbErr != nil {
+			log.Warnf("Unable to delete pending universe "+
+				"commitment: %v", dbErr)
+		}
+
+		return nil, err
+	}
+
+	return commitment, nil
+}
+
+// confirmCommitment waits for the given pending commitment to confirm, and
+// then stores it as the latest commitment.
+func (s *ChainStamper) confirmCommitment(ctx context.Context,
+	pending *Commitment) (*Commitment, error) {
+
+	commitment, err := s.cfg.Committer.ConfirmCommitment(ctx, pending)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cfg.Commitments.InsertCommitment(ctx, commitment)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store universe commitment: "+
+			"%w", err)
+	}
+
+	return commitment, nil
+}
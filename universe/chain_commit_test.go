@@ -0,0 +1,644 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// mockMultiverse is a mock multiverse archive that only serves the
+// multiverse leaves and roots.
+type mockMultiverse struct {
+	MultiverseArchive
+
+	leaves map[ProofType][]MultiverseLeaf
+}
+
+func (m *mockMultiverse) MultiverseRootNode(ctx context.Context,
+	proofType ProofType) (fn.Option[MultiverseRoot], error) {
+
+	leaves, ok := m.leaves[proofType]
+	if !ok {
+		return fn.None[MultiverseRoot](), nil
+	}
+
+	tree, err := newMultiverseTree(ctx, leaves)
+	if err != nil {
+		return fn.None[MultiverseRoot](), err
+	}
+	root, err := tree.Root(ctx)
+	if err != nil {
+		return fn.None[MultiverseRoot](), err
+	}
+
+	return fn.Some(MultiverseRoot{
+		ProofType: proofType,
+		Node:      root,
+	}), nil
+}
+
+func (m *mockMultiverse) FetchLeaves(_ context.Context,
+	_ []MultiverseLeafDesc, proofType ProofType) ([]MultiverseLeaf,
+	error) {
+
+	return m.leaves[proofType], nil
+}
+
+// addLeaf adds a new leaf to the multiverse, and returns the new multiverse
+// root of the leaf's proof type along with an inclusion proof for the leaf.
+func (m *mockMultiverse) addLeaf(t *testing.T,
+	leaf MultiverseLeaf) (mssmt.Node, *mssmt.Proof) {
+
+	ctx := context.Background()
+	proofType := leaf.ID.ProofType
+	m.leaves[proofType] = append(m.leaves[proofType], leaf)
+
+	tree, err := newMultiverseTree(ctx, m.leaves[proofType])
+	require.NoError(t, err)
+
+	root, err := tree.Root(ctx)
+	require.NoError(t, err)
+
+	inclusionProof, err := tree.MerkleProof(ctx, leaf.ID.Bytes())
+	require.NoError(t, err)
+
+	return root, inclusionProof
+}
+
+// addRandLeaf adds a random leaf of the given proof type to the multiverse and
+// returns the new multiverse root.
+func (m *mockMultiverse) addRandLeaf(t *testing.T,
+	proofType ProofType) mssmt.Node {
+
+	root, _ := m.addLeaf(t, MultiverseLeaf{
+		ID: Identifier{
+			AssetID:   asset.RandID(t),
+			ProofType: proofType,
+		},
+		LeafNode: mssmt.NewLeafNode(
+			test.RandBytes(32), uint64(test.RandInt[uint32]()),
+		),
+	})
+
+	return root
+}
+
+// addIssuance creates a new universe with a single issuance leaf, adds the
+// universe to the multiverse and returns a full universe proof for the leaf.
+func (m *mockMultiverse) addIssuance(t *testing.T) *Proof {
+	ctx := context.Background()
+
+	genAsset := randGenesisAsset(t)
+	leaf := &Leaf{
+		GenesisWithGroup: GenesisWithGroup{
+			Genesis: genAsset.Genesis,
+		},
+		RawProof: test.RandBytes(100),
+		Asset:    &genAsset,
+		Amt:      genAsset.Amount,
+	}
+	leafKey := LeafKey{
+		OutPoint:  test.RandOp(t),
+		ScriptKey: &genAsset.ScriptKey,
+	}
+
+	uniTree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	_, err := uniTree.Insert(ctx, leafKey.UniverseKey(), leaf.SmtLeafNode())
+	require.NoError(t, err)
+
+	uniRoot, err := uniTree.Root(ctx)
+	require.NoError(t, err)
+
+	uniInclusionProof, err := uniTree.MerkleProof(
+		ctx, leafKey.UniverseKey(),
+	)
+	require.NoError(t, err)
+
+	uniProof := &Proof{
+		Leaf:                   leaf,
+		LeafKey:                leafKey,
+		UniverseRoot:           uniRoot,
+		UniverseInclusionProof: uniInclusionProof,
+	}
+
+	multiverseLeaf, err := proofMultiverseLeaf(uniProof)
+	require.NoError(t, err)
+
+	uniProof.MultiverseRoot, uniProof.MultiverseInclusionProof = m.addLeaf(
+		t, multiverseLeaf,
+	)
+
+	return uniProof
+}
+
+// mockCommitmentArchive is an in-memory commitment archive.
+type mockCommitmentArchive struct {
+	sync.Mutex
+
+	commitments []*Commitment
+	leaves      [][]MultiverseLeaf
+
+	pending       *Commitment
+	pendingLeaves []MultiverseLeaf
+}
+
+func (m *mockCommitmentArchive) InsertPendingCommitment(_ context.Context,
+	c *Commitment, leaves []MultiverseLeaf) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.pending = c
+	m.pendingLeaves = leaves
+	return nil
+}
+
+func (m *mockCommitmentArchive) PendingCommitment(
+	_ context.Context) (*Commitment, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	if m.pending == nil {
+		return nil, ErrNoCommitment
+	}
+
+	return m.pending, nil
+}
+
+func (m *mockCommitmentArchive) DeletePendingCommitment(
+	_ context.Context) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.pending = nil
+	m.pendingLeaves = nil
+	return nil
+}
+
+func (m *mockCommitmentArchive) InsertCommitment(_ context.Context,
+	c *Commitment) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.commitments = append(m.commitments, c)
+	m.leaves = append(m.leaves, m.pendingLeaves)
+	m.pending = nil
+	m.pendingLeaves = nil
+	return nil
+}
+
+func (m *mockCommitmentArchive) LatestCommitment(
+	_ context.Context) (*Commitment, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	if len(m.commitments) == 0 {
+		return nil, ErrNoCommitment
+	}
+
+	return m.commitments[len(m.commitments)-1], nil
+}
+
+func (m *mockCommitmentArchive) FetchCommitmentByRoot(_ context.Context,
+	root mssmt.Node) (*Commitment, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	for _, c := range m.commitments {
+		if c.CommitsTo(ProofTypeIssuance, root) ||
+			c.CommitsTo(ProofTypeTransfer, root) {
+
+			return c, nil
+		}
+	}
+
+	return nil, ErrNoCommitment
+}
+
+func (m *mockCommitmentArchive) FetchCommitmentByLeaf(_ context.Context,
+	leaf MultiverseLeaf) (*Commitment, []MultiverseLeaf, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	for idx := len(m.commitments) - 1; idx >= 0; idx-- {
+		var (
+			found      bool
+			typeLeaves []MultiverseLeaf
+		)
+		for _, l := range m.leaves[idx] {
+			if l.ID.ProofType != leaf.ID.ProofType {
+				continue
+			}
+
+			typeLeaves = append(typeLeaves, l)
+			if l.ID.Bytes() == leaf.ID.Bytes() &&
+				mssmt.IsEqualNode(l.LeafNode, leaf.LeafNode) {
+
+				found = true
+			}
+		}
+
+		if found {
+			return m.commitments[idx], typeLeaves, nil
+		}
+	}
+
+	return nil, nil, ErrNoCommitment
+}
+
+// mockCommitter is a chain committer that mines each commitment in a new
+// fake block.
+type mockCommitter struct {
+	sync.Mutex
+
+	t      *testing.T
+	height uint32
+
+	publishErr error
+	published  []*Commitment
+	abandoned  []*Commitment
+}
+
+func (m *mockCommitter) NewCommitment(_ context.Context, issuanceRoot,
+	transferRoot mssmt.Node) (*Commitment, error) {
+
+	internalKey, _ := test.RandKeyDesc(m.t)
+	universeRoot := NewCommitmentRoot(issuanceRoot, transferRoot)
+	commitment := &Commitment{
+		InternalKey:  internalKey,
+		IssuanceRoot: universeRoot.Left,
+		TransferRoot: universeRoot.Right,
+		UniverseRoot: universeRoot,
+	}
+
+	pkScript, err := commitment.PkScript()
+	require.NoError(m.t, err)
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(m.t),
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		Value:    int64(CommitmentOutputValue),
+		PkScript: pkScript,
+	})
+	commitment.AnchorTx = anchorTx
+
+	return commitment, nil
+}
+
+func (m *mockCommitter) PublishCommitment(_ context.Context,
+	c *Commitment) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	if m.publishErr != nil {
+		return m.publishErr
+	}
+
+	m.published = append(m.published, c)
+	return nil
+}
+
+func (m *mockCommitter) ConfirmCommitment(_ context.Context,
+	c *Commitment) (*Commitment, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.height++
+
+	otherTx := wire.NewMsgTx(2)
+	otherTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(m.t),
+	})
+
+	blockTxs := []*wire.MsgTx{otherTx, c.AnchorTx}
+	merkleProof, err := proof.NewTxMerkleProof(blockTxs, 1)
+	require.NoError(m.t, err)
+
+	merkleRoot := blockchain.CalcMerkleRoot(
+		fn.Map(blockTxs, btcutil.NewTx), false,
+	)
+
+	confirmed := *c
+	confirmed.BlockHeight = m.height
+	confirmed.MerkleProof = merkleProof
+	confirmed.BlockHeader = *wire.NewBlockHeader(
+		0, fn.Ptr(test.RandHash()), &merkleRoot, 0, 0,
+	)
+
+	return &confirmed, nil
+}
+
+func (m *mockCommitter) AbandonCommitment(_ context.Context,
+	c *Commitment) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.abandoned = append(m.abandoned, c)
+	return nil
+}
+
+// commitUniverse creates a new confirmed commitment to the given roots.
+func (m *mockCommitter) commitUniverse(issuanceRoot,
+	transferRoot mssmt.Node) *Commitment {
+
+	ctx := context.Background()
+	c, err := m.NewCommitment(ctx, issuanceRoot, transferRoot)
+	require.NoError(m.t, err)
+
+	c, err = m.ConfirmCommitment(ctx, c)
+	require.NoError(m.t, err)
+
+	return c
+}
+
+// randRootNode returns a random MS-SMT root node.
+func randRootNode() mssmt.Node {
+	return mssmt.NewComputedBranch(
+		mssmt.NodeHash(test.RandHash()), uint64(test.RandInt[uint32]()),
+	)
+}
+
+// TestCommitmentVerify tests that a valid universe commitment verifies, and
+// that any modification to it is detected.
+func TestCommitmentVerify(t *testing.T) {
+	t.Parallel()
+
+	committer := &mockCommitter{t: t}
+	newCommitment := func() *Commitment {
+		return committer.commitUniverse(randRootNode(), randRootNode())
+	}
+
+	verify := func(c *Commitment) error {
+		return c.Verify(
+			proof.MockHeaderVerifier, proof.DefaultMerkleVerifier,
+		)
+	}
+
+	require.NoError(t, verify(newCommitment()))
+
+	testCases := []struct {
+		name   string
+		modify func(c *Commitment)
+	}{{
+		name: "missing anchor tx",
+		modify: func(c *Commitment) {
+			c.AnchorTx = nil
+		},
+	}, {
+		name: "invalid output index",
+		modify: func(c *Commitment) {
+			c.OutputIndex = 1
+		},
+	}, {
+		name: "issuance root mismatch",
+		modify: func(c *Commitment) {
+			c.IssuanceRoot = randRootNode()
+		},
+	}, {
+		name: "tampered universe root",
+		modify: func(c *Commitment) {
+			c.IssuanceRoot = randRootNode()
+			c.UniverseRoot = NewCommitmentRoot(
+				c.IssuanceRoot, c.TransferRoot,
+			)
+		},
+	}, {
+		name: "wrong internal key",
+		modify: func(c *Commitment) {
+			c.InternalKey.PubKey = test.RandPubKey(t)
+		},
+	}, {
+		name: "invalid merkle proof",
+		modify: func(c *Commitment) {
+			c.BlockHeader.MerkleRoot = test.RandHash()
+		},
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newCommitment()
+			tc.modify(c)
+
+			require.ErrorIs(t, verify(c), ErrInvalidCommitment)
+		})
+	}
+}
+
+// newTestChainStamper creates a new chain stamper backed by mocks.
+func newTestChainStamper(t *testing.T) (*ChainStamper, *mockMultiverse,
+	*mockCommitmentArchive, *mockCommitter) {
+
+	multiverse := &mockMultiverse{
+		leaves: make(map[ProofType][]MultiverseLeaf),
+	}
+	archive := &mockCommitmentArchive{}
+	committer := &mockCommitter{t: t}
+	stamper := NewChainStamper(ChainStamperConfig{
+		Multiverse:  multiverse,
+		Commitments: archive,
+		Committer:   committer,
+	})
+
+	return stamper, multiverse, archive, committer
+}
+
+// TestChainStamperUpdateCommitment tests that the chain stamper only commits
+// to the multiverse roots if they changed, and that proofs are coupled with
+// the earliest commitment of their multiverse root.
+func TestChainStamperUpdateCommitment(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	stamper, multiverse, archive, _ := newTestChainStamper(t)
+
+	_, err := stamper.LatestCommitment(ctx)
+	require.ErrorIs(t, err, ErrNoCommitment)
+
+	// With an empty multiverse, we'll commit to the empty tree roots.
+	commitment1, err := stamper.UpdateChainCommitment(ctx)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(
+		commitment1.IssuanceRoot, mssmt.EmptyTree[0],
+	))
+	require.True(t, mssmt.IsEqualNode(
+		commitment1.TransferRoot, mssmt.EmptyTree[0],
+	))
+
+	// If nothing changed, we shouldn't create a new commitment.
+	_, err = stamper.UpdateChainCommitment(ctx)
+	require.ErrorIs(t, err, ErrCommitmentUnchanged)
+
+	// Once the issuance root changes, a new commitment is created.
+	uniProof := multiverse.addIssuance(t)
+	issuanceRoot := uniProof.MultiverseRoot
+
+	commitment2, err := stamper.UpdateChainCommitment(ctx)
+	require.NoError(t, err)
+	require.True(t, commitment2.CommitsTo(ProofTypeIssuance, issuanceRoot))
+
+	latest, err := stamper.LatestCommitment(ctx)
+	require.NoError(t, err)
+	require.Equal(t, commitment2, latest)
+
+	// The confirmed commitment is no longer pending.
+	_, err = archive.PendingCommitment(ctx)
+	require.ErrorIs(t, err, ErrNoCommitment)
+
+	// Changing only the transfer root also results in a new commitment,
+	// but a proof against the issuance root should still be coupled with
+	// the earliest commitment to it.
+	multiverse.addRandLeaf(t, ProofTypeTransfer)
+
+	commitment3, err := stamper.UpdateChainCommitment(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, commitment2, commitment3)
+
+	committedProof, err := stamper.CommitProof(ctx, uniProof)
+	require.NoError(t, err)
+	require.Equal(t, commitment2, committedProof.ChainProof)
+	require.NoError(t, committedProof.Verify(
+		proof.MockHeaderVerifier, proof.DefaultMerkleVerifier,
+	))
+
+	// A proof against a universe that was never committed to has no chain
+	// proof.
+	uncommittedProof := multiverse.addIssuance(t)
+	committedProof, err = stamper.CommitProof(ctx, uncommittedProof)
+	require.NoError(t, err)
+	require.Nil(t, committedProof.ChainProof)
+}
+
+// TestChainStamperCommitProofHistory tests that a proof generated against an
+// uncommitted multiverse root is coupled with the latest commitment that
+// still includes its universe root.
+func TestChainStamperCommitProofHistory(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	stamper, multiverse, _, _ := newTestChainStamper(t)
+
+	uniProof := multiverse.addIssuance(t)
+	commitment1, err := stamper.UpdateChainCommitment(ctx)
+	require.NoError(t, err)
+
+	multiverse.addRandLeaf(t, ProofTypeIssuance)
+	commitment2, err := stamper.UpdateChainCommitment(ctx)
+	require.NoError(t, err)
+
+	// The multiverse moves on after the latest commitment, and the proof
+	// is re-created against the current multiverse root.
+	newProof := multiverse.addIssuance(t)
+
+	multiverseLeaf, err := proofMultiverseLeaf(uniProof)
+	require.NoError(t, err)
+	tree, err := newMultiverseTree(
+		ctx, multiverse.leaves[ProofTypeIssuance],
+	)
+	require.NoError(t, err)
+	currentRoot, err := tree.Root(ctx)
+	require.NoError(t, err)
+
+	currentProof := *uniProof
+	currentProof.MultiverseRoot = currentRoot
+	currentProof.MultiverseInclusionProof, err = tree.MerkleProof(
+		ctx, multiverseLeaf.ID.Bytes(),
+	)
+	require.NoError(t, err)
+
+	// The proof should be coupled with the latest commitment, not the
+	// first one, and verify against it.
+	committedProof, err := stamper.CommitProof(ctx, &currentProof)
+	require.NoError(t, err)
+	require.Equal(t, commitment2, committedProof.ChainProof)
+	require.NotEqual(t, commitment1, committedProof.ChainProof)
+	require.True(t, mssmt.IsEqualNode(
+		commitment2.IssuanceRoot,
+		committedProof.TaprootAssetProof.MultiverseRoot,
+	))
+	require.NoError(t, committedProof.Verify(
+		proof.MockHeaderVerifier, proof.DefaultMerkleVerifier,
+	))
+
+	// The universe that was added after the latest commitment isn't
+	// covered by any commitment yet.
+	committedProof, err = stamper.CommitProof(ctx, newProof)
+	require.NoError(t, err)
+	require.Nil(t, committedProof.ChainProof)
+}
+
+// TestChainStamperPendingCommitment tests that a pending commitment blocks new
+// commitments, is resumed on start, and that a commitment that fails to be
+// published is abandoned.
+func TestChainStamperPendingCommitment(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	stamper, multiverse, archive, committer := newTestChainStamper(t)
+	multiverse.addRandLeaf(t, ProofTypeIssuance)
+
+	// If the commitment can't be published, the inputs are released and
+	// the pending commitment is removed again.
+	committer.publishErr = errors.New("publish failed")
+	_, err := stamper.UpdateChainCommitment(ctx)
+	require.ErrorIs(t, err, committer.publishErr)
+	require.Len(t, committer.abandoned, 1)
+
+	_, err = archive.PendingCommitment(ctx)
+	require.ErrorIs(t, err, ErrNoCommitment)
+
+	// We now simulate a restart while waiting for a published commitment
+	// to confirm.
+	committer.publishErr = nil
+	pending, err := committer.NewCommitment(
+		ctx, randRootNode(), randRootNode(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, archive.InsertPendingCommitment(ctx, pending, nil))
+
+	// As long as the commitment is pending, no new commitment is created.
+	_, err = stamper.UpdateChainCommitment(ctx)
+	require.ErrorIs(t, err, ErrCommitmentPending)
+
+	// Once started, the stamper re-publishes the pending commitment and
+	// stores it once it confirmed.
+	require.NoError(t, stamper.Start())
+	t.Cleanup(func() {
+		require.NoError(t, stamper.Stop())
+	})
+
+	require.Eventually(t, func() bool {
+		_, err := archive.PendingCommitment(ctx)
+		return errors.Is(err, ErrNoCommitment)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	latest, err := stamper.LatestCommitment(ctx)
+	require.NoError(t, err)
+	require.Equal(t, pending.AnchorTx.TxHash(), latest.AnchorTx.TxHash())
+
+	committer.Lock()
+	require.Len(t, committer.published, 1)
+	committer.Unlock()
+}
@@ -0,0 +1,152 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+// NewCheckpointVerifier returns a proof checkpoint verifier that accepts a
+// proof as a checkpoint if the exact same proof is a leaf of one of the
+// universes the given diff engines serve, and the leaf is committed to the
+// current root of that universe. The local universe is expected to be passed
+// first, followed by any trusted remote universe servers. For the local
+// universe, this means the proof was already verified when it was inserted, or
+// it was synced from a trusted federation server.
+func NewCheckpointVerifier(local DiffEngine,
+	trustedRemotes ...DiffEngine) proof.CheckpointVerifier {
+
+	diffEngines := append([]DiffEngine{local}, trustedRemotes...)
+
+	return func(ctx context.Context, p *proof.Proof) error {
+		var errs []error
+		for _, diffEngine := range diffEngines {
+			err := verifyCheckpoint(ctx, diffEngine, p)
+			if err == nil {
+				return nil
+			}
+
+			errs = append(errs, err)
+		}
+
+		return errors.Join(errs...)
+	}
+}
+
+// verifyCheckpoint checks that the given proof is a leaf of the universe
+// served by the given diff engine, and that the leaf is committed to the
+// current root of that universe.
+func verifyCheckpoint(ctx context.Context, diffEngine DiffEngine,
+	p *proof.Proof) error {
+
+	uniID := NewUniIDFromAsset(p.Asset)
+	leafKey := LeafKey{
+		OutPoint:  p.OutPoint(),
+		ScriptKey: &p.Asset.ScriptKey,
+	}
+
+	root, err := diffEngine.RootNode(ctx, uniID)
+	if err != nil {
+		return fmt.Errorf("unable to fetch universe root: %w", err)
+	}
+
+	uniProofs, err := diffEngine.FetchProofLeaf(ctx, uniID, leafKey)
+	if err != nil {
+		return fmt.Errorf("unable to fetch universe leaf: %w", err)
+	}
+
+	var proofBuf bytes.Buffer
+	if err := p.Encode(&proofBuf); err != nil {
+		return fmt.Errorf("unable to encode proof: %w", err)
+	}
+
+	// Multiple leaves might be returned for the same leaf key, so we look
+	// for the one that contains our proof.
+	for _, uniProof := range uniProofs {
+		if uniProof.Leaf == nil || !bytes.Equal(
+			uniProof.Leaf.RawProof, proofBuf.Bytes(),
+		) {
+
+			continue
+		}
+
+		if !uniProof.VerifyRoot(root.Node) {
+			return fmt.Errorf("universe leaf isn't committed to " +
+				"universe root")
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("proof doesn't match universe leaf %x",
+		leafKey.UniverseKey())
+}
+
+// NewHistoryFetcher returns a proof history fetcher that fetches single
+// proofs from the first of the universes the given diff engines serve that
+// has them. This is used to restore the history of pruned proof files from the
+// same universes their checkpoints are verified against.
+func NewHistoryFetcher(local DiffEngine,
+	trustedRemotes ...DiffEngine) proof.HistoryFetcher {
+
+	diffEngines := append([]DiffEngine{local}, trustedRemotes...)
+
+	return func(ctx context.Context, loc proof.Locator) (proof.Blob,
+		error) {
+
+		if loc.AssetID == nil || loc.OutPoint == nil {
+			return nil, fmt.Errorf("proof locator is missing " +
+				"asset ID or outpoint")
+		}
+
+		scriptKey := asset.NewScriptKey(&loc.ScriptKey)
+		leafKey := LeafKey{
+			OutPoint:  *loc.OutPoint,
+			ScriptKey: &scriptKey,
+		}
+
+		// The proof could either be an issuance or a transfer proof,
+		// so we look in both universes.
+		var uniIDs []Identifier
+		for _, proofType := range []ProofType{
+			ProofTypeIssuance, ProofTypeTransfer,
+		} {
+
+			uniID := Identifier{
+				AssetID:   *loc.AssetID,
+				ProofType: proofType,
+			}
+			if loc.GroupKey != nil {
+				uniID = Identifier{
+					GroupKey:  loc.GroupKey,
+					ProofType: proofType,
+				}
+			}
+
+			uniIDs = append(uniIDs, uniID)
+		}
+
+		for _, diffEngine := range diffEngines {
+			for _, uniID := range uniIDs {
+				uniProofs, err := diffEngine.FetchProofLeaf(
+					ctx, uniID, leafKey,
+				)
+				if err != nil || len(uniProofs) == 0 ||
+					uniProofs[0].Leaf == nil {
+
+					continue
+				}
+
+				return uniProofs[0].Leaf.RawProof, nil
+			}
+		}
+
+		return nil, fmt.Errorf("%w: proof %x not found in any trusted "+
+			"universe", proof.ErrProofNotFound,
+			leafKey.UniverseKey())
+	}
+}
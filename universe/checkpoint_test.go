@@ -0,0 +1,148 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// mockCheckpointDiffEngine is a diff engine that serves a single universe
+// root and the proofs of a single leaf.
+type mockCheckpointDiffEngine struct {
+	DiffEngine
+
+	root   Root
+	proofs []*Proof
+}
+
+func (m *mockCheckpointDiffEngine) RootNode(context.Context,
+	Identifier) (Root, error) {
+
+	return m.root, nil
+}
+
+func (m *mockCheckpointDiffEngine) FetchProofLeaf(context.Context,
+	Identifier, LeafKey) ([]*Proof, error) {
+
+	return m.proofs, nil
+}
+
+// TestCheckpointVerifier tests that a proof is only accepted as a checkpoint
+// if it is a leaf that is committed to the universe root.
+func TestCheckpointVerifier(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxOut(&wire.TxOut{
+		PkScript: bytes.Repeat([]byte{0x01}, 34),
+		Value:    1000,
+	})
+	block := wire.MsgBlock{
+		Transactions: []*wire.MsgTx{anchorTx},
+	}
+
+	genesis := asset.RandGenesis(t, asset.Normal)
+	checkpoint := proof.RandProof(
+		t, genesis, test.RandPubKey(t), block, 0, 0,
+	)
+
+	var proofBuf bytes.Buffer
+	require.NoError(t, checkpoint.Encode(&proofBuf))
+
+	leafKey := LeafKey{
+		OutPoint:  checkpoint.OutPoint(),
+		ScriptKey: &checkpoint.Asset.ScriptKey,
+	}
+	leaf := &Leaf{
+		RawProof: proofBuf.Bytes(),
+		Asset:    &checkpoint.Asset,
+		Amt:      checkpoint.Asset.Amount,
+	}
+
+	tree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	_, err := tree.Insert(ctx, leafKey.UniverseKey(), leaf.SmtLeafNode())
+	require.NoError(t, err)
+
+	rootNode, err := tree.Root(ctx)
+	require.NoError(t, err)
+	inclusionProof, err := tree.MerkleProof(ctx, leafKey.UniverseKey())
+	require.NoError(t, err)
+
+	diffEngine := &mockCheckpointDiffEngine{
+		root: Root{
+			ID:   NewUniIDFromAsset(checkpoint.Asset),
+			Node: rootNode,
+		},
+		proofs: []*Proof{{
+			Leaf:                   leaf,
+			LeafKey:                leafKey,
+			UniverseRoot:           rootNode,
+			UniverseInclusionProof: inclusionProof,
+		}},
+	}
+	verifier := NewCheckpointVerifier(diffEngine)
+
+	// The proof is a leaf of the universe, so it's accepted.
+	require.NoError(t, verifier(ctx, &checkpoint))
+
+	// A different proof at the same leaf key isn't accepted.
+	otherProof := checkpoint
+	otherProof.BlockHeight++
+	require.ErrorContains(
+		t, verifier(ctx, &otherProof), "doesn't match universe leaf",
+	)
+
+	// And neither is a leaf that isn't committed to the universe root.
+	diffEngine.root.Node = mssmt.NewComputedBranch(
+		mssmt.NodeHash(test.RandHash()), 1,
+	)
+	require.ErrorContains(
+		t, verifier(ctx, &checkpoint), "isn't committed to universe",
+	)
+
+	// A leaf of a trusted remote universe is accepted as well, even if the
+	// local universe doesn't know about it.
+	localEngine := &mockCheckpointDiffEngine{
+		root: diffEngine.root,
+	}
+	remoteEngine := &mockCheckpointDiffEngine{
+		root: Root{
+			ID:   NewUniIDFromAsset(checkpoint.Asset),
+			Node: rootNode,
+		},
+		proofs: diffEngine.proofs,
+	}
+	verifier = NewCheckpointVerifier(localEngine, remoteEngine)
+	require.NoError(t, verifier(ctx, &checkpoint))
+
+	verifier = NewCheckpointVerifier(localEngine)
+	require.ErrorContains(
+		t, verifier(ctx, &checkpoint), "doesn't match universe leaf",
+	)
+
+	// The history of pruned proof files is fetched from the same
+	// universes.
+	loc := proof.Locator{
+		AssetID:   fn.Ptr(checkpoint.Asset.ID()),
+		ScriptKey: *checkpoint.Asset.ScriptKey.PubKey,
+		OutPoint:  fn.Ptr(checkpoint.OutPoint()),
+	}
+	historyFetcher := NewHistoryFetcher(localEngine, remoteEngine)
+	rawProof, err := historyFetcher(ctx, loc)
+	require.NoError(t, err)
+	require.Equal(t, proofBuf.Bytes(), []byte(rawProof))
+
+	historyFetcher = NewHistoryFetcher(localEngine)
+	_, err = historyFetcher(ctx, loc)
+	require.ErrorIs(t, err, proof.ErrProofNotFound)
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/tor"
 )
 
 var (
@@ -33,12 +35,29 @@ var (
 	// ErrNoUniverseProofFound is returned when a user attempts to look up
 	// a key in the universe that actually points to the empty leaf.
 	ErrNoUniverseProofFound = fmt.Errorf("no universe proof found")
+
+	// ErrNoCommitment is returned when no matching on chain universe
+	// commitment is found.
+	ErrNoCommitment = fmt.Errorf("no universe commitment found")
+
+	// ErrTreeDiffUnsupported is returned when a universe doesn't support
+	// comparing universe trees top-down.
+	ErrTreeDiffUnsupported = fmt.Errorf("universe tree diff not supported")
+
+	// ErrTreeDiffRootChanged is returned when a universe tree changes
+	// while a tree diff session walks it.
+	ErrTreeDiffRootChanged = fmt.Errorf("universe root changed during " +
+		"tree diff")
 )
 
 const (
 	// MaxPageSize is the maximum page size that can be used when querying
 	// for asset roots and leaves.
 	MaxPageSize = 512
+
+	// MaxTreeDiffProofs is the maximum number of leaves that can be
+	// fetched with a single multi-proof during a tree diff.
+	MaxTreeDiffProofs = 64
 )
 
 // Identifier is the identifier for a universe.
@@ -310,6 +329,46 @@ func (i *Proof) VerifyRoot(expectedRoot mssmt.Node) bool {
 		mssmt.IsEqualNode(reconstructedRoot, expectedRoot)
 }
 
+// MultiProof associates a set of universe leaves (and keys) with a single
+// inclusion proof for all of them in the universe tree.
+type MultiProof struct {
+	// LeafKeys are the universe leaf keys of the proven leaves.
+	LeafKeys []LeafKey
+
+	// Leaves are the leaf nodes for the assets within the universe tree,
+	// in the same order as the leaf keys.
+	Leaves []*Leaf
+
+	// UniverseRoot is the root of the universe that the assets are located
+	// within.
+	UniverseRoot mssmt.Node
+
+	// UniverseInclusionProof is the universe inclusion proof for all the
+	// assets within the universe tree.
+	UniverseInclusionProof *mssmt.MultiProof
+}
+
+// VerifyRoot verifies that the inclusion proof for the root node matches the
+// specified root. This is useful for sanity checking a multi-proof against the
+// purported root, and the included leaves.
+func (m *MultiProof) VerifyRoot(expectedRoot mssmt.Node) bool {
+	if len(m.LeafKeys) != len(m.Leaves) {
+		return false
+	}
+
+	keys := fn.Map(m.LeafKeys, func(k LeafKey) [32]byte {
+		return k.UniverseKey()
+	})
+	leafNodes := fn.Map(m.Leaves, func(l *Leaf) *mssmt.LeafNode {
+		return l.SmtLeafNode()
+	})
+
+	return mssmt.IsEqualNode(m.UniverseRoot, expectedRoot) &&
+		mssmt.VerifyMultiProof(
+			keys, leafNodes, m.UniverseInclusionProof, expectedRoot,
+		)
+}
+
 // BaseBackend is the backend storage interface for a base universe. The
 // backend can be used to store issuance profs, retrieve them, and also fetch
 // the set of keys and leaves stored within the universe.
@@ -438,6 +497,17 @@ type MultiverseArchive interface {
 	// proof type.
 	MultiverseRootNode(ctx context.Context,
 		proofType ProofType) (fn.Option[MultiverseRoot], error)
+
+	// UniverseDiffChildren returns the left and right children of the
+	// branch nodes with the given hashes, which are all located at the
+	// given height of the universe tree.
+	UniverseDiffChildren(ctx context.Context, id Identifier, height int,
+		nodes []mssmt.NodeHash) ([][2]mssmt.DiffNode, error)
+
+	// UniverseLeafKeysByNodeKey returns the universe leaf keys of the
+	// leaves stored at the given MS-SMT keys of the universe tree.
+	UniverseLeafKeysByNodeKey(ctx context.Context, id Identifier,
+		nodeKeys [][32]byte) ([]LeafKey, error)
 }
 
 // Registrar is an interface that allows a caller to upsert a proof leaf in a
@@ -490,16 +560,16 @@ const (
 	DefaultUniverseRPCPort = 10029
 )
 
-// resolveUniverseAddr maps an RPC universe host (of the form 'host' or
-// 'host:port') into a net.Addr.
-func resolverUniverseAddr(uniAddr string) (net.Addr, error) {
+// splitUniverseAddr splits an RPC universe host (of the form 'host' or
+// 'host:port') into its host and port components, without resolving the host.
+func splitUniverseAddr(uniAddr string) (string, int, error) {
 	var (
 		host string
 		port int
 	)
 
 	if len(uniAddr) == 0 {
-		return nil, fmt.Errorf("universe host cannot be empty")
+		return "", 0, fmt.Errorf("universe host cannot be empty")
 	}
 
 	// Split the address into its host and port components.
@@ -514,12 +584,29 @@ func resolverUniverseAddr(uniAddr string) (net.Addr, error) {
 		host = h
 		portNum, err := strconv.Atoi(p)
 		if err != nil {
-			return nil, err
+			return "", 0, err
 		}
 		port = portNum
 	}
 
-	// TODO(roasbeef): add tor support
+	return host, port, nil
+}
+
+// resolveUniverseAddr maps an RPC universe host (of the form 'host' or
+// 'host:port') into a net.Addr. Onion service hosts aren't resolved, as they
+// can only be reached through a Tor proxy.
+func resolverUniverseAddr(uniAddr string) (net.Addr, error) {
+	host, port, err := splitUniverseAddr(uniAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if tor.IsOnionHost(host) {
+		return &tor.OnionAddr{
+			OnionService: host,
+			Port:         port,
+		}, nil
+	}
 
 	hostPort := net.JoinHostPort(host, strconv.Itoa(port))
 	return net.ResolveTCPAddr("tcp", hostPort)
@@ -578,6 +665,45 @@ func (s *ServerAddr) HostStr() string {
 	return s.addrStr
 }
 
+// HostPort returns the host and port of the remote universe server in the
+// form 'host:port', without resolving the host. This is used to connect to the
+// server through a proxy, which then resolves the host itself.
+func (s *ServerAddr) HostPort() (string, error) {
+	host, port, err := splitUniverseAddr(s.addrStr)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(port)), nil
+}
+
+// IsSameServer returns true if the given address points to the same remote
+// universe server. An address without a port refers to the default universe RPC
+// port.
+func (s *ServerAddr) IsSameServer(other ServerAddr) bool {
+	hostPort, err := s.HostPort()
+	if err != nil {
+		return s.addrStr == other.addrStr
+	}
+
+	otherHostPort, err := other.HostPort()
+	if err != nil {
+		return false
+	}
+
+	return hostPort == otherHostPort
+}
+
+// IsOnion returns true if the remote universe server is a Tor onion service.
+func (s *ServerAddr) IsOnion() bool {
+	host, _, err := splitUniverseAddr(s.addrStr)
+	if err != nil {
+		return false
+	}
+
+	return tor.IsOnionHost(host)
+}
+
 // SyncType is an enum that describes the type of sync that should be performed
 // between a local and remote universe.
 type SyncType uint8
@@ -630,6 +756,13 @@ type Syncer interface {
 	SyncUniverse(ctx context.Context, host ServerAddr,
 		syncType SyncType, syncConfigs SyncConfigs,
 		idsToSync ...Identifier) ([]AssetSyncDiff, error)
+
+	// FetchRoots returns the roots of the remote universes that would be
+	// synced with the given sync type, sync configs and set of universe
+	// IDs to sync, without syncing them.
+	FetchRoots(ctx context.Context, host ServerAddr, syncType SyncType,
+		syncConfigs SyncConfigs, idsToSync ...Identifier) ([]Root,
+		error)
 }
 
 // DiffEngine is a Universe diff engine that can be used to compare the state
@@ -658,6 +791,44 @@ type DiffEngine interface {
 	Close() error
 }
 
+// TreeDiffSession is used to walk the tree of a single universe top-down, in
+// order to only fetch the subtrees and leaves that differ from another tree.
+type TreeDiffSession interface {
+	// Root returns the root of the universe tree at the time the session
+	// was opened. All nodes and proofs of the session are against this
+	// root. ErrTreeDiffRootChanged is returned by the other methods if the
+	// tree changed since.
+	Root() Root
+
+	// DiffChildren returns the left and right children of the branch
+	// nodes with the given hashes, which are all located at the given
+	// height of the universe tree.
+	DiffChildren(ctx context.Context, height int,
+		nodes []mssmt.NodeHash) ([][2]mssmt.DiffNode, error)
+
+	// FetchMultiProof returns the leaves stored at the given MS-SMT keys
+	// of the universe tree, along with a single inclusion proof for all of
+	// them. At most MaxTreeDiffProofs keys can be fetched at once.
+	FetchMultiProof(ctx context.Context,
+		nodeKeys [][32]byte) (*MultiProof, error)
+
+	// Close ends the session.
+	Close() error
+}
+
+// TreeDiffEngine is a diff engine that is also able to compare universe trees
+// top-down, exchanging only the subtrees that differ instead of the full set
+// of leaf keys.
+type TreeDiffEngine interface {
+	DiffEngine
+
+	// NewTreeDiffSession opens a new tree diff session for the universe
+	// with the given ID. ErrTreeDiffUnsupported is returned if the
+	// universe doesn't support tree diffs.
+	NewTreeDiffSession(ctx context.Context,
+		id Identifier) (TreeDiffSession, error)
+}
+
 // Commitment is an on chain universe commitment. This includes the merkle
 // proof for a transaction which anchors the target universe root.
 type Commitment struct {
@@ -672,7 +843,36 @@ type Commitment struct {
 	// anchor output was included.
 	MerkleProof *proof.TxMerkleProof
 
-	// UniverseRoot is the full Universe root for this commitment.
+	// AnchorTx is the transaction that carries the commitment output.
+	AnchorTx *wire.MsgTx
+
+	// OutputIndex is the index of the commitment output within the anchor
+	// transaction.
+	OutputIndex uint32
+
+	// ChainFees is the amount in sats paid in on-chain fees for the
+	// anchor transaction.
+	ChainFees int64
+
+	// HeightHint is the block height at which the anchor transaction was
+	// created. This is only used to watch for the confirmation of a
+	// pending commitment.
+	HeightHint uint32
+
+	// InternalKey is the internal key of the commitment output. The
+	// output key is derived by tweaking this key with the universe root.
+	InternalKey keychain.KeyDescriptor
+
+	// IssuanceRoot is the root of the issuance multiverse at the time of
+	// the commitment.
+	IssuanceRoot mssmt.Node
+
+	// TransferRoot is the root of the transfer multiverse at the time of
+	// the commitment.
+	TransferRoot mssmt.Node
+
+	// UniverseRoot is the full Universe root for this commitment. This is
+	// a branch over the issuance and transfer multiverse roots.
 	UniverseRoot mssmt.Node
 }
 
@@ -681,7 +881,8 @@ type Commitment struct {
 // their responses re actually committed to within the chain.
 type CommittedIssuanceProof struct {
 	// ChainProof is the on chain proof that shows the Universe root has
-	// been stamped in the chain.
+	// been stamped in the chain. This is nil if the multiverse root the
+	// proof was generated against hasn't been committed to yet.
 	ChainProof *Commitment
 
 	// TaprootAssetProof is a proof of new asset issuance.
@@ -690,9 +891,67 @@ type CommittedIssuanceProof struct {
 
 // ChainCommitter is used to commit a Universe backend in the chain.
 type ChainCommitter interface {
-	// CommitUniverse takes a Universe and returns a new commitment to that
-	// Universe in the main chain.
-	CommitUniverse(universe BaseBackend) (*Commitment, error)
+	// NewCommitment creates a new fully signed, but unpublished commitment
+	// to the given issuance and transfer multiverse roots. The wallet
+	// inputs spent by the anchor transaction stay leased until the
+	// commitment is either confirmed or abandoned.
+	NewCommitment(ctx context.Context, issuanceRoot,
+		transferRoot mssmt.Node) (*Commitment, error)
+
+	// PublishCommitment publishes the anchor transaction of the given
+	// pending commitment.
+	PublishCommitment(ctx context.Context, commitment *Commitment) error
+
+	// ConfirmCommitment blocks until the anchor transaction of the given
+	// pending commitment has confirmed, and returns the commitment with
+	// its block and merkle proof populated.
+	ConfirmCommitment(ctx context.Context,
+		commitment *Commitment) (*Commitment, error)
+
+	// AbandonCommitment releases the wallet inputs that were leased for
+	// the anchor transaction of the given pending commitment.
+	AbandonCommitment(ctx context.Context, commitment *Commitment) error
+}
+
+// CommitmentArchive is used to persist and query on chain universe
+// commitments.
+type CommitmentArchive interface {
+	// InsertPendingCommitment stores a new commitment that hasn't
+	// confirmed yet, along with the multiverse leaves of the committed
+	// issuance and transfer multiverse trees.
+	InsertPendingCommitment(ctx context.Context, commitment *Commitment,
+		leaves []MultiverseLeaf) error
+
+	// PendingCommitment returns the pending universe commitment. If there
+	// is no pending commitment, then ErrNoCommitment is returned.
+	PendingCommitment(ctx context.Context) (*Commitment, error)
+
+	// DeletePendingCommitment removes the pending universe commitment
+	// along with its multiverse leaves.
+	DeletePendingCommitment(ctx context.Context) error
+
+	// InsertCommitment stores a new confirmed universe commitment. The
+	// multiverse leaves of the pending commitment are moved over to the
+	// confirmed one, and the pending commitment is removed.
+	InsertCommitment(ctx context.Context, commitment *Commitment) error
+
+	// LatestCommitment returns the most recent universe commitment. If no
+	// commitment exists, then ErrNoCommitment is returned.
+	LatestCommitment(ctx context.Context) (*Commitment, error)
+
+	// FetchCommitmentByRoot returns the earliest universe commitment that
+	// committed to the given multiverse root, either as its issuance or
+	// transfer root. If no such commitment exists, then ErrNoCommitment
+	// is returned.
+	FetchCommitmentByRoot(ctx context.Context,
+		multiverseRoot mssmt.Node) (*Commitment, error)
+
+	// FetchCommitmentByLeaf returns the latest universe commitment that
+	// includes the given multiverse leaf, along with all leaves of the
+	// committed multiverse tree of the leaf's proof type. If no such
+	// commitment exists, then ErrNoCommitment is returned.
+	FetchCommitmentByLeaf(ctx context.Context,
+		leaf MultiverseLeaf) (*Commitment, []MultiverseLeaf, error)
 }
 
 // Canonical is an interface that allows a caller to query for the latest
@@ -700,17 +959,22 @@ type ChainCommitter interface {
 //
 // TODO(roasbeef): sync methods too, divide into read/write?
 type Canonical interface {
-	BaseBackend
+	// Query returns a fully proved response for the target leaf key.
+	Query(context.Context, Identifier,
+		LeafKey) ([]*CommittedIssuanceProof, error)
 
-	// Query returns a fully proved response for the target base key.
-	Query(context.Context, LeafKey) (*CommittedIssuanceProof, error)
+	// CommitProof couples the given universe proof with the earliest chain
+	// commitment of the multiverse root the proof was generated against.
+	// If that root was never committed to, the latest commitment that
+	// still includes the universe root of the proof is used instead.
+	CommitProof(context.Context, *Proof) (*CommittedIssuanceProof, error)
 
 	// LatestCommitment returns the latest chain commitment.
-	LatestCommitment() (*Commitment, error)
+	LatestCommitment(context.Context) (*Commitment, error)
 
-	// UpdateChainCommitment takes in a series of chain commitments and
-	// updates the commitment on chain.
-	UpdateChainCommitment(chainCommits ...ChainCommitter) (*Commitment, error)
+	// UpdateChainCommitment commits the current multiverse roots on chain,
+	// unless they're unchanged since the latest commitment.
+	UpdateChainCommitment(context.Context) (*Commitment, error)
 }
 
 // FederationLog is used to keep track of the set Universe servers that
@@ -802,6 +1066,17 @@ type FedGlobalSyncConfig struct {
 	// universes of the given proof type have may be exported via federation
 	// sync.
 	AllowSyncExport bool
+
+	// SyncQuorum is the combined trust weight of the federation servers
+	// that need to report the same root for a universe of the given proof
+	// type before that root is synced. If zero, the root of any single
+	// server is synced.
+	//
+	// NOTE: Servers only agree on a root if they report the exact same
+	// tree. A universe that is still growing is only synced once enough
+	// servers caught up with each other, servers that are behind or ahead
+	// don't count towards the quorum of any root in the meantime.
+	SyncQuorum uint32
 }
 
 // FedUniSyncConfig is a config that can be used to specify the federation sync
@@ -819,6 +1094,32 @@ type FedUniSyncConfig struct {
 	AllowSyncExport bool
 }
 
+// DefaultServerTrustWeight is the trust weight of federation servers that
+// don't have a server specific sync config.
+const DefaultServerTrustWeight = 1
+
+// FedServerSyncConfig is a config that can be used to specify the federation
+// sync behavior for a given Universe server.
+type FedServerSyncConfig struct {
+	// ServerAddr is the address of the server that the config applies to.
+	ServerAddr ServerAddr
+
+	// AllowSyncInsert is a boolean that indicates whether leaves may be
+	// inserted from the target server via federation sync. If false, the
+	// server is write-only and we never sync from it.
+	AllowSyncInsert bool
+
+	// AllowSyncExport is a boolean that indicates whether leaves may be
+	// exported to the target server via federation sync. If false, the
+	// server is read-only and we never push proofs to it.
+	AllowSyncExport bool
+
+	// TrustWeight is the weight the roots reported by the target server
+	// carry when determining whether the sync quorum for a universe root
+	// is reached.
+	TrustWeight uint32
+}
+
 // FederationSyncConfigDB is used to manage the set of Universe servers as part
 // of a federation.
 type FederationSyncConfigDB interface {
@@ -832,6 +1133,16 @@ type FederationSyncConfigDB interface {
 	UpsertFederationSyncConfig(
 		ctx context.Context, globalSyncConfigs []*FedGlobalSyncConfig,
 		uniSyncConfigs []*FedUniSyncConfig) error
+
+	// QueryFederationServerSyncConfigs returns the server specific
+	// federation sync configs.
+	QueryFederationServerSyncConfigs(
+		ctx context.Context) ([]*FedServerSyncConfig, error)
+
+	// UpsertFederationServerSyncConfigs upserts server specific
+	// federation sync configs.
+	UpsertFederationServerSyncConfigs(ctx context.Context,
+		serverSyncConfigs []*FedServerSyncConfig) error
 }
 
 // SyncDirection is the direction of a proof sync.
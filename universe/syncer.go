@@ -80,6 +80,54 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 		s.isSyncing.Store(false)
 	}()
 
+	targetRoots, err := s.fetchTargetRoots(
+		ctx, diffEngine, syncType, syncConfigs, idsToSync,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Obtained %v roots from remote Universe server",
+		len(targetRoots))
+
+	// If the federation requires a quorum of servers to agree on a root
+	// before it is synced, we skip all roots that didn't reach it.
+	targetRoots = fn.Filter(targetRoots, func(r Root) bool {
+		if syncConfigs.IsRootAgreed(r) {
+			return true
+		}
+
+		log.Infof("UniverseRoot(%v) didn't reach the sync quorum, "+
+			"skipping", r.ID.String())
+
+		return false
+	})
+
+	// Now that we know the set of Universes we need to sync, we'll execute
+	// the diff operation for each of them.
+	syncDiffs := make(chan AssetSyncDiff, len(targetRoots))
+	err = fn.ParSlice(
+		ctx, targetRoots, func(ctx context.Context, r Root) error {
+			return s.syncRoot(
+				ctx, r, diffEngine, syncConfigs, syncDiffs,
+			)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Finally, we'll collect all the diffs and return them to the caller.
+	return fn.Collect(syncDiffs), nil
+}
+
+// fetchTargetRoots fetches the roots of the remote universes that should be
+// synced, governed by the sync type, the sync configs and the set of universe
+// IDs to sync.
+func (s *SimpleSyncer) fetchTargetRoots(ctx context.Context,
+	diffEngine DiffEngine, syncType SyncType, syncConfigs SyncConfigs,
+	idsToSync []Identifier) ([]Root, error) {
+
 	// Examine config to ascertain whether global insertion for either proof
 	// type is allowed.
 	globalInsertEnabled := fn.Any(
@@ -161,23 +209,7 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 		}
 	}
 
-	log.Infof("Obtained %v roots from remote Universe server",
-		len(targetRoots))
-
-	// Now that we know the set of Universes we need to sync, we'll execute
-	// the diff operation for each of them.
-	syncDiffs := make(chan AssetSyncDiff, len(targetRoots))
-	err = fn.ParSlice(
-		ctx, targetRoots, func(ctx context.Context, r Root) error {
-			return s.syncRoot(ctx, r, diffEngine, syncDiffs)
-		},
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	// Finally, we'll collect all the diffs and return them to the caller.
-	return fn.Collect(syncDiffs), nil
+	return targetRoots, nil
 }
 
 // fetchRootsForIDs fetches the roots for a specific set of universe IDs.
@@ -214,7 +246,8 @@ func fetchRootsForIDs(ctx context.Context, idsToSync []Identifier,
 // syncRoot attempts to sync the local Universe with the remote diff engine for
 // a specific base root.
 func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
-	diffEngine DiffEngine, result chan<- AssetSyncDiff) error {
+	diffEngine DiffEngine, syncConfigs SyncConfigs,
+	result chan<- AssetSyncDiff) error {
 
 	// First, we'll compare the remote root against the local root.
 	uniID := remoteRoot.ID
@@ -241,26 +274,89 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 		uniID.String())
 
 	// Otherwise, we'll need to perform a diff operation to find the set of
-	// keys we need to fetch. We'll start by fetching the set of keys from
-	// both the local and remote Universe.
+	// keys we need to fetch. If both universes support it, we'll only walk
+	// the subtrees that differ and fetch their leaves in bulk.
 	var (
-		remoteUniKeys []LeafKey
-		localUniKeys  []LeafKey
+		keysToFetch []LeafKey
+		fetchLeaf   func(context.Context, LeafKey) (*Leaf, error)
+	)
+	treeDiffRoot, treeDiffProofs, err := s.fetchTreeDiff(
+		ctx, uniID, diffEngine,
 	)
+	switch {
+	// If the tree diff isn't supported or failed, for example because one
+	// of the trees changed while it was walked, we fall back to fetching
+	// the set of keys from both the local and remote Universe, then fetch
+	// the missing leaves one by one.
+	case err != nil:
+		if errors.Is(err, ErrTreeDiffUnsupported) {
+			log.Debugf("UniverseRoot(%v): tree diff not "+
+				"supported, comparing leaf keys",
+				uniID.String())
+		} else {
+			log.Warnf("UniverseRoot(%v): tree diff failed, "+
+				"comparing leaf keys: %v", uniID.String(), err)
+		}
 
-	remoteUniKeys, err = s.fetchAllLeafKeys(ctx, diffEngine, uniID)
-	if err != nil {
-		return err
-	}
+		keysToFetch, err = s.diffLeafKeys(ctx, diffEngine, uniID)
+		if err != nil {
+			return err
+		}
 
-	localUniKeys, err = s.fetchAllLeafKeys(ctx, s.cfg.LocalDiffEngine, uniID)
-	if err != nil {
-		return err
-	}
+		fetchLeaf = func(ctx context.Context, key LeafKey) (*Leaf,
+			error) {
 
-	// With the set of keys fetched, we can now find the set of keys that
-	// need to be synced.
-	keysToFetch := fn.SetDiff(remoteUniKeys, localUniKeys)
+			newProof, err := diffEngine.FetchProofLeaf(
+				ctx, uniID, key,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			leafProof := newProof[0]
+
+			// Now that we have this leaf proof, we want to ensure
+			// that it's actually part of the remote root we were
+			// given.
+			validRoot := leafProof.VerifyRoot(remoteRoot)
+			if !validRoot {
+				return nil, fmt.Errorf("proof for key=%v is "+
+					"invalid", spew.Sdump(key))
+			}
+
+			return leafProof.Leaf, nil
+		}
+
+	// The leaves of the tree diff were already fetched and verified
+	// against the remote root of the diff session, which might be more
+	// recent than the one we were given.
+	default:
+		// If the remote root moved on since the sync quorum was
+		// reached, the new root wasn't agreed on yet.
+		// We skip this universe until the next sync, the other
+		// universes are still synced.
+		if !syncConfigs.IsRootAgreed(treeDiffRoot) {
+			log.Warnf("UniverseRoot(%v) changed after the sync "+
+				"quorum was reached, skipping", uniID.String())
+
+			return nil
+		}
+
+		remoteRoot = treeDiffRoot
+
+		diffLeaves := make(map[[32]byte]*Leaf, len(treeDiffProofs))
+		for _, leafProof := range treeDiffProofs {
+			keysToFetch = append(keysToFetch, leafProof.LeafKey)
+			diffLeaves[leafProof.LeafKey.UniverseKey()] =
+				leafProof.Leaf
+		}
+
+		fetchLeaf = func(_ context.Context, key LeafKey) (*Leaf,
+			error) {
+
+			return diffLeaves[key.UniverseKey()], nil
+		}
+	}
 
 	log.Infof("UniverseRoot(%v): diff_size=%v", uniID.String(),
 		len(keysToFetch))
@@ -296,24 +392,11 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 	// proofs from the remote party.
 	err = fn.ParSlice(
 		ctx, keysToFetch, func(ctx context.Context, key LeafKey) error {
-			newProof, err := diffEngine.FetchProofLeaf(
-				ctx, uniID, key,
-			)
+			leaf, err := fetchLeaf(ctx, key)
 			if err != nil {
 				return err
 			}
 
-			leafProof := newProof[0]
-
-			// Now that we have this leaf proof, we want to ensure
-			// that it's actually part of the remote root we were
-			// given.
-			validRoot := leafProof.VerifyRoot(remoteRoot)
-			if !validRoot {
-				return fmt.Errorf("proof for key=%v is "+
-					"invalid", spew.Sdump(key))
-			}
-
 			// If this is an issuance proof, then we can send
 			// things directly to the batch insertion goroutine.
 			// Otherwise, we'll another step to the pipeline below
@@ -322,13 +405,13 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 				fetchedLeaves <- &Item{
 					ID:   uniID,
 					Key:  key,
-					Leaf: leafProof.Leaf,
+					Leaf: leaf,
 				}
 			} else {
 				transferLeafProofs <- &Item{
 					ID:   uniID,
 					Key:  key,
-					Leaf: leafProof.Leaf,
+					Leaf: leaf,
 				}
 			}
 
@@ -395,6 +478,134 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 	return nil
 }
 
+// diffLeafKeys fetches the full set of leaf keys of the given universe from
+// both the local and remote Universe and returns the keys that are missing
+// locally.
+func (s *SimpleSyncer) diffLeafKeys(ctx context.Context,
+	diffEngine DiffEngine, uniID Identifier) ([]LeafKey, error) {
+
+	remoteUniKeys, err := s.fetchAllLeafKeys(ctx, diffEngine, uniID)
+	if err != nil {
+		return nil, err
+	}
+
+	localUniKeys, err := s.fetchAllLeafKeys(
+		ctx, s.cfg.LocalDiffEngine, uniID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// With the set of keys fetched, we can now find the set of keys that
+	// need to be synced.
+	return fn.SetDiff(remoteUniKeys, localUniKeys), nil
+}
+
+// fetchTreeDiff walks the local and remote tree of the given universe
+// top-down and fetches the leaves of all remote subtrees that differ from the
+// local tree, verified against the remote root of the diff session. The
+// returned leaves might include some leaves we already have locally.
+// ErrTreeDiffUnsupported is returned if either the local or the remote
+// Universe doesn't support tree diffs.
+func (s *SimpleSyncer) fetchTreeDiff(ctx context.Context, uniID Identifier,
+	diffEngine DiffEngine) (Root, []*Proof, error) {
+
+	remoteEngine, ok := diffEngine.(TreeDiffEngine)
+	if !ok {
+		return Root{}, nil, ErrTreeDiffUnsupported
+	}
+	localEngine, ok := s.cfg.LocalDiffEngine.(TreeDiffEngine)
+	if !ok {
+		return Root{}, nil, ErrTreeDiffUnsupported
+	}
+
+	remoteSession, err := remoteEngine.NewTreeDiffSession(ctx, uniID)
+	if err != nil {
+		return Root{}, nil, err
+	}
+	defer remoteSession.Close()
+
+	remoteRoot := remoteSession.Root()
+
+	// If we don't have this universe yet, there's no local tree to walk,
+	// so all remote leaves will be fetched.
+	var (
+		localRoot  mssmt.Node
+		fetchLocal mssmt.DiffChildrenFetcher
+	)
+	localSession, err := localEngine.NewTreeDiffSession(ctx, uniID)
+	switch {
+	case errors.Is(err, ErrNoUniverseRoot):
+		fetchLocal = func(context.Context, int,
+			[]mssmt.NodeHash) ([][2]mssmt.DiffNode, error) {
+
+			return nil, ErrNoUniverseRoot
+		}
+
+	case err != nil:
+		return Root{}, nil, err
+
+	default:
+		defer localSession.Close()
+
+		localRoot = localSession.Root().Node
+		fetchLocal = localSession.DiffChildren
+	}
+
+	nodeKeys, err := mssmt.DiffLeafKeys(
+		ctx, localRoot, remoteRoot.Node, fetchLocal,
+		remoteSession.DiffChildren,
+	)
+	if err != nil {
+		return Root{}, nil, err
+	}
+
+	log.Debugf("UniverseRoot(%v): tree diff found %d differing leaves",
+		uniID.String(), len(nodeKeys))
+
+	// With the differing leaves found, we fetch them in batches, each
+	// with a single proof for the whole batch.
+	leafProofs := make([]*Proof, 0, len(nodeKeys))
+	for start := 0; start < len(nodeKeys); start += MaxTreeDiffProofs {
+		end := min(start+MaxTreeDiffProofs, len(nodeKeys))
+		batchKeys := nodeKeys[start:end]
+
+		multiProof, err := remoteSession.FetchMultiProof(ctx, batchKeys)
+		if err != nil {
+			return Root{}, nil, fmt.Errorf("unable to fetch "+
+				"leaves: %w", err)
+		}
+
+		// We make sure we got exactly the leaves we asked for, and
+		// that they're actually part of the remote root.
+		if len(multiProof.LeafKeys) != len(batchKeys) {
+			return Root{}, nil, fmt.Errorf("expected %d leaves, "+
+				"got %d", len(batchKeys),
+				len(multiProof.LeafKeys))
+		}
+		for idx, key := range multiProof.LeafKeys {
+			if key.UniverseKey() != batchKeys[idx] {
+				return Root{}, nil, fmt.Errorf("unexpected "+
+					"leaf key %x", key.UniverseKey())
+			}
+		}
+		if !multiProof.VerifyRoot(remoteRoot) {
+			return Root{}, nil, fmt.Errorf("multi-proof for %d "+
+				"leaves is invalid", len(batchKeys))
+		}
+
+		for idx, key := range multiProof.LeafKeys {
+			leafProofs = append(leafProofs, &Proof{
+				Leaf:         multiProof.Leaves[idx],
+				LeafKey:      key,
+				UniverseRoot: remoteRoot,
+			})
+		}
+	}
+
+	return remoteRoot, leafProofs, nil
+}
+
 // batchStreamNewItems streams the set of new items to the local registrar in
 // batches and returns the new leaf proofs.
 func (s *SimpleSyncer) batchStreamNewItems(ctx context.Context,
@@ -468,6 +679,25 @@ func (s *SimpleSyncer) SyncUniverse(ctx context.Context, host ServerAddr,
 	return s.executeSync(ctx, diffEngine, syncType, syncConfigs, idsToSync)
 }
 
+// FetchRoots returns the roots of the remote universes that would be synced
+// with the given sync type, sync configs and set of universe IDs to sync,
+// without syncing them.
+func (s *SimpleSyncer) FetchRoots(ctx context.Context, host ServerAddr,
+	syncType SyncType, syncConfigs SyncConfigs,
+	idsToSync ...Identifier) ([]Root, error) {
+
+	diffEngine, err := s.cfg.NewRemoteDiffEngine(host)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create remote diff "+
+			"engine: %w", err)
+	}
+	defer diffEngine.Close()
+
+	return s.fetchTargetRoots(
+		ctx, diffEngine, syncType, syncConfigs, idsToSync,
+	)
+}
+
 // fetchAllRoots fetches all the roots from the remote Universe. This function
 // is used in order to isolate any logic related to the specifics of how we
 // fetch the data from the universe server.
@@ -107,3 +107,53 @@ func TestValidateProofUniverseType(t *testing.T) {
 		})
 	}
 }
+
+// TestServerAddrOnion tests that onion service addresses are recognized and
+// not resolved, so they can be dialed through a Tor proxy.
+func TestServerAddrOnion(t *testing.T) {
+	t.Parallel()
+
+	const onionHost = "3g2upl4pq6kufc4m3g2upl4pq6kufc4m3g2upl4pq6kufc4m" +
+		"3g2upl4p.onion"
+
+	testCases := []struct {
+		name         string
+		addr         string
+		isOnion      bool
+		expectedHost string
+	}{{
+		name:         "onion without port",
+		addr:         onionHost,
+		isOnion:      true,
+		expectedHost: onionHost + ":10029",
+	}, {
+		name:         "onion with port",
+		addr:         onionHost + ":8443",
+		isOnion:      true,
+		expectedHost: onionHost + ":8443",
+	}, {
+		name:         "clearnet host",
+		addr:         "universe.example.com",
+		expectedHost: "universe.example.com:10029",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			addr := NewServerAddrFromStr(tc.addr)
+			require.Equal(t, tc.isOnion, addr.IsOnion())
+
+			hostPort, err := addr.HostPort()
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedHost, hostPort)
+
+			if !tc.isOnion {
+				return
+			}
+
+			// Onion addresses are never resolved locally.
+			netAddr, err := addr.Addr()
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedHost, netAddr.String())
+		})
+	}
+}
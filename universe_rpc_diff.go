@@ -3,13 +3,19 @@ package taprootassets
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"fmt"
+	"sync"
 
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/tor"
 	"golang.org/x/exp/maps"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // RpcUniverseDiff is an implementation of the universe.DiffEngine interface
@@ -19,11 +25,12 @@ type RpcUniverseDiff struct {
 }
 
 // NewRpcUniverseDiff creates a new RpcUniverseDiff instance that dials out to
-// the target remote universe server address.
-func NewRpcUniverseDiff(
-	serverAddr universe.ServerAddr) (universe.DiffEngine, error) {
+// the target remote universe server address. If a proxy is given, the
+// connection is made through it.
+func NewRpcUniverseDiff(serverAddr universe.ServerAddr,
+	proxy *tor.ProxyNet) (universe.DiffEngine, error) {
 
-	conn, err := ConnectUniverse(serverAddr)
+	conn, err := ConnectUniverse(serverAddr, proxy)
 	if err != nil {
 		return nil, fmt.Errorf("unable to connect to universe RPC "+
 			"server: %w", err)
@@ -210,6 +217,271 @@ func (r *RpcUniverseDiff) FetchProofLeaf(ctx context.Context,
 	return []*universe.Proof{uniProof}, nil
 }
 
+// NewTreeDiffSession opens a new tree diff stream to the remote universe
+// server for the universe with the given ID. If the remote server doesn't
+// support tree diffs, universe.ErrTreeDiffUnsupported is returned.
+func (r *RpcUniverseDiff) NewTreeDiffSession(ctx context.Context,
+	id universe.Identifier) (universe.TreeDiffSession, error) {
+
+	uniID, err := MarshalUniID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// The stream lives as long as the session, so we give it its own
+	// context that is canceled once the session is closed.
+	streamCtx, cancel := context.WithCancel(ctx)
+	stream, err := r.conn.SyncDiff(streamCtx)
+	if err != nil {
+		cancel()
+		return nil, rpcTreeDiffErr(err)
+	}
+
+	session := &rpcTreeDiffSession{
+		stream: stream,
+		cancel: cancel,
+	}
+
+	resp, err := session.roundTrip(&unirpc.SyncDiffRequest{
+		Request: &unirpc.SyncDiffRequest_Start{
+			Start: &unirpc.SyncDiffStart{
+				Id: uniID,
+			},
+		},
+	})
+	if err != nil {
+		session.Close()
+		return nil, err
+	}
+
+	rpcRoot := resp.GetRoot()
+	if rpcRoot == nil || rpcRoot.MssmtRoot == nil {
+		session.Close()
+		return nil, fmt.Errorf("expected universe root, got %T",
+			resp.Response)
+	}
+
+	session.root = universe.Root{
+		ID:   id,
+		Node: unmarshalMerkleSumNode(rpcRoot.MssmtRoot),
+	}
+
+	return session, nil
+}
+
+// rpcTreeDiffErr maps the error of a tree diff stream to
+// universe.ErrTreeDiffUnsupported if the remote server doesn't know the RPC
+// yet.
+func rpcTreeDiffErr(err error) error {
+	if status.Code(err) == codes.Unimplemented {
+		return fmt.Errorf("%w: %v", universe.ErrTreeDiffUnsupported,
+			err)
+	}
+
+	return err
+}
+
+// rpcTreeDiffSession is a tree diff session that walks the tree of a remote
+// universe over a single bidirectional stream. Each request is answered with
+// exactly one response, so the requests of the session are serialized.
+type rpcTreeDiffSession struct {
+	root universe.Root
+
+	stream unirpc.Universe_SyncDiffClient
+	cancel context.CancelFunc
+
+	mu sync.Mutex
+}
+
+// A compile time interface to ensure that rpcTreeDiffSession implements the
+// universe.TreeDiffSession interface.
+var _ universe.TreeDiffSession = (*rpcTreeDiffSession)(nil)
+
+// roundTrip sends the given request to the remote server and waits for its
+// response.
+func (s *rpcTreeDiffSession) roundTrip(
+	req *unirpc.SyncDiffRequest) (*unirpc.SyncDiffResponse, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.stream.Send(req); err != nil {
+		return nil, rpcTreeDiffErr(err)
+	}
+
+	resp, err := s.stream.Recv()
+	if err != nil {
+		return nil, rpcTreeDiffErr(err)
+	}
+
+	return resp, nil
+}
+
+// Root returns the root of the remote universe tree at the time the session
+// was opened.
+//
+// NOTE: This is part of the universe.TreeDiffSession interface.
+func (s *rpcTreeDiffSession) Root() universe.Root {
+	return s.root
+}
+
+// DiffChildren returns the left and right children of the branch nodes with
+// the given hashes, which are all located at the given height of the remote
+// universe tree.
+//
+// NOTE: The passed context is ignored, the requests are bound to the context
+// the session was opened with.
+//
+// NOTE: This is part of the universe.TreeDiffSession interface.
+func (s *rpcTreeDiffSession) DiffChildren(_ context.Context, height int,
+	nodes []mssmt.NodeHash) ([][2]mssmt.DiffNode, error) {
+
+	resp, err := s.roundTrip(&unirpc.SyncDiffRequest{
+		Request: &unirpc.SyncDiffRequest_Nodes{
+			Nodes: &unirpc.SyncDiffNodesRequest{
+				Height: uint32(height),
+				NodeHashes: fn.Map(
+					nodes, fn.ByteSlice[mssmt.NodeHash],
+				),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rpcNodes := resp.GetNodes()
+	if rpcNodes == nil {
+		return nil, fmt.Errorf("expected diff nodes, got %T",
+			resp.Response)
+	}
+
+	children := make([][2]mssmt.DiffNode, len(rpcNodes.Children))
+	for idx, pair := range rpcNodes.Children {
+		left, err := unmarshalDiffNode(pair.Left)
+		if err != nil {
+			return nil, err
+		}
+
+		right, err := unmarshalDiffNode(pair.Right)
+		if err != nil {
+			return nil, err
+		}
+
+		children[idx] = [2]mssmt.DiffNode{left, right}
+	}
+
+	return children, nil
+}
+
+// FetchMultiProof returns the leaves stored at the given MS-SMT keys of the
+// remote universe tree, along with a single inclusion proof for all of them.
+//
+// NOTE: The passed context is ignored, the requests are bound to the context
+// the session was opened with.
+//
+// NOTE: This is part of the universe.TreeDiffSession interface.
+func (s *rpcTreeDiffSession) FetchMultiProof(_ context.Context,
+	nodeKeys [][32]byte) (*universe.MultiProof, error) {
+
+	resp, err := s.roundTrip(&unirpc.SyncDiffRequest{
+		Request: &unirpc.SyncDiffRequest_Leaves{
+			Leaves: &unirpc.SyncDiffLeavesRequest{
+				LeafNodeKeys: fn.Map(
+					nodeKeys, fn.ByteSlice[[32]byte],
+				),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rpcProof := resp.GetLeaves()
+	if rpcProof == nil || rpcProof.UniverseRoot == nil ||
+		rpcProof.UniverseRoot.MssmtRoot == nil {
+
+		return nil, fmt.Errorf("expected leaves, got %T",
+			resp.Response)
+	}
+
+	return unmarshalMultiProof(rpcProof)
+}
+
+// Close ends the session by closing the stream to the remote server.
+//
+// NOTE: This is part of the universe.TreeDiffSession interface.
+func (s *rpcTreeDiffSession) Close() error {
+	defer s.cancel()
+
+	return s.stream.CloseSend()
+}
+
+// unmarshalDiffNode parses a node of a tree diff from its RPC counterpart.
+func unmarshalDiffNode(node *unirpc.DiffNode) (mssmt.DiffNode, error) {
+	if node == nil || len(node.NodeHash) != sha256.Size {
+		return mssmt.DiffNode{}, fmt.Errorf("invalid diff node")
+	}
+
+	diffNode := mssmt.DiffNode{
+		Node: mssmt.NewComputedNode(
+			fn.ToArray[mssmt.NodeHash](node.NodeHash), node.NodeSum,
+		),
+	}
+
+	switch len(node.LeafNodeKey) {
+	// The node isn't a compacted leaf.
+	case 0:
+
+	case sha256.Size:
+		leafKey := fn.ToArray[[32]byte](node.LeafNodeKey)
+		diffNode.LeafKey = &leafKey
+
+	default:
+		return mssmt.DiffNode{}, fmt.Errorf("invalid leaf node key "+
+			"length: %d", len(node.LeafNodeKey))
+	}
+
+	return diffNode, nil
+}
+
+// unmarshalMultiProof parses a universe multi-proof from its RPC counterpart.
+func unmarshalMultiProof(
+	rpcProof *unirpc.MultiProofResponse) (*universe.MultiProof, error) {
+
+	if len(rpcProof.LeafKeys) != len(rpcProof.Leaves) {
+		return nil, fmt.Errorf("got %d leaf keys for %d leaves",
+			len(rpcProof.LeafKeys), len(rpcProof.Leaves))
+	}
+
+	leafKeys, err := fn.MapErr(rpcProof.LeafKeys, unmarshalLeafKey)
+	if err != nil {
+		return nil, err
+	}
+
+	leaves, err := fn.MapErr(rpcProof.Leaves, unmarshalAssetLeaf)
+	if err != nil {
+		return nil, err
+	}
+
+	var inclusionProof mssmt.MultiProof
+	err = inclusionProof.Decode(
+		bytes.NewReader(rpcProof.UniverseMultiProof),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	uniRoot := unmarshalMerkleSumNode(rpcProof.UniverseRoot.MssmtRoot)
+
+	return &universe.MultiProof{
+		LeafKeys:               leafKeys,
+		Leaves:                 leaves,
+		UniverseRoot:           uniRoot,
+		UniverseInclusionProof: &inclusionProof,
+	}, nil
+}
+
 // Close closes the underlying RPC connection to the remote universe server.
 func (r *RpcUniverseDiff) Close() error {
 	if err := r.conn.Close(); err != nil {
@@ -222,5 +494,5 @@ func (r *RpcUniverseDiff) Close() error {
 }
 
 // A compile time interface to ensure that RpcUniverseDiff implements the
-// universe.DiffEngine interface.
-var _ universe.DiffEngine = (*RpcUniverseDiff)(nil)
+// universe.TreeDiffEngine interface.
+var _ universe.TreeDiffEngine = (*RpcUniverseDiff)(nil)
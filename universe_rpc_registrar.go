@@ -9,8 +9,10 @@ import (
 
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
 	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/tor"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 )
@@ -22,11 +24,12 @@ type RpcUniverseRegistrar struct {
 }
 
 // NewRpcUniverseRegistrar creates a new RpcUniverseRegistrar instance that
-// dials out to the target remote universe server address.
-func NewRpcUniverseRegistrar(
-	serverAddr universe.ServerAddr) (universe.Registrar, error) {
+// dials out to the target remote universe server address. If a proxy is
+// given, the connection is made through it.
+func NewRpcUniverseRegistrar(serverAddr universe.ServerAddr,
+	proxy *tor.ProxyNet) (universe.Registrar, error) {
 
-	conn, err := ConnectUniverse(serverAddr)
+	conn, err := ConnectUniverse(serverAddr, proxy)
 	if err != nil {
 		return nil, fmt.Errorf("unable to connect to universe RPC "+
 			"server: %w", err)
@@ -133,12 +136,12 @@ var _ universe.Registrar = (*RpcUniverseRegistrar)(nil)
 // CheckFederationServer attempts to connect to the target server and ensure
 // that it is a valid federation server that isn't the local daemon.
 func CheckFederationServer(localRuntimeID int64, connectTimeout time.Duration,
-	server universe.ServerAddr) error {
+	server universe.ServerAddr, proxy *tor.ProxyNet) error {
 
 	srvrLog.Debugf("Attempting to connect to federation server %v",
 		server.HostStr())
 
-	conn, err := ConnectUniverse(server)
+	conn, err := ConnectUniverse(server, proxy)
 	if err != nil {
 		return fmt.Errorf("error connecting to server %v: %w",
 			server.HostStr(), err)
@@ -171,9 +174,11 @@ type universeClientConn struct {
 }
 
 // ConnectUniverse connects to a remote Universe server using the provided
-// server address.
-func ConnectUniverse(
-	serverAddr universe.ServerAddr) (*universeClientConn, error) {
+// server address. If a proxy is given, the connection is made through it and
+// the server's host name is resolved by the proxy, which also allows
+// connecting to onion services.
+func ConnectUniverse(serverAddr universe.ServerAddr,
+	proxy *tor.ProxyNet) (*universeClientConn, error) {
 
 	// TODO(roasbeef): all info is authenticated, but also want to allow
 	// brontide connect as well, can avoid TLS certs
@@ -187,12 +192,33 @@ func ConnectUniverse(
 		grpc.WithDefaultCallOptions(MaxMsgReceiveSize),
 	}
 
-	uniAddr, err := serverAddr.Addr()
-	if err != nil {
-		return nil, err
+	// With a proxy, we don't resolve the host ourselves to not leak the
+	// server we're connecting to through DNS.
+	var target string
+	switch {
+	case proxy != nil:
+		hostPort, err := serverAddr.HostPort()
+		if err != nil {
+			return nil, err
+		}
+
+		target = hostPort
+		opts = append(opts, proof.ProxyDialOption(proxy))
+
+	case serverAddr.IsOnion():
+		return nil, fmt.Errorf("unable to connect to onion service %v "+
+			"without a Tor proxy", serverAddr.HostStr())
+
+	default:
+		uniAddr, err := serverAddr.Addr()
+		if err != nil {
+			return nil, err
+		}
+
+		target = uniAddr.String()
 	}
 
-	rawConn, err := grpc.Dial(uniAddr.String(), opts...)
+	rawConn, err := grpc.Dial(target, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to connect to RPC server: "+
 			"%w", err)
@@ -1,13 +1,17 @@
 package taprootassets
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/input"
 )
 
 // LndRpcVirtualTxSigner is an implementation of the tapscript.Signer
@@ -47,8 +51,156 @@ func (l *LndRpcVirtualTxSigner) SignVirtualTx(signDesc *lndclient.SignDescriptor
 	return virtualTxSig, nil
 }
 
+// MuSig2CreateNonce creates a new MuSig2 signing session for the given script
+// key and returns the local public nonce of the session.
+func (l *LndRpcVirtualTxSigner) MuSig2CreateNonce(ctx context.Context,
+	scriptKey asset.ScriptKey) ([musig2.PubNonceSize]byte, error) {
+
+	var nonce [musig2.PubNonceSize]byte
+	keyInfo, err := muSig2KeyInfo(scriptKey)
+	if err != nil {
+		return nonce, err
+	}
+
+	signers := make([][]byte, len(keyInfo.SignerKeys))
+	for idx, signerKey := range keyInfo.SignerKeys {
+		signers[idx] = signerKey.SerializeCompressed()
+	}
+
+	session, err := l.lnd.Signer.MuSig2CreateSession(
+		ctx, input.MuSig2Version100RC2,
+		&keyInfo.LocalKey.KeyLocator, signers,
+		lndclient.MuSig2TaprootTweakOpt(nil, true),
+	)
+	if err != nil {
+		return nonce, fmt.Errorf("error creating MuSig2 session: %w",
+			err)
+	}
+
+	// The session must be for the script key we expect, otherwise the
+	// signature we'll create won't be valid. We then remove the session
+	// again, as it can't be used.
+	if !bytes.Equal(
+		schnorr.SerializePubKey(session.CombinedKey),
+		schnorr.SerializePubKey(scriptKey.PubKey),
+	) {
+
+		err := l.lnd.Signer.MuSig2Cleanup(ctx, session.SessionID)
+		if err != nil {
+			tapdLog.Warnf("Unable to clean up MuSig2 session "+
+				"%x: %v", session.SessionID[:], err)
+		}
+
+		return nonce, fmt.Errorf("MuSig2 session combined key %x "+
+			"doesn't match script key %x",
+			session.CombinedKey.SerializeCompressed(),
+			scriptKey.PubKey.SerializeCompressed())
+	}
+
+	return session.PublicNonce, nil
+}
+
+// MuSig2PartialSign registers the public nonces of the other signers with the
+// session identified by the local nonce and creates the local partial
+// signature for the given message.
+func (l *LndRpcVirtualTxSigner) MuSig2PartialSign(ctx context.Context,
+	scriptKey asset.ScriptKey, localNonce [musig2.PubNonceSize]byte,
+	otherNonces [][musig2.PubNonceSize]byte,
+	msg [32]byte) ([input.MuSig2PartialSigSize]byte, error) {
+
+	var partialSig [input.MuSig2PartialSigSize]byte
+	sessionID, err := muSig2SessionID(scriptKey, localNonce)
+	if err != nil {
+		return partialSig, err
+	}
+
+	_, err = l.lnd.Signer.MuSig2RegisterNonces(ctx, sessionID, otherNonces)
+	if err != nil {
+		return partialSig, fmt.Errorf("error registering nonces: %w",
+			err)
+	}
+
+	// We don't clean up the session yet, as we need it to combine the
+	// partial signatures later on.
+	sig, err := l.lnd.Signer.MuSig2Sign(ctx, sessionID, msg, false)
+	if err != nil {
+		return partialSig, fmt.Errorf("error signing: %w", err)
+	}
+	if len(sig) != input.MuSig2PartialSigSize {
+		return partialSig, fmt.Errorf("invalid partial signature "+
+			"length %d", len(sig))
+	}
+	copy(partialSig[:], sig)
+
+	return partialSig, nil
+}
+
+// MuSig2CombineSig combines the local partial signature of the session
+// identified by the local nonce with the partial signatures of the other
+// signers into the final Schnorr signature.
+func (l *LndRpcVirtualTxSigner) MuSig2CombineSig(ctx context.Context,
+	scriptKey asset.ScriptKey, localNonce [musig2.PubNonceSize]byte,
+	otherSigs [][input.MuSig2PartialSigSize]byte) (
+	*schnorr.Signature, error) {
+
+	sessionID, err := muSig2SessionID(scriptKey, localNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs := make([][]byte, len(otherSigs))
+	for idx := range otherSigs {
+		sigs[idx] = otherSigs[idx][:]
+	}
+
+	// The session is removed from lnd's memory once the final signature
+	// was created.
+	haveAllSigs, finalSig, err := l.lnd.Signer.MuSig2CombineSig(
+		ctx, sessionID, sigs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error combining signatures: %w", err)
+	}
+	if !haveAllSigs {
+		return nil, fmt.Errorf("missing partial signatures")
+	}
+
+	return schnorr.ParseSignature(finalSig)
+}
+
+// muSig2KeyInfo returns the key aggregation context of the given script key.
+func muSig2KeyInfo(scriptKey asset.ScriptKey) (*asset.MuSig2KeyInfo, error) {
+	if scriptKey.TweakedScriptKey == nil || scriptKey.MuSig2 == nil {
+		return nil, fmt.Errorf("script key %x is not a MuSig2 script "+
+			"key", scriptKey.PubKey.SerializeCompressed())
+	}
+
+	return scriptKey.MuSig2, nil
+}
+
+// muSig2SessionID returns the ID lnd assigned to the MuSig2 session of the
+// given script key that was created with the given local nonce.
+func muSig2SessionID(scriptKey asset.ScriptKey,
+	localNonce [musig2.PubNonceSize]byte) (input.MuSig2SessionID, error) {
+
+	keyInfo, err := muSig2KeyInfo(scriptKey)
+	if err != nil {
+		return input.MuSig2SessionID{}, err
+	}
+
+	aggKey, err := keyInfo.AggregateKey()
+	if err != nil {
+		return input.MuSig2SessionID{}, fmt.Errorf("error aggregating "+
+			"keys: %w", err)
+	}
+
+	return input.NewMuSig2SessionID(aggKey.FinalKey, localNonce), nil
+}
+
 // Compile time assertions to ensure LndRpcVirtualTxSigner meets the
-// tapscript.Signer and asset.GenesisSigner interfaces.
+// tapscript.Signer, tapscript.MuSig2Signer and asset.GenesisSigner interfaces.
 var _ tapscript.Signer = (*LndRpcVirtualTxSigner)(nil)
 
+var _ tapscript.MuSig2Signer = (*LndRpcVirtualTxSigner)(nil)
+
 var _ asset.GenesisSigner = (*LndRpcVirtualTxSigner)(nil)
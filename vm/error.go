@@ -66,6 +66,11 @@ const (
 	// ErrUnfinalizedAsset represents an error case where the asset has not
 	// been finalized. A virtual TX may only contain finalized assets.
 	ErrUnfinalizedAsset
+
+	// ErrInvalidIntrospection represents an error case where a ScriptV1
+	// script uses an introspection opcode incorrectly, or the asset outputs
+	// revealed to the script are invalid.
+	ErrInvalidIntrospection
 )
 
 // Wrap select errors related to virtual TX handling to provide more
@@ -112,6 +117,8 @@ func (k ErrorKind) String() string {
 		return "invalid zero-value root asset"
 	case ErrUnfinalizedAsset:
 		return "un-finalized asset"
+	case ErrInvalidIntrospection:
+		return "invalid asset output introspection"
 	default:
 		return "unknown"
 	}
@@ -0,0 +1,636 @@
+package vm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// The introspection opcodes of ScriptV1. They re-define the upgradable NOP
+// opcodes of tapscript, which is why ScriptV1 scripts are executed without the
+// txscript.ScriptDiscourageUpgradableNops flag. Introspection opcodes may not
+// be used within conditional branches.
+//
+// Amounts are pushed to and popped from the stack as 8-byte little-endian
+// integers, as script numbers are limited to 4 bytes. Output indexes are
+// regular, minimally encoded script numbers.
+const (
+	// OP_INSPECTINPUTAMOUNT pushes the amount of the asset input that is
+	// being spent.
+	OP_INSPECTINPUTAMOUNT = txscript.OP_NOP4
+
+	// OP_INSPECTOUTPUTAMOUNT pops an output index and pushes the amount of
+	// that asset output.
+	OP_INSPECTOUTPUTAMOUNT = txscript.OP_NOP5
+
+	// OP_INSPECTOUTPUTSCRIPTKEY pops an output index and pushes the 32-byte
+	// x-only script key of that asset output.
+	OP_INSPECTOUTPUTSCRIPTKEY = txscript.OP_NOP6
+
+	// OP_INSPECTOUTPUTASSETID pops an output index and pushes the 32-byte
+	// asset ID of that asset output.
+	OP_INSPECTOUTPUTASSETID = txscript.OP_NOP7
+
+	// OP_ADD64 pops two amounts and pushes their sum. Execution fails if
+	// the sum overflows.
+	OP_ADD64 = txscript.OP_NOP8
+
+	// OP_SUB64 pops two amounts a and b, with b on top of the stack, and
+	// pushes a-b. Execution fails if b is greater than a.
+	OP_SUB64 = txscript.OP_NOP9
+
+	// OP_GREATERTHANOREQUAL64 pops two amounts a and b, with b on top of
+	// the stack, and pushes 1 if a is greater than or equal to b, or an
+	// empty vector otherwise.
+	OP_GREATERTHANOREQUAL64 = txscript.OP_NOP10
+)
+
+const (
+	// amountSize is the size of an amount on the stack.
+	amountSize = 8
+
+	// maxOutputIndexSize is the maximum size of an output index on the
+	// stack.
+	maxOutputIndexSize = 2
+)
+
+// IntrospectionOutput is an asset output of a split transfer that is revealed
+// to the ScriptV1 scripts of the transfer's inputs. The asset outputs of a
+// split transfer are only committed to by the split commitment root of the
+// root asset, so their leaves are revealed in the annex of the input witness,
+// together with a proof of their inclusion in the split commitment.
+type IntrospectionOutput struct {
+	// OutputIndex is the index of the anchor output of the asset output.
+	OutputIndex uint32
+
+	// Leaf is the encoded split asset that is committed to by the split
+	// commitment.
+	Leaf []byte
+
+	// Proof is the inclusion proof of the leaf in the split commitment.
+	Proof mssmt.Proof
+}
+
+// NewIntrospectionOutput creates the introspection output that reveals the
+// given split asset, which must carry its split commitment proof, to ScriptV1
+// scripts.
+func NewIntrospectionOutput(
+	splitAsset *commitment.SplitAsset) (*IntrospectionOutput, error) {
+
+	if !splitAsset.HasSplitCommitmentWitness() {
+		return nil, fmt.Errorf("asset has no split commitment witness")
+	}
+
+	// The split commitment leaf is the split asset without its split
+	// commitment, and with the lock times of the root asset, which is also
+	// how the split commitment proof is verified.
+	splitCommitment := splitAsset.PrevWitnesses[0].SplitCommitment
+	leafAsset := splitAsset.Copy()
+	leafAsset.PrevWitnesses[0].SplitCommitment = nil
+	leafAsset.LockTime = splitCommitment.RootAsset.LockTime
+	leafAsset.RelativeLockTime = splitCommitment.RootAsset.RelativeLockTime
+
+	leaf, err := leafAsset.Leaf()
+	if err != nil {
+		return nil, err
+	}
+
+	return &IntrospectionOutput{
+		OutputIndex: splitAsset.OutputIndex,
+		Leaf:        leaf.Value,
+		Proof:       splitCommitment.Proof,
+	}, nil
+}
+
+// EncodeIntrospectionAnnex encodes the given introspection outputs as a
+// tapscript annex. The outputs are revealed to scripts in the given order.
+func EncodeIntrospectionAnnex(outputs []*IntrospectionOutput) ([]byte,
+	error) {
+
+	var (
+		w   bytes.Buffer
+		buf [8]byte
+	)
+	w.WriteByte(txscript.TaprootAnnexTag)
+
+	err := tlv.WriteVarInt(&w, uint64(len(outputs)), &buf)
+	if err != nil {
+		return nil, err
+	}
+	for _, output := range outputs {
+		err := binary.Write(&w, binary.BigEndian, output.OutputIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		err = asset.InlineVarBytesEncoder(&w, &output.Leaf, &buf)
+		if err != nil {
+			return nil, err
+		}
+
+		var proof bytes.Buffer
+		if err := output.Proof.Compress().Encode(&proof); err != nil {
+			return nil, err
+		}
+		proofBytes := proof.Bytes()
+		err = asset.InlineVarBytesEncoder(&w, &proofBytes, &buf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return w.Bytes(), nil
+}
+
+// DecodeIntrospectionAnnex decodes the introspection outputs from the given
+// tapscript annex.
+func DecodeIntrospectionAnnex(annex []byte) ([]*IntrospectionOutput, error) {
+	if len(annex) == 0 || annex[0] != txscript.TaprootAnnexTag {
+		return nil, fmt.Errorf("invalid annex tag")
+	}
+
+	var (
+		r   = bytes.NewReader(annex[1:])
+		buf [8]byte
+	)
+	numOutputs, err := tlv.ReadVarInt(r, &buf)
+	if err != nil {
+		return nil, err
+	}
+	if numOutputs > math.MaxUint16 {
+		return nil, fmt.Errorf("too many introspection outputs: %d",
+			numOutputs)
+	}
+
+	outputs := make([]*IntrospectionOutput, 0, numOutputs)
+	for i := uint64(0); i < numOutputs; i++ {
+		var output IntrospectionOutput
+		err := binary.Read(r, binary.BigEndian, &output.OutputIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		err = asset.InlineVarBytesDecoder(
+			r, &output.Leaf, &buf, math.MaxUint16,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var proofBytes []byte
+		err = asset.InlineVarBytesDecoder(
+			r, &proofBytes, &buf, math.MaxUint16,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var compressedProof mssmt.CompressedProof
+		err = compressedProof.Decode(bytes.NewReader(proofBytes))
+		if err != nil {
+			return nil, err
+		}
+		proof, err := compressedProof.Decompress()
+		if err != nil {
+			return nil, err
+		}
+		output.Proof = *proof
+
+		outputs = append(outputs, &output)
+	}
+
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("annex has %d trailing bytes", r.Len())
+	}
+
+	return outputs, nil
+}
+
+// introspectionOutput is the view of an asset output that is exposed to
+// ScriptV1 scripts.
+type introspectionOutput struct {
+	amount    uint64
+	scriptKey []byte
+	assetID   asset.ID
+}
+
+// isIntrospectionOpcode returns true if the given opcode is one of the
+// introspection opcodes of ScriptV1.
+func isIntrospectionOpcode(op byte) bool {
+	return op >= OP_INSPECTINPUTAMOUNT && op <= OP_GREATERTHANOREQUAL64
+}
+
+// witnessAnnex returns the annex of the given witness, if there is one.
+func witnessAnnex(txWitness wire.TxWitness) fn.Option[[]byte] {
+	if len(txWitness) < 2 {
+		return fn.None[[]byte]()
+	}
+
+	lastElement := txWitness[len(txWitness)-1]
+	if len(lastElement) == 0 ||
+		lastElement[0] != txscript.TaprootAnnexTag {
+
+		return fn.None[[]byte]()
+	}
+
+	return fn.Some(lastElement)
+}
+
+// witnessLeafScript returns the tapscript leaf revealed by the given witness,
+// if it is a script path spend.
+func witnessLeafScript(txWitness wire.TxWitness) fn.Option[[]byte] {
+	if witnessAnnex(txWitness).IsSome() {
+		txWitness = txWitness[:len(txWitness)-1]
+	}
+
+	// A key spend doesn't reveal a script.
+	if len(txWitness) < 2 {
+		return fn.None[[]byte]()
+	}
+
+	return fn.Some(txWitness[len(txWitness)-2])
+}
+
+// checkIntrospectionScript makes sure the tapscript leaf revealed by the given
+// witness, if any, only uses introspection opcodes outside of conditional
+// branches, and doesn't use the reserved OP_NOP1.
+func checkIntrospectionScript(txWitness wire.TxWitness) error {
+	leafScript := witnessLeafScript(txWitness)
+	if leafScript.IsNone() {
+		return nil
+	}
+
+	var (
+		script    = leafScript.UnwrapOr(nil)
+		tokenizer = txscript.MakeScriptTokenizer(0, script)
+		depth     int
+	)
+	for tokenizer.Next() {
+		switch op := tokenizer.Opcode(); {
+		case op == txscript.OP_IF || op == txscript.OP_NOTIF:
+			depth++
+
+		case op == txscript.OP_ENDIF:
+			depth--
+
+		case op == txscript.OP_NOP1:
+			return newErrInner(
+				ErrInvalidIntrospection,
+				fmt.Errorf("reserved opcode OP_NOP1 used"),
+			)
+
+		case isIntrospectionOpcode(op) && depth > 0:
+			return newErrInner(
+				ErrInvalidIntrospection,
+				fmt.Errorf("introspection opcode used in "+
+					"conditional branch"),
+			)
+		}
+	}
+	if err := tokenizer.Err(); err != nil {
+		return newErrInner(ErrInvalidTransferWitness, err)
+	}
+
+	return nil
+}
+
+// introspectionOutputs returns the asset outputs of the state transition that
+// are revealed to a ScriptV1 script through the given witness. If the new
+// asset doesn't commit to any splits, the new asset is the only output.
+// Otherwise, the outputs are the ones revealed in the annex of the witness,
+// each of which must be committed to by the split commitment root.
+func (vm *Engine) introspectionOutputs(
+	txWitness wire.TxWitness) ([]introspectionOutput, error) {
+
+	annex := witnessAnnex(txWitness)
+
+	if vm.newAsset.SplitCommitmentRoot == nil {
+		if annex.IsSome() {
+			return nil, newErrInner(
+				ErrInvalidIntrospection,
+				fmt.Errorf("annex without split commitment"),
+			)
+		}
+
+		return []introspectionOutput{{
+			amount: vm.newAsset.Amount,
+			scriptKey: schnorr.SerializePubKey(
+				vm.newAsset.ScriptKey.PubKey,
+			),
+			assetID: vm.newAsset.Genesis.ID(),
+		}}, nil
+	}
+
+	// Without an annex, no outputs are revealed to the script.
+	if annex.IsNone() {
+		return nil, nil
+	}
+
+	revealedOutputs, err := DecodeIntrospectionAnnex(
+		annex.UnwrapOr(nil),
+	)
+	if err != nil {
+		return nil, newErrInner(ErrInvalidIntrospection, err)
+	}
+
+	outputs := make([]introspectionOutput, 0, len(revealedOutputs))
+	locators := fn.NewSet[[32]byte]()
+	for idx, revealedOutput := range revealedOutputs {
+		var leafAsset asset.Asset
+		err := leafAsset.Decode(bytes.NewReader(revealedOutput.Leaf))
+		if err != nil {
+			return nil, newErrInner(ErrInvalidIntrospection, err)
+		}
+
+		locator := commitment.SplitLocator{
+			OutputIndex: revealedOutput.OutputIndex,
+			AssetID:     leafAsset.Genesis.ID(),
+			ScriptKey: asset.ToSerialized(
+				leafAsset.ScriptKey.PubKey,
+			),
+			Amount: leafAsset.Amount,
+		}
+		locatorHash := locator.Hash()
+		if locators.Contains(locatorHash) {
+			return nil, newErrInner(
+				ErrInvalidIntrospection,
+				fmt.Errorf("output %d revealed twice", idx),
+			)
+		}
+		locators.Add(locatorHash)
+
+		leaf := mssmt.NewLeafNode(revealedOutput.Leaf, leafAsset.Amount)
+		if !mssmt.VerifyMerkleProof(
+			locatorHash, leaf, &revealedOutput.Proof,
+			vm.newAsset.SplitCommitmentRoot,
+		) {
+
+			return nil, newErrInner(
+				ErrInvalidIntrospection,
+				fmt.Errorf("output %d not committed to split "+
+					"commitment", idx),
+			)
+		}
+
+		outputs = append(outputs, introspectionOutput{
+			amount: leafAsset.Amount,
+			scriptKey: schnorr.SerializePubKey(
+				leafAsset.ScriptKey.PubKey,
+			),
+			assetID: locator.AssetID,
+		})
+	}
+
+	return outputs, nil
+}
+
+// validateWitnessV1 attempts to validate a new asset's witness based on the
+// ScriptV1 script version, which extends ScriptV0 with introspection opcodes
+// that give the script access to the asset outputs of the state transition.
+func (vm *Engine) validateWitnessV1(virtualTx *wire.MsgTx, inputIdx uint32,
+	witness *asset.Witness, prevAsset *asset.Asset) error {
+
+	if prevAsset.ScriptVersion != asset.ScriptV1 {
+		return ErrInvalidScriptVersion
+	}
+
+	// The introspection opcodes re-define the upgradable NOPs, so we can't
+	// discourage them.
+	flags := txscript.StandardVerifyFlags &^
+		txscript.ScriptDiscourageUpgradableNops
+
+	engine, pkScript, err := vm.newScriptEngine(
+		virtualTx, inputIdx, witness, prevAsset, flags,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := checkIntrospectionScript(witness.TxWitness); err != nil {
+		return err
+	}
+
+	outputs, err := vm.introspectionOutputs(witness.TxWitness)
+	if err != nil {
+		return err
+	}
+
+	// The VM first executes the P2TR output script and then, for a script
+	// path spend, the revealed leaf script.
+	scripts := [][]byte{pkScript}
+	witnessLeafScript(witness.TxWitness).WhenSome(func(script []byte) {
+		scripts = append(scripts, script)
+	})
+
+	introspector := &introspector{
+		inputAmount: prevAsset.Amount,
+		outputs:     outputs,
+		scripts:     scripts,
+	}
+
+	return introspector.execute(engine)
+}
+
+// introspector executes the introspection opcodes of a ScriptV1 script.
+type introspector struct {
+	// inputAmount is the amount of the asset input being spent.
+	inputAmount uint64
+
+	// outputs are the asset outputs revealed to the script.
+	outputs []introspectionOutput
+
+	// scripts are the scripts executed by the VM, in execution order.
+	scripts [][]byte
+}
+
+// execute steps through the given Tapscript VM, executing each introspection
+// opcode on the stack of the VM before the VM executes it as a NOP.
+func (i *introspector) execute(engine *txscript.Engine) error {
+	// We keep a cursor into the executed scripts that we advance in
+	// lockstep with the VM, so we know the opcode it executes next.
+	// Empty scripts are skipped, just like the VM does.
+	var (
+		scriptIdx int
+		tokenizer txscript.ScriptTokenizer
+	)
+	for done := false; !done; {
+		for !tokenizer.Next() {
+			if err := tokenizer.Err(); err != nil {
+				return newErrInner(
+					ErrInvalidTransferWitness, err,
+				)
+			}
+
+			if scriptIdx >= len(i.scripts) {
+				return newErrInner(
+					ErrInvalidTransferWitness,
+					fmt.Errorf("VM stepped beyond the "+
+						"executed scripts"),
+				)
+			}
+
+			tokenizer = txscript.MakeScriptTokenizer(
+				0, i.scripts[scriptIdx],
+			)
+			scriptIdx++
+		}
+
+		// Only tapscript leaves contain NOPs, since we only ever
+		// execute P2TR outputs, and leaves were checked to not use
+		// introspection opcodes in conditional branches, so every
+		// introspection opcode we encounter is executed.
+		if op := tokenizer.Opcode(); isIntrospectionOpcode(op) {
+			stack, err := i.executeOpcode(op, engine.GetStack())
+			if err != nil {
+				return newErrInner(ErrInvalidIntrospection, err)
+			}
+			engine.SetStack(stack)
+		}
+
+		var err error
+		done, err = engine.Step()
+		if err != nil {
+			return newErrInner(ErrInvalidTransferWitness, err)
+		}
+	}
+
+	if err := engine.CheckErrorCondition(true); err != nil {
+		return newErrInner(ErrInvalidTransferWitness, err)
+	}
+
+	return nil
+}
+
+// executeOpcode executes the given introspection opcode on the given stack,
+// the top of which is the last element, and returns the resulting stack.
+func (i *introspector) executeOpcode(op byte, stack [][]byte) ([][]byte,
+	error) {
+
+	switch op {
+	case OP_INSPECTINPUTAMOUNT:
+		return append(stack, encodeAmount(i.inputAmount)), nil
+
+	case OP_INSPECTOUTPUTAMOUNT, OP_INSPECTOUTPUTSCRIPTKEY,
+		OP_INSPECTOUTPUTASSETID:
+
+		if len(stack) < 1 {
+			return nil, fmt.Errorf("missing output index")
+		}
+		outputIdx, err := decodeOutputIndex(stack[len(stack)-1])
+		if err != nil {
+			return nil, err
+		}
+		if outputIdx >= len(i.outputs) {
+			return nil, fmt.Errorf("output index %d out of range, "+
+				"%d outputs revealed", outputIdx,
+				len(i.outputs))
+		}
+
+		output := i.outputs[outputIdx]
+		stack = stack[:len(stack)-1]
+
+		switch op {
+		case OP_INSPECTOUTPUTAMOUNT:
+			return append(stack, encodeAmount(output.amount)), nil
+
+		case OP_INSPECTOUTPUTSCRIPTKEY:
+			scriptKey := fn.CopySlice(output.scriptKey)
+			return append(stack, scriptKey), nil
+
+		default:
+			assetID := fn.CopySlice(output.assetID[:])
+			return append(stack, assetID), nil
+		}
+
+	case OP_ADD64, OP_SUB64, OP_GREATERTHANOREQUAL64:
+		if len(stack) < 2 {
+			return nil, fmt.Errorf("missing amount operands")
+		}
+		a, err := decodeAmount(stack[len(stack)-2])
+		if err != nil {
+			return nil, err
+		}
+		b, err := decodeAmount(stack[len(stack)-1])
+		if err != nil {
+			return nil, err
+		}
+		stack = stack[:len(stack)-2]
+
+		switch op {
+		case OP_ADD64:
+			if a > math.MaxUint64-b {
+				return nil, fmt.Errorf("amount overflow")
+			}
+			return append(stack, encodeAmount(a+b)), nil
+
+		case OP_SUB64:
+			if b > a {
+				return nil, fmt.Errorf("amount underflow")
+			}
+			return append(stack, encodeAmount(a-b)), nil
+
+		default:
+			if a >= b {
+				return append(stack, []byte{1}), nil
+			}
+			return append(stack, nil), nil
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown introspection opcode %x", op)
+	}
+}
+
+// encodeAmount encodes the given amount as an 8-byte little-endian integer.
+func encodeAmount(amount uint64) []byte {
+	var b [amountSize]byte
+	binary.LittleEndian.PutUint64(b[:], amount)
+	return b[:]
+}
+
+// decodeAmount decodes an 8-byte little-endian amount.
+func decodeAmount(b []byte) (uint64, error) {
+	if len(b) != amountSize {
+		return 0, fmt.Errorf("amount must be %d bytes, got %d",
+			amountSize, len(b))
+	}
+
+	return binary.LittleEndian.Uint64(b), nil
+}
+
+// decodeOutputIndex decodes an output index from a minimally encoded,
+// non-negative script number.
+func decodeOutputIndex(b []byte) (int, error) {
+	switch {
+	case len(b) == 0:
+		return 0, nil
+
+	case len(b) > maxOutputIndexSize:
+		return 0, fmt.Errorf("output index too large")
+
+	// The most significant byte may only be zero if the sign bit of the
+	// byte before it is set, otherwise the number isn't minimally encoded.
+	case b[len(b)-1]&0x7f == 0 && (len(b) == 1 || b[len(b)-2]&0x80 == 0):
+		return 0, fmt.Errorf("output index not minimally encoded")
+
+	case b[len(b)-1]&0x80 != 0:
+		return 0, fmt.Errorf("negative output index")
+	}
+
+	var outputIdx int
+	for i := len(b) - 1; i >= 0; i-- {
+		outputIdx = outputIdx<<8 | int(b[i])
+	}
+
+	return outputIdx, nil
+}
@@ -0,0 +1,453 @@
+package vm
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
+)
+
+// scriptV1Asset creates a ScriptV1 asset with the given amount and a script key
+// that commits to the single tapscript leaf returned by the given function.
+// The witness that spends the asset through that leaf is returned as well.
+func scriptV1Asset(t *testing.T, amount uint64,
+	genScript func(*asset.Asset) []byte) (*asset.Asset, wire.TxWitness) {
+
+	t.Helper()
+
+	inputAsset := randAsset(t, asset.Normal, test.RandPubKey(t))
+	inputAsset.Amount = amount
+	inputAsset.ScriptVersion = asset.ScriptV1
+
+	// The script key doesn't influence the asset ID, so the script may
+	// commit to the ID of the asset it locks.
+	tapLeaf := txscript.NewBaseTapLeaf(genScript(inputAsset))
+	tapTree := txscript.AssembleTaprootScriptTree(tapLeaf)
+	tapTreeRoot := tapTree.RootNode.TapHash()
+
+	internalKey := test.RandPubKey(t)
+	inputAsset.ScriptKey = asset.NewScriptKey(
+		txscript.ComputeTaprootOutputKey(internalKey, tapTreeRoot[:]),
+	)
+
+	controlBlock := tapTree.LeafMerkleProofs[0].ToControlBlock(internalKey)
+	controlBlockBytes, err := controlBlock.ToBytes()
+	require.NoError(t, err)
+
+	return inputAsset, wire.TxWitness{tapLeaf.Script, controlBlockBytes}
+}
+
+// outputCovenantScript returns a script that requires the asset output with
+// the given index to send at least the given amount of the given asset to the
+// given script key.
+func outputCovenantScript(t *testing.T, outputIdx int64, assetID asset.ID,
+	scriptKey []byte, minAmount uint64) []byte {
+
+	script, err := txscript.NewScriptBuilder().
+		AddInt64(outputIdx).
+		AddOp(OP_INSPECTOUTPUTSCRIPTKEY).
+		AddData(scriptKey).
+		AddOp(txscript.OP_EQUALVERIFY).
+		AddInt64(outputIdx).
+		AddOp(OP_INSPECTOUTPUTASSETID).
+		AddData(assetID[:]).
+		AddOp(txscript.OP_EQUALVERIFY).
+		AddInt64(outputIdx).
+		AddOp(OP_INSPECTOUTPUTAMOUNT).
+		AddData(encodeAmount(minAmount)).
+		AddOp(OP_GREATERTHANOREQUAL64).
+		Script()
+	require.NoError(t, err)
+
+	return script
+}
+
+// executeTransition executes the state transition of the given new asset.
+func executeTransition(newAsset *asset.Asset,
+	splitAssets []*commitment.SplitAsset,
+	inputs commitment.InputSet) error {
+
+	engine, err := New(
+		newAsset, splitAssets, inputs, WithSkipTimeLockValidation(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return engine.Execute()
+}
+
+// requireErrKind asserts that the given error is a VM error of the given kind.
+func requireErrKind(t *testing.T, err error, kind ErrorKind) {
+	t.Helper()
+
+	var vmErr Error
+	require.ErrorAs(t, err, &vmErr)
+	require.Equal(t, kind, vmErr.Kind, vmErr.Error())
+}
+
+// TestScriptV1Introspection tests that ScriptV1 scripts can constrain the
+// asset output of a full value state transition.
+func TestScriptV1Introspection(t *testing.T) {
+	t.Parallel()
+
+	recipientKey := test.RandPubKey(t)
+	recipientKeyBytes := schnorr.SerializePubKey(recipientKey)
+
+	testCases := []struct {
+		name          string
+		genScript     func(*asset.Asset) []byte
+		scriptVersion asset.ScriptVersion
+		recipientKey  bool
+		errKind       fn.Option[ErrorKind]
+	}{{
+		name: "covenant satisfied",
+		genScript: func(a *asset.Asset) []byte {
+			return outputCovenantScript(
+				t, 0, a.Genesis.ID(), recipientKeyBytes,
+				a.Amount,
+			)
+		},
+		scriptVersion: asset.ScriptV1,
+		recipientKey:  true,
+	}, {
+		name: "wrong script key",
+		genScript: func(a *asset.Asset) []byte {
+			return outputCovenantScript(
+				t, 0, a.Genesis.ID(), recipientKeyBytes,
+				a.Amount,
+			)
+		},
+		scriptVersion: asset.ScriptV1,
+		errKind:       fn.Some(ErrInvalidTransferWitness),
+	}, {
+		name: "input amount",
+		genScript: func(a *asset.Asset) []byte {
+			script, err := txscript.NewScriptBuilder().
+				AddOp(OP_INSPECTINPUTAMOUNT).
+				AddData(encodeAmount(a.Amount)).
+				AddOp(txscript.OP_EQUAL).
+				Script()
+			require.NoError(t, err)
+
+			return script
+		},
+		scriptVersion: asset.ScriptV1,
+	}, {
+		name: "output index out of range",
+		genScript: func(a *asset.Asset) []byte {
+			return outputCovenantScript(
+				t, 1, a.Genesis.ID(), recipientKeyBytes,
+				a.Amount,
+			)
+		},
+		scriptVersion: asset.ScriptV1,
+		recipientKey:  true,
+		errKind:       fn.Some(ErrInvalidIntrospection),
+	}, {
+		name: "introspection in conditional branch",
+		genScript: func(a *asset.Asset) []byte {
+			script, err := txscript.NewScriptBuilder().
+				AddOp(txscript.OP_1).
+				AddOp(txscript.OP_IF).
+				AddOp(OP_INSPECTINPUTAMOUNT).
+				AddOp(txscript.OP_DROP).
+				AddOp(txscript.OP_ENDIF).
+				AddOp(txscript.OP_1).
+				Script()
+			require.NoError(t, err)
+
+			return script
+		},
+		scriptVersion: asset.ScriptV1,
+		errKind:       fn.Some(ErrInvalidIntrospection),
+	}, {
+		name: "introspection opcodes not allowed in v0",
+		genScript: func(a *asset.Asset) []byte {
+			return outputCovenantScript(
+				t, 0, a.Genesis.ID(), recipientKeyBytes,
+				a.Amount,
+			)
+		},
+		scriptVersion: asset.ScriptV0,
+		recipientKey:  true,
+		errKind:       fn.Some(ErrInvalidTransferWitness),
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inputAsset, witness := scriptV1Asset(
+				t, 100, tc.genScript,
+			)
+			inputAsset.ScriptVersion = tc.scriptVersion
+
+			prevID := asset.PrevID{
+				ID: inputAsset.Genesis.ID(),
+				ScriptKey: asset.ToSerialized(
+					inputAsset.ScriptKey.PubKey,
+				),
+			}
+
+			newAsset := inputAsset.Copy()
+			newAsset.ScriptKey = asset.RandScriptKey(t)
+			if tc.recipientKey {
+				newAsset.ScriptKey = asset.NewScriptKey(
+					recipientKey,
+				)
+			}
+			newAsset.PrevWitnesses = []asset.Witness{{
+				PrevID:    &prevID,
+				TxWitness: witness,
+			}}
+
+			err := executeTransition(
+				newAsset, nil, commitment.InputSet{
+					prevID: inputAsset,
+				},
+			)
+
+			if tc.errKind.IsNone() {
+				require.NoError(t, err)
+				return
+			}
+			requireErrKind(t, err, tc.errKind.UnwrapOr(0))
+		})
+	}
+}
+
+// TestScriptV1SplitIntrospection tests that ScriptV1 scripts can constrain the
+// asset outputs of a split state transition, which are revealed in the annex
+// of the witness.
+func TestScriptV1SplitIntrospection(t *testing.T) {
+	t.Parallel()
+
+	recipientKey := test.RandPubKey(t)
+	recipientKeyBytes := schnorr.SerializePubKey(recipientKey)
+
+	// The asset can only be spent if at least 60 units are sent to the
+	// recipient.
+	inputAsset, witness := scriptV1Asset(
+		t, 100, func(a *asset.Asset) []byte {
+			return outputCovenantScript(
+				t, 0, a.Genesis.ID(), recipientKeyBytes, 60,
+			)
+		},
+	)
+
+	assetID := inputAsset.Genesis.ID()
+	changeLocator := &commitment.SplitLocator{
+		OutputIndex: 0,
+		AssetID:     assetID,
+		ScriptKey:   asset.RandSerializedKey(t),
+		Amount:      40,
+	}
+	recipientLocator := &commitment.SplitLocator{
+		OutputIndex: 1,
+		AssetID:     assetID,
+		ScriptKey:   asset.ToSerialized(recipientKey),
+		Amount:      60,
+	}
+	splitCommitment, err := commitment.NewSplitCommitment(
+		context.Background(), []commitment.SplitCommitmentInput{{
+			Asset:    inputAsset,
+			OutPoint: wire.OutPoint{},
+		}}, changeLocator, recipientLocator,
+	)
+	require.NoError(t, err)
+
+	rootAsset := splitCommitment.RootAsset
+	splitAssets := maps.Values(splitCommitment.SplitAssets)
+
+	changeOutput, err := NewIntrospectionOutput(
+		splitCommitment.SplitAssets[*changeLocator],
+	)
+	require.NoError(t, err)
+	recipientOutput, err := NewIntrospectionOutput(
+		splitCommitment.SplitAssets[*recipientLocator],
+	)
+	require.NoError(t, err)
+
+	executeWithAnnex := func(outputs ...*IntrospectionOutput) error {
+		txWitness := fn.CopySlice(witness)
+		if len(outputs) > 0 {
+			annex, err := EncodeIntrospectionAnnex(outputs)
+			require.NoError(t, err)
+
+			txWitness = append(txWitness, annex)
+		}
+		rootAsset.PrevWitnesses[0].TxWitness = txWitness
+
+		return executeTransition(
+			rootAsset, splitAssets, splitCommitment.PrevAssets,
+		)
+	}
+
+	// If the recipient output is revealed as the first output, the
+	// covenant is satisfied.
+	require.NoError(t, executeWithAnnex(recipientOutput, changeOutput))
+
+	// The annex can be decoded again.
+	annex, err := EncodeIntrospectionAnnex(
+		[]*IntrospectionOutput{recipientOutput},
+	)
+	require.NoError(t, err)
+	decodedOutputs, err := DecodeIntrospectionAnnex(annex)
+	require.NoError(t, err)
+	require.Len(t, decodedOutputs, 1)
+	require.Equal(t, recipientOutput.Leaf, decodedOutputs[0].Leaf)
+
+	// Without an annex, no outputs are revealed.
+	requireErrKind(t, executeWithAnnex(), ErrInvalidIntrospection)
+
+	// If the change output is revealed first, the covenant isn't
+	// satisfied.
+	requireErrKind(
+		t, executeWithAnnex(changeOutput, recipientOutput),
+		ErrInvalidTransferWitness,
+	)
+
+	// Outputs can't be revealed twice.
+	requireErrKind(
+		t, executeWithAnnex(recipientOutput, recipientOutput),
+		ErrInvalidIntrospection,
+	)
+
+	// And outputs that aren't committed to by the split commitment can't
+	// be revealed either.
+	fakeOutput := *recipientOutput
+	fakeOutput.OutputIndex = 2
+	requireErrKind(
+		t, executeWithAnnex(&fakeOutput), ErrInvalidIntrospection,
+	)
+}
+
+// TestIntrospectionOpcodes tests the execution of the individual introspection
+// opcodes.
+func TestIntrospectionOpcodes(t *testing.T) {
+	t.Parallel()
+
+	assetID := asset.RandID(t)
+	scriptKey := schnorr.SerializePubKey(test.RandPubKey(t))
+	introspector := &introspector{
+		inputAmount: 10,
+		outputs: []introspectionOutput{{
+			amount:    7,
+			scriptKey: scriptKey,
+			assetID:   assetID,
+		}},
+	}
+
+	testCases := []struct {
+		name     string
+		op       byte
+		stack    [][]byte
+		expected [][]byte
+		err      string
+	}{{
+		name:     "input amount",
+		op:       OP_INSPECTINPUTAMOUNT,
+		expected: [][]byte{encodeAmount(10)},
+	}, {
+		name:     "output amount",
+		op:       OP_INSPECTOUTPUTAMOUNT,
+		stack:    [][]byte{nil},
+		expected: [][]byte{encodeAmount(7)},
+	}, {
+		name:     "output script key",
+		op:       OP_INSPECTOUTPUTSCRIPTKEY,
+		stack:    [][]byte{{0x01}, nil},
+		expected: [][]byte{{0x01}, scriptKey},
+	}, {
+		name:     "output asset id",
+		op:       OP_INSPECTOUTPUTASSETID,
+		stack:    [][]byte{nil},
+		expected: [][]byte{assetID[:]},
+	}, {
+		name:  "non-minimal output index",
+		op:    OP_INSPECTOUTPUTAMOUNT,
+		stack: [][]byte{{0x00}},
+		err:   "not minimally encoded",
+	}, {
+		name:  "negative output index",
+		op:    OP_INSPECTOUTPUTAMOUNT,
+		stack: [][]byte{{0x81}},
+		err:   "negative output index",
+	}, {
+		name: "missing output index",
+		op:   OP_INSPECTOUTPUTASSETID,
+		err:  "missing output index",
+	}, {
+		name: "add",
+		op:   OP_ADD64,
+		stack: [][]byte{
+			encodeAmount(3), encodeAmount(4),
+		},
+		expected: [][]byte{encodeAmount(7)},
+	}, {
+		name: "add overflow",
+		op:   OP_ADD64,
+		stack: [][]byte{
+			encodeAmount(math.MaxUint64), encodeAmount(1),
+		},
+		err: "amount overflow",
+	}, {
+		name: "sub",
+		op:   OP_SUB64,
+		stack: [][]byte{
+			encodeAmount(4), encodeAmount(3),
+		},
+		expected: [][]byte{encodeAmount(1)},
+	}, {
+		name: "sub underflow",
+		op:   OP_SUB64,
+		stack: [][]byte{
+			encodeAmount(3), encodeAmount(4),
+		},
+		err: "amount underflow",
+	}, {
+		name: "greater than or equal",
+		op:   OP_GREATERTHANOREQUAL64,
+		stack: [][]byte{
+			encodeAmount(4), encodeAmount(4),
+		},
+		expected: [][]byte{{1}},
+	}, {
+		name: "less than",
+		op:   OP_GREATERTHANOREQUAL64,
+		stack: [][]byte{
+			encodeAmount(3), encodeAmount(4),
+		},
+		expected: [][]byte{nil},
+	}, {
+		name: "invalid amount",
+		op:   OP_GREATERTHANOREQUAL64,
+		stack: [][]byte{
+			{0x03}, encodeAmount(4),
+		},
+		err: "amount must be 8 bytes",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stack, err := introspector.executeOpcode(
+				tc.op, tc.stack,
+			)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, stack)
+		})
+	}
+}
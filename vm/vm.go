@@ -225,6 +225,13 @@ func ValidateWitnesses(newAsset *asset.Asset,
 			if err != nil {
 				return err
 			}
+		case asset.ScriptV1:
+			err := vm.validateWitnessV1(
+				virtualTx, uint32(i), &witness, prevAsset,
+			)
+			if err != nil {
+				return err
+			}
 		default:
 			return ErrInvalidScriptVersion
 		}
@@ -328,15 +335,38 @@ func (vm *Engine) validateSplit(splitAsset *commitment.SplitAsset) error {
 func (vm *Engine) validateWitnessV0(virtualTx *wire.MsgTx, inputIdx uint32,
 	witness *asset.Witness, prevAsset *asset.Asset) error {
 
-	// We only support version 0 scripts atm.
 	if prevAsset.ScriptVersion != asset.ScriptV0 {
 		return ErrInvalidScriptVersion
 	}
 
+	// With all the components mapped into a virtual transaction, will
+	// execute it using the normal Tapscript VM, which does most of the
+	// heavy lifting here.
+	engine, _, err := vm.newScriptEngine(
+		virtualTx, inputIdx, witness, prevAsset,
+		txscript.StandardVerifyFlags,
+	)
+	if err != nil {
+		return err
+	}
+	if err := engine.Execute(); err != nil {
+		return newErrInner(ErrInvalidTransferWitness, err)
+	}
+
+	return nil
+}
+
+// newScriptEngine creates a Tapscript VM that validates the witness of the
+// given input of the virtual transaction, using the given script flags. The
+// pkScript of the previous output spent by the input is returned as well.
+func (vm *Engine) newScriptEngine(virtualTx *wire.MsgTx, inputIdx uint32,
+	witness *asset.Witness, prevAsset *asset.Asset,
+	flags txscript.ScriptFlags) (*txscript.Engine, []byte, error) {
+
 	// An input must have a valid witness.
 	if len(witness.TxWitness) == 0 {
 		inner := fmt.Errorf("input has no witness")
-		return newErrInner(ErrInvalidTransferWitness, inner)
+		return nil, nil, newErrInner(ErrInvalidTransferWitness, inner)
 	}
 
 	var (
@@ -355,22 +385,24 @@ func (vm *Engine) validateWitnessV0(virtualTx *wire.MsgTx, inputIdx uint32,
 		// An input MUST have a prev out and also a valid witness.
 		if witness.PrevID == nil {
 			inner := fmt.Errorf("input has nil prev ID")
-			return newErrInner(ErrInvalidTransferWitness, inner)
+			return nil, nil, newErrInner(
+				ErrInvalidTransferWitness, inner,
+			)
 		}
 
 		// The parameters of the new and old asset much match exactly.
 		err = matchesAssetParams(vm.newAsset, prevAsset, witness)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 
 		prevOutFetcher, err = tapscript.InputPrevOutFetcher(*prevAsset)
 	}
 	if err != nil {
 		if errors.Is(err, tapscript.ErrInvalidScriptVersion) {
-			return ErrInvalidScriptVersion
+			return nil, nil, ErrInvalidScriptVersion
 		}
-		return err
+		return nil, nil, err
 	}
 
 	// Obtain the prev out created above, we can pass in a null outpoint
@@ -387,21 +419,15 @@ func (vm *Engine) validateWitnessV0(virtualTx *wire.MsgTx, inputIdx uint32,
 
 	sigHashes := txscript.NewTxSigHashes(virtualTxCopy, prevOutFetcher)
 
-	// With all the components mapped into a virtual transaction, will
-	// execute it using the normal Tapscript VM, which does most of the
-	// heavy lifting here.
 	engine, err := txscript.NewEngine(
-		prevOut.PkScript, virtualTxCopy, 0, txscript.StandardVerifyFlags,
-		nil, sigHashes, prevOut.Value, prevOutFetcher,
+		prevOut.PkScript, virtualTxCopy, 0, flags, nil, sigHashes,
+		prevOut.Value, prevOutFetcher,
 	)
 	if err != nil {
-		return newErrInner(ErrInvalidTransferWitness, err)
-	}
-	if err := engine.Execute(); err != nil {
-		return newErrInner(ErrInvalidTransferWitness, err)
+		return nil, nil, newErrInner(ErrInvalidTransferWitness, err)
 	}
 
-	return nil
+	return engine, prevOut.PkScript, nil
 }
 
 // validateStateTransition attempts to validate a normal state transition where
@@ -486,6 +512,13 @@ func (vm *Engine) validateStateTransition() error {
 			if err != nil {
 				return err
 			}
+		case asset.ScriptV1:
+			err := vm.validateWitnessV1(
+				virtualTx, uint32(idx), &witness, prevAsset,
+			)
+			if err != nil {
+				return err
+			}
 		default:
 			return ErrInvalidScriptVersion
 		}
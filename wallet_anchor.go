@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strings"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
@@ -135,7 +136,8 @@ func (l *LndRpcWalletAnchor) SignAndFinalizePsbt(ctx context.Context,
 }
 
 // ImportTaprootOutput imports a new public key into the wallet, as a P2TR
-// output.
+// output. If the output is already known to the wallet, an error wrapping
+// tapsend.ErrOutputAlreadyImported is returned.
 func (l *LndRpcWalletAnchor) ImportTaprootOutput(ctx context.Context,
 	pub *btcec.PublicKey) (btcutil.Address, error) {
 
@@ -145,7 +147,15 @@ func (l *LndRpcWalletAnchor) ImportTaprootOutput(ctx context.Context,
 			FullOutputKey: pub,
 		},
 	)
-	if err != nil {
+
+	// The wallet only tells us about a duplicate import through the error
+	// message, so we translate it into a typed error here, once.
+	switch {
+	case err != nil && strings.Contains(err.Error(), "already exists"):
+		return nil, fmt.Errorf("%w: %w",
+			tapsend.ErrOutputAlreadyImported, err)
+
+	case err != nil:
 		return nil, err
 	}
 